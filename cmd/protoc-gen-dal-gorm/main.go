@@ -16,12 +16,10 @@ package main
 
 import (
 	"flag"
-	"fmt"
 
 	"google.golang.org/protobuf/compiler/protogen"
 
-	"github.com/panyam/protoc-gen-dal/pkg/collector"
-	"github.com/panyam/protoc-gen-dal/pkg/gorm"
+	"github.com/panyam/protoc-gen-dal/pkg/driver"
 )
 
 func main() {
@@ -33,71 +31,28 @@ func main() {
 	dalOutputDir := flags.String("dal_output_dir", "", "Subdirectory for DAL files relative to main output (e.g., 'dal' -> 'gen/gorm/dal/')")
 	entityImportPath := flags.String("entity_import_path", "", "Import path for entity package (auto-detected from proto go_package if not specified)")
 
+	// Template customization
+	templateDir := flags.String("template_dir", "", "Directory of user templates, one subdirectory per target (e.g., 'templates' -> 'templates/gorm/dal.go.tmpl')")
+	extraTemplates := flags.String("extra_templates", "", "Comma-separated extra templates in template_dir rendered once per proto file (e.g., 'metrics.go.tmpl')")
+
+	// Tooling
+	emitIR := flags.String("emit_ir", "", "Write a machine-readable dump of the generator's view of each proto file (json -> '{file}_<target>.ir.json')")
+	generateTests := flags.Bool("generate_tests", false, "Write '{file}_converters_test.go' round-trip tests for the generated converters")
+
 	// Run the plugin
 	protogen.Options{
-		ParamFunc: flags.Set,
+		ParamFunc: driver.ParamFunc(&flags, "extra_templates"),
 	}.Run(func(plugin *protogen.Plugin) error {
-		// Phase 1: Collect all GORM messages
-		messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
-		if err != nil {
-			return fmt.Errorf("failed to collect GORM messages: %w", err)
-		}
-
-		if len(messages) == 0 {
-			// No GORM messages found - this is not an error, just skip
-			return nil
-		}
-
-		// Phase 2: Generate GORM struct code
-		result, err := gorm.Generate(messages)
-		if err != nil {
-			return fmt.Errorf("failed to generate GORM code: %w", err)
-		}
-
-		// Phase 3: Generate converter code
-		converterResult, err := gorm.GenerateConverters(messages)
-		if err != nil {
-			return fmt.Errorf("failed to generate converter code: %w", err)
-		}
-
-		// Phase 3.5: Generate DAL helper code (if enabled)
-		var dalResult *gorm.GenerateResult
-		if *generateDAL {
-			dalResult, err = gorm.GenerateDALHelpers(messages, &gorm.DALOptions{
-				FilenameSuffix:   *dalFilenameSuffix,
-				FilenamePrefix:   *dalFilenamePrefix,
-				OutputDir:        *dalOutputDir,
-				EntityImportPath: *entityImportPath,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to generate DAL helper code: %w", err)
-			}
-		}
-
-		// Phase 4: Write generated files to plugin response
-		for _, genFile := range result.Files {
-			// Create a new file in the plugin response
-			// The second parameter is the Go import path for this generated file
-			f := plugin.NewGeneratedFile(genFile.Path, protogen.GoImportPath(genFile.Path))
-
-			// Write the generated content
-			f.P(genFile.Content)
-		}
-
-		// Write converter files
-		for _, genFile := range converterResult.Files {
-			f := plugin.NewGeneratedFile(genFile.Path, protogen.GoImportPath(genFile.Path))
-			f.P(genFile.Content)
-		}
-
-		// Write DAL helper files (if generated)
-		if dalResult != nil {
-			for _, genFile := range dalResult.Files {
-				f := plugin.NewGeneratedFile(genFile.Path, protogen.GoImportPath(genFile.Path))
-				f.P(genFile.Content)
-			}
-		}
-
-		return nil
+		return driver.Run(plugin, []string{"gorm"}, &driver.Options{
+			GenerateDAL:       *generateDAL,
+			DALFilenameSuffix: *dalFilenameSuffix,
+			DALFilenamePrefix: *dalFilenamePrefix,
+			DALOutputDir:      *dalOutputDir,
+			EntityImportPath:  *entityImportPath,
+			TemplateDir:       *templateDir,
+			ExtraTemplates:    driver.SplitList(*extraTemplates),
+			EmitIR:            *emitIR,
+			GenerateTests:     *generateTests,
+		})
 	})
 }
@@ -0,0 +1,114 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+protoc-gen-dal-lint is a Protocol Buffers compiler plugin that validates DAL sidecar protos
+without generating any code.
+
+# Overview
+
+protoc-gen-dal-lint runs the collector and the generators' field mapping analysis for each target
+and reports problems with their proto file:line:column positions, e.g.:
+
+	gorm/user.proto:42:3: error: field "profile" (api.v1.Profile) is not converted: no sidecar declares source "api.v1.Profile"; add one or handle the field in a decorator [missing-converter]
+
+Issues are written to stderr. If any issue has severity "error" the plugin fails, so it can gate CI.
+
+# Usage with buf
+
+Add to your buf.gen.yaml (the output directory is required by buf but nothing is written to it):
+
+	version: v2
+	plugins:
+	  - local: protoc-gen-dal-lint
+	    out: ./gen
+	    opt:
+	      - targets=gorm,datastore
+	      - severity=no-conversion:error
+	      - ignore=missing-primary-key@gorm.AuditLogGorm
+
+# Configuration Options
+
+  - targets: Comma-separated list of targets to lint (gorm|datastore, default: gorm,datastore)
+  - severity: Comma-separated rule:off|warning|error overrides (e.g., no-conversion:off)
+  - ignore: Comma-separated rules to suppress, optionally limited to a message or field
+    with rule@full.name (e.g., missing-converter@gorm.UserGorm.profile); "*" matches every rule
+
+# Rules
+
+  - unknown-source (error): the source message of a sidecar does not exist
+  - unknown-skip-field (error): skip_field on a field that does not exist in the source
+  - missing-converter (error): a message field's type has no converter
+  - no-conversion (warning): source and target types differ and no built-in conversion exists
+  - missing-primary-key (error): a GORM message with DAL helpers declares no primary key (its DAL is skipped)
+  - unsupported-type (error): a Datastore entity field is an unsigned integer
+  - generation-error (error): the generator could not build the target's structs or converters
+  - sidecar-name-collision (error): auto_sidecar would synthesize a message whose name is already taken
+
+# Links
+
+Documentation:
+
+  - GitHub: https://github.com/panyam/protoc-gen-dal
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/driver"
+	"github.com/panyam/protoc-gen-dal/pkg/lint"
+)
+
+func main() {
+	var flags flag.FlagSet
+	targets := flags.String("targets", "gorm,datastore", "Comma-separated list of targets to lint (gorm|datastore)")
+	severity := flags.String("severity", "", "Comma-separated rule:off|warning|error overrides (e.g., 'no-conversion:off')")
+	ignore := flags.String("ignore", "", "Comma-separated rules to suppress, optionally as rule@full.name (e.g., 'missing-converter@gorm.UserGorm.profile')")
+
+	protogen.Options{
+		ParamFunc: driver.ParamFunc(&flags, "targets", "severity", "ignore"),
+	}.Run(func(plugin *protogen.Plugin) error {
+		targetNames, err := driver.ParseTargets(*targets)
+		if err != nil {
+			return err
+		}
+
+		severities, err := lint.ParseSeverities(driver.SplitList(*severity))
+		if err != nil {
+			return err
+		}
+
+		issues, err := lint.Lint(plugin, targetNames, &lint.Config{
+			Severities: severities,
+			Ignore:     driver.SplitList(*ignore),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			fmt.Fprintln(os.Stderr, issue)
+		}
+
+		if errors := lint.CountErrors(issues); errors > 0 {
+			return fmt.Errorf("%d lint error(s), %d warning(s)", errors, len(issues)-errors)
+		}
+		return nil
+	})
+}
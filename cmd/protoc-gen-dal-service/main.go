@@ -0,0 +1,103 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+protoc-gen-dal-service is a Protocol Buffers compiler plugin that generates gRPC server
+implementations for standard resource services on top of the GORM DALs and converters
+generated by protoc-gen-dal-gorm.
+
+# Overview
+
+A service opts in by naming the GORM message that stores its resource:
+
+	service NoteService {
+	  option (dal.v1.service) = { target: "gorm.NoteGorm" };
+
+	  rpc CreateNote(CreateNoteRequest) returns (api.Note);
+	  rpc GetNote(GetNoteRequest) returns (api.Note);
+	  rpc UpdateNote(UpdateNoteRequest) returns (api.Note);
+	  rpc DeleteNote(DeleteNoteRequest) returns (google.protobuf.Empty);
+	  rpc ListNotes(ListNotesRequest) returns (ListNotesResponse);
+	}
+
+For each such service it writes a NoteServiceDALServer that embeds UnimplementedNoteServiceServer
+and implements the methods with AIP-style shapes: requests carry the resource (Create, Update,
+with an optional update_mask) or its primary key fields (Get, Delete), and List methods page with
+page_size, page_token and next_page_token and apply an AIP-160 filter field. Errors are returned as gRPC statuses (NotFound,
+InvalidArgument, AlreadyExists, ...). Will<Method> hooks on the server can reject requests.
+
+The output goes next to the protoc-gen-go-grpc output, in the service's Go package.
+
+# Usage with buf
+
+	version: v2
+	plugins:
+	  - remote: buf.build/grpc/go
+	    out: ./gen/go
+	    opt: paths=source_relative
+	  - local: protoc-gen-dal-service
+	    out: ./gen/go
+	    opt:
+	      - paths=source_relative
+	      - entity_import_path=github.com/example/gen/gorm
+	      - dal_output_dir=dal
+
+# Configuration Options
+
+  - filename_suffix: Suffix of generated files (default: "_dal_server" -> "note_service_dal_server.go")
+  - entity_import_path: Import path of the protoc-gen-dal-gorm output (same value as passed to it)
+  - dal_output_dir: DAL subdirectory of the protoc-gen-dal-gorm output (same value as passed to it)
+
+# Links
+
+Documentation:
+
+  - GitHub: https://github.com/panyam/protoc-gen-dal
+*/
+package main
+
+import (
+	"flag"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/driver"
+	"github.com/panyam/protoc-gen-dal/pkg/service"
+)
+
+func main() {
+	var flags flag.FlagSet
+	filenameSuffix := flags.String("filename_suffix", "_dal_server", "Suffix for generated filenames (e.g., '_dal_server' -> 'note_service_dal_server.go')")
+	entityImportPath := flags.String("entity_import_path", "", "Import path of the protoc-gen-dal-gorm output (auto-detected from the target's go_package if not specified)")
+	dalOutputDir := flags.String("dal_output_dir", "", "DAL subdirectory of the protoc-gen-dal-gorm output (e.g., 'dal')")
+
+	protogen.Options{
+		ParamFunc: driver.ParamFunc(&flags),
+	}.Run(func(plugin *protogen.Plugin) error {
+		files, err := service.Generate(plugin, &service.Options{
+			FilenameSuffix:   *filenameSuffix,
+			EntityImportPath: *entityImportPath,
+			DALOutputDir:     *dalOutputDir,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, genFile := range files {
+			f := plugin.NewGeneratedFile(genFile.Path, protogen.GoImportPath(genFile.Path))
+			f.P(genFile.Content)
+		}
+		return nil
+	})
+}
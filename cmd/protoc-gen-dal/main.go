@@ -13,12 +13,15 @@
 // limitations under the License.
 
 /*
-protoc-gen-dal is a Protocol Buffers compiler plugin that generates Data Access Layer (DAL) converters for protobuf messages.
+protoc-gen-dal is a Protocol Buffers compiler plugin that generates database entities, converters
+and DAL helpers for every supported target in a single pass.
 
 # Overview
 
-This generator creates converter functions for transforming between protobuf messages and various database/datastore representations.
-It supports generating converters for SQL databases, NoSQL stores, and other persistence backends.
+protoc-gen-dal runs the same collector → generator pipeline as the single-target plugins
+(protoc-gen-dal-gorm, protoc-gen-dal-datastore), once per requested target. Each target picks up the
+messages annotated for it (e.g., (dal.v1.gorm) or (dal.v1.datastore_options)) and ignores the rest,
+so one buf plugin entry covers a whole repository.
 
 # Installation
 
@@ -40,25 +43,17 @@ Add to your buf.gen.yaml:
 	    out: ./gen/go
 	    opt: paths=source_relative
 
-	  # Generate DAL converters (raw database)
+	  # Generate GORM and Datastore code in one pass
 	  - local: protoc-gen-dal
-	    out: ./gen/dal
+	    out: ./gen
 	    opt:
-	      - datastores=postgres
-	      - target=raw
-
-	  # OR Generate GORM models
-	  - local: protoc-gen-dal
-	    out: ./gen/dal
-	    opt:
-	      - datastores=postgres
-	      - target=gorm
-
-	  # OR Generate Google Cloud Datastore bindings
-	  - local: protoc-gen-dal
-	    out: ./gen/dal
-	    opt:
-	      - target=datastore
+	      - paths=source_relative
+	      - targets=gorm,datastore
+	      - gorm_out_dir=gorm
+	      - datastore_out_dir=datastore
+	      - generate_dal=true
+	      - dal_output_dir=dal
+	      - entity_import_path=github.com/example/gen
 
 Generate code:
 
@@ -66,85 +61,48 @@ Generate code:
 
 # Configuration Options
 
-Core Generation:
+Target Selection:
 
-  - dal_export_path: Path where DAL converter code should be generated (default: ".")
-  - datastores: Comma-separated list of datastores to generate converters for (postgres|mysql|sqlite|mongodb|redis|dynamodb|firestore)
-  - target: Generation target - raw (direct DB), gorm (GORM ORM), datastore (Google Cloud Datastore), firestore (Google Cloud Firestore)
-  - package_name: Go package name for generated code (default: "dal")
+  - targets: Comma-separated list of targets to generate (gorm|datastore, default: gorm,datastore)
+  - <target>_out_dir: Subdirectory (relative to the plugin output) for a target's files (e.g., gorm_out_dir=gorm)
 
-Message Selection:
+DAL Helpers (shared by all targets):
 
-  - messages: Comma-separated list of messages to generate converters for (default: all)
-  - message_include: Comma-separated glob patterns for messages to include
-  - message_exclude: Comma-separated glob patterns for messages to exclude
+  - generate_dal: Generate DAL helper methods (default: false)
+  - dal_filename_suffix: Suffix for DAL helper filenames (default: "_dal")
+  - dal_filename_prefix: Prefix for DAL helper filenames (overrides the suffix when set)
+  - dal_output_dir: Subdirectory for DAL files relative to each target's output
+  - entity_import_path: Import path of the plugin output root; each target's <target>_out_dir is appended
 
-# Generated Files
-
-The generator produces converter files per datastore:
+Templates:
 
-  - {package}_postgres.go: PostgreSQL converters (ToRow/FromRow)
-  - {package}_mongodb.go: MongoDB converters (ToBSON/FromBSON)
-  - {package}_redis.go: Redis converters (ToHash/FromHash)
+  - template_dir: Directory of user templates with one subdirectory per target; a file named after a
+    built-in template (e.g., template_dir/gorm/dal.go.tmpl) replaces it
+  - extra_templates: Comma-separated templates in template_dir/<target>/ rendered once per proto file
+    (e.g., metrics.go.tmpl -> {file}_metrics.go)
 
-# Usage Example
+Tooling:
 
-Define your message:
+  - emit_ir: Set to "json" to also write {file}_<target>.ir.json describing the resolved messages,
+    fields, column names, primary keys, per-field conversions, skipped fields and warnings
+  - generate_tests: Set to true to also write {file}_converters_test.go, which round-trips random
+    API messages through each converter pair and compares them with proto.Equal
 
-	syntax = "proto3";
-	package library.v1;
+# Generated Files
 
-	message Book {
-	  string id = 1;
-	  string title = 2;
-	  string author = 3;
-	  int64 published_year = 4;
-	}
+For each target the generator produces the same files as the corresponding single-target plugin:
 
-Generate DAL converters:
+  - gorm: {file}_gorm.go, {file}_converters.go, _embedded_gorm.go and optionally DAL helpers
+  - datastore: {file}.go, {file}_converters.go and optionally DAL helpers
 
-	buf generate
-
-Use the generated converters:
-
-	package main
-
-	import (
-	    "database/sql"
-	    libraryv1 "your-project/gen/go/library/v1"
-	    "your-project/gen/dal/library/v1"
-	)
-
-	func main() {
-	    db, _ := sql.Open("postgres", "...")
-
-	    // Convert protobuf to SQL row values
-	    book := &libraryv1.Book{
-	        Id: "1",
-	        Title: "Go Programming",
-	        Author: "John Doe",
-	        PublishedYear: 2024,
-	    }
-
-	    // ToPostgresRow returns column names and values
-	    columns, values := library_v1.BookToPostgresRow(book)
-	    _, err := db.Exec("INSERT INTO books ...") // use columns/values
-
-	    // FromPostgresRow populates protobuf from SQL row
-	    rows, _ := db.Query("SELECT * FROM books WHERE id = $1", "1")
-	    defer rows.Close()
-	    if rows.Next() {
-	        foundBook := library_v1.BookFromPostgresRow(rows)
-	    }
-	}
+If two targets would write the same file (e.g., both annotate messages in the same proto file),
+generation fails; set <target>_out_dir to separate them.
 
 # Architecture
 
-The generator uses a simple architecture:
-
- 1. DALGenerator: Orchestrates converter generation
- 2. Filters: Message filtering
- 3. Templates: Converter code templates per datastore type
+ 1. Collector: Finds messages annotated for a target (pkg/collector)
+ 2. Generators: Produce entity, converter and DAL code per target (pkg/gorm, pkg/datastore)
+ 3. Driver: Runs the pipeline for each target with shared options (pkg/driver)
 
 # Links
 
@@ -156,77 +114,63 @@ package main
 
 import (
 	"flag"
-	"fmt"
-	"log"
 
 	"google.golang.org/protobuf/compiler/protogen"
 
-	"github.com/panyam/protoc-gen-dal/pkg/builders"
-	"github.com/panyam/protoc-gen-dal/pkg/filters"
-	"github.com/panyam/protoc-gen-dal/pkg/generators"
+	"github.com/panyam/protoc-gen-dal/pkg/driver"
 )
 
 func main() {
-	var flagSet flag.FlagSet
+	var flags flag.FlagSet
 
-	// Core generation options
-	dalExportPath := flagSet.String("dal_export_path", ".", "Path where DAL converter code should be generated")
-	datastores := flagSet.String("datastores", "postgres", "Comma-separated list of datastores (postgres|mysql|sqlite|mongodb|redis|dynamodb|firestore)")
-	packageName := flagSet.String("package_name", "dal", "Go package name for generated code")
+	// Target selection
+	targets := flags.String("targets", "gorm,datastore", "Comma-separated list of targets to generate (gorm|datastore)")
+	targetOutputDirs := make(map[string]*string)
+	for _, name := range driver.TargetNames() {
+		targetOutputDirs[name] = flags.String(name+"_out_dir", "", "Subdirectory for "+name+" files relative to main output")
+	}
 
-	// Target selection: raw database or ORM/API
-	target := flagSet.String("target", "raw", "Generation target (raw|gorm|datastore|firestore)")
+	// Shared DAL options
+	generateDAL := flags.Bool("generate_dal", false, "Generate DAL helper methods")
+	dalFilenameSuffix := flags.String("dal_filename_suffix", "_dal", "Suffix for DAL helper filename (e.g., '_dal' -> 'world_gorm_dal.go')")
+	dalFilenamePrefix := flags.String("dal_filename_prefix", "", "Prefix for DAL helper filename (e.g., 'dal_' -> 'dal_world_gorm.go')")
+	dalOutputDir := flags.String("dal_output_dir", "", "Subdirectory for DAL files relative to each target's output (e.g., 'dal' -> 'gen/gorm/dal/')")
+	entityImportPath := flags.String("entity_import_path", "", "Import path for the output root (auto-detected from proto go_package if not specified)")
 
-	// Message selection
-	messages := flagSet.String("messages", "", "Comma-separated list of messages to generate converters for (default: all)")
-	messageInclude := flagSet.String("message_include", "", "Comma-separated glob patterns for messages to include")
-	messageExclude := flagSet.String("message_exclude", "", "Comma-separated glob patterns for messages to exclude")
+	// Template customization
+	templateDir := flags.String("template_dir", "", "Directory of user templates, one subdirectory per target (e.g., 'templates' -> 'templates/gorm/dal.go.tmpl')")
+	extraTemplates := flags.String("extra_templates", "", "Comma-separated extra templates in template_dir rendered once per proto file (e.g., 'metrics.go.tmpl')")
 
-	protogen.Options{
-		ParamFunc: flagSet.Set,
-	}.Run(func(gen *protogen.Plugin) error {
-		log.Printf("DAL GENERATOR: Plugin callback started")
-		log.Printf("DAL GENERATOR: Request has %d files", len(gen.Files))
-		log.Printf("DAL GENERATOR: Request parameters: %+v", gen.Request.GetParameter())
-
-		defer func() {
-			log.Printf("DAL GENERATOR: Plugin callback ending")
-			log.Printf("DAL GENERATOR: Response has %d files", len(gen.Response().File))
-			for i, file := range gen.Response().File {
-				log.Printf("DAL GENERATOR: Response file %d: %s (%d bytes)",
-					i, file.GetName(), len(file.GetContent()))
-			}
-		}()
-
-		// Create generation configuration
-		config := &builders.GenerationConfig{
-			DALExportPath: *dalExportPath,
-			Datastores:    *datastores,
-			PackageName:   *packageName,
-			Target:        *target,
-		}
+	// Tooling
+	emitIR := flags.String("emit_ir", "", "Write a machine-readable dump of the generator's view of each proto file (json -> '{file}_<target>.ir.json')")
+	generateTests := flags.Bool("generate_tests", false, "Write '{file}_converters_test.go' round-trip tests for the generated converters")
 
-		// Create filter criteria from configuration
-		filterCriteria, err := filters.ParseFromConfig(*messages, *messageInclude, *messageExclude)
+	protogen.Options{
+		ParamFunc: driver.ParamFunc(&flags, "targets", "extra_templates"),
+	}.Run(func(plugin *protogen.Plugin) error {
+		targetNames, err := driver.ParseTargets(*targets)
 		if err != nil {
-			return fmt.Errorf("invalid filter configuration: %w", err)
+			return err
 		}
 
-		// Create DAL generator
-		dalGenerator := generators.NewDALGenerator(gen)
-
-		// Validate configuration
-		if err := dalGenerator.ValidateConfig(config); err != nil {
-			return fmt.Errorf("invalid configuration: %w", err)
-		}
-
-		// Perform generation with detailed error handling
-		if err := dalGenerator.Generate(config, filterCriteria); err != nil {
-			log.Printf("protoc-gen-dal: Generation failed: %v", err)
-			return fmt.Errorf("DAL generation failed: %w", err)
+		outputDirs := make(map[string]string)
+		for name, dir := range targetOutputDirs {
+			if *dir != "" {
+				outputDirs[name] = *dir
+			}
 		}
 
-		log.Printf("protoc-gen-dal: Generation completed successfully")
-		return nil
+		return driver.Run(plugin, targetNames, &driver.Options{
+			GenerateDAL:       *generateDAL,
+			DALFilenameSuffix: *dalFilenameSuffix,
+			DALFilenamePrefix: *dalFilenamePrefix,
+			DALOutputDir:      *dalOutputDir,
+			EntityImportPath:  *entityImportPath,
+			TemplateDir:       *templateDir,
+			ExtraTemplates:    driver.SplitList(*extraTemplates),
+			EmitIR:            *emitIR,
+			GenerateTests:     *generateTests,
+			TargetOutputDirs:  outputDirs,
+		})
 	})
 }
@@ -0,0 +1,75 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit provides the runtime helpers used by DALs generated by
+// protoc-gen-dal with audit columns (created_by, updated_by, created_at,
+// updated_at).
+//
+// Generated DALs read the actor of each write from its context through an
+// Extractor and the time from a Clock. By default those are FromContext,
+// which returns the actor stored with WithActor, and time.Now; applications
+// can plug in their own per DAL or globally via DefaultExtractor and
+// DefaultClock.
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Extractor returns the actor of a context, and false if there is none.
+type Extractor func(ctx context.Context) (string, bool)
+
+// Clock returns the current time.
+type Clock func() time.Time
+
+// DefaultExtractor is used by DALs that do not set their own extractor.
+var DefaultExtractor Extractor = FromContext
+
+// DefaultClock is used by DALs that do not set their own clock.
+var DefaultClock Clock = time.Now
+
+// contextKey is the context key for the actor stored by WithActor.
+type contextKey struct{}
+
+// WithActor returns a copy of ctx carrying actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// FromContext returns the actor stored in ctx by WithActor.
+func FromContext(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(contextKey{}).(string)
+	return actor, ok
+}
+
+// Actor returns the actor of ctx using extract, or DefaultExtractor if
+// extract is nil.
+// Returns "" if the context has no actor: writes without one (e.g., from
+// background jobs) are still allowed and leave the *_by columns empty.
+func Actor(ctx context.Context, extract Extractor) string {
+	if extract == nil {
+		extract = DefaultExtractor
+	}
+	actor, _ := extract(ctx)
+	return actor
+}
+
+// Now returns the current time using clock, or DefaultClock if clock is nil.
+func Now(clock Clock) time.Time {
+	if clock == nil {
+		clock = DefaultClock
+	}
+	return clock()
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestActor_FromContext(t *testing.T) {
+	ctx := WithActor(context.Background(), "alice")
+	if got := Actor(ctx, nil); got != "alice" {
+		t.Errorf("Expected actor alice, got %q", got)
+	}
+	if got := Actor(context.Background(), nil); got != "" {
+		t.Errorf("Expected no actor, got %q", got)
+	}
+}
+
+func TestActor_CustomExtractor(t *testing.T) {
+	extract := func(ctx context.Context) (string, bool) {
+		return "system", true
+	}
+	if got := Actor(context.Background(), extract); got != "system" {
+		t.Errorf("Expected actor system, got %q", got)
+	}
+}
+
+func TestNow(t *testing.T) {
+	fixed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := Now(func() time.Time { return fixed }); !got.Equal(fixed) {
+		t.Errorf("Expected %v, got %v", fixed, got)
+	}
+	if got := Now(nil); got.IsZero() {
+		t.Error("Expected Now(nil) to use the default clock")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides the runtime helpers used by the read-through cached
+// DALs generated by protoc-gen-dal (GORM and Datastore cache option).
+//
+// Cached DALs store entities as serialized source (API) messages in a Cache.
+// The package includes an in-memory LRU; other stores (e.g., Redis or
+// memcached) plug in by implementing the three methods of Cache.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache stores byte values by key. Implementations must be safe for
+// concurrent use.
+type Cache interface {
+	// Get returns the value stored under key, and false if there is none.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key for ttl. A zero ttl never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes keys. Keys that are not stored are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// ErrInvalidEntry is returned by Decode for values not written by Encode.
+var ErrInvalidEntry = errors.New("cache: invalid entry")
+
+// Entry markers, the first byte of encoded entries.
+const (
+	notFoundEntry byte = 0
+	foundEntry    byte = 1
+)
+
+// keyEscaper escapes the separator of key parts.
+var keyEscaper = strings.NewReplacer(`\`, `\\`, ":", `\:`)
+
+// Key returns the cache key of an entity: prefix and the parts of its primary
+// key (and tenant, if any) joined by ":". Parts are escaped so that distinct
+// keys never collide.
+func Key(prefix string, parts ...any) string {
+	var b strings.Builder
+	b.WriteString(keyEscaper.Replace(prefix))
+	for _, part := range parts {
+		b.WriteByte(':')
+		b.WriteString(keyEscaper.Replace(fmt.Sprint(part)))
+	}
+	return b.String()
+}
+
+// Encode returns the entry caching msg, or caching a not-found result if
+// msg is nil.
+func Encode(msg proto.Message) ([]byte, error) {
+	if msg == nil {
+		return []byte{notFoundEntry}, nil
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{foundEntry}, data...), nil
+}
+
+// Decode reads an entry written by Encode into msg.
+// Returns false, and leaves msg untouched, for a cached not-found result.
+func Decode(data []byte, msg proto.Message) (bool, error) {
+	if len(data) == 0 {
+		return false, ErrInvalidEntry
+	}
+	switch data[0] {
+	case notFoundEntry:
+		return false, nil
+	case foundEntry:
+		if err := proto.Unmarshal(data[1:], msg); err != nil {
+			return false, fmt.Errorf("%w: %v", ErrInvalidEntry, err)
+		}
+		return true, nil
+	}
+	return false, ErrInvalidEntry
+}
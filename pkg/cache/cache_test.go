@@ -0,0 +1,81 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{Key("Book", "b1"), "Book:b1"},
+		{Key("Edition", "b1", int32(2)), "Edition:b1:2"},
+		{Key("Book", "a:b", "c"), `Book:a\:b:c`},
+		{Key("Book", `a\`, ":b"), `Book:a\\:\:b`},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("Got key %q, want %q", tt.got, tt.want)
+		}
+	}
+	if Key("Book", "a:b", "c") == Key("Book", "a", "b:c") {
+		t.Error("Expected distinct parts to give distinct keys")
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, seconds := range []int64{0, 1700000000} {
+		data, err := Encode(&timestamppb.Timestamp{Seconds: seconds})
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		var got timestamppb.Timestamp
+		found, err := Decode(data, &got)
+		if err != nil || !found {
+			t.Fatalf("Decode returned %v, %v", found, err)
+		}
+		if got.Seconds != seconds {
+			t.Errorf("Expected %d seconds, got %d", seconds, got.Seconds)
+		}
+	}
+}
+
+func TestEncodeDecode_NotFound(t *testing.T) {
+	data, err := Encode(nil)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	got := &timestamppb.Timestamp{Seconds: 5}
+	found, err := Decode(data, got)
+	if err != nil || found {
+		t.Fatalf("Expected a not-found entry, got %v, %v", found, err)
+	}
+	if got.Seconds != 5 {
+		t.Error("Expected a not-found entry to leave the message untouched")
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	for _, data := range [][]byte{nil, {7}, {foundEntry, 0xff}} {
+		if _, err := Decode(data, &timestamppb.Timestamp{}); !errors.Is(err, ErrInvalidEntry) {
+			t.Errorf("%v: expected ErrInvalidEntry, got %v", data, err)
+		}
+	}
+}
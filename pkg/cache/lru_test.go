@@ -0,0 +1,81 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Verify LRU implements Cache
+var _ Cache = (*LRU)(nil)
+
+func TestLRU_Evicts(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+	c.Set(ctx, "a", []byte("1"), 0)
+	c.Set(ctx, "b", []byte("2"), 0)
+	c.Get(ctx, "a") // b is now the least recently used
+	c.Set(ctx, "c", []byte("3"), 0)
+
+	if _, ok, _ := c.Get(ctx, "b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := c.Get(ctx, key); !ok {
+			t.Errorf("Expected %s to be cached", key)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", c.Len())
+	}
+}
+
+func TestLRU_TTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(10)
+	c.Now = func() time.Time { return now }
+	c.Set(ctx, "short", []byte("1"), time.Minute)
+	c.Set(ctx, "forever", []byte("2"), 0)
+
+	now = now.Add(time.Minute)
+	if _, ok, _ := c.Get(ctx, "short"); ok {
+		t.Error("Expected short to expire")
+	}
+	if value, ok, _ := c.Get(ctx, "forever"); !ok || string(value) != "2" {
+		t.Errorf("Expected forever to stay cached, got %q, %v", value, ok)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Expected the expired entry to be removed, got %d entries", c.Len())
+	}
+}
+
+func TestLRU_SetAndDelete(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10)
+	c.Set(ctx, "a", []byte("1"), 0)
+	c.Set(ctx, "a", []byte("2"), 0)
+	if value, _, _ := c.Get(ctx, "a"); string(value) != "2" {
+		t.Errorf("Expected the latest value, got %q", value)
+	}
+
+	c.Set(ctx, "b", []byte("3"), 0)
+	c.Delete(ctx, "a", "b", "missing")
+	if c.Len() != 0 {
+		t.Errorf("Expected no entries after Delete, got %d", c.Len())
+	}
+}
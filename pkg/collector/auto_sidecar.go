@@ -0,0 +1,270 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// ErrSidecarNameCollision is wrapped by errors for synthesized sidecar
+// messages whose name is already taken by another message.
+var ErrSidecarNameCollision = errors.New("sidecar name collision")
+
+// wellKnownPackage holds the well-known types, which the generators convert
+// natively and which therefore never get a sidecar.
+const wellKnownPackage = "google.protobuf"
+
+// autoSidecarTargets maps the auto_sidecar target enum to collector targets.
+var autoSidecarTargets = map[dalv1.SidecarTarget]Target{
+	dalv1.SidecarTarget_GORM:      TargetGorm,
+	dalv1.SidecarTarget_DATASTORE: TargetDatastore,
+}
+
+// defaultSidecarSuffix returns the suffix appended to source message names
+// when auto_sidecar does not set one.
+func defaultSidecarSuffix(target Target) string {
+	if target == TargetDatastore {
+		return "Datastore"
+	}
+	return "Gorm"
+}
+
+// collectAutoSidecars synthesizes sidecar messages for files with the
+// (dal.v1.auto_sidecar) option.
+//
+// Why synthesize messages?
+// A sidecar that only names its source is an empty message whose fields all
+// come from MergeSourceFields. Synthesizing it produces exactly the message
+// the user would have written, so the generators handle it like any other.
+//
+// Which sources get a sidecar:
+//  1. Every top-level message in the option's package_include packages
+//  2. Every message type referenced by the file's sidecar messages (declared
+//     or synthesized), transitively, so the MessageRegistry can resolve them.
+//     Fields a declared sidecar overrides, skips or replaces (oneofs) are not
+//     followed, since the generated struct does not use their source type.
+//
+// Sources that already have a sidecar for the target (declared anywhere, or
+// synthesized for an earlier file) are left alone; that is how per-message
+// options such as kind or table are set. Excluded sources, sources marked
+// (dal.v1.skip_dal) and well-known types are never synthesized.
+//
+// Parameters:
+//   - gen: The protogen plugin containing all proto files
+//   - target: Which datastore target to collect
+//   - index: Message index from buildMessageIndex
+//   - collected: Messages collected from declared sidecars
+//
+// Returns:
+//   - Slice of MessageInfo, one per synthesized message
+//   - Slice of MessageError for names that collide with existing messages
+func collectAutoSidecars(gen *protogen.Plugin, target Target, index map[string]*protogen.Message, collected []*MessageInfo) ([]*MessageInfo, []*MessageError) {
+	var synthesized []*MessageInfo
+	var messageErrors []*MessageError
+
+	covered := make(map[string]bool)
+	for _, info := range collected {
+		covered[info.SourceName] = true
+	}
+
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
+		}
+
+		fileOpts := file.Desc.Options()
+		if fileOpts == nil || !proto.HasExtension(fileOpts, dalv1.E_AutoSidecar) {
+			continue
+		}
+		autoOpts, _ := proto.GetExtension(fileOpts, dalv1.E_AutoSidecar).([]*dalv1.AutoSidecarOptions)
+
+		for _, opts := range autoOpts {
+			if t, ok := autoSidecarTargets[opts.Target]; !ok || t != target {
+				continue
+			}
+			infos, errs := synthesizeFileSidecars(file, target, opts, gen, index, collected, covered)
+			synthesized = append(synthesized, infos...)
+			messageErrors = append(messageErrors, errs...)
+		}
+	}
+
+	return synthesized, messageErrors
+}
+
+// synthesizeFileSidecars synthesizes the sidecar messages for one
+// auto_sidecar entry of a file. See collectAutoSidecars for the rules.
+func synthesizeFileSidecars(file *protogen.File, target Target, opts *dalv1.AutoSidecarOptions, gen *protogen.Plugin,
+	index map[string]*protogen.Message, collected []*MessageInfo, covered map[string]bool) ([]*MessageInfo, []*MessageError) {
+	var messageErrors []*MessageError
+
+	suffix := opts.Suffix
+	if suffix == "" {
+		suffix = defaultSidecarSuffix(target)
+	}
+	excluded := make(map[string]bool, len(opts.MessageExclude))
+	for _, name := range opts.MessageExclude {
+		excluded[name] = true
+	}
+
+	// Sources to synthesize, in discovery order (determines output order)
+	var queue []*protogen.Message
+	enqueue := func(msg *protogen.Message) {
+		fullName := string(msg.Desc.FullName())
+		if covered[fullName] || excluded[fullName] || msg.Desc.IsMapEntry() ||
+			msg.Desc.ParentFile().Package() == wellKnownPackage || isSkipDAL(msg) {
+			return
+		}
+		covered[fullName] = true
+		queue = append(queue, msg)
+	}
+
+	// Roots 1: every message in the included packages
+	includes := make(map[string]bool, len(opts.PackageInclude))
+	for _, pkg := range opts.PackageInclude {
+		includes[pkg] = true
+	}
+	for _, f := range gen.Files {
+		if !includes[string(f.Desc.Package())] {
+			continue
+		}
+		for _, msg := range f.Messages {
+			enqueue(msg)
+		}
+	}
+
+	// Roots 2: types referenced by the file's declared sidecars
+	for _, info := range collected {
+		if info.TargetMessage.Desc.ParentFile().Path() != file.Desc.Path() {
+			continue
+		}
+		overridden := make(map[string]bool)
+		for _, field := range info.TargetMessage.Fields {
+			overridden[string(field.Desc.Name())] = true
+		}
+		for _, field := range info.SourceMessage.Fields {
+			if overridden[string(field.Desc.Name())] {
+				continue
+			}
+			if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() && overridden[string(oneof.Desc.Name())] {
+				continue
+			}
+			if ref := referencedMessage(field); ref != nil {
+				enqueue(ref)
+			}
+		}
+	}
+
+	// Synthesize sidecars breadth-first so referenced types follow their users
+	fileDesc := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(file.Desc.Path()),
+		Package: proto.String(string(file.Desc.Package())),
+		Syntax:  proto.String("proto3"),
+	}
+	var goNames []string
+	for i := 0; i < len(queue); i++ {
+		source := queue[i]
+		for _, field := range source.Fields {
+			if ref := referencedMessage(field); ref != nil {
+				enqueue(ref)
+			}
+		}
+
+		name := source.GoIdent.GoName + suffix
+		fullName := string(file.Desc.Package()) + "." + name
+		if existing := index[fullName]; existing != nil {
+			messageErrors = append(messageErrors, &MessageError{
+				Message: existing,
+				Err: fmt.Errorf("%w: auto_sidecar in '%s' cannot synthesize '%s' for source '%s' because the name is taken; declare the sidecar explicitly or set a different suffix",
+					ErrSidecarNameCollision, file.Desc.Path(), fullName, source.Desc.FullName()),
+			})
+			continue
+		}
+
+		msgOpts := &descriptorpb.MessageOptions{}
+		switch target {
+		case TargetGorm:
+			proto.SetExtension(msgOpts, dalv1.E_Gorm, &dalv1.GormOptions{Source: string(source.Desc.FullName())})
+		case TargetDatastore:
+			proto.SetExtension(msgOpts, dalv1.E_DatastoreOptions, &dalv1.DatastoreOptions{Source: string(source.Desc.FullName())})
+		}
+		fileDesc.MessageType = append(fileDesc.MessageType, &descriptorpb.DescriptorProto{
+			Name:    proto.String(name),
+			Options: msgOpts,
+		})
+		goNames = append(goNames, name)
+	}
+
+	if len(fileDesc.MessageType) == 0 {
+		return nil, messageErrors
+	}
+
+	// The synthesized messages live in a descriptor of their own that shares
+	// the sidecar file's path and package, so they are grouped with it.
+	desc, err := protodesc.NewFile(fileDesc, new(protoregistry.Files))
+	if err != nil {
+		messageErrors = append(messageErrors, &MessageError{
+			Message: nil,
+			Err:     fmt.Errorf("auto_sidecar in '%s': %w", file.Desc.Path(), err),
+		})
+		return nil, messageErrors
+	}
+
+	var synthesized []*MessageInfo
+	for i, name := range goNames {
+		msg := &protogen.Message{
+			Desc:     desc.Messages().Get(i),
+			GoIdent:  protogen.GoIdent{GoName: name, GoImportPath: file.GoImportPath},
+			Location: protogen.Location{SourceFile: file.Desc.Path()},
+		}
+		info, err := extractMessageInfo(msg, target, index)
+		if err != nil {
+			messageErrors = append(messageErrors, &MessageError{Message: msg, Err: err})
+			continue
+		}
+		synthesized = append(synthesized, info)
+	}
+	return synthesized, messageErrors
+}
+
+// referencedMessage returns the message type a field refers to (the value
+// type for maps), or nil for scalar fields.
+func referencedMessage(field *protogen.Field) *protogen.Message {
+	if field.Message == nil {
+		return nil
+	}
+	if field.Desc.IsMap() {
+		return field.Message.Fields[1].Message
+	}
+	return field.Message
+}
+
+// isSkipDAL reports whether a message is marked with (dal.v1.skip_dal).
+func isSkipDAL(msg *protogen.Message) bool {
+	opts := msg.Desc.Options()
+	if opts == nil {
+		return false
+	}
+	skip, _ := proto.GetExtension(opts, dalv1.E_SkipDal).(bool)
+	return skip
+}
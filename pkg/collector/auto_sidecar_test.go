@@ -0,0 +1,219 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// gameProtos returns a source package with a few related messages and a
+// common package they reference.
+func gameProtos() []testutil.TestFile {
+	return []testutil.TestFile{
+		{
+			Name: "common/v1/common.proto",
+			Pkg:  "common.v1",
+			Messages: []testutil.TestMessage{
+				{Name: "Color", Fields: []testutil.TestField{{Name: "hex", Number: 1, TypeName: "string"}}},
+				{Name: "Unused", Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}}},
+			},
+		},
+		{
+			Name:    "game/v1/game.proto",
+			Pkg:     "game.v1",
+			Imports: []string{"common/v1/common.proto"},
+			Messages: []testutil.TestMessage{
+				{
+					Name: "Game",
+					Fields: []testutil.TestField{
+						{Name: "id", Number: 1, TypeName: "string"},
+						{Name: "players", Number: 2, TypeName: "game.v1.Player", Repeated: true},
+						{Name: "teams", Number: 3, TypeName: "game.v1.Team", IsMap: true, MapKeyType: "string"},
+					},
+				},
+				{
+					Name: "Player",
+					Fields: []testutil.TestField{
+						{Name: "name", Number: 1, TypeName: "string"},
+						{Name: "color", Number: 2, TypeName: "common.v1.Color"},
+					},
+				},
+				{Name: "Team", Fields: []testutil.TestField{{Name: "name", Number: 1, TypeName: "string"}}},
+				{Name: "Internal", SkipDAL: true},
+			},
+		},
+	}
+}
+
+// sourceNames maps each collected source message name to its sidecar name.
+func sourceNames(messages []*MessageInfo) map[string]string {
+	names := make(map[string]string)
+	for _, msg := range messages {
+		names[msg.SourceName] = string(msg.TargetMessage.Desc.Name())
+	}
+	return names
+}
+
+// TestAutoSidecar_PackageInclude verifies that every message in an included
+// package gets a sidecar, plus the messages they reference from elsewhere.
+func TestAutoSidecar_PackageInclude(t *testing.T) {
+	files := append(gameProtos(), testutil.TestFile{
+		Name: "dal/game.proto",
+		Pkg:  "dal",
+		AutoSidecar: []*dalv1.AutoSidecarOptions{
+			{Target: dalv1.SidecarTarget_GORM, PackageInclude: []string{"game.v1"}},
+		},
+		Messages: []testutil.TestMessage{
+			// Declared sidecars override the synthesized ones
+			{Name: "GameGorm", GormOpts: &dalv1.GormOptions{Source: "game.v1.Game", Table: "games"}},
+		},
+	})
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{Files: files})
+
+	messages, err := CollectMessages(plugin, TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	want := map[string]string{
+		"game.v1.Game":    "GameGorm",
+		"game.v1.Player":  "PlayerGorm",
+		"game.v1.Team":    "TeamGorm",
+		"common.v1.Color": "ColorGorm",
+	}
+	if got := sourceNames(messages); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected sidecars %v, got %v", want, got)
+	}
+
+	for _, msg := range messages {
+		if msg.TargetMessage.Desc.ParentFile().Path() != "dal/game.proto" {
+			t.Errorf("Expected %s to be in dal/game.proto, got %s", msg.TargetMessage.Desc.Name(), msg.TargetMessage.Desc.ParentFile().Path())
+		}
+		if msg.TargetMessage.GoIdent.GoImportPath != "github.com/test/gen/go/dal" {
+			t.Errorf("Expected %s in the sidecar's Go package, got %s", msg.TargetMessage.Desc.Name(), msg.TargetMessage.GoIdent.GoImportPath)
+		}
+		if msg.SourceName == "game.v1.Game" && (msg.TableName != "games" || !msg.GenerateDAL) {
+			t.Errorf("Expected the declared GameGorm options to be kept, got table %q", msg.TableName)
+		}
+		if msg.SourceName == "game.v1.Player" && (msg.TableName != "" || msg.GenerateDAL) {
+			t.Errorf("Expected synthesized PlayerGorm to have no table or DAL")
+		}
+	}
+
+	// Other targets are unaffected
+	dsMessages, err := CollectMessages(plugin, TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	if len(dsMessages) != 0 {
+		t.Errorf("Expected no Datastore messages, got %v", sourceNames(dsMessages))
+	}
+}
+
+// TestAutoSidecar_ReferencedOnly verifies that without package_include only
+// the types referenced by the declared sidecars are synthesized, and that
+// fields a sidecar overrides are not followed.
+func TestAutoSidecar_ReferencedOnly(t *testing.T) {
+	files := append(gameProtos(), testutil.TestFile{
+		Name: "dal/game.proto",
+		Pkg:  "dal",
+		AutoSidecar: []*dalv1.AutoSidecarOptions{
+			{Target: dalv1.SidecarTarget_DATASTORE, Suffix: "Entity", MessageExclude: []string{"common.v1.Color"}},
+		},
+		Messages: []testutil.TestMessage{
+			{
+				Name:          "GameEntity",
+				DatastoreOpts: &dalv1.DatastoreOptions{Source: "game.v1.Game", Kind: "Game"},
+				Fields: []testutil.TestField{
+					{Name: "teams", Number: 3, TypeName: "string", SkipField: true},
+				},
+			},
+		},
+	})
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{Files: files})
+
+	messages, err := CollectMessages(plugin, TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	want := map[string]string{
+		"game.v1.Game":   "GameEntity",
+		"game.v1.Player": "PlayerEntity",
+	}
+	if got := sourceNames(messages); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected sidecars %v, got %v", want, got)
+	}
+}
+
+// TestAutoSidecar_SkipDAL verifies that sources marked skip_dal are not
+// synthesized even when their package is included.
+func TestAutoSidecar_SkipDAL(t *testing.T) {
+	files := append(gameProtos(), testutil.TestFile{
+		Name: "dal/game.proto",
+		Pkg:  "dal",
+		AutoSidecar: []*dalv1.AutoSidecarOptions{
+			{Target: dalv1.SidecarTarget_GORM, PackageInclude: []string{"game.v1"}},
+		},
+	})
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{Files: files})
+
+	messages, err := CollectMessages(plugin, TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	if _, ok := sourceNames(messages)["game.v1.Internal"]; ok {
+		t.Errorf("Expected no sidecar for skip_dal message game.v1.Internal")
+	}
+}
+
+// TestAutoSidecar_NameCollision verifies that a synthesized name that is
+// already taken is reported against the existing message.
+func TestAutoSidecar_NameCollision(t *testing.T) {
+	files := append(gameProtos(), testutil.TestFile{
+		Name: "dal/game.proto",
+		Pkg:  "dal",
+		AutoSidecar: []*dalv1.AutoSidecarOptions{
+			{Target: dalv1.SidecarTarget_GORM, PackageInclude: []string{"common.v1"}},
+		},
+		Messages: []testutil.TestMessage{
+			// Not a sidecar of common.v1.Color, but has its synthesized name
+			{Name: "ColorGorm", Fields: []testutil.TestField{{Name: "rgb", Number: 1, TypeName: "string"}}},
+		},
+	})
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{Files: files})
+
+	messages, messageErrors := CollectMessagesWithErrors(plugin, TargetGorm)
+	if len(messageErrors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(messageErrors))
+	}
+	if !errors.Is(messageErrors[0].Err, ErrSidecarNameCollision) {
+		t.Errorf("Expected ErrSidecarNameCollision, got %v", messageErrors[0].Err)
+	}
+	if messageErrors[0].Message.Desc.FullName() != "dal.ColorGorm" {
+		t.Errorf("Expected the error on dal.ColorGorm, got %s", messageErrors[0].Message.Desc.FullName())
+	}
+
+	want := map[string]string{"common.v1.Unused": "UnusedGorm"}
+	if got := sourceNames(messages); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected sidecars %v, got %v", want, got)
+	}
+}
@@ -69,6 +69,24 @@ type MessageInfo struct {
 	// methods (Save/Load) for Datastore. Required for structs with map fields since
 	// Datastore doesn't natively support Go maps.
 	ImplementPropertyLoader bool
+
+	// ImplementKeyLoader indicates whether to generate a datastore.KeyLoader
+	// (LoadKey) that sets a loaded Datastore entity's Key and id field.
+	ImplementKeyLoader bool
+
+	// TenantColumn is the column holding the tenant ID (GORM tenant_column).
+	// When set, the generated DAL scopes every operation to the context's tenant.
+	TenantColumn string
+
+	// TenantNamespace indicates whether the generated Datastore DAL uses the
+	// context's tenant as the namespace of every key and query.
+	TenantNamespace bool
+
+	// Audit names the columns the generated DAL fills on writes (nil if none).
+	Audit *dalv1.AuditOptions
+
+	// Cache indicates whether to generate a read-through cached DAL wrapper.
+	Cache bool
 }
 
 // CollectMessages finds all messages for a target across all proto files.
@@ -91,8 +109,48 @@ type MessageInfo struct {
 //   - Slice of MessageInfo, one per DAL schema message found
 //   - Error if any messages reference missing source messages
 func CollectMessages(gen *protogen.Plugin, target Target) ([]*MessageInfo, error) {
+	collected, messageErrors := CollectMessagesWithErrors(gen, target)
+
+	// If we encountered any errors, fail
+	if len(messageErrors) > 0 {
+		errors := make([]string, 0, len(messageErrors))
+		for _, msgErr := range messageErrors {
+			errors = append(errors, msgErr.Err.Error())
+		}
+		return nil, fmt.Errorf("failed to collect messages:\n  - %s", strings.Join(errors, "\n  - "))
+	}
+
+	return collected, nil
+}
+
+// MessageError is a problem found while collecting a single message.
+type MessageError struct {
+	// Message is the annotated message that could not be collected
+	Message *protogen.Message
+
+	// Err describes the problem (e.g., the source message does not exist)
+	Err error
+}
+
+// CollectMessagesWithErrors finds all messages for a target like CollectMessages,
+// but reports broken messages individually instead of failing.
+//
+// Messages with errors are not included in the collected slice. This lets
+// tools such as the lint plugin report every problem in one pass.
+//
+// Sidecars synthesized for (dal.v1.auto_sidecar) files are appended after
+// the declared ones; see collectAutoSidecars.
+//
+// Parameters:
+//   - gen: The protogen plugin containing all proto files
+//   - target: Which datastore target to collect
+//
+// Returns:
+//   - Slice of MessageInfo for messages that were collected successfully
+//   - Slice of MessageError, one per message that could not be collected
+func CollectMessagesWithErrors(gen *protogen.Plugin, target Target) ([]*MessageInfo, []*MessageError) {
 	var collected []*MessageInfo
-	var errors []string
+	var messageErrors []*MessageError
 
 	// Build index of all messages for source lookup
 	// This allows us to resolve "source: library.v1.Book" references quickly
@@ -108,7 +166,7 @@ func CollectMessages(gen *protogen.Plugin, target Target) ([]*MessageInfo, error
 		for _, msg := range file.Messages {
 			info, err := extractMessageInfo(msg, target, messageIndex)
 			if err != nil {
-				errors = append(errors, err.Error())
+				messageErrors = append(messageErrors, &MessageError{Message: msg, Err: err})
 			}
 			if info != nil {
 				collected = append(collected, info)
@@ -116,12 +174,12 @@ func CollectMessages(gen *protogen.Plugin, target Target) ([]*MessageInfo, error
 		}
 	}
 
-	// If we encountered any errors, fail
-	if len(errors) > 0 {
-		return nil, fmt.Errorf("failed to collect messages:\n  - %s", strings.Join(errors, "\n  - "))
-	}
+	// Synthesize sidecars for files with (dal.v1.auto_sidecar)
+	synthesized, autoErrors := collectAutoSidecars(gen, target, messageIndex, collected)
+	collected = append(collected, synthesized...)
+	messageErrors = append(messageErrors, autoErrors...)
 
-	return collected, nil
+	return collected, messageErrors
 }
 
 // buildMessageIndex creates a map of fully qualified message names to messages.
@@ -139,18 +197,28 @@ func CollectMessages(gen *protogen.Plugin, target Target) ([]*MessageInfo, error
 // The index maps "library.v1.Book" -> *protogen.Message for Book
 //
 // Note: We index ALL messages (not just those being generated) because
-// source messages might be in imported files.
+// source messages might be in imported files. Nested messages are indexed
+// too (e.g., "library.v1.Book.Edition"); map entries are not.
 func buildMessageIndex(gen *protogen.Plugin) map[string]*protogen.Message {
 	index := make(map[string]*protogen.Message)
 	for _, file := range gen.Files {
-		for _, msg := range file.Messages {
-			fqn := string(msg.Desc.FullName())
-			index[fqn] = msg
-		}
+		indexMessages(file.Messages, index)
 	}
 	return index
 }
 
+// indexMessages adds messages and their nested messages to the index.
+func indexMessages(messages []*protogen.Message, index map[string]*protogen.Message) {
+	for _, msg := range messages {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		fqn := string(msg.Desc.FullName())
+		index[fqn] = msg
+		indexMessages(msg.Messages, index)
+	}
+}
+
 // extractMessageInfo extracts message info for a specific target.
 //
 // This function checks if a message has an annotation for the requested target.
@@ -243,6 +311,9 @@ func extractGormInfo(msg *protogen.Message, opts proto.Message, index map[string
 		SchemaName:       "", // GORM doesn't use schema
 		ImplementScanner: gormOpts.ImplementScanner,
 		GenerateDAL:      generateDAL,
+		TenantColumn:     gormOpts.TenantColumn,
+		Audit:            gormOpts.Audit,
+		Cache:            gormOpts.Cache,
 	}, nil
 }
 
@@ -409,6 +480,10 @@ func extractDatastoreInfo(msg *protogen.Message, opts proto.Message, index map[s
 				SchemaName:              dsOpts.Namespace, // SchemaName repurposed for "namespace"
 				GenerateDAL:             generateDAL,
 				ImplementPropertyLoader: dsOpts.ImplementPropertyLoader,
+				ImplementKeyLoader:      dsOpts.ImplementKeyLoader,
+				TenantNamespace:         dsOpts.TenantNamespace,
+				Audit:                   dsOpts.Audit,
+				Cache:                   dsOpts.Cache,
 			}, nil
 		}
 	}
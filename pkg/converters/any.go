@@ -15,6 +15,8 @@
 package converters
 
 import (
+	"context"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -119,3 +121,15 @@ func AnyBytesToMessageConverter[T proto.Message](dest T, src *[]byte, decorator
 	}
 	return msg, nil
 }
+
+// MessageToAnyBytesConverterWithOptions is MessageToAnyBytesConverter with the
+// signature of generated ...WithOptions converters.
+func MessageToAnyBytesConverterWithOptions(ctx context.Context, src proto.Message, dest *[]byte, opts ...ConvertOption) (*[]byte, error) {
+	return MessageToAnyBytesConverter(src, dest, nil)
+}
+
+// AnyBytesToMessageConverterWithOptions is AnyBytesToMessageConverter with the
+// signature of generated ...WithOptions converters.
+func AnyBytesToMessageConverterWithOptions[T proto.Message](ctx context.Context, dest T, src *[]byte, opts ...ConvertOption) (T, error) {
+	return AnyBytesToMessageConverter[T](dest, src, nil)
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import "fmt"
+
+// MapSlice converts each element of src with convert, for the generated batch
+// converters (e.g. UserSliceToUserGORMSlice). The result is preallocated to len(src)
+// and keeps positions: nil elements stay nil without calling convert.
+// Returns nil if src is nil.
+// Returns the first error, with the index of the element that failed (see
+// elementError).
+func MapSlice[S, D any](src []*S, convert func(*S) (*D, error)) ([]*D, error) {
+	if src == nil {
+		return nil, nil
+	}
+	out := make([]*D, len(src))
+	for i, item := range src {
+		if item == nil {
+			continue
+		}
+		converted, err := convert(item)
+		if err != nil {
+			return nil, elementError(err, "element", i)
+		}
+		out[i] = converted
+	}
+	return out, nil
+}
+
+// MapValues converts each value of src with convert, keeping keys. Nil values
+// stay nil without calling convert.
+// Returns nil if src is nil.
+// Returns the first error, with the key of the value that failed (see
+// elementError).
+func MapValues[K comparable, S, D any](src map[K]*S, convert func(*S) (*D, error)) (map[K]*D, error) {
+	if src == nil {
+		return nil, nil
+	}
+	out := make(map[K]*D, len(src))
+	for key, value := range src {
+		if value == nil {
+			out[key] = nil
+			continue
+		}
+		converted, err := convert(value)
+		if err != nil {
+			return nil, elementError(err, "value", key)
+		}
+		out[key] = converted
+	}
+	return out, nil
+}
+
+// elementError adds the index or key of a failed element to err. A
+// ConversionError from a generated converter gets it right after its root, so
+// its Path still names the failing field (e.g. "user[3].created_at"); other
+// errors, such as a decorator's, are prefixed with it.
+func elementError(err error, kind string, key any) error {
+	if nested, ok := err.(*ConversionError); ok {
+		out := *nested
+		out.Path = fmt.Sprintf("%s[%v]%s", nested.Path[:nested.rootLen], key, nested.Path[nested.rootLen:])
+		return &out
+	}
+	return fmt.Errorf("converting %s %v: %w", kind, key, err)
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func parseInt(s *string) (*int, error) {
+	n, err := strconv.Atoi(*s)
+	return &n, err
+}
+
+func TestMapSlice(t *testing.T) {
+	one, two, bad := "1", "2", "x"
+
+	if out, err := MapSlice[string, int](nil, parseInt); out != nil || err != nil {
+		t.Errorf("MapSlice(nil) = %v, %v; want nil, nil", out, err)
+	}
+
+	out, err := MapSlice([]*string{&one, nil, &two}, parseInt)
+	if err != nil {
+		t.Fatalf("MapSlice: %v", err)
+	}
+	if len(out) != 3 || *out[0] != 1 || out[1] != nil || *out[2] != 2 {
+		t.Errorf("MapSlice = %v, want [1 nil 2]", out)
+	}
+
+	_, err = MapSlice([]*string{&one, &bad}, parseInt)
+	var numErr *strconv.NumError
+	if err == nil || !errors.As(err, &numErr) || err.Error() != `converting element 1: strconv.Atoi: parsing "x": invalid syntax` {
+		t.Errorf("MapSlice error = %v", err)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	one, bad := "1", "x"
+
+	out, err := MapValues(map[string]*string{"a": &one, "b": nil}, parseInt)
+	if err != nil {
+		t.Fatalf("MapValues: %v", err)
+	}
+	if len(out) != 2 || *out["a"] != 1 || out["b"] != nil {
+		t.Errorf("MapValues = %v, want a:1 b:nil", out)
+	}
+
+	if _, err := MapValues(map[string]*string{"c": &bad}, parseInt); err == nil || err.Error() != `converting value c: strconv.Atoi: parsing "x": invalid syntax` {
+		t.Errorf("MapValues error = %v", err)
+	}
+}
+
+// TestMapSlice_ConversionErrorPath verifies that the index or key of the
+// failed element is added to a ConversionError's path after its root.
+func TestMapSlice_ConversionErrorPath(t *testing.T) {
+	conv := Conversion{Message: "user", SourceType: "string", TargetType: "int"}
+	convert := func(s *string) (*int, error) {
+		n, err := parseInt(s)
+		return n, conv.FieldError(err, "age")
+	}
+	one, bad := "1", "x"
+
+	_, err := MapSlice([]*string{&one, &one, &one, &bad}, convert)
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Path != "user[3].age" {
+		t.Fatalf("MapSlice error = %v, want path user[3].age", err)
+	}
+
+	// The index stays when the batch is itself a field of an outer message
+	outer := Conversion{Message: "team"}.FieldError(err, "members")
+	if !errors.As(outer, &convErr) || convErr.Path != "team.members[3].age" {
+		t.Errorf("Nested error = %v, want path team.members[3].age", outer)
+	}
+
+	_, err = MapValues(map[string]*string{"bob": &bad}, convert)
+	if !errors.As(err, &convErr) || convErr.Path != "user[bob].age" {
+		t.Errorf("MapValues error = %v, want path user[bob].age", err)
+	}
+}
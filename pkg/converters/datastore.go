@@ -25,3 +25,14 @@ func MustParseUint(s string) uint64 {
 	}
 	return val
 }
+
+// ParseUint32 parses a string to uint32, returning an error for invalid or
+// out of range values. This is used for IDs stored as strings in repeated and
+// map fields, where the error is reported with the element's index or key.
+func ParseUint32(s string) (uint32, error) {
+	val, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(val), nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import "fmt"
+
+// Direction is the direction of a generated conversion.
+type Direction int
+
+const (
+	// ToTarget converts an API message to a storage entity
+	ToTarget Direction = iota
+	// FromTarget converts a storage entity back to an API message
+	FromTarget
+)
+
+// String returns "to_target" or "from_target".
+func (d Direction) String() string {
+	if d == FromTarget {
+		return "from_target"
+	}
+	return "to_target"
+}
+
+// ConversionError is returned by generated converters when converting a field
+// fails. Errors from nested message converters are extended rather than
+// wrapped again, so Path always runs from the outermost message to the field
+// that failed, in API (proto) field names.
+//
+// Use errors.As to get it, e.g. to turn conversion failures into field
+// violations:
+//
+//	var convErr *converters.ConversionError
+//	if errors.As(err, &convErr) {
+//	    violations = append(violations, convErr.Path)
+//	}
+type ConversionError struct {
+	// Path is the field path, e.g. "library.contributors[3].address.zip"
+	Path string
+
+	// Direction of the conversion
+	Direction Direction
+
+	// SourceType and TargetType are the types of the innermost conversion
+	// that failed (e.g., "api.Address" and "AddressGORM")
+	SourceType string
+	TargetType string
+
+	// Err is the underlying error
+	Err error
+
+	// rootLen is the length of the outermost message name in Path
+	rootLen int
+}
+
+// Error returns the path, types and underlying error.
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("converting %s (%s to %s): %v", e.Path, e.SourceType, e.TargetType, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Conversion describes a generated converter for the ConversionErrors it
+// returns. Generated converter files declare one per converter.
+type Conversion struct {
+	Direction Direction
+
+	// Message is the path root: the proto name of the API message in
+	// snake_case (e.g., "library")
+	Message string
+
+	// SourceType and TargetType are the converted Go types
+	SourceType string
+	TargetType string
+}
+
+// FieldError returns the ConversionError for err from converting field, a
+// proto field name.
+func (c Conversion) FieldError(err error, field string) error {
+	return c.wrap(err, field)
+}
+
+// ElementError returns the ConversionError for err from converting the
+// element of a repeated field at index, or the value of a map field at key.
+func (c Conversion) ElementError(err error, field string, key any) error {
+	return c.wrap(err, fmt.Sprintf("%s[%v]", field, key))
+}
+
+// wrap prefixes the path of a nested ConversionError with segment, or starts a
+// new one.
+func (c Conversion) wrap(err error, segment string) error {
+	if err == nil {
+		return nil
+	}
+	prefix := c.Message + "." + segment
+	if nested, ok := err.(*ConversionError); ok {
+		out := *nested
+		out.Path = prefix + nested.Path[nested.rootLen:]
+		out.rootLen = len(c.Message)
+		return &out
+	}
+	return &ConversionError{
+		Path:       prefix,
+		Direction:  c.Direction,
+		SourceType: c.SourceType,
+		TargetType: c.TargetType,
+		Err:        err,
+		rootLen:    len(c.Message),
+	}
+}
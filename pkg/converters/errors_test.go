@@ -0,0 +1,71 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestConversionError_NestedPath(t *testing.T) {
+	errZip := errors.New("invalid zip")
+	address := Conversion{Direction: ToTarget, Message: "address", SourceType: "api.Address", TargetType: "AddressGORM"}
+	author := Conversion{Direction: ToTarget, Message: "author", SourceType: "api.Author", TargetType: "AuthorGORM"}
+	library := Conversion{Direction: ToTarget, Message: "library", SourceType: "api.Library", TargetType: "LibraryGORM"}
+
+	// Each converter extends the error of the one it called
+	err := address.FieldError(errZip, "zip")
+	err = author.FieldError(err, "address")
+	err = library.ElementError(err, "contributors", 3)
+
+	// Wrapping by application code does not hide it from errors.As
+	wrapped := fmt.Errorf("saving library: %w", err)
+
+	var convErr *ConversionError
+	if !errors.As(wrapped, &convErr) {
+		t.Fatalf("errors.As did not find a ConversionError in %v", wrapped)
+	}
+	if convErr.Path != "library.contributors[3].address.zip" {
+		t.Errorf("Path = %q, want %q", convErr.Path, "library.contributors[3].address.zip")
+	}
+	if convErr.Direction != ToTarget || convErr.SourceType != "api.Address" || convErr.TargetType != "AddressGORM" {
+		t.Errorf("got %s %s -> %s, want the innermost conversion", convErr.Direction, convErr.SourceType, convErr.TargetType)
+	}
+	if !errors.Is(wrapped, errZip) {
+		t.Error("errors.Is did not find the underlying error")
+	}
+	want := "converting library.contributors[3].address.zip (api.Address to AddressGORM): invalid zip"
+	if convErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", convErr.Error(), want)
+	}
+}
+
+func TestConversionError_MapKeysAndDirection(t *testing.T) {
+	org := Conversion{Direction: FromTarget, Message: "organization", SourceType: "OrganizationGORM", TargetType: "api.Organization"}
+
+	err := org.ElementError(ErrUnmappedField, "departments", "sales")
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("expected a ConversionError, got %v", err)
+	}
+	if convErr.Path != "organization.departments[sales]" || convErr.Direction != FromTarget {
+		t.Errorf("got %q (%s), want organization.departments[sales] (from_target)", convErr.Path, convErr.Direction)
+	}
+
+	if org.FieldError(nil, "name") != nil {
+		t.Error("FieldError(nil) should be nil")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MessageToBytes marshals a proto message for a PROTO_BINARY column.
+// Returns nil if the message is nil, and empty (non-nil) bytes for an empty
+// message, so the two stay distinguishable.
+// Returns error if marshaling fails.
+func MessageToBytes(msg proto.Message) ([]byte, error) {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+	data, err := proto.Marshal(msg)
+	if data == nil && err == nil {
+		data = []byte{}
+	}
+	return data, err
+}
+
+// BytesToMessage unmarshals a PROTO_BINARY column back to a typed message.
+// Returns nil if data is nil.
+// Returns error if unmarshaling fails.
+// Usage: converters.BytesToMessage[*api.Settings](data)
+func BytesToMessage[T proto.Message](data []byte) (T, error) {
+	var msg T
+	if data == nil {
+		return msg, nil
+	}
+	msg = msg.ProtoReflect().Type().New().Interface().(T)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		var zero T
+		return zero, err
+	}
+	return msg, nil
+}
+
+// MessageToJSON marshals a proto message for a PROTOJSON column.
+// Returns "" if the message is nil; an empty message is "{}".
+// Returns error if marshaling fails.
+func MessageToJSON(msg proto.Message) (string, error) {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return "", nil
+	}
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// JSONToMessage unmarshals a PROTOJSON column back to a typed message.
+// Returns nil if data is "".
+// Returns error if unmarshaling fails.
+// Usage: converters.JSONToMessage[*api.Settings](data)
+func JSONToMessage[T proto.Message](data string) (T, error) {
+	var msg T
+	if data == "" {
+		return msg, nil
+	}
+	msg = msg.ProtoReflect().Type().New().Interface().(T)
+	if err := protojson.Unmarshal([]byte(data), msg); err != nil {
+		var zero T
+		return zero, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrUnmappedField is returned in strict mode when a source field without a
+// generated conversion is set and no type converter handles it.
+var ErrUnmappedField = errors.New("converters: field has no conversion")
+
+// ErrMaxDepthExceeded is returned when nested conversions go deeper than the
+// configured maximum depth.
+var ErrMaxDepthExceeded = errors.New("converters: maximum nesting depth exceeded")
+
+// ErrNoEncryption is returned by the encryption helpers when the conversion
+// has no encryption provider.
+var ErrNoEncryption = errors.New("converters: no encryption provider")
+
+// EncryptionProvider encrypts and decrypts field values for converters.
+type EncryptionProvider interface {
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// TypeConverterFunc converts a value for a field without a generated
+// conversion. The result must have the destination field's type.
+type TypeConverterFunc func(ctx context.Context, src any) (any, error)
+
+// TypeConverterLookup returns the converter from src to dest values, or nil if
+// it has none for the pair.
+type TypeConverterLookup func(src, dest reflect.Type) TypeConverterFunc
+
+// ConvertOptions are the options of a conversion, passed to the generated
+// ...WithOptions converters and carried in their context into nested message
+// converters.
+type ConvertOptions struct {
+	// Clock returns the current time (time.Now if nil)
+	Clock func() time.Time
+
+	// Strict makes conversions fail with ErrUnmappedField when a field
+	// without a generated conversion is set and no type converter handles it
+	Strict bool
+
+	// Encryption is used by EncryptBytes/DecryptBytes and the string variants
+	Encryption EncryptionProvider
+
+	// TypeConverters converts fields without a generated conversion
+	TypeConverters TypeConverterLookup
+
+	// MaxDepth limits how deeply converters may nest (0 means unlimited)
+	MaxDepth int
+
+	// depth is the nesting depth of the current conversion
+	depth int
+}
+
+// ConvertOption configures a conversion.
+type ConvertOption func(*ConvertOptions)
+
+// WithClock sets the clock of the conversion.
+func WithClock(clock func() time.Time) ConvertOption {
+	return func(o *ConvertOptions) { o.Clock = clock }
+}
+
+// WithStrict enables or disables strict mode.
+func WithStrict(strict bool) ConvertOption {
+	return func(o *ConvertOptions) { o.Strict = strict }
+}
+
+// WithEncryption sets the encryption provider of the conversion.
+func WithEncryption(provider EncryptionProvider) ConvertOption {
+	return func(o *ConvertOptions) { o.Encryption = provider }
+}
+
+// WithTypeConverters sets the lookup for converting fields without a
+// generated conversion.
+func WithTypeConverters(lookup TypeConverterLookup) ConvertOption {
+	return func(o *ConvertOptions) { o.TypeConverters = lookup }
+}
+
+// WithMaxDepth limits how deeply converters may nest; the top-level converter
+// is depth 1.
+func WithMaxDepth(depth int) ConvertOption {
+	return func(o *ConvertOptions) { o.MaxDepth = depth }
+}
+
+// optionsKey is the context key for the options of the current conversion.
+type optionsKey struct{}
+
+// OptionsFromContext returns the options of the conversion ctx belongs to
+// (the zero options outside of one).
+func OptionsFromContext(ctx context.Context) ConvertOptions {
+	if o, ok := ctx.Value(optionsKey{}).(*ConvertOptions); ok {
+		return *o
+	}
+	return ConvertOptions{}
+}
+
+// EnterConversion is called by generated ...WithOptions converters on entry.
+// It applies opts on top of the options already in ctx, counts the nesting
+// depth, and returns the context to pass to nested converters.
+// Returns ErrMaxDepthExceeded if the conversion is nested too deeply.
+func EnterConversion(ctx context.Context, opts ...ConvertOption) (context.Context, error) {
+	o := OptionsFromContext(ctx)
+	if len(opts) == 0 && o.MaxDepth == 0 {
+		// Nothing to change or count: nested converters share ctx
+		return ctx, nil
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.depth++
+	if o.MaxDepth > 0 && o.depth > o.MaxDepth {
+		return ctx, fmt.Errorf("%w (%d)", ErrMaxDepthExceeded, o.MaxDepth)
+	}
+	return context.WithValue(ctx, optionsKey{}, &o), nil
+}
+
+// Now returns the current time using the conversion's clock.
+func Now(ctx context.Context) time.Time {
+	if clock := OptionsFromContext(ctx).Clock; clock != nil {
+		return clock()
+	}
+	return time.Now()
+}
+
+// ConvertUnmapped is called by generated ...WithOptions converters for fields
+// they have no conversion for. A type converter from the options sets dest;
+// otherwise dest is left as is, and in strict mode a non-zero src fails with
+// ErrUnmappedField.
+func ConvertUnmapped[S, D any](ctx context.Context, src S, dest *D) error {
+	o := OptionsFromContext(ctx)
+	if o.TypeConverters != nil {
+		srcType, destType := reflect.TypeOf((*S)(nil)).Elem(), reflect.TypeOf((*D)(nil)).Elem()
+		if convert := o.TypeConverters(srcType, destType); convert != nil {
+			value, err := convert(ctx, src)
+			if err != nil {
+				return err
+			}
+			converted, ok := value.(D)
+			if !ok {
+				return fmt.Errorf("converters: type converter from %s returned %T, want %s", srcType, value, destType)
+			}
+			*dest = converted
+			return nil
+		}
+	}
+	if o.Strict && !reflect.ValueOf(&src).Elem().IsZero() {
+		return ErrUnmappedField
+	}
+	return nil
+}
+
+// EncryptBytes encrypts plaintext with the conversion's encryption provider.
+// Its signature suits to_func with signature CONTEXT_RETURNS_ERROR.
+// Returns ErrNoEncryption if the conversion has no provider.
+func EncryptBytes(ctx context.Context, plaintext []byte) ([]byte, error) {
+	provider := OptionsFromContext(ctx).Encryption
+	if provider == nil {
+		return nil, ErrNoEncryption
+	}
+	if plaintext == nil {
+		return nil, nil
+	}
+	return provider.Encrypt(ctx, plaintext)
+}
+
+// DecryptBytes decrypts ciphertext with the conversion's encryption provider.
+// Returns ErrNoEncryption if the conversion has no provider.
+func DecryptBytes(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	provider := OptionsFromContext(ctx).Encryption
+	if provider == nil {
+		return nil, ErrNoEncryption
+	}
+	if ciphertext == nil {
+		return nil, nil
+	}
+	return provider.Decrypt(ctx, ciphertext)
+}
+
+// EncryptString encrypts a string field into a bytes column.
+// Returns nil for "", so empty values stay empty.
+func EncryptString(ctx context.Context, plaintext string) ([]byte, error) {
+	if plaintext == "" {
+		return EncryptBytes(ctx, nil)
+	}
+	return EncryptBytes(ctx, []byte(plaintext))
+}
+
+// DecryptString decrypts a bytes column written by EncryptString.
+func DecryptString(ctx context.Context, ciphertext []byte) (string, error) {
+	if len(ciphertext) == 0 {
+		ciphertext = nil
+	}
+	plaintext, err := DecryptBytes(ctx, ciphertext)
+	return string(plaintext), err
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converters
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestEnterConversion(t *testing.T) {
+	ctx := context.Background()
+
+	// Without options or a depth limit, ctx is passed through unchanged
+	if got, err := EnterConversion(ctx); err != nil || got != ctx {
+		t.Fatalf("EnterConversion() = %v, %v; want ctx unchanged", got, err)
+	}
+
+	// Options apply on top of those inherited from the parent conversion
+	clock := func() time.Time { return time.Unix(100, 0) }
+	outer, err := EnterConversion(ctx, WithClock(clock), WithMaxDepth(2))
+	if err != nil {
+		t.Fatalf("outer: %v", err)
+	}
+	inner, err := EnterConversion(outer, WithStrict(true))
+	if err != nil {
+		t.Fatalf("inner: %v", err)
+	}
+	opts := OptionsFromContext(inner)
+	if !opts.Strict || opts.MaxDepth != 2 || !Now(inner).Equal(time.Unix(100, 0)) {
+		t.Errorf("inner options = %+v, want strict, max depth 2 and the outer clock", opts)
+	}
+	if OptionsFromContext(outer).Strict {
+		t.Error("inner options leaked into the outer conversion")
+	}
+
+	if _, err := EnterConversion(inner); !errors.Is(err, ErrMaxDepthExceeded) {
+		t.Errorf("third level: err = %v, want ErrMaxDepthExceeded", err)
+	}
+}
+
+func TestConvertUnmapped(t *testing.T) {
+	ctx := context.Background()
+
+	// Not strict: dest is left as is
+	dest := int64(7)
+	if err := ConvertUnmapped(ctx, "42", &dest); err != nil || dest != 7 {
+		t.Errorf("default: dest = %d, err = %v; want 7, nil", dest, err)
+	}
+
+	// Strict: set fields fail, zero fields pass
+	strict, _ := EnterConversion(ctx, WithStrict(true))
+	if err := ConvertUnmapped(strict, "42", &dest); !errors.Is(err, ErrUnmappedField) {
+		t.Errorf("strict: err = %v, want ErrUnmappedField", err)
+	}
+	if err := ConvertUnmapped(strict, "", &dest); err != nil {
+		t.Errorf("strict with zero value: err = %v", err)
+	}
+
+	// Type converters handle the pair before strict mode applies
+	lookup := func(src, dest reflect.Type) TypeConverterFunc {
+		if src.Kind() != reflect.String || dest.Kind() != reflect.Int64 {
+			return nil
+		}
+		return func(ctx context.Context, src any) (any, error) {
+			return strconv.ParseInt(src.(string), 10, 64)
+		}
+	}
+	converting, _ := EnterConversion(strict, WithTypeConverters(lookup))
+	if err := ConvertUnmapped(converting, "42", &dest); err != nil || dest != 42 {
+		t.Errorf("type converter: dest = %d, err = %v; want 42, nil", dest, err)
+	}
+	if err := ConvertUnmapped(converting, "x", &dest); err == nil {
+		t.Error("type converter error was not returned")
+	}
+	var wrongType int32
+	if err := ConvertUnmapped(converting, "42", &wrongType); !errors.Is(err, ErrUnmappedField) {
+		t.Errorf("unhandled pair: err = %v, want ErrUnmappedField", err)
+	}
+}
+
+// xorEncryption is a reversible stand-in for a real encryption provider.
+type xorEncryption struct{}
+
+func (xorEncryption) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	out := make([]byte, len(plaintext))
+	for i, b := range plaintext {
+		out[i] = b ^ 0x5a
+	}
+	return out, nil
+}
+
+func (x xorEncryption) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	return x.Encrypt(ctx, ciphertext)
+}
+
+func TestEncryptionHelpers(t *testing.T) {
+	if _, err := EncryptString(context.Background(), "secret"); !errors.Is(err, ErrNoEncryption) {
+		t.Errorf("no provider: err = %v, want ErrNoEncryption", err)
+	}
+
+	ctx, _ := EnterConversion(context.Background(), WithEncryption(xorEncryption{}))
+	ciphertext, err := EncryptString(ctx, "secret")
+	if err != nil || bytes.Equal(ciphertext, []byte("secret")) {
+		t.Fatalf("EncryptString = %q, %v", ciphertext, err)
+	}
+	plaintext, err := DecryptString(ctx, ciphertext)
+	if err != nil || plaintext != "secret" {
+		t.Errorf("DecryptString = %q, %v; want \"secret\"", plaintext, err)
+	}
+
+	// Empty values stay empty
+	if ciphertext, err := EncryptString(ctx, ""); err != nil || ciphertext != nil {
+		t.Errorf("EncryptString(\"\") = %q, %v; want nil", ciphertext, err)
+	}
+}
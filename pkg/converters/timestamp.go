@@ -55,3 +55,13 @@ func Int64ToTimestamp(seconds int64) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(time.Unix(seconds, 0))
 }
+
+// TruncateTimestampToSeconds returns the Timestamp that survives a
+// TimestampToInt64 → Int64ToTimestamp round trip: nanoseconds are dropped
+// and the Unix epoch becomes nil.
+func TruncateTimestampToSeconds(ts *timestamppb.Timestamp) *timestamppb.Timestamp {
+	if ts.GetSeconds() == 0 {
+		return nil
+	}
+	return &timestamppb.Timestamp{Seconds: ts.GetSeconds()}
+}
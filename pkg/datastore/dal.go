@@ -16,6 +16,7 @@ package datastore
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -39,7 +40,39 @@ type DALData struct {
 	DALTypeName string // e.g., "UserDatastoreDAL"
 	HasIDField  bool   // Whether the struct has an "id" field for convenience methods
 	IDFieldType string // Type of the ID field (usually "string")
-	HasStringID bool   // Whether the struct has a string Id field (for key derivation in Put)
+	HasStringID bool   // Whether the struct has a string Id field (keys by name, NameKey)
+	HasIntID    bool   // Whether the struct has an integer Id field (numeric keys, IDKey)
+	IDType      string // Named id_type of the ID field (empty if untyped)
+
+	// ID strategy of the ID field (id_strategy): generated IDs are filled by
+	// Put before deriving the key; empty integer IDs are allocated by
+	// Datastore and read back from the key
+	IDStrategy     *common.IDStrategy
+	HasGeneratedID bool
+
+	// Namespace is the namespace option, used when the DAL's Namespace is empty.
+	Namespace string
+
+	// TenantNamespace scopes every operation to the namespace of the context's tenant.
+	TenantNamespace bool
+
+	// Audit names the properties filled on Put (nil if not audited).
+	Audit *common.AuditFields
+
+	// IterateAPI support: the API message type (e.g., "api.Note"; empty
+	// without a source), the converter from the entity, and its package import.
+	SourceType    string
+	FromConverter string
+	SourceImport  common.ImportSpec
+
+	// FilterFields are the API fields allowed in AIP-160 filters, with their properties.
+	FilterFields []common.FilterField
+
+	// Read-through cache wrapper (cache option): its type name (e.g.,
+	// "UserDatastoreCachedDAL"; empty if not cached) and the converter from
+	// the API message to the entity.
+	CachedDALTypeName string
+	ToConverter       string
 }
 
 // DALTemplateData is the root template data for DAL file generation.
@@ -51,6 +84,12 @@ type DALTemplateData struct {
 	DatastoreLib  string // Datastore library reference (e.g., "dslib" or "datastore")
 }
 
+// GenerateDALHelpers generates DAL helper methods with the built-in templates
+// (see Generator.GenerateDALHelpers).
+func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
+	return builtinGenerator.GenerateDALHelpers(messages, options)
+}
+
 // GenerateDALHelpers generates DAL helper methods for Datastore messages.
 //
 // This generates Put, Get, Delete, GetMulti, PutMulti, DeleteMulti, Query, and Count
@@ -63,7 +102,7 @@ type DALTemplateData struct {
 // Returns:
 //   - GenerateResult containing DAL helper files
 //   - error if generation fails
-func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
+func (g *Generator) GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -110,7 +149,7 @@ func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions)
 			entityPkgInfo.Alias = common.GetPackageAlias(importPath)
 		}
 
-		content, err := generateDALFileCodeWithOptions(msgs, entityPkgInfo, options)
+		content, err := g.generateDALFileCodeWithOptions(msgs, entityPkgInfo, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate DAL helpers for %s: %w", protoFile, err)
 		}
@@ -172,7 +211,7 @@ func extractProtoDir(protoFile string) string {
 }
 
 // generateDALFileCodeWithOptions generates the DAL helper code for messages.
-func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkgInfo common.PackageInfo, options *DALOptions) (string, error) {
+func (g *Generator) generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkgInfo common.PackageInfo, options *DALOptions) (string, error) {
 	if len(messages) == 0 {
 		return "", nil
 	}
@@ -182,9 +221,18 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 
 	// Build DAL data for each message
 	var dals []DALData
+	hasTenant := false
+	hasAudit := false
+	hasCache := false
 	for _, msg := range messages {
-		dalData := buildDALData(msg)
+		dalData, err := buildDALData(msg)
+		if err != nil {
+			return "", err
+		}
 		dals = append(dals, dalData)
+		hasTenant = hasTenant || dalData.TenantNamespace
+		hasAudit = hasAudit || dalData.Audit != nil
+		hasCache = hasCache || dalData.CachedDALTypeName != ""
 	}
 
 	if len(dals) == 0 {
@@ -199,6 +247,7 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 
 	// Always add standard imports
 	imports.Add(common.ImportSpec{Path: "context"})
+	imports.Add(common.ImportSpec{Path: "google.golang.org/api/iterator"})
 
 	if options.OutputDir != "" {
 		// When OutputDir is specified, use subdirectory name as package
@@ -218,6 +267,40 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 		// No OutputDir - entity package is in the same package, no alias needed for dslib
 		imports.Add(common.ImportSpec{Path: "cloud.google.com/go/datastore"})
 	}
+	if hasTenant {
+		imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/tenant"})
+	}
+	if hasAudit {
+		imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/audit"})
+	}
+	if hasCache {
+		imports.Add(common.ImportSpec{Path: "time"})
+		imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/cache"})
+	}
+	for _, dal := range dals {
+		if dal.HasGeneratedID {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/idgen"})
+			break
+		}
+	}
+	for _, dal := range dals {
+		if dal.SourceType != "" {
+			imports.Add(dal.SourceImport)
+		}
+		if len(dal.FilterFields) > 0 {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/filtering"})
+		}
+	}
+
+	// Typed IDs are declared next to the entities
+	for i := range dals {
+		if dals[i].IDType != "" {
+			dals[i].IDFieldType = entityPrefix + dals[i].IDType
+			if dals[i].IDStrategy != nil {
+				dals[i].IDStrategy.Convert = dals[i].IDFieldType
+			}
+		}
+	}
 
 	// Build template data
 	data := DALTemplateData{
@@ -229,23 +312,59 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 	}
 
 	// Render the DAL template
-	return renderTemplate("dal.go.tmpl", data)
+	return g.renderTemplate("dal.go.tmpl", data)
 }
 
 // buildDALData builds the template data for a single message's DAL helper.
-func buildDALData(msg *collector.MessageInfo) DALData {
+func buildDALData(msg *collector.MessageInfo) (DALData, error) {
 	structName := buildStructName(msg.TargetMessage)
 	dalTypeName := structName + "DAL"
 
 	// Check for ID field
 	hasIDField := false
 	idFieldType := "string"
+	idType := ""
+	var idStrategy *common.IDStrategy
 	for _, field := range msg.TargetMessage.Fields {
-		if strings.ToLower(string(field.Desc.Name())) == "id" {
+		strategy, err := resolveIDStrategy(field, structName)
+		if err != nil {
+			return DALData{}, err
+		}
+		if !hasIDField && isKeyIDField(field) {
 			hasIDField = true
 			idFieldType = getGoType(field)
-			break
+			idType = common.GetIDType(field)
+			idStrategy = strategy
+		}
+	}
+
+	mergedFields, err := common.MergeSourceFields(msg.SourceMessage, msg.TargetMessage)
+	if err != nil {
+		return DALData{}, fmt.Errorf("failed to merge fields for %s: %w", structName, err)
+	}
+	audit, err := common.ResolveAuditFields(msg.Audit, mergedFields, structName)
+	if err != nil {
+		return DALData{}, err
+	}
+
+	var sourceType, fromConverter, toConverter string
+	var sourceImport common.ImportSpec
+	if msg.SourceMessage != nil {
+		sourceName := string(msg.SourceMessage.Desc.Name())
+		pkgInfo := common.ExtractPackageInfo(msg.SourceMessage)
+		sourceType = pkgInfo.Alias + "." + sourceName
+		fromConverter = sourceName + "From" + structName
+		toConverter = sourceName + "To" + structName
+		sourceImport = common.ImportSpec{Alias: pkgInfo.Alias, Path: pkgInfo.ImportPath}
+	}
+
+	// Cached DALs store entities as serialized source messages
+	cachedDALTypeName := ""
+	if msg.Cache {
+		if msg.SourceMessage == nil {
+			return DALData{}, fmt.Errorf("cache option of %s requires a source message", structName)
 		}
+		cachedDALTypeName = structName + "CachedDAL"
 	}
 
 	return DALData{
@@ -254,7 +373,38 @@ func buildDALData(msg *collector.MessageInfo) DALData {
 		HasIDField:  hasIDField,
 		IDFieldType: idFieldType,
 		HasStringID: hasIDField && idFieldType == "string",
+		HasIntID:    hasIDField && idFieldType != "string",
+		IDType:      idType,
+
+		IDStrategy:     idStrategy,
+		HasGeneratedID: idStrategy != nil && idStrategy.Generated != "",
+
+		Namespace: msg.SchemaName,
+
+		TenantNamespace: msg.TenantNamespace,
+		Audit:           audit,
+		SourceType:      sourceType,
+		FromConverter:   fromConverter,
+		SourceImport:    sourceImport,
+		FilterFields:    common.FilterFields(msg.SourceMessage, mergedFields, filterProperty),
+
+		CachedDALTypeName: cachedDALTypeName,
+		ToConverter:       toConverter,
+	}, nil
+}
+
+// filterProperty returns the property of a field for filter schemas (see
+// buildFieldTags), or "" if Datastore ignores the field or does not index it,
+// since a query on an unindexed property matches nothing
+func filterProperty(field *protogen.Field) string {
+	if common.IsEncodedKey(field) {
+		return ""
+	}
+	tags := common.GetColumnOptions(field).GetDatastoreTags()
+	if slices.Contains(tags, "-") || slices.Contains(tags, "noindex") {
+		return ""
 	}
+	return string(field.Desc.Name())
 }
 
 // getGoType returns the Go type for a protogen.Field.
@@ -149,6 +149,169 @@ func TestGenerateDALHelpers_IDBasedConvenienceMethods(t *testing.T) {
 	}
 }
 
+// TestGenerateDALHelpers_IDType verifies that the ID-based convenience
+// methods take an id_type and convert it for the key name.
+func TestGenerateDALHelpers_IDType(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{IdType: "UserID"}},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages := []*collector.MessageInfo{
+		{
+			TargetMessage: plugin.Files[0].Messages[0],
+			GenerateDAL:   true,
+		},
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix:   "_dal",
+		OutputDir:        "dal",
+		EntityImportPath: "github.com/test/gen/v1",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (d *UserDatastoreDAL) newKey(id test.UserID) *dslib.Key {",
+		"dslib.NameKey(d.getKind(), string(id), nil)",
+		"GetByID(ctx context.Context, client *dslib.Client, id test.UserID)",
+		"DeleteByID(ctx context.Context, client *dslib.Client, id test.UserID)",
+		"GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []test.UserID)",
+		"key = d.newKey(obj.Id)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// idStrategyMessages returns a UserDatastore whose id has the given type
+// and id_strategy, ready for GenerateDALHelpers.
+func idStrategyMessages(t *testing.T, typeName string, strategy dalv1.IDStrategy) []*collector.MessageInfo {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: typeName, ColumnOpts: &dalv1.ColumnOptions{IdStrategy: strategy}},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+	return []*collector.MessageInfo{{TargetMessage: plugin.Files[0].Messages[0], GenerateDAL: true}}
+}
+
+// TestGenerateDALHelpers_IDStrategy verifies that Put and PutMulti fill
+// empty generated IDs, and that allocated IDs are read back from the key.
+func TestGenerateDALHelpers_IDStrategy(t *testing.T) {
+	result, err := GenerateDALHelpers(idStrategyMessages(t, "string", dalv1.IDStrategy_UUIDV4), &DALOptions{FilenameSuffix: "_dal"})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/idgen"`,
+		"IDGenerator idgen.Generator",
+		"func (d *UserDatastoreDAL) assignID(ctx context.Context, obj *UserDatastore) error {",
+		"id, err := idgen.String(ctx, d.IDGenerator, idgen.UUIDv4)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+	if n := strings.Count(content, "if err := d.assignID(ctx, obj); err != nil {"); n != 2 {
+		t.Errorf("Expected Put and PutMulti to call assignID, got %d calls", n)
+	}
+
+	result, err = GenerateDALHelpers(idStrategyMessages(t, "int64", dalv1.IDStrategy_DATASTORE_ALLOCATED), &DALOptions{FilenameSuffix: "_dal"})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+	content = result.Files[0].Content
+	for _, want := range []string{
+		"func (d *UserDatastoreDAL) newKey(id int64) *datastore.Key {",
+		"datastore.IDKey(d.getKind(), id, nil)",
+		"} else if obj.Id != 0 {",
+		"obj.Id = resultKey.ID",
+		"objs[i].Id = key.ID",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "idgen") {
+		t.Errorf("Allocated IDs should not need an IDGenerator.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateDALHelpers_IntID verifies that integer ids are numeric keys:
+// newKey builds an IDKey and Put reads allocated IDs back from the key.
+func TestGenerateDALHelpers_IntID(t *testing.T) {
+	result, err := GenerateDALHelpers(idStrategyMessages(t, "uint32", dalv1.IDStrategy_ID_STRATEGY_UNSPECIFIED), &DALOptions{FilenameSuffix: "_dal"})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (d *UserDatastoreDAL) newKey(id uint32) *datastore.Key {",
+		"datastore.IDKey(d.getKind(), int64(id), nil)",
+		"} else if obj.Id != 0 {",
+		"obj.Id = uint32(resultKey.ID)",
+		"objs[i].Id = uint32(key.ID)",
+		"func (d *UserDatastoreDAL) GetByID(ctx context.Context, client *datastore.Client, id uint32) (*UserDatastore, error) {",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	// Generated integer IDs are numeric keys too
+	result, err = GenerateDALHelpers(idStrategyMessages(t, "int64", dalv1.IDStrategy_SNOWFLAKE), &DALOptions{FilenameSuffix: "_dal"})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+	content = result.Files[0].Content
+	for _, want := range []string{
+		"id, err := idgen.Int64(ctx, d.IDGenerator, idgen.Snowflake)",
+		"datastore.IDKey(d.getKind(), id, nil)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
 // TestGenerateDALHelpers_SkipsWhenDALFalse verifies that DAL generation is
 // skipped when GenerateDAL is false.
 func TestGenerateDALHelpers_SkipsWhenDALFalse(t *testing.T) {
@@ -264,6 +427,7 @@ func TestGenerateDALHelpers_NamespaceSupport(t *testing.T) {
 		{
 			TargetMessage: plugin.Files[0].Messages[0],
 			GenerateDAL:   true,
+			SchemaName:    "prod",
 		},
 	}
 
@@ -276,9 +440,19 @@ func TestGenerateDALHelpers_NamespaceSupport(t *testing.T) {
 
 	content := result.Files[0].Content
 
-	// Verify namespace is used in key creation
-	if !strings.Contains(content, "Namespace") {
-		t.Error("Expected generated code to support Namespace field")
+	// The namespace option is the default of the Namespace field, used by
+	// keys and queries
+	for _, want := range []string{
+		"Namespace string",
+		`return "prod"`,
+		"key.Namespace = d.getNamespace()",
+		"if ns := d.getNamespace(); ns != \"\" {",
+		"client.GetAll(ctx, d.inNamespace(q), &entities)",
+		"client.Count(ctx, d.inNamespace(q))",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
 	}
 }
 
@@ -417,3 +591,424 @@ func TestGenerateDALHelpers_DeleteMulti(t *testing.T) {
 		t.Error("Expected generated code to contain DeleteMulti method")
 	}
 }
+
+// TestGenerateDALHelpers_TenantNamespace verifies that a tenant_namespace DAL
+// places every key and query in the namespace of the context's tenant.
+func TestGenerateDALHelpers_TenantNamespace(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages := []*collector.MessageInfo{
+		{
+			TargetMessage:   plugin.Files[0].Messages[0],
+			GenerateDAL:     true,
+			TenantNamespace: true,
+		},
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/tenant"`,
+		"TenantExtractor tenant.Extractor",
+		"tenantID, err := tenant.Get(ctx, d.TenantExtractor)",
+		"scoped.Parent = d.tenantKey(tenantID, key.Parent)",
+		"key = d.tenantKey(tenantID, key)",
+		"keys = d.tenantKeys(tenantID, keys)",
+		"keys[i] = d.tenantKey(tenantID, keys[i])",
+		"client.DeleteMulti(ctx, d.tenantKeys(tenantID, keys))",
+		"client.GetAll(ctx, q.Namespace(tenantID), &entities)",
+		"client.Count(ctx, q.Namespace(tenantID))",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateDALHelpers_Audit verifies that Put and PutMulti fill the audit
+// properties, treating entities without a creation time as new.
+func TestGenerateDALHelpers_Audit(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "created_by", Number: 2, TypeName: "string"},
+							{Name: "created_at", Number: 3, TypeName: "int64"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages := []*collector.MessageInfo{
+		{
+			TargetMessage: plugin.Files[0].Messages[0],
+			GenerateDAL:   true,
+			Audit:         &dalv1.AuditOptions{CreatedBy: "created_by", CreatedAt: "created_at"},
+		},
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/audit"`,
+		"obj.CreatedAt = now.Unix()",
+		"obj.CreatedBy = actor",
+		"d.stampAudit(ctx, obj, obj.CreatedAt == 0)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+	if strings.Count(content, "d.stampAudit(ctx, obj,") != 2 {
+		t.Errorf("Expected Put and PutMulti to fill the audit properties.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateDALHelpers_Iterate verifies that Iterate streams entities with
+// their cursors and that IterateAPI converts them back to the source message.
+func TestGenerateDALHelpers_Iterate(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "test/dal/user_datastore.proto",
+				Pkg:     "test.v1.dal",
+				Imports: []string{"test/user.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		`"google.golang.org/api/iterator"`,
+		"fn func(*UserDatastore, datastore.Cursor) error) error {",
+		"it := client.Run(ctx, d.inNamespace(q))",
+		"if err == iterator.Done {",
+		"cursor, err := it.Cursor()",
+		"fn func(*v1.User, datastore.Cursor) error) error {",
+		"msg, err := UserFromUserDatastore(nil, entity, nil)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateDALHelpers_Filter tests that DALs with a source message get a
+// filter schema over the source's indexed properties and a Filter method
+func TestGenerateDALHelpers_Filter(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "age", Number: 2, TypeName: "int32"},
+							{Name: "secret", Number: 3, TypeName: "string"},
+							{Name: "bio", Number: 4, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "test/dal/user_datastore.proto",
+				Pkg:     "test.v1.dal",
+				Imports: []string{"test/user.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{
+								Name: "secret", Number: 3, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{DatastoreTags: []string{"-"}},
+							},
+							{
+								Name: "bio", Number: 4, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{DatastoreTags: []string{"noindex"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/filtering"`,
+		"var UserDatastoreFilterSchema = filtering.MustSchema((&v1.User{}).ProtoReflect().Descriptor(), map[string]string{\n" +
+			"\t\"id\": \"id\",\n\t\"age\": \"age\",\n})",
+		"func (d *UserDatastoreDAL) Filter(q *datastore.Query, filter string) (*datastore.Query, error) {",
+		"return datastore.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil",
+		"return datastore.OrFilter{Filters: filters}",
+		"return q.FilterEntity(cond), nil",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+	// Properties Datastore ignores or does not index cannot be filtered
+	for _, name := range []string{`"secret"`, `"bio"`} {
+		if strings.Contains(content, name) {
+			t.Errorf("Expected %s to be left out of the filter schema", name)
+		}
+	}
+}
+
+// TestGenerateDALHelpers_FilterUnsigned tests that unsigned fields can be
+// filtered, with their uint64 literals converted to the int64 values Datastore
+// accepts
+func TestGenerateDALHelpers_FilterUnsigned(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "age", Number: 2, TypeName: "uint32"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "test/dal/user_datastore.proto",
+				Pkg:     "test.v1.dal",
+				Imports: []string{"test/user.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source: "test.v1.User",
+							Kind:   "User",
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		"\t\"age\": \"age\",\n",
+		"value := c.Value\n" +
+			"\t\tif n, ok := value.(uint64); ok {\n" +
+			"\t\t\tif int64(n) < 0 {\n" +
+			"\t\t\t\treturn nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: \"value out of range for an int64 property\"}\n" +
+			"\t\t\t}\n" +
+			"\t\t\tvalue = int64(n)\n" +
+			"\t\t}",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateDALHelpers_Cache tests that the cache option generates a
+// read-through wrapper keyed by the tenant-scoped Datastore key
+func TestGenerateDALHelpers_Cache(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/user.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "test/dal/user_datastore.proto",
+				Pkg:     "test.v1.dal",
+				Imports: []string{"test/user.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name: "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{
+							Source:          "test.v1.User",
+							Kind:            "User",
+							TenantNamespace: true,
+							Cache:           true,
+						},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateDALHelpers(messages, &DALOptions{
+		FilenameSuffix: "_dal",
+	})
+	if err != nil {
+		t.Fatalf("GenerateDALHelpers failed: %v", err)
+	}
+
+	content := result.Files[0].Content
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/cache"`,
+		"type UserDatastoreCachedDAL struct {\n\tUserDatastoreDAL\n",
+		"func NewUserDatastoreCachedDAL(kind string, c cache.Cache) *UserDatastoreCachedDAL {",
+		// Keys are scoped to the tenant before encoding
+		"key = d.tenantKey(tenantID, key)",
+		"return cache.Key(prefix, key.Encode()), key, nil",
+		"entity, err := UserToUserDatastore(&msg, nil, nil)",
+		"msg, err := UserFromUserDatastore(nil, entity, nil)",
+		// Reads go through the cache, writes invalidate it
+		"loaded, err := d.UserDatastoreDAL.GetMulti(ctx, client, missing)",
+		"return keys, d.invalidate(ctx, keys...)",
+		"func (d *UserDatastoreCachedDAL) GetByID(ctx context.Context, client *datastore.Client, id string) (*UserDatastore, error) {\n\treturn d.Get(ctx, client, d.newKey(id))",
+		// Unchanged entities are neither written nor invalidated
+		"func (d *UserDatastoreDAL) PutChanged(ctx context.Context, client *datastore.Client, old, obj *UserDatastore) (*datastore.Key, error) {\n\tif old != nil && obj.Equal(old) {",
+		"\t\treturn d.UserDatastoreDAL.PutChanged(ctx, client, old, obj)\n\t}\n\treturn d.Put(ctx, client, obj)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
@@ -16,6 +16,7 @@ package datastore
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -31,13 +32,25 @@ import (
 type GeneratedFile = types.GeneratedFile
 type GenerateResult = types.GenerateResult
 
+// buildStructName generates the Datastore struct name from the target message name.
+// For Datastore, we keep the name as-is (e.g., "UserDatastore" stays "UserDatastore")
+func buildStructName(msg *protogen.Message) string {
+	return string(msg.Desc.Name())
+}
+
+// Generate generates Datastore code for the given messages with the built-in
+// templates (see Generator.Generate).
+func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	return builtinGenerator.Generate(messages)
+}
+
 // Generate generates Datastore code for the given messages.
 //
 // This is the main entry point for Datastore code generation. It receives all
 // messages collected for the Datastore target and generates:
 // - Datastore entity struct definitions with tags
 // - Kind() methods
-// - LoadKey()/SaveKey() methods for key management
+// - LoadKey() methods filling the key and id (implement_key_loader)
 //
 // Parameters:
 //   - messages: Collected Datastore messages from the collector
@@ -45,14 +58,7 @@ type GenerateResult = types.GenerateResult
 // Returns:
 //   - GenerateResult containing all generated files
 //   - error if generation fails
-//
-// buildStructName generates the Datastore struct name from the target message name.
-// For Datastore, we keep the name as-is (e.g., "UserDatastore" stays "UserDatastore")
-func buildStructName(msg *protogen.Message) string {
-	return string(msg.Desc.Name())
-}
-
-func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
+func (g *Generator) Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -77,12 +83,18 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	}
 	sort.Strings(protoFiles)
 
+	// Named ID types (id_type) are declared once per Go package
+	idTypes, err := common.CollectIDTypes(protoFiles, fileGroups)
+	if err != nil {
+		return nil, err
+	}
+
 	var files []*GeneratedFile
 
 	// Generate one file per proto file
 	for _, protoFile := range protoFiles {
 		msgs := fileGroups[protoFile]
-		content, err := generateFileCode(msgs, msgRegistry)
+		content, err := g.generateFileCode(msgs, msgRegistry, idTypes[protoFile])
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate code for %s: %w", protoFile, err)
 		}
@@ -101,7 +113,7 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 }
 
 // generateFileCode generates the complete Go code for all messages in a proto file.
-func generateFileCode(messages []*collector.MessageInfo, registry *common.MessageRegistry) (string, error) {
+func (g *Generator) generateFileCode(messages []*collector.MessageInfo, registry *common.MessageRegistry, idTypes []common.IDType) (string, error) {
 	if len(messages) == 0 {
 		return "", fmt.Errorf("no messages to generate")
 	}
@@ -111,9 +123,10 @@ func generateFileCode(messages []*collector.MessageInfo, registry *common.Messag
 	if err != nil {
 		return "", fmt.Errorf("failed to build template data: %w", err)
 	}
+	data.IDTypes = idTypes
 
 	// Execute template
-	content, err := executeTemplate(data)
+	content, err := g.executeTemplate(data)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute template: %w", err)
 	}
@@ -139,6 +152,10 @@ func buildTemplateData(messages []*collector.MessageInfo, registry *common.Messa
 	// Always add required packages
 	importsMap.Add(common.ImportSpec{Path: "time"})
 	importsMap.Add(common.ImportSpec{Path: "cloud.google.com/go/datastore"})
+	importsMap.Add(common.ImportSpec{Path: "bytes"})
+	importsMap.Add(common.ImportSpec{Path: "reflect"})
+	importsMap.Add(common.ImportSpec{Path: "slices"})
+	importsMap.Add(common.ImportSpec{Path: "maps"})
 
 	// Build struct data for each message
 	for _, msgInfo := range messages {
@@ -213,17 +230,70 @@ func buildStructData(msgInfo *collector.MessageInfo, registry *common.MessageReg
 		sourcePkgName = pkgInfo.Alias
 	}
 
+	// Audit properties must exist so the DAL can fill them
+	if _, err := common.ResolveAuditFields(msgInfo.Audit, mergedFields, structName); err != nil {
+		return nil, err
+	}
+
 	// Extract fields from merged list
 	var fields []*FieldData
 	var mapFields []*MapFieldInfo
+	var keyIDName, keyIDExpression string
 	for _, field := range mergedFields {
+		// The encoded key is the entity's Key, set by the converters
+		if err := common.ValidateEncodedKeyField(field, sourceMsg, structName); err != nil {
+			return nil, err
+		}
+		if common.IsEncodedKey(field) {
+			continue
+		}
+		if err := common.ValidateFlattenField(field, structName); err != nil {
+			return nil, err
+		}
+		if err := common.ValidateMessageStorageField(field, structName); err != nil {
+			return nil, err
+		}
+		if err := common.ValidateIDTypeField(field, structName); err != nil {
+			return nil, err
+		}
+		if _, err := resolveIDStrategy(field, structName); err != nil {
+			return nil, err
+		}
+		if common.GetChildTableOptions(field) != nil {
+			return nil, fmt.Errorf("field '%s.%s': child_table is only supported by the GORM target", structName, field.GoName)
+		}
+
 		isMap := field.Desc.IsMap()
 
+		goType := fieldType(field, sourcePkgName, registry)
 		fieldData := &FieldData{
-			Name:  fieldName(field),
-			Type:  fieldType(field, sourcePkgName, registry),
-			Tags:  buildFieldTags(field),
-			IsMap: isMap,
+			Name:     fieldName(field),
+			Type:     goType,
+			Tags:     buildFieldTags(field),
+			IsMap:    isMap,
+			Equality: common.FieldEquality(field, goType),
+			Cloning:  common.FieldCloning(goType),
+		}
+
+		// Named ID types keep the plain type's comparison and copying
+		if idType := common.GetIDType(field); idType != "" {
+			fieldData.Type = idType
+		}
+
+		// LoadKey fills the id field the DAL derives keys from
+		if keyIDName == "" && field.Parent == targetMsg && isKeyIDField(field) {
+			keyIDName = fieldData.Name
+			keyIDExpression = keyIDExpr(fieldData.Type, "k", getGoType(field) == "string")
+		}
+
+		// Property names for ChangedColumns: flattened structs report their
+		// own properties as "field.sub", and ignored fields have none
+		switch {
+		case common.GetFlattenOptions(field) != nil:
+			fieldData.Embedded = true
+			fieldData.ColumnPrefix = string(field.Desc.Name()) + "."
+		case fieldData.Tags != "`datastore:\"-\"`":
+			fieldData.Column = string(field.Desc.Name())
 		}
 		fields = append(fields, fieldData)
 
@@ -236,9 +306,10 @@ func buildStructData(msgInfo *collector.MessageInfo, registry *common.MessageReg
 
 	// Add Key field at the beginning (excluded from datastore properties)
 	keyField := &FieldData{
-		Name: "Key",
-		Type: "*datastore.Key",
-		Tags: "`datastore:\"-\"`",
+		Name:    "Key",
+		Type:    "*datastore.Key",
+		Tags:    "`datastore:\"-\"`",
+		Cloning: common.CloneKey,
 	}
 	fields = append([]*FieldData{keyField}, fields...)
 
@@ -248,6 +319,9 @@ func buildStructData(msgInfo *collector.MessageInfo, registry *common.MessageReg
 		Fields:                  fields,
 		ImplementPropertyLoader: msgInfo.ImplementPropertyLoader,
 		MapFields:               mapFields,
+		ImplementKeyLoader:      msgInfo.ImplementKeyLoader,
+		KeyIDName:               keyIDName,
+		KeyIDExpr:               keyIDExpression,
 	}, nil
 }
 
@@ -261,9 +335,13 @@ func extractMapFieldInfo(field *protogen.Field) *MapFieldInfo {
 	keyType := common.ProtoScalarToGo(keyField.Desc.Kind().String())
 	valueType := common.ProtoScalarToGo(valueField.Desc.Kind().String())
 
-	// Handle message value types
+	// Handle message value types (well-known types use their Go mapping, e.g. time.Time)
 	if valueField.Desc.Kind().String() == "message" && valueField.Message != nil {
-		valueType = string(valueField.Message.Desc.Name())
+		if wkt, isWellKnown := common.GetWellKnownTypeMapping(valueField.Message); isWellKnown {
+			valueType = wkt.GoType
+		} else {
+			valueType = string(valueField.Message.Desc.Name())
+		}
 	}
 
 	return &MapFieldInfo{
@@ -291,21 +369,34 @@ func fieldType(field *protogen.Field, sourcePkgName string, registry *common.Mes
 // buildFieldTags creates struct tags for a field.
 // It reads datastore_tags from the column annotation and joins them with the field name.
 // Example: datastore_tags: ["noindex", "omitempty"] generates `datastore:"field_name,noindex,omitempty"`
+// Flattened fields also get Datastore's flatten tag, which stores the nested
+// struct's fields as "field_name.sub_field" properties. Serialized message
+// fields (storage option) get noindex, since they are opaque blobs.
 func buildFieldTags(field *protogen.Field) string {
 	// Use snake_case for datastore property names
 	propName := string(field.Desc.Name())
 
 	// Get datastore_tags from column options
 	colOpts := common.GetColumnOptions(field)
-	if colOpts != nil && len(colOpts.DatastoreTags) > 0 {
+	var extraTags []string
+	if colOpts != nil {
+		extraTags = colOpts.DatastoreTags
+		if colOpts.Flatten != nil {
+			extraTags = append(extraTags[:len(extraTags):len(extraTags)], "flatten")
+		}
+		if common.HasMessageStorage(field) && !slices.Contains(extraTags, "noindex") {
+			extraTags = append(extraTags[:len(extraTags):len(extraTags)], "noindex")
+		}
+	}
+	if len(extraTags) > 0 {
 		// Check for "-" tag (ignore field)
-		for _, tag := range colOpts.DatastoreTags {
+		for _, tag := range extraTags {
 			if tag == "-" {
 				return "`datastore:\"-\"`"
 			}
 		}
 		// Join field name with additional tags
-		tags := append([]string{propName}, colOpts.DatastoreTags...)
+		tags := append([]string{propName}, extraTags...)
 		return fmt.Sprintf("`datastore:\"%s\"`", joinDatastoreTags(tags))
 	}
 
@@ -324,6 +415,12 @@ func joinDatastoreTags(tags []string) string {
 	return strings.Join(result, ",")
 }
 
+// GenerateConverters generates converter functions with the built-in templates
+// (see Generator.GenerateConverters).
+func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	return builtinGenerator.GenerateConverters(messages)
+}
+
 // GenerateConverters generates converter functions for transforming between
 // API messages and Datastore entities.
 //
@@ -337,7 +434,7 @@ func joinDatastoreTags(tags []string) string {
 // Returns:
 //   - GenerateResult containing converter files (*_converters.go)
 //   - error if generation fails
-func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
+func (g *Generator) GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -360,7 +457,7 @@ func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, err
 	// Generate one converter file per proto file
 	for _, protoFile := range protoFiles {
 		msgs := fileGroups[protoFile]
-		content, err := generateConverterFileCode(msgs, msgRegistry)
+		content, err := g.generateConverterFileCode(msgs, msgRegistry)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate converters for %s: %w", protoFile, err)
 		}
@@ -378,10 +475,88 @@ func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, err
 	return &GenerateResult{Files: files}, nil
 }
 
+// GenerateExtras renders the generator's extra templates.
+//
+// Each extra template is rendered once per proto file with ExtraTemplateData,
+// which carries the same entity and converter data as the built-in templates.
+// Output files are named after the proto file and the template, e.g.
+// "metrics.go.tmpl" for datastore/user.proto -> datastore/user_metrics.go.
+//
+// Parameters:
+//   - messages: Collected Datastore messages from the collector
+//
+// Returns:
+//   - GenerateResult containing one file per proto file and extra template
+//   - error if generation fails
+func (g *Generator) GenerateExtras(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	if len(messages) == 0 || g.templateOptions == nil || len(g.templateOptions.Extras) == 0 {
+		return &GenerateResult{Files: []*GeneratedFile{}}, nil
+	}
+
+	msgRegistry := common.NewMessageRegistry(messages, buildStructName)
+
+	// Group messages by their source proto file
+	fileGroups := common.GroupMessagesByFile(messages)
+
+	// Get sorted proto file paths for deterministic output
+	protoFiles := make([]string, 0, len(fileGroups))
+	for protoFile := range fileGroups {
+		protoFiles = append(protoFiles, protoFile)
+	}
+	sort.Strings(protoFiles)
+
+	var files []*GeneratedFile
+	for _, protoFile := range protoFiles {
+		msgs := fileGroups[protoFile]
+		entities, err := buildTemplateData(msgs, msgRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build template data for %s: %w", protoFile, err)
+		}
+		converters, err := buildConverterFileData(msgs, msgRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build converter data for %s: %w", protoFile, err)
+		}
+
+		data := &ExtraTemplateData{
+			ProtoFile:  protoFile,
+			Entities:   entities,
+			Converters: converters,
+		}
+		for _, extra := range g.templateOptions.Extras {
+			content, err := g.renderTemplate(extra.Name, data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render %s for %s: %w", extra.Name, protoFile, err)
+			}
+			files = append(files, &GeneratedFile{
+				Path:    common.ExtraTemplateFilename(protoFile, extra.Name),
+				Content: content,
+			})
+		}
+	}
+
+	return &GenerateResult{Files: files}, nil
+}
+
 // generateConverterFileCode generates the complete converter code for all messages in a proto file.
-func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (string, error) {
+func (g *Generator) generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (string, error) {
+	data, err := buildConverterFileData(messages, msgRegistry)
+	if err != nil {
+		return "", err
+	}
+
+	// Execute converter template
+	content, err := g.executeConverterTemplate(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute converter template: %w", err)
+	}
+
+	return content, nil
+}
+
+// buildConverterFileData builds the converter template data for all messages in a proto file.
+func buildConverterFileData(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (*ConverterFileData, error) {
 	if len(messages) == 0 {
-		return "", fmt.Errorf("no messages to generate converters for")
+		return nil, fmt.Errorf("no messages to generate converters for")
 	}
 
 	// Extract package name from the first message's target
@@ -404,9 +579,9 @@ func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *c
 			continue
 		}
 
-		converterData, err := buildConverterData(msg, reg, msgRegistry)
+		converterData, err := buildConverterData(msg, reg, msgRegistry, nil)
 		if err != nil {
-			return "", fmt.Errorf("failed to build converter data for %s: %w", msg.TargetMessage.Desc.Name(), err)
+			return nil, fmt.Errorf("failed to build converter data for %s: %w", msg.TargetMessage.Desc.Name(), err)
 		}
 		converters = append(converters, converterData)
 
@@ -419,44 +594,31 @@ func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *c
 
 		// Collect custom converter package imports (new for Datastore!)
 		common.CollectCustomConverterImports(msg.TargetMessage, importsMap)
-	}
+		common.CollectMessageStorageImports(msg.SourceMessage, msg.TargetMessage, importsMap)
 
-	// Build import list
-	importList := importsMap.ToSlice()
-
-	// Check if we need fmt import (for repeated/map message conversions)
-	hasFmtNeeded := false
-	for _, conv := range converters {
-		for _, field := range conv.FieldMappings {
-			if (field.IsRepeated || field.IsMap) && field.ToTargetConverterFunc != "" {
-				hasFmtNeeded = true
-				break
-			}
-		}
-		if hasFmtNeeded {
-			break
+		// Encoded keys are decoded and encoded with the datastore package
+		if converterData.EncodedKey != nil {
+			importsMap.Add(common.ImportSpec{Path: "cloud.google.com/go/datastore"})
 		}
 	}
 
-	// Build template data
-	data := &ConverterFileData{
-		PackageName:                   packageName,
-		Imports:                       importList,
-		Converters:                    converters,
-		HasRepeatedMessageConversions: hasFmtNeeded,
-	}
+	// The ...WithOptions converters take a context
+	importsMap.Add(common.ImportSpec{Path: "context"})
 
-	// Execute converter template
-	content, err := executeConverterTemplate(data)
-	if err != nil {
-		return "", fmt.Errorf("failed to execute converter template: %w", err)
-	}
+	// Build import list
+	importList := importsMap.ToSlice()
 
-	return content, nil
+	// Build template data
+	return &ConverterFileData{
+		PackageName: packageName,
+		Imports:     importList,
+		Converters:  converters,
+	}, nil
 }
 
 // buildConverterData builds converter metadata for a single message.
-func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterRegistry, msgRegistry *common.MessageRegistry) (*types.ConverterData, error) {
+// Warnings about fields without a conversion go to warnings (nil logs them).
+func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterRegistry, msgRegistry *common.MessageRegistry, warnings *common.Warnings) (*types.ConverterData, error) {
 	sourceMsg := msgInfo.SourceMessage
 	targetMsg := msgInfo.TargetMessage
 
@@ -475,8 +637,26 @@ func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterR
 	}
 
 	// Build field mappings
-	var fieldMappings []*converter.FieldMapping
+	var fieldMappings, unmapped []*converter.FieldMapping
+	var encodedKey *converter.FieldMapping
 	for _, mergedField := range mergedFields {
+		// The encoded key is converted to and from the entity's Key
+		if common.IsEncodedKey(mergedField) {
+			encodedKey = &converter.FieldMapping{
+				SourceField:              mergedField.GoName,
+				TargetField:              "Key",
+				SourceName:               string(mergedField.Desc.Name()),
+				ToTargetCode:             "datastore.DecodeKey(src." + mergedField.GoName + ")",
+				FromTargetCode:           "src.Key.Encode()",
+				ToTargetConversionType:   converter.ConvertByTransformerWithError,
+				FromTargetConversionType: converter.ConvertByTransformer,
+				ToTargetRenderStrategy:   converter.StrategySetterWithError,
+				FromTargetRenderStrategy: converter.StrategySetterTransform,
+				TargetIsPointer:          true,
+			}
+			continue
+		}
+
 		// Find corresponding source field by name
 		var sourceField *protogen.Field
 		for _, sf := range sourceMsg.Fields {
@@ -491,9 +671,14 @@ func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterR
 			continue
 		}
 
-		mapping := buildFieldMapping(sourceField, mergedField, reg, sourcePkgName, msgRegistry)
+		mapping := buildFieldMapping(sourceField, mergedField, reg, sourcePkgName, msgRegistry, warnings)
 
-		// Skip fields marked as ConvertIgnore (no conversion available, decorator handles)
+		// Fields with no conversion are left to decorators or type converters;
+		// ConvertIgnore fields are skipped
+		if mapping == nil {
+			unmapped = append(unmapped, converter.UnmappedFieldMapping(sourceField, mergedField))
+			continue
+		}
 		if mapping.ToTargetConversionType == converter.ConvertIgnore {
 			continue
 		}
@@ -511,10 +696,17 @@ func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterR
 	fromSetter := filterNonOneofFields(classified.FromTargetSetter)
 	fromLoop := filterNonOneofFields(classified.FromTargetLoop)
 
+	sliceToTarget, sliceFromTarget := converter.BuildBatchConverterNames(sourceName, targetName)
+
 	return &types.ConverterData{
 		SourceType:    sourceName,
 		TargetType:    targetName,
 		SourcePkgName: sourcePkgName,
+		PathRoot:      common.ToSnakeCase(sourceName),
+
+		SliceToTargetFunc:   sliceToTarget,
+		SliceFromTargetFunc: sliceFromTarget,
+
 		FieldMappings: fieldMappings,
 
 		ToTargetInlineFields: classified.ToTargetInline,
@@ -524,6 +716,9 @@ func buildConverterData(msgInfo *collector.MessageInfo, reg *registry.ConverterR
 		FromTargetInlineFields: fromInline,
 		FromTargetSetterFields: fromSetter,
 		FromTargetLoopFields:   fromLoop,
+
+		UnmappedFields: unmapped,
+		EncodedKey:     encodedKey,
 	}, nil
 }
 
@@ -554,8 +749,8 @@ func addRenderStrategies(mapping *converter.FieldMapping) {
 		mapping.TargetIsPointer,
 		mapping.IsRepeated,
 		mapping.IsMap,
-		mapping.ToTargetConverterFunc != "",
-		mapping.FromTargetConverterFunc != "",
+		mapping.ToTargetConverterFunc != "" || mapping.ElementToTargetCode != "",
+		mapping.FromTargetConverterFunc != "" || mapping.ElementFromTargetCode != "",
 	)
 
 	mapping.ToTargetRenderStrategy = toTargetStrategy
@@ -571,6 +766,23 @@ func addRenderStrategies(mapping *converter.FieldMapping) {
 
 // buildFieldMapping creates a field mapping with type conversion if needed.
 // This is a thin wrapper around the shared BuildFieldMapping function.
-func buildFieldMapping(sourceField, targetField *protogen.Field, reg *registry.ConverterRegistry, sourcePkgName string, msgRegistry *common.MessageRegistry) *converter.FieldMapping {
-	return converter.BuildFieldMapping(sourceField, targetField, reg, msgRegistry, sourcePkgName, addRenderStrategies)
+func buildFieldMapping(sourceField, targetField *protogen.Field, reg *registry.ConverterRegistry, sourcePkgName string, msgRegistry *common.MessageRegistry, warnings *common.Warnings) *converter.FieldMapping {
+	return converter.BuildFieldMapping(sourceField, targetField, reg, msgRegistry, sourcePkgName, addRenderStrategies, warnings)
+}
+
+// resolveIDStrategy returns how Put fills an empty id field, or nil if the
+// field has no id_strategy. Datastore keys are derived from the id field, so
+// only it can have one, and AUTO_INCREMENT is GORM-only.
+func resolveIDStrategy(field *protogen.Field, structName string) (*common.IDStrategy, error) {
+	strategy, err := common.ResolveIDStrategy(field, structName)
+	if err != nil || strategy == nil {
+		return nil, err
+	}
+	switch {
+	case strings.ToLower(string(field.Desc.Name())) != "id":
+		return nil, fmt.Errorf("field '%s.%s': id_strategy is only supported on the id field", structName, field.GoName)
+	case strategy.AutoIncrement:
+		return nil, fmt.Errorf("field '%s.%s': id_strategy AUTO_INCREMENT is only supported by the GORM target", structName, field.GoName)
+	}
+	return strategy, nil
 }
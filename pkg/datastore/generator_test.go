@@ -434,3 +434,356 @@ func TestGenerateDatastore_KeyFieldCollision(t *testing.T) {
 // Test helpers (copied from collector_test.go pattern)
 
 // Test helpers have been moved to pkg/generator/testutil
+
+// flattenProtos returns an Order whose Address field is flattened, plus the
+// given extra OrderDatastore fields.
+func flattenProtos(extra ...testutil.TestField) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "shop/v1/order.proto",
+				Pkg:  "shop.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Address",
+						Fields: []testutil.TestField{
+							{Name: "street", Number: 1, TypeName: "string"},
+							{Name: "city", Number: 2, TypeName: "string"},
+						},
+					},
+					{
+						Name: "Order",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "billing", Number: 2, TypeName: "shop.v1.Address"},
+							{Name: "tags", Number: 3, TypeName: "shop.v1.Address", Repeated: true},
+						},
+					},
+				},
+			},
+			{
+				Name:    "shop/v1/dal/order_datastore.proto",
+				Pkg:     "shop.v1.dal",
+				Imports: []string{"shop/v1/order.proto"},
+				Messages: []testutil.TestMessage{
+					{Name: "AddressDatastore", DatastoreOpts: &dalv1.DatastoreOptions{Source: "shop.v1.Address"}},
+					{
+						Name:          "OrderDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "shop.v1.Order", Kind: "Order"},
+						Fields: append([]testutil.TestField{
+							{
+								Name: "billing", Number: 2, TypeName: "shop.v1.Address",
+								ColumnOpts: &dalv1.ColumnOptions{
+									DatastoreTags: []string{"omitempty"},
+									Flatten:       &dalv1.FlattenOptions{},
+								},
+							},
+						}, extra...),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGenerateDatastore_Flatten tests that flattened fields get Datastore's
+// flatten tag after any datastore_tags.
+func TestGenerateDatastore_Flatten(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos())
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+
+	if !strings.Contains(content, "`datastore:\"billing,omitempty,flatten\"`") {
+		t.Errorf("Expected Billing field with datastore:\"billing,omitempty,flatten\" tag.\nGenerated content:\n%s", content)
+	}
+
+	// Flattened properties are reported as "field.sub" by ChangedColumns
+	if !strings.Contains(content, "for _, column := range m.Billing.ChangedColumns(&other.Billing) {\n\t\tcolumns = append(columns, \"billing.\"+column)") {
+		t.Errorf("Expected Billing's properties in ChangedColumns.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateDatastore_Clone tests the generated Clone methods: keys are
+// copied with their parents, and nested structs and lists are copied.
+func TestGenerateDatastore_Clone(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(
+		testutil.TestField{Name: "tags", Number: 3, TypeName: "string", Repeated: true},
+	))
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (m *OrderDatastore) Clone() *OrderDatastore {",
+		"key := *m.Key\n\t\tm.Key = &key",
+		"parent := *k.Parent\n\t\t\tk.Parent = &parent",
+		"m.Billing.cloneFields()",
+		"m.Tags = slices.Clone(m.Tags)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateDatastore_FlattenRepeated tests that flatten is rejected on
+// repeated fields.
+func TestGenerateDatastore_FlattenRepeated(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(testutil.TestField{
+		Name: "tags", Number: 3, TypeName: "shop.v1.Address", Repeated: true,
+		ColumnOpts: &dalv1.ColumnOptions{Flatten: &dalv1.FlattenOptions{}},
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	_, err = Generate(messages)
+	if err == nil || !strings.Contains(err.Error(), "flatten cannot be used on repeated or map fields") {
+		t.Errorf("Expected flatten error for OrderDatastore.Tags, got %v", err)
+	}
+}
+
+// TestGenerateDatastore_IDStrategyInvalid tests that id_strategies are only
+// allowed on the id field, with strategies Datastore can key entities by.
+func TestGenerateDatastore_IDStrategyInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		field testutil.TestField
+		want  string
+	}{
+		{"not the id", testutil.TestField{Name: "note", Number: 4, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{IdStrategy: dalv1.IDStrategy_ULID}}, "only supported on the id field"},
+		{"auto increment", testutil.TestField{Name: "id", Number: 1, TypeName: "int64", ColumnOpts: &dalv1.ColumnOptions{IdStrategy: dalv1.IDStrategy_AUTO_INCREMENT}}, "only supported by the GORM target"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := testutil.CreateTestPlugin(t, flattenProtos(tt.field))
+			messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestGenerateDatastore_MessageStorage tests that serialized message fields
+// are noindex string/[]byte properties, and that repeated fields are rejected.
+func TestGenerateDatastore_MessageStorage(t *testing.T) {
+	protos := flattenProtos()
+	order := &protos.Files[1].Messages[1]
+	order.Fields = []testutil.TestField{
+		{
+			Name: "billing", Number: 2, TypeName: "shop.v1.Address",
+			ColumnOpts: &dalv1.ColumnOptions{Storage: dalv1.MessageStorage_PROTOJSON},
+		},
+	}
+	messages, err := collector.CollectMessages(testutil.CreateTestPlugin(t, protos), collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if content := result.Files[0].Content; !strings.Contains(content, "Billing string `datastore:\"billing,noindex\"`") {
+		t.Errorf("Expected Billing as a noindex string property.\nGenerated content:\n%s", content)
+	}
+
+	order.Fields = append(order.Fields, testutil.TestField{
+		Name: "tags", Number: 3, TypeName: "shop.v1.Address", Repeated: true,
+		ColumnOpts: &dalv1.ColumnOptions{Storage: dalv1.MessageStorage_PROTO_BINARY},
+	})
+	messages, err = collector.CollectMessages(testutil.CreateTestPlugin(t, protos), collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	_, err = Generate(messages)
+	if err == nil || !strings.Contains(err.Error(), "storage PROTO_BINARY cannot be used on repeated or map fields") {
+		t.Errorf("Expected storage error for OrderDatastore.Tags, got %v", err)
+	}
+}
+
+// TestGenerateDatastore_ChildTable tests that child_table, a GORM-only
+// option, is rejected.
+func TestGenerateDatastore_ChildTable(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(testutil.TestField{
+		Name: "tags", Number: 3, TypeName: "shop.v1.Address", Repeated: true,
+		ColumnOpts: &dalv1.ColumnOptions{ChildTable: &dalv1.ChildTableOptions{}},
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	_, err = Generate(messages)
+	if err == nil || !strings.Contains(err.Error(), "child_table is only supported by the GORM target") {
+		t.Errorf("Expected child_table error for OrderDatastore.Tags, got %v", err)
+	}
+}
+
+// keyProtos returns a Note API message with an encoded key field and a
+// NoteDatastore with a numeric id loaded from its keys, plus extra fields.
+func keyProtos(extra ...testutil.TestField) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "notes/v1/note.proto",
+				Pkg:  "notes.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Note",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "uint32"},
+							{Name: "text", Number: 2, TypeName: "string"},
+							{Name: "key", Number: 3, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "notes/v1/dal/note_datastore.proto",
+				Pkg:     "notes.v1.dal",
+				Imports: []string{"notes/v1/note.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name:          "NoteDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "notes.v1.Note", Kind: "Note", ImplementKeyLoader: true},
+						Fields: append([]testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "uint32", ColumnOpts: &dalv1.ColumnOptions{DatastoreTags: []string{"-"}}},
+						}, extra...),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGenerateDatastore_KeyLoader tests that implement_key_loader generates
+// LoadKey, filling the id from numeric key IDs, along with the Load and Save
+// methods KeyLoader requires.
+func TestGenerateDatastore_KeyLoader(t *testing.T) {
+	messages, err := collector.CollectMessages(testutil.CreateTestPlugin(t, keyProtos()), collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (m *NoteDatastore) LoadKey(k *datastore.Key) error {",
+		"m.Key = k",
+		"m.Id = uint32(k.ID)",
+		"return datastore.LoadStruct(m, props)",
+		"return datastore.SaveStruct(m)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateDatastore_EncodedKey tests that an encoded_key field is not a
+// property, and that the converters decode it into Key and encode Key back.
+func TestGenerateDatastore_EncodedKey(t *testing.T) {
+	protos := keyProtos(testutil.TestField{
+		Name: "key", Number: 3, TypeName: "string",
+		ColumnOpts: &dalv1.ColumnOptions{EncodedKey: true},
+	})
+	messages, err := collector.CollectMessages(testutil.CreateTestPlugin(t, protos), collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if content := result.Files[0].Content; strings.Contains(content, "Key string") {
+		t.Errorf("Expected no property for the encoded key.\nGenerated content:\n%s", content)
+	}
+
+	result, err = GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		`"cloud.google.com/go/datastore"`,
+		"if out.Key, err = datastore.DecodeKey(src.Key); err != nil {",
+		`conversionNoteToNoteDatastore.FieldError(err, "key")`,
+		"out.Key = src.Key.Encode()",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	// The IR describes the conversion on the unstored Key field
+	docs, err := BuildIR(messages)
+	if err != nil {
+		t.Fatalf("BuildIR failed: %v", err)
+	}
+	for _, field := range docs[0].Messages[0].Fields {
+		if field.GoName == "Key" && (field.Name != "key" || field.ColumnName != "" || field.Conversion == nil) {
+			t.Errorf("Expected Key to describe the unstored encoded key with its conversion, got %+v", field)
+		}
+	}
+
+	// Round-trip tests fill the field with a valid encoded key
+	result, err = GenerateTests(messages)
+	if err != nil {
+		t.Fatalf("GenerateTests failed: %v", err)
+	}
+	if content := result.Files[0].Content; strings.Count(content, `src.Key = datastore.IDKey("NoteDatastore", int64(i+1), nil).Encode()`) != 2 {
+		t.Errorf("Expected the round-trip and clone tests to set an encoded key.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateDatastore_EncodedKeyInvalid tests that encoded_key is only
+// allowed on string fields whose source field is a string too.
+func TestGenerateDatastore_EncodedKeyInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		field testutil.TestField
+		want  string
+	}{
+		{"not a string", testutil.TestField{Name: "key", Number: 3, TypeName: "int64", ColumnOpts: &dalv1.ColumnOptions{EncodedKey: true}}, "encoded_key requires a singular, non-optional string field"},
+		{"not in source", testutil.TestField{Name: "web_key", Number: 4, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{EncodedKey: true}}, "encoded_key needs source field notes.v1.Note.web_key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, err := collector.CollectMessages(testutil.CreateTestPlugin(t, keyProtos(tt.field)), collector.TargetDatastore)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
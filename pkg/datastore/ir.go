@@ -0,0 +1,148 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/registry"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+)
+
+// GenerateIR generates a JSON description of the Datastore entities and converters.
+//
+// One "{file}_datastore.ir.json" document is written per proto file. Column
+// names are the Datastore property names, and the primary key is the "id"
+// field used to build entity keys (if present). The generated Key field is
+// listed with origin "generated".
+//
+// Parameters:
+//   - messages: Collected Datastore messages from the collector
+//
+// Returns:
+//   - GenerateResult containing one IR file per proto file
+//   - error if generation fails
+func GenerateIR(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	docs, err := BuildIR(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*GeneratedFile{}
+	for _, doc := range docs {
+		content, err := doc.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal IR for %s: %w", doc.ProtoFile, err)
+		}
+		files = append(files, &GeneratedFile{
+			Path:    ir.Filename(doc.ProtoFile, "datastore"),
+			Content: content,
+		})
+	}
+
+	return &GenerateResult{Files: files}, nil
+}
+
+// BuildIR builds the IR documents written by GenerateIR, one per proto file
+// in sorted order.
+//
+// Parameters:
+//   - messages: Collected Datastore messages from the collector
+//
+// Returns:
+//   - IR documents, one per proto file
+//   - error if struct or converter data cannot be built
+func BuildIR(messages []*collector.MessageInfo) ([]*ir.Document, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	msgRegistry := common.NewMessageRegistry(messages, buildStructName)
+	convRegistry := registry.NewConverterRegistry(messages, buildStructName)
+	irRegistry := ir.BuildRegistry(messages, buildStructName)
+
+	// Group messages by their source proto file
+	fileGroups := common.GroupMessagesByFile(messages)
+
+	// Get sorted proto file paths for deterministic output
+	protoFiles := make([]string, 0, len(fileGroups))
+	for protoFile := range fileGroups {
+		protoFiles = append(protoFiles, protoFile)
+	}
+	sort.Strings(protoFiles)
+
+	var docs []*ir.Document
+	for _, protoFile := range protoFiles {
+		var inputs []*ir.MessageInput
+		warnings := common.NewWarnings()
+		for _, msg := range fileGroups[protoFile] {
+			input, err := buildIRInput(msg, convRegistry, msgRegistry, warnings)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build IR for %s: %w", protoFile, err)
+			}
+			inputs = append(inputs, input)
+		}
+
+		docs = append(docs, ir.BuildDocument("datastore", protoFile, inputs, irRegistry, warnings.Messages()))
+	}
+
+	return docs, nil
+}
+
+// buildIRInput collects the struct, property and converter data for one message,
+// recording its warnings into warnings.
+func buildIRInput(msg *collector.MessageInfo, convRegistry *registry.ConverterRegistry, msgRegistry *common.MessageRegistry, warnings *common.Warnings) (*ir.MessageInput, error) {
+	structData, err := buildStructData(msg, msgRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ir.MessageInput{
+		Info:        msg,
+		StructName:  structData.Name,
+		ColumnNames: make(map[string]string),
+	}
+	for _, field := range structData.Fields {
+		input.Fields = append(input.Fields, *field)
+	}
+
+	mergedFields, err := common.MergeSourceFields(msg.SourceMessage, msg.TargetMessage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge fields: %w", err)
+	}
+	for _, field := range mergedFields {
+		// Fields tagged datastore:"-" and encoded keys are not stored
+		if buildFieldTags(field) != "`datastore:\"-\"`" && !common.IsEncodedKey(field) {
+			input.ColumnNames[field.GoName] = string(field.Desc.Name())
+		}
+		if strings.ToLower(string(field.Desc.Name())) == "id" && len(input.PrimaryKeys) == 0 {
+			input.PrimaryKeys = []string{field.GoName}
+		}
+	}
+
+	if msg.SourceMessage != nil {
+		converterData, err := buildConverterData(msg, convRegistry, msgRegistry, warnings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build converter data for %s: %w", msg.TargetMessage.Desc.Name(), err)
+		}
+		input.Converter = converterData
+	}
+
+	return input, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// TestGenerateIR verifies that the Datastore IR lists the generated Key
+// field, property names and the id-based key.
+func TestGenerateIR(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "api/v1/user.proto",
+				Pkg:  "api.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "bio", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name: "datastore/user.proto",
+				Pkg:  "datastore",
+				Messages: []testutil.TestMessage{
+					{
+						Name:          "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "api.v1.User", Kind: "User"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "bio", Number: 2, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{DatastoreTags: []string{"-"}}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateIR(messages)
+	if err != nil {
+		t.Fatalf("GenerateIR failed: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].Path != "datastore/user_datastore.ir.json" {
+		t.Fatalf("Expected one IR file, got %v", result.Files)
+	}
+
+	var doc ir.Document
+	if err := json.Unmarshal([]byte(result.Files[0].Content), &doc); err != nil {
+		t.Fatalf("IR is not valid JSON: %v", err)
+	}
+	if len(doc.Messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(doc.Messages))
+	}
+
+	msg := doc.Messages[0]
+	if msg.TableName != "User" || len(msg.PrimaryKeys) != 1 || msg.PrimaryKeys[0] != "Id" {
+		t.Errorf("Unexpected kind or key: %+v", msg)
+	}
+	if len(msg.Fields) != 3 {
+		t.Fatalf("Expected Key, Id and Bio fields, got %d", len(msg.Fields))
+	}
+	if key := msg.Fields[0]; key.GoName != "Key" || key.Origin != ir.OriginGenerated || key.Name != "" {
+		t.Errorf("Expected generated Key field first, got %+v", key)
+	}
+	if id := msg.Fields[1]; id.ColumnName != "id" || id.Conversion == nil {
+		t.Errorf("Expected converted id property, got %+v", id)
+	}
+	if bio := msg.Fields[2]; bio.ColumnName != "" {
+		t.Errorf("Expected unstored Bio to have no property name, got %+v", bio)
+	}
+}
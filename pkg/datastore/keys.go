@@ -0,0 +1,49 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isKeyIDField reports whether field is the id field from which entity keys
+// are derived: a field named "id" holding a key name (string) or a numeric
+// key ID (integer).
+func isKeyIDField(field *protogen.Field) bool {
+	if strings.ToLower(string(field.Desc.Name())) != "id" || field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	switch getGoType(field) {
+	case "string", "int32", "int64", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+// keyIDExpr returns the expression reading an id of type idType from the key
+// variable key: string ids are key names and integer ids numeric key IDs
+// (e.g., "k.Name", "uint32(k.ID)", "UserID(k.Name)").
+func keyIDExpr(idType, key string, stringID bool) string {
+	expr, plain := key+".ID", "int64"
+	if stringID {
+		expr, plain = key+".Name", "string"
+	}
+	if idType == plain {
+		return expr
+	}
+	return idType + "(" + expr + ")"
+}
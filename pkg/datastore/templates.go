@@ -16,8 +16,11 @@ package datastore
 
 import (
 	"bytes"
-	_ "embed"
+	"embed"
 	"fmt"
+	"io/fs"
+	"path"
+	"sync"
 	"text/template"
 
 	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
@@ -37,6 +40,9 @@ type TemplateData struct {
 
 	// Structs is the list of entity structs to generate
 	Structs []*StructData
+
+	// IDTypes are the named ID types declared in this file (id_type)
+	IDTypes []common.IDType
 }
 
 // StructData contains metadata for generating a single entity struct.
@@ -56,6 +62,16 @@ type StructData struct {
 
 	// MapFields contains fields that are maps (need special handling in PropertyLoadSaver)
 	MapFields []*MapFieldInfo
+
+	// ImplementKeyLoader indicates whether to generate the KeyLoader method
+	// LoadKey, which sets Key and fills the id field from the loaded key.
+	ImplementKeyLoader bool
+
+	// KeyIDName is the Go name of the id field filled by LoadKey (empty if none)
+	KeyIDName string
+
+	// KeyIDExpr reads the id from the key k (e.g., "k.Name", "uint32(k.ID)")
+	KeyIDExpr string
 }
 
 // MapFieldInfo contains information about a map field for PropertyLoadSaver generation.
@@ -73,49 +89,76 @@ type MapFieldInfo struct {
 	ValueType string
 }
 
+// ExtraTemplateData contains the data passed to user-supplied extra templates.
+// Extra templates are rendered once per proto file.
+type ExtraTemplateData struct {
+	// ProtoFile is the source proto file (e.g., "datastore/user.proto")
+	ProtoFile string
+
+	// Entities is the same data as file.go.tmpl
+	Entities *TemplateData
+
+	// Converters is the same data as converters.go.tmpl
+	Converters *ConverterFileData
+}
+
 type FieldData = types.FieldData
 type ConverterFileData = types.ConverterFileData
 
 // Embedded templates
 
-//go:embed templates/file.go.tmpl
-var fileTemplate string
-
-//go:embed templates/converters.go.tmpl
-var converterTemplate string
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
 
-//go:embed templates/dal.go.tmpl
-var dalTemplate string
+// Generator generates Datastore code with a set of user-supplied templates.
+//
+// Overrides replace built-in templates of the same name (see TemplateNames)
+// and extras are rendered by GenerateExtras. The templates are parsed on
+// first use and belong to the Generator, so generators with different
+// templates can be used side by side and concurrently.
+type Generator struct {
+	templateOptions *common.TemplateOptions
+
+	loadOnce sync.Once
+	tmpl     *template.Template
+	loadErr  error
+}
 
-//go:embed templates/property_load_saver.go.tmpl
-var propertyLoadSaverTemplate string
+// NewGenerator returns a Generator applying opts over the built-in templates.
+// A nil opts uses the built-in templates only.
+func NewGenerator(opts *common.TemplateOptions) *Generator {
+	return &Generator{templateOptions: opts}
+}
 
-// executeTemplate executes the file template with the given data.
-func executeTemplate(data *TemplateData) (string, error) {
-	// Parse both templates so property_load_saver can be invoked from file template
-	tmpl, err := template.New("file").Parse(fileTemplate)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse file template: %w", err)
-	}
+// builtinGenerator backs the package-level functions, which use the built-in
+// templates.
+var builtinGenerator = NewGenerator(nil)
 
-	// Parse property_load_saver template into the same template set
-	tmpl, err = tmpl.Parse(propertyLoadSaverTemplate)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse property_load_saver template: %w", err)
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("failed to execute template: %w", err)
+// TemplateNames returns the names of the built-in templates that can be overridden.
+func TemplateNames() []string {
+	paths, _ := fs.Glob(templatesFS, "templates/*.tmpl")
+	names := make([]string, 0, len(paths))
+	for _, p := range paths {
+		names = append(names, path.Base(p))
 	}
+	return names
+}
 
-	return buf.String(), nil
+// loadTemplates returns the generator's templates, parsing them on first use.
+func (g *Generator) loadTemplates() (*template.Template, error) {
+	g.loadOnce.Do(func() {
+		g.tmpl, g.loadErr = parseTemplates(g.templateOptions)
+	})
+	return g.tmpl, g.loadErr
 }
 
-// executeConverterTemplate executes the converter template with the given data.
-func executeConverterTemplate(data *ConverterFileData) (string, error) {
+// parseTemplates parses all templates into a single set so that file.go.tmpl
+// can invoke property_load_saver and key_loader, and user templates (opts)
+// can invoke any built-in definition.
+func parseTemplates(opts *common.TemplateOptions) (*template.Template, error) {
+
 	// Create template with helper functions
-	tmpl := template.New("converters").Funcs(template.FuncMap{
+	t := template.New("").Funcs(template.FuncMap{
 		// fieldRef generates the correct field reference expression for converter parameters.
 		// For pointer fields: returns "varName.fieldName" (pass pointer as-is)
 		// For value fields: returns "&varName.fieldName" (take address for in-place modification)
@@ -160,40 +203,50 @@ func executeConverterTemplate(data *ConverterFileData) (string, error) {
 		"needsErrorCheck": func(convType converter.ConversionType) bool {
 			return convType == converter.ConvertByTransformerWithError
 		},
+		"withOptions": converter.WithOptionsConverterName,
+		// keyID reads an id of the given type from a key variable: string ids
+		// from its name, integer ids from its numeric ID.
+		"keyID": keyIDExpr,
 	})
 
-	tmpl, err := tmpl.Parse(converterTemplate)
+	// Parse all template files
+	t, err := t.ParseFS(templatesFS, "templates/*.tmpl")
 	if err != nil {
-		return "", fmt.Errorf("failed to parse converter template: %w", err)
+		return nil, err
 	}
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("failed to execute converter template: %w", err)
+	// Apply user overrides and extras on top of the built-in templates
+	if err := common.ApplyTemplateOptions(t, opts); err != nil {
+		return nil, err
 	}
+	return t, nil
+}
 
-	return buf.String(), nil
+// executeTemplate executes the file template with the given data.
+func (g *Generator) executeTemplate(data *TemplateData) (string, error) {
+	return g.renderTemplate("file.go.tmpl", data)
+}
+
+// executeConverterTemplate executes the converter template with the given data.
+func (g *Generator) executeConverterTemplate(data *ConverterFileData) (string, error) {
+	return g.renderTemplate("converters.go.tmpl", data)
 }
 
 // renderTemplate renders a template by name with the given data.
-// This is used by the DAL generator to render the DAL template.
-func renderTemplate(name string, data interface{}) (string, error) {
-	var templateContent string
-	switch name {
-	case "dal.go.tmpl":
-		templateContent = dalTemplate
-	default:
-		return "", fmt.Errorf("unknown template: %s", name)
+// Errors carry the template name and line (e.g., "template: dal.go.tmpl:12: ...").
+func (g *Generator) renderTemplate(name string, data interface{}) (string, error) {
+	t, err := g.loadTemplates()
+	if err != nil {
+		return "", err
 	}
 
-	tmpl, err := template.New(name).Parse(templateContent)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse template %s: %w", name, err)
+	if t.Lookup(name) == nil {
+		return "", fmt.Errorf("unknown template: %s", name)
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("failed to execute template %s: %w", name, err)
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
 	}
 
 	return buf.String(), nil
@@ -0,0 +1,75 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"fmt"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testgen"
+)
+
+// GenerateTests generates round-trip tests for the Datastore converters with the
+// built-in templates (see Generator.GenerateTests).
+func GenerateTests(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	return builtinGenerator.GenerateTests(messages)
+}
+
+// GenerateTests generates round-trip tests for the Datastore converters.
+//
+// One "{file}_converters_test.go" is written per proto file with converters,
+// in the same package as the converters. Each test fills random API messages,
+// runs XToXDatastore then XFromXDatastore and compares the result with proto.Equal.
+// Fields the converters do not restore (skip_field, oneof members, fields
+// without a conversion) are cleared first, and lossy conversions declared in
+// the IR (e.g., Timestamp -> int64 seconds) are normalised explicitly.
+//
+// Parameters:
+//   - messages: Collected Datastore messages from the collector
+//
+// Returns:
+//   - GenerateResult containing one test file per proto file with converters
+//   - error if generation fails
+func (g *Generator) GenerateTests(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	docs, err := BuildIR(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	fileGroups := common.GroupMessagesByFile(messages)
+
+	files := []*GeneratedFile{}
+	for _, doc := range docs {
+		data, err := testgen.BuildFileData(doc, fileGroups[doc.ProtoFile])
+		if err != nil {
+			return nil, fmt.Errorf("failed to build test data for %s: %w", doc.ProtoFile, err)
+		}
+		if data == nil {
+			continue
+		}
+
+		content, err := g.renderTemplate("converters_test.go.tmpl", data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate tests for %s: %w", doc.ProtoFile, err)
+		}
+		files = append(files, &GeneratedFile{
+			Path:    testgen.Filename(doc.ProtoFile),
+			Content: content,
+		})
+	}
+
+	return &GenerateResult{Files: files}, nil
+}
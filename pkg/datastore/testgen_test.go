@@ -0,0 +1,86 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// TestGenerateTests verifies that a round-trip test is generated per
+// Datastore converter pair and that skipped fields are cleared.
+func TestGenerateTests(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "api/v1/user.proto",
+				Pkg:  "api.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "password", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name: "datastore/user.proto",
+				Pkg:  "datastore",
+				Messages: []testutil.TestMessage{
+					{
+						Name:          "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "api.v1.User", Kind: "User"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "password", Number: 2, TypeName: "string", SkipField: true},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetDatastore)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateTests(messages)
+	if err != nil {
+		t.Fatalf("GenerateTests failed: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].Path != "datastore/user_converters_test.go" {
+		t.Fatalf("Expected one test file, got %v", result.Files)
+	}
+
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"// Code generated by protoc-gen-dal-datastore. DO NOT EDIT.",
+		"func TestUserToUserDatastoreRoundTrip(t *testing.T) {",
+		"got, err := UserFromUserDatastore(nil, target, nil)",
+		`"password", // skip_field`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated test to contain %q\n%s", want, content)
+		}
+	}
+}
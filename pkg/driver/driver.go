@@ -0,0 +1,428 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package driver runs the collector → generator pipeline for one or more
+// targets and writes the results into a protogen plugin response.
+//
+// It is shared by protoc-gen-dal (multi-target) and the single-target
+// protoc-gen-dal-gorm / protoc-gen-dal-datastore binaries so that every
+// plugin honours the same options.
+package driver
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/datastore"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/types"
+	"github.com/panyam/protoc-gen-dal/pkg/gorm"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+)
+
+// Options contains the settings shared by all targets.
+type Options struct {
+	GenerateDAL       bool   // Generate DAL helper methods
+	DALFilenameSuffix string // e.g., "_dal" -> "user_gorm_dal.go"
+	DALFilenamePrefix string // e.g., "dal_" -> "dal_user_gorm.go"
+	DALOutputDir      string // e.g., "dal" -> DAL files go to "<out>/dal/"
+	EntityImportPath  string // Import path of the output root (auto-detected if empty)
+
+	// TargetOutputDirs places each target's files in a subdirectory of the
+	// plugin output, keyed by target name (e.g., {"gorm": "gorm"}).
+	// When set, EntityImportPath is extended with the same subdirectory.
+	TargetOutputDirs map[string]string
+
+	// TemplateDir holds user templates, one subdirectory per target
+	// (e.g., "<dir>/gorm/dal.go.tmpl" overrides the GORM DAL template).
+	TemplateDir string
+
+	// ExtraTemplates names additional templates in TemplateDir that are
+	// rendered once per proto file (e.g., "metrics.go.tmpl").
+	ExtraTemplates []string
+
+	// EmitIR writes a machine-readable dump of each target's intermediate
+	// representation next to the generated code ("" disables, "json" writes
+	// "{file}_<target>.ir.json").
+	EmitIR string
+
+	// GenerateTests writes "{file}_converters_test.go" round-trip tests for
+	// each proto file with converters (see pkg/generator/testgen).
+	GenerateTests bool
+}
+
+// Target describes how to generate code for a single target.
+type Target struct {
+	// Name is the value used in the targets= option (e.g., "gorm")
+	Name string
+
+	// Collector is the collector target used to find messages
+	Collector collector.Target
+
+	// NewGenerator returns the target's generators rendering with the given
+	// user templates (nil for the built-in templates only)
+	NewGenerator func(templates *common.TemplateOptions) *Generator
+
+	// TemplateNames lists the built-in templates that can be overridden
+	TemplateNames func() []string
+
+	// GenerateIR produces the IR dump (only called when EmitIR is set)
+	GenerateIR func(messages []*collector.MessageInfo) (*types.GenerateResult, error)
+
+	// BuildIR builds the IR documents without rendering them (used by the lint plugin)
+	BuildIR func(messages []*collector.MessageInfo) ([]*ir.Document, error)
+}
+
+// Generator holds a target's template-rendering generators, bound to one set
+// of user templates so that targets and concurrent runs do not share them.
+type Generator struct {
+	// Generate produces the entity structs
+	Generate func(messages []*collector.MessageInfo) (*types.GenerateResult, error)
+
+	// GenerateConverters produces the API <-> entity converters
+	GenerateConverters func(messages []*collector.MessageInfo) (*types.GenerateResult, error)
+
+	// GenerateDALHelpers produces the DAL helpers (only called when GenerateDAL is set)
+	GenerateDALHelpers func(messages []*collector.MessageInfo, opts *Options, entityImportPath string) (*types.GenerateResult, error)
+
+	// GenerateExtras renders user-supplied extra templates
+	GenerateExtras func(messages []*collector.MessageInfo) (*types.GenerateResult, error)
+
+	// GenerateTests produces the converter round-trip tests (only called when GenerateTests is set)
+	GenerateTests func(messages []*collector.MessageInfo) (*types.GenerateResult, error)
+}
+
+// targets holds all supported targets keyed by name.
+var targets = map[string]*Target{
+	"gorm": {
+		Name:      "gorm",
+		Collector: collector.TargetGorm,
+		NewGenerator: func(templates *common.TemplateOptions) *Generator {
+			g := gorm.NewGenerator(templates)
+			return &Generator{
+				Generate:           g.Generate,
+				GenerateConverters: g.GenerateConverters,
+				GenerateDALHelpers: func(messages []*collector.MessageInfo, opts *Options, entityImportPath string) (*types.GenerateResult, error) {
+					return g.GenerateDALHelpers(messages, &gorm.DALOptions{
+						FilenameSuffix:   opts.DALFilenameSuffix,
+						FilenamePrefix:   opts.DALFilenamePrefix,
+						OutputDir:        opts.DALOutputDir,
+						EntityImportPath: entityImportPath,
+					})
+				},
+				GenerateExtras: g.GenerateExtras,
+				GenerateTests:  g.GenerateTests,
+			}
+		},
+		TemplateNames: gorm.TemplateNames,
+		GenerateIR:    gorm.GenerateIR,
+		BuildIR:       gorm.BuildIR,
+	},
+	"datastore": {
+		Name:      "datastore",
+		Collector: collector.TargetDatastore,
+		NewGenerator: func(templates *common.TemplateOptions) *Generator {
+			g := datastore.NewGenerator(templates)
+			return &Generator{
+				Generate:           g.Generate,
+				GenerateConverters: g.GenerateConverters,
+				GenerateDALHelpers: func(messages []*collector.MessageInfo, opts *Options, entityImportPath string) (*types.GenerateResult, error) {
+					return g.GenerateDALHelpers(messages, &datastore.DALOptions{
+						FilenameSuffix:   opts.DALFilenameSuffix,
+						FilenamePrefix:   opts.DALFilenamePrefix,
+						OutputDir:        opts.DALOutputDir,
+						EntityImportPath: entityImportPath,
+					})
+				},
+				GenerateExtras: g.GenerateExtras,
+				GenerateTests:  g.GenerateTests,
+			}
+		},
+		TemplateNames: datastore.TemplateNames,
+		GenerateIR:    datastore.GenerateIR,
+		BuildIR:       datastore.BuildIR,
+	},
+}
+
+// TargetNames returns the names of all supported targets in sorted order.
+func TargetNames() []string {
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupTarget returns the target with the given name.
+func LookupTarget(name string) (*Target, bool) {
+	target, ok := targets[name]
+	return target, ok
+}
+
+// ParseTargets parses a comma-separated list of target names.
+//
+// Whitespace and empty entries are ignored and duplicates are removed while
+// preserving order.
+//
+// Examples:
+//   - ParseTargets("gorm,datastore") -> ["gorm", "datastore"]
+//   - ParseTargets("gorm, gorm") -> ["gorm"]
+//   - ParseTargets("mysql") -> error (unknown target)
+func ParseTargets(value string) ([]string, error) {
+	var result []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := targets[name]; !ok {
+			return nil, fmt.Errorf("unknown target %q (supported: %s)", name, strings.Join(TargetNames(), ", "))
+		}
+		seen[name] = true
+		result = append(result, name)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no targets specified (supported: %s)", strings.Join(TargetNames(), ", "))
+	}
+	return result, nil
+}
+
+// SplitList splits a comma-separated option value, dropping empty entries.
+func SplitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ParamFunc returns a protogen ParamFunc that sets flags on fs.
+//
+// protoc joins plugin options with commas, so "targets=gorm,datastore" reaches
+// the plugin as "targets=gorm" followed by a bare "datastore". Bare parameters
+// that follow one of listFlags are appended to that flag's value instead of
+// being treated as unknown flags.
+func ParamFunc(fs *flag.FlagSet, listFlags ...string) func(name, value string) error {
+	lists := make(map[string]bool)
+	for _, name := range listFlags {
+		lists[name] = true
+	}
+
+	lastList := ""
+	return func(name, value string) error {
+		if value == "" && lastList != "" && fs.Lookup(name) == nil {
+			current := fs.Lookup(lastList).Value.String()
+			return fs.Set(lastList, current+","+name)
+		}
+
+		lastList = ""
+		if lists[name] {
+			lastList = name
+		}
+		return fs.Set(name, value)
+	}
+}
+
+// Generate runs the collector and generators for each named target and
+// returns all generated files.
+//
+// Targets with no annotated messages are skipped. An error is returned if two
+// targets would write the same output path; use Options.TargetOutputDirs to
+// separate them.
+func Generate(plugin *protogen.Plugin, targetNames []string, opts *Options) ([]*types.GeneratedFile, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if opts.EmitIR != "" && opts.EmitIR != "json" {
+		return nil, fmt.Errorf("unsupported emit_ir format %q (supported: json)", opts.EmitIR)
+	}
+
+	templateOpts, err := loadTemplateOptions(targetNames, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*types.GeneratedFile
+	owners := make(map[string]string) // output path -> target name
+	for _, name := range targetNames {
+		target := targets[name]
+
+		targetFiles, err := generateTarget(plugin, target, target.NewGenerator(templateOpts[name]), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range targetFiles {
+			if owner, exists := owners[file.Path]; exists {
+				return nil, fmt.Errorf("targets %q and %q both generate %s; set %s_out_dir to separate them",
+					owner, name, file.Path, name)
+			}
+			owners[file.Path] = name
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// loadTemplateOptions loads the user templates for each target from
+// <TemplateDir>/<target>/.
+//
+// Every name in ExtraTemplates must be found for at least one target.
+func loadTemplateOptions(targetNames []string, opts *Options) (map[string]*common.TemplateOptions, error) {
+	result := make(map[string]*common.TemplateOptions)
+	for _, name := range targetNames {
+		if _, ok := targets[name]; !ok {
+			return nil, fmt.Errorf("unknown target %q (supported: %s)", name, strings.Join(TargetNames(), ", "))
+		}
+	}
+
+	if opts.TemplateDir == "" {
+		if len(opts.ExtraTemplates) > 0 {
+			return nil, fmt.Errorf("extra_templates requires template_dir")
+		}
+		return result, nil
+	}
+
+	if info, err := os.Stat(opts.TemplateDir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("template_dir %s is not a directory", opts.TemplateDir)
+	}
+
+	found := make(map[string]bool)
+	for _, name := range targetNames {
+		target := targets[name]
+		dir := filepath.Join(opts.TemplateDir, name)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		templateOpts, err := common.LoadTemplateOptions(dir, target.TemplateNames(), opts.ExtraTemplates)
+		if err != nil {
+			return nil, err
+		}
+		for _, extra := range templateOpts.Extras {
+			found[extra.Name] = true
+		}
+		result[name] = templateOpts
+	}
+
+	for _, extra := range opts.ExtraTemplates {
+		if !found[extra] {
+			return nil, fmt.Errorf("extra template %s not found under %s/<target>/ for targets %s",
+				extra, opts.TemplateDir, strings.Join(targetNames, ", "))
+		}
+	}
+	return result, nil
+}
+
+// Run generates files for each named target and writes them to the plugin response.
+func Run(plugin *protogen.Plugin, targetNames []string, opts *Options) error {
+	files, err := Generate(plugin, targetNames, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, genFile := range files {
+		// The second parameter is the Go import path for this generated file
+		f := plugin.NewGeneratedFile(genFile.Path, protogen.GoImportPath(genFile.Path))
+		f.P(genFile.Content)
+	}
+	return nil
+}
+
+// generateTarget runs the full pipeline for a single target, rendering with gen.
+func generateTarget(plugin *protogen.Plugin, target *Target, gen *Generator, opts *Options) ([]*types.GeneratedFile, error) {
+	// Phase 1: Collect messages for this target
+	messages, err := collector.CollectMessages(plugin, target.Collector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect %s messages: %w", target.Name, err)
+	}
+
+	if len(messages) == 0 {
+		// No messages for this target - this is not an error, just skip
+		return nil, nil
+	}
+
+	// Phase 2: Generate entity struct code
+	result, err := gen.Generate(messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s code: %w", target.Name, err)
+	}
+	files := result.Files
+
+	// Phase 3: Generate converter code
+	converterResult, err := gen.GenerateConverters(messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s converter code: %w", target.Name, err)
+	}
+	files = append(files, converterResult.Files...)
+
+	// Phase 3.5: Render user-supplied extra templates
+	extrasResult, err := gen.GenerateExtras(messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s extra templates: %w", target.Name, err)
+	}
+	files = append(files, extrasResult.Files...)
+
+	outDir := strings.Trim(opts.TargetOutputDirs[target.Name], "/")
+
+	// Phase 4: Generate DAL helper code (if enabled)
+	if opts.GenerateDAL {
+		entityImportPath := opts.EntityImportPath
+		if entityImportPath != "" && outDir != "" {
+			entityImportPath = entityImportPath + "/" + outDir
+		}
+		dalResult, err := gen.GenerateDALHelpers(messages, opts, entityImportPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s DAL helper code: %w", target.Name, err)
+		}
+		files = append(files, dalResult.Files...)
+	}
+
+	// Phase 5: Dump the intermediate representation (if enabled)
+	if opts.EmitIR != "" {
+		irResult, err := target.GenerateIR(messages)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s IR: %w", target.Name, err)
+		}
+		files = append(files, irResult.Files...)
+	}
+
+	// Phase 6: Generate converter round-trip tests (if enabled)
+	if opts.GenerateTests {
+		testsResult, err := gen.GenerateTests(messages)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s converter tests: %w", target.Name, err)
+		}
+		files = append(files, testsResult.Files...)
+	}
+
+	if outDir != "" {
+		for _, file := range files {
+			file.Path = outDir + "/" + file.Path
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,459 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// createMultiTargetPlugin builds a proto set with one API message and both a
+// GORM and a Datastore sidecar for it, each in its own proto file.
+func createMultiTargetPlugin(t *testing.T) *protogen.Plugin {
+	t.Helper()
+
+	return testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "api/v1/user.proto",
+				Pkg:  "api.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name: "gorm/user.proto",
+				Pkg:  "gorm",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "UserGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.User", Table: "users"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}}},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name: "datastore/user.proto",
+				Pkg:  "datastore",
+				Messages: []testutil.TestMessage{
+					{
+						Name:          "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "api.v1.User", Kind: "User"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "name", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// filePaths returns the paths of generated files for easy assertions.
+func filePaths(t *testing.T, plugin *protogen.Plugin, targetNames []string, opts *Options) map[string]string {
+	t.Helper()
+
+	files, err := Generate(plugin, targetNames, opts)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	paths := make(map[string]string)
+	for _, f := range files {
+		paths[f.Path] = f.Content
+	}
+	return paths
+}
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{input: "gorm", want: []string{"gorm"}},
+		{input: "gorm,datastore", want: []string{"gorm", "datastore"}},
+		{input: " datastore , gorm ,gorm", want: []string{"datastore", "gorm"}},
+		{input: "gorm,mysql", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTargets(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseTargets(%q) expected error, got %v", tt.input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseTargets(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("ParseTargets(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestParamFunc_CommaSeparatedTargets verifies that "targets=gorm,datastore"
+// survives protoc splitting the parameter string on commas.
+func TestParamFunc_CommaSeparatedTargets(t *testing.T) {
+	var flags flag.FlagSet
+	targets := flags.String("targets", "gorm", "")
+	generateDAL := flags.Bool("generate_dal", false, "")
+
+	set := ParamFunc(&flags, "targets")
+	for _, p := range [][2]string{{"targets", "datastore"}, {"gorm", ""}, {"generate_dal", "true"}} {
+		if err := set(p[0], p[1]); err != nil {
+			t.Fatalf("set(%q, %q) failed: %v", p[0], p[1], err)
+		}
+	}
+
+	if *targets != "datastore,gorm" {
+		t.Errorf("Expected targets 'datastore,gorm', got %q", *targets)
+	}
+	if !*generateDAL {
+		t.Error("Expected generate_dal to be true")
+	}
+
+	// A bare unknown name that does not follow a list flag is still an error
+	if err := set("bogus", ""); err == nil {
+		t.Error("Expected error for unknown parameter")
+	}
+}
+
+func TestGenerate_MultipleTargets(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	paths := filePaths(t, plugin, []string{"gorm", "datastore"}, &Options{
+		GenerateDAL:       true,
+		DALFilenameSuffix: "_dal",
+	})
+
+	for _, want := range []string{
+		"gorm/user_gorm.go",
+		"gorm/user_converters.go",
+		"gorm/user_gorm_dal.go",
+		"datastore/user.go",
+		"datastore/user_converters.go",
+		"datastore/user_datastore_dal.go",
+	} {
+		if _, ok := paths[want]; !ok {
+			t.Errorf("Expected generated file %s, got %v", want, paths)
+		}
+	}
+
+	if !strings.Contains(paths["gorm/user_gorm.go"], "type UserGORM struct") {
+		t.Error("Expected GORM struct in gorm output")
+	}
+	if !strings.Contains(paths["datastore/user.go"], "type UserDatastore struct") {
+		t.Error("Expected Datastore struct in datastore output")
+	}
+}
+
+// TestGenerate_SingleTargetIgnoresOthers verifies that a target only picks up
+// messages annotated for it.
+func TestGenerate_SingleTargetIgnoresOthers(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	paths := filePaths(t, plugin, []string{"datastore"}, &Options{})
+
+	for path := range paths {
+		if strings.Contains(path, "gorm") {
+			t.Errorf("Expected no GORM files for datastore target, got %s", path)
+		}
+	}
+	if _, ok := paths["datastore/user.go"]; !ok {
+		t.Errorf("Expected datastore/user.go, got %v", paths)
+	}
+}
+
+func TestGenerate_TargetOutputDirs(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	paths := filePaths(t, plugin, []string{"gorm", "datastore"}, &Options{
+		GenerateDAL:       true,
+		DALFilenameSuffix: "_dal",
+		DALOutputDir:      "dal",
+		EntityImportPath:  "github.com/example/gen",
+		TargetOutputDirs:  map[string]string{"gorm": "gorm", "datastore": "ds/"},
+	})
+
+	for _, want := range []string{
+		"gorm/gorm/user_gorm.go",
+		"gorm/dal/gorm/user_gorm_dal.go",
+		"ds/datastore/user.go",
+		"ds/dal/datastore/user_datastore_dal.go",
+	} {
+		if _, ok := paths[want]; !ok {
+			t.Errorf("Expected generated file %s, got %v", want, paths)
+		}
+	}
+
+	// Entity import path is extended with the target's output dir
+	if !strings.Contains(paths["gorm/dal/gorm/user_gorm_dal.go"], `"github.com/example/gen/gorm/gorm"`) {
+		t.Error("Expected GORM DAL to import entity package under gorm_out_dir")
+	}
+	if !strings.Contains(paths["ds/dal/datastore/user_datastore_dal.go"], `"github.com/example/gen/ds/datastore"`) {
+		t.Error("Expected Datastore DAL to import entity package under datastore_out_dir")
+	}
+}
+
+// TestGenerate_PathCollision verifies that two targets writing the same file
+// is reported rather than silently overwritten.
+func TestGenerate_PathCollision(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "api/v1/user.proto",
+				Pkg:  "api.v1",
+				Messages: []testutil.TestMessage{
+					{Name: "User", Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}}},
+				},
+			},
+			{
+				Name: "dal/v1/user.proto",
+				Pkg:  "dal.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "UserGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.User"},
+						Fields:   []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}},
+					},
+					{
+						Name:          "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "api.v1.User", Kind: "User"},
+						Fields:        []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}},
+					},
+				},
+			},
+		},
+	})
+
+	_, err := Generate(plugin, []string{"gorm", "datastore"}, &Options{})
+	if err == nil {
+		t.Fatal("Expected collision error for dal/v1/user_converters.go")
+	}
+	if !strings.Contains(err.Error(), "datastore_out_dir") {
+		t.Errorf("Expected error to suggest datastore_out_dir, got: %v", err)
+	}
+
+	// Separating the targets resolves the collision
+	if _, err := Generate(plugin, []string{"gorm", "datastore"}, &Options{
+		TargetOutputDirs: map[string]string{"datastore": "datastore"},
+	}); err != nil {
+		t.Errorf("Expected no error with datastore_out_dir, got: %v", err)
+	}
+}
+
+// TestGenerate_TemplateOverridesAndExtras verifies that template_dir overrides
+// built-in templates per target and that extra templates add outputs.
+func TestGenerate_TemplateOverridesAndExtras(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "gorm", "dal.go.tmpl"),
+		`package {{ .PackageName }}
+{{ range .DALs }}// Custom DAL for {{ .StructName }}
+{{ end }}`)
+	writeFile(t, filepath.Join(dir, "gorm", "metrics.go.tmpl"),
+		`package {{ .Entities.PackageName }}
+// Proto: {{ .ProtoFile }}
+{{ range .Converters.Converters }}// Converter: {{ .TargetType }}
+{{ end }}`)
+	writeFile(t, filepath.Join(dir, "datastore", "metrics.go.tmpl"),
+		`package {{ .Entities.Package }}
+{{ range .Entities.Structs }}// Kind: {{ .Kind }}
+{{ end }}`)
+
+	paths := filePaths(t, plugin, []string{"gorm", "datastore"}, &Options{
+		GenerateDAL:       true,
+		DALFilenameSuffix: "_dal",
+		TemplateDir:       dir,
+		ExtraTemplates:    []string{"metrics.go.tmpl"},
+	})
+
+	if got := paths["gorm/user_gorm_dal.go"]; !strings.Contains(got, "// Custom DAL for UserGORM") {
+		t.Errorf("Expected overridden GORM DAL template, got:\n%s", got)
+	}
+	if got := paths["datastore/user_datastore_dal.go"]; !strings.Contains(got, "type UserDatastoreDAL struct") {
+		t.Errorf("Expected built-in Datastore DAL template, got:\n%s", got)
+	}
+	if got := paths["gorm/user_metrics.go"]; !strings.Contains(got, "// Proto: gorm/user.proto") || !strings.Contains(got, "// Converter: UserGORM") {
+		t.Errorf("Expected GORM extra output, got:\n%s", got)
+	}
+	if got := paths["datastore/user_metrics.go"]; !strings.Contains(got, "// Kind: User") {
+		t.Errorf("Expected Datastore extra output, got:\n%s", got)
+	}
+
+	// Built-in templates are restored after the run
+	restored := filePaths(t, createMultiTargetPlugin(t), []string{"gorm"}, &Options{GenerateDAL: true, DALFilenameSuffix: "_dal"})
+	if !strings.Contains(restored["gorm/user_gorm_dal.go"], "type UserGORMDAL struct") {
+		t.Error("Expected built-in GORM DAL template after run")
+	}
+}
+
+// TestGenerate_ConcurrentTemplateOptions verifies that runs with and without
+// user templates do not see each other's templates.
+func TestGenerate_ConcurrentTemplateOptions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "gorm", "dal.go.tmpl"),
+		`package {{ .PackageName }}
+{{ range .DALs }}// Custom DAL for {{ .StructName }}
+{{ end }}`)
+
+	const runs = 8
+	results := make([]map[string]string, runs)
+	var wg sync.WaitGroup
+	for i := range runs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			opts := &Options{GenerateDAL: true, DALFilenameSuffix: "_dal"}
+			if i%2 == 0 {
+				opts.TemplateDir = dir
+			}
+			files, err := Generate(createMultiTargetPlugin(t), []string{"gorm"}, opts)
+			if err != nil {
+				t.Errorf("Generate failed: %v", err)
+				return
+			}
+			results[i] = make(map[string]string)
+			for _, file := range files {
+				results[i][file.Path] = file.Content
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, paths := range results {
+		custom := strings.Contains(paths["gorm/user_gorm_dal.go"], "// Custom DAL for UserGORM")
+		if custom != (i%2 == 0) {
+			t.Errorf("run %d: custom DAL template = %v, want %v", i, custom, i%2 == 0)
+		}
+	}
+}
+
+// TestGenerate_TemplateExecutionErrorHasNameAndLine verifies that errors in
+// user templates report the template name and line.
+func TestGenerate_TemplateExecutionErrorHasNameAndLine(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "gorm", "converters.go.tmpl"), "package x\n\n{{ .NoSuchField }}\n")
+
+	_, err := Generate(plugin, []string{"gorm"}, &Options{TemplateDir: dir})
+	if err == nil {
+		t.Fatal("Expected template execution error")
+	}
+	if !strings.Contains(err.Error(), "converters.go.tmpl:3") {
+		t.Errorf("Expected error to contain template name and line, got: %v", err)
+	}
+}
+
+func TestGenerate_MissingExtraTemplate(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	_, err := Generate(plugin, []string{"gorm"}, &Options{
+		TemplateDir:    t.TempDir(),
+		ExtraTemplates: []string{"metrics.go.tmpl"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "metrics.go.tmpl") {
+		t.Errorf("Expected error naming the missing extra template, got: %v", err)
+	}
+}
+
+func TestGenerate_EmitIR(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	paths := filePaths(t, plugin, []string{"gorm", "datastore"}, &Options{
+		EmitIR:           "json",
+		TargetOutputDirs: map[string]string{"datastore": "ds"},
+	})
+
+	for _, want := range []string{"gorm/user_gorm.ir.json", "ds/datastore/user_datastore.ir.json"} {
+		if !strings.Contains(paths[want], `"version": 1`) {
+			t.Errorf("Expected IR document %s, got %v", want, paths)
+		}
+	}
+
+	// Without emit_ir no IR files are written
+	for path := range filePaths(t, plugin, []string{"gorm"}, &Options{}) {
+		if strings.HasSuffix(path, ".ir.json") {
+			t.Errorf("Expected no IR files by default, got %s", path)
+		}
+	}
+}
+
+func TestGenerate_EmitIRUnsupportedFormat(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	_, err := Generate(plugin, []string{"gorm"}, &Options{EmitIR: "yaml"})
+	if err == nil || !strings.Contains(err.Error(), "yaml") {
+		t.Errorf("Expected error naming the unsupported format, got: %v", err)
+	}
+}
+
+func TestGenerate_GenerateTests(t *testing.T) {
+	plugin := createMultiTargetPlugin(t)
+
+	paths := filePaths(t, plugin, []string{"gorm", "datastore"}, &Options{GenerateTests: true})
+	for path, want := range map[string]string{
+		"gorm/user_converters_test.go":      "func TestUserToUserGORMRoundTrip(t *testing.T) {",
+		"datastore/user_converters_test.go": "func TestUserToUserDatastoreRoundTrip(t *testing.T) {",
+	} {
+		if !strings.Contains(paths[path], want) {
+			t.Errorf("Expected %s to contain %q, got %v", path, want, paths[path])
+		}
+	}
+
+	// Without generate_tests no test files are written
+	for path := range filePaths(t, plugin, []string{"gorm"}, &Options{}) {
+		if strings.HasSuffix(path, "_test.go") {
+			t.Errorf("Expected no test files by default, got %s", path)
+		}
+	}
+}
+
+// writeFile writes content to path, creating parent directories.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
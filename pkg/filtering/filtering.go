@@ -0,0 +1,273 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filtering translates AIP-160 filter strings, as accepted by List
+// RPCs, into database queries.
+//
+// A Schema lists the fields of an API message that may be filtered on and
+// the column (or Datastore property) each one is stored in. DALs generated by
+// protoc-gen-dal declare one per entity (e.g., NoteGORMFilterSchema), derived
+// from the source message's fields that are stored unconverted.
+//
+// Parse checks a filter against a schema:
+//
+//	title = "Dune" AND (year >= 1965 OR NOT archived = true) AND created_at > "2024-01-01T00:00:00Z"
+//
+// Literals are typed by the field they are compared with: strings, numbers,
+// true/false, enum value names and RFC 3339 timestamps. String equality
+// accepts "*" wildcards. Unknown fields, values of the wrong type and syntax
+// errors are returned as *Error, which matches ErrInvalidFilter with
+// errors.Is and is meant to be reported as InvalidArgument.
+//
+// The parsed expression is translated with SQL (used by the generated GORM
+// FilterScope) or folded into any other query form with Build (used by the
+// generated Datastore Filter).
+package filtering
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrInvalidFilter is matched (with errors.Is) by every error Parse returns.
+var ErrInvalidFilter = errors.New("filtering: invalid filter")
+
+// Error describes why a filter string was rejected.
+type Error struct {
+	Filter string // The filter string
+	Pos    int    // Byte offset of the problem in Filter
+	Msg    string // What is wrong at Pos
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Pos+1, e.Msg)
+}
+
+// Is reports whether target is ErrInvalidFilter.
+func (e *Error) Is(target error) bool {
+	return target == ErrInvalidFilter
+}
+
+// Field is a filterable field of a Schema.
+type Field struct {
+	Path   string                       // Field path in filters (e.g., "created_at")
+	Column string                       // Column or property the field is stored in
+	Desc   protoreflect.FieldDescriptor // The API field, which types the field's literals
+}
+
+// Schema is the allowlist of fields a filter may use.
+type Schema struct {
+	message protoreflect.MessageDescriptor
+	fields  map[string]*Field
+}
+
+// NewSchema returns a schema for filtering desc's messages, where columns
+// maps each filterable field path (dotted for nested messages) to the column
+// storing it.
+//
+// Returns an error if a path does not name a field of desc, or names one
+// that cannot be compared: bytes, repeated, map and message fields other
+// than google.protobuf.Timestamp.
+func NewSchema(desc protoreflect.MessageDescriptor, columns map[string]string) (*Schema, error) {
+	schema := &Schema{message: desc, fields: make(map[string]*Field, len(columns))}
+	for path, column := range columns {
+		field, err := resolvePath(desc, path)
+		if err != nil {
+			return nil, fmt.Errorf("filtering: %s: %w", desc.FullName(), err)
+		}
+		if column == "" {
+			return nil, fmt.Errorf("filtering: %s: field %q has no column", desc.FullName(), path)
+		}
+		schema.fields[path] = &Field{Path: path, Column: column, Desc: field}
+	}
+	return schema, nil
+}
+
+// MustSchema is like NewSchema but panics on error.
+// It is meant for package-level schema variables, like the generated ones.
+func MustSchema(desc protoreflect.MessageDescriptor, columns map[string]string) *Schema {
+	schema, err := NewSchema(desc, columns)
+	if err != nil {
+		panic(err)
+	}
+	return schema
+}
+
+// Message returns the API message the schema filters.
+func (s *Schema) Message() protoreflect.MessageDescriptor {
+	return s.message
+}
+
+// Field returns the filterable field with the given path, or nil.
+func (s *Schema) Field(path string) *Field {
+	return s.fields[path]
+}
+
+// Paths returns the filterable field paths in sorted order.
+func (s *Schema) Paths() []string {
+	paths := make([]string, 0, len(s.fields))
+	for path := range s.fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// resolvePath returns the field a dotted path names in desc.
+func resolvePath(desc protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	var field protoreflect.FieldDescriptor
+	for i, name := range names {
+		if i > 0 {
+			if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+				return nil, fmt.Errorf("field path %q: %s is not a message", path, strings.Join(names[:i], "."))
+			}
+			desc = field.Message()
+		}
+		field = desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("field path %q: no field %q in %s", path, name, desc.FullName())
+		}
+	}
+	if field.IsList() || field.IsMap() {
+		return nil, fmt.Errorf("field path %q: repeated and map fields cannot be filtered", path)
+	}
+	switch field.Kind() {
+	case protoreflect.BytesKind, protoreflect.GroupKind:
+		return nil, fmt.Errorf("field path %q: %s fields cannot be filtered", path, field.Kind())
+	case protoreflect.MessageKind:
+		if field.Message().FullName() != timestampName {
+			return nil, fmt.Errorf("field path %q: message %s cannot be filtered", path, field.Message().FullName())
+		}
+	}
+	return field, nil
+}
+
+// Op is a comparison operator.
+type Op string
+
+// Comparison operators. The AIP-160 has operator (":") is parsed as Equal.
+const (
+	Equal        Op = "="
+	NotEqual     Op = "!="
+	Less         Op = "<"
+	LessEqual    Op = "<="
+	Greater      Op = ">"
+	GreaterEqual Op = ">="
+)
+
+// negate returns the operator matching exactly the values op does not.
+func (op Op) negate() Op {
+	switch op {
+	case Equal:
+		return NotEqual
+	case NotEqual:
+		return Equal
+	case Less:
+		return GreaterEqual
+	case LessEqual:
+		return Greater
+	case Greater:
+		return LessEqual
+	default:
+		return Less
+	}
+}
+
+// Expr is a parsed filter: an And, Or or Compare.
+// Parse pushes NOT down into the comparisons, so there is no Not node.
+type Expr interface {
+	negate() Expr
+}
+
+// And matches records matched by all of its expressions.
+type And struct {
+	Exprs []Expr
+}
+
+// Or matches records matched by any of its expressions.
+type Or struct {
+	Exprs []Expr
+}
+
+// Compare compares a field with a literal.
+type Compare struct {
+	Field *Field
+	Op    Op
+	// Value is the literal typed for the field: string, bool, int64, uint64,
+	// float64, int64 for enums (the value's number) or time.Time.
+	Value any
+	// Pattern reports whether Value is a string with "*" wildcards, which
+	// only occurs with Equal and NotEqual.
+	Pattern bool
+	// Pos is the byte offset of the comparison in the filter, for errors.
+	Pos int
+}
+
+func (e *And) negate() Expr {
+	return &Or{Exprs: negateAll(e.Exprs)}
+}
+
+func (e *Or) negate() Expr {
+	return &And{Exprs: negateAll(e.Exprs)}
+}
+
+func (e *Compare) negate() Expr {
+	negated := *e
+	negated.Op = e.Op.negate()
+	return &negated
+}
+
+// negateAll returns the negations of exprs.
+func negateAll(exprs []Expr) []Expr {
+	negated := make([]Expr, len(exprs))
+	for i, expr := range exprs {
+		negated[i] = expr.negate()
+	}
+	return negated
+}
+
+// Build folds expr into a query condition of type T, e.g. a Datastore
+// EntityFilter: compare converts each comparison, and and or combine the
+// conditions of And and Or expressions.
+// Errors from compare are returned as they are.
+func Build[T any](expr Expr, compare func(*Compare) (T, error), and, or func([]T) T) (T, error) {
+	var zero T
+	var exprs []Expr
+	var combine func([]T) T
+	switch e := expr.(type) {
+	case *Compare:
+		return compare(e)
+	case *And:
+		exprs, combine = e.Exprs, and
+	case *Or:
+		exprs, combine = e.Exprs, or
+	default:
+		return zero, fmt.Errorf("filtering: unknown expression %T", expr)
+	}
+
+	conds := make([]T, len(exprs))
+	for i, sub := range exprs {
+		cond, err := Build(sub, compare, and, or)
+		if err != nil {
+			return zero, err
+		}
+		conds[i] = cond
+	}
+	return combine(conds), nil
+}
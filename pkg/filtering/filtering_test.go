@@ -0,0 +1,143 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bookDescriptor returns a test.Book message with fields of every kind a
+// filter may or may not use
+func bookDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	tags := field("tags", 8, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	tags.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/book.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("DRAFT"), Number: proto.Int32(1)},
+				{Name: proto.String("PUBLISHED"), Number: proto.Int32(2)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Book"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("title", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("year", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, ""),
+					field("rating", 3, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, ""),
+					field("archived", 4, descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""),
+					field("status", 5, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Status"),
+					field("created_at", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+					field("author", 7, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Author"),
+					tags,
+					field("cover", 9, descriptorpb.FieldDescriptorProto_TYPE_BYTES, ""),
+				},
+			},
+			{
+				Name:  proto.String("Author"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("Failed to build test descriptor: %v", err)
+	}
+	return fd.Messages().ByName("Book")
+}
+
+// bookSchema returns a schema over test.Book's filterable fields
+func bookSchema(t *testing.T) *Schema {
+	t.Helper()
+	schema, err := NewSchema(bookDescriptor(t), map[string]string{
+		"title":       "title",
+		"year":        "pub_year",
+		"rating":      "rating",
+		"archived":    "archived",
+		"status":      "status",
+		"created_at":  "created_at",
+		"author.name": "author_name",
+	})
+	if err != nil {
+		t.Fatalf("NewSchema failed: %v", err)
+	}
+	return schema
+}
+
+func TestNewSchema(t *testing.T) {
+	schema := bookSchema(t)
+	if got := strings.Join(schema.Paths(), ","); got != "archived,author.name,created_at,rating,status,title,year" {
+		t.Errorf("Unexpected paths %s", got)
+	}
+	if field := schema.Field("author.name"); field == nil || field.Column != "author_name" || field.Desc.Name() != "name" {
+		t.Errorf("Expected author.name to resolve to Author.name, got %+v", field)
+	}
+	if schema.Field("tags") != nil {
+		t.Error("Expected fields outside the allowlist to be absent")
+	}
+}
+
+func TestNewSchema_Invalid(t *testing.T) {
+	desc := bookDescriptor(t)
+	tests := map[string]string{
+		"missing":     `no field "missing" in test.Book`,
+		"author.nope": `no field "nope" in test.Author`,
+		"title.x":     "title is not a message",
+		"tags":        "repeated and map fields cannot be filtered",
+		"cover":       "bytes fields cannot be filtered",
+		"author":      "message test.Author cannot be filtered",
+	}
+	for path, want := range tests {
+		_, err := NewSchema(desc, map[string]string{path: "col"})
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected error containing %q, got %v", path, want, err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected MustSchema to panic")
+		}
+	}()
+	MustSchema(desc, map[string]string{"missing": "col"})
+}
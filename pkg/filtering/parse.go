@@ -0,0 +1,419 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// timestampName is the only message type fields can be filtered by
+const timestampName = "google.protobuf.Timestamp"
+
+// maxDepth limits the nesting of parentheses in a filter
+const maxDepth = 32
+
+// Parse parses an AIP-160 filter against schema.
+//
+// The supported grammar, in order of increasing precedence:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }            (juxtaposition is AND)
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = restriction | "(" expression ")"
+//	restriction = field comparator value
+//	comparator  = "=" | "!=" | "<" | "<=" | ">" | ">=" | ":"
+//
+// Values are quoted strings ("..." or '...') or bare words, converted to the
+// field's type. Bool and enum fields only support equality.
+//
+// Returns a nil Expr for an empty filter, and an *Error if the filter is
+// invalid.
+func Parse(schema *Schema, filter string) (Expr, error) {
+	tokens, err := lex(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{filter: filter, schema: schema, tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return nil, nil
+	}
+
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, p.errorf(tok.pos, "unexpected %q", tok.text)
+	}
+	return expr, nil
+}
+
+// tokenKind identifies the kind of a filter token
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokLParen
+	tokRParen
+	tokComparator
+	tokString // Quoted string, with text unquoted
+	tokText   // Bare word: field path, keyword or value
+	tokMinus  // "-" negating a term
+)
+
+// token is a lexed piece of a filter
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits a filter into tokens, ending with a tokEOF token
+func lex(filter string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{tokComparator, string(c), i})
+			i++
+		case c == '<' || c == '>' || c == '!':
+			end := i + 1
+			if end < len(filter) && filter[end] == '=' {
+				end++
+			}
+			if c == '!' && end == i+1 {
+				return nil, &Error{Filter: filter, Pos: i, Msg: `expected "!="`}
+			}
+			tokens = append(tokens, token{tokComparator, filter[i:end], i})
+			i = end
+		case c == '"' || c == '\'':
+			text, end, err := lexString(filter, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{tokString, text, i})
+			i = end
+		case c == '-' && (i+1 == len(filter) || !isNumberStart(filter[i+1])):
+			tokens = append(tokens, token{tokMinus, "-", i})
+			i++
+		default:
+			end := i + 1
+			for end < len(filter) && !strings.ContainsRune(" \t\n\r()=:<>!\"'", rune(filter[end])) {
+				end++
+			}
+			tokens = append(tokens, token{tokText, filter[i:end], i})
+			i = end
+		}
+	}
+	return append(tokens, token{tokEOF, "", len(filter)}), nil
+}
+
+// lexString reads the quoted string starting at start, returning its
+// unescaped text and the offset after the closing quote.
+// A backslash includes the next character as it is.
+func lexString(filter string, start int) (string, int, error) {
+	quote := filter[start]
+	var text strings.Builder
+	for i := start + 1; i < len(filter); i++ {
+		switch filter[i] {
+		case quote:
+			return text.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(filter) {
+				i++
+			}
+		}
+		text.WriteByte(filter[i])
+	}
+	return "", 0, &Error{Filter: filter, Pos: start, Msg: "unterminated string"}
+}
+
+// isNumberStart reports whether c can follow the "-" of a negative number
+func isNumberStart(c byte) bool {
+	return c >= '0' && c <= '9' || c == '.'
+}
+
+// parser is a recursive descent parser over a filter's tokens
+type parser struct {
+	filter string
+	schema *Schema
+	tokens []token
+	next   int
+	depth  int
+}
+
+// peek returns the current token
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+// advance consumes and returns the current token
+func (p *parser) advance() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokEOF {
+		p.next++
+	}
+	return tok
+}
+
+// atKeyword reports whether the current token is the keyword kw
+func (p *parser) atKeyword(kw string) bool {
+	tok := p.peek()
+	return tok.kind == tokText && tok.text == kw
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) *Error {
+	return &Error{Filter: p.filter, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// expression parses sequences joined by AND
+func (p *parser) expression() (Expr, error) {
+	exprs, err := p.list(p.sequence, "AND")
+	if err != nil {
+		return nil, err
+	}
+	return join(exprs, true), nil
+}
+
+// sequence parses juxtaposed factors, which are ANDed
+func (p *parser) sequence() (Expr, error) {
+	exprs, err := p.list(p.factor, "")
+	if err != nil {
+		return nil, err
+	}
+	return join(exprs, true), nil
+}
+
+// factor parses terms joined by OR
+func (p *parser) factor() (Expr, error) {
+	exprs, err := p.list(p.term, "OR")
+	if err != nil {
+		return nil, err
+	}
+	return join(exprs, false), nil
+}
+
+// list parses one or more items separated by keyword, or juxtaposed if
+// keyword is empty.
+func (p *parser) list(item func() (Expr, error), keyword string) ([]Expr, error) {
+	var exprs []Expr
+	for {
+		expr, err := item()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		switch {
+		case keyword != "" && p.atKeyword(keyword):
+			p.advance()
+		case keyword == "" && p.atTerm():
+		default:
+			return exprs, nil
+		}
+	}
+}
+
+// atTerm reports whether the current token can start a term
+func (p *parser) atTerm() bool {
+	tok := p.peek()
+	switch tok.kind {
+	case tokLParen, tokMinus, tokString:
+		return true
+	case tokText:
+		return tok.text != "AND" && tok.text != "OR"
+	}
+	return false
+}
+
+// term parses an optionally negated simple expression
+func (p *parser) term() (Expr, error) {
+	negated := false
+	if p.atKeyword("NOT") || p.peek().kind == tokMinus {
+		p.advance()
+		negated = true
+	}
+	expr, err := p.simple()
+	if err != nil {
+		return nil, err
+	}
+	if negated {
+		return expr.negate(), nil
+	}
+	return expr, nil
+}
+
+// simple parses a restriction or a parenthesized expression
+func (p *parser) simple() (Expr, error) {
+	if p.peek().kind != tokLParen {
+		return p.restriction()
+	}
+
+	open := p.advance()
+	if p.depth++; p.depth > maxDepth {
+		return nil, p.errorf(open.pos, "parentheses nested too deeply")
+	}
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.advance(); tok.kind != tokRParen {
+		return nil, p.errorf(tok.pos, "expected \")\" to close \"(\" at position %d", open.pos+1)
+	}
+	p.depth--
+	return expr, nil
+}
+
+// restriction parses a comparison of a schema field with a value
+func (p *parser) restriction() (Expr, error) {
+	name := p.advance()
+	if name.kind != tokText {
+		return nil, p.errorf(name.pos, "expected a field name")
+	}
+	field := p.schema.Field(name.text)
+	if field == nil {
+		return nil, p.errorf(name.pos, "unknown field %q", name.text)
+	}
+
+	comparator := p.advance()
+	if comparator.kind != tokComparator {
+		return nil, p.errorf(comparator.pos, "expected a comparison operator after %q", name.text)
+	}
+	op := Op(comparator.text)
+	if op == ":" {
+		op = Equal
+	}
+
+	arg := p.advance()
+	if arg.kind != tokText && arg.kind != tokString {
+		return nil, p.errorf(arg.pos, "expected a value to compare %q with", name.text)
+	}
+	return p.compare(field, op, arg, name.pos)
+}
+
+// compare types the value of a restriction for its field
+func (p *parser) compare(field *Field, op Op, arg token, pos int) (Expr, error) {
+	desc := field.Desc
+	expr := &Compare{Field: field, Op: op, Pos: pos}
+	invalid := func(want string) error {
+		return p.errorf(arg.pos, "field %q expects %s, got %q", field.Path, want, arg.text)
+	}
+
+	switch desc.Kind() {
+	case protoreflect.StringKind:
+		expr.Value = arg.text
+		expr.Pattern = (op == Equal || op == NotEqual) && strings.Contains(arg.text, "*")
+
+	case protoreflect.BoolKind:
+		switch arg.text {
+		case "true":
+			expr.Value = true
+		case "false":
+			expr.Value = false
+		default:
+			return nil, invalid("true or false")
+		}
+
+	case protoreflect.EnumKind:
+		value := desc.Enum().Values().ByName(protoreflect.Name(arg.text))
+		if value == nil {
+			return nil, invalid("a value of " + string(desc.Enum().FullName()))
+		}
+		expr.Value = int64(value.Number())
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(arg.text, 10, 32)
+		if err != nil {
+			return nil, invalid("a 32-bit integer")
+		}
+		expr.Value = n
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(arg.text, 10, 64)
+		if err != nil {
+			return nil, invalid("an integer")
+		}
+		expr.Value = n
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(arg.text, 10, 32)
+		if err != nil {
+			return nil, invalid("an unsigned 32-bit integer")
+		}
+		expr.Value = n
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(arg.text, 10, 64)
+		if err != nil {
+			return nil, invalid("an unsigned integer")
+		}
+		expr.Value = n
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(arg.text, 64)
+		if err != nil {
+			return nil, invalid("a number")
+		}
+		expr.Value = f
+
+	case protoreflect.MessageKind: // google.protobuf.Timestamp, checked by NewSchema
+		t, err := time.Parse(time.RFC3339Nano, arg.text)
+		if err != nil {
+			return nil, invalid("an RFC 3339 timestamp")
+		}
+		expr.Value = t
+	}
+
+	if (desc.Kind() == protoreflect.BoolKind || desc.Kind() == protoreflect.EnumKind) && op != Equal && op != NotEqual {
+		return nil, p.errorf(pos, "field %q only supports = and !=", field.Path)
+	}
+	return expr, nil
+}
+
+// join combines exprs with AND or OR, flattening nested expressions of the
+// same kind. A single expression is returned as it is.
+func join(exprs []Expr, and bool) Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	var flat []Expr
+	for _, expr := range exprs {
+		if e, ok := expr.(*And); ok && and {
+			flat = append(flat, e.Exprs...)
+		} else if e, ok := expr.(*Or); ok && !and {
+			flat = append(flat, e.Exprs...)
+		} else {
+			flat = append(flat, expr)
+		}
+	}
+	if and {
+		return &And{Exprs: flat}
+	}
+	return &Or{Exprs: flat}
+}
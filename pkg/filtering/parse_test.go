@@ -0,0 +1,128 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse_Values(t *testing.T) {
+	schema := bookSchema(t)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		filter  string
+		op      Op
+		value   any
+		pattern bool
+	}{
+		{`title = "Dune"`, Equal, "Dune", false},
+		{`title:'It\'s'`, Equal, "It's", false},
+		{`title != Dune*`, NotEqual, "Dune*", true},
+		{`title > "D*"`, Greater, "D*", false},
+		{`year >= -1965`, GreaterEqual, int64(-1965), false},
+		{`rating < 4.5`, Less, 4.5, false},
+		{`archived = false`, Equal, false, false},
+		{`status = PUBLISHED`, Equal, int64(2), false},
+		{`created_at <= "2024-01-02T03:04:05Z"`, LessEqual, created, false},
+		{`author.name = "Frank"`, Equal, "Frank", false},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(schema, tt.filter)
+		if err != nil {
+			t.Errorf("%s: Parse failed: %v", tt.filter, err)
+			continue
+		}
+		c, ok := expr.(*Compare)
+		if !ok {
+			t.Errorf("%s: expected a comparison, got %T", tt.filter, expr)
+			continue
+		}
+		if c.Op != tt.op || c.Value != tt.value || c.Pattern != tt.pattern {
+			t.Errorf("%s: got %s %#v (pattern %v), want %s %#v (pattern %v)", tt.filter, c.Op, c.Value, c.Pattern, tt.op, tt.value, tt.pattern)
+		}
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	expr, err := Parse(bookSchema(t), "  ")
+	if expr != nil || err != nil {
+		t.Errorf("Expected nil, nil for an empty filter, got %v, %v", expr, err)
+	}
+}
+
+func TestParse_Precedence(t *testing.T) {
+	schema := bookSchema(t)
+	tests := map[string]string{
+		// OR binds tighter than juxtaposition and AND
+		`year = 1 year = 2 OR year = 3 AND year = 4`: "(pub_year = ? AND (pub_year = ? OR pub_year = ?) AND pub_year = ?)",
+		`(year = 1 AND year = 2) OR year = 3`:        "((pub_year = ? AND pub_year = ?) OR pub_year = ?)",
+		// NOT is pushed down into the comparisons
+		`NOT (year < 1 OR title = "a*")`:          "(pub_year >= ? AND title NOT LIKE ? ESCAPE '!')",
+		`-year > 1 AND NOT (NOT archived = true)`: "(pub_year <= ? AND archived = ?)",
+	}
+	for filter, want := range tests {
+		expr, err := Parse(schema, filter)
+		if err != nil {
+			t.Errorf("%s: Parse failed: %v", filter, err)
+			continue
+		}
+		if got, _ := SQL(expr); got != want {
+			t.Errorf("%s:\n got %s\nwant %s", filter, got, want)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	schema := bookSchema(t)
+	tests := []struct {
+		filter string
+		pos    int
+		want   string
+	}{
+		{`tags = "x"`, 1, `unknown field "tags"`},
+		{`year = "abc"`, 8, `field "year" expects a 32-bit integer, got "abc"`},
+		{`year = 99999999999`, 8, "32-bit integer"},
+		{`archived = yes`, 12, "expects true or false"},
+		{`status = GONE`, 10, "expects a value of test.Status"},
+		{`status > DRAFT`, 1, `field "status" only supports = and !=`},
+		{`created_at > "yesterday"`, 14, "RFC 3339 timestamp"},
+		{`title`, 6, `expected a comparison operator after "title"`},
+		{`title =`, 8, `expected a value to compare "title" with`},
+		{`"title" = x`, 1, "expected a field name"},
+		{`title = "x`, 9, "unterminated string"},
+		{`title ! "x"`, 7, `expected "!="`},
+		{`(title = x`, 11, `expected ")" to close "(" at position 1`},
+		{`title = x)`, 10, `unexpected ")"`},
+		{`title = x AND`, 14, "expected a field name"},
+		{strings.Repeat("(", 40) + "title = x" + strings.Repeat(")", 40), 33, "nested too deeply"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(schema, tt.filter)
+		var ferr *Error
+		if !errors.As(err, &ferr) {
+			t.Errorf("%s: expected an *Error, got %v", tt.filter, err)
+			continue
+		}
+		if !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%s: expected the error to match ErrInvalidFilter", tt.filter)
+		}
+		if ferr.Pos+1 != tt.pos || !strings.Contains(ferr.Msg, tt.want) {
+			t.Errorf("%s: got %q, want position %d and %q", tt.filter, err, tt.pos, tt.want)
+		}
+	}
+}
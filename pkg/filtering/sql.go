@@ -0,0 +1,55 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"strings"
+)
+
+// likeEscaper escapes LIKE metacharacters with "!", which (unlike "\") means
+// the same in the string literals of every SQL dialect
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "*", "%")
+
+// SQL returns expr as a SQL condition with "?" placeholders and the
+// arguments for them, e.g. for GORM:
+//
+//	cond, args := filtering.SQL(expr)
+//	query = query.Where(cond, args...)
+//
+// Columns are used as they appear in the schema. Wildcard patterns become
+// LIKE conditions, so their case sensitivity is the database's.
+func SQL(expr Expr) (string, []any) {
+	var args []any
+	compare := func(c *Compare) (string, error) {
+		if c.Pattern {
+			args = append(args, likeEscaper.Replace(c.Value.(string)))
+			if c.Op == NotEqual {
+				return c.Field.Column + " NOT LIKE ? ESCAPE '!'", nil
+			}
+			return c.Field.Column + " LIKE ? ESCAPE '!'", nil
+		}
+		args = append(args, c.Value)
+		return c.Field.Column + " " + string(c.Op) + " ?", nil
+	}
+	joiner := func(sep string) func([]string) string {
+		return func(conds []string) string {
+			return "(" + strings.Join(conds, sep) + ")"
+		}
+	}
+
+	// compare never fails
+	cond, _ := Build(expr, compare, joiner(" AND "), joiner(" OR "))
+	return cond, args
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSQL(t *testing.T) {
+	schema := bookSchema(t)
+	tests := []struct {
+		filter string
+		cond   string
+		args   []any
+	}{
+		{`title = "Dune" AND year > 1960`, "(title = ? AND pub_year > ?)", []any{"Dune", int64(1960)}},
+		{`author.name = "Frank*"`, "author_name LIKE ? ESCAPE '!'", []any{"Frank%"}},
+		{`title = "*100%_off!*"`, "title LIKE ? ESCAPE '!'", []any{"%100!%!_off!!%"}},
+		{`status = DRAFT OR archived = true`, "(status = ? OR archived = ?)", []any{int64(1), true}},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(schema, tt.filter)
+		if err != nil {
+			t.Errorf("%s: Parse failed: %v", tt.filter, err)
+			continue
+		}
+		cond, args := SQL(expr)
+		if cond != tt.cond || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("%s:\n got %s %v\nwant %s %v", tt.filter, cond, args, tt.cond, tt.args)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	expr, err := Parse(bookSchema(t), `year = 1 (title = a OR title = b*)`)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	joiner := func(name string) func([]string) string {
+		return func(conds []string) string { return name + "[" + strings.Join(conds, " ") + "]" }
+	}
+	compare := func(c *Compare) (string, error) {
+		if c.Pattern {
+			return "", fmt.Errorf("no patterns at %d", c.Pos)
+		}
+		return fmt.Sprintf("%s%s%v", c.Field.Column, c.Op, c.Value), nil
+	}
+
+	if _, err := Build(expr, compare, joiner("and"), joiner("or")); err == nil || err.Error() != "no patterns at 23" {
+		t.Errorf("Expected the comparison error, got %v", err)
+	}
+
+	expr, _ = Parse(bookSchema(t), `year = 1 (title = a OR title = b)`)
+	got, err := Build(expr, compare, joiner("and"), joiner("or"))
+	if err != nil || got != "and[pub_year=1 or[title=a title=b]]" {
+		t.Errorf("Unexpected result %q, %v", got, err)
+	}
+}
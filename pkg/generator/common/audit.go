@@ -0,0 +1,135 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// AuditField is a field the generated DAL fills on writes.
+type AuditField struct {
+	Name       string // Go field name (e.g., "CreatedAt")
+	ColumnName string // Column name (e.g., "created_at")
+	Unix       bool   // Time stored as int64 Unix seconds instead of time.Time
+}
+
+// AuditFields are the resolved audit columns of a message.
+// Nil entries are not tracked.
+type AuditFields struct {
+	CreatedBy *AuditField
+	UpdatedBy *AuditField
+	CreatedAt *AuditField
+	UpdatedAt *AuditField
+}
+
+// HasTime reports whether any time column is tracked.
+func (a *AuditFields) HasTime() bool {
+	return a.CreatedAt != nil || a.UpdatedAt != nil
+}
+
+// HasActor reports whether any actor column is tracked.
+func (a *AuditFields) HasActor() bool {
+	return a.CreatedBy != nil || a.UpdatedBy != nil
+}
+
+// HasCreated reports whether any created_* column is tracked.
+func (a *AuditFields) HasCreated() bool {
+	return a.CreatedBy != nil || a.CreatedAt != nil
+}
+
+// TimeFields returns the tracked time columns.
+func (a *AuditFields) TimeFields() []*AuditField {
+	var out []*AuditField
+	for _, field := range []*AuditField{a.CreatedAt, a.UpdatedAt} {
+		if field != nil {
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+// ResolveAuditFields finds the fields holding a message's audit columns.
+//
+// Each configured column must match the column name of one of the merged
+// fields: a string for created_by/updated_by, and a google.protobuf.Timestamp
+// or int64 (Unix seconds) for created_at/updated_at.
+//
+// Parameters:
+//   - opts: The message's audit options (may be nil)
+//   - fields: The message's merged fields
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - the resolved columns, nil if no column is configured
+//   - error if a column does not match a field of the right type
+func ResolveAuditFields(opts *dalv1.AuditOptions, fields []*protogen.Field, structName string) (*AuditFields, error) {
+	if opts == nil {
+		return nil, nil
+	}
+
+	var err error
+	audit := &AuditFields{}
+	if audit.CreatedBy, err = resolveAuditField("created_by", opts.CreatedBy, false, fields, structName); err != nil {
+		return nil, err
+	}
+	if audit.UpdatedBy, err = resolveAuditField("updated_by", opts.UpdatedBy, false, fields, structName); err != nil {
+		return nil, err
+	}
+	if audit.CreatedAt, err = resolveAuditField("created_at", opts.CreatedAt, true, fields, structName); err != nil {
+		return nil, err
+	}
+	if audit.UpdatedAt, err = resolveAuditField("updated_at", opts.UpdatedAt, true, fields, structName); err != nil {
+		return nil, err
+	}
+
+	if !audit.HasTime() && !audit.HasActor() {
+		return nil, nil
+	}
+	return audit, nil
+}
+
+// resolveAuditField finds the field for one audit column ("" if not tracked).
+func resolveAuditField(option, column string, isTime bool, fields []*protogen.Field, structName string) (*AuditField, error) {
+	if column == "" {
+		return nil, nil
+	}
+	for _, field := range fields {
+		if GetColumnName(field) != column {
+			continue
+		}
+		result := &AuditField{Name: field.GoName, ColumnName: column}
+		kind := field.Desc.Kind()
+		switch {
+		case field.Desc.IsList() || field.Desc.IsMap():
+		case !isTime && kind == protoreflect.StringKind:
+			return result, nil
+		case isTime && kind == protoreflect.MessageKind && field.Message.Desc.FullName() == "google.protobuf.Timestamp":
+			return result, nil
+		case isTime && (kind == protoreflect.Int64Kind || kind == protoreflect.Sint64Kind || kind == protoreflect.Sfixed64Kind):
+			result.Unix = true
+			return result, nil
+		}
+		if isTime {
+			return nil, fmt.Errorf("audit %s column '%s' of %s must be a google.protobuf.Timestamp or int64 field", option, column, structName)
+		}
+		return nil, fmt.Errorf("audit %s column '%s' of %s must be a string field", option, column, structName)
+	}
+	return nil, fmt.Errorf("audit %s column '%s' of %s does not match any field's column", option, column, structName)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// Default column names for child table rows.
+const (
+	DefaultChildForeignKey    = "parent_id"
+	DefaultChildOrdinalColumn = "ordinal"
+)
+
+// GetChildTableOptions returns the child table options of a field's column annotation.
+// Returns nil if the field is not stored in a child table.
+func GetChildTableOptions(field *protogen.Field) *dalv1.ChildTableOptions {
+	return GetColumnOptions(field).GetChildTable()
+}
+
+// ChildTableName returns the table holding a child table field's rows: the
+// configured table, or the parent table and the field's column name joined
+// by "_".
+//
+// Example: "repeated AuthorGorm authors = 3" on table "books" -> "books_authors"
+func ChildTableName(field *protogen.Field, parentTable string) string {
+	if table := GetChildTableOptions(field).GetTable(); table != "" {
+		return table
+	}
+	return parentTable + "_" + GetColumnName(field)
+}
+
+// ChildTableForeignKey returns the column holding the parent's primary key.
+func ChildTableForeignKey(field *protogen.Field) string {
+	if fk := GetChildTableOptions(field).GetForeignKey(); fk != "" {
+		return fk
+	}
+	return DefaultChildForeignKey
+}
+
+// ChildTableOrdinalColumn returns the column holding an element's list position.
+func ChildTableOrdinalColumn(field *protogen.Field) string {
+	if col := GetChildTableOptions(field).GetOrdinalColumn(); col != "" {
+		return col
+	}
+	return DefaultChildOrdinalColumn
+}
+
+// ValidateChildTableField checks that a field marked with child_table can be
+// stored as rows of a separate table.
+//
+// Only repeated message fields qualify: each element becomes one row, so
+// scalars, maps and well-known types have no row shape. A child table field
+// cannot also be flattened or serialized, and the parent needs a table of its
+// own for the rows to reference. Fields without child table options are
+// always valid.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - structName: Name of the generated struct, for error messages
+//   - parentTable: The parent's table name ("" if it has none)
+//
+// Returns:
+//   - error describing why the field cannot use a child table, nil otherwise
+func ValidateChildTableField(field *protogen.Field, structName, parentTable string) error {
+	if GetChildTableOptions(field) == nil {
+		return nil
+	}
+
+	if field.Desc.Kind().String() != "message" || field.Message == nil || field.Desc.IsMap() {
+		return fmt.Errorf("field '%s.%s': child_table requires a repeated message field, got %s", structName, field.GoName, field.Desc.Kind())
+	}
+	if !field.Desc.IsList() {
+		return fmt.Errorf("field '%s.%s': child_table requires a repeated field", structName, field.GoName)
+	}
+	if _, isWellKnown := GetWellKnownTypeMapping(field.Message); isWellKnown {
+		return fmt.Errorf("field '%s.%s': child_table cannot be used on well-known type %s", structName, field.GoName, field.Message.Desc.FullName())
+	}
+	if GetFlattenOptions(field) != nil || HasMessageStorage(field) {
+		return fmt.Errorf("field '%s.%s': child_table cannot be combined with flatten or storage", structName, field.GoName)
+	}
+	if parentTable == "" {
+		return fmt.Errorf("field '%s.%s': child_table requires the parent message to have a table", structName, field.GoName)
+	}
+	return nil
+}
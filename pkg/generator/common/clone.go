@@ -0,0 +1,119 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"strings"
+)
+
+// Cloning is how the generated Clone method copies a struct field after the
+// struct itself has been copied.
+type Cloning string
+
+const (
+	// CloneValue needs no copy (scalars, enums, time.Time, qualified types)
+	CloneValue Cloning = "value"
+	// CloneBytes copies a []byte with bytes.Clone
+	CloneBytes Cloning = "bytes"
+	// CloneSlice copies a slice of values with slices.Clone
+	CloneSlice Cloning = "slice"
+	// CloneMap copies a map of values with maps.Clone
+	CloneMap Cloning = "map"
+	// CloneStruct deep-copies a nested generated struct
+	CloneStruct Cloning = "struct"
+	// CloneStructSlice copies a slice and deep-copies its generated structs
+	CloneStructSlice Cloning = "struct_slice"
+	// CloneStructMap copies a map and deep-copies its generated struct values
+	CloneStructMap Cloning = "struct_map"
+	// CloneBytesSlice copies a slice and each of its []byte elements
+	CloneBytesSlice Cloning = "bytes_slice"
+	// CloneBytesMap copies a map and each of its []byte values
+	CloneBytesMap Cloning = "bytes_map"
+	// CloneKey copies a *datastore.Key and its parent keys
+	CloneKey Cloning = "key"
+)
+
+// FieldCloning returns how to copy a struct field of the given Go type (see
+// ProtoFieldToGoType).
+//
+// Unqualified identifiers other than Go's predeclared types are taken to be
+// structs generated in the same package. Qualified types other than
+// *datastore.Key (e.g., time.Time, api.SampleEnum) are copied by value.
+//
+// Examples:
+//   - "string", "time.Time"        -> CloneValue
+//   - "[]byte"                     -> CloneBytes
+//   - "[]string", "[]time.Time"    -> CloneSlice
+//   - "map[string]int64"           -> CloneMap
+//   - "AuthorGORM"                 -> CloneStruct
+//   - "[]AuthorGORM"               -> CloneStructSlice
+//   - "map[string]AuthorDatastore" -> CloneStructMap
+//   - "[][]byte"                   -> CloneBytesSlice
+//   - "*datastore.Key"             -> CloneKey
+func FieldCloning(goType string) Cloning {
+	switch {
+	case goType == "*datastore.Key":
+		return CloneKey
+	case goType == "[]byte":
+		return CloneBytes
+	case strings.HasPrefix(goType, "[]"):
+		switch elem := goType[2:]; {
+		case elem == "[]byte":
+			return CloneBytesSlice
+		case isGeneratedStruct(elem):
+			return CloneStructSlice
+		default:
+			return CloneSlice
+		}
+	case strings.HasPrefix(goType, "map["):
+		elem := goType[strings.Index(goType, "]")+1:]
+		switch {
+		case elem == "[]byte":
+			return CloneBytesMap
+		case isGeneratedStruct(elem):
+			return CloneStructMap
+		default:
+			return CloneMap
+		}
+	case isGeneratedStruct(goType):
+		return CloneStruct
+	default:
+		return CloneValue
+	}
+}
+
+// predeclaredTypes are Go's built-in type names, which are never generated
+// structs.
+var predeclaredTypes = map[string]bool{
+	"bool": true, "string": true, "byte": true, "rune": true, "int": true,
+	"int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"uintptr": true, "float32": true, "float64": true,
+	"complex64": true, "complex128": true, "error": true, "any": true,
+}
+
+// isGeneratedStruct reports whether goType names a struct generated in the
+// same package: an unqualified identifier that is not a predeclared type.
+func isGeneratedStruct(goType string) bool {
+	if goType == "" || predeclaredTypes[goType] {
+		return false
+	}
+	for _, r := range goType {
+		if r != '_' && !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+)
+
+func TestFieldCloning(t *testing.T) {
+	tests := []struct {
+		goType   string
+		expected Cloning
+	}{
+		{"string", CloneValue},
+		{"int64", CloneValue},
+		{"time.Time", CloneValue},
+		{"api.SampleEnum", CloneValue},
+		{"[]byte", CloneBytes},
+		{"[]string", CloneSlice},
+		{"[]time.Time", CloneSlice},
+		{"[]api.SampleEnum", CloneSlice},
+		{"map[string]int64", CloneMap},
+		{"map[string]time.Time", CloneMap},
+		{"AuthorGORM", CloneStruct},
+		{"[]AuthorGORM", CloneStructSlice},
+		{"[]LibraryChildGORMContributorsChild", CloneStructSlice},
+		{"map[uint32]MapValueMessageDatastore", CloneStructMap},
+		{"[][]byte", CloneBytesSlice},
+		{"map[string][]byte", CloneBytesMap},
+		{"*datastore.Key", CloneKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goType, func(t *testing.T) {
+			if got := FieldCloning(tt.goType); got != tt.expected {
+				t.Errorf("FieldCloning(%q) = %q, want %q", tt.goType, got, tt.expected)
+			}
+		})
+	}
+}
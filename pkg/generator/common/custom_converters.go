@@ -135,6 +135,44 @@ func CollectCustomConverterImports(msg *protogen.Message, imports ImportMap) {
 	}
 }
 
+// CustomConverter is a custom converter function from a field's column options
+// (to_func or from_func).
+type CustomConverter struct {
+	// Func is the qualified function name (e.g., "myconv.ToMillis")
+	Func string
+
+	// Signature is the function's signature style
+	Signature dalv1.ConverterSignature
+}
+
+// IsSet reports whether the converter function is specified.
+func (c CustomConverter) IsSet() bool {
+	return c.Func != ""
+}
+
+// ReturnsError reports whether the function returns (U, error).
+func (c CustomConverter) ReturnsError() bool {
+	return c.Signature == dalv1.ConverterSignature_RETURNS_ERROR || c.TakesContext()
+}
+
+// TakesContext reports whether the function takes a context.Context first.
+// Generated converters pass theirs as "ctx".
+func (c CustomConverter) TakesContext() bool {
+	return c.Signature == dalv1.ConverterSignature_CONTEXT_RETURNS_ERROR
+}
+
+// Call returns the expression calling the function on arg.
+//
+// Example:
+//   CustomConverter{Func: "c.ParseID", Signature: CONTEXT_RETURNS_ERROR}.Call("src.ID")
+//   Returns: "c.ParseID(ctx, src.ID)"
+func (c CustomConverter) Call(arg string) string {
+	if c.TakesContext() {
+		return c.Func + "(ctx, " + arg + ")"
+	}
+	return c.Func + "(" + arg + ")"
+}
+
 // ExtractCustomConverters extracts custom converter code from a field's column options.
 // Returns the to_func and from_func conversion code if specified.
 //
@@ -145,40 +183,40 @@ func CollectCustomConverterImports(msg *protogen.Message, imports ImportMap) {
 //   Field with: to_func: {package: "conv", alias: "c", function: "ToMillis"}
 //   Returns: toCode = "c.ToMillis(src.FieldName)", fromCode = ""
 func ExtractCustomConverters(field *protogen.Field, fieldName string) (toTargetCode, fromTargetCode string) {
-	opts := field.Desc.Options()
-	if opts == nil {
-		return "", ""
+	toFunc, fromFunc := ExtractCustomConverterFuncs(field)
+	if toFunc.IsSet() {
+		toTargetCode = toFunc.Call("src." + fieldName)
 	}
-
-	// Get column options
-	v := proto.GetExtension(opts, dalv1.E_Column)
-	if v == nil {
-		return "", ""
+	if fromFunc.IsSet() {
+		fromTargetCode = fromFunc.Call("src." + fieldName)
 	}
+	return toTargetCode, fromTargetCode
+}
 
-	colOpts, ok := v.(*dalv1.ColumnOptions)
-	if !ok || colOpts == nil {
-		return "", ""
-	}
+// ExtractCustomConverterFuncs extracts the custom converter functions from a
+// field's column options, for callers that need their signatures or apply them
+// to something other than the whole field (e.g., each element of a repeated field).
+//
+// Example:
+//   Field with: to_func: {package: "conv", alias: "c", function: "ToMillis"}
+//   Returns: toFunc = {Func: "c.ToMillis"}, fromFunc = {} (not set)
+func ExtractCustomConverterFuncs(field *protogen.Field) (toFunc, fromFunc CustomConverter) {
+	colOpts := GetColumnOptions(field)
+	return customConverter(colOpts.GetToFunc()), customConverter(colOpts.GetFromFunc())
+}
 
-	// Extract to_func
-	if colOpts.ToFunc != nil && colOpts.ToFunc.Function != "" {
-		pkgAlias := colOpts.ToFunc.Alias
-		if pkgAlias == "" {
-			// Use last segment of package path as alias
-			pkgAlias = GetPackageAlias(colOpts.ToFunc.Package)
-		}
-		toTargetCode = pkgAlias + "." + colOpts.ToFunc.Function + "(src." + fieldName + ")"
+// customConverter resolves a ConverterFunc option, using the last segment of
+// its package path when no alias is given.
+func customConverter(fn *dalv1.ConverterFunc) CustomConverter {
+	if fn == nil || fn.Function == "" {
+		return CustomConverter{}
 	}
-
-	// Extract from_func
-	if colOpts.FromFunc != nil && colOpts.FromFunc.Function != "" {
-		pkgAlias := colOpts.FromFunc.Alias
-		if pkgAlias == "" {
-			pkgAlias = GetPackageAlias(colOpts.FromFunc.Package)
-		}
-		fromTargetCode = pkgAlias + "." + colOpts.FromFunc.Function + "(src." + fieldName + ")"
+	pkgAlias := fn.Alias
+	if pkgAlias == "" {
+		pkgAlias = GetPackageAlias(fn.Package)
+	}
+	return CustomConverter{
+		Func:      pkgAlias + "." + fn.Function,
+		Signature: fn.Signature,
 	}
-
-	return toTargetCode, fromTargetCode
 }
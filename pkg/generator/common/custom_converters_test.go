@@ -251,6 +251,44 @@ func TestExtractCustomConverters(t *testing.T) {
 	}
 }
 
+func TestExtractCustomConverterFuncs_Signatures(t *testing.T) {
+	field := createFieldWithCustomConverter(
+		&dalv1.ConverterFunc{
+			Package:   "github.com/test/ids",
+			Function:  "ParseID",
+			Signature: dalv1.ConverterSignature_RETURNS_ERROR,
+		},
+		&dalv1.ConverterFunc{
+			Package:   "github.com/test/crypto",
+			Alias:     "c",
+			Function:  "Decrypt",
+			Signature: dalv1.ConverterSignature_CONTEXT_RETURNS_ERROR,
+		},
+	)
+
+	toFunc, fromFunc := ExtractCustomConverterFuncs(field)
+
+	if got := toFunc.Call("src.ID"); got != "ids.ParseID(src.ID)" {
+		t.Errorf("to_func call = %q, want %q", got, "ids.ParseID(src.ID)")
+	}
+	if !toFunc.ReturnsError() || toFunc.TakesContext() {
+		t.Errorf("RETURNS_ERROR: ReturnsError = %v, TakesContext = %v", toFunc.ReturnsError(), toFunc.TakesContext())
+	}
+
+	if got := fromFunc.Call("src.Secret"); got != "c.Decrypt(ctx, src.Secret)" {
+		t.Errorf("from_func call = %q, want %q", got, "c.Decrypt(ctx, src.Secret)")
+	}
+	if !fromFunc.ReturnsError() || !fromFunc.TakesContext() {
+		t.Errorf("CONTEXT_RETURNS_ERROR: ReturnsError = %v, TakesContext = %v", fromFunc.ReturnsError(), fromFunc.TakesContext())
+	}
+
+	// The signature does not change the plain extraction
+	toCode, fromCode := ExtractCustomConverters(field, "ID")
+	if toCode != "ids.ParseID(src.ID)" || fromCode != "c.Decrypt(ctx, src.ID)" {
+		t.Errorf("ExtractCustomConverters = %q, %q", toCode, fromCode)
+	}
+}
+
 // mockFieldDescriptor is a minimal implementation for testing custom converters
 // We only need to implement Options() - all other methods can use zero values
 type mockFieldDescriptor struct {
@@ -0,0 +1,61 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// IsEncodedKey reports whether a field has the encoded_key column option,
+// i.e. holds the web-safe encoded Datastore key instead of a property.
+func IsEncodedKey(field *protogen.Field) bool {
+	return GetColumnOptions(field).GetEncodedKey()
+}
+
+// ValidateEncodedKeyField checks that a field with encoded_key is a singular
+// string field whose source field is one too. Fields without encoded_key are
+// always valid.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - source: The source message (nil for embedded types)
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - error describing why the field cannot hold the encoded key, nil otherwise
+func ValidateEncodedKeyField(field *protogen.Field, source *protogen.Message, structName string) error {
+	if !IsEncodedKey(field) {
+		return nil
+	}
+
+	if field.Desc.Kind().String() != "string" || field.Desc.IsList() || field.Desc.HasPresence() {
+		return fmt.Errorf("field '%s.%s': encoded_key requires a singular, non-optional string field", structName, field.GoName)
+	}
+	if source == nil {
+		return fmt.Errorf("field '%s.%s': encoded_key requires a source message", structName, field.GoName)
+	}
+	for _, sourceField := range source.Fields {
+		if sourceField.Desc.Name() == field.Desc.Name() {
+			if sourceField.Desc.Kind() != field.Desc.Kind() || sourceField.Desc.IsList() || sourceField.Desc.HasPresence() {
+				break
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("field '%s.%s': encoded_key needs source field %s.%s to be a singular, non-optional string",
+		structName, field.GoName, source.Desc.FullName(), field.Desc.Name())
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Equality is how the generated Equal and ChangedColumns methods compare a
+// struct field.
+type Equality string
+
+const (
+	// EqualValue compares with == (scalars, enums, serialized JSON)
+	EqualValue Equality = "value"
+	// EqualTime compares time.Time values with their Equal method
+	EqualTime Equality = "time"
+	// EqualBytes compares []byte values with bytes.Equal
+	EqualBytes Equality = "bytes"
+	// EqualStruct compares nested generated structs with their Equal method
+	EqualStruct Equality = "struct"
+	// EqualDeep compares lists, maps and other types with reflect.DeepEqual
+	EqualDeep Equality = "deep"
+	// EqualRows compares GORM child table rows by their Value
+	EqualRows Equality = "rows"
+)
+
+// FieldEquality returns how to compare a struct field generated for a proto
+// field with the given Go type (see ProtoFieldToGoType).
+//
+// Examples:
+//   - string name = 1                    -> EqualValue
+//   - google.protobuf.Timestamp at = 2   -> EqualTime (time.Time)
+//   - google.protobuf.Any payload = 3    -> EqualBytes ([]byte)
+//   - Author author = 4                  -> EqualStruct (AuthorGORM)
+//   - repeated string tags = 5           -> EqualDeep
+//   - Settings s = 6 [storage: PROTOJSON] -> EqualValue (string)
+func FieldEquality(field *protogen.Field, goType string) Equality {
+	switch {
+	case goType == "time.Time":
+		return EqualTime
+	case goType == "[]byte":
+		return EqualBytes
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return EqualDeep
+	case HasMessageStorage(field):
+		return EqualValue
+	case field.Message != nil:
+		if _, ok := GetWellKnownTypeMapping(field.Message); ok {
+			return EqualDeep
+		}
+		return EqualStruct
+	default:
+		return EqualValue
+	}
+}
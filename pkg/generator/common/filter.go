@@ -0,0 +1,96 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FilterField is a source message field allowed in AIP-160 filters, with the
+// column (or Datastore property) storing it.
+type FilterField struct {
+	Path   string // Source field name, as used in filters (e.g., "created_at")
+	Column string // Column or property name (e.g., "created_at")
+}
+
+// FilterFields returns the fields of a source message that generated filter
+// schemas allow: singular scalar, enum and google.protobuf.Timestamp fields
+// stored as they are, so that a filter's literal compares with the stored
+// value. Fields the target converts (custom converters, storage, flatten,
+// child tables, a different type) are left out, as are bytes fields.
+//
+// Parameters:
+//   - source: The source API message (nil yields no fields)
+//   - fields: The target's merged fields (see MergeSourceFields)
+//   - column: Returns the column or property of a merged field, "" if not stored
+//
+// Returns:
+//   - Filterable fields in merged field order
+func FilterFields(source *protogen.Message, fields []*protogen.Field, column func(*protogen.Field) string) []FilterField {
+	if source == nil {
+		return nil
+	}
+
+	var result []FilterField
+	for _, field := range fields {
+		name := field.Desc.Name()
+		sourceField := source.Desc.Fields().ByName(name)
+		if sourceField == nil || !isFilterable(sourceField) {
+			continue
+		}
+		if field.Desc != sourceField && !sameFilterType(field.Desc, sourceField) {
+			continue
+		}
+		if opts := GetColumnOptions(field); opts != nil {
+			if opts.ToFunc != nil || opts.FromFunc != nil || opts.Flatten != nil || opts.ChildTable != nil || HasMessageStorage(field) {
+				continue
+			}
+		}
+		if col := column(field); col != "" {
+			result = append(result, FilterField{Path: string(name), Column: col})
+		}
+	}
+	return result
+}
+
+// isFilterable reports whether a filter can compare a field with a literal
+func isFilterable(field protoreflect.FieldDescriptor) bool {
+	if field.IsList() || field.IsMap() {
+		return false
+	}
+	switch field.Kind() {
+	case protoreflect.BytesKind, protoreflect.GroupKind:
+		return false
+	case protoreflect.MessageKind:
+		return field.Message().FullName() == "google.protobuf.Timestamp"
+	}
+	return true
+}
+
+// sameFilterType reports whether a target field stores values of the same
+// type as the source field it overrides
+func sameFilterType(target, source protoreflect.FieldDescriptor) bool {
+	if target.Kind() != source.Kind() || target.IsList() || target.IsMap() {
+		return false
+	}
+	switch target.Kind() {
+	case protoreflect.EnumKind:
+		return target.Enum().FullName() == source.Enum().FullName()
+	case protoreflect.MessageKind:
+		return target.Message().FullName() == source.Message().FullName()
+	}
+	return true
+}
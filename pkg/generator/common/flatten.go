@@ -0,0 +1,70 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// GetFlattenOptions returns the flatten options of a field's column annotation.
+// Returns nil if the field is not flattened.
+func GetFlattenOptions(field *protogen.Field) *dalv1.FlattenOptions {
+	return GetColumnOptions(field).GetFlatten()
+}
+
+// FlattenPrefix returns the column prefix for a flattened field: the
+// configured prefix, or the field's column name followed by "_".
+//
+// Example: "Address address = 5" with no prefix -> "address_"
+func FlattenPrefix(field *protogen.Field) string {
+	if prefix := GetFlattenOptions(field).GetPrefix(); prefix != "" {
+		return prefix
+	}
+	return GetColumnName(field) + "_"
+}
+
+// ValidateFlattenField checks that a field marked with flatten can be stored
+// as columns on its parent.
+//
+// Only singular message fields can be flattened: lists and maps have no fixed
+// set of columns, and well-known types (Timestamp, Any, ...) are stored as a
+// single value. Fields without flatten options are always valid.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - error describing why the field cannot be flattened, nil otherwise
+func ValidateFlattenField(field *protogen.Field, structName string) error {
+	if GetFlattenOptions(field) == nil {
+		return nil
+	}
+
+	if field.Desc.Kind().String() != "message" || field.Message == nil {
+		return fmt.Errorf("field '%s.%s': flatten requires a message field, got %s", structName, field.GoName, field.Desc.Kind())
+	}
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return fmt.Errorf("field '%s.%s': flatten cannot be used on repeated or map fields", structName, field.GoName)
+	}
+	if _, isWellKnown := GetWellKnownTypeMapping(field.Message); isWellKnown {
+		return fmt.Errorf("field '%s.%s': flatten cannot be used on well-known type %s", structName, field.GoName, field.Message.Desc.FullName())
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// IDStrategy describes how a DAL fills an empty key field on create (the
+// id_strategy column option).
+type IDStrategy struct {
+	Name string // Go field name (e.g., "Id")
+	Zero string // Zero value of the field (e.g., `""`, "0")
+
+	// Generated IDs come from the DAL's idgen.Generator
+	Generated string // idgen strategy constant (e.g., "UUIDv7"); empty for IDs assigned by the database
+	Func      string // idgen function returning the ID: "String" or "Int64"
+	Convert   string // Type the ID is converted to, empty if none (e.g., "uint64"); DALs set id_types
+
+	AutoIncrement bool // Assigned by the database on insert (GORM)
+	Allocated     bool // Numeric key ID allocated by Datastore on Put
+}
+
+// generatedStrategies maps the id_strategy values filled by an
+// idgen.Generator to their idgen constants.
+var generatedStrategies = map[dalv1.IDStrategy]string{
+	dalv1.IDStrategy_UUIDV4:    "UUIDv4",
+	dalv1.IDStrategy_UUIDV7:    "UUIDv7",
+	dalv1.IDStrategy_ULID:      "ULID",
+	dalv1.IDStrategy_SNOWFLAKE: "Snowflake",
+}
+
+// GetIDStrategy returns the id_strategy of a field's column annotation.
+func GetIDStrategy(field *protogen.Field) dalv1.IDStrategy {
+	return GetColumnOptions(field).GetIdStrategy()
+}
+
+// ResolveIDStrategy returns how a DAL fills a field with an id_strategy.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - the field's IDStrategy, nil if it has none
+//   - error if the strategy cannot fill a field of its type
+func ResolveIDStrategy(field *protogen.Field, structName string) (*IDStrategy, error) {
+	strategy := GetIDStrategy(field)
+	if strategy == dalv1.IDStrategy_ID_STRATEGY_UNSPECIFIED {
+		return nil, nil
+	}
+	if field.Desc.IsList() || field.Desc.IsMap() || field.Desc.HasPresence() {
+		return nil, fmt.Errorf("field '%s.%s': id_strategy requires a singular, non-optional field", structName, field.GoName)
+	}
+
+	kind := field.Desc.Kind().String()
+	resolved := &IDStrategy{Name: field.GoName, Zero: "0"}
+	if kind == "string" {
+		resolved.Zero = `""`
+	}
+	var kinds []string
+	switch strategy {
+	case dalv1.IDStrategy_UUIDV4, dalv1.IDStrategy_UUIDV7, dalv1.IDStrategy_ULID:
+		kinds = []string{"string"}
+		resolved.Func = "String"
+	case dalv1.IDStrategy_SNOWFLAKE:
+		kinds = []string{"string", "int64", "uint64"}
+		resolved.Func = "Int64"
+		switch kind {
+		case "string":
+			resolved.Func = "String"
+		case "uint64":
+			resolved.Convert = "uint64"
+		}
+	case dalv1.IDStrategy_AUTO_INCREMENT:
+		kinds = []string{"int32", "int64", "uint32", "uint64"}
+		resolved.AutoIncrement = true
+	case dalv1.IDStrategy_DATASTORE_ALLOCATED:
+		kinds = []string{"int64"}
+		resolved.Allocated = true
+	default:
+		return nil, fmt.Errorf("field '%s.%s': unknown id_strategy %v", structName, field.GoName, strategy)
+	}
+	resolved.Generated = generatedStrategies[strategy]
+
+	for _, k := range kinds {
+		if k == kind {
+			return resolved, nil
+		}
+	}
+	return nil, fmt.Errorf("field '%s.%s': id_strategy %s requires a %s field, got %s", structName, field.GoName, strategy, joinOr(kinds), kind)
+}
+
+// joinOr joins names as "a", "a or b" or "a, b or c".
+func joinOr(names []string) string {
+	last := len(names) - 1
+	if last == 0 {
+		return names[0]
+	}
+	return strings.Join(names[:last], ", ") + " or " + names[last]
+}
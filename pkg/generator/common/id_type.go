@@ -0,0 +1,148 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"go/token"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+)
+
+// IDType is a named Go type generated for the id_type column option
+// (e.g., `type GameID string`).
+type IDType struct {
+	Name  string // Go type name (e.g., "GameID")
+	Type  string // Underlying Go type (e.g., "string", "uint32")
+	Field string // First proto field using it, for the doc comment (e.g., "weewar.v1.GameGorm.id")
+}
+
+// idTypeKinds are the proto kinds a field with an id_type can have.
+var idTypeKinds = map[string]bool{
+	"string": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+}
+
+// GetIDType returns the id_type of a field's column annotation, or "" if the
+// field has none.
+func GetIDType(field *protogen.Field) string {
+	return GetColumnOptions(field).GetIdType()
+}
+
+// ValidateIDTypeField checks that a field with an id_type can hold a named
+// scalar type. Fields without an id_type are always valid.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - error describing why the field cannot have an id_type, nil otherwise
+func ValidateIDTypeField(field *protogen.Field, structName string) error {
+	name := GetIDType(field)
+	if name == "" {
+		return nil
+	}
+
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return fmt.Errorf("field '%s.%s': id_type %q must be an exported Go identifier", structName, field.GoName, name)
+	}
+	if !idTypeKinds[field.Desc.Kind().String()] {
+		return fmt.Errorf("field '%s.%s': id_type requires a string or integer field, got %s", structName, field.GoName, field.Desc.Kind())
+	}
+	if field.Desc.IsList() {
+		return fmt.Errorf("field '%s.%s': id_type cannot be used on repeated fields", structName, field.GoName)
+	}
+	if field.Desc.HasPresence() {
+		return fmt.Errorf("field '%s.%s': id_type cannot be used on optional or oneof fields", structName, field.GoName)
+	}
+	return nil
+}
+
+// CollectIDTypes returns the ID types each proto file's generated file must
+// declare. Every ID type is declared once per Go package, in the first file
+// (in protoFiles order) with a field using it.
+//
+// Parameters:
+//   - protoFiles: Proto file paths in generation order
+//   - fileGroups: Messages of each proto file (see GroupMessagesByFile)
+//
+// Returns:
+//   - ID types to declare, by proto file
+//   - error if a field's id_type is invalid, or if one id_type is used for
+//     fields of different types in a package
+func CollectIDTypes(protoFiles []string, fileGroups map[string][]*collector.MessageInfo) (map[string][]IDType, error) {
+	declared := make(map[string]IDType) // by "<go import path>.<name>"
+	decls := make(map[string][]IDType)
+	for _, protoFile := range protoFiles {
+		for _, msg := range fileGroups[protoFile] {
+			fields, err := MergeSourceFields(msg.SourceMessage, msg.TargetMessage)
+			if err != nil {
+				return nil, err
+			}
+			structName := string(msg.TargetMessage.Desc.Name())
+			for _, field := range fields {
+				name := GetIDType(field)
+				if name == "" {
+					continue
+				}
+				if err := ValidateIDTypeField(field, structName); err != nil {
+					return nil, err
+				}
+				if err := validateIDTypeSource(msg.SourceMessage, field, structName); err != nil {
+					return nil, err
+				}
+
+				idType := IDType{
+					Name:  name,
+					Type:  ProtoScalarToGo(field.Desc.Kind().String()),
+					Field: string(field.Desc.FullName()),
+				}
+				key := string(msg.TargetMessage.GoIdent.GoImportPath) + "." + name
+				if prev, ok := declared[key]; ok {
+					if prev.Type != idType.Type {
+						return nil, fmt.Errorf("field '%s.%s': id_type %s is %s, but %s makes it %s", structName, field.GoName, name, idType.Type, prev.Field, prev.Type)
+					}
+					continue
+				}
+				declared[key] = idType
+				decls[protoFile] = append(decls[protoFile], idType)
+			}
+		}
+	}
+	return decls, nil
+}
+
+// validateIDTypeSource checks that the converters can cast between a field
+// with an id_type and its source field: both must have the same proto type,
+// unless custom converter functions do the conversion.
+func validateIDTypeSource(source *protogen.Message, field *protogen.Field, structName string) error {
+	if source == nil {
+		return nil
+	}
+	if toFunc, _ := ExtractCustomConverterFuncs(field); toFunc.IsSet() {
+		return nil
+	}
+	for _, sourceField := range source.Fields {
+		if sourceField.Desc.Name() != field.Desc.Name() || sourceField == field {
+			continue
+		}
+		if sourceField.Desc.Kind() != field.Desc.Kind() || sourceField.Desc.IsList() || sourceField.Desc.HasPresence() {
+			return fmt.Errorf("field '%s.%s': id_type needs source field %s to be a singular, non-optional %s", structName, field.GoName, sourceField.Desc.FullName(), field.Desc.Kind())
+		}
+	}
+	return nil
+}
@@ -183,6 +183,11 @@ func (r *MessageRegistry) collectReferencedMessage(field *protogen.Field, refere
 		return
 	}
 
+	// Serialized message fields are stored as-is and need no target type
+	if HasMessageStorage(field) {
+		return
+	}
+
 	// Handle map fields - check the value type
 	if field.Desc.IsMap() {
 		mapEntry := field.Message
@@ -0,0 +1,106 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// GetMessageStorage returns how a message field is stored in its column.
+// Returns MESSAGE_STORAGE_UNSPECIFIED for fields stored as converted structs.
+func GetMessageStorage(field *protogen.Field) dalv1.MessageStorage {
+	return GetColumnOptions(field).GetStorage()
+}
+
+// HasMessageStorage reports whether a field is stored serialized (PROTO_BINARY
+// or PROTOJSON) rather than as a converted struct.
+func HasMessageStorage(field *protogen.Field) bool {
+	return GetMessageStorage(field) != dalv1.MessageStorage_MESSAGE_STORAGE_UNSPECIFIED
+}
+
+// MessageStorageGoType returns the Go column type for a storage mode:
+// []byte for PROTO_BINARY, string for PROTOJSON, "" otherwise.
+func MessageStorageGoType(storage dalv1.MessageStorage) string {
+	switch storage {
+	case dalv1.MessageStorage_PROTO_BINARY:
+		return "[]byte"
+	case dalv1.MessageStorage_PROTOJSON:
+		return "string"
+	}
+	return ""
+}
+
+// ValidateMessageStorageField checks that a field with a storage mode is a
+// message the converters can marshal.
+//
+// Only singular, non-well-known message fields can be serialized (well-known
+// types already have native column types), and a serialized field cannot also
+// be flattened. Fields without a storage mode are always valid.
+//
+// Parameters:
+//   - field: The merged field to check
+//   - structName: Name of the generated struct, for error messages
+//
+// Returns:
+//   - error describing why the field cannot be serialized, nil otherwise
+func ValidateMessageStorageField(field *protogen.Field, structName string) error {
+	if !HasMessageStorage(field) {
+		return nil
+	}
+	storage := GetMessageStorage(field)
+
+	if field.Desc.Kind().String() != "message" || field.Message == nil {
+		return fmt.Errorf("field '%s.%s': storage %s requires a message field, got %s", structName, field.GoName, storage, field.Desc.Kind())
+	}
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return fmt.Errorf("field '%s.%s': storage %s cannot be used on repeated or map fields", structName, field.GoName, storage)
+	}
+	if _, isWellKnown := GetWellKnownTypeMapping(field.Message); isWellKnown {
+		return fmt.Errorf("field '%s.%s': storage %s cannot be used on well-known type %s", structName, field.GoName, storage, field.Message.Desc.FullName())
+	}
+	if GetFlattenOptions(field) != nil {
+		return fmt.Errorf("field '%s.%s': storage %s cannot be combined with flatten", structName, field.GoName, storage)
+	}
+	return nil
+}
+
+// CollectMessageStorageImports adds the packages of the message types that
+// serialized fields are unmarshalled into. The converters unmarshal into the
+// source field's type, so that is imported rather than the target field's.
+//
+// Parameters:
+//   - sourceMsg: The source (API) message
+//   - targetMsg: The target message whose fields may have a storage mode
+//   - imports: Import map to add to
+func CollectMessageStorageImports(sourceMsg, targetMsg *protogen.Message, imports ImportMap) {
+	if sourceMsg == nil || targetMsg == nil {
+		return
+	}
+	for _, field := range targetMsg.Fields {
+		if !HasMessageStorage(field) {
+			continue
+		}
+		for _, sourceField := range sourceMsg.Fields {
+			if sourceField.GoName == field.GoName && sourceField.Message != nil {
+				pkgInfo := ExtractPackageInfo(sourceField.Message)
+				imports.Add(ImportSpec{Alias: pkgInfo.Alias, Path: pkgInfo.ImportPath})
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// TemplateFile is a user-supplied template loaded from disk.
+type TemplateFile struct {
+	// Name is the template name used for lookup and in error messages (e.g., "dal.go.tmpl")
+	Name string
+
+	// Path is the file the template was read from
+	Path string
+
+	// Content is the raw template text
+	Content string
+}
+
+// TemplateOptions holds user-supplied templates for a single generator.
+type TemplateOptions struct {
+	// Overrides replace the built-in templates with the same name
+	Overrides []*TemplateFile
+
+	// Extras are rendered once per proto file as additional outputs
+	Extras []*TemplateFile
+}
+
+// LoadTemplateOptions reads user templates from dir.
+//
+// Every *.tmpl file in dir must either replace one of the builtins or be
+// named in extras; anything else is reported as an error so that a misspelt
+// override is not silently ignored. Extras that are not present in dir are
+// skipped, so a single extra_templates list can be shared by several targets.
+//
+// Parameters:
+//   - dir: Directory containing the templates (a missing directory yields empty options)
+//   - builtins: Names of the generator's built-in templates (e.g., "dal.go.tmpl")
+//   - extras: Names of extra templates to render per proto file (e.g., "metrics.go.tmpl")
+//
+// Returns:
+//   - TemplateOptions with overrides and extras sorted by name
+//   - error if the directory cannot be read or contains unknown templates
+func LoadTemplateOptions(dir string, builtins []string, extras []string) (*TemplateOptions, error) {
+	opts := &TemplateOptions{}
+	if dir == "" {
+		return opts, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates in %s: %w", dir, err)
+	}
+	sort.Strings(paths)
+
+	isBuiltin := make(map[string]bool)
+	for _, name := range builtins {
+		isBuiltin[name] = true
+	}
+	isExtra := make(map[string]bool)
+	for _, name := range extras {
+		if isBuiltin[name] {
+			return nil, fmt.Errorf("extra template %s conflicts with the built-in template of the same name", name)
+		}
+		isExtra[name] = true
+	}
+
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if !isBuiltin[name] && !isExtra[name] {
+			return nil, fmt.Errorf("unknown template %s in %s (built-in templates: %s; add it to extra_templates to render it as an extra output)",
+				name, dir, strings.Join(builtins, ", "))
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+		}
+
+		file := &TemplateFile{Name: name, Path: path, Content: string(content)}
+		if isBuiltin[name] {
+			opts.Overrides = append(opts.Overrides, file)
+		} else {
+			opts.Extras = append(opts.Extras, file)
+		}
+	}
+
+	return opts, nil
+}
+
+// ApplyTemplateOptions parses overrides and extras into a template set.
+//
+// Overrides are parsed under the name of the built-in template they replace,
+// so any {{ define }} blocks they contain replace the built-in definitions as
+// well. Parse errors are reported with the template name and line, followed
+// by the file the template was loaded from.
+//
+// Parameters:
+//   - t: Template set that already contains the built-in templates
+//   - opts: User templates to apply (nil is a no-op)
+//
+// Returns:
+//   - error if any user template fails to parse
+func ApplyTemplateOptions(t *template.Template, opts *TemplateOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	files := append(append([]*TemplateFile{}, opts.Overrides...), opts.Extras...)
+	for _, file := range files {
+		if _, err := t.New(file.Name).Parse(file.Content); err != nil {
+			return fmt.Errorf("%w (loaded from %s)", err, file.Path)
+		}
+	}
+	return nil
+}
+
+// ExtraTemplateFilename creates the output filename for an extra template.
+//
+// The template name without its ".tmpl" extension becomes the suffix of the
+// proto file's base name.
+//
+// Examples:
+//   - ExtraTemplateFilename("gorm/user.proto", "metrics.go.tmpl") -> "gorm/user_metrics.go"
+//   - ExtraTemplateFilename("user.proto", "schema.sql.tmpl") -> "user_schema.sql"
+//
+// Parameters:
+//   - protoPath: Path to the proto file
+//   - templateName: Name of the extra template
+//
+// Returns:
+//   - output filename with directory structure preserved
+func ExtraTemplateFilename(protoPath, templateName string) string {
+	return GenerateFilenameFromProto(protoPath, "_"+strings.TrimSuffix(templateName, ".tmpl"))
+}
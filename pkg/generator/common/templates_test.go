@@ -0,0 +1,140 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+// writeTemplates writes name -> content pairs into dir.
+func writeTemplates(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
+
+func TestLoadTemplateOptions_OverridesAndExtras(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplates(t, dir, map[string]string{
+		"dal.go.tmpl":     "custom dal",
+		"metrics.go.tmpl": "metrics",
+	})
+
+	opts, err := LoadTemplateOptions(dir, []string{"dal.go.tmpl", "file.go.tmpl"}, []string{"metrics.go.tmpl", "absent.go.tmpl"})
+	if err != nil {
+		t.Fatalf("LoadTemplateOptions failed: %v", err)
+	}
+
+	if len(opts.Overrides) != 1 || opts.Overrides[0].Name != "dal.go.tmpl" || opts.Overrides[0].Content != "custom dal" {
+		t.Errorf("Expected dal.go.tmpl override, got %+v", opts.Overrides)
+	}
+	// absent.go.tmpl is not in dir and is skipped
+	if len(opts.Extras) != 1 || opts.Extras[0].Name != "metrics.go.tmpl" {
+		t.Errorf("Expected metrics.go.tmpl extra, got %+v", opts.Extras)
+	}
+	if opts.Extras[0].Path != filepath.Join(dir, "metrics.go.tmpl") {
+		t.Errorf("Expected extra path to be recorded, got %s", opts.Extras[0].Path)
+	}
+}
+
+func TestLoadTemplateOptions_UnknownTemplate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplates(t, dir, map[string]string{"dall.go.tmpl": "typo"})
+
+	_, err := LoadTemplateOptions(dir, []string{"dal.go.tmpl"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for unknown template")
+	}
+	if !strings.Contains(err.Error(), "dall.go.tmpl") {
+		t.Errorf("Expected error to name the unknown template, got: %v", err)
+	}
+}
+
+func TestLoadTemplateOptions_ExtraShadowsBuiltin(t *testing.T) {
+	_, err := LoadTemplateOptions(t.TempDir(), []string{"dal.go.tmpl"}, []string{"dal.go.tmpl"})
+	if err == nil {
+		t.Fatal("Expected error when an extra template uses a built-in name")
+	}
+}
+
+func TestApplyTemplateOptions(t *testing.T) {
+	base := template.Must(template.New("").Parse(`{{ define "greeting" }}hello{{ end }}`))
+	template.Must(base.New("file.go.tmpl").Parse(`{{ template "greeting" }} world`))
+
+	err := ApplyTemplateOptions(base, &TemplateOptions{
+		Overrides: []*TemplateFile{{Name: "file.go.tmpl", Path: "x/file.go.tmpl", Content: `{{ template "greeting" }} there`}},
+		Extras:    []*TemplateFile{{Name: "extra.go.tmpl", Path: "x/extra.go.tmpl", Content: `{{ template "greeting" }}!`}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyTemplateOptions failed: %v", err)
+	}
+
+	var out strings.Builder
+	if err := base.ExecuteTemplate(&out, "file.go.tmpl", nil); err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+	if out.String() != "hello there" {
+		t.Errorf("Expected override to replace built-in, got %q", out.String())
+	}
+
+	out.Reset()
+	if err := base.ExecuteTemplate(&out, "extra.go.tmpl", nil); err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+	if out.String() != "hello!" {
+		t.Errorf("Expected extra to use built-in definitions, got %q", out.String())
+	}
+}
+
+// TestApplyTemplateOptions_ParseErrorHasNameAndLine verifies that template
+// errors point at the template name, line and source file.
+func TestApplyTemplateOptions_ParseErrorHasNameAndLine(t *testing.T) {
+	err := ApplyTemplateOptions(template.New(""), &TemplateOptions{
+		Overrides: []*TemplateFile{{Name: "dal.go.tmpl", Path: "tmpl/gorm/dal.go.tmpl", Content: "line one\n{{ .Broken "}},
+	})
+	if err == nil {
+		t.Fatal("Expected parse error")
+	}
+	for _, want := range []string{"dal.go.tmpl:2", "tmpl/gorm/dal.go.tmpl"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got: %v", want, err)
+		}
+	}
+}
+
+func TestExtraTemplateFilename(t *testing.T) {
+	tests := []struct {
+		protoPath    string
+		templateName string
+		expected     string
+	}{
+		{"gorm/user.proto", "metrics.go.tmpl", "gorm/user_metrics.go"},
+		{"user.proto", "schema.sql.tmpl", "user_schema.sql"},
+		{"likes/v1/gorm.proto", "wrappers.go.tmpl", "likes/v1/gorm_wrappers.go"},
+	}
+
+	for _, tt := range tests {
+		if got := ExtraTemplateFilename(tt.protoPath, tt.templateName); got != tt.expected {
+			t.Errorf("ExtraTemplateFilename(%q, %q) = %q, want %q", tt.protoPath, tt.templateName, got, tt.expected)
+		}
+	}
+}
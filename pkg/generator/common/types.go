@@ -123,6 +123,7 @@ type StructNameFunc func(*protogen.Message) string
 //   - Maps with scalar values: "map[string]int32", "map[string]string", etc.
 //   - Maps with enum values: "map[string]api.SampleEnum"
 //   - Maps with message values: "map[string]BookGORM", "map[uint32]AuthorDatastore", etc.
+//   - Messages with a storage mode: "[]byte" (PROTO_BINARY) or "string" (PROTOJSON)
 //
 // Parameters:
 //   - field: The proto field to convert
@@ -135,6 +136,11 @@ type StructNameFunc func(*protogen.Message) string
 func ProtoFieldToGoType(field *protogen.Field, structNameFunc StructNameFunc, sourcePkgName string, registry *MessageRegistry) string {
 	kind := field.Desc.Kind().String()
 
+	// Serialized message fields are stored as []byte or string columns
+	if goType := MessageStorageGoType(GetMessageStorage(field)); goType != "" {
+		return goType
+	}
+
 	// Helper function to get struct name for a message
 	// Uses registry if available to find the correct target type
 	getMessageTypeName := func(msg *protogen.Message) string {
@@ -165,7 +171,11 @@ func ProtoFieldToGoType(field *protogen.Field, structNameFunc StructNameFunc, so
 		var valueType string
 		valueKind := valueField.Desc.Kind().String()
 
+		wellKnown, isWellKnown := GetWellKnownTypeMapping(valueField.Message)
 		switch {
+		case valueKind == "message" && isWellKnown:
+			// Map value is a well-known type - use its Go mapping (e.g., time.Time)
+			valueType = wellKnown.GoType
 		case valueKind == "message":
 			// Map value is a message type - use the target struct name
 			valueType = getMessageTypeName(valueField.Message)
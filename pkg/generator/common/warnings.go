@@ -0,0 +1,66 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Warnings receives the warnings of one generation pass.
+//
+// A nil *Warnings logs each warning to stderr, which is what the regular
+// generation pass uses. The IR pass passes a collector from NewWarnings
+// instead, so its warnings are recorded into the IR document rather than
+// logged a second time. Each pass owns its collector, so concurrent runs
+// never see each other's warnings.
+type Warnings struct {
+	messages []string
+}
+
+// NewWarnings returns a collector that records warnings instead of logging them.
+func NewWarnings() *Warnings {
+	return &Warnings{}
+}
+
+// Warnf reports a generator warning.
+//
+// Parameters:
+//   - format: printf-style format string
+//   - args: format arguments
+func (w *Warnings) Warnf(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if w != nil {
+		w.messages = append(w.messages, strings.TrimSpace(msg))
+		return
+	}
+	log.Print(msg)
+}
+
+// IsCapturing reports whether warnings are recorded rather than logged, so
+// callers can skip follow-up log lines that only make sense next to a logged
+// warning.
+func (w *Warnings) IsCapturing() bool {
+	return w != nil
+}
+
+// Messages returns the recorded warnings, in order.
+func (w *Warnings) Messages() []string {
+	if w == nil {
+		return nil
+	}
+	return w.messages
+}
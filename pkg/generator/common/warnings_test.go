@@ -0,0 +1,57 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWarnings(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	warnings := NewWarnings()
+	if !warnings.IsCapturing() {
+		t.Error("Expected a collector to be capturing")
+	}
+	warnings.Warnf("[WARN] first %d\n", 1)
+	warnings.Warnf("[WARN] second")
+
+	got := warnings.Messages()
+	if len(got) != 2 || got[0] != "[WARN] first 1" || got[1] != "[WARN] second" {
+		t.Errorf("Unexpected captured warnings: %q", got)
+	}
+	if logged.Len() != 0 {
+		t.Errorf("Expected captured warnings not to be logged, got %q", logged.String())
+	}
+
+	// A nil collector logs instead
+	var logging *Warnings
+	if logging.IsCapturing() {
+		t.Error("Expected a nil collector not to be capturing")
+	}
+	logging.Warnf("[WARN] third")
+	if !strings.Contains(logged.String(), "[WARN] third") {
+		t.Errorf("Expected warning to be logged, got %q", logged.String())
+	}
+	if logging.Messages() != nil {
+		t.Errorf("Expected no recorded warnings, got %q", logging.Messages())
+	}
+}
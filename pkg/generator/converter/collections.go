@@ -16,6 +16,7 @@ package converter
 
 import (
 	"fmt"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
@@ -95,6 +96,35 @@ func BuildNestedConverterName(sourceType, targetType string) (toFunc, fromFunc s
 	return toFunc, fromFunc
 }
 
+// BuildBatchConverterNames generates the names of the slice converters for a
+// message pair, following the map converters' naming: "UserSliceToUserGORMSlice"
+// and "UserSliceFromUserGORMSlice". Type names are not pluralized, so the
+// names never equal a single-item converter's.
+//
+// Parameters:
+//   - sourceType: source message name (e.g., "Library")
+//   - targetType: target message name (e.g., "LibraryGORM")
+//
+// Returns:
+//   - toFunc: slice converter for source→target (e.g., "LibrarySliceToLibraryGORMSlice")
+//   - fromFunc: slice converter for target→source (e.g., "LibrarySliceFromLibraryGORMSlice")
+func BuildBatchConverterNames(sourceType, targetType string) (toFunc, fromFunc string) {
+	return BuildNestedConverterName(sourceType+"Slice", targetType+"Slice")
+}
+
+// WithOptionsConverterName returns the ...WithOptions variant of a converter
+// function, keeping type arguments last.
+//
+// Examples:
+//   - "AuthorToAuthorGORM" -> "AuthorToAuthorGORMWithOptions"
+//   - "converters.AnyBytesToMessageConverter[*api.Event]" -> "converters.AnyBytesToMessageConverterWithOptions[*api.Event]"
+func WithOptionsConverterName(converterFunc string) string {
+	if i := strings.Index(converterFunc, "["); i >= 0 {
+		return converterFunc[:i] + "WithOptions" + converterFunc[i:]
+	}
+	return converterFunc + "WithOptions"
+}
+
 // ExtractMapMessages extracts source and target message descriptors from map fields.
 //
 // For map<K, MessageType> fields, this returns the message descriptors for the
@@ -69,6 +69,31 @@ func TestBuildNestedConverterName(t *testing.T) {
 	}
 }
 
+func TestBuildBatchConverterNames(t *testing.T) {
+	tests := []struct {
+		sourceType   string
+		targetType   string
+		wantToFunc   string
+		wantFromFunc string
+	}{
+		{"User", "UserGORM", "UserSliceToUserGORMSlice", "UserSliceFromUserGORMSlice"},
+		{"Address", "AddressDatastore", "AddressSliceToAddressDatastoreSlice", "AddressSliceFromAddressDatastoreSlice"},
+		{"GameSettings", "GameSettingsGORM", "GameSettingsSliceToGameSettingsGORMSlice", "GameSettingsSliceFromGameSettingsGORMSlice"},
+		{"Library", "LibraryGORM", "LibrarySliceToLibraryGORMSlice", "LibrarySliceFromLibraryGORMSlice"},
+		// Single-item converter is StatsToDailyStats
+		{"Stats", "DailyStats", "StatsSliceToDailyStatsSlice", "StatsSliceFromDailyStatsSlice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sourceType+"/"+tt.targetType, func(t *testing.T) {
+			gotToFunc, gotFromFunc := BuildBatchConverterNames(tt.sourceType, tt.targetType)
+			if gotToFunc != tt.wantToFunc || gotFromFunc != tt.wantFromFunc {
+				t.Errorf("BuildBatchConverterNames() = %v, %v; want %v, %v", gotToFunc, gotFromFunc, tt.wantToFunc, tt.wantFromFunc)
+			}
+		})
+	}
+}
+
 // Note: CheckMapValueType, CheckRepeatedElementType, ExtractMapMessages, and ExtractRepeatedMessages
 // require actual protogen.Field descriptors to test properly. These functions are tested via
 // integration tests in the GORM and Datastore generator test suites, where real proto descriptors
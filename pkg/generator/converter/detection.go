@@ -69,6 +69,44 @@ func IsNumericConversion(sourceKind, targetKind string) bool {
 	return common.IsNumericKind(sourceKind) && common.IsNumericKind(targetKind)
 }
 
+// IsLosslessNumericCast checks if casting source → target → source always
+// returns the original value.
+//
+// Integer casts are lossless when the target is at least as wide as the
+// source (signedness only reinterprets the bits), float casts when the
+// target is at least as precise. Casts between integers and floats are
+// treated as lossy.
+//
+// Parameters:
+//   - sourceKind: proto kind string (e.g., "int64")
+//   - targetKind: proto kind string (e.g., "int32")
+//
+// Returns:
+//   - true if the round trip is lossless
+//
+// Examples:
+//   - IsLosslessNumericCast("uint32", "int32") -> true
+//   - IsLosslessNumericCast("int64", "int32") -> false
+//   - IsLosslessNumericCast("float", "double") -> true
+func IsLosslessNumericCast(sourceKind, targetKind string) bool {
+	sourceFloat := sourceKind == "float" || sourceKind == "double"
+	targetFloat := targetKind == "float" || targetKind == "double"
+	if sourceFloat != targetFloat {
+		return false
+	}
+	return numericBits(targetKind) >= numericBits(sourceKind)
+}
+
+// numericBits returns the width of a numeric proto kind.
+func numericBits(kind string) int {
+	switch kind {
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64", "double":
+		return 64
+	default:
+		return 32
+	}
+}
+
 // IsSameScalarType checks if source and target have the same scalar type.
 //
 // When types match and are not messages, conversion is a simple assignment.
@@ -72,6 +72,32 @@ func TestIsNumericConversion(t *testing.T) {
 	}
 }
 
+func TestIsLosslessNumericCast(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceKind string
+		targetKind string
+		want       bool
+	}{
+		{name: "widening", sourceKind: "int32", targetKind: "int64", want: true},
+		{name: "sign change", sourceKind: "uint32", targetKind: "int32", want: true},
+		{name: "fixed to int", sourceKind: "fixed64", targetKind: "int64", want: true},
+		{name: "narrowing", sourceKind: "int64", targetKind: "int32", want: false},
+		{name: "float to double", sourceKind: "float", targetKind: "double", want: true},
+		{name: "double to float", sourceKind: "double", targetKind: "float", want: false},
+		{name: "int to double", sourceKind: "int32", targetKind: "double", want: false},
+		{name: "double to int", sourceKind: "double", targetKind: "int64", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLosslessNumericCast(tt.sourceKind, tt.targetKind); got != tt.want {
+				t.Errorf("IsLosslessNumericCast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsSameScalarType(t *testing.T) {
 	tests := []struct {
 		name       string
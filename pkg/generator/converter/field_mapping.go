@@ -21,6 +21,8 @@ import (
 	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
 	"github.com/panyam/protoc-gen-dal/pkg/generator/registry"
 	"google.golang.org/protobuf/compiler/protogen"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
 )
 
 // FieldMapping contains all data needed to convert a field between source and target types.
@@ -29,6 +31,7 @@ type FieldMapping struct {
 	// Source and target field names
 	SourceField string
 	TargetField string
+	SourceName  string // Proto name of the source field (e.g., "created_at"), used in error paths
 
 	// Conversion code for direct transformations (empty if using converter functions)
 	ToTargetCode   string // API → Target conversion code
@@ -60,6 +63,20 @@ type FieldMapping struct {
 	SourceElementType string // For repeated/map: Go type of source element/value (e.g., "Author")
 	MapKeyType        string // For map fields: Go type of map key (e.g., "string", "int32", "bool")
 
+	// Element conversion for repeated/map fields of scalars and well-known types.
+	// Expressions over the loop variable ("item" for repeated, "value" for maps);
+	// when set, SourceElementType and TargetElementType are full Go types
+	// (e.g., "*timestamppb.Timestamp" and "time.Time").
+	ElementToTargetCode   string // e.g., "converters.TimestampToTime(item)"
+	ElementFromTargetCode string // e.g., "converters.TimeToTimestamp(item)"
+
+	// Child table characteristics (GORM child_table option)
+	ChildTable bool // Target elements are child rows: the converted element is in .Value, its position in .Ordinal
+
+	// Round-trip characteristics (empty for lossless conversions)
+	Lossy         string // What a ToTarget → FromTarget round trip loses (e.g., "sub-second precision")
+	RoundTripCode string // Expression for the source value a round trip returns, reading "want.<SourceField>" (empty if unknown)
+
 	// Package information
 	SourcePkgName string // Source package name (e.g., "api" or "testapi") - needed for type references
 }
@@ -112,6 +129,7 @@ type MessageFieldMappingParams struct {
 	FieldName     string
 	IsRepeated    bool
 	IsMap         bool
+	Warnings      *common.Warnings
 }
 
 // BuildMapFieldMapping handles map field conversions.
@@ -232,6 +250,134 @@ func BuildRepeatedFieldMapping(params RepeatedFieldMappingParams, mapping *Field
 	return true // Complete - return early
 }
 
+// BuildElementFieldMapping handles repeated and map fields whose elements (or
+// map values) are scalars or well-known types needing a conversion: custom
+// to_func/from_func converters, known type mappings (e.g., Timestamp → int64)
+// and numeric casts, applied to each element in a generated loop.
+// Returns true if an element conversion was found. Modifies mapping in place.
+func BuildElementFieldMapping(sourceField, targetField *protogen.Field, mapping *FieldMapping) bool {
+	sourceElem, targetElem := sourceField, targetField
+	elemVar := "item"
+	switch {
+	case sourceField.Desc.IsList() && targetField.Desc.IsList():
+	case sourceField.Desc.IsMap() && targetField.Desc.IsMap():
+		sourceElem, targetElem = sourceField.Message.Fields[1], targetField.Message.Fields[1]
+		elemVar = "value"
+	default:
+		return false
+	}
+
+	// Collections of regular messages are converted by their converter pairs
+	sourceType := elementGoType(sourceElem, mapping.SourcePkgName, false)
+	targetType := elementGoType(targetElem, mapping.SourcePkgName, true)
+	if sourceType == "" || targetType == "" {
+		return false
+	}
+
+	sourceKind := sourceElem.Desc.Kind().String()
+	targetKind := targetElem.Desc.Kind().String()
+	toConvType, fromConvType := ConvertByTransformer, ConvertByTransformer
+	var toCode, fromCode, lossy, roundTrip string
+
+	if toFunc, fromFunc := common.ExtractCustomConverterFuncs(targetField); toFunc.IsSet() || fromFunc.IsSet() {
+		// Custom converters take a single element; a missing side is assigned as-is
+		toCode, fromCode = elemVar, elemVar
+		if toFunc.IsSet() {
+			toCode = toFunc.Call(elemVar)
+			toConvType = customConversionType(toFunc)
+		}
+		if fromFunc.IsSet() {
+			fromCode = fromFunc.Call(elemVar)
+			fromConvType = customConversionType(fromFunc)
+		}
+		lossy = "custom converter"
+	} else if typeMapping := GetTypeMapping(sourceElem, targetElem); typeMapping != nil {
+		toCode = elementTemplate(typeMapping.ToTargetTemplate, elemVar)
+		fromCode = elementTemplate(typeMapping.FromTargetTemplate, elemVar)
+		toConvType, fromConvType = typeMapping.ConversionType, typeMapping.ConversionType
+		if typeMapping.ElementFromTargetTemplate != "" {
+			// Bad elements are reported with their index or key, not by a panic
+			fromCode = elementTemplate(typeMapping.ElementFromTargetTemplate, elemVar)
+			fromConvType = ConvertByTransformerWithError
+		}
+		lossy = typeMapping.Lossy
+		roundTrip = replaceAll(typeMapping.RoundTripTemplate, "want.{{.SourceField}}", "v")
+	} else if sourceKind != targetKind && common.IsNumericKind(sourceKind) && common.IsNumericKind(targetKind) {
+		toCode = fmt.Sprintf("%s(%s)", common.ProtoKindToGoType(targetKind), elemVar)
+		fromCode = fmt.Sprintf("%s(%s)", common.ProtoKindToGoType(sourceKind), elemVar)
+		if !IsLosslessNumericCast(sourceKind, targetKind) {
+			lossy = fmt.Sprintf("values outside the %s range or precision", targetKind)
+			roundTrip = fmt.Sprintf("%s(%s(v))", common.ProtoKindToGoType(sourceKind), common.ProtoKindToGoType(targetKind))
+		}
+	} else {
+		return false
+	}
+
+	if mapping.IsMap {
+		mapping.MapKeyType = common.ProtoKindToGoType(sourceField.Message.Fields[0].Desc.Kind().String())
+	}
+	mapping.SourceElementType = sourceType
+	mapping.TargetElementType = targetType
+	mapping.ElementToTargetCode = toCode
+	mapping.ElementFromTargetCode = fromCode
+	mapping.ToTargetConversionType = toConvType
+	mapping.FromTargetConversionType = fromConvType
+	mapping.Lossy = lossy
+	if roundTrip != "" {
+		// Normalise each element (or map value) "v" of the expected collection
+		each := "Each"
+		if mapping.IsMap {
+			each = "EachValue"
+		}
+		mapping.RoundTripCode = fmt.Sprintf("roundtrip.%s(want.%s, func(v %s) %s { return %s })",
+			each, sourceField.GoName, sourceType, sourceType, roundTrip)
+	}
+	return true
+}
+
+// customConversionType returns the conversion type of a custom converter
+// function: with error for functions returning one, a plain transformer otherwise.
+func customConversionType(fn common.CustomConverter) ConversionType {
+	if fn.ReturnsError() {
+		return ConvertByTransformerWithError
+	}
+	return ConvertByTransformer
+}
+
+// elementGoType returns the Go type of a repeated field's elements or a map
+// value field: the generated proto type on the source side, the well-known type
+// mapping on the target side. Returns "" for regular message elements.
+func elementGoType(field *protogen.Field, sourcePkgName string, isTarget bool) string {
+	switch field.Desc.Kind().String() {
+	case "message":
+		wkt, isWellKnown := common.GetWellKnownTypeMapping(field.Message)
+		if !isWellKnown {
+			return ""
+		}
+		if isTarget {
+			return wkt.GoType
+		}
+		return "*" + common.ExtractPackageInfo(field.Message).Alias + "." + field.Message.GoIdent.GoName
+	case "enum":
+		if field.Enum == nil {
+			return ""
+		}
+		if sourcePkgName != "" {
+			return sourcePkgName + "." + field.Enum.GoIdent.GoName
+		}
+		return field.Enum.GoIdent.GoName
+	default:
+		return common.ProtoScalarToGo(common.ProtoKindToGoType(field.Desc.Kind().String()))
+	}
+}
+
+// elementTemplate fills a type mapping template for a single element, read from
+// the loop variable elemVar instead of a src field.
+func elementTemplate(template, elemVar string) string {
+	result := replaceAll(template, "src.{{.SourceField}}", elemVar)
+	return replaceAll(result, "src.{{.TargetField}}", elemVar)
+}
+
 // BuildMessageToMessageMapping handles message→message conversions including google.protobuf.Any.
 // Returns: 0 = not applicable, 1 = conversion found, -1 = skip field (no converter).
 // Modifies mapping in place with conversion details.
@@ -308,18 +454,20 @@ func BuildMessageToMessageMapping(params MessageFieldMappingParams, mapping *Fie
 
 		// No converter available - warn user and mark for skip
 		if params.IsRepeated {
-			log.Printf("WARNING: Field '%s' is []%s but no converter found for element type.\n",
+			params.Warnings.Warnf("WARNING: Field '%s' is []%s but no converter found for element type.\n",
 				params.FieldName, sourceTypeName)
 		} else if params.IsMap {
-			log.Printf("WARNING: Field '%s' is map<K, %s> but no converter found for value type.\n",
+			params.Warnings.Warnf("WARNING: Field '%s' is map<K, %s> but no converter found for value type.\n",
 				params.FieldName, sourceTypeName)
 		} else {
-			log.Printf("WARNING: Field '%s' has matching message types (%s → %s) but no converter found.\n",
+			params.Warnings.Warnf("WARNING: Field '%s' has matching message types (%s → %s) but no converter found.\n",
 				params.FieldName, sourceTypeName, targetTypeName)
 		}
-		log.Printf("         If you want automatic conversion, add 'source' annotation to %s message.\n",
-			targetMsg.Desc.Name())
-		log.Printf("         Skipping field - handle in decorator function.\n\n")
+		if !params.Warnings.IsCapturing() {
+			log.Printf("         If you want automatic conversion, add 'source' annotation to %s message.\n",
+				targetMsg.Desc.Name())
+			log.Printf("         Skipping field - handle in decorator function.\n\n")
+		}
 
 		return -1 // Skip field
 	}
@@ -339,11 +487,43 @@ func BuildKnownTypeMapping(sourceField, targetField *protogen.Field, mapping *Fi
 		if targetIsPtr != nil {
 			mapping.TargetIsPointer = *targetIsPtr
 		}
+		if typeMapping := GetTypeMapping(sourceField, targetField); typeMapping != nil && typeMapping.Lossy != "" {
+			mapping.Lossy = typeMapping.Lossy
+			mapping.RoundTripCode = replaceTemplate(typeMapping.RoundTripTemplate, sourceField.GoName, targetField.GoName)
+		}
 		return true
 	}
 	return false
 }
 
+// BuildMessageStorageMapping handles message fields stored serialized
+// (storage: PROTO_BINARY or PROTOJSON). The source message is marshalled as-is
+// and unmarshalled back into its own Go type, so no sidecar is involved and
+// the message type the target field declares does not matter.
+// Returns true if the target field has a storage mode. Modifies mapping in place.
+func BuildMessageStorageMapping(sourceField, targetField *protogen.Field, mapping *FieldMapping) bool {
+	var toFunc, fromFunc string
+	switch common.GetMessageStorage(targetField) {
+	case dalv1.MessageStorage_PROTO_BINARY:
+		toFunc, fromFunc = "MessageToBytes", "BytesToMessage"
+	case dalv1.MessageStorage_PROTOJSON:
+		toFunc, fromFunc = "MessageToJSON", "JSONToMessage"
+	default:
+		return false
+	}
+	if sourceField.Message == nil {
+		return false
+	}
+
+	msgType := common.ExtractPackageInfo(sourceField.Message).Alias + "." + sourceField.Message.GoIdent.GoName
+	mapping.ToTargetCode = fmt.Sprintf("converters.%s(%s)", toFunc, sourceFieldAccess(sourceField.GoName, mapping.SourceIsOneofMember))
+	mapping.FromTargetCode = fmt.Sprintf("converters.%s[*%s](src.%s)", fromFunc, msgType, targetField.GoName)
+	mapping.ToTargetConversionType = ConvertByTransformerWithError
+	mapping.FromTargetConversionType = ConvertByTransformerWithError
+	mapping.TargetIsPointer = false
+	return true
+}
+
 // BuildSameTypeMapping handles same-type field conversions (direct assignment).
 // Returns true if types match. Modifies mapping in place.
 // Uses mapping.SourceIsOneofMember to determine correct field access (getter vs direct).
@@ -365,6 +545,28 @@ func BuildSameTypeMapping(sourceKind, targetKind, fieldName string, excludeMessa
 	return true
 }
 
+// BuildIDTypeMapping handles target fields with an id_type: the converters
+// cast the source value to the named ID type and back.
+// Returns true if the target field has an id_type and the source field the
+// same kind (see common.ValidateIDTypeField). Modifies mapping in place.
+//
+// Example: string id = 1 [(dal.v1.column) = { id_type: "GameID" }]
+//   - ToTarget:   GameID(src.Id)
+//   - FromTarget: string(src.Id)
+func BuildIDTypeMapping(sourceField, targetField *protogen.Field, mapping *FieldMapping) bool {
+	idType := common.GetIDType(targetField)
+	if idType == "" || sourceField.Desc.Kind() != targetField.Desc.Kind() {
+		return false
+	}
+
+	srcAccess := sourceFieldAccess(sourceField.GoName, mapping.SourceIsOneofMember)
+	mapping.ToTargetCode = fmt.Sprintf("%s(%s)", idType, srcAccess)
+	mapping.FromTargetCode = fmt.Sprintf("%s(src.%s)", common.ProtoScalarToGo(sourceField.Desc.Kind().String()), sourceField.GoName)
+	mapping.ToTargetConversionType = ConvertByAssignment
+	mapping.FromTargetConversionType = ConvertByAssignment
+	return true
+}
+
 // BuildNumericTypeMapping handles numeric type conversions using casting.
 // Returns true if both types are numeric. Modifies mapping in place.
 // Uses mapping.SourceIsOneofMember to determine correct field access (getter vs direct).
@@ -379,9 +581,26 @@ func BuildNumericTypeMapping(sourceKind, targetKind, fieldName string, mapping *
 	mapping.FromTargetCode = fmt.Sprintf("%s(src.%s)", common.ProtoKindToGoType(sourceKind), fieldName)
 	mapping.ToTargetConversionType = ConvertByAssignment
 	mapping.FromTargetConversionType = ConvertByAssignment
+	if !IsLosslessNumericCast(sourceKind, targetKind) {
+		mapping.Lossy = fmt.Sprintf("values outside the %s range or precision", targetKind)
+		mapping.RoundTripCode = fmt.Sprintf("%s(%s(want.%s))",
+			common.ProtoKindToGoType(sourceKind), common.ProtoKindToGoType(targetKind), fieldName)
+	}
 	return true
 }
 
+// UnmappedFieldMapping describes a source field that BuildFieldMapping found
+// no conversion for. Generated ...WithOptions converters hand these fields to
+// converters.ConvertUnmapped, which applies type converters and strict mode.
+func UnmappedFieldMapping(sourceField, targetField *protogen.Field) *FieldMapping {
+	return &FieldMapping{
+		SourceField:         sourceField.GoName,
+		TargetField:         targetField.GoName,
+		SourceName:          string(sourceField.Desc.Name()),
+		SourceIsOneofMember: sourceField.Oneof != nil && !sourceField.Oneof.Desc.IsSynthetic(),
+	}
+}
+
 // RenderStrategyAdder is a function type for adding render strategies to a field mapping.
 // This allows target-specific generators to customize the rendering logic.
 type RenderStrategyAdder func(*FieldMapping)
@@ -396,6 +615,7 @@ type RenderStrategyAdder func(*FieldMapping)
 //   - msgRegistry: Message registry for resolving message type mappings
 //   - sourcePkgName: Package name of the source message for template rendering
 //   - addRenderStrategies: Function to add target-specific render strategies
+//   - warnings: Receives warnings about skipped fields (nil logs them)
 //
 // Returns nil if no conversion is possible (field should be skipped).
 func BuildFieldMapping(
@@ -404,6 +624,7 @@ func BuildFieldMapping(
 	msgRegistry *common.MessageRegistry,
 	sourcePkgName string,
 	addRenderStrategies RenderStrategyAdder,
+	warnings *common.Warnings,
 ) *FieldMapping {
 	sourceKind := sourceField.Desc.Kind().String()
 	targetKind := targetField.Desc.Kind().String()
@@ -433,6 +654,7 @@ func BuildFieldMapping(
 	mapping := &FieldMapping{
 		SourceField:         sourceField.GoName,
 		TargetField:         targetField.GoName,
+		SourceName:          string(sourceField.Desc.Name()),
 		SourceIsPointer:     sourceIsPointer,
 		TargetIsPointer:     targetIsPointer,
 		SourceIsOneofMember: sourceIsOneofMember,
@@ -443,6 +665,13 @@ func BuildFieldMapping(
 	mapping.IsMap = sourceField.Desc.IsMap()
 	mapping.IsRepeated = sourceField.Desc.IsList()
 
+	// Step 0: Check collections converted element by element (custom converters,
+	// known types and numeric casts applied per element or map value)
+	if BuildElementFieldMapping(sourceField, targetField, mapping) {
+		addRenderStrategies(mapping)
+		return mapping
+	}
+
 	// Step 1: Check map fields (primitive maps return early)
 	if BuildMapFieldMapping(MapFieldMappingParams{
 		SourceField: sourceField,
@@ -491,12 +720,23 @@ func BuildFieldMapping(
 	}
 
 	// Step 3: Check for custom converter functions (highest priority - overrides defaults)
-	toTargetCode, fromTargetCode := common.ExtractCustomConverters(targetField, fieldName)
-	if toTargetCode != "" {
-		mapping.ToTargetCode = toTargetCode
-		mapping.FromTargetCode = fromTargetCode
-		mapping.ToTargetConversionType = ConvertByTransformer
+	toFunc, fromFunc := common.ExtractCustomConverterFuncs(targetField)
+	if toFunc.IsSet() {
+		mapping.ToTargetCode = toFunc.Call("src." + fieldName)
+		mapping.ToTargetConversionType = customConversionType(toFunc)
 		mapping.FromTargetConversionType = ConvertByTransformer
+		if fromFunc.IsSet() {
+			mapping.FromTargetCode = fromFunc.Call("src." + fieldName)
+			mapping.FromTargetConversionType = customConversionType(fromFunc)
+		}
+		// What user converters preserve is unknown, so round trips cannot be checked
+		mapping.Lossy = "custom converter"
+		addRenderStrategies(mapping)
+		return mapping
+	}
+
+	// Step 3b: Check for serialized message storage (PROTO_BINARY/PROTOJSON)
+	if BuildMessageStorageMapping(sourceField, targetField, mapping) {
 		addRenderStrategies(mapping)
 		return mapping
 	}
@@ -507,6 +747,12 @@ func BuildFieldMapping(
 		return mapping
 	}
 
+	// Step 4b: Check for named ID types (id_type), cast from the plain value
+	if BuildIDTypeMapping(sourceField, targetField, mapping) {
+		addRenderStrategies(mapping)
+		return mapping
+	}
+
 	// Step 5: Check for same-type fields (excluding messages for consistency)
 	if BuildSameTypeMapping(sourceKind, targetKind, fieldName, true, mapping) {
 		addRenderStrategies(mapping)
@@ -524,6 +770,7 @@ func BuildFieldMapping(
 		FieldName:   fieldName,
 		IsRepeated:  mapping.IsRepeated,
 		IsMap:       mapping.IsMap,
+		Warnings:    warnings,
 	}, mapping)
 	if msgStatus == -1 {
 		// No converter available - already logged warning - skip field
@@ -541,10 +788,12 @@ func BuildFieldMapping(
 	}
 
 	// No built-in conversion available - log warning and skip
-	log.Printf("WARNING: No type conversion found for field %q: %s (%s) → %s (%s).",
+	warnings.Warnf("WARNING: No type conversion found for field %q: %s (%s) → %s (%s).",
 		fieldName,
 		GetTypeName(sourceField), sourceKind,
 		GetTypeName(targetField), targetKind)
-	log.Printf("         Field will be skipped in converter - handle in decorator function.")
+	if !warnings.IsCapturing() {
+		log.Printf("         Field will be skipped in converter - handle in decorator function.")
+	}
 	return nil
 }
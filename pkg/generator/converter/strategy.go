@@ -44,6 +44,24 @@ const (
 	ConvertByTransformerWithIgnorableError
 )
 
+// String returns the name of the conversion type (e.g., "ByAssignment").
+func (c ConversionType) String() string {
+	switch c {
+	case ConvertIgnore:
+		return "Ignore"
+	case ConvertByAssignment:
+		return "ByAssignment"
+	case ConvertByTransformer:
+		return "ByTransformer"
+	case ConvertByTransformerWithError:
+		return "ByTransformerWithError"
+	case ConvertByTransformerWithIgnorableError:
+		return "ByTransformerWithIgnorableError"
+	default:
+		return "Unknown"
+	}
+}
+
 // FieldRenderStrategy represents how to render a field conversion in the template.
 //
 // This is an implementation detail derived from ConversionType plus field characteristics
@@ -84,6 +102,28 @@ const (
 	StrategyLoopMap
 )
 
+// String returns the name of the render strategy (e.g., "InlineValue").
+func (s FieldRenderStrategy) String() string {
+	switch s {
+	case StrategyInlineValue:
+		return "InlineValue"
+	case StrategySetterSimple:
+		return "SetterSimple"
+	case StrategySetterTransform:
+		return "SetterTransform"
+	case StrategySetterWithError:
+		return "SetterWithError"
+	case StrategySetterIgnoreError:
+		return "SetterIgnoreError"
+	case StrategyLoopRepeated:
+		return "LoopRepeated"
+	case StrategyLoopMap:
+		return "LoopMap"
+	default:
+		return "Unknown"
+	}
+}
+
 // FieldCharacteristics captures the properties of a field that affect rendering strategy.
 type FieldCharacteristics struct {
 	IsPointer          bool   // Whether field is a pointer type
@@ -37,6 +37,19 @@ type TypeMapping struct {
 	// TargetIsPointer overrides the pointer detection for target field
 	// Set to false for value types like time.Time
 	TargetIsPointer *bool
+
+	// Lossy describes what a source → target → source round trip loses
+	// (empty for lossless mappings)
+	Lossy string
+
+	// RoundTripTemplate is the Go expression for the source value a round trip
+	// returns, reading "want.{{.SourceField}}" (only for lossy mappings)
+	RoundTripTemplate string
+
+	// ElementFromTargetTemplate replaces FromTargetTemplate in repeated and map
+	// element loops with a (value, error) expression, for mappings whose
+	// FromTargetTemplate panics on bad input
+	ElementFromTargetTemplate string
 }
 
 // TypePair uniquely identifies a source→target type conversion.
@@ -52,6 +65,8 @@ var globalTypeMappings = map[TypePair]TypeMapping{
 		ToTargetTemplate:   "converters.TimestampToInt64(src.{{.SourceField}})",
 		FromTargetTemplate: "converters.Int64ToTimestamp(src.{{.TargetField}})",
 		ConversionType:     ConvertByTransformer,
+		Lossy:              "sub-second precision",
+		RoundTripTemplate:  "converters.TruncateTimestampToSeconds(want.{{.SourceField}})",
 	},
 
 	// google.protobuf.Timestamp → google.protobuf.Timestamp (maps to time.Time in Go)
@@ -74,6 +89,8 @@ var globalTypeMappings = map[TypePair]TypeMapping{
 		ToTargetTemplate:   "strconv.FormatUint(uint64(src.{{.SourceField}}), 10)",
 		FromTargetTemplate: "uint32(converters.MustParseUint(src.{{.TargetField}}))",
 		ConversionType:     ConvertByTransformer,
+
+		ElementFromTargetTemplate: "converters.ParseUint32(src.{{.TargetField}})",
 	},
 }
 
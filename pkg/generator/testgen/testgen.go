@@ -0,0 +1,232 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testgen builds the template data for generated converter
+// round-trip tests (generate_tests=true).
+//
+// For every converter pair the test fills a random API message (see
+// pkg/roundtrip), converts it to the target and back, and compares the result
+// with an expected message. The expected message is the input with the
+// fields the converters do not restore cleared, lossy conversions normalised
+// as declared in the IR, and nested messages normalised by the expectation of
+// their own converter pair.
+package testgen
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+)
+
+// RuntimeImportPath is the import path of the helpers used by generated tests.
+const RuntimeImportPath = "github.com/panyam/protoc-gen-dal/pkg/roundtrip"
+
+// convertersImportPath is the import path of the built-in conversion helpers.
+const convertersImportPath = "github.com/panyam/protoc-gen-dal/pkg/converters"
+
+// FileData contains all data for generating a converter test file.
+type FileData struct {
+	// PackageName is the Go package name (same as the converter file)
+	PackageName string
+
+	// Imports lists the source message packages and helper packages
+	Imports []common.ImportSpec
+
+	// Tests has one entry per converter pair in the proto file
+	Tests []*TestData
+}
+
+// TestData describes the round-trip test for one converter pair.
+type TestData struct {
+	SourceType string // Qualified source type (e.g., "api.User")
+	TargetType string // Target struct name (e.g., "UserGORM")
+	ToTarget   string // e.g., "UserToUserGORM"
+	FromTarget string // e.g., "UserFromUserGORM"
+	Expected   string // Name of the expectation function (e.g., "expectedUserFromUserGORM")
+
+	// Cleared lists source fields that FromTarget does not restore
+	Cleared []*ClearedField
+
+	// Lossy lists fields whose conversion loses information in a known way
+	Lossy []*LossyField
+
+	// Nested lists message fields converted by another converter pair
+	Nested []*NestedField
+
+	// EncodedKeys lists the source Go fields holding an encoded Datastore
+	// key (encoded_key), which the test fills with a valid key
+	EncodedKeys []string
+}
+
+// ClearedField is a source field cleared in the expected message.
+type ClearedField struct {
+	Name   string // Proto field name (e.g., "created_at")
+	Reason string // e.g., "skip_field", "oneof member"
+}
+
+// LossyField is a source field normalised to its round-trip value.
+type LossyField struct {
+	GoName string // Source Go field name (e.g., "CreatedAt")
+	Lossy  string // What the conversion loses (e.g., "sub-second precision")
+	Code   string // Expected value expression (e.g., "converters.TruncateTimestampToSeconds(want.CreatedAt)")
+}
+
+// NestedField is a message field normalised by its converter pair's expectation.
+type NestedField struct {
+	GoName   string // Source Go field name (e.g., "Author")
+	Expected string // Expectation function of the nested pair (e.g., "expectedAuthorFromAuthorGORM")
+	Repeated bool
+	Map      bool
+}
+
+// BuildFileData builds the test data for one IR document.
+//
+// Parameters:
+//   - doc: The IR document of a proto file (see ir.BuildDocument)
+//   - messages: The collected messages of the same proto file
+//
+// Returns:
+//   - test data, or nil if the file has no converters
+//   - error if a message in doc was not collected
+func BuildFileData(doc *ir.Document, messages []*collector.MessageInfo) (*FileData, error) {
+	infos := make(map[string]*collector.MessageInfo)
+	for _, msg := range messages {
+		infos[string(msg.TargetMessage.Desc.FullName())] = msg
+	}
+
+	data := &FileData{}
+	importsMap := make(common.ImportMap)
+	needsConverters := false
+	for _, irMsg := range doc.Messages {
+		if irMsg.Converter == nil {
+			continue // Embedded types have no converters
+		}
+		info, ok := infos[irMsg.Name]
+		if !ok {
+			return nil, fmt.Errorf("message %s is not in the collected messages", irMsg.Name)
+		}
+		if data.PackageName == "" {
+			data.PackageName = common.ExtractPackageName(info.TargetMessage)
+		}
+
+		pkgInfo := common.ExtractPackageInfo(info.SourceMessage)
+		importsMap.Add(common.ImportSpec{Alias: pkgInfo.Alias, Path: pkgInfo.ImportPath})
+
+		test := buildTestData(irMsg, info)
+		for _, lossy := range test.Lossy {
+			if strings.Contains(lossy.Code, "converters.") {
+				needsConverters = true
+			}
+		}
+		data.Tests = append(data.Tests, test)
+	}
+
+	if len(data.Tests) == 0 {
+		return nil, nil
+	}
+
+	importsMap.Add(common.ImportSpec{Path: RuntimeImportPath})
+	if needsConverters {
+		importsMap.Add(common.ImportSpec{Path: convertersImportPath})
+	}
+	data.Imports = importsMap.ToSlice()
+	return data, nil
+}
+
+// Filename returns the test filename for a proto file.
+//
+// Examples:
+//   - Filename("gorm/user.proto") -> "gorm/user_converters_test.go"
+func Filename(protoFile string) string {
+	return common.GenerateFilenameFromProto(protoFile, "_converters_test.go")
+}
+
+// buildTestData decides, field by field, what the round trip of a message
+// is expected to return.
+func buildTestData(irMsg *ir.Message, info *collector.MessageInfo) *TestData {
+	conv := irMsg.Converter
+	test := &TestData{
+		SourceType: common.ExtractPackageName(info.SourceMessage) + "." + conv.SourceType,
+		TargetType: conv.TargetType,
+		ToTarget:   conv.ToTarget,
+		FromTarget: conv.FromTarget,
+		Expected:   "expected" + conv.FromTarget,
+	}
+
+	conversions := make(map[string]*ir.Conversion)
+	for _, field := range irMsg.Fields {
+		if field.Conversion != nil {
+			conversions[field.Name] = field.Conversion
+		}
+	}
+	skipReasons := make(map[string]string)
+	for _, skipped := range irMsg.SkippedFields {
+		skipReasons[skipped.Name] = skipped.Reason
+	}
+
+	for _, field := range info.TargetMessage.Fields {
+		if common.IsEncodedKey(field) {
+			test.EncodedKeys = append(test.EncodedKeys, field.GoName)
+		}
+	}
+
+	for _, field := range info.SourceMessage.Fields {
+		name := string(field.Desc.Name())
+		conversion := conversions[name]
+
+		switch {
+		case conversion == nil:
+			reason := skipReasons[name]
+			if reason == "" {
+				reason = ir.SkipReasonNoConversion
+			}
+			test.Cleared = append(test.Cleared, &ClearedField{Name: name, Reason: reason})
+		case conversion.FromTarget == nil:
+			test.Cleared = append(test.Cleared, &ClearedField{Name: name, Reason: "oneof member"})
+		case conversion.Lossy != "" && conversion.RoundTrip == "":
+			test.Cleared = append(test.Cleared, &ClearedField{Name: name, Reason: conversion.Lossy})
+		case conversion.RoundTrip != "":
+			test.Lossy = append(test.Lossy, &LossyField{
+				GoName: field.GoName,
+				Lossy:  conversion.Lossy,
+				Code:   conversion.RoundTrip,
+			})
+		default:
+			if nested := nestedField(field, conversion); nested != nil {
+				test.Nested = append(test.Nested, nested)
+			}
+		}
+	}
+	return test
+}
+
+// nestedField returns the nested expectation for a field converted by another
+// generated converter pair, or nil for other conversions.
+func nestedField(field *protogen.Field, conversion *ir.Conversion) *NestedField {
+	fromFunc := conversion.FromTarget.ConverterFunc
+	if fromFunc == "" || strings.Contains(fromFunc, ".") {
+		return nil // Not a generated converter (e.g., converters.AnyBytesToMessageConverter)
+	}
+	return &NestedField{
+		GoName:   field.GoName,
+		Expected: "expected" + fromFunc,
+		Repeated: field.Desc.IsList(),
+		Map:      field.Desc.IsMap(),
+	}
+}
@@ -33,9 +33,26 @@ type TestProtoSet struct {
 
 // TestFile represents a single proto file with messages.
 type TestFile struct {
-	Name     string
-	Pkg      string
-	Messages []TestMessage
+	Name        string
+	Pkg         string
+	Messages    []TestMessage
+	Imports     []string                    // Files whose messages are referenced (other packages)
+	AutoSidecar []*dalv1.AutoSidecarOptions // Sets (dal.v1.auto_sidecar)
+	Services    []TestService
+}
+
+// TestService represents a proto service with optional DAL options.
+type TestService struct {
+	Name        string
+	Methods     []TestMethod
+	ServiceOpts *dalv1.ServiceOptions // Sets (dal.v1.service)
+}
+
+// TestMethod represents a unary rpc.
+type TestMethod struct {
+	Name   string
+	Input  string // Fully qualified request type (e.g., "test.v1.GetBookRequest")
+	Output string // Fully qualified response type
 }
 
 // TestMessage represents a proto message with optional DAL options.
@@ -44,6 +61,7 @@ type TestMessage struct {
 	Fields        []TestField
 	GormOpts      *dalv1.GormOptions
 	DatastoreOpts *dalv1.DatastoreOptions
+	SkipDAL       bool // Sets (dal.v1.skip_dal) = true
 }
 
 // TestField represents a proto field.
@@ -55,6 +73,7 @@ type TestField struct {
 	Repeated   bool
 	IsMap      bool
 	MapKeyType string // For map fields: "int32", "string", etc.
+	SkipField  bool   // Sets (dal.v1.skip_field) = true
 }
 
 // CreateTestPlugin creates a protogen.Plugin from a test proto set.
@@ -102,6 +121,11 @@ func BuildFileDescriptor(t *testing.T, file TestFile) *descriptorpb.FileDescript
 		Options: &descriptorpb.FileOptions{
 			GoPackage: proto.String(goPackage),
 		},
+		Dependency: file.Imports,
+	}
+
+	if len(file.AutoSidecar) > 0 {
+		proto.SetExtension(fileDesc.Options, dalv1.E_AutoSidecar, file.AutoSidecar)
 	}
 
 	for _, msg := range file.Messages {
@@ -109,6 +133,10 @@ func BuildFileDescriptor(t *testing.T, file TestFile) *descriptorpb.FileDescript
 		fileDesc.MessageType = append(fileDesc.MessageType, msgDesc)
 	}
 
+	for _, svc := range file.Services {
+		fileDesc.Service = append(fileDesc.Service, BuildServiceDescriptor(svc))
+	}
+
 	return fileDesc
 }
 
@@ -201,10 +229,15 @@ func BuildMessageDescriptorWithPackage(t *testing.T, msg TestMessage, pkg string
 				fieldDesc.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
 			}
 
-			// Add column options if present
-			if field.ColumnOpts != nil {
+			// Add column options and skip_field if present
+			if field.ColumnOpts != nil || field.SkipField {
 				opts := &descriptorpb.FieldOptions{}
-				proto.SetExtension(opts, dalv1.E_Column, field.ColumnOpts)
+				if field.ColumnOpts != nil {
+					proto.SetExtension(opts, dalv1.E_Column, field.ColumnOpts)
+				}
+				if field.SkipField {
+					proto.SetExtension(opts, dalv1.E_SkipField, true)
+				}
 				fieldDesc.Options = opts
 			}
 
@@ -223,10 +256,35 @@ func BuildMessageDescriptorWithPackage(t *testing.T, msg TestMessage, pkg string
 		proto.SetExtension(opts, dalv1.E_DatastoreOptions, msg.DatastoreOpts)
 		msgDesc.Options = opts
 	}
+	if msg.SkipDAL {
+		if msgDesc.Options == nil {
+			msgDesc.Options = &descriptorpb.MessageOptions{}
+		}
+		proto.SetExtension(msgDesc.Options, dalv1.E_SkipDal, true)
+	}
 
 	return msgDesc
 }
 
+// BuildServiceDescriptor creates a ServiceDescriptorProto from a test service.
+func BuildServiceDescriptor(svc TestService) *descriptorpb.ServiceDescriptorProto {
+	svcDesc := &descriptorpb.ServiceDescriptorProto{
+		Name: proto.String(svc.Name),
+	}
+	for _, method := range svc.Methods {
+		svcDesc.Method = append(svcDesc.Method, &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(method.Name),
+			InputType:  proto.String("." + method.Input),
+			OutputType: proto.String("." + method.Output),
+		})
+	}
+	if svc.ServiceOpts != nil {
+		svcDesc.Options = &descriptorpb.ServiceOptions{}
+		proto.SetExtension(svcDesc.Options, dalv1.E_Service, svc.ServiceOpts)
+	}
+	return svcDesc
+}
+
 // GetFieldType returns the proto field type enum for a type name.
 func GetFieldType(typeName string) *descriptorpb.FieldDescriptorProto_Type {
 	switch typeName {
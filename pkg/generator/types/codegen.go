@@ -46,6 +46,15 @@ type ConverterData struct {
 	// SourcePkgName is the source package name for imports (e.g., "api", "testapi")
 	SourcePkgName string
 
+	// PathRoot is the snake_case source message name starting field paths in
+	// conversion errors (e.g., "library")
+	PathRoot string
+
+	// SliceToTargetFunc and SliceFromTargetFunc are the names of the batch
+	// converters for slices of the pair (e.g., "UserSliceToUserGORMSlice")
+	SliceToTargetFunc   string
+	SliceFromTargetFunc string
+
 	// FieldMappings is the list of field conversions (for backward compatibility)
 	FieldMappings []*converter.FieldMapping
 
@@ -57,6 +66,14 @@ type ConverterData struct {
 	FromTargetInlineFields []*converter.FieldMapping // Fields for struct literal initialization (FromTarget)
 	FromTargetSetterFields []*converter.FieldMapping // Fields needing setter statements (FromTarget)
 	FromTargetLoopFields   []*converter.FieldMapping // Fields needing loop-based conversion (FromTarget)
+
+	// Source fields with no generated conversion; the ...WithOptions converters
+	// pass them to converters.ConvertUnmapped (type converters, strict mode)
+	UnmappedFields []*converter.FieldMapping
+
+	// EncodedKey maps the source field holding the web-safe encoded key
+	// (encoded_key) to the entity's Key, nil if none (Datastore only)
+	EncodedKey *converter.FieldMapping
 }
 
 // FieldData contains data for a single struct field.
@@ -66,6 +83,15 @@ type FieldData struct {
 	Tags       string // struct tag content (e.g., "primaryKey;type:uuid")
 	IsOptional bool   // Whether field is marked optional in proto (affects pointer generation)
 	IsMap      bool   // Whether field is a map type (e.g., map[string]int64)
+
+	// Change detection (Equal and ChangedColumns methods)
+	Column       string          // Column or property name; empty if the field is not stored as one
+	Equality     common.Equality // How Equal compares the field; empty to leave it out (e.g., a Datastore Key)
+	Embedded     bool            // Nested struct whose own columns are stored on this struct's table
+	ColumnPrefix string          // Prefix of an embedded struct's columns (e.g., "by_")
+
+	// Cloning is how the Clone method copies the field (see common.FieldCloning)
+	Cloning common.Cloning
 }
 
 // ConverterFileData contains all data for generating a converter file.
@@ -78,7 +104,4 @@ type ConverterFileData struct {
 
 	// Converters is the list of converter functions to generate
 	Converters []*ConverterData
-
-	// HasRepeatedMessageConversions indicates if any converter has repeated/map message conversions (needs fmt)
-	HasRepeatedMessageConversions bool
 }
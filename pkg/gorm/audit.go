@@ -0,0 +1,55 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+)
+
+// gormAutoTimeTags turns off GORM's own time tracking on a column. GORM
+// tracks fields named CreatedAt/UpdatedAt even without tags, which would
+// override the DAL's clock.
+const gormAutoTimeTags = "autoCreateTime:false;autoUpdateTime:false"
+
+// applyAuditTags hands the audit time columns over to the generated DAL by
+// disabling GORM's autoCreateTime/autoUpdateTime on them.
+//
+// Returns an error if an audit column also sets those tags itself, since the
+// two would disagree on who fills it.
+func applyAuditTags(fields []FieldData, audit *common.AuditFields, structName string) error {
+	if audit == nil {
+		return nil
+	}
+	for _, auditField := range audit.TimeFields() {
+		for i := range fields {
+			if fields[i].Name != auditField.Name {
+				continue
+			}
+			for _, tag := range strings.Split(fields[i].Tags, ";") {
+				if strings.HasPrefix(tag, "autoCreateTime") || strings.HasPrefix(tag, "autoUpdateTime") {
+					return fmt.Errorf("field '%s.%s': audit column '%s' is filled by the DAL and cannot also use %s", structName, fields[i].Name, auditField.ColumnName, tag)
+				}
+			}
+			if fields[i].Tags != "" {
+				fields[i].Tags += ";"
+			}
+			fields[i].Tags += gormAutoTimeTags
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Go field names of the generated child row struct.
+const (
+	childParentKeyField = "ParentID"
+	childOrdinalField   = "Ordinal"
+	childValueField     = "Value"
+)
+
+// ChildTableData describes a repeated message field stored in a child table.
+type ChildTableData struct {
+	FieldName     string          // Parent struct field (e.g., "Contributors")
+	StructName    string          // Child row struct (e.g., "LibraryGORMContributorsChild")
+	TableName     string          // Child table (e.g., "libraries_contributors")
+	ForeignKey    string          // Column holding the parent's key (e.g., "parent_id")
+	OrdinalColumn string          // Column holding the list position (e.g., "ordinal")
+	ElementType   string          // Struct stored in each row (e.g., "AuthorGORM")
+	ParentKey     PrimaryKeyField // Parent primary key the rows reference
+}
+
+// childStructName returns the row struct name for a child table field.
+// E.g., ("LibraryGORM", contributors) -> "LibraryGORMContributorsChild"
+func childStructName(parentStruct string, field *protogen.Field) string {
+	return parentStruct + field.GoName + "Child"
+}
+
+// buildChildTables collects the child table fields of a message.
+//
+// Parameters:
+//   - msg: The message owning the fields
+//   - fields: The message's merged fields
+//   - structName: The message's struct name
+//   - registry: Message registry used to resolve element struct names
+//
+// Returns:
+//   - child table data in field order (nil if there are none)
+//   - error if a field cannot be stored in a child table
+func buildChildTables(msg *collector.MessageInfo, fields []*protogen.Field, structName string, registry *common.MessageRegistry) ([]ChildTableData, error) {
+	var children []ChildTableData
+	for _, field := range fields {
+		if common.GetChildTableOptions(field) == nil {
+			continue
+		}
+		if err := common.ValidateChildTableField(field, structName, msg.TableName); err != nil {
+			return nil, err
+		}
+
+		primaryKeys, err := detectPrimaryKeysInFields(fields)
+		if err != nil || len(primaryKeys) != 1 {
+			return nil, fmt.Errorf("field '%s.%s': child_table requires a single-column primary key on the parent", structName, field.GoName)
+		}
+
+		goType := common.ProtoFieldToGoType(field, buildStructName, "", registry)
+		children = append(children, ChildTableData{
+			FieldName:     field.GoName,
+			StructName:    childStructName(structName, field),
+			TableName:     common.ChildTableName(field, msg.TableName),
+			ForeignKey:    common.ChildTableForeignKey(field),
+			OrdinalColumn: common.ChildTableOrdinalColumn(field),
+			ElementType:   strings.TrimPrefix(goType, "[]"),
+			ParentKey:     primaryKeys[0],
+		})
+	}
+	return children, nil
+}
+
+// buildChildStructData builds the row struct for a child table.
+// Each row holds the parent's key and the element's position, which together
+// form its primary key, followed by the element's own columns.
+func buildChildStructData(parentStruct string, child ChildTableData) StructData {
+	return StructData{
+		Name:         child.StructName,
+		TableName:    child.TableName,
+		ChildTableOf: parentStruct + "." + child.FieldName,
+		Fields: []FieldData{
+			{Name: childParentKeyField, Type: child.ParentKey.Type, Tags: "primaryKey;column:" + child.ForeignKey, Column: child.ForeignKey, Equality: common.EqualValue, Cloning: common.CloneValue},
+			{Name: childOrdinalField, Type: "int", Tags: "primaryKey;column:" + child.OrdinalColumn, Column: child.OrdinalColumn, Equality: common.EqualValue, Cloning: common.CloneValue},
+			{Name: childValueField, Type: child.ElementType, Tags: "embedded", Embedded: true, Equality: common.EqualStruct, Cloning: common.CloneStruct},
+		},
+	}
+}
+
+// childTableFieldData returns the parent struct field for a child table:
+// a has-many association on the row struct.
+func childTableFieldData(child ChildTableData) FieldData {
+	return FieldData{
+		Name: child.FieldName,
+		Type: "[]" + child.StructName,
+		Tags: "foreignKey:" + childParentKeyField + ";references:" + child.ParentKey.Name,
+
+		// Rows are not columns of the parent; Equal compares their values
+		Equality: common.EqualRows,
+		Cloning:  common.CloneStructSlice,
+	}
+}
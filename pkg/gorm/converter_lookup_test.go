@@ -110,7 +110,7 @@ func TestConverterLookup_NestedMessage(t *testing.T) {
 	}
 
 	// Build converter data - this should succeed without warnings
-	converterData, err := buildConverterData(worldMsg, convRegistry, msgRegistry)
+	converterData, err := buildConverterData(worldMsg, convRegistry, msgRegistry, nil)
 	if err != nil {
 		t.Fatalf("buildConverterData failed: %v", err)
 	}
@@ -219,7 +219,7 @@ func TestConverterLookup_RepeatedMessage(t *testing.T) {
 		t.Fatal("WorldDataGorm message not found")
 	}
 
-	converterData, err := buildConverterData(worldDataMsg, convRegistry, msgRegistry)
+	converterData, err := buildConverterData(worldDataMsg, convRegistry, msgRegistry, nil)
 	if err != nil {
 		t.Fatalf("buildConverterData failed: %v", err)
 	}
@@ -324,7 +324,7 @@ func TestConverterLookup_MapWithMessageValue(t *testing.T) {
 		t.Fatal("GameGorm message not found")
 	}
 
-	converterData, err := buildConverterData(gameMsg, convRegistry, msgRegistry)
+	converterData, err := buildConverterData(gameMsg, convRegistry, msgRegistry, nil)
 	if err != nil {
 		t.Fatalf("buildConverterData failed: %v", err)
 	}
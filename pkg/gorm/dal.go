@@ -22,6 +22,7 @@ import (
 	"github.com/panyam/protoc-gen-dal/pkg/collector"
 	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // DALOptions contains configuration for DAL helper generation
@@ -36,17 +37,47 @@ type DALOptions struct {
 type PrimaryKeyField struct {
 	Name       string // Go field name (e.g., "Id", "BookId")
 	ProtoName  string // Proto field name (e.g., "id", "book_id")
-	Type       string // Go type (e.g., "string", "int32")
+	Type       string // Go type (e.g., "string", "int32", or the ID type: "GameID", "v1.GameID" in DAL packages)
 	ColumnName string // Database column name (from tags or snake_case of proto name)
+	BaseType   string // Plain Go type of an ID type (e.g., "string"); same as Type otherwise
+	IDType     string // Named ID type (id_type, e.g., "GameID"); empty for plain types
+
+	IDStrategy *common.IDStrategy // How the DAL fills the key when empty (nil without id_strategy)
+}
+
+// TenantField represents the tenant column of a tenant-scoped message
+type TenantField struct {
+	Name       string // Go field name (e.g., "TenantId")
+	ColumnName string // Database column name (e.g., "tenant_id")
 }
 
 // DALData holds the template data for DAL helper generation
 type DALData struct {
-	StructName     string            // e.g., "WorldGORM"
-	DALTypeName    string            // e.g., "WorldGORMDAL"
-	PrimaryKeys    []PrimaryKeyField // Primary key fields (in order)
-	HasCompositePK bool              // Whether there are multiple primary keys
-	PKStructName   string            // Composite key struct name (e.g., "WorldKey")
+	StructName     string               // e.g., "WorldGORM"
+	DALTypeName    string               // e.g., "WorldGORMDAL"
+	PrimaryKeys    []PrimaryKeyField    // Primary key fields (in order)
+	HasCompositePK bool                 // Whether there are multiple primary keys
+	PKStructName   string               // Composite key struct name (e.g., "WorldKey")
+	ChildTables    []ChildTableData     // Child table fields synced on write and preloaded on read
+	Tenant         *TenantField         // Tenant column every operation is scoped to (nil if not tenant-scoped)
+	Audit          *common.AuditFields  // Audit columns filled on writes (nil if not audited)
+	SourceType     string               // API message type for IterateAPI (e.g., "api.Note"; empty without a source)
+	FromConverter  string               // Converter from the struct to the API message (e.g., "NoteFromNoteGORM")
+	SourceImport   common.ImportSpec    // Import of the API message's package
+	FilterFields   []common.FilterField // API fields allowed in AIP-160 filters, with their columns
+
+	// HasGeneratedIDs is set when a primary key is filled by an idgen.Generator
+	HasGeneratedIDs bool
+
+	// Read-through cache wrapper (cache option)
+	CachedDALTypeName string // e.g., "WorldGORMCachedDAL" (empty if not cached)
+	ToConverter       string // Converter from the API message to the struct (e.g., "NoteToNoteGORM")
+}
+
+// GenerateDALHelpers generates DAL helper methods with the built-in templates
+// (see Generator.GenerateDALHelpers).
+func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
+	return builtinGenerator.GenerateDALHelpers(messages, options)
 }
 
 // GenerateDALHelpers generates DAL helper methods for GORM messages.
@@ -65,7 +96,7 @@ type DALData struct {
 // Returns:
 //   - GenerateResult containing DAL helper files
 //   - error if generation fails
-func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
+func (g *Generator) GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -101,7 +132,7 @@ func GenerateDALHelpers(messages []*collector.MessageInfo, options *DALOptions)
 			entityPkgInfo.Alias = common.GetPackageAlias(importPath)
 		}
 
-		content, err := generateDALFileCodeWithOptions(msgs, entityPkgInfo, options)
+		content, err := g.generateDALFileCodeWithOptions(msgs, entityPkgInfo, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate DAL helpers for %s: %w", protoFile, err)
 		}
@@ -169,11 +200,11 @@ func generateDALFileCode(messages []*collector.MessageInfo) (string, error) {
 		return "", fmt.Errorf("no messages to generate DAL helpers for")
 	}
 	entityPkgInfo := common.ExtractPackageInfo(messages[0].TargetMessage)
-	return generateDALFileCodeWithOptions(messages, entityPkgInfo, &DALOptions{})
+	return builtinGenerator.generateDALFileCodeWithOptions(messages, entityPkgInfo, &DALOptions{})
 }
 
 // generateDALFileCodeWithOptions generates the DAL helper code with subdirectory support
-func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkgInfo common.PackageInfo, options *DALOptions) (string, error) {
+func (g *Generator) generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkgInfo common.PackageInfo, options *DALOptions) (string, error) {
 	if len(messages) == 0 {
 		return "", fmt.Errorf("no messages to generate DAL helpers for")
 	}
@@ -191,7 +222,7 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 			continue
 		}
 
-		dalData, err := buildDALData(msg)
+		dalData, err := BuildDALData(msg)
 		if err != nil {
 			// Skip messages that don't have primary keys
 			// (e.g., embedded types or messages without id fields)
@@ -242,6 +273,74 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 		imports.Add(common.ImportSpec{Path: "gorm.io/gorm"})
 	}
 
+	// ID types live in the entity package
+	for i := range dals {
+		for j, pk := range dals[i].PrimaryKeys {
+			if pk.IDType != "" {
+				dals[i].PrimaryKeys[j].Type = entityPrefix + pk.IDType
+				if pk.IDStrategy != nil {
+					pk.IDStrategy.Convert = entityPrefix + pk.IDType
+				}
+			}
+		}
+	}
+
+	// Tenant-scoped DALs read the tenant through pkg/tenant
+	for _, dal := range dals {
+		if dal.Tenant != nil {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/tenant"})
+			break
+		}
+	}
+
+	// IterateAPI yields the source API messages
+	for _, dal := range dals {
+		if dal.SourceType != "" {
+			imports.Add(dal.SourceImport)
+		}
+	}
+
+	// Filter schemas and scopes are built with pkg/filtering
+	for _, dal := range dals {
+		if len(dal.FilterFields) > 0 {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/filtering"})
+			break
+		}
+	}
+
+	// Cached DALs store records through pkg/cache
+	for _, dal := range dals {
+		if dal.CachedDALTypeName != "" {
+			imports.Add(common.ImportSpec{Path: "time"})
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/cache"})
+			break
+		}
+	}
+
+	// Audited DALs read the actor and clock through pkg/audit
+	for _, dal := range dals {
+		if dal.Audit != nil {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/audit"})
+			break
+		}
+	}
+
+	// Generated primary keys come from pkg/idgen
+	for _, dal := range dals {
+		if dal.HasGeneratedIDs {
+			imports.Add(common.ImportSpec{Path: "github.com/panyam/protoc-gen-dal/pkg/idgen"})
+			break
+		}
+	}
+
+	// Child table sync needs upsert clauses
+	for _, dal := range dals {
+		if len(dal.ChildTables) > 0 {
+			imports.Add(common.ImportSpec{Path: "gorm.io/gorm/clause"})
+			break
+		}
+	}
+
 	// Build template data
 	data := DALTemplateData{
 		PackageName:  packageName,
@@ -252,11 +351,12 @@ func generateDALFileCodeWithOptions(messages []*collector.MessageInfo, entityPkg
 	}
 
 	// Render the DAL template
-	return renderTemplate("dal.go.tmpl", data)
+	return g.renderTemplate("dal.go.tmpl", data)
 }
 
-// buildDALData builds the template data for a single message's DAL helper
-func buildDALData(msg *collector.MessageInfo) (DALData, error) {
+// BuildDALData builds the template data for a single message's DAL helper
+// (also used by protoc-gen-dal-service to call the generated DAL)
+func BuildDALData(msg *collector.MessageInfo) (DALData, error) {
 	structName := buildStructName(msg.TargetMessage)
 	dalTypeName := structName + "DAL"
 
@@ -266,6 +366,11 @@ func buildDALData(msg *collector.MessageInfo) (DALData, error) {
 		return DALData{}, fmt.Errorf("failed to detect primary keys for %s: %w", structName, err)
 	}
 
+	hasGeneratedIDs, err := resolveIDStrategies(msg.TargetMessage.Fields, primaryKeys, structName)
+	if err != nil {
+		return DALData{}, err
+	}
+
 	hasCompositePK := len(primaryKeys) > 1
 	pkStructName := ""
 	if hasCompositePK {
@@ -274,21 +379,89 @@ func buildDALData(msg *collector.MessageInfo) (DALData, error) {
 		pkStructName = strings.TrimSuffix(structName, "GORM") + "Key"
 	}
 
+	mergedFields, err := common.MergeSourceFields(msg.SourceMessage, msg.TargetMessage)
+	if err != nil {
+		return DALData{}, fmt.Errorf("failed to merge fields for %s: %w", structName, err)
+	}
+	childTables, err := buildChildTables(msg, mergedFields, structName, nil)
+	if err != nil {
+		return DALData{}, err
+	}
+
+	tenantField, err := findTenantField(msg, mergedFields)
+	if err != nil {
+		return DALData{}, err
+	}
+
+	audit, err := common.ResolveAuditFields(msg.Audit, mergedFields, structName)
+	if err != nil {
+		return DALData{}, err
+	}
+
+	var sourceType, fromConverter, toConverter string
+	var sourceImport common.ImportSpec
+	if msg.SourceMessage != nil {
+		sourceName := string(msg.SourceMessage.Desc.Name())
+		pkgInfo := common.ExtractPackageInfo(msg.SourceMessage)
+		sourceType = pkgInfo.Alias + "." + sourceName
+		fromConverter = sourceName + "From" + structName
+		toConverter = sourceName + "To" + structName
+		sourceImport = common.ImportSpec{Alias: pkgInfo.Alias, Path: pkgInfo.ImportPath}
+	}
+
+	// Cached DALs store records as serialized source messages
+	cachedDALTypeName := ""
+	if msg.Cache {
+		if msg.SourceMessage == nil {
+			return DALData{}, fmt.Errorf("cache option of %s requires a source message", structName)
+		}
+		cachedDALTypeName = structName + "CachedDAL"
+	}
+
 	return DALData{
 		StructName:     structName,
 		DALTypeName:    dalTypeName,
 		PrimaryKeys:    primaryKeys,
 		HasCompositePK: hasCompositePK,
 		PKStructName:   pkStructName,
+		ChildTables:    childTables,
+		Tenant:         tenantField,
+		Audit:          audit,
+		SourceType:     sourceType,
+		FromConverter:  fromConverter,
+		SourceImport:   sourceImport,
+		FilterFields:   common.FilterFields(msg.SourceMessage, mergedFields, filterColumn),
+
+		HasGeneratedIDs: hasGeneratedIDs,
+
+		CachedDALTypeName: cachedDALTypeName,
+		ToConverter:       toConverter,
 	}, nil
 }
 
+// filterColumn returns the column of a field for filter schemas, or "" if
+// GORM ignores the field ("-" tags)
+func filterColumn(field *protogen.Field) string {
+	for _, tag := range common.GetColumnOptions(field).GetGormTags() {
+		if strings.HasPrefix(tag, "-") {
+			return ""
+		}
+	}
+	return common.GetColumnName(field)
+}
+
 // detectPrimaryKeys detects primary key fields from GORM tags or defaults to "id" field
 func detectPrimaryKeys(msg *protogen.Message) ([]PrimaryKeyField, error) {
+	return detectPrimaryKeysInFields(msg.Fields)
+}
+
+// detectPrimaryKeysInFields detects primary key fields among fields, e.g. a
+// message's merged fields, from GORM tags or defaults to "id" field
+func detectPrimaryKeysInFields(fields []*protogen.Field) ([]PrimaryKeyField, error) {
 	var primaryKeys []PrimaryKeyField
 
 	// First pass: look for fields with "primaryKey" in gorm_tags
-	for _, field := range msg.Fields {
+	for _, field := range fields {
 		if hasPrimaryKeyTag(field) {
 			pkField := PrimaryKeyField{
 				Name:       field.GoName,
@@ -296,13 +469,13 @@ func detectPrimaryKeys(msg *protogen.Message) ([]PrimaryKeyField, error) {
 				Type:       getGoType(field),
 				ColumnName: common.GetColumnName(field),
 			}
-			primaryKeys = append(primaryKeys, pkField)
+			primaryKeys = append(primaryKeys, withIDType(pkField, field))
 		}
 	}
 
 	// If no primary keys found, default to "id" field
 	if len(primaryKeys) == 0 {
-		for _, field := range msg.Fields {
+		for _, field := range fields {
 			if strings.ToLower(string(field.Desc.Name())) == "id" {
 				pkField := PrimaryKeyField{
 					Name:       field.GoName,
@@ -310,7 +483,7 @@ func detectPrimaryKeys(msg *protogen.Message) ([]PrimaryKeyField, error) {
 					Type:       getGoType(field),
 					ColumnName: "id",
 				}
-				primaryKeys = append(primaryKeys, pkField)
+				primaryKeys = append(primaryKeys, withIDType(pkField, field))
 				break
 			}
 		}
@@ -323,6 +496,56 @@ func detectPrimaryKeys(msg *protogen.Message) ([]PrimaryKeyField, error) {
 	return primaryKeys, nil
 }
 
+// withIDType sets the types of a primary key field from its field's id_type.
+// The ID type is unqualified, as used in the entity package.
+func withIDType(pk PrimaryKeyField, field *protogen.Field) PrimaryKeyField {
+	pk.BaseType = pk.Type
+	if idType := common.GetIDType(field); idType != "" {
+		pk.IDType = idType
+		pk.Type = idType
+	}
+	return pk
+}
+
+// resolveIDStrategies sets the IDStrategy of the primary keys with an
+// id_strategy. Returns whether any of them is filled by an idgen.Generator.
+// Strategies on other fields are rejected when generating the entity.
+func resolveIDStrategies(fields []*protogen.Field, primaryKeys []PrimaryKeyField, structName string) (bool, error) {
+	generated := false
+	for _, field := range fields {
+		strategy, err := common.ResolveIDStrategy(field, structName)
+		if err != nil {
+			return false, err
+		}
+		for i := range primaryKeys {
+			if strategy != nil && primaryKeys[i].Name == field.GoName {
+				primaryKeys[i].IDStrategy = strategy
+				generated = generated || strategy.Generated != ""
+			}
+		}
+	}
+	return generated, nil
+}
+
+// findTenantField finds the field holding a message's tenant_column.
+// Returns nil if the message is not tenant-scoped, and an error if no string
+// field maps to the column.
+func findTenantField(msg *collector.MessageInfo, fields []*protogen.Field) (*TenantField, error) {
+	if msg.TenantColumn == "" {
+		return nil, nil
+	}
+	for _, field := range fields {
+		if common.GetColumnName(field) != msg.TenantColumn {
+			continue
+		}
+		if field.Desc.Kind() != protoreflect.StringKind || field.Desc.IsList() {
+			return nil, fmt.Errorf("tenant_column '%s' of %s must be a string field, got %s", msg.TenantColumn, msg.TargetMessage.Desc.Name(), field.Desc.Kind())
+		}
+		return &TenantField{Name: field.GoName, ColumnName: msg.TenantColumn}, nil
+	}
+	return nil, fmt.Errorf("tenant_column '%s' of %s does not match any field's column", msg.TenantColumn, msg.TargetMessage.Desc.Name())
+}
+
 // hasPrimaryKeyTag checks if a field has "primaryKey" in its gorm_tags
 func hasPrimaryKeyTag(field *protogen.Field) bool {
 	// Get column options from the field
@@ -247,9 +247,9 @@ func TestBuildDALData_SingleKey(t *testing.T) {
 	}
 
 	// Build DAL data
-	dalData, err := buildDALData(msgInfo)
+	dalData, err := BuildDALData(msgInfo)
 	if err != nil {
-		t.Fatalf("buildDALData failed: %v", err)
+		t.Fatalf("BuildDALData failed: %v", err)
 	}
 
 	// Verify DAL data
@@ -317,9 +317,9 @@ func TestBuildDALData_CompositeKey(t *testing.T) {
 	}
 
 	// Build DAL data
-	dalData, err := buildDALData(msgInfo)
+	dalData, err := BuildDALData(msgInfo)
 	if err != nil {
-		t.Fatalf("buildDALData failed: %v", err)
+		t.Fatalf("BuildDALData failed: %v", err)
 	}
 
 	// Verify composite key handling
@@ -670,7 +670,7 @@ func TestGenerateDALFileCode_WithSubdirectory(t *testing.T) {
 		ImportPath: "github.com/test/gen/v1",
 		Alias:      "v1",
 	}
-	content, err := generateDALFileCodeWithOptions(messages, entityPkgInfo, options)
+	content, err := builtinGenerator.generateDALFileCodeWithOptions(messages, entityPkgInfo, options)
 	if err != nil {
 		t.Fatalf("generateDALFileCodeWithOptions failed: %v", err)
 	}
@@ -703,3 +703,419 @@ func TestGenerateDALFileCode_WithSubdirectory(t *testing.T) {
 		t.Error("Found unprefixed entity type references (should all be prefixed with 'v1.')")
 	}
 }
+
+func TestGenerateDALFileCode_ChildTable(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, childTableProtos(&dalv1.ColumnOptions{
+		ChildTable: &dalv1.ChildTableOptions{Table: "book_authors", ForeignKey: "book_id", OrdinalColumn: "position"},
+	}, testutil.TestField{
+		Name: "id", Number: 1, TypeName: "string",
+		ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}},
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`"gorm.io/gorm/clause"`,
+		// Writes run in a transaction that syncs the rows
+		"tx.Omit(clause.Associations).Save(obj)",
+		"return d.syncChildren(tx, obj)",
+		"obj.Authors[i].ParentID = obj.Id",
+		"tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&obj.Authors)",
+		`tx.Where("book_id = ? AND position >= ?", obj.Id, len(obj.Authors)).Delete(&BookGORMAuthorsChild{})`,
+		// Reads preload the rows in order
+		`Preload("Authors", func(db *gorm.DB) *gorm.DB {`,
+		`return db.Order("position")`,
+		"err := d.preload(d.db(db)).First(&out",
+		// Delete removes the rows with the parent
+		`children.Where("book_id = ?", id).Delete(&BookGORMAuthorsChild{})`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// tenantProtos returns a BookGorm with the given tenant_column and extra fields.
+func tenantProtos(tenantColumn string, extra ...testutil.TestField) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "title", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "library/v1/dal/book_gorm.proto",
+				Pkg:     "library.v1.dal",
+				Imports: []string{"library/v1/book.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books", TenantColumn: tenantColumn},
+						Fields: append([]testutil.TestField{
+							{
+								Name: "id", Number: 1, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}},
+							},
+						}, extra...),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGenerateDALFileCode_Tenant tests that a tenant_column DAL reads the
+// tenant from the context and scopes every operation to it
+func TestGenerateDALFileCode_Tenant(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, tenantProtos("org_id", testutil.TestField{
+		Name: "org_id", Number: 10, TypeName: "string",
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/tenant"`,
+		"TenantExtractor tenant.Extractor",
+		"tenantID, err := tenant.Get(ctx, d.TenantExtractor)",
+		// Writes stamp the tenant
+		"obj.OrgId = tenantID",
+		`result := d.db(db).Where("org_id = ?", tenantID).Updates(obj)`,
+		"if err == nil && existing.OrgId != tenantID {\n\t\treturn tenant.ErrCrossTenant",
+		// Reads and deletes are scoped
+		`err = d.db(db).Where("org_id = ?", tenantID).First(&out, "id = ?", id).Error`,
+		`d.db(db).Where("org_id = ?", tenantID).Where("id = ?", id).Delete(&BookGORM{})`,
+		`err = d.db(query).Where("org_id = ?", tenantID).Find(&out).Error`,
+		`d.db(db).Where("org_id = ?", tenantID).Where("id IN ?", ids).Find(&out)`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateGORM_TenantColumnInvalid tests that a tenant_column must name a
+// string field
+func TestGenerateGORM_TenantColumnInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		extra []testutil.TestField
+		want  string
+	}{
+		{"missing", nil, "does not match any field's column"},
+		{"not a string", []testutil.TestField{{Name: "org_id", Number: 10, TypeName: "int64"}}, "must be a string field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := testutil.CreateTestPlugin(t, tenantProtos("org_id", tt.extra...))
+			messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// auditProtos returns a BookGorm with the given audit options and extra fields.
+func auditProtos(audit *dalv1.AuditOptions, extra ...testutil.TestField) *testutil.TestProtoSet {
+	protos := tenantProtos("", extra...)
+	protos.Files[1].Messages[0].GormOpts.Audit = audit
+	return protos
+}
+
+// TestGenerateDALFileCode_Audit tests that audit columns are filled by the
+// DAL and no longer tracked by GORM itself
+func TestGenerateDALFileCode_Audit(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, auditProtos(&dalv1.AuditOptions{
+		CreatedAt: "created_at",
+		UpdatedAt: "updated_at",
+		UpdatedBy: "updated_by",
+	},
+		testutil.TestField{Name: "created_at", Number: 10, TypeName: "int64"},
+		testutil.TestField{Name: "updated_at", Number: 11, TypeName: "int64"},
+		testutil.TestField{Name: "updated_by", Number: 12, TypeName: "string"},
+	))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	structs := result.Files[0].Content
+	if !strings.Contains(structs, `gorm:"autoCreateTime:false;autoUpdateTime:false"`) {
+		t.Errorf("Expected GORM time tracking disabled on audit columns.\nGenerated content:\n%s", structs)
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/audit"`,
+		"ActorExtractor audit.Extractor",
+		"Clock audit.Clock",
+		"now := audit.Now(d.Clock)",
+		"actor := audit.Actor(ctx, d.ActorExtractor)",
+		"if creating {\n\t\tobj.CreatedAt = now.Unix()\n\t}",
+		"obj.UpdatedAt = now.Unix()",
+		"obj.UpdatedBy = actor",
+		// Save keeps the stored creation audit
+		"obj.CreatedAt = existing.CreatedAt",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerateGORM_AuditInvalid tests that audit columns must name fields of
+// the right type
+func TestGenerateGORM_AuditInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		audit *dalv1.AuditOptions
+		extra []testutil.TestField
+		want  string
+	}{
+		{"missing", &dalv1.AuditOptions{CreatedBy: "created_by"}, nil, "does not match any field's column"},
+		{
+			"actor not a string", &dalv1.AuditOptions{CreatedBy: "created_by"},
+			[]testutil.TestField{{Name: "created_by", Number: 10, TypeName: "int64"}},
+			"must be a string field",
+		},
+		{
+			"time not a timestamp", &dalv1.AuditOptions{UpdatedAt: "updated_at"},
+			[]testutil.TestField{{Name: "updated_at", Number: 10, TypeName: "string"}},
+			"must be a google.protobuf.Timestamp or int64 field",
+		},
+		{
+			"autoCreateTime tag", &dalv1.AuditOptions{CreatedAt: "created_at"},
+			[]testutil.TestField{{
+				Name: "created_at", Number: 10, TypeName: "int64",
+				ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"autoCreateTime"}},
+			}},
+			"cannot also use autoCreateTime",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := testutil.CreateTestPlugin(t, auditProtos(tt.audit, tt.extra...))
+			messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestGenerateDALFileCode_Iterate tests that single-key DALs stream with
+// FindInBatches, composite-key DALs stream with Rows, and DALs with a source
+// message also get IterateAPI
+func TestGenerateDALFileCode_Iterate(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, tenantProtos(""))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"func (d *BookGORMDAL) Iterate(ctx context.Context, query *gorm.DB, batchSize int, fn func(*BookGORM) error) error {",
+		"return d.db(query).FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {",
+		"if err := ctx.Err(); err != nil {",
+		"func (d *BookGORMDAL) IterateAPI(ctx context.Context, query *gorm.DB, batchSize int, fn func(*v1.Book) error) error {",
+		"msg, err := BookFromBookGORM(nil, obj, nil)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	// A composite key cannot be batched, so rows are scanned one at a time
+	plugin = testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "test/book.proto",
+				Pkg:  "test.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookEditionGORM",
+						GormOpts: &dalv1.GormOptions{Table: "book_editions"},
+						Fields: []testutil.TestField{
+							{
+								Name: "book_id", Number: 1, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}},
+							},
+							{
+								Name: "edition", Number: 2, TypeName: "int32",
+								ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	content, err = generateDALFileCode([]*collector.MessageInfo{
+		{TargetMessage: plugin.Files[0].Messages[0], GenerateDAL: true},
+	})
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+	for _, want := range []string{
+		"rows, err := d.db(query).Model(&BookEditionGORM{}).Rows()",
+		"if err := query.ScanRows(rows, &obj); err != nil {",
+		"return rows.Err()",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "IterateAPI") {
+		t.Error("Expected no IterateAPI for a DAL without a source message")
+	}
+}
+
+// TestGenerateDALFileCode_FilterSchema tests that DALs with a source message
+// get a filter schema over the source fields stored as they are
+func TestGenerateDALFileCode_FilterSchema(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, tenantProtos("",
+		testutil.TestField{
+			Name: "title", Number: 2, TypeName: "string",
+			ColumnOpts: &dalv1.ColumnOptions{Name: "book_title"},
+		},
+		testutil.TestField{Name: "org_id", Number: 10, TypeName: "string"},
+	))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/filtering"`,
+		"var BookGORMFilterSchema = filtering.MustSchema((&v1.Book{}).ProtoReflect().Descriptor(), map[string]string{\n" +
+			"\t\"id\": \"id\",\n\t\"title\": \"book_title\",\n})",
+		"func (d *BookGORMDAL) FilterScope(filter string) (func(*gorm.DB) *gorm.DB, error) {",
+		"expr, err := filtering.Parse(BookGORMFilterSchema, filter)",
+		"cond, args := filtering.SQL(expr)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+	// Fields without a source field cannot be filtered
+	if strings.Contains(content, `"org_id"`) {
+		t.Error("Expected org_id to be left out of the filter schema")
+	}
+}
+
+// TestGenerateDALFileCode_Cache tests that the cache option generates a
+// read-through wrapper keyed by tenant and primary key
+func TestGenerateDALFileCode_Cache(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, tenantProtos("org_id", testutil.TestField{
+		Name: "org_id", Number: 10, TypeName: "string",
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	content, err := generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+	if strings.Contains(content, "CachedDAL") {
+		t.Error("Expected no cached DAL without the cache option")
+	}
+
+	for _, msg := range messages {
+		msg.Cache = true
+	}
+	content, err = generateDALFileCode(messages)
+	if err != nil {
+		t.Fatalf("generateDALFileCode failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/cache"`,
+		"type BookGORMCachedDAL struct {\n\tBookGORMDAL\n",
+		"func NewBookGORMCachedDAL(tableName string, c cache.Cache) *BookGORMCachedDAL {",
+		"return cache.Key(prefix, d.TableName, tenantID, id), nil",
+		// Cached records are serialized source messages
+		"obj, err := BookToBookGORM(&msg, nil, nil)",
+		"obj.OrgId, _ = tenant.Get(ctx, d.TenantExtractor)",
+		"msg, err := BookFromBookGORM(nil, obj, nil)",
+		"d.Cache.Set(ctx, key, data, d.NegativeTTL)",
+		// Reads go through the cache, writes invalidate it
+		"func (d *BookGORMCachedDAL) Get(ctx context.Context, db *gorm.DB, id string) (*BookGORM, error) {",
+		"loaded, err := d.BookGORMDAL.BatchGet(ctx, db, missing)",
+		"if err := d.BookGORMDAL.Save(ctx, db, obj); err != nil {\n\t\treturn err\n\t}\n\treturn d.invalidate(ctx, obj.Id)",
+		"if err := d.BookGORMDAL.Delete(ctx, db, id); err != nil {\n\t\treturn err\n\t}\n\treturn d.invalidate(ctx, id)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
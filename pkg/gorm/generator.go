@@ -16,7 +16,6 @@ package gorm
 
 import (
 	"fmt"
-	"log"
 	"sort"
 	"strings"
 
@@ -35,6 +34,12 @@ import (
 type GeneratedFile = types.GeneratedFile
 type GenerateResult = types.GenerateResult
 
+// Generate generates GORM code for the given messages with the built-in
+// templates (see Generator.Generate).
+func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	return builtinGenerator.Generate(messages)
+}
+
 // Generate generates GORM code for the given messages.
 //
 // This is the main entry point for GORM code generation. It receives all
@@ -50,7 +55,7 @@ type GenerateResult = types.GenerateResult
 // Returns:
 //   - GenerateResult containing all generated files
 //   - error if generation fails
-func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
+func (g *Generator) Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -100,10 +105,16 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	}
 	sort.Strings(protoFiles)
 
+	// Named ID types (id_type) are declared once per Go package
+	idTypes, err := common.CollectIDTypes(protoFiles, fileGroups)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate one file per proto file (without embedded types)
 	for _, protoFile := range protoFiles {
 		msgs := fileGroups[protoFile]
-		content, err := generateFileCodeWithoutEmbedded(msgs, msgRegistry)
+		content, err := g.generateFileCodeWithoutEmbedded(msgs, msgRegistry, idTypes[protoFile])
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate code for %s: %w", protoFile, err)
 		}
@@ -120,7 +131,7 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 
 	// Generate a single shared file for all embedded types (if any)
 	if len(sharedEmbeddedTypes) > 0 {
-		content, err := generateEmbeddedTypesFile(sharedEmbeddedTypes, messages[0].TargetMessage, msgRegistry)
+		content, err := g.generateEmbeddedTypesFile(sharedEmbeddedTypes, messages[0].TargetMessage, msgRegistry)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate embedded types: %w", err)
 		}
@@ -134,6 +145,12 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	return &GenerateResult{Files: files}, nil
 }
 
+// GenerateConverters generates converter functions with the built-in templates
+// (see Generator.GenerateConverters).
+func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	return builtinGenerator.GenerateConverters(messages)
+}
+
 // GenerateConverters generates converter functions for transforming between
 // API messages and GORM structs.
 //
@@ -147,7 +164,7 @@ func Generate(messages []*collector.MessageInfo) (*GenerateResult, error) {
 // Returns:
 //   - GenerateResult containing converter files (*_converters.go)
 //   - error if generation fails
-func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
+func (g *Generator) GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, error) {
 	if len(messages) == 0 {
 		return &GenerateResult{Files: []*GeneratedFile{}}, nil
 	}
@@ -171,7 +188,7 @@ func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, err
 	// Generate one converter file per proto file
 	for _, protoFile := range protoFiles {
 		msgs := fileGroups[protoFile]
-		content, err := generateConverterFileCode(msgs, msgRegistry)
+		content, err := g.generateConverterFileCode(msgs, msgRegistry)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate converters for %s: %w", protoFile, err)
 		}
@@ -189,11 +206,91 @@ func GenerateConverters(messages []*collector.MessageInfo) (*GenerateResult, err
 	return &GenerateResult{Files: files}, nil
 }
 
+// GenerateExtras renders the generator's extra templates.
+//
+// Each extra template is rendered once per proto file with ExtraTemplateData,
+// which carries the same struct and converter data as the built-in templates.
+// Output files are named after the proto file and the template, e.g.
+// "metrics.go.tmpl" for gorm/user.proto -> gorm/user_metrics.go.
+//
+// Parameters:
+//   - messages: Collected GORM messages from the collector
+//
+// Returns:
+//   - GenerateResult containing one file per proto file and extra template
+//   - error if generation fails
+func (g *Generator) GenerateExtras(messages []*collector.MessageInfo) (*GenerateResult, error) {
+	if len(messages) == 0 || g.templateOptions == nil || len(g.templateOptions.Extras) == 0 {
+		return &GenerateResult{Files: []*GeneratedFile{}}, nil
+	}
+
+	msgRegistry := common.NewMessageRegistry(messages, buildStructName)
+
+	// Group messages by their source proto file
+	fileGroups := common.GroupMessagesByFile(messages)
+
+	// Get sorted proto file paths for deterministic output
+	protoFiles := make([]string, 0, len(fileGroups))
+	for protoFile := range fileGroups {
+		protoFiles = append(protoFiles, protoFile)
+	}
+	sort.Strings(protoFiles)
+
+	idTypes, err := common.CollectIDTypes(protoFiles, fileGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*GeneratedFile
+	for _, protoFile := range protoFiles {
+		msgs := fileGroups[protoFile]
+		entities, err := buildFileTemplateData(msgs, msgRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build struct data for %s: %w", protoFile, err)
+		}
+		entities.IDTypes = idTypes[protoFile]
+		converters, err := buildConverterFileData(msgs, msgRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build converter data for %s: %w", protoFile, err)
+		}
+
+		data := ExtraTemplateData{
+			ProtoFile:  protoFile,
+			Entities:   entities,
+			Converters: converters,
+		}
+		for _, extra := range g.templateOptions.Extras {
+			content, err := g.renderTemplate(extra.Name, data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render %s for %s: %w", extra.Name, protoFile, err)
+			}
+			files = append(files, &GeneratedFile{
+				Path:    common.ExtraTemplateFilename(protoFile, extra.Name),
+				Content: content,
+			})
+		}
+	}
+
+	return &GenerateResult{Files: files}, nil
+}
+
 // generateFileCodeWithoutEmbedded generates Go code for messages in a proto file.
 // Embedded types are NOT included - they're generated separately in _embedded_gorm.go
-func generateFileCodeWithoutEmbedded(messages []*collector.MessageInfo, registry *common.MessageRegistry) (string, error) {
+func (g *Generator) generateFileCodeWithoutEmbedded(messages []*collector.MessageInfo, registry *common.MessageRegistry, idTypes []common.IDType) (string, error) {
+	data, err := buildFileTemplateData(messages, registry)
+	if err != nil {
+		return "", err
+	}
+	data.IDTypes = idTypes
+
+	// Render the file template
+	return g.renderTemplate("file.go.tmpl", data)
+}
+
+// buildFileTemplateData builds the struct file template data for messages in a proto file.
+func buildFileTemplateData(messages []*collector.MessageInfo, registry *common.MessageRegistry) (TemplateData, error) {
 	if len(messages) == 0 {
-		return "", fmt.Errorf("no messages to generate")
+		return TemplateData{}, fmt.Errorf("no messages to generate")
 	}
 
 	// Extract package name from the first message's target
@@ -208,13 +305,20 @@ func generateFileCodeWithoutEmbedded(messages []*collector.MessageInfo, registry
 	importsMap.Add(common.ImportSpec{Path: "database/sql/driver"})
 	importsMap.Add(common.ImportSpec{Path: "encoding/json"})
 	importsMap.Add(common.ImportSpec{Path: "fmt"})
+	importsMap.Add(common.ImportSpec{Path: "bytes"})
+	importsMap.Add(common.ImportSpec{Path: "reflect"})
+	importsMap.Add(common.ImportSpec{Path: "slices"})
+	importsMap.Add(common.ImportSpec{Path: "maps"})
 
 	for _, msg := range messages {
-		structData, err := buildStructData(msg, registry)
+		structData, err := buildStructData(msg, registry, nil)
 		if err != nil {
-			return "", err
+			return TemplateData{}, err
 		}
 		structs = append(structs, structData)
+		for _, child := range structData.ChildTables {
+			structs = append(structs, buildChildStructData(structData.Name, child))
+		}
 
 		// Add source package import only if actually needed (for enum types)
 		// Check if any field type references the source package
@@ -239,19 +343,16 @@ func generateFileCodeWithoutEmbedded(messages []*collector.MessageInfo, registry
 	imports := importsMap.ToSlice()
 
 	// Build template data
-	data := TemplateData{
+	return TemplateData{
 		PackageName: packageName,
 		Imports:     imports,
 		Structs:     structs,
-	}
-
-	// Render the file template
-	return renderTemplate("file.go.tmpl", data)
+	}, nil
 }
 
 // generateEmbeddedTypesFile generates a single file containing all shared embedded types.
 // This prevents duplicate type definitions across multiple generated files.
-func generateEmbeddedTypesFile(embeddedTypes map[string]*protogen.Message, sampleMsg *protogen.Message, registry *common.MessageRegistry) (string, error) {
+func (g *Generator) generateEmbeddedTypesFile(embeddedTypes map[string]*protogen.Message, sampleMsg *protogen.Message, registry *common.MessageRegistry) (string, error) {
 	if len(embeddedTypes) == 0 {
 		return "", fmt.Errorf("no embedded types to generate")
 	}
@@ -291,6 +392,10 @@ func generateEmbeddedTypesFile(embeddedTypes map[string]*protogen.Message, sampl
 	importsMap.Add(common.ImportSpec{Path: "database/sql/driver"})
 	importsMap.Add(common.ImportSpec{Path: "encoding/json"})
 	importsMap.Add(common.ImportSpec{Path: "fmt"})
+	importsMap.Add(common.ImportSpec{Path: "bytes"})
+	importsMap.Add(common.ImportSpec{Path: "reflect"})
+	importsMap.Add(common.ImportSpec{Path: "slices"})
+	importsMap.Add(common.ImportSpec{Path: "maps"})
 
 	data := TemplateData{
 		PackageName: packageName,
@@ -299,13 +404,24 @@ func generateEmbeddedTypesFile(embeddedTypes map[string]*protogen.Message, sampl
 	}
 
 	// Render the file template
-	return renderTemplate("file.go.tmpl", data)
+	return g.renderTemplate("file.go.tmpl", data)
 }
 
 // generateConverterFileCode generates converter functions for all messages in a proto file.
-func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (string, error) {
+func (g *Generator) generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (string, error) {
+	data, err := buildConverterFileData(messages, msgRegistry)
+	if err != nil {
+		return "", err
+	}
+
+	// Render the converter file template
+	return g.renderTemplate("converters.go.tmpl", data)
+}
+
+// buildConverterFileData builds the converter template data for all messages in a proto file.
+func buildConverterFileData(messages []*collector.MessageInfo, msgRegistry *common.MessageRegistry) (ConverterFileData, error) {
 	if len(messages) == 0 {
-		return "", fmt.Errorf("no messages to generate converters for")
+		return ConverterFileData{}, fmt.Errorf("no messages to generate converters for")
 	}
 
 	// Extract package name from the first message's target
@@ -324,9 +440,9 @@ func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *c
 			continue
 		}
 
-		converterData, err := buildConverterData(msg, registry, msgRegistry)
+		converterData, err := buildConverterData(msg, registry, msgRegistry, nil)
 		if err != nil {
-			return "", fmt.Errorf("failed to build converter data for %s: %w", msg.TargetMessage.Desc.Name(), err)
+			return ConverterFileData{}, fmt.Errorf("failed to build converter data for %s: %w", msg.TargetMessage.Desc.Name(), err)
 		}
 		converters = append(converters, converterData)
 
@@ -339,41 +455,29 @@ func generateConverterFileCode(messages []*collector.MessageInfo, msgRegistry *c
 
 		// Collect custom converter package imports
 		common.CollectCustomConverterImports(msg.TargetMessage, importsMap)
+		common.CollectMessageStorageImports(msg.SourceMessage, msg.TargetMessage, importsMap)
 	}
 
+	// The ...WithOptions converters take a context
+	importsMap.Add(common.ImportSpec{Path: "context"})
+
 	// Build import list using ImportMap's ToSlice method
 	importList := importsMap.ToSlice()
 
-	// Check if we need fmt import (for repeated/map message conversions)
-	hasFmtNeeded := false
-	for _, conv := range converters {
-		for _, field := range conv.FieldMappings {
-			if (field.IsRepeated || field.IsMap) && field.ToTargetConverterFunc != "" {
-				hasFmtNeeded = true
-				break
-			}
-		}
-		if hasFmtNeeded {
-			break
-		}
-	}
-
 	// Build template data
-	data := ConverterFileData{
-		PackageName:                   packageName,
-		Imports:                       importList,
-		Converters:                    converters,
-		HasRepeatedMessageConversions: hasFmtNeeded,
-	}
-
-	// Render the converter file template
-	return renderTemplate("converters.go.tmpl", data)
+	return ConverterFileData{
+		PackageName: packageName,
+		Imports:     importList,
+		Converters:  converters,
+	}, nil
 }
 
 // collectEmbeddedTypes collects all message-type fields from a message.
+// Serialized message fields (storage option) are skipped, as they are stored
+// as []byte/string rather than as structs.
 func collectEmbeddedTypes(msg *protogen.Message, types map[string]*protogen.Message) {
 	for _, field := range msg.Fields {
-		if field.Desc.Kind().String() == "message" && field.Message != nil {
+		if field.Desc.Kind().String() == "message" && field.Message != nil && !common.HasMessageStorage(field) {
 			// Add to map (using full name as key to avoid duplicates)
 			fullName := string(field.Message.Desc.FullName())
 			if _, exists := types[fullName]; !exists {
@@ -384,7 +488,8 @@ func collectEmbeddedTypes(msg *protogen.Message, types map[string]*protogen.Mess
 }
 
 // buildStructData extracts struct information from a MessageInfo.
-func buildStructData(msg *collector.MessageInfo, registry *common.MessageRegistry) (StructData, error) {
+// Field warnings go to warnings (nil logs them).
+func buildStructData(msg *collector.MessageInfo, registry *common.MessageRegistry, warnings *common.Warnings) (StructData, error) {
 	targetMsg := msg.TargetMessage
 	sourceMsg := msg.SourceMessage
 
@@ -410,10 +515,37 @@ func buildStructData(msg *collector.MessageInfo, registry *common.MessageRegistr
 	}
 
 	// Build fields from merged list with validation
-	fields, err := buildFieldsWithValidation(mergedFields, sourcePkgAlias, registry, structName)
+	fields, err := buildFieldsWithValidation(mergedFields, sourcePkgAlias, registry, structName, warnings)
+	if err != nil {
+		return StructData{}, err
+	}
+
+	// The tenant column must exist so the DAL can scope by it
+	if _, err := findTenantField(msg, mergedFields); err != nil {
+		return StructData{}, err
+	}
+
+	// Audit columns must exist, and their times are filled by the DAL alone
+	audit, err := common.ResolveAuditFields(msg.Audit, mergedFields, structName)
 	if err != nil {
 		return StructData{}, err
 	}
+	if err := applyAuditTags(fields, audit, structName); err != nil {
+		return StructData{}, err
+	}
+
+	// Child table fields become has-many associations on generated row structs
+	childTables, err := buildChildTables(msg, mergedFields, structName, registry)
+	if err != nil {
+		return StructData{}, err
+	}
+	for _, child := range childTables {
+		for i := range fields {
+			if fields[i].Name == child.FieldName {
+				fields[i] = childTableFieldData(child)
+			}
+		}
+	}
 
 	return StructData{
 		Name:             structName,
@@ -421,11 +553,13 @@ func buildStructData(msg *collector.MessageInfo, registry *common.MessageRegistr
 		TableName:        msg.TableName,
 		Fields:           fields,
 		ImplementScanner: msg.ImplementScanner,
+		ChildTables:      childTables,
 	}, nil
 }
 
 // buildConverterData builds converter function data from a MessageInfo.
-func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegistry, msgRegistry *common.MessageRegistry) (*types.ConverterData, error) {
+// Warnings about fields without a conversion go to warnings (nil logs them).
+func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegistry, msgRegistry *common.MessageRegistry, warnings *common.Warnings) (*types.ConverterData, error) {
 	// Extract source type name and package
 	sourceTypeName := string(msg.SourceMessage.Desc.Name())
 	sourcePkgName := common.ExtractPackageName(msg.SourceMessage)
@@ -441,7 +575,7 @@ func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegis
 	}
 
 	// Build field mappings between source and GORM with built-in conversions
-	var fieldMappings []*converter.FieldMapping
+	var fieldMappings, unmapped []*converter.FieldMapping
 
 	// Create a map of source fields by name for quick lookup
 	sourceFields := make(map[string]*protogen.Field)
@@ -460,12 +594,19 @@ func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegis
 		}
 
 		// Generate conversion code based on type compatibility
-		mapping := buildFieldConversion(sourceField, mergedField, reg, sourcePkgName, msgRegistry)
+		mapping := buildFieldConversion(sourceField, mergedField, reg, sourcePkgName, msgRegistry, warnings)
 		if mapping == nil {
-			// No conversion possible - skip, decorator must handle
+			// No conversion possible - decorator or type converters must handle
+			unmapped = append(unmapped, converter.UnmappedFieldMapping(sourceField, mergedField))
 			continue
 		}
 
+		// Child table elements are converted into the row struct's Value
+		if mapping.IsRepeated && common.GetChildTableOptions(mergedField) != nil {
+			mapping.ChildTable = true
+			mapping.TargetElementType = childStructName(gormTypeName, mergedField)
+		}
+
 		fieldMappings = append(fieldMappings, mapping)
 	}
 
@@ -479,10 +620,17 @@ func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegis
 	fromSetter := filterNonOneofFields(classified.FromTargetSetter)
 	fromLoop := filterNonOneofFields(classified.FromTargetLoop)
 
+	sliceToTarget, sliceFromTarget := converter.BuildBatchConverterNames(sourceTypeName, gormTypeName)
+
 	return &types.ConverterData{
 		SourceType:    sourceTypeName,
 		SourcePkgName: sourcePkgName,
 		TargetType:    gormTypeName,
+		PathRoot:      common.ToSnakeCase(sourceTypeName),
+
+		SliceToTargetFunc:   sliceToTarget,
+		SliceFromTargetFunc: sliceFromTarget,
+
 		FieldMappings: fieldMappings, // Keep for backward compatibility
 
 		// Classified field groups
@@ -493,6 +641,8 @@ func buildConverterData(msg *collector.MessageInfo, reg *registry.ConverterRegis
 		FromTargetInlineFields: fromInline,
 		FromTargetSetterFields: fromSetter,
 		FromTargetLoopFields:   fromLoop,
+
+		UnmappedFields: unmapped,
 	}, nil
 }
 
@@ -523,8 +673,8 @@ func addRenderStrategies(mapping *converter.FieldMapping) {
 		mapping.TargetIsPointer,
 		mapping.IsRepeated,
 		mapping.IsMap,
-		mapping.ToTargetConverterFunc != "",
-		mapping.FromTargetConverterFunc != "",
+		mapping.ToTargetConverterFunc != "" || mapping.ElementToTargetCode != "",
+		mapping.FromTargetConverterFunc != "" || mapping.ElementFromTargetCode != "",
 	)
 
 	mapping.ToTargetRenderStrategy = toTargetStrategy
@@ -540,8 +690,8 @@ func addRenderStrategies(mapping *converter.FieldMapping) {
 
 // buildFieldConversion generates conversion code for a field pair.
 // This is a thin wrapper around the shared BuildFieldMapping function.
-func buildFieldConversion(sourceField, targetField *protogen.Field, reg *registry.ConverterRegistry, sourcePkgName string, msgRegistry *common.MessageRegistry) *converter.FieldMapping {
-	return converter.BuildFieldMapping(sourceField, targetField, reg, msgRegistry, sourcePkgName, addRenderStrategies)
+func buildFieldConversion(sourceField, targetField *protogen.Field, reg *registry.ConverterRegistry, sourcePkgName string, msgRegistry *common.MessageRegistry, warnings *common.Warnings) *converter.FieldMapping {
+	return converter.BuildFieldMapping(sourceField, targetField, reg, msgRegistry, sourcePkgName, addRenderStrategies, warnings)
 }
 
 // buildStructName generates the GORM struct name from the target message name.
@@ -557,17 +707,46 @@ func buildStructName(msg *protogen.Message) string {
 
 // buildFields extracts field information from a list of proto fields.
 func buildFields(protoFields []*protogen.Field, sourcePkgName string, registry *common.MessageRegistry) ([]FieldData, error) {
-	return buildFieldsWithValidation(protoFields, sourcePkgName, registry, "")
+	return buildFieldsWithValidation(protoFields, sourcePkgName, registry, "", nil)
 }
 
 // buildFieldsWithValidation extracts field information from a list of proto fields with optional validation.
-func buildFieldsWithValidation(protoFields []*protogen.Field, sourcePkgName string, registry *common.MessageRegistry, msgName string) ([]FieldData, error) {
+// Serializer tag warnings go to warnings (nil logs them).
+func buildFieldsWithValidation(protoFields []*protogen.Field, sourcePkgName string, registry *common.MessageRegistry, msgName string, warnings *common.Warnings) ([]FieldData, error) {
 	var fields []FieldData
 
+	// id_strategy is only allowed on primary keys
+	primaryKeys := map[string]bool{}
+	if msgName != "" {
+		keys, _ := detectPrimaryKeysInFields(protoFields)
+		for _, pk := range keys {
+			primaryKeys[pk.Name] = true
+		}
+	}
+
 	for _, field := range protoFields {
 		// Validate serializer tags if message name is provided
 		if msgName != "" {
-			validateSerializerTags(field, msgName, registry)
+			if err := common.ValidateFlattenField(field, msgName); err != nil {
+				return nil, err
+			}
+			if err := common.ValidateMessageStorageField(field, msgName); err != nil {
+				return nil, err
+			}
+			if err := common.ValidateIDTypeField(field, msgName); err != nil {
+				return nil, err
+			}
+			if strategy, err := common.ResolveIDStrategy(field, msgName); err != nil {
+				return nil, err
+			} else if strategy != nil && strategy.Allocated {
+				return nil, fmt.Errorf("field '%s.%s': id_strategy DATASTORE_ALLOCATED is only supported by the Datastore target", msgName, field.GoName)
+			} else if strategy != nil && !primaryKeys[field.GoName] {
+				return nil, fmt.Errorf("field '%s.%s': id_strategy is only supported on primary key fields", msgName, field.GoName)
+			}
+			if common.IsEncodedKey(field) {
+				return nil, fmt.Errorf("field '%s.%s': encoded_key is only supported by the Datastore target", msgName, field.GoName)
+			}
+			validateSerializerTags(field, msgName, registry, warnings)
 		}
 
 		fieldData, err := buildField(field, sourcePkgName, registry)
@@ -592,11 +771,51 @@ func buildField(field *protogen.Field, sourcePkgName string, registry *common.Me
 	// Extract GORM tags from column options
 	gormTag := extractGormTags(field)
 
-	return FieldData{
-		Name: goName,
-		Type: goType,
-		Tags: gormTag,
-	}, nil
+	fieldData := FieldData{
+		Name:     goName,
+		Type:     goType,
+		Tags:     gormTag,
+		Equality: common.FieldEquality(field, goType),
+		Cloning:  common.FieldCloning(goType),
+	}
+
+	// Named ID types keep the plain type's comparison and copying
+	if idType := common.GetIDType(field); idType != "" {
+		fieldData.Type = idType
+	}
+
+	// Column names for ChangedColumns: embedded structs report their own
+	// columns under their prefix, and ignored fields have none
+	switch {
+	case isEmbeddedField(field):
+		fieldData.Embedded = true
+		fieldData.ColumnPrefix = gormTagValue(gormTag, "embeddedPrefix")
+	case !hasGormTag(gormTag, "-"):
+		fieldData.Column = common.GetColumnName(field)
+	}
+
+	return fieldData, nil
+}
+
+// hasGormTag reports whether a semicolon-separated gorm tag string contains
+// name, with or without a value (e.g., "-" in "-;column:x" or "-:all").
+func hasGormTag(tags, name string) bool {
+	for _, tag := range strings.Split(tags, ";") {
+		if tag == name || strings.HasPrefix(tag, name+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// gormTagValue returns the value of a "name:value" gorm tag, or "" if absent.
+func gormTagValue(tags, name string) string {
+	for _, tag := range strings.Split(tags, ";") {
+		if value, ok := strings.CutPrefix(tag, name+":"); ok {
+			return value
+		}
+	}
+	return ""
 }
 
 // extractGormTags extracts GORM tags from field column options.
@@ -609,9 +828,18 @@ func extractGormTags(field *protogen.Field) string {
 	// Get column options
 	if v := proto.GetExtension(opts, dalv1.E_Column); v != nil {
 		if colOpts, ok := v.(*dalv1.ColumnOptions); ok && colOpts != nil {
+			tags := colOpts.GormTags
+			// Flattened fields are embedded structs with prefixed columns
+			if colOpts.Flatten != nil {
+				tags = append(tags[:len(tags):len(tags)], "embedded", "embeddedPrefix:"+common.FlattenPrefix(field))
+			}
+			// Database-assigned keys must be auto-increment columns
+			if colOpts.IdStrategy == dalv1.IDStrategy_AUTO_INCREMENT && !hasGormTag(strings.ToLower(strings.Join(tags, ";")), "autoincrement") {
+				tags = append(tags[:len(tags):len(tags)], "autoIncrement")
+			}
 			// Join gorm_tags with semicolons
-			if len(colOpts.GormTags) > 0 {
-				return strings.Join(colOpts.GormTags, ";")
+			if len(tags) > 0 {
+				return strings.Join(tags, ";")
 			}
 		}
 	}
@@ -637,6 +865,11 @@ func isEmbeddedField(field *protogen.Field) bool {
 		return false
 	}
 
+	// Flattened fields are always embedded
+	if colOpts.Flatten != nil {
+		return true
+	}
+
 	// Check if "embedded" is in the gorm_tags
 	for _, tag := range colOpts.GormTags {
 		if tag == "embedded" || strings.HasPrefix(tag, "embedded:") {
@@ -668,10 +901,10 @@ func hasImplementScanner(msg *protogen.Message) bool {
 }
 
 // validateSerializerTags checks if complex types have appropriate serializer tags for cross-DB compatibility.
-// Logs warnings for repeated fields, maps, and repeated message types without serializer:json tags.
-func validateSerializerTags(field *protogen.Field, msgName string, registry *common.MessageRegistry) {
-	// Skip embedded fields - they don't need serialization
-	if isEmbeddedField(field) {
+// Reports warnings for repeated fields, maps, and repeated message types without serializer:json tags.
+func validateSerializerTags(field *protogen.Field, msgName string, registry *common.MessageRegistry, warnings *common.Warnings) {
+	// Skip embedded and child table fields - they don't need serialization
+	if isEmbeddedField(field) || common.GetChildTableOptions(field) != nil {
 		return
 	}
 
@@ -722,19 +955,19 @@ func validateSerializerTags(field *protogen.Field, msgName string, registry *com
 	// Check if serializer:json tag is present
 	opts := field.Desc.Options()
 	if opts == nil {
-		log.Printf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
+		warnings.Warnf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
 		return
 	}
 
 	v := proto.GetExtension(opts, dalv1.E_Column)
 	if v == nil {
-		log.Printf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
+		warnings.Warnf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
 		return
 	}
 
 	colOpts, ok := v.(*dalv1.ColumnOptions)
 	if !ok || colOpts == nil {
-		log.Printf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
+		warnings.Warnf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
 		return
 	}
 
@@ -748,6 +981,6 @@ func validateSerializerTags(field *protogen.Field, msgName string, registry *com
 	}
 
 	if !hasSerializer {
-		log.Printf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
+		warnings.Warnf("[WARN] Field '%s.%s' (%s): missing serializer:json tag for cross-DB compatibility (SQLite/PostgreSQL)", msgName, field.GoName, fieldTypeDesc)
 	}
 }
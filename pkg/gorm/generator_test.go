@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
 	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
 
 	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
@@ -242,3 +243,902 @@ func TestGenerateConverters(t *testing.T) {
 }
 
 // Test helpers have been moved to pkg/generator/testutil
+
+// flattenProtos returns an Order with two Address fields, one flattened with
+// the default prefix and one with a custom prefix, plus the given extra
+// OrderGorm fields.
+func flattenProtos(extra ...testutil.TestField) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "shop/v1/order.proto",
+				Pkg:  "shop.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Address",
+						Fields: []testutil.TestField{
+							{Name: "street", Number: 1, TypeName: "string"},
+							{Name: "city", Number: 2, TypeName: "string"},
+						},
+					},
+					{
+						Name: "Order",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "billing", Number: 2, TypeName: "shop.v1.Address"},
+							{Name: "shipping", Number: 3, TypeName: "shop.v1.Address"},
+							{Name: "note", Number: 4, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "shop/v1/dal/order_gorm.proto",
+				Pkg:     "shop.v1.dal",
+				Imports: []string{"shop/v1/order.proto"},
+				Messages: []testutil.TestMessage{
+					{Name: "AddressGorm", GormOpts: &dalv1.GormOptions{Source: "shop.v1.Address"}},
+					{
+						Name:     "OrderGorm",
+						GormOpts: &dalv1.GormOptions{Source: "shop.v1.Order", Table: "orders"},
+						Fields: append([]testutil.TestField{
+							{
+								Name: "billing", Number: 2, TypeName: "shop.v1.Address",
+								ColumnOpts: &dalv1.ColumnOptions{Flatten: &dalv1.FlattenOptions{}},
+							},
+							{
+								Name: "shipping", Number: 3, TypeName: "shop.v1.Address",
+								ColumnOpts: &dalv1.ColumnOptions{Flatten: &dalv1.FlattenOptions{Prefix: "ship_"}},
+							},
+						}, extra...),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGenerateGORM_Flatten tests that flattened fields become embedded
+// structs with prefixed columns.
+func TestGenerateGORM_Flatten(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos())
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+
+	if !strings.Contains(content, "Billing AddressGORM `gorm:\"embedded;embeddedPrefix:billing_\"`") {
+		t.Errorf("Expected Billing embedded with default prefix.\nGenerated content:\n%s", content)
+	}
+	if !strings.Contains(content, "Shipping AddressGORM `gorm:\"embedded;embeddedPrefix:ship_\"`") {
+		t.Errorf("Expected Shipping embedded with ship_ prefix.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateGORM_ChangeDetection tests the generated Equal and
+// ChangedColumns methods: flattened structs report prefixed columns, lists are
+// compared deeply and ignored fields have no column.
+func TestGenerateGORM_ChangeDetection(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(
+		testutil.TestField{Name: "note", Number: 4, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"-"}}},
+		testutil.TestField{Name: "tags", Number: 5, TypeName: "string", Repeated: true, ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"serializer:json"}}},
+	))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (m *OrderGORM) Equal(other *OrderGORM) bool {",
+		"return m.Id == other.Id &&\n\t\tm.Billing.Equal(&other.Billing) &&",
+		"reflect.DeepEqual(m.Tags, other.Tags)",
+		"func (m *OrderGORM) ChangedColumns(other *OrderGORM) []string {",
+		"for _, column := range m.Shipping.ChangedColumns(&other.Shipping) {\n\t\tcolumns = append(columns, \"ship_\"+column)",
+		"if !reflect.DeepEqual(m.Tags, other.Tags) {\n\t\tcolumns = append(columns, \"tags\")",
+		"func (m *AddressGORM) ChangedColumns(other *AddressGORM) []string {",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, `columns = append(columns, "note")`) {
+		t.Errorf("Ignored field note should have no column.\nGenerated content:\n%s", content)
+	}
+}
+
+// TestGenerateGORM_Clone tests the generated Clone methods: nested structs
+// are copied by their own cloneFields, and lists and maps are copied.
+func TestGenerateGORM_Clone(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(
+		testutil.TestField{Name: "tags", Number: 4, TypeName: "string", Repeated: true, ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"serializer:json"}}},
+		testutil.TestField{Name: "labels", Number: 5, TypeName: "string", IsMap: true, MapKeyType: "string", ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"serializer:json"}}},
+	))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func (m *OrderGORM) Clone() *OrderGORM {",
+		"out := *m\n\tout.cloneFields()\n\treturn &out",
+		"m.Billing.cloneFields()\n\tm.Shipping.cloneFields()",
+		"m.Tags = slices.Clone(m.Tags)",
+		"m.Labels = maps.Clone(m.Labels)",
+		"func (m *AddressGORM) Clone() *AddressGORM {",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// idTypeProtos returns a BookGorm whose primary key has the given id_type.
+func idTypeProtos(idType string, extra ...testutil.TestField) *testutil.TestProtoSet {
+	protos := tenantProtos("", extra...)
+	protos.Files[1].Messages[0].Fields[0].ColumnOpts.IdType = idType
+	return protos
+}
+
+// TestGenerateGORM_IDType tests that an id_type declares a named type used
+// by the entity, the converters and the DAL's key parameters.
+func TestGenerateGORM_IDType(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, idTypeProtos("BookID"))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"type BookID string",
+		"Id BookID `gorm:\"primaryKey\"`",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated code.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	converters, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	for _, want := range []string{"Id: BookID(src.Id)", "Id: string(src.Id)"} {
+		if !strings.Contains(converters.Files[0].Content, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters.Files[0].Content)
+		}
+	}
+
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+	dal, err := builtinGenerator.generateDALFileCodeWithOptions(messages, common.PackageInfo{ImportPath: "github.com/test/gen/v1", Alias: "v1"}, &DALOptions{OutputDir: "dal"})
+	if err != nil {
+		t.Fatalf("generateDALFileCodeWithOptions failed: %v", err)
+	}
+	for _, want := range []string{
+		"Get(ctx context.Context, db *gorm.DB, id v1.BookID)",
+		"Delete(ctx context.Context, db *gorm.DB, id v1.BookID)",
+		"BatchGet(ctx context.Context, db *gorm.DB, ids []v1.BookID)",
+		`if obj.Id == "" {`,
+	} {
+		if !strings.Contains(dal, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, dal)
+		}
+	}
+}
+
+// TestGenerateGORM_IDTypeInvalid tests that id_types are only allowed on
+// singular string and integer fields whose source field has the same type.
+func TestGenerateGORM_IDTypeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		field testutil.TestField
+		want  string
+	}{
+		{"not exported", testutil.TestField{Name: "shelf", Number: 10, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{IdType: "shelfID"}}, "must be an exported Go identifier"},
+		{"bool", testutil.TestField{Name: "hidden", Number: 10, TypeName: "bool", ColumnOpts: &dalv1.ColumnOptions{IdType: "HiddenID"}}, "requires a string or integer field"},
+		{"repeated", testutil.TestField{Name: "shelves", Number: 10, TypeName: "string", Repeated: true, ColumnOpts: &dalv1.ColumnOptions{IdType: "ShelfID", GormTags: []string{"serializer:json"}}}, "cannot be used on repeated fields"},
+		{"source type", testutil.TestField{Name: "title", Number: 2, TypeName: "int64", ColumnOpts: &dalv1.ColumnOptions{IdType: "TitleID"}}, "needs source field library.v1.Book.title to be a singular, non-optional int64"},
+		{"conflict", testutil.TestField{Name: "copy_id", Number: 10, TypeName: "int64", ColumnOpts: &dalv1.ColumnOptions{IdType: "BookID"}}, "id_type BookID is int64, but library.v1.dal.BookGorm.id makes it string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := testutil.CreateTestPlugin(t, idTypeProtos("BookID", tt.field))
+			messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// idStrategyProtos returns a BookGorm whose primary key has the given
+// id_strategy and, in both messages, the given type.
+func idStrategyProtos(strategy dalv1.IDStrategy, typeName string, extra ...testutil.TestField) *testutil.TestProtoSet {
+	protos := tenantProtos("", extra...)
+	protos.Files[0].Messages[0].Fields[0].TypeName = typeName
+	id := &protos.Files[1].Messages[0].Fields[0]
+	id.TypeName = typeName
+	id.ColumnOpts.IdStrategy = strategy
+	return protos
+}
+
+// TestGenerateGORM_IDStrategy tests that generated id_strategies are filled
+// in by Create and Save, and that AUTO_INCREMENT keys are left to the database.
+func TestGenerateGORM_IDStrategy(t *testing.T) {
+	protos := idStrategyProtos(dalv1.IDStrategy_UUIDV7, "string")
+	protos.Files[1].Messages[0].Fields[0].ColumnOpts.IdType = "BookID"
+	plugin := testutil.CreateTestPlugin(t, protos)
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	for _, msg := range messages {
+		msg.GenerateDAL = true
+	}
+
+	dal, err := builtinGenerator.generateDALFileCodeWithOptions(messages, common.PackageInfo{ImportPath: "github.com/test/gen/v1", Alias: "v1"}, &DALOptions{OutputDir: "dal"})
+	if err != nil {
+		t.Fatalf("generateDALFileCodeWithOptions failed: %v", err)
+	}
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/idgen"`,
+		"IDGenerator idgen.Generator",
+		"func (d *BookGORMDAL) assignIDs(ctx context.Context, obj *v1.BookGORM) error {",
+		"id, err := idgen.String(ctx, d.IDGenerator, idgen.UUIDv7)",
+		"obj.Id = v1.BookID(id)",
+	} {
+		if !strings.Contains(dal, want) {
+			t.Errorf("Expected %q in generated DAL.\nGenerated content:\n%s", want, dal)
+		}
+	}
+	if n := strings.Count(dal, "if err := d.assignIDs(ctx, obj); err != nil {"); n != 2 {
+		t.Errorf("Expected Create and Save to call assignIDs, got %d calls", n)
+	}
+	if strings.Contains(dal, "primary key 'Id' cannot be empty") {
+		t.Errorf("Save should not reject empty generated keys.\nGenerated content:\n%s", dal)
+	}
+
+	// Snowflake keys can be integers; AUTO_INCREMENT needs no generator
+	for _, tt := range []struct {
+		strategy dalv1.IDStrategy
+		want     string
+		dal      bool
+	}{
+		{dalv1.IDStrategy_SNOWFLAKE, "idgen.Int64(ctx, d.IDGenerator, idgen.Snowflake)", true},
+		{dalv1.IDStrategy_AUTO_INCREMENT, "`gorm:\"primaryKey;autoIncrement\"`", false},
+	} {
+		plugin := testutil.CreateTestPlugin(t, idStrategyProtos(tt.strategy, "int64"))
+		messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+		if err != nil {
+			t.Fatalf("CollectMessages failed: %v", err)
+		}
+		for _, msg := range messages {
+			msg.GenerateDAL = true
+		}
+		var content string
+		if tt.dal {
+			content, err = builtinGenerator.generateDALFileCodeWithOptions(messages, common.PackageInfo{ImportPath: "github.com/test/gen/v1", Alias: "v1"}, &DALOptions{OutputDir: "dal"})
+		} else {
+			var result *GenerateResult
+			if result, err = Generate(messages); err == nil {
+				content = result.Files[0].Content
+			}
+		}
+		if err != nil {
+			t.Fatalf("%s: generation failed: %v", tt.strategy, err)
+		}
+		if !strings.Contains(content, tt.want) {
+			t.Errorf("%s: expected %q in generated code.\nGenerated content:\n%s", tt.strategy, tt.want, content)
+		}
+	}
+}
+
+// TestGenerateGORM_IDStrategyInvalid tests that id_strategies are only
+// allowed on primary keys of a kind the strategy can produce, and that the
+// Datastore-only key options are rejected.
+func TestGenerateGORM_IDStrategyInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy dalv1.IDStrategy
+		typeName string
+		field    testutil.TestField
+		want     string
+	}{
+		{"wrong kind", dalv1.IDStrategy_ULID, "int64", testutil.TestField{}, "id_strategy ULID requires a string field, got int64"},
+		{"datastore only", dalv1.IDStrategy_DATASTORE_ALLOCATED, "int64", testutil.TestField{}, "only supported by the Datastore target"},
+		{"not a key", dalv1.IDStrategy_ID_STRATEGY_UNSPECIFIED, "string", testutil.TestField{Name: "title", Number: 2, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{IdStrategy: dalv1.IDStrategy_UUIDV4}}, "only supported on primary key fields"},
+		{"encoded key", dalv1.IDStrategy_ID_STRATEGY_UNSPECIFIED, "string", testutil.TestField{Name: "key", Number: 2, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{EncodedKey: true}}, "encoded_key is only supported by the Datastore target"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var extra []testutil.TestField
+			if tt.field.Name != "" {
+				extra = append(extra, tt.field)
+			}
+			plugin := testutil.CreateTestPlugin(t, idStrategyProtos(tt.strategy, tt.typeName, extra...))
+			messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+			if err != nil {
+				t.Fatalf("CollectMessages failed: %v", err)
+			}
+
+			_, err = Generate(messages)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestGenerateGORM_FlattenNonMessage tests that flatten is rejected on
+// fields that are not singular messages.
+func TestGenerateGORM_FlattenNonMessage(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, flattenProtos(testutil.TestField{
+		Name: "note", Number: 4, TypeName: "string",
+		ColumnOpts: &dalv1.ColumnOptions{Flatten: &dalv1.FlattenOptions{}},
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	_, err = Generate(messages)
+	if err == nil || !strings.Contains(err.Error(), "flatten requires a message field") {
+		t.Errorf("Expected flatten error for OrderGORM.Note, got %v", err)
+	}
+}
+
+// TestGenerateGORM_MessageStorage tests that message fields with a storage
+// mode become []byte/string columns marshalled by the converters, without
+// needing a sidecar for the message type.
+func TestGenerateGORM_MessageStorage(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "shop/v1/order.proto",
+				Pkg:  "shop.v1",
+				Messages: []testutil.TestMessage{
+					{Name: "Address", Fields: []testutil.TestField{{Name: "street", Number: 1, TypeName: "string"}}},
+					{
+						Name: "Order",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "billing", Number: 2, TypeName: "shop.v1.Address"},
+							{Name: "shipping", Number: 3, TypeName: "shop.v1.Address"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "shop/v1/dal/order_gorm.proto",
+				Pkg:     "shop.v1.dal",
+				Imports: []string{"shop/v1/order.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "OrderGorm",
+						GormOpts: &dalv1.GormOptions{Source: "shop.v1.Order", Table: "orders"},
+						Fields: []testutil.TestField{
+							{
+								Name: "billing", Number: 2, TypeName: "shop.v1.Address",
+								ColumnOpts: &dalv1.ColumnOptions{Storage: dalv1.MessageStorage_PROTO_BINARY},
+							},
+							{
+								Name: "shipping", Number: 3, TypeName: "shop.v1.Address",
+								ColumnOpts: &dalv1.ColumnOptions{Storage: dalv1.MessageStorage_PROTOJSON},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{"Billing []byte", "Shipping string"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated struct.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	convResult, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := convResult.Files[0].Content
+	for _, want := range []string{
+		"converters.MessageToBytes(src.Billing)",
+		"converters.BytesToMessage[*v1.Address](src.Billing)",
+		"converters.MessageToJSON(src.Shipping)",
+		"converters.JSONToMessage[*v1.Address](src.Shipping)",
+	} {
+		if !strings.Contains(converters, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+		}
+	}
+}
+
+// TestGenerateGORM_MessageStorageTargetType tests that a serialized field is
+// unmarshalled into the source field's message type even when the target
+// declares the field with its own message type.
+func TestGenerateGORM_MessageStorageTargetType(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "shop/v1/user.proto",
+				Pkg:  "shop.v1",
+				Messages: []testutil.TestMessage{
+					{Name: "Settings", Fields: []testutil.TestField{{Name: "theme", Number: 1, TypeName: "string"}}},
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "settings", Number: 5, TypeName: "shop.v1.Settings"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "shop/v1/dal/user_gorm.proto",
+				Pkg:     "shop.v1.dal",
+				Imports: []string{"shop/v1/user.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "SettingsGorm",
+						GormOpts: &dalv1.GormOptions{Source: "shop.v1.Settings"},
+					},
+					{
+						Name:     "UserGorm",
+						GormOpts: &dalv1.GormOptions{Source: "shop.v1.User", Table: "users"},
+						Fields: []testutil.TestField{
+							{
+								Name: "settings", Number: 5, TypeName: "shop.v1.dal.SettingsGorm",
+								ColumnOpts: &dalv1.ColumnOptions{Storage: dalv1.MessageStorage_PROTOJSON},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	convResult, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := convResult.Files[0].Content
+	if want := "converters.JSONToMessage[*v1.Settings](src.Settings)"; !strings.Contains(converters, want) {
+		t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+	}
+	if strings.Contains(converters, "JSONToMessage[*dal.SettingsGorm]") {
+		t.Errorf("Expected the target's message type not to be used.\nGenerated content:\n%s", converters)
+	}
+}
+
+// childTableProtos returns a Book with a repeated Author field stored in a
+// child table; extra fields are added to the GORM target message.
+func childTableProtos(authors *dalv1.ColumnOptions, extra ...testutil.TestField) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Author",
+						Fields: []testutil.TestField{
+							{Name: "name", Number: 1, TypeName: "string"},
+						},
+					},
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "authors", Number: 2, TypeName: "library.v1.Author", Repeated: true},
+							{Name: "editor", Number: 3, TypeName: "library.v1.Author"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "library/v1/dal/book_gorm.proto",
+				Pkg:     "library.v1.dal",
+				Imports: []string{"library/v1/book.proto"},
+				Messages: []testutil.TestMessage{
+					{Name: "AuthorGorm", GormOpts: &dalv1.GormOptions{Source: "library.v1.Author"}},
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: append([]testutil.TestField{
+							{
+								Name: "authors", Number: 2, TypeName: "library.v1.dal.AuthorGorm", Repeated: true,
+								ColumnOpts: authors,
+							},
+						}, extra...),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGenerateGORM_ChildTable tests that a child_table field becomes a
+// has-many association on a generated row struct, with converters that fill
+// the row's value and position.
+func TestGenerateGORM_ChildTable(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, childTableProtos(&dalv1.ColumnOptions{ChildTable: &dalv1.ChildTableOptions{}}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := Generate(messages)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"Authors []BookGORMAuthorsChild `gorm:\"foreignKey:ParentID;references:Id\"`",
+		"type BookGORMAuthorsChild struct",
+		"ParentID string `gorm:\"primaryKey;column:parent_id\"`",
+		"Ordinal int `gorm:\"primaryKey;column:ordinal\"`",
+		"Value AuthorGORM `gorm:\"embedded\"`",
+		`return "books_authors"`,
+		// The unprefixed value's columns are the row's columns
+		"columns = append(columns, m.Value.ChangedColumns(&other.Value)...)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated structs.\nGenerated content:\n%s", want, content)
+		}
+	}
+
+	convResult, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := convResult.Files[0].Content
+	for _, want := range []string{
+		"out.Authors = make([]BookGORMAuthorsChild, len(src.Authors))",
+		"out.Authors[i].Ordinal = i",
+		"AuthorToAuthorGORMWithOptions(ctx, item, &out.Authors[i].Value)",
+		"AuthorFromAuthorGORMWithOptions(ctx, nil, &item.Value)",
+	} {
+		if !strings.Contains(converters, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+		}
+	}
+}
+
+// TestGenerateGORM_ChildTableSingular tests that child_table is rejected on
+// singular message fields.
+func TestGenerateGORM_ChildTableSingular(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, childTableProtos(nil, testutil.TestField{
+		Name: "editor", Number: 3, TypeName: "library.v1.dal.AuthorGorm",
+		ColumnOpts: &dalv1.ColumnOptions{ChildTable: &dalv1.ChildTableOptions{}},
+	}))
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	_, err = Generate(messages)
+	if err == nil || !strings.Contains(err.Error(), "child_table requires a repeated field") {
+		t.Errorf("Expected child_table error for BookGORM.Editor, got %v", err)
+	}
+}
+
+// TestGenerateConverters_ElementConversions tests that repeated fields whose
+// elements need a conversion are converted element by element: known type
+// mappings, numeric casts and custom to_func/from_func converters.
+func TestGenerateConverters_ElementConversions(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "reader_ids", Number: 2, TypeName: "uint32", Repeated: true},
+							{Name: "ratings", Number: 3, TypeName: "int64", Repeated: true},
+							{Name: "scores", Number: 4, TypeName: "int32", Repeated: true},
+						},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/book_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{Name: "reader_ids", Number: 2, TypeName: "string", Repeated: true},
+							{Name: "ratings", Number: 3, TypeName: "int32", Repeated: true},
+							{
+								Name: "scores", Number: 4, TypeName: "string", Repeated: true,
+								ColumnOpts: &dalv1.ColumnOptions{
+									ToFunc:   &dalv1.ConverterFunc{Package: "github.com/example/conv", Function: "ScoreToString"},
+									FromFunc: &dalv1.ConverterFunc{Package: "github.com/example/conv", Function: "ScoreFromString"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := result.Files[0].Content
+	for _, want := range []string{
+		"out.ReaderIds = make([]string, len(src.ReaderIds))",
+		"out.ReaderIds[i] = strconv.FormatUint(uint64(item), 10)",
+		"out.ReaderIds = make([]uint32, len(src.ReaderIds))",
+		"out.ReaderIds[i], err = converters.ParseUint32(item)",
+		"out.Ratings[i] = int32(item)",
+		"out.Ratings[i] = int64(item)",
+		"out.Scores[i] = conv.ScoreToString(item)",
+		"out.Scores[i] = conv.ScoreFromString(item)",
+	} {
+		if !strings.Contains(converters, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+		}
+	}
+}
+
+// TestGenerateConverters_ErrorReturningConverters verifies that custom
+// converters declaring a (T, error) or (ctx, T) (T, error) signature are called
+// as setters whose errors are wrapped with the field name.
+func TestGenerateConverters_ErrorReturningConverters(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "secret", Number: 2, TypeName: "string"},
+							{Name: "tag_ids", Number: 3, TypeName: "string", Repeated: true},
+						},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/book_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{
+								Name: "id", Number: 1, TypeName: "uint64",
+								ColumnOpts: &dalv1.ColumnOptions{
+									ToFunc:   &dalv1.ConverterFunc{Package: "github.com/example/ids", Function: "ParseID", Signature: dalv1.ConverterSignature_RETURNS_ERROR},
+									FromFunc: &dalv1.ConverterFunc{Package: "github.com/example/ids", Function: "FormatID"},
+								},
+							},
+							{
+								Name: "secret", Number: 2, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{
+									ToFunc:   &dalv1.ConverterFunc{Package: "github.com/example/vault", Function: "Encrypt", Signature: dalv1.ConverterSignature_CONTEXT_RETURNS_ERROR},
+									FromFunc: &dalv1.ConverterFunc{Package: "github.com/example/vault", Function: "Decrypt", Signature: dalv1.ConverterSignature_CONTEXT_RETURNS_ERROR},
+								},
+							},
+							{
+								Name: "tag_ids", Number: 3, TypeName: "uint64", Repeated: true,
+								ColumnOpts: &dalv1.ColumnOptions{
+									ToFunc:   &dalv1.ConverterFunc{Package: "github.com/example/ids", Function: "ParseID", Signature: dalv1.ConverterSignature_RETURNS_ERROR},
+									FromFunc: &dalv1.ConverterFunc{Package: "github.com/example/ids", Function: "FormatID"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := result.Files[0].Content
+	for _, want := range []string{
+		`"context"`,
+		"out.Id, err = ids.ParseID(src.Id)",
+		`return nil, conversionBookToBookGORM.FieldError(err, "id")`,
+		"Id: ids.FormatID(src.Id)",
+		"ctx context.Context,",
+		"out.Secret, err = vault.Encrypt(ctx, src.Secret)",
+		"out.Secret, err = vault.Decrypt(ctx, src.Secret)",
+		`return nil, conversionBookFromBookGORM.FieldError(err, "secret")`,
+		"out.TagIds[i], err = ids.ParseID(item)",
+		`return nil, conversionBookToBookGORM.ElementError(err, "tag_ids", i)`,
+		"out.TagIds[i] = ids.FormatID(item)",
+	} {
+		if !strings.Contains(converters, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+		}
+	}
+}
+
+// TestGenerateConverters_WithOptions verifies the ...WithOptions converter
+// variants: plain converters delegate to them, nested converters are called
+// with ctx, and fields without a conversion go through ConvertUnmapped.
+func TestGenerateConverters_WithOptions(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Author",
+						Fields: []testutil.TestField{
+							{Name: "name", Number: 1, TypeName: "string"},
+						},
+					},
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "author", Number: 2, TypeName: "library.v1.Author"},
+							{Name: "edition", Number: 3, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/book_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "AuthorGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Author"},
+					},
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{Name: "edition", Number: 3, TypeName: "int64"},
+						},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	converters := result.Files[0].Content
+	for _, want := range []string{
+		"out, err = BookToBookGORMWithOptions(context.Background(), src, dest)",
+		"func BookToBookGORMWithOptions(\n\tctx context.Context,",
+		"opts ...converters.ConvertOption,",
+		"if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {",
+		"AuthorToAuthorGORMWithOptions(ctx, src.Author, &out.Author)",
+		"AuthorFromAuthorGORMWithOptions(ctx, nil, &src.Author)",
+		"converters.ConvertUnmapped(ctx, src.Edition, &out.Edition)",
+		`conversionBookToBookGORM.FieldError(err, "edition")`,
+		"func BookSliceToBookGORMSlice(\n\tsrc []*v1.Book,",
+		"func BookSliceFromBookGORMSlice(\n\tsrc []*BookGORM,",
+		"return converters.MapSlice(src, func(item *v1.Book) (*BookGORM, error) {",
+		"func BookMapToBookGORMMap[K comparable](",
+		"return BookFromBookGORM(nil, value, decorator)",
+	} {
+		if !strings.Contains(converters, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, converters)
+		}
+	}
+}
+
+// TestGenerateConverters_BatchNamesDoNotCollide verifies that message pairs
+// whose names both end in "s" get batch converters distinct from their
+// single-item converters.
+func TestGenerateConverters_BatchNamesDoNotCollide(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/stats.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name:   "Stats",
+						Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/stats_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "DailyStats",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Stats", Table: "daily_stats"},
+						Fields:   []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}},
+					},
+				},
+			},
+		},
+	})
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateConverters(messages)
+	if err != nil {
+		t.Fatalf("GenerateConverters failed: %v", err)
+	}
+	content := result.Files[0].Content
+	for _, want := range []string{
+		"func StatsToDailyStats(",
+		"func StatsSliceToDailyStatsSlice(",
+		"func StatsSliceFromDailyStatsSlice(",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated converters.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// TestGenerateIR verifies the IR document for a GORM message: field origins,
+// column names, primary keys, conversions and captured warnings.
+func TestGenerateIR(t *testing.T) {
+	messages := createBookIRMessages(t)
+
+	result, err := GenerateIR(messages)
+	if err != nil {
+		t.Fatalf("GenerateIR failed: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].Path != "library/v1/dal/book_gorm_gorm.ir.json" {
+		t.Fatalf("Expected one IR file, got %v", result.Files)
+	}
+
+	var doc ir.Document
+	if err := json.Unmarshal([]byte(result.Files[0].Content), &doc); err != nil {
+		t.Fatalf("IR is not valid JSON: %v", err)
+	}
+
+	if doc.Target != "gorm" || doc.ProtoFile != "library/v1/dal/book_gorm.proto" {
+		t.Errorf("Unexpected document header: %+v", doc)
+	}
+	if len(doc.Registry) != 1 || doc.Registry[0].Source != "library.v1.Book" || doc.Registry[0].StructName != "BookGORM" {
+		t.Errorf("Unexpected registry: %+v", doc.Registry)
+	}
+	if len(doc.Messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(doc.Messages))
+	}
+
+	msg := doc.Messages[0]
+	if msg.StructName != "BookGORM" || msg.TableName != "books" || msg.Source != "library.v1.Book" {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+	if len(msg.PrimaryKeys) != 1 || msg.PrimaryKeys[0] != "Id" {
+		t.Errorf("Expected primary key Id, got %v", msg.PrimaryKeys)
+	}
+
+	fields := make(map[string]*ir.Field)
+	for _, field := range msg.Fields {
+		fields[field.GoName] = field
+	}
+
+	id := fields["Id"]
+	if id == nil || id.Origin != ir.OriginOverride || id.ColumnName != "book_id" {
+		t.Errorf("Expected overridden Id with column book_id, got %+v", id)
+	}
+	if id != nil && (id.Conversion == nil || id.Conversion.ToTarget.Type != "ByAssignment") {
+		t.Errorf("Expected Id to be converted by assignment, got %+v", id.Conversion)
+	}
+	if title := fields["Title"]; title == nil || title.Origin != ir.OriginSource || title.ColumnName != "title" {
+		t.Errorf("Expected inherited Title, got %+v", title)
+	}
+	if tags := fields["Tags"]; tags == nil || !tags.Repeated {
+		t.Errorf("Expected repeated Tags, got %+v", tags)
+	}
+	if revision := fields["Revision"]; revision == nil || revision.Origin != ir.OriginTarget || revision.Conversion != nil {
+		t.Errorf("Expected target-only Revision without conversion, got %+v", revision)
+	}
+
+	// The missing serializer warning is recorded rather than logged
+	if len(doc.Warnings) != 1 || !strings.Contains(doc.Warnings[0], "BookGORM.Tags") {
+		t.Errorf("Expected serializer warning for Tags, got %v", doc.Warnings)
+	}
+}
+
+// TestGenerateIR_ConcurrentWarnings verifies that concurrent IR runs each
+// record only their own warnings.
+func TestGenerateIR_ConcurrentWarnings(t *testing.T) {
+	messages := createBookIRMessages(t)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			docs, err := BuildIR(messages)
+			if err != nil {
+				t.Errorf("BuildIR failed: %v", err)
+				return
+			}
+			if len(docs) != 1 || len(docs[0].Warnings) != 1 {
+				t.Errorf("Expected one warning per run, got %v", docs)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// createBookIRMessages collects a Book message whose repeated Tags field
+// lacks a serializer tag, so building it reports one warning.
+func createBookIRMessages(t *testing.T) []*collector.MessageInfo {
+	t.Helper()
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "title", Number: 2, TypeName: "string"},
+							{Name: "tags", Number: 3, TypeName: "string", Repeated: true},
+						},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/book_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey", "column:book_id"}}},
+							{Name: "revision", Number: 10, TypeName: "int64"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+	return messages
+}
@@ -17,6 +17,9 @@ package gorm
 import (
 	"bytes"
 	"embed"
+	"io/fs"
+	"path"
+	"sync"
 	"text/template"
 
 	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
@@ -32,6 +35,7 @@ type TemplateData struct {
 	PackageName string
 	Imports     []common.ImportSpec // Import specifications with optional aliases
 	Structs     []StructData        // Multiple structs per file
+	IDTypes     []common.IDType     // Named ID types declared in this file (id_type)
 }
 
 // StructData contains data for generating a GORM struct.
@@ -41,20 +45,67 @@ type StructData struct {
 	TableName        string      // Database table name (e.g., "books")
 	Fields           []FieldData // Struct fields
 	ImplementScanner bool        // Generate driver.Valuer/sql.Scanner methods
+
+	// Child tables (see ChildTableOptions)
+	ChildTables  []ChildTableData // Child table fields of this struct
+	ChildTableOf string           // For child row structs: the parent field (e.g., "LibraryGORM.Contributors")
+}
+
+// ExtraTemplateData contains the data passed to user-supplied extra templates.
+// Extra templates are rendered once per proto file.
+type ExtraTemplateData struct {
+	ProtoFile  string            // Source proto file (e.g., "gorm/user.proto")
+	Entities   TemplateData      // Same data as file.go.tmpl
+	Converters ConverterFileData // Same data as converters.go.tmpl
 }
 
 type FieldData = types.FieldData
 type ConverterFileData = types.ConverterFileData
 
-var tmpl *template.Template
+// Generator generates GORM code with a set of user-supplied templates.
+//
+// Overrides replace built-in templates of the same name (see TemplateNames)
+// and extras are rendered by GenerateExtras. The templates are parsed on
+// first use and belong to the Generator, so generators with different
+// templates can be used side by side and concurrently.
+type Generator struct {
+	templateOptions *common.TemplateOptions
+
+	loadOnce sync.Once
+	tmpl     *template.Template
+	loadErr  error
+}
+
+// NewGenerator returns a Generator applying opts over the built-in templates.
+// A nil opts uses the built-in templates only.
+func NewGenerator(opts *common.TemplateOptions) *Generator {
+	return &Generator{templateOptions: opts}
+}
 
-// loadTemplates loads and parses all templates.
-// This is called once during initialization.
-func loadTemplates() (*template.Template, error) {
-	if tmpl != nil {
-		return tmpl, nil
+// builtinGenerator backs the package-level functions, which use the built-in
+// templates.
+var builtinGenerator = NewGenerator(nil)
+
+// TemplateNames returns the names of the built-in templates that can be overridden.
+func TemplateNames() []string {
+	paths, _ := fs.Glob(templatesFS, "templates/*.tmpl")
+	names := make([]string, 0, len(paths))
+	for _, p := range paths {
+		names = append(names, path.Base(p))
 	}
+	return names
+}
 
+// loadTemplates returns the generator's templates, parsing them on first use.
+func (g *Generator) loadTemplates() (*template.Template, error) {
+	g.loadOnce.Do(func() {
+		g.tmpl, g.loadErr = parseTemplates(g.templateOptions)
+	})
+	return g.tmpl, g.loadErr
+}
+
+// parseTemplates parses the built-in templates with opts applied.
+func parseTemplates(opts *common.TemplateOptions) (*template.Template, error) {
 	// Create template with helper functions
 	t := template.New("").Funcs(template.FuncMap{
 		// fieldRef generates the correct field reference expression for converter parameters.
@@ -101,6 +152,7 @@ func loadTemplates() (*template.Template, error) {
 		"needsErrorCheck": func(convType converter.ConversionType) bool {
 			return convType == converter.ConvertByTransformerWithError
 		},
+		"withOptions": converter.WithOptionsConverterName,
 		// DAL helper template functions
 		"zeroValue": func(goType string) string {
 			switch goType {
@@ -181,13 +233,16 @@ func loadTemplates() (*template.Template, error) {
 		return nil, err
 	}
 
-	tmpl = t
-	return tmpl, nil
+	// Apply user overrides and extras on top of the built-in templates
+	if err := common.ApplyTemplateOptions(t, opts); err != nil {
+		return nil, err
+	}
+	return t, nil
 }
 
 // renderTemplate executes a template with the given data.
-func renderTemplate(name string, data any) (string, error) {
-	t, err := loadTemplates()
+func (g *Generator) renderTemplate(name string, data any) (string, error) {
+	t, err := g.loadTemplates()
 	if err != nil {
 		return "", err
 	}
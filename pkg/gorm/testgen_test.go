@@ -0,0 +1,131 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// TestGenerateTests verifies that the generated round-trip tests clear
+// fields the converters do not restore, normalise lossy conversions and
+// delegate nested messages to their own converter pair.
+func TestGenerateTests(t *testing.T) {
+	plugin := testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name:   "Author",
+						Fields: []testutil.TestField{{Name: "name", Number: 1, TypeName: "string"}},
+					},
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "pages", Number: 2, TypeName: "int64"},
+							{Name: "author", Number: 3, TypeName: "library.v1.Author"},
+							{Name: "editors", Number: 4, TypeName: "library.v1.Author", Repeated: true},
+							{Name: "notes", Number: 5, TypeName: "string"},
+							{Name: "isbn", Number: 6, TypeName: "string"},
+							{Name: "rating", Number: 7, TypeName: "bool"},
+						},
+					},
+				},
+			},
+			{
+				Name: "library/v1/dal/book_gorm.proto",
+				Pkg:  "library.v1.dal",
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "AuthorGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Author"},
+					},
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}}},
+							{Name: "pages", Number: 2, TypeName: "int32"},
+							{Name: "author", Number: 3, TypeName: "library.v1.dal.AuthorGorm"},
+							{Name: "editors", Number: 4, TypeName: "library.v1.dal.AuthorGorm", Repeated: true},
+							{Name: "notes", Number: 5, TypeName: "string", SkipField: true},
+							{Name: "isbn", Number: 6, TypeName: "string", ColumnOpts: &dalv1.ColumnOptions{
+								ToFunc:   &dalv1.ConverterFunc{Package: "github.com/example/isbn", Function: "Normalize"},
+								FromFunc: &dalv1.ConverterFunc{Package: "github.com/example/isbn", Function: "Format"},
+							}},
+							{Name: "rating", Number: 7, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		t.Fatalf("CollectMessages failed: %v", err)
+	}
+
+	result, err := GenerateTests(messages)
+	if err != nil {
+		t.Fatalf("GenerateTests failed: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].Path != "library/v1/dal/book_gorm_converters_test.go" {
+		t.Fatalf("Expected one test file, got %v", result.Files)
+	}
+	content := result.Files[0].Content
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "book_gorm_converters_test.go", content, 0); err != nil {
+		t.Fatalf("Generated test does not parse: %v\n%s", err, content)
+	}
+
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/roundtrip"`,
+		"func TestBookToBookGORMRoundTrip(t *testing.T) {",
+		"target, err := BookToBookGORM(src, nil, nil)",
+		"got, err := BookFromBookGORM(nil, target, nil)",
+		"func expectedBookFromBookGORM(src *v1.Book) *v1.Book {",
+		`"isbn", // custom converter`,
+		`"notes", // skip_field`,
+		`"rating", // no_conversion`,
+		"want.Pages = int64(int32(want.Pages))",
+		"want.Author = expectedAuthorFromAuthorGORM(want.Author)",
+		"want.Editors[i] = expectedAuthorFromAuthorGORM(item)",
+		"func TestAuthorToAuthorGORMRoundTrip(t *testing.T) {",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated test to contain %q\n%s", want, content)
+		}
+	}
+
+	// Lossless fields are compared as-is
+	if strings.Contains(content, `"id"`) {
+		t.Errorf("Expected id to be compared, not cleared\n%s", content)
+	}
+	// Only built-in converters helpers need the converters package
+	if strings.Contains(content, "pkg/converters") {
+		t.Errorf("Expected no converters import\n%s", content)
+	}
+}
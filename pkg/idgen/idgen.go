@@ -0,0 +1,88 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idgen provides the runtime helpers used by DALs generated by
+// protoc-gen-dal for key columns with an id_strategy.
+//
+// Generated DALs fill empty keys on create through a Generator. By default
+// that is DefaultGenerator, a Source using the system clock and crypto/rand;
+// applications can plug in their own per DAL (the IDGenerator field) or
+// globally. Tests can use NewSeeded for reproducible IDs of the real formats,
+// or a Sequence for predictable ones.
+package idgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Strategy is a format of generated IDs.
+type Strategy string
+
+const (
+	// UUIDv4 is a random UUID (RFC 9562), e.g. "0b7e8a3c-5d1f-4e2a-9c6b-2f4d8e1a7b3c".
+	UUIDv4 Strategy = "uuidv4"
+
+	// UUIDv7 is a time-ordered UUID (RFC 9562) with a millisecond timestamp.
+	UUIDv7 Strategy = "uuidv7"
+
+	// ULID is a time-ordered, 26 character Crockford base32 ID.
+	ULID Strategy = "ulid"
+
+	// Snowflake is a time-ordered 64-bit ID: a millisecond timestamp since
+	// SnowflakeEpoch, a node number and a per-millisecond sequence.
+	Snowflake Strategy = "snowflake"
+)
+
+// ErrUnsupportedStrategy is returned by generators for IDs they cannot
+// produce (e.g., a numeric UUID).
+var ErrUnsupportedStrategy = errors.New("idgen: unsupported strategy")
+
+// Generator creates IDs. Implementations must be safe for concurrent use.
+type Generator interface {
+	// NewString returns a new ID for a string key.
+	NewString(ctx context.Context, strategy Strategy) (string, error)
+
+	// NewInt64 returns a new ID for an integer key. Only Snowflake IDs are
+	// numeric.
+	NewInt64(ctx context.Context, strategy Strategy) (int64, error)
+}
+
+// DefaultGenerator is used by DALs that do not set their own generator.
+var DefaultGenerator Generator = New(0)
+
+// String returns a new string ID using gen, or DefaultGenerator if gen is nil.
+func String(ctx context.Context, gen Generator, strategy Strategy) (string, error) {
+	if gen == nil {
+		gen = DefaultGenerator
+	}
+	id, err := gen.NewString(ctx, strategy)
+	if err != nil {
+		return "", fmt.Errorf("generating %s ID: %w", strategy, err)
+	}
+	return id, nil
+}
+
+// Int64 returns a new numeric ID using gen, or DefaultGenerator if gen is nil.
+func Int64(ctx context.Context, gen Generator, strategy Strategy) (int64, error) {
+	if gen == nil {
+		gen = DefaultGenerator
+	}
+	id, err := gen.NewInt64(ctx, strategy)
+	if err != nil {
+		return 0, fmt.Errorf("generating %s ID: %w", strategy, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idgen
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var (
+	uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-([0-9a-f])[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	ulidPattern = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)
+)
+
+func TestSource_Formats(t *testing.T) {
+	ctx := context.Background()
+	s := New(0)
+	for _, tt := range []struct {
+		strategy Strategy
+		pattern  *regexp.Regexp
+		version  string
+	}{
+		{UUIDv4, uuidPattern, "4"},
+		{UUIDv7, uuidPattern, "7"},
+		{ULID, ulidPattern, ""},
+	} {
+		id, err := s.NewString(ctx, tt.strategy)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.strategy, err)
+		}
+		m := tt.pattern.FindStringSubmatch(id)
+		if m == nil || (tt.version != "" && m[1] != tt.version) {
+			t.Errorf("%s: malformed ID %q", tt.strategy, id)
+		}
+	}
+	if id, err := s.NewString(ctx, Snowflake); err != nil {
+		t.Errorf("Snowflake: %v", err)
+	} else if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		t.Errorf("Snowflake: expected a decimal ID, got %q", id)
+	}
+}
+
+func TestSource_Timestamps(t *testing.T) {
+	ctx := context.Background()
+
+	// Examples of the ULID and UUIDv7 specs
+	s := NewSeeded(1, time.UnixMilli(1469918176385))
+	if id, _ := s.NewString(ctx, ULID); id[:10] != "01ARYZ6S41" {
+		t.Errorf("Expected ULID timestamp 01ARYZ6S41, got %q", id)
+	}
+	s = NewSeeded(1, time.UnixMilli(0x017F22E279B0))
+	if id, _ := s.NewString(ctx, UUIDv7); id[:15] != "017f22e2-79b0-7" {
+		t.Errorf("Expected UUIDv7 timestamp 017f22e2-79b0, got %q", id)
+	}
+}
+
+func TestNewSeeded_Reproducible(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	a, b := NewSeeded(42, start), NewSeeded(42, start)
+	for _, strategy := range []Strategy{UUIDv4, UUIDv7, ULID, Snowflake} {
+		x, _ := a.NewString(ctx, strategy)
+		y, _ := b.NewString(ctx, strategy)
+		if x != y {
+			t.Errorf("%s: expected equal IDs, got %q and %q", strategy, x, y)
+		}
+	}
+	x, _ := NewSeeded(1, start).NewString(ctx, UUIDv4)
+	y, _ := NewSeeded(2, start).NewString(ctx, UUIDv4)
+	if x == y {
+		t.Errorf("Expected different seeds to give different IDs, got %q", x)
+	}
+}
+
+func TestSource_SnowflakeIncreasing(t *testing.T) {
+	ctx := context.Background()
+	fixed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := New(3)
+	s.now = func() time.Time { return fixed }
+
+	// More IDs than one millisecond's sequence holds
+	var last int64
+	for i := 0; i < maxSnowflakeSeq+10; i++ {
+		id, err := s.NewInt64(ctx, Snowflake)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id <= last {
+			t.Fatalf("ID %d: %d is not greater than %d", i, id, last)
+		}
+		last = id
+	}
+	if node := last >> snowflakeSeqBits & MaxNode; node != 3 {
+		t.Errorf("Expected node 3, got %d", node)
+	}
+	if ms := last >> (snowflakeNodeBits + snowflakeSeqBits); ms != fixed.Sub(SnowflakeEpoch).Milliseconds()+1 {
+		t.Errorf("Expected the sequence to continue in the next millisecond, got %d", ms)
+	}
+}
+
+func TestSource_Unsupported(t *testing.T) {
+	ctx := context.Background()
+	if _, err := New(0).NewInt64(ctx, UUIDv7); !errors.Is(err, ErrUnsupportedStrategy) {
+		t.Errorf("Expected ErrUnsupportedStrategy, got %v", err)
+	}
+	if _, err := String(ctx, nil, "nanoid"); !errors.Is(err, ErrUnsupportedStrategy) {
+		t.Errorf("Expected ErrUnsupportedStrategy, got %v", err)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	ctx := context.Background()
+	gen := &Sequence{Prefix: "book-"}
+	if id, _ := String(ctx, gen, UUIDv7); id != "book-1" {
+		t.Errorf("Expected book-1, got %q", id)
+	}
+	if id, _ := Int64(ctx, gen, Snowflake); id != 2 {
+		t.Errorf("Expected 2, got %d", id)
+	}
+}
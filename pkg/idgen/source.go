@@ -0,0 +1,204 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idgen
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	mrand "math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SnowflakeEpoch is the time Snowflake timestamps count from.
+var SnowflakeEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Snowflake layout: 41 bits of milliseconds, 10 of node and 12 of sequence.
+const (
+	snowflakeNodeBits = 10
+	snowflakeSeqBits  = 12
+	maxSnowflakeSeq   = 1<<snowflakeSeqBits - 1
+
+	// MaxNode is the largest Snowflake node number.
+	MaxNode = 1<<snowflakeNodeBits - 1
+)
+
+// crockford is the ULID alphabet (Crockford's base32).
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Source is the standard Generator. It takes the time of IDs from a clock and
+// their random bits from a reader.
+type Source struct {
+	mu   sync.Mutex
+	rand io.Reader
+	now  func() time.Time
+	node int64
+
+	// Snowflake state: timestamp and sequence of the last ID
+	lastMs int64
+	seq    int64
+}
+
+// New returns a Source using the system clock and crypto/rand. node numbers
+// the process in Snowflake IDs (0 to MaxNode): processes generating Snowflake
+// IDs for the same table need distinct nodes.
+func New(node int64) *Source {
+	return &Source{rand: crand.Reader, now: time.Now, node: node & MaxNode}
+}
+
+// NewSeeded returns a Source whose IDs depend only on seed and start: random
+// bits come from a ChaCha8 stream seeded with seed, and the clock starts at
+// start and advances one millisecond per ID. Use it for reproducible IDs in
+// tests.
+func NewSeeded(seed uint64, start time.Time) *Source {
+	var key [32]byte
+	binary.BigEndian.PutUint64(key[:], seed)
+	next := start
+	return &Source{
+		rand: mrand.NewChaCha8(key),
+		now: func() time.Time {
+			t := next
+			next = next.Add(time.Millisecond)
+			return t
+		},
+	}
+}
+
+// NewString returns a new UUIDv4, UUIDv7, ULID or (decimal) Snowflake ID.
+func (s *Source) NewString(ctx context.Context, strategy Strategy) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b [16]byte
+	switch strategy {
+	case UUIDv4:
+		if _, err := io.ReadFull(s.rand, b[:]); err != nil {
+			return "", err
+		}
+		return formatUUID(b, 4), nil
+	case UUIDv7:
+		putMillis(b[:6], s.now())
+		if _, err := io.ReadFull(s.rand, b[6:]); err != nil {
+			return "", err
+		}
+		return formatUUID(b, 7), nil
+	case ULID:
+		putMillis(b[:6], s.now())
+		if _, err := io.ReadFull(s.rand, b[6:]); err != nil {
+			return "", err
+		}
+		return formatULID(b), nil
+	case Snowflake:
+		return strconv.FormatInt(s.snowflake(), 10), nil
+	}
+	return "", ErrUnsupportedStrategy
+}
+
+// NewInt64 returns a new Snowflake ID.
+func (s *Source) NewInt64(ctx context.Context, strategy Strategy) (int64, error) {
+	if strategy != Snowflake {
+		return 0, ErrUnsupportedStrategy
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snowflake(), nil
+}
+
+// snowflake returns the next Snowflake ID. IDs keep increasing when the
+// clock goes back or a millisecond's sequence runs out, by continuing from
+// the last timestamp.
+func (s *Source) snowflake() int64 {
+	ms := s.now().Sub(SnowflakeEpoch).Milliseconds()
+	if ms > s.lastMs {
+		s.lastMs = ms
+		s.seq = 0
+	} else if s.seq++; s.seq > maxSnowflakeSeq {
+		s.lastMs++
+		s.seq = 0
+	}
+	return s.lastMs<<(snowflakeNodeBits+snowflakeSeqBits) | s.node<<snowflakeSeqBits | s.seq
+}
+
+// putMillis writes the Unix milliseconds of t to b as a 48-bit big-endian number.
+func putMillis(b []byte, t time.Time) {
+	ms := uint64(t.UnixMilli())
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(ms)
+		ms >>= 8
+	}
+}
+
+// formatUUID sets the version and variant bits of b and formats it as a UUID.
+func formatUUID(b [16]byte, version byte) string {
+	b[6] = b[6]&0x0f | version<<4
+	b[8] = b[8]&0x3f | 0x80
+
+	var out [36]byte
+	hex.Encode(out[0:8], b[0:4])
+	out[8] = '-'
+	hex.Encode(out[9:13], b[4:6])
+	out[13] = '-'
+	hex.Encode(out[14:18], b[6:8])
+	out[18] = '-'
+	hex.Encode(out[19:23], b[8:10])
+	out[23] = '-'
+	hex.Encode(out[24:], b[10:])
+	return string(out[:])
+}
+
+// formatULID encodes the 128 bits of b as 26 base32 characters.
+func formatULID(b [16]byte) string {
+	hi := binary.BigEndian.Uint64(b[:8])
+	lo := binary.BigEndian.Uint64(b[8:])
+	var out [26]byte
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = crockford[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
+
+// Sequence is a Generator for tests returning consecutive numbers from 1,
+// whatever the strategy: Prefix followed by the number for string IDs, and
+// the number for numeric ones.
+type Sequence struct {
+	Prefix string
+
+	mu sync.Mutex
+	n  int64
+}
+
+// NewString returns Prefix followed by the next number.
+func (s *Sequence) NewString(ctx context.Context, strategy Strategy) (string, error) {
+	return s.Prefix + strconv.FormatInt(s.next(), 10), nil
+}
+
+// NewInt64 returns the next number.
+func (s *Sequence) NewInt64(ctx context.Context, strategy Strategy) (int64, error) {
+	return s.next(), nil
+}
+
+// next increments and returns the counter.
+func (s *Sequence) next() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.n++
+	return s.n
+}
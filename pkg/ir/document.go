@@ -0,0 +1,422 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/converter"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/types"
+)
+
+// DocumentVersion is bumped whenever the JSON layout changes incompatibly.
+const DocumentVersion = 1
+
+// Field origins describe where a generated struct field came from.
+const (
+	OriginSource    = "source"    // Inherited from the source API message
+	OriginTarget    = "target"    // Declared only on the target message
+	OriginOverride  = "override"  // Declared on the target, replacing a source field
+	OriginGenerated = "generated" // Added by the generator (e.g., Datastore Key)
+)
+
+// Reasons a source field does not appear in the struct or converters.
+const (
+	SkipReasonSkipField     = "skip_field"     // Removed with (dal.v1.skip_field)
+	SkipReasonOneofReplaced = "oneof_replaced" // Oneof member replaced by a target field named after the oneof
+	SkipReasonNoConversion  = "no_conversion"  // No conversion found; the decorator must handle it
+)
+
+// Document is the machine-readable description of one proto file for one
+// target, written by emit_ir=json.
+type Document struct {
+	Version   int              `json:"version"`
+	Target    string           `json:"target"`
+	ProtoFile string           `json:"proto_file"`
+	GoPackage string           `json:"go_package"`
+	Messages  []*Message       `json:"messages"`
+	Registry  []*RegistryEntry `json:"registry"`
+	Warnings  []string         `json:"warnings,omitempty"`
+}
+
+// RegistryEntry maps a source API message to its target message for this target.
+type RegistryEntry struct {
+	Source     string `json:"source"`      // e.g., "api.v1.Author"
+	Target     string `json:"target"`      // e.g., "gorm.AuthorGorm"
+	StructName string `json:"struct_name"` // e.g., "AuthorGORM"
+}
+
+// Message describes a target message and its generated struct.
+type Message struct {
+	Name                    string          `json:"name"` // Full proto name of the target message
+	StructName              string          `json:"struct_name"`
+	Source                  string          `json:"source,omitempty"`
+	TableName               string          `json:"table_name,omitempty"` // Table (GORM) or Kind (Datastore)
+	SchemaName              string          `json:"schema_name,omitempty"`
+	GenerateDAL             bool            `json:"generate_dal"`
+	ImplementScanner        bool            `json:"implement_scanner,omitempty"`
+	ImplementPropertyLoader bool            `json:"implement_property_loader,omitempty"`
+	TenantColumn            string          `json:"tenant_column,omitempty"`
+	TenantNamespace         bool            `json:"tenant_namespace,omitempty"`
+	Audit                   *Audit          `json:"audit,omitempty"`
+	Cache                   bool            `json:"cache,omitempty"`
+	PrimaryKeys             []string        `json:"primary_keys,omitempty"` // Go field names
+	Fields                  []*Field        `json:"fields"`
+	SkippedFields           []*SkippedField `json:"skipped_fields,omitempty"`
+	Converter               *Converter      `json:"converter,omitempty"`
+}
+
+// Audit names the columns the generated DAL fills on writes.
+type Audit struct {
+	CreatedBy string `json:"created_by,omitempty"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// Field describes one field of a generated struct.
+type Field struct {
+	Name       string      `json:"name,omitempty"` // Proto field name (empty for generated fields)
+	Number     int32       `json:"number,omitempty"`
+	Origin     string      `json:"origin"`
+	Kind       string      `json:"kind,omitempty"`      // Proto kind (e.g., "string", "message")
+	TypeName   string      `json:"type_name,omitempty"` // Full name of message/enum types
+	Repeated   bool        `json:"repeated,omitempty"`
+	Map        bool        `json:"map,omitempty"`
+	Optional   bool        `json:"optional,omitempty"`
+	Oneof      string      `json:"oneof,omitempty"`
+	GoName     string      `json:"go_name"`
+	GoType     string      `json:"go_type"`
+	Tags       string      `json:"tags,omitempty"`
+	ColumnName string      `json:"column_name,omitempty"`
+	Conversion *Conversion `json:"conversion,omitempty"`
+}
+
+// SkippedField is a source field that is not converted automatically.
+type SkippedField struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Converter describes the generated converter pair for a message.
+type Converter struct {
+	SourceType string `json:"source_type"`
+	TargetType string `json:"target_type"`
+	ToTarget   string `json:"to_target"`   // e.g., "UserToUserGORM"
+	FromTarget string `json:"from_target"` // e.g., "UserFromUserGORM"
+}
+
+// Conversion describes how a field is converted in each direction.
+// FromTarget is omitted for oneof members, which decorators must populate.
+//
+// Lossy is set when converting to the target and back does not return the
+// original value. RoundTrip is then the Go expression for the value that does
+// come back, reading "want.<SourceField>"; it is empty when that is unknown
+// (e.g., custom converters).
+type Conversion struct {
+	SourceField string          `json:"source_field"`
+	ToTarget    *ConversionStep `json:"to_target"`
+	FromTarget  *ConversionStep `json:"from_target,omitempty"`
+	Lossy       string          `json:"lossy,omitempty"`      // e.g., "sub-second precision"
+	RoundTrip   string          `json:"round_trip,omitempty"` // e.g., "converters.TruncateTimestampToSeconds(want.CreatedAt)"
+}
+
+// ConversionStep describes one direction of a field conversion.
+type ConversionStep struct {
+	Type          string `json:"type"`     // ConversionType (e.g., "ByTransformer")
+	Strategy      string `json:"strategy"` // FieldRenderStrategy (e.g., "InlineValue")
+	Code          string `json:"code,omitempty"`
+	ConverterFunc string `json:"converter_func,omitempty"`
+	ElementType   string `json:"element_type,omitempty"`
+	ElementCode   string `json:"element_code,omitempty"` // Per element of a collection (e.g., "converters.TimestampToTime(item)")
+}
+
+// MessageInput is the data a generator has computed for one message.
+type MessageInput struct {
+	// Info is the collected message
+	Info *collector.MessageInfo
+
+	// StructName is the generated struct name (e.g., "UserGORM")
+	StructName string
+
+	// Fields are the generated struct fields in order
+	Fields []types.FieldData
+
+	// ColumnNames maps Go field names to column names (optional)
+	ColumnNames map[string]string
+
+	// PrimaryKeys lists the primary key Go field names (optional)
+	PrimaryKeys []string
+
+	// Converter is the converter data (nil for messages without a source)
+	Converter *types.ConverterData
+}
+
+// BuildDocument assembles the IR document for one proto file.
+//
+// Parameters:
+//   - target: Target name (e.g., "gorm")
+//   - protoFile: Proto file path (e.g., "gorm/user.proto")
+//   - inputs: Per-message data computed by the generator, in output order
+//   - registry: Source → target registry entries for the whole request
+//   - warnings: Warnings reported while building the inputs
+//
+// Returns:
+//   - the IR document
+func BuildDocument(target, protoFile string, inputs []*MessageInput, registry []*RegistryEntry, warnings []string) *Document {
+	doc := &Document{
+		Version:   DocumentVersion,
+		Target:    target,
+		ProtoFile: protoFile,
+		Messages:  []*Message{},
+		Registry:  registry,
+		Warnings:  warnings,
+	}
+	if len(inputs) > 0 {
+		doc.GoPackage = common.ExtractPackageInfo(inputs[0].Info.TargetMessage).ImportPath
+	}
+
+	for _, input := range inputs {
+		doc.Messages = append(doc.Messages, buildMessage(input))
+	}
+	return doc
+}
+
+// BuildRegistry creates registry entries for all messages of a target, sorted by source name.
+//
+// Parameters:
+//   - messages: All collected messages for the target
+//   - structNameFunc: The generator's struct naming function
+//
+// Returns:
+//   - registry entries for messages that have a source
+func BuildRegistry(messages []*collector.MessageInfo, structNameFunc common.StructNameFunc) []*RegistryEntry {
+	entries := []*RegistryEntry{}
+	for _, msg := range messages {
+		if msg.SourceMessage == nil {
+			continue
+		}
+		entries = append(entries, &RegistryEntry{
+			Source:     string(msg.SourceMessage.Desc.FullName()),
+			Target:     string(msg.TargetMessage.Desc.FullName()),
+			StructName: structNameFunc(msg.TargetMessage),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Source < entries[j].Source
+	})
+	return entries
+}
+
+// Marshal renders the document as indented JSON.
+func (d *Document) Marshal() (string, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Filename returns the IR output filename for a proto file and target.
+//
+// Examples:
+//   - Filename("gorm/user.proto", "gorm") -> "gorm/user_gorm.ir.json"
+//   - Filename("datastore/user.proto", "datastore") -> "datastore/user_datastore.ir.json"
+func Filename(protoFile, target string) string {
+	return common.GenerateFilenameFromProto(protoFile, "_"+target+".ir.json")
+}
+
+// buildMessage builds the IR for one message.
+func buildMessage(input *MessageInput) *Message {
+	info := input.Info
+	msg := &Message{
+		Name:                    string(info.TargetMessage.Desc.FullName()),
+		StructName:              input.StructName,
+		Source:                  info.SourceName,
+		TableName:               info.TableName,
+		SchemaName:              info.SchemaName,
+		GenerateDAL:             info.GenerateDAL,
+		ImplementScanner:        info.ImplementScanner,
+		ImplementPropertyLoader: info.ImplementPropertyLoader,
+		TenantColumn:            info.TenantColumn,
+		TenantNamespace:         info.TenantNamespace,
+		Cache:                   info.Cache,
+		PrimaryKeys:             input.PrimaryKeys,
+		Fields:                  []*Field{},
+	}
+	if audit := info.Audit; audit != nil {
+		msg.Audit = &Audit{
+			CreatedBy: audit.CreatedBy,
+			UpdatedBy: audit.UpdatedBy,
+			CreatedAt: audit.CreatedAt,
+			UpdatedAt: audit.UpdatedAt,
+		}
+	}
+
+	// Proto fields backing the struct, keyed by Go name
+	mergedFields, _ := common.MergeSourceFields(info.SourceMessage, info.TargetMessage)
+	protoFields := make(map[string]*protogen.Field)
+	for _, field := range mergedFields {
+		protoFields[field.GoName] = field
+	}
+
+	sourceFields := make(map[string]*protogen.Field)
+	if info.SourceMessage != nil {
+		for _, field := range info.SourceMessage.Fields {
+			sourceFields[field.GoName] = field
+		}
+	}
+
+	// Field mappings, keyed by target field name
+	mappings := make(map[string]*converter.FieldMapping)
+	if input.Converter != nil {
+		msg.Converter = &Converter{
+			SourceType: input.Converter.SourceType,
+			TargetType: input.Converter.TargetType,
+			ToTarget:   input.Converter.SourceType + "To" + input.Converter.TargetType,
+			FromTarget: input.Converter.SourceType + "From" + input.Converter.TargetType,
+		}
+		for _, mapping := range input.Converter.FieldMappings {
+			mappings[mapping.TargetField] = mapping
+		}
+	}
+
+	// The encoded key (Datastore encoded_key) is converted to and from a
+	// generated field, which it describes
+	var encodedKey *converter.FieldMapping
+	if input.Converter != nil && input.Converter.EncodedKey != nil {
+		encodedKey = input.Converter.EncodedKey
+		mappings[encodedKey.TargetField] = encodedKey
+	}
+
+	for _, fieldData := range input.Fields {
+		field := &Field{
+			GoName:     fieldData.Name,
+			GoType:     fieldData.Type,
+			Tags:       fieldData.Tags,
+			ColumnName: input.ColumnNames[fieldData.Name],
+			Origin:     OriginGenerated,
+		}
+
+		protoField, ok := protoFields[fieldData.Name]
+		if !ok && encodedKey != nil && fieldData.Name == encodedKey.TargetField {
+			protoField, ok = protoFields[encodedKey.SourceField]
+		}
+		if ok {
+			describeProtoField(field, protoField)
+			_, inSource := sourceFields[protoField.GoName]
+			switch {
+			case protoField.Parent == info.TargetMessage && inSource:
+				field.Origin = OriginOverride
+			case protoField.Parent == info.TargetMessage:
+				field.Origin = OriginTarget
+			default:
+				field.Origin = OriginSource
+			}
+
+			if mapping, ok := mappings[fieldData.Name]; ok {
+				field.Conversion = buildConversion(mapping)
+			} else if inSource && input.Converter != nil {
+				msg.SkippedFields = append(msg.SkippedFields, &SkippedField{
+					Name:   string(protoField.Desc.Name()),
+					Reason: SkipReasonNoConversion,
+				})
+			}
+		}
+
+		msg.Fields = append(msg.Fields, field)
+	}
+
+	msg.SkippedFields = append(msg.SkippedFields, mergeSkippedFields(info, protoFields)...)
+	sort.SliceStable(msg.SkippedFields, func(i, j int) bool {
+		return msg.SkippedFields[i].Name < msg.SkippedFields[j].Name
+	})
+	return msg
+}
+
+// mergeSkippedFields lists source fields removed during field merging.
+func mergeSkippedFields(info *collector.MessageInfo, protoFields map[string]*protogen.Field) []*SkippedField {
+	if info.SourceMessage == nil {
+		return nil
+	}
+
+	var skipped []*SkippedField
+	for _, field := range info.SourceMessage.Fields {
+		if _, kept := protoFields[field.GoName]; kept {
+			continue
+		}
+		reason := SkipReasonOneofReplaced
+		for _, targetField := range info.TargetMessage.Fields {
+			if targetField.Desc.Name() == field.Desc.Name() && common.HasSkipField(targetField) {
+				reason = SkipReasonSkipField
+				break
+			}
+		}
+		skipped = append(skipped, &SkippedField{Name: string(field.Desc.Name()), Reason: reason})
+	}
+	return skipped
+}
+
+// describeProtoField copies the proto-level details of a field.
+func describeProtoField(field *Field, protoField *protogen.Field) {
+	desc := protoField.Desc
+	field.Name = string(desc.Name())
+	field.Number = int32(desc.Number())
+	field.Kind = desc.Kind().String()
+	field.Map = desc.IsMap()
+	field.Repeated = desc.IsList()
+	field.Optional = desc.HasOptionalKeyword()
+	if protoField.Message != nil {
+		field.TypeName = string(protoField.Message.Desc.FullName())
+	} else if protoField.Enum != nil {
+		field.TypeName = string(protoField.Enum.Desc.FullName())
+	}
+	if protoField.Oneof != nil && !protoField.Oneof.Desc.IsSynthetic() {
+		field.Oneof = string(protoField.Oneof.Desc.Name())
+	}
+}
+
+// buildConversion describes a field mapping in both directions.
+func buildConversion(mapping *converter.FieldMapping) *Conversion {
+	conversion := &Conversion{
+		SourceField: mapping.SourceField,
+		Lossy:       mapping.Lossy,
+		RoundTrip:   mapping.RoundTripCode,
+		ToTarget: &ConversionStep{
+			Type:          mapping.ToTargetConversionType.String(),
+			Strategy:      mapping.ToTargetRenderStrategy.String(),
+			Code:          mapping.ToTargetCode,
+			ConverterFunc: mapping.ToTargetConverterFunc,
+			ElementType:   mapping.TargetElementType,
+			ElementCode:   mapping.ElementToTargetCode,
+		},
+	}
+	if !mapping.SourceIsOneofMember {
+		conversion.FromTarget = &ConversionStep{
+			Type:          mapping.FromTargetConversionType.String(),
+			Strategy:      mapping.FromTargetRenderStrategy.String(),
+			Code:          mapping.FromTargetCode,
+			ConverterFunc: mapping.FromTargetConverterFunc,
+			ElementType:   mapping.SourceElementType,
+			ElementCode:   mapping.ElementFromTargetCode,
+		}
+	}
+	return conversion
+}
@@ -136,12 +136,17 @@ func (r RelationshipType) String() string {
 	}
 }
 
-// GetStorageStrategy determines the storage strategy from column options
-// GetStorageStrategy is deprecated - we now use target-specific tags directly.
-// Keeping this for reference but it's not used in the new design.
+// GetStorageStrategy determines the storage strategy from column options.
+// Only the generator-managed layouts are reported: child_table fields are
+// StorageSeparateTable and storage fields are StorageSerialized. Everything
+// else is StorageAuto, as column types are chosen with target-specific tags
+// (e.g., gorm_tags: ["type:jsonb"]).
 func GetStorageStrategy(colOpts *dalv1.ColumnOptions, field *protogen.Field) StorageStrategy {
-	// NOTE: This function is not used. With the new design, storage strategies
-	// are determined by target-specific tags (e.g., gorm_tags: ["type:jsonb"]).
-	// Users specify types directly using their target's syntax.
+	if colOpts.GetChildTable() != nil {
+		return StorageSeparateTable
+	}
+	if colOpts.GetStorage() != dalv1.MessageStorage_MESSAGE_STORAGE_UNSPECIFIED {
+		return StorageSerialized
+	}
 	return StorageAuto
 }
@@ -0,0 +1,237 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/driver"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/ir"
+)
+
+// lintTarget collects a target's messages and runs every rule on them.
+//
+// Severities and the ignore list are applied by Lint.
+func lintTarget(plugin *protogen.Plugin, name string) ([]*Issue, error) {
+	target, ok := driver.LookupTarget(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown target %q (supported: %s)", name, strings.Join(driver.TargetNames(), ", "))
+	}
+
+	var issues []*Issue
+
+	// Phase 1: Collect messages, reporting broken source references individually
+	messages, messageErrors := collector.CollectMessagesWithErrors(plugin, target.Collector)
+	for _, msgErr := range messageErrors {
+		rule := RuleUnknownSource
+		if errors.Is(msgErr.Err, collector.ErrSidecarNameCollision) {
+			rule = RuleSidecarNameCollision
+		}
+		var desc protoreflect.Descriptor
+		if msgErr.Message != nil {
+			desc = msgErr.Message.Desc
+		}
+		issues = append(issues, newIssue(rule, desc, "", "%v", msgErr.Err))
+	}
+
+	// Phase 2: Structural checks; messages that fail them cannot be analysed further
+	var valid []*collector.MessageInfo
+	infos := make(map[string]*collector.MessageInfo)
+	for _, msg := range messages {
+		skipIssues := checkSkipFields(msg)
+		if len(skipIssues) > 0 {
+			issues = append(issues, skipIssues...)
+			continue
+		}
+		valid = append(valid, msg)
+		infos[string(msg.TargetMessage.Desc.FullName())] = msg
+	}
+
+	// Phase 3: Mapping analysis on the generator's IR
+	docs, err := target.BuildIR(valid)
+	if err != nil {
+		issues = append(issues, newIssue(RuleGenerationError, nil, "", "%s: %v", name, err))
+		return issues, nil
+	}
+
+	for _, doc := range docs {
+		for _, irMsg := range doc.Messages {
+			info := infos[irMsg.Name]
+			issues = append(issues, checkConversions(info, irMsg)...)
+
+			switch doc.Target {
+			case "gorm":
+				issues = append(issues, checkPrimaryKey(info, irMsg)...)
+			case "datastore":
+				issues = append(issues, checkDatastoreTypes(info, irMsg)...)
+			}
+		}
+	}
+	return issues, nil
+}
+
+// checkSkipFields reports skip_field annotations on fields the source does not have.
+func checkSkipFields(info *collector.MessageInfo) []*Issue {
+	if info.SourceMessage == nil {
+		return nil
+	}
+
+	var issues []*Issue
+	for _, field := range info.TargetMessage.Fields {
+		if !common.HasSkipField(field) || findField(info.SourceMessage, string(field.Desc.Name())) != nil {
+			continue
+		}
+		issues = append(issues, newIssue(RuleUnknownSkipField, field.Desc, "",
+			"field %q has skip_field but source %s has no field with that name",
+			field.Desc.Name(), info.SourceName))
+	}
+	return issues
+}
+
+// checkConversions reports source fields the generated converters leave out.
+func checkConversions(info *collector.MessageInfo, irMsg *ir.Message) []*Issue {
+	var issues []*Issue
+	for _, skipped := range irMsg.SkippedFields {
+		if skipped.Reason != ir.SkipReasonNoConversion {
+			continue
+		}
+
+		sourceField := findField(info.SourceMessage, skipped.Name)
+		targetField := findField(info.TargetMessage, skipped.Name)
+		if targetField == nil {
+			targetField = sourceField // Inherited from the source unchanged
+		}
+		desc, element := fieldPosition(info, skipped.Name)
+
+		sourceElem, targetElem := elementMessage(sourceField), elementMessage(targetField)
+		if sourceElem != nil && targetElem != nil {
+			issues = append(issues, newIssue(RuleMissingConverter, desc, element,
+				"field %q (%s) is not converted: no sidecar declares source %q; add one or handle the field in a decorator",
+				skipped.Name, describeType(sourceField), sourceElem.Desc.FullName()))
+			continue
+		}
+
+		issues = append(issues, newIssue(RuleNoConversion, desc, element,
+			"field %q is not converted: no conversion from %s to %s; change the target type or handle the field in a decorator",
+			skipped.Name, describeType(sourceField), describeType(targetField)))
+	}
+	return issues
+}
+
+// checkPrimaryKey reports GORM messages with DAL helpers but no primary key.
+// GenerateDALHelpers silently skips such messages.
+func checkPrimaryKey(info *collector.MessageInfo, irMsg *ir.Message) []*Issue {
+	if !irMsg.GenerateDAL || len(irMsg.PrimaryKeys) > 0 {
+		return nil
+	}
+	return []*Issue{newIssue(RuleMissingPrimaryKey, info.TargetMessage.Desc, "",
+		"%s has DAL helpers enabled but declares no primary key, so its DAL is skipped; declare the id field (fields inherited from the source are not considered), tag a field with gorm_tags \"primaryKey\", or set dal: false",
+		info.TargetMessage.Desc.Name())}
+}
+
+// checkDatastoreTypes reports stored fields whose Go type Datastore cannot hold.
+func checkDatastoreTypes(info *collector.MessageInfo, irMsg *ir.Message) []*Issue {
+	var issues []*Issue
+	for _, field := range irMsg.Fields {
+		if field.Name == "" || field.ColumnName == "" {
+			continue // Generated or not stored
+		}
+		if field.Map && irMsg.ImplementPropertyLoader {
+			continue // Maps are JSON-encoded by the generated Save/Load
+		}
+
+		goType := strings.TrimLeft(field.GoType, "[]*")
+		if field.Map {
+			goType = goType[strings.Index(goType, "]")+1:]
+		}
+		if !strings.HasPrefix(goType, "uint") {
+			continue
+		}
+
+		desc, element := fieldPosition(info, field.Name)
+		issues = append(issues, newIssue(RuleUnsupportedType, desc, element,
+			"field %q has Go type %s, which Datastore cannot store; use int64 or string in the sidecar",
+			field.Name, field.GoType))
+	}
+	return issues
+}
+
+// fieldPosition returns the descriptor to position an issue at and the
+// element name for a field of a target message. Fields inherited from the
+// source are reported at the target message.
+func fieldPosition(info *collector.MessageInfo, name string) (protoreflect.Descriptor, string) {
+	element := string(info.TargetMessage.Desc.FullName()) + "." + name
+	if field := findField(info.TargetMessage, name); field != nil {
+		return field.Desc, element
+	}
+	return info.TargetMessage.Desc, element
+}
+
+// findField returns the field with the given proto name, or nil.
+func findField(msg *protogen.Message, name string) *protogen.Field {
+	if msg == nil {
+		return nil
+	}
+	for _, field := range msg.Fields {
+		if string(field.Desc.Name()) == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// elementMessage returns the message type of a field, or of its elements for
+// repeated and map fields, or nil for scalar fields.
+func elementMessage(field *protogen.Field) *protogen.Message {
+	if field == nil {
+		return nil
+	}
+	if field.Desc.IsMap() {
+		return field.Message.Fields[1].Message
+	}
+	return field.Message
+}
+
+// describeType returns a proto-style type description (e.g., "repeated api.v1.Tag").
+func describeType(field *protogen.Field) string {
+	if field == nil {
+		return "unknown"
+	}
+	if field.Desc.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", scalarOrMessage(field.Message.Fields[0]), scalarOrMessage(field.Message.Fields[1]))
+	}
+	if field.Desc.IsList() {
+		return "repeated " + scalarOrMessage(field)
+	}
+	return scalarOrMessage(field)
+}
+
+// scalarOrMessage returns the kind of a scalar field or the full name of a message/enum.
+func scalarOrMessage(field *protogen.Field) string {
+	if field.Message != nil {
+		return string(field.Message.Desc.FullName())
+	}
+	if field.Enum != nil {
+		return string(field.Enum.Desc.FullName())
+	}
+	return field.Desc.Kind().String()
+}
@@ -0,0 +1,315 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint validates DAL sidecar protos without generating code.
+//
+// It runs the collector and the generators' mapping analysis (via the IR,
+// see pkg/ir) for each target and reports problems that would otherwise
+// surface as generation failures, compile errors or silently skipped fields.
+// Issues carry the proto file and line of the offending message or field.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Severity is how seriously an issue is reported.
+type Severity int
+
+const (
+	// SeverityOff disables a rule
+	SeverityOff Severity = iota
+
+	// SeverityWarning reports an issue without failing the lint run
+	SeverityWarning
+
+	// SeverityError reports an issue and fails the lint run
+	SeverityError
+)
+
+// String returns the name used in options and output (e.g., "warning").
+func (s Severity) String() string {
+	switch s {
+	case SeverityOff:
+		return "off"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// ParseSeverity parses "off", "warning" or "error".
+func ParseSeverity(value string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "off":
+		return SeverityOff, nil
+	case "warning", "warn":
+		return SeverityWarning, nil
+	case "error":
+		return SeverityError, nil
+	}
+	return SeverityOff, fmt.Errorf("unknown severity %q (supported: off, warning, error)", value)
+}
+
+// Rule names.
+const (
+	RuleUnknownSource        = "unknown-source"
+	RuleUnknownSkipField     = "unknown-skip-field"
+	RuleMissingConverter     = "missing-converter"
+	RuleNoConversion         = "no-conversion"
+	RuleMissingPrimaryKey    = "missing-primary-key"
+	RuleUnsupportedType      = "unsupported-type"
+	RuleGenerationError      = "generation-error"
+	RuleSidecarNameCollision = "sidecar-name-collision"
+)
+
+// Rule describes a lint check.
+type Rule struct {
+	// Name is used in output, severity overrides and the ignore list
+	Name string
+
+	// Severity is the default severity
+	Severity Severity
+
+	// Description is a one-line summary of what the rule checks
+	Description string
+}
+
+// Rules lists all lint rules.
+var Rules = []*Rule{
+	{RuleUnknownSource, SeverityError, "the source message of a sidecar does not exist"},
+	{RuleUnknownSkipField, SeverityError, "skip_field is set on a field that does not exist in the source"},
+	{RuleMissingConverter, SeverityError, "a message field's type has no converter (no sidecar declares it as source)"},
+	{RuleNoConversion, SeverityWarning, "source and target field types differ and no built-in conversion exists, so the field is not converted"},
+	{RuleMissingPrimaryKey, SeverityError, "a GORM message with DAL helpers enabled declares no primary key, so its DAL is skipped"},
+	{RuleUnsupportedType, SeverityError, "a Datastore entity field has a type Datastore cannot store (unsigned integers)"},
+	{RuleGenerationError, SeverityError, "the generator could not build the target's structs or converters"},
+	{RuleSidecarNameCollision, SeverityError, "auto_sidecar would synthesize a message whose name is already taken"},
+}
+
+// lookupRule returns the rule with the given name.
+func lookupRule(name string) *Rule {
+	for _, rule := range Rules {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ruleNames returns the names of all rules.
+func ruleNames() []string {
+	names := make([]string, 0, len(Rules))
+	for _, rule := range Rules {
+		names = append(names, rule.Name)
+	}
+	return names
+}
+
+// Config controls which issues are reported and how.
+type Config struct {
+	// Severities overrides the default severity per rule name
+	Severities map[string]Severity
+
+	// Ignore suppresses issues. Each entry is either a rule name
+	// ("missing-converter"), or a rule name and a proto element
+	// ("missing-converter@gorm.UserGorm.profile"). An element matches itself
+	// and anything nested in it, so a message name covers all its fields.
+	// "*" matches every rule.
+	Ignore []string
+}
+
+// ParseSeverities parses "rule:severity" entries into a severity map.
+//
+// Examples:
+//   - ParseSeverities([]string{"missing-converter:error"}) -> {"missing-converter": SeverityError}
+//   - ParseSeverities([]string{"no-conversion:off"}) -> {"no-conversion": SeverityOff}
+func ParseSeverities(entries []string) (map[string]Severity, error) {
+	result := make(map[string]Severity)
+	for _, entry := range entries {
+		name, value, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid severity %q (expected rule:off|warning|error)", entry)
+		}
+		name = strings.TrimSpace(name)
+		if lookupRule(name) == nil {
+			return nil, fmt.Errorf("unknown lint rule %q (rules: %s)", name, strings.Join(ruleNames(), ", "))
+		}
+		severity, err := ParseSeverity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid severity for %s: %w", name, err)
+		}
+		result[name] = severity
+	}
+	return result, nil
+}
+
+// validate checks that the config only names known rules.
+func (c *Config) validate() error {
+	for name := range c.Severities {
+		if lookupRule(name) == nil {
+			return fmt.Errorf("unknown lint rule %q (rules: %s)", name, strings.Join(ruleNames(), ", "))
+		}
+	}
+	for _, entry := range c.Ignore {
+		name, _, _ := strings.Cut(entry, "@")
+		if name != "*" && lookupRule(name) == nil {
+			return fmt.Errorf("unknown lint rule %q in ignore entry %q (rules: %s)", name, entry, strings.Join(ruleNames(), ", "))
+		}
+	}
+	return nil
+}
+
+// severity returns the effective severity of a rule.
+func (c *Config) severity(rule string) Severity {
+	if severity, ok := c.Severities[rule]; ok {
+		return severity
+	}
+	return lookupRule(rule).Severity
+}
+
+// ignored reports whether an issue is suppressed by the ignore list.
+func (c *Config) ignored(issue *Issue) bool {
+	for _, entry := range c.Ignore {
+		name, element, hasElement := strings.Cut(entry, "@")
+		if name != "*" && name != issue.Rule {
+			continue
+		}
+		if !hasElement || issue.Element == element || strings.HasPrefix(issue.Element, element+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Issue is a single problem found by the linter.
+type Issue struct {
+	Rule     string
+	Severity Severity
+	Target   string // e.g., "gorm"
+	File     string // Proto file path (empty if unknown)
+	Line     int    // 1-based line (0 if unknown)
+	Column   int    // 1-based column (0 if unknown)
+	Element  string // Full name of the offending message or field
+	Message  string
+}
+
+// String formats the issue as "file:line:col: severity: message [rule]".
+func (i *Issue) String() string {
+	var pos strings.Builder
+	if i.File != "" {
+		pos.WriteString(i.File)
+		if i.Line > 0 {
+			fmt.Fprintf(&pos, ":%d:%d", i.Line, i.Column)
+		}
+		pos.WriteString(": ")
+	}
+	return fmt.Sprintf("%s%s: %s [%s]", pos.String(), i.Severity, i.Message, i.Rule)
+}
+
+// Lint runs all rules for each named target.
+//
+// Parameters:
+//   - plugin: The protogen plugin containing all proto files
+//   - targetNames: Targets to lint (e.g., ["gorm", "datastore"])
+//   - config: Severity overrides and ignore list (nil uses the defaults)
+//
+// Returns:
+//   - issues sorted by file, line and rule, excluding ignored and disabled ones
+//   - error if the config or a target name is invalid
+func Lint(plugin *protogen.Plugin, targetNames []string, config *Config) ([]*Issue, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	var issues []*Issue
+	for _, name := range targetNames {
+		found, err := lintTarget(plugin, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range found {
+			issue.Target = name
+			issue.Severity = config.severity(issue.Rule)
+			if issue.Severity == SeverityOff || config.ignored(issue) {
+				continue
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Rule < b.Rule
+	})
+	return issues, nil
+}
+
+// CountErrors returns the number of issues with SeverityError.
+func CountErrors(issues []*Issue) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// newIssue creates an issue positioned at a proto descriptor.
+//
+// element is the full name reported for the issue; it defaults to the
+// descriptor's full name when empty.
+func newIssue(rule string, desc protoreflect.Descriptor, element string, format string, args ...any) *Issue {
+	issue := &Issue{
+		Rule:    rule,
+		Element: element,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if desc == nil {
+		return issue
+	}
+	if issue.Element == "" {
+		issue.Element = string(desc.FullName())
+	}
+	if file := desc.ParentFile(); file != nil {
+		issue.File = file.Path()
+		loc := file.SourceLocations().ByDescriptor(desc)
+		if len(loc.Path) > 0 {
+			issue.Line = loc.StartLine + 1
+			issue.Column = loc.StartColumn + 1
+		}
+	}
+	return issue
+}
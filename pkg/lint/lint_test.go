@@ -0,0 +1,247 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// createLintPlugin builds a proto set in which every rule fires at least once.
+func createLintPlugin(t *testing.T) *protogen.Plugin {
+	t.Helper()
+
+	return testutil.CreateTestPlugin(t, &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "api/v1/user.proto",
+				Pkg:  "api.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name:   "Profile",
+						Fields: []testutil.TestField{{Name: "bio", Number: 1, TypeName: "string"}},
+					},
+					{
+						Name: "User",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "name", Number: 2, TypeName: "string"},
+							{Name: "age", Number: 3, TypeName: "uint32"},
+							{Name: "profile", Number: 4, TypeName: "api.v1.Profile"},
+						},
+					},
+				},
+			},
+			{
+				Name: "gorm/user.proto",
+				Pkg:  "gorm",
+				AutoSidecar: []*dalv1.AutoSidecarOptions{
+					{Target: dalv1.SidecarTarget_GORM, PackageInclude: []string{"api.v1"}},
+				},
+				Messages: []testutil.TestMessage{
+					{
+						// Takes the name auto_sidecar would give api.v1.Profile
+						Name:   "ProfileGorm",
+						Fields: []testutil.TestField{{Name: "bio", Number: 1, TypeName: "string"}},
+					},
+					{
+						// name has no string -> bool conversion; profile has no sidecar
+						Name:     "UserGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.User", Table: "users"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "name", Number: 2, TypeName: "bool"},
+							{Name: "profile", Number: 4, TypeName: "string", SkipField: true},
+						},
+					},
+					{
+						// DAL enabled by table, but id is only inherited
+						Name:     "AccountGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.User", Table: "accounts"},
+						Fields: []testutil.TestField{
+							{Name: "profile", Number: 4, TypeName: "string", SkipField: true},
+						},
+					},
+					{
+						Name:     "BadSkipGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.User"},
+						Fields: []testutil.TestField{
+							{Name: "nickname", Number: 10, TypeName: "string", SkipField: true},
+						},
+					},
+					{
+						Name:     "OrphanGorm",
+						GormOpts: &dalv1.GormOptions{Source: "api.v1.Missing"},
+					},
+				},
+			},
+			{
+				Name: "datastore/user.proto",
+				Pkg:  "datastore",
+				Messages: []testutil.TestMessage{
+					{
+						// age is inherited as uint32; profile has no Datastore sidecar
+						Name:          "UserDatastore",
+						DatastoreOpts: &dalv1.DatastoreOptions{Source: "api.v1.User", Kind: "User"},
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// issueKeys returns "rule element" keys for easy assertions.
+func issueKeys(issues []*Issue) map[string]*Issue {
+	keys := make(map[string]*Issue)
+	for _, issue := range issues {
+		keys[issue.Rule+" "+issue.Element] = issue
+	}
+	return keys
+}
+
+func TestLint_Rules(t *testing.T) {
+	issues, err := Lint(createLintPlugin(t), []string{"gorm", "datastore"}, nil)
+	if err != nil {
+		t.Fatalf("Lint failed: %v", err)
+	}
+	keys := issueKeys(issues)
+
+	expected := map[string]Severity{
+		"unknown-source gorm.OrphanGorm":                    SeverityError,
+		"unknown-skip-field gorm.BadSkipGorm.nickname":      SeverityError,
+		"no-conversion gorm.UserGorm.name":                  SeverityWarning,
+		"missing-primary-key gorm.AccountGorm":              SeverityError,
+		"unsupported-type datastore.UserDatastore.age":      SeverityError,
+		"missing-converter datastore.UserDatastore.profile": SeverityError,
+		"sidecar-name-collision gorm.ProfileGorm":           SeverityError,
+	}
+	for key, severity := range expected {
+		issue, ok := keys[key]
+		if !ok {
+			t.Errorf("Expected issue %q, got %v", key, issues)
+			continue
+		}
+		if issue.Severity != severity {
+			t.Errorf("Expected %q to be %s, got %s", key, severity, issue.Severity)
+		}
+		if issue.File == "" {
+			t.Errorf("Expected %q to have a file position", key)
+		}
+	}
+
+	// Skipped fields are intentional and must not be reported
+	if _, ok := keys["missing-converter gorm.UserGorm.profile"]; ok {
+		t.Error("Expected skip_field to silence missing-converter")
+	}
+	// Messages with broken skip_field references are not analysed further
+	for key := range keys {
+		if key != "unknown-skip-field gorm.BadSkipGorm.nickname" && issueElementHasPrefix(keys[key], "gorm.BadSkipGorm") {
+			t.Errorf("Expected no further issues for BadSkipGorm, got %q", key)
+		}
+	}
+
+	if got := CountErrors(issues); got != len(issues)-1 {
+		t.Errorf("Expected all but the no-conversion issue to be errors, got %d of %d", got, len(issues))
+	}
+}
+
+// issueElementHasPrefix reports whether an issue's element is or is nested in name.
+func issueElementHasPrefix(issue *Issue, name string) bool {
+	return issue.Element == name || len(issue.Element) > len(name) && issue.Element[:len(name)+1] == name+"."
+}
+
+func TestLint_SeverityAndIgnore(t *testing.T) {
+	issues, err := Lint(createLintPlugin(t), []string{"gorm", "datastore"}, &Config{
+		Severities: map[string]Severity{
+			RuleNoConversion:  SeverityError,
+			RuleUnknownSource: SeverityOff,
+		},
+		Ignore: []string{
+			"missing-primary-key",
+			"*@datastore.UserDatastore",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Lint failed: %v", err)
+	}
+	keys := issueKeys(issues)
+
+	if issue := keys["no-conversion gorm.UserGorm.name"]; issue == nil || issue.Severity != SeverityError {
+		t.Errorf("Expected no-conversion to be raised to error, got %v", issue)
+	}
+	for _, key := range []string{
+		"unknown-source gorm.OrphanGorm",
+		"missing-primary-key gorm.AccountGorm",
+		"unsupported-type datastore.UserDatastore.age",
+		"missing-converter datastore.UserDatastore.profile",
+	} {
+		if _, ok := keys[key]; ok {
+			t.Errorf("Expected %q to be suppressed", key)
+		}
+	}
+}
+
+func TestLint_UnknownRuleInConfig(t *testing.T) {
+	_, err := Lint(createLintPlugin(t), []string{"gorm"}, &Config{Ignore: []string{"missing-convertor"}})
+	if err == nil {
+		t.Error("Expected error for misspelt rule in ignore list")
+	}
+}
+
+func TestParseSeverities(t *testing.T) {
+	severities, err := ParseSeverities([]string{"no-conversion:error", "unsupported-type:off"})
+	if err != nil {
+		t.Fatalf("ParseSeverities failed: %v", err)
+	}
+	if severities[RuleNoConversion] != SeverityError || severities[RuleUnsupportedType] != SeverityOff {
+		t.Errorf("Unexpected severities: %v", severities)
+	}
+
+	for _, bad := range []string{"no-conversion", "no-conversion:fatal", "bogus:error"} {
+		if _, err := ParseSeverities([]string{bad}); err == nil {
+			t.Errorf("Expected error for %q", bad)
+		}
+	}
+}
+
+func TestIssueString(t *testing.T) {
+	issue := &Issue{
+		Rule:     RuleNoConversion,
+		Severity: SeverityWarning,
+		File:     "gorm/user.proto",
+		Line:     12,
+		Column:   3,
+		Message:  "field \"name\" is not converted",
+	}
+	want := `gorm/user.proto:12:3: warning: field "name" is not converted [no-conversion]`
+	if got := issue.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	// Without source info only the file is shown
+	issue.Line, issue.Column = 0, 0
+	want = `gorm/user.proto: warning: field "name" is not converted [no-conversion]`
+	if got := issue.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roundtrip
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SharedMemory returns the paths (e.g., "Authors[0].Tags") of the non-empty
+// slices, non-nil maps and pointers reachable from a that are the same memory
+// as their counterpart in b. Generated Clone tests use it to check that a
+// clone does not alias the original.
+//
+// a and b must have the same type. Only exported struct fields are followed,
+// so values such as time.Time, whose pointers are never written through, are
+// not reported.
+func SharedMemory(a, b any) []string {
+	var paths []string
+	sharedMemory(reflect.ValueOf(a), reflect.ValueOf(b), "", &paths)
+	return paths
+}
+
+// sharedMemory walks a and b in step and appends the paths at which they
+// share memory.
+func sharedMemory(a, b reflect.Value, path string, paths *[]string) {
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			*paths = append(*paths, pathOrRoot(path))
+			return
+		}
+		sharedMemory(a.Elem(), b.Elem(), path, paths)
+	case reflect.Interface:
+		if !a.IsNil() && !b.IsNil() {
+			sharedMemory(a.Elem(), b.Elem(), path, paths)
+		}
+	case reflect.Slice:
+		if a.Len() > 0 && b.Len() > 0 && a.Pointer() == b.Pointer() {
+			*paths = append(*paths, pathOrRoot(path))
+			return
+		}
+		for i := 0; i < min(a.Len(), b.Len()); i++ {
+			sharedMemory(a.Index(i), b.Index(i), fmt.Sprintf("%s[%d]", path, i), paths)
+		}
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			sharedMemory(a.Index(i), b.Index(i), fmt.Sprintf("%s[%d]", path, i), paths)
+		}
+	case reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			*paths = append(*paths, pathOrRoot(path))
+			return
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			if value := b.MapIndex(iter.Key()); value.IsValid() {
+				sharedMemory(iter.Value(), value, fmt.Sprintf("%s[%v]", path, iter.Key()), paths)
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if path != "" {
+				name = path + "." + name
+			}
+			sharedMemory(a.Field(i), b.Field(i), name, paths)
+		}
+	}
+}
+
+// pathOrRoot names the top-level value in SharedMemory paths.
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
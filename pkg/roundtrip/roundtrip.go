@@ -0,0 +1,221 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package roundtrip provides the runtime helpers used by the converter
+// round-trip tests that protoc-gen-dal writes with generate_tests=true.
+//
+// The generated tests fill API messages with random values, convert them to
+// the target struct and back, and compare the result with proto.Equal after
+// clearing the fields the converters do not restore.
+package roundtrip
+
+import (
+	"fmt"
+	"math/rand"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Iterations is the number of random messages each generated test converts.
+var Iterations = 100
+
+// MaxDepth limits how deeply nested messages are filled. Message fields
+// below it are set to empty messages, which also ends recursive types.
+var MaxDepth = 3
+
+// maxItems is the maximum number of entries in a filled list or map.
+const maxItems = 3
+
+// Fill sets the fields of msg to random values.
+//
+// Every singular message field is set (empty below MaxDepth), lists and maps
+// get up to three entries, and each oneof has at most one member set. Strings
+// are valid UTF-8, floats are finite and enums use declared values, so the
+// result always survives proto.Marshal and compares equal to itself.
+// Timestamp, Duration and Any are filled with valid values.
+func Fill(msg proto.Message, rng *rand.Rand) {
+	fillMessage(msg.ProtoReflect(), rng, 0)
+}
+
+// ClearFields clears the named fields of msg.
+//
+// It panics if msg has no field with one of the names, since generated tests
+// only name fields of the message they were generated for.
+func ClearFields(msg proto.Message, names ...string) {
+	m := msg.ProtoReflect()
+	for _, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			panic(fmt.Sprintf("roundtrip: %s has no field %q", m.Descriptor().FullName(), name))
+		}
+		m.Clear(fd)
+	}
+}
+
+// Each returns a copy of s with f applied to every element, or nil if s is
+// nil. Generated tests use it for repeated fields converted element by
+// element with a lossy conversion.
+func Each[T any](s []T, f func(T) T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for i, item := range s {
+		out[i] = f(item)
+	}
+	return out
+}
+
+// EachValue returns a copy of m with f applied to every value, or nil if m
+// is nil. It is the map counterpart of Each.
+func EachValue[K comparable, V any](m map[K]V, f func(V) V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	out := make(map[K]V, len(m))
+	for key, value := range m {
+		out[key] = f(value)
+	}
+	return out
+}
+
+// fillMessage fills m with random values.
+func fillMessage(m protoreflect.Message, rng *rand.Rand, depth int) {
+	desc := m.Descriptor()
+	if fillWellKnown(m, rng) || depth >= MaxDepth {
+		return
+	}
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			continue // Filled below
+		}
+		fillField(m, fd, rng, depth)
+	}
+
+	oneofs := desc.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		oneof := oneofs.Get(i)
+		if oneof.IsSynthetic() {
+			continue
+		}
+		// One slot past the last member leaves the oneof unset
+		if n := rng.Intn(oneof.Fields().Len() + 1); n < oneof.Fields().Len() {
+			fillField(m, oneof.Fields().Get(n), rng, depth)
+		}
+	}
+}
+
+// fillWellKnown fills well-known types whose values must be in range.
+// Returns false for other messages.
+func fillWellKnown(m protoreflect.Message, rng *rand.Rand) bool {
+	fields := m.Descriptor().Fields()
+	switch m.Descriptor().FullName() {
+	case "google.protobuf.Timestamp":
+		// Between 1970 and 2100, so time.Time conversions stay exact
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(rng.Int63n(4102444800)))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(rng.Int31n(1e9)))
+	case "google.protobuf.Duration":
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(rng.Int63n(1e9)))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(rng.Int31n(1e9)))
+	case "google.protobuf.Any":
+		m.Set(fields.ByName("type_url"), protoreflect.ValueOfString("type.googleapis.com/roundtrip.Random"))
+		m.Set(fields.ByName("value"), protoreflect.ValueOfBytes(randomBytes(rng)))
+	default:
+		return false
+	}
+	return true
+}
+
+// fillField sets one field of m to a random value.
+func fillField(m protoreflect.Message, fd protoreflect.FieldDescriptor, rng *rand.Rand, depth int) {
+	switch {
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		for n := rng.Intn(maxItems + 1); n > 0; n-- {
+			if fd.Message() != nil {
+				item := list.NewElement()
+				fillMessage(item.Message(), rng, depth+1)
+				list.Append(item)
+			} else {
+				list.Append(randomScalar(fd, rng))
+			}
+		}
+	case fd.IsMap():
+		entries := m.Mutable(fd).Map()
+		for n := rng.Intn(maxItems + 1); n > 0; n-- {
+			key := randomScalar(fd.MapKey(), rng).MapKey()
+			if fd.MapValue().Message() != nil {
+				value := entries.NewValue()
+				fillMessage(value.Message(), rng, depth+1)
+				entries.Set(key, value)
+			} else {
+				entries.Set(key, randomScalar(fd.MapValue(), rng))
+			}
+		}
+	case fd.Message() != nil:
+		fillMessage(m.Mutable(fd).Message(), rng, depth+1)
+	default:
+		m.Set(fd, randomScalar(fd, rng))
+	}
+}
+
+// randomScalar returns a random value for a non-message field.
+func randomScalar(fd protoreflect.FieldDescriptor, rng *rand.Rand) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(rng.Intn(2) == 1)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(rng.Intn(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(rng.Uint32()))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(rng.Uint64()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(rng.Uint32())
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(rng.Uint64())
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(rng.NormFloat64() * 1e3))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(rng.NormFloat64() * 1e6)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(randomString(rng))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(randomBytes(rng))
+	default:
+		panic(fmt.Sprintf("roundtrip: unsupported kind %s for %s", fd.Kind(), fd.FullName()))
+	}
+}
+
+// randomString returns a short random alphanumeric string.
+func randomString(rng *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, rng.Intn(12))
+	for i := range b {
+		b[i] = letters[rng.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// randomBytes returns a short random byte slice.
+func randomBytes(rng *rand.Rand) []byte {
+	b := make([]byte, rng.Intn(12))
+	rng.Read(b)
+	return b
+}
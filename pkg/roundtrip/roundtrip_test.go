@@ -0,0 +1,200 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roundtrip
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/typepb"
+)
+
+func TestFill_DeterministicAndMarshalable(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		a := &typepb.Type{}
+		b := &typepb.Type{}
+		Fill(a, rand.New(rand.NewSource(seed)))
+		Fill(b, rand.New(rand.NewSource(seed)))
+		if !proto.Equal(a, b) {
+			t.Fatalf("seed %d: Fill is not deterministic", seed)
+		}
+
+		data, err := proto.Marshal(a)
+		if err != nil {
+			t.Fatalf("seed %d: Marshal failed: %v", seed, err)
+		}
+		decoded := &typepb.Type{}
+		if err := proto.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("seed %d: Unmarshal failed: %v", seed, err)
+		}
+		if !proto.Equal(a, decoded) {
+			t.Fatalf("seed %d: filled message does not survive Marshal", seed)
+		}
+	}
+}
+
+func TestFill_RecursiveTypesAreBounded(t *testing.T) {
+	// Value -> Struct -> map<string, Value> -> ... recurses without limit
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		value := &structpb.Value{}
+		Fill(value, rng)
+		if deepest := deepestSetMessage(value.ProtoReflect(), 0); deepest >= MaxDepth {
+			t.Fatalf("Expected messages at depth %d to be empty, got %v", MaxDepth, value)
+		}
+	}
+}
+
+// deepestSetMessage returns the depth of the deepest message with a field set.
+func deepestSetMessage(m protoreflect.Message, depth int) int {
+	deepest := -1
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		deepest = max(deepest, depth)
+		switch {
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+				deepest = max(deepest, deepestSetMessage(item.Message(), depth+1))
+				return true
+			})
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				deepest = max(deepest, deepestSetMessage(v.List().Get(i).Message(), depth+1))
+			}
+		case !fd.IsMap() && !fd.IsList() && fd.Message() != nil:
+			deepest = max(deepest, deepestSetMessage(v.Message(), depth+1))
+		}
+		return true
+	})
+	return deepest
+}
+
+func TestFill_WellKnownTypes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		ts := &timestamppb.Timestamp{}
+		Fill(ts, rng)
+		if err := ts.CheckValid(); err != nil {
+			t.Fatalf("Expected a valid Timestamp, got %v: %v", ts, err)
+		}
+
+		anyMsg := &anypb.Any{}
+		Fill(anyMsg, rng)
+		if anyMsg.GetTypeUrl() == "" {
+			t.Fatalf("Expected Any to have a type URL")
+		}
+	}
+}
+
+func TestClearFields(t *testing.T) {
+	msg := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("id"),
+		JsonName: proto.String("id"),
+		Number:   proto.Int32(1),
+	}
+	ClearFields(msg, "json_name", "number")
+	if msg.JsonName != nil || msg.Number != nil || msg.GetName() != "id" {
+		t.Errorf("Expected only json_name and number to be cleared, got %v", msg)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unknown field")
+		}
+	}()
+	ClearFields(msg, "nickname")
+}
+
+func TestEach(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	if got := Each([]int{1, 2, 3}, double); !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("Each = %v, want [2 4 6]", got)
+	}
+	if got := Each(nil, double); got != nil {
+		t.Errorf("Each(nil) = %v, want nil", got)
+	}
+
+	values := map[string]int{"a": 1, "b": 2}
+	if got := EachValue(values, double); !reflect.DeepEqual(got, map[string]int{"a": 2, "b": 4}) {
+		t.Errorf("EachValue = %v, want map[a:2 b:4]", got)
+	}
+	if values["a"] != 1 {
+		t.Errorf("EachValue modified its input: %v", values)
+	}
+	if got := EachValue(map[string]int(nil), double); got != nil {
+		t.Errorf("EachValue(nil) = %v, want nil", got)
+	}
+}
+
+func TestSharedMemory(t *testing.T) {
+	type inner struct {
+		Data  []byte
+		Parts map[string]int
+	}
+	type outer struct {
+		Name   string
+		Tags   []string
+		Inner  inner
+		Rows   []inner
+		ByName map[string]inner
+		Ptr    *inner
+		When   time.Time
+	}
+	original := &outer{
+		Name:   "a",
+		Tags:   []string{"x"},
+		Inner:  inner{Data: []byte{1}, Parts: map[string]int{"p": 1}},
+		Rows:   []inner{{Data: []byte{2}}},
+		ByName: map[string]inner{"k": {Data: []byte{3}}},
+		Ptr:    &inner{},
+		When:   time.Now(),
+	}
+
+	if got := SharedMemory(original, original); !reflect.DeepEqual(got, []string{"(root)"}) {
+		t.Errorf("SharedMemory(x, x) = %v, want [(root)]", got)
+	}
+
+	shallow := *original
+	want := []string{"Tags", "Inner.Data", "Inner.Parts", "Rows", "ByName", "Ptr"}
+	if got := SharedMemory(original, &shallow); !reflect.DeepEqual(got, want) {
+		t.Errorf("SharedMemory of a shallow copy = %v, want %v", got, want)
+	}
+
+	deep := &outer{
+		Name:   "a",
+		Tags:   []string{"x"},
+		Inner:  inner{Data: []byte{1}, Parts: map[string]int{"p": 1}},
+		Rows:   []inner{{Data: original.Rows[0].Data}},
+		ByName: map[string]inner{"k": {Data: []byte{3}}},
+		Ptr:    &inner{},
+		When:   original.When,
+	}
+	want = []string{"Rows[0].Data"}
+	if got := SharedMemory(original, deep); !reflect.DeepEqual(got, want) {
+		t.Errorf("SharedMemory of a partly deep copy = %v, want %v", got, want)
+	}
+
+	// Empty slices share no elements
+	if got := SharedMemory(&outer{Tags: []string{}}, &outer{Tags: []string{}}); len(got) != 0 {
+		t.Errorf("SharedMemory of empty slices = %v, want none", got)
+	}
+}
@@ -0,0 +1,529 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service generates gRPC server implementations for standard
+// resource services on top of the generated GORM DALs and converters.
+//
+// A service opts in with (dal.v1.service) naming the GORM message that stores
+// its resource. Methods are matched by name and shape (see AIP-131 to 135):
+//
+//   - Create<R>(req{R <field>}) returns R
+//   - Get<R>(req{<primary key fields>}) returns R
+//   - Update<R>(req{R <field>, google.protobuf.FieldMask update_mask}) returns R
+//   - Delete<R>(req{<primary key fields>}) returns R or google.protobuf.Empty
+//   - List<Rs>(req{int32 page_size, string page_token}) returns resp{repeated R <field>, string next_page_token}
+//
+// The generated server embeds the Unimplemented server of protoc-gen-go-grpc,
+// so methods that are not standard stay unimplemented and can be written by hand.
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/panyam/protoc-gen-dal/pkg/collector"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/common"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/converter"
+	"github.com/panyam/protoc-gen-dal/pkg/generator/types"
+	"github.com/panyam/protoc-gen-dal/pkg/gorm"
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// Options contains configuration for service generation
+type Options struct {
+	FilenameSuffix   string // e.g., "_dal_server" -> "note_service_dal_server.go"
+	EntityImportPath string // Import path of the GORM output root (auto-detected if empty)
+	DALOutputDir     string // Subdirectory of the GORM DAL files (e.g., "dal"), as passed to protoc-gen-dal-gorm
+}
+
+// Method kinds
+const (
+	KindCreate = "Create"
+	KindGet    = "Get"
+	KindUpdate = "Update"
+	KindDelete = "Delete"
+	KindList   = "List"
+)
+
+// MethodData holds the template data for one standard method
+type MethodData struct {
+	Name     string // RPC name (e.g., "GetNote")
+	Kind     string // One of the Kind constants
+	Request  string // Go request type (e.g., "GetNoteRequest")
+	Response string // Go response type (e.g., "api.Note")
+
+	ResourceField string   // Create/Update: Go name of the resource field (e.g., "Note")
+	ResourceProto string   // Create/Update: proto name of the resource field (e.g., "note")
+	KeyFields     []string // Get/Delete: Go names of the primary key fields, in DAL order
+	KeyArgs       []string // Get/Delete: DAL arguments for the key fields (typed IDs are converted)
+	UpdateMask    string   // Update: Go name of the FieldMask field ("" for full replacement)
+	ReturnsEmpty  bool     // Delete: returns google.protobuf.Empty instead of the resource
+
+	ItemsField    string // List: Go name of the repeated resource field
+	PageSize      string // List: Go name of the page size field ("" if absent)
+	PageToken     string // List: Go name of the page token field ("" if not paginated)
+	NextPageToken string // List: Go name of the next page token field
+	Filter        string // List: Go name of the AIP-160 filter field ("" if absent)
+}
+
+// ServiceData holds the template data for one service
+type ServiceData struct {
+	Name          string       // Service name (e.g., "NoteService")
+	ServerName    string       // Generated server type (e.g., "NoteServiceDALServer")
+	DALType       string       // DAL type (e.g., "dal.NoteGORMDAL")
+	StructType    string       // GORM struct type (e.g., "gorm.NoteGORM")
+	ResourceType  string       // API message type (e.g., "api.Note")
+	ResourceName  string       // API message name used in errors (e.g., "Note")
+	ToConverter   string       // e.g., "gorm.NoteToNoteGORM"
+	FromConverter string       // e.g., "gorm.NoteFromNoteGORM"
+	ListConverter string       // Batch converter for List results (e.g., "gorm.NoteSliceFromNoteGORMSlice")
+	OrderBy       string       // Primary key columns List orders by (e.g., "id")
+	NotFound      string       // Format of not-found errors for the key fields (e.g., "Note %v not found")
+	KeyFields     []string     // Go names of the GORM struct's primary key fields
+	Tenant        bool         // Whether the DAL is tenant-scoped
+	Methods       []MethodData // Standard methods, in service order
+}
+
+// HasKind reports whether the service has a method of the given kind
+func (s ServiceData) HasKind(kind string) bool {
+	for _, m := range s.Methods {
+		if m.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// HasUpdateMask reports whether any Update method takes a field mask
+func (s ServiceData) HasUpdateMask() bool {
+	for _, m := range s.Methods {
+		if m.UpdateMask != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPagination reports whether any List method is paginated
+func (s ServiceData) HasPagination() bool {
+	for _, m := range s.Methods {
+		if m.PageToken != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFilter reports whether any List method takes a filter
+func (s ServiceData) HasFilter() bool {
+	for _, m := range s.Methods {
+		if m.Filter != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// FileData is the root template data for a generated server file
+type FileData struct {
+	PackageName string
+	Imports     []common.ImportSpec
+	GormAlias   string // Alias of the gorm library (e.g., "gorm" or "gormlib")
+	Services    []ServiceData
+}
+
+// Generate generates a server file for each proto file with (dal.v1.service)
+// services.
+//
+// Files are written next to the protoc-gen-go-grpc output, in the proto's Go
+// package, so the servers can embed its Unimplemented servers.
+func Generate(plugin *protogen.Plugin, options *Options) ([]*types.GeneratedFile, error) {
+	messages, err := collector.CollectMessages(plugin, collector.TargetGorm)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[string]*collector.MessageInfo, len(messages))
+	for _, msg := range messages {
+		targets[string(msg.TargetMessage.Desc.FullName())] = msg
+	}
+
+	var files []*types.GeneratedFile
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		content, err := generateFile(file, targets, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate services for %s: %w", file.Desc.Path(), err)
+		}
+		if content == "" {
+			continue
+		}
+		files = append(files, &types.GeneratedFile{
+			Path:    file.GeneratedFilenamePrefix + options.FilenameSuffix + ".go",
+			Content: content,
+		})
+	}
+	return files, nil
+}
+
+// serviceOptions returns a service's (dal.v1.service) options, or nil
+func serviceOptions(svc *protogen.Service) *dalv1.ServiceOptions {
+	opts := svc.Desc.Options()
+	if opts == nil || !proto.HasExtension(opts, dalv1.E_Service) {
+		return nil
+	}
+	v, _ := proto.GetExtension(opts, dalv1.E_Service).(*dalv1.ServiceOptions)
+	return v
+}
+
+// generateFile renders the servers of a proto file's annotated services.
+// Returns "" if the file has none.
+func generateFile(file *protogen.File, targets map[string]*collector.MessageInfo, options *Options) (string, error) {
+	imports := &importer{self: file.GoImportPath, imports: common.ImportMap{}}
+	var services []ServiceData
+	for _, svc := range file.Services {
+		opts := serviceOptions(svc)
+		if opts == nil {
+			continue
+		}
+		data, err := buildServiceData(svc, opts, targets, imports, options)
+		if err != nil {
+			return "", err
+		}
+		services = append(services, data)
+	}
+	if len(services) == 0 {
+		return "", nil
+	}
+
+	imports.add("context", "")
+	imports.add("errors", "")
+	imports.add("google.golang.org/grpc/codes", "")
+	imports.add("google.golang.org/grpc/status", "")
+	imports.add("google.golang.org/genproto/googleapis/rpc/errdetails", "")
+	imports.add("github.com/panyam/protoc-gen-dal/pkg/converters", "")
+	for _, svc := range services {
+		if svc.Tenant {
+			imports.add("github.com/panyam/protoc-gen-dal/pkg/tenant", "")
+		}
+		if svc.HasUpdateMask() {
+			imports.add("fmt", "")
+			imports.add("google.golang.org/protobuf/proto", "")
+			imports.add("google.golang.org/protobuf/reflect/protoreflect", "")
+		}
+		if svc.HasPagination() {
+			imports.add("encoding/base64", "")
+			imports.add("fmt", "")
+			imports.add("strconv", "")
+		}
+		if svc.HasFilter() {
+			imports.add("github.com/panyam/protoc-gen-dal/pkg/filtering", "")
+		}
+	}
+
+	return renderTemplate("server.go.tmpl", FileData{
+		PackageName: string(file.GoPackageName),
+		Imports:     imports.imports.ToSlice(),
+		GormAlias:   imports.gormAlias,
+		Services:    services,
+	})
+}
+
+// buildServiceData matches a service's standard methods against its target
+func buildServiceData(svc *protogen.Service, opts *dalv1.ServiceOptions, targets map[string]*collector.MessageInfo, imports *importer, options *Options) (ServiceData, error) {
+	target := targets[opts.Target]
+	if target == nil {
+		return ServiceData{}, fmt.Errorf("service %s: target %q is not a GORM message (import its proto file or generate it in the same run)", svc.Desc.FullName(), opts.Target)
+	}
+	if !target.GenerateDAL {
+		return ServiceData{}, fmt.Errorf("service %s: target %s has no DAL (set a table or dal: true)", svc.Desc.FullName(), opts.Target)
+	}
+	dal, err := gorm.BuildDALData(target)
+	if err != nil {
+		return ServiceData{}, fmt.Errorf("service %s: %w", svc.Desc.FullName(), err)
+	}
+
+	entityPath, dalPath := gormPackages(target, options)
+	entityAlias := imports.add(entityPath, common.GetPackageAlias(entityPath))
+	dalAlias := entityAlias
+	if dalPath != entityPath {
+		dalAlias = imports.add(dalPath, dalPackageName(options))
+	}
+	imports.addGorm(entityAlias)
+
+	resource := target.SourceMessage
+	resourceName := resource.GoIdent.GoName
+	_, listConverter := converter.BuildBatchConverterNames(resourceName, dal.StructName)
+	data := ServiceData{
+		Name:          svc.GoName,
+		ServerName:    svc.GoName + "DALServer",
+		DALType:       dalAlias + "." + dal.DALTypeName,
+		StructType:    entityAlias + "." + dal.StructName,
+		ResourceType:  imports.goType(resource),
+		ResourceName:  resourceName,
+		ToConverter:   entityAlias + "." + resourceName + "To" + dal.StructName,
+		FromConverter: entityAlias + "." + resourceName + "From" + dal.StructName,
+		ListConverter: entityAlias + "." + listConverter,
+		Tenant:        dal.Tenant != nil,
+	}
+	var columns, verbs []string
+	for _, pk := range dal.PrimaryKeys {
+		columns = append(columns, pk.ColumnName)
+		verbs = append(verbs, "%v")
+		data.KeyFields = append(data.KeyFields, pk.Name)
+	}
+	data.OrderBy = strings.Join(columns, ", ")
+	data.NotFound = resourceName + " " + strings.Join(verbs, "/") + " not found"
+
+	for _, method := range svc.Methods {
+		m, err := matchMethod(method, resource, target.TargetMessage, dal, entityAlias, imports)
+		if err != nil {
+			return ServiceData{}, fmt.Errorf("%s.%s: %w", svc.Desc.Name(), method.Desc.Name(), err)
+		}
+		if m != nil {
+			data.Methods = append(data.Methods, *m)
+		}
+	}
+	if len(data.Methods) == 0 {
+		return ServiceData{}, fmt.Errorf("service %s has no standard methods for %s", svc.Desc.FullName(), resource.Desc.FullName())
+	}
+	return data, nil
+}
+
+// matchMethod returns the standard method a method implements, or nil if it
+// is not one. Methods named like a standard method must have its shape.
+func matchMethod(method *protogen.Method, resource, target *protogen.Message, dal gorm.DALData, entityAlias string, imports *importer) (*MethodData, error) {
+	name := method.GoName
+	resourceName := resource.GoIdent.GoName
+	kind := ""
+	switch {
+	case name == KindCreate+resourceName:
+		kind = KindCreate
+	case name == KindGet+resourceName:
+		kind = KindGet
+	case name == KindUpdate+resourceName:
+		kind = KindUpdate
+	case name == KindDelete+resourceName:
+		kind = KindDelete
+	case strings.HasPrefix(name, KindList+resourceName) && findField(method.Output, resource, true) != nil:
+		kind = KindList
+	default:
+		return nil, nil
+	}
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		return nil, fmt.Errorf("%s methods must be unary", kind)
+	}
+
+	m := &MethodData{
+		Name:     name,
+		Kind:     kind,
+		Request:  imports.goType(method.Input),
+		Response: imports.goType(method.Output),
+	}
+	returnsResource := method.Output.Desc.FullName() == resource.Desc.FullName()
+
+	switch kind {
+	case KindCreate, KindUpdate:
+		field := findField(method.Input, resource, false)
+		if field == nil {
+			return nil, fmt.Errorf("request %s has no %s field", method.Input.Desc.Name(), resource.Desc.FullName())
+		}
+		m.ResourceField = field.GoName
+		m.ResourceProto = string(field.Desc.Name())
+		if kind == KindUpdate {
+			if mask := fieldByName(method.Input, "update_mask"); mask != nil {
+				if mask.Message == nil || mask.Message.Desc.FullName() != "google.protobuf.FieldMask" {
+					return nil, fmt.Errorf("update_mask must be a google.protobuf.FieldMask")
+				}
+				m.UpdateMask = mask.GoName
+			}
+		}
+		if !returnsResource {
+			return nil, fmt.Errorf("must return %s", resource.Desc.FullName())
+		}
+
+	case KindGet, KindDelete:
+		for _, pk := range dal.PrimaryKeys {
+			field := fieldByName(method.Input, pk.ProtoName)
+			targetField := fieldByName(target, pk.ProtoName)
+			if field == nil {
+				return nil, fmt.Errorf("request %s has no %q field for the primary key", method.Input.Desc.Name(), pk.ProtoName)
+			}
+			if targetField != nil && (field.Desc.Kind() != targetField.Desc.Kind() || field.Desc.IsList()) {
+				return nil, fmt.Errorf("request field %q must have the primary key's type %s", pk.ProtoName, targetField.Desc.Kind())
+			}
+			m.KeyFields = append(m.KeyFields, field.GoName)
+			arg := "req." + field.GoName
+			if pk.IDType != "" {
+				arg = entityAlias + "." + pk.IDType + "(" + arg + ")"
+			}
+			m.KeyArgs = append(m.KeyArgs, arg)
+		}
+		switch {
+		case returnsResource:
+		case kind == KindDelete && method.Output.Desc.FullName() == "google.protobuf.Empty":
+			m.ReturnsEmpty = true
+		case kind == KindDelete:
+			return nil, fmt.Errorf("must return %s or google.protobuf.Empty", resource.Desc.FullName())
+		default:
+			return nil, fmt.Errorf("must return %s", resource.Desc.FullName())
+		}
+
+	case KindList:
+		m.ItemsField = findField(method.Output, resource, true).GoName
+		if field := fieldByName(method.Input, "page_size"); field != nil {
+			if field.Desc.Kind() != protoreflect.Int32Kind {
+				return nil, fmt.Errorf("page_size must be an int32")
+			}
+			m.PageSize = field.GoName
+		}
+		token := fieldByName(method.Input, "page_token")
+		next := fieldByName(method.Output, "next_page_token")
+		if (token == nil) != (next == nil) {
+			return nil, fmt.Errorf("paginated List methods need both page_token and next_page_token")
+		}
+		if token != nil {
+			if token.Desc.Kind() != protoreflect.StringKind || next.Desc.Kind() != protoreflect.StringKind {
+				return nil, fmt.Errorf("page_token and next_page_token must be strings")
+			}
+			m.PageToken = token.GoName
+			m.NextPageToken = next.GoName
+		}
+		if filter := fieldByName(method.Input, "filter"); filter != nil {
+			if filter.Desc.Kind() != protoreflect.StringKind || filter.Desc.IsList() {
+				return nil, fmt.Errorf("filter must be a string")
+			}
+			if len(dal.FilterFields) == 0 {
+				return nil, fmt.Errorf("filter needs fields of %s stored as they are, but %s has none", resource.Desc.FullName(), target.Desc.Name())
+			}
+			m.Filter = filter.GoName
+		}
+	}
+
+	return m, nil
+}
+
+// findField returns the first field of msg holding the resource message,
+// singular or repeated
+func findField(msg, resource *protogen.Message, repeated bool) *protogen.Field {
+	for _, field := range msg.Fields {
+		if field.Message == nil || field.Desc.IsMap() || field.Desc.IsList() != repeated {
+			continue
+		}
+		if field.Message.Desc.FullName() == resource.Desc.FullName() {
+			return field
+		}
+	}
+	return nil
+}
+
+// fieldByName returns the field with the given proto name, or nil
+func fieldByName(msg *protogen.Message, name string) *protogen.Field {
+	for _, field := range msg.Fields {
+		if string(field.Desc.Name()) == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// gormPackages returns the import paths of a target's GORM entities
+// (structs and converters) and DAL, laid out as protoc-gen-dal-gorm writes them
+func gormPackages(target *collector.MessageInfo, options *Options) (entityPath, dalPath string) {
+	protoFile := target.TargetMessage.Desc.ParentFile().Path()
+	protoDir := ""
+	if idx := strings.LastIndex(protoFile, "/"); idx != -1 {
+		protoDir = protoFile[:idx]
+	}
+
+	root := options.EntityImportPath
+	if root != "" {
+		entityPath = root
+		if protoDir != "" {
+			entityPath += "/" + protoDir
+		}
+	} else {
+		entityPath = common.ExtractPackageInfo(target.TargetMessage).ImportPath
+		root = strings.TrimSuffix(entityPath, "/"+protoDir)
+	}
+
+	if options.DALOutputDir == "" {
+		return entityPath, entityPath
+	}
+	dalPath = root + "/" + strings.Trim(options.DALOutputDir, "/")
+	if protoDir != "" {
+		dalPath += "/" + protoDir
+	}
+	return entityPath, dalPath
+}
+
+// dalPackageName returns the package name protoc-gen-dal-gorm gives DAL files
+// written to DALOutputDir
+func dalPackageName(options *Options) string {
+	return strings.TrimSuffix(options.DALOutputDir, "/")
+}
+
+// importer collects the imports of a generated file and qualifies Go types
+type importer struct {
+	self      protogen.GoImportPath // Package the file is generated into
+	imports   common.ImportMap
+	gormAlias string
+}
+
+// add imports path as alias ("" for no alias) and returns the name to
+// qualify its identifiers with
+func (im *importer) add(path, alias string) string {
+	if existing, ok := im.imports[path]; ok {
+		if existing.Alias != "" {
+			return existing.Alias
+		}
+		return common.GetPackageAlias(path)
+	}
+	im.imports.Add(common.ImportSpec{Alias: alias, Path: path})
+	if alias != "" {
+		return alias
+	}
+	return common.GetPackageAlias(path)
+}
+
+// addGorm imports the gorm library, aliased as "gormlib" if the entity
+// package is also named gorm
+func (im *importer) addGorm(entityAlias string) {
+	if im.gormAlias != "" {
+		return
+	}
+	im.gormAlias = "gorm"
+	if entityAlias == "gorm" {
+		im.gormAlias = "gormlib"
+	}
+	alias := ""
+	if im.gormAlias != "gorm" {
+		alias = im.gormAlias
+	}
+	im.imports.Add(common.ImportSpec{Alias: alias, Path: "gorm.io/gorm"})
+}
+
+// goType returns the Go type of a message as referenced from the file
+func (im *importer) goType(msg *protogen.Message) string {
+	info := common.ExtractPackageInfo(msg)
+	if protogen.GoImportPath(info.ImportPath) == im.self {
+		return msg.GoIdent.GoName
+	}
+	return im.add(info.ImportPath, info.Alias) + "." + msg.GoIdent.GoName
+}
@@ -0,0 +1,293 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/pkg/generator/testutil"
+	dalv1 "github.com/panyam/protoc-gen-dal/protos/gen/dal/v1"
+)
+
+// bookProtos returns a Book resource, its BookGorm DAL message and a
+// library.v1.BookService with the given methods and request messages.
+func bookProtos(methods []testutil.TestMethod, requests ...testutil.TestMessage) *testutil.TestProtoSet {
+	return &testutil.TestProtoSet{
+		Files: []testutil.TestFile{
+			{
+				Name: "library/v1/book.proto",
+				Pkg:  "library.v1",
+				Messages: []testutil.TestMessage{
+					{
+						Name: "Book",
+						Fields: []testutil.TestField{
+							{Name: "id", Number: 1, TypeName: "string"},
+							{Name: "title", Number: 2, TypeName: "string"},
+						},
+					},
+				},
+			},
+			{
+				Name:    "library/v1/dal/book_gorm.proto",
+				Pkg:     "library.v1.dal",
+				Imports: []string{"library/v1/book.proto"},
+				Messages: []testutil.TestMessage{
+					{
+						Name:     "BookGorm",
+						GormOpts: &dalv1.GormOptions{Source: "library.v1.Book", Table: "books"},
+						Fields: []testutil.TestField{
+							{
+								Name: "id", Number: 1, TypeName: "string",
+								ColumnOpts: &dalv1.ColumnOptions{GormTags: []string{"primaryKey"}},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:     "library/v1/services/book_service.proto",
+				Pkg:      "library.v1.services",
+				Imports:  []string{"library/v1/book.proto", "library/v1/dal/book_gorm.proto"},
+				Messages: requests,
+				Services: []testutil.TestService{
+					{
+						Name:        "BookService",
+						ServiceOpts: &dalv1.ServiceOptions{Target: "library.v1.dal.BookGorm"},
+						Methods:     methods,
+					},
+				},
+			},
+		},
+	}
+}
+
+// standardBookMethods returns the standard methods of BookService and their messages
+func standardBookMethods() ([]testutil.TestMethod, []testutil.TestMessage) {
+	const pkg = "library.v1.services."
+	methods := []testutil.TestMethod{
+		{Name: "CreateBook", Input: pkg + "CreateBookRequest", Output: "library.v1.Book"},
+		{Name: "GetBook", Input: pkg + "GetBookRequest", Output: "library.v1.Book"},
+		{Name: "UpdateBook", Input: pkg + "UpdateBookRequest", Output: "library.v1.Book"},
+		{Name: "DeleteBook", Input: pkg + "GetBookRequest", Output: "library.v1.Book"},
+		{Name: "ListBooks", Input: pkg + "ListBooksRequest", Output: pkg + "ListBooksResponse"},
+		{Name: "ShelveBook", Input: pkg + "GetBookRequest", Output: "library.v1.Book"},
+	}
+	messages := []testutil.TestMessage{
+		{Name: "CreateBookRequest", Fields: []testutil.TestField{{Name: "book", Number: 1, TypeName: "library.v1.Book"}}},
+		{Name: "GetBookRequest", Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}}},
+		{Name: "UpdateBookRequest", Fields: []testutil.TestField{{Name: "book", Number: 1, TypeName: "library.v1.Book"}}},
+		{Name: "ListBooksRequest", Fields: []testutil.TestField{
+			{Name: "page_size", Number: 1, TypeName: "int32"},
+			{Name: "page_token", Number: 2, TypeName: "string"},
+		}},
+		{Name: "ListBooksResponse", Fields: []testutil.TestField{
+			{Name: "books", Number: 1, TypeName: "library.v1.Book", Repeated: true},
+			{Name: "next_page_token", Number: 2, TypeName: "string"},
+		}},
+	}
+	return methods, messages
+}
+
+// TestGenerate_StandardMethods tests that standard methods are implemented on
+// the target's DAL and other methods are left unimplemented
+func TestGenerate_StandardMethods(t *testing.T) {
+	methods, messages := standardBookMethods()
+	plugin := testutil.CreateTestPlugin(t, bookProtos(methods, messages...))
+
+	files, err := Generate(plugin, &Options{FilenameSuffix: "_dal_server", DALOutputDir: "dal"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 file, got %d", len(files))
+	}
+	if want := "github.com/test/gen/go/library/v1/services/book_service_dal_server.go"; files[0].Path != want {
+		t.Errorf("Expected path %q, got %q", want, files[0].Path)
+	}
+
+	content := files[0].Content
+	for _, want := range []string{
+		"package services",
+		`v1 "github.com/test/gen/go/library/v1"`,
+		`dal "github.com/test/gen/go/dal/library/v1/dal"`,
+		`"github.com/test/gen/go/library/v1/dal"`,
+		"type BookServiceDALServer struct {\n\tUnimplementedBookServiceServer",
+		"DAL dal.BookGORMDAL",
+		"func (s *BookServiceDALServer) CreateBook(ctx context.Context, req *CreateBookRequest) (*v1.Book, error) {",
+		"if err := s.DAL.Create(ctx, s.DB, obj); err != nil {",
+		"obj, err := s.DAL.Get(ctx, s.DB, req.Id)",
+		`return nil, status.Errorf(codes.NotFound, "Book %v not found", req.Id)`,
+		"existing, err := s.DAL.Get(ctx, s.DB, obj.Id)",
+		"if err := s.DAL.Save(ctx, s.DB, obj); err != nil {",
+		"if err := s.DAL.Delete(ctx, s.DB, req.Id); err != nil {",
+		`query = query.Order("id")`,
+		"resp.NextPageToken = s.encodePageToken(offset + size)",
+		"if resp.Books, err = dal.BookSliceFromBookGORMSlice(records, nil); err != nil {",
+		"obj, err := dal.BookToBookGORM(msg, nil, nil)",
+		"var convErr *converters.ConversionError",
+		"&errdetails.BadRequest_FieldViolation{Field: convErr.Path, Description: convErr.Err.Error()}",
+		"msg, err := dal.BookFromBookGORM(nil, obj, nil)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated server.\nGenerated content:\n%s", want, content)
+		}
+	}
+	for _, unwanted := range []string{"ShelveBook", "applyUpdateMask", "emptypb"} {
+		if strings.Contains(content, unwanted) {
+			t.Errorf("Did not expect %q in generated server", unwanted)
+		}
+	}
+}
+
+// TestGenerate_Filter tests that List methods with a filter field apply it
+// through the DAL's filter scope
+func TestGenerate_Filter(t *testing.T) {
+	methods, messages := standardBookMethods()
+	messages[3].Fields = append(messages[3].Fields, testutil.TestField{Name: "filter", Number: 3, TypeName: "string"})
+	plugin := testutil.CreateTestPlugin(t, bookProtos(methods, messages...))
+
+	files, err := Generate(plugin, &Options{FilenameSuffix: "_dal_server", DALOutputDir: "dal"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := files[0].Content
+	for _, want := range []string{
+		`"github.com/panyam/protoc-gen-dal/pkg/filtering"`,
+		"filter, err := s.DAL.FilterScope(req.Filter)",
+		"query = query.Scopes(filter)",
+		"case errors.Is(err, filtering.ErrInvalidFilter):\n\t\treturn status.Error(codes.InvalidArgument, err.Error())",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated server.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerate_IDType tests that request keys are converted to the target's
+// id_type for the DAL
+func TestGenerate_IDType(t *testing.T) {
+	methods, messages := standardBookMethods()
+	protos := bookProtos(methods, messages...)
+	protos.Files[1].Messages[0].Fields[0].ColumnOpts.IdType = "BookID"
+	plugin := testutil.CreateTestPlugin(t, protos)
+
+	files, err := Generate(plugin, &Options{FilenameSuffix: "_dal_server", DALOutputDir: "dal"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := files[0].Content
+	for _, want := range []string{
+		"obj, err := s.DAL.Get(ctx, s.DB, dal.BookID(req.Id))",
+		`return nil, status.Errorf(codes.NotFound, "Book %v not found", req.Id)`,
+		"existing, err := s.DAL.Get(ctx, s.DB, obj.Id)",
+		"if err := s.DAL.Delete(ctx, s.DB, dal.BookID(req.Id)); err != nil {",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected %q in generated server.\nGenerated content:\n%s", want, content)
+		}
+	}
+}
+
+// TestGenerate_SkipsUnannotatedServices tests that only (dal.v1.service)
+// services get a server
+func TestGenerate_SkipsUnannotatedServices(t *testing.T) {
+	methods, messages := standardBookMethods()
+	protos := bookProtos(methods, messages...)
+	protos.Files[2].Services[0].ServiceOpts = nil
+	plugin := testutil.CreateTestPlugin(t, protos)
+
+	files, err := Generate(plugin, &Options{FilenameSuffix: "_dal_server"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Expected no files, got %d", len(files))
+	}
+}
+
+// TestGenerate_Invalid tests that standard methods with the wrong shape and
+// bad targets are reported
+func TestGenerate_Invalid(t *testing.T) {
+	const pkg = "library.v1.services."
+	key := testutil.TestMessage{Name: "GetBookRequest", Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "string"}}}
+	noKey := testutil.TestMessage{Name: "GetBookRequest", Fields: []testutil.TestField{{Name: "name", Number: 1, TypeName: "string"}}}
+	wrongKey := testutil.TestMessage{Name: "GetBookRequest", Fields: []testutil.TestField{{Name: "id", Number: 1, TypeName: "int64"}}}
+	noResource := testutil.TestMessage{Name: "CreateBookRequest", Fields: []testutil.TestField{{Name: "title", Number: 1, TypeName: "string"}}}
+	getBook := []testutil.TestMethod{{Name: "GetBook", Input: pkg + "GetBookRequest", Output: "library.v1.Book"}}
+
+	tests := []struct {
+		name   string
+		protos *testutil.TestProtoSet
+		want   string
+	}{
+		{
+			name:   "missing primary key field",
+			protos: bookProtos(getBook, noKey),
+			want:   `BookService.GetBook: request GetBookRequest has no "id" field for the primary key`,
+		},
+		{
+			name:   "primary key type mismatch",
+			protos: bookProtos(getBook, wrongKey),
+			want:   `request field "id" must have the primary key's type string`,
+		},
+		{
+			name: "missing resource field",
+			protos: bookProtos([]testutil.TestMethod{
+				{Name: "CreateBook", Input: pkg + "CreateBookRequest", Output: "library.v1.Book"},
+			}, noResource),
+			want: "request CreateBookRequest has no library.v1.Book field",
+		},
+		{
+			name: "wrong response",
+			protos: bookProtos([]testutil.TestMethod{
+				{Name: "GetBook", Input: pkg + "GetBookRequest", Output: pkg + "GetBookRequest"},
+			}, key),
+			want: "must return library.v1.Book",
+		},
+		{
+			name: "unknown target",
+			protos: func() *testutil.TestProtoSet {
+				protos := bookProtos(getBook, noKey)
+				protos.Files[2].Services[0].ServiceOpts.Target = "library.v1.dal.Missing"
+				return protos
+			}(),
+			want: `target "library.v1.dal.Missing" is not a GORM message`,
+		},
+		{
+			name: "filter type",
+			protos: bookProtos([]testutil.TestMethod{
+				{Name: "ListBooks", Input: pkg + "ListBooksRequest", Output: pkg + "ListBooksResponse"},
+			}, testutil.TestMessage{Name: "ListBooksRequest", Fields: []testutil.TestField{
+				{Name: "filter", Number: 1, TypeName: "int32"},
+			}}, testutil.TestMessage{Name: "ListBooksResponse", Fields: []testutil.TestField{
+				{Name: "books", Number: 1, TypeName: "library.v1.Book", Repeated: true},
+			}}),
+			want: "BookService.ListBooks: filter must be a string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := testutil.CreateTestPlugin(t, tt.protos)
+			_, err := Generate(plugin, &Options{FilenameSuffix: "_dal_server"})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
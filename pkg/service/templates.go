@@ -0,0 +1,66 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"embed"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var tmpl *template.Template
+
+// loadTemplates loads and parses all templates.
+// This is called once during initialization.
+func loadTemplates() (*template.Template, error) {
+	if tmpl != nil {
+		return tmpl, nil
+	}
+
+	t, err := template.New("").Funcs(template.FuncMap{
+		// args joins Go expressions into an argument list
+		"args": func(prefix string, names []string) string {
+			refs := make([]string, len(names))
+			for i, name := range names {
+				refs[i] = prefix + name
+			}
+			return strings.Join(refs, ", ")
+		},
+	}).ParseFS(templatesFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	tmpl = t
+	return tmpl, nil
+}
+
+// renderTemplate renders a named template with the given data
+func renderTemplate(name string, data any) (string, error) {
+	t, err := loadTemplates()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
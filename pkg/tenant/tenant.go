@@ -0,0 +1,71 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant provides the runtime helpers used by tenant-scoped DALs
+// generated by protoc-gen-dal (GORM tenant_column, Datastore tenant_namespace).
+//
+// Generated DALs read the tenant of each operation from its context through
+// an Extractor. By default that is FromContext, which returns the tenant
+// stored with WithTenant; applications that already carry the tenant in
+// their own context values (e.g., an auth claim) can plug in their own
+// extractor per DAL or globally via DefaultExtractor.
+package tenant
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMissingTenant is returned by tenant-scoped DAL operations when the
+// context carries no tenant.
+var ErrMissingTenant = errors.New("tenant: no tenant in context")
+
+// ErrCrossTenant is returned when a write would modify a record that belongs
+// to another tenant.
+var ErrCrossTenant = errors.New("tenant: record belongs to another tenant")
+
+// Extractor returns the tenant of a context, and false if there is none.
+type Extractor func(ctx context.Context) (string, bool)
+
+// DefaultExtractor is used by DALs that do not set their own extractor.
+var DefaultExtractor Extractor = FromContext
+
+// contextKey is the context key for the tenant stored by WithTenant.
+type contextKey struct{}
+
+// WithTenant returns a copy of ctx carrying tenantID.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantID)
+}
+
+// FromContext returns the tenant stored in ctx by WithTenant.
+// An empty tenant counts as no tenant.
+func FromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(contextKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+// Get returns the tenant of ctx using extract, or DefaultExtractor if
+// extract is nil.
+// Returns ErrMissingTenant if the context has no tenant.
+func Get(ctx context.Context, extract Extractor) (string, error) {
+	if extract == nil {
+		extract = DefaultExtractor
+	}
+	tenantID, ok := extract(ctx)
+	if !ok || tenantID == "" {
+		return "", ErrMissingTenant
+	}
+	return tenantID, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGet_FromContext(t *testing.T) {
+	ctx := WithTenant(context.Background(), "acme")
+
+	got, err := Get(ctx, nil)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got != "acme" {
+		t.Errorf("Expected tenant acme, got %q", got)
+	}
+}
+
+func TestGet_Missing(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"no tenant":    context.Background(),
+		"empty tenant": WithTenant(context.Background(), ""),
+	} {
+		if _, err := Get(ctx, nil); !errors.Is(err, ErrMissingTenant) {
+			t.Errorf("%s: expected ErrMissingTenant, got %v", name, err)
+		}
+	}
+}
+
+func TestGet_CustomExtractor(t *testing.T) {
+	type claimsKey struct{}
+	extract := func(ctx context.Context) (string, bool) {
+		org, ok := ctx.Value(claimsKey{}).(string)
+		return org, ok
+	}
+	ctx := context.WithValue(context.Background(), claimsKey{}, "globex")
+
+	got, err := Get(ctx, extract)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got != "globex" {
+		t.Errorf("Expected tenant globex, got %q", got)
+	}
+
+	// The default extractor does not see the custom value
+	if _, err := Get(ctx, nil); !errors.Is(err, ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant from the default extractor, got %v", err)
+	}
+}
@@ -36,6 +36,181 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How a message field is stored in its column
+type MessageStorage int32
+
+const (
+	// Converted to the message's sidecar struct (default)
+	MessageStorage_MESSAGE_STORAGE_UNSPECIFIED MessageStorage = 0
+	// proto.Marshal bytes; keeps unknown fields
+	MessageStorage_PROTO_BINARY MessageStorage = 1
+	// protojson text; readable and usable with JSON column types
+	MessageStorage_PROTOJSON MessageStorage = 2
+)
+
+// Enum value maps for MessageStorage.
+var (
+	MessageStorage_name = map[int32]string{
+		0: "MESSAGE_STORAGE_UNSPECIFIED",
+		1: "PROTO_BINARY",
+		2: "PROTOJSON",
+	}
+	MessageStorage_value = map[string]int32{
+		"MESSAGE_STORAGE_UNSPECIFIED": 0,
+		"PROTO_BINARY":                1,
+		"PROTOJSON":                   2,
+	}
+)
+
+func (x MessageStorage) Enum() *MessageStorage {
+	p := new(MessageStorage)
+	*p = x
+	return p
+}
+
+func (x MessageStorage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStorage) Descriptor() protoreflect.EnumDescriptor {
+	return file_dal_v1_annotations_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageStorage) Type() protoreflect.EnumType {
+	return &file_dal_v1_annotations_proto_enumTypes[0]
+}
+
+func (x MessageStorage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStorage.Descriptor instead.
+func (MessageStorage) EnumDescriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+// How empty key fields are filled on create
+type IDStrategy int32
+
+const (
+	// Keys are set by the caller (default)
+	IDStrategy_ID_STRATEGY_UNSPECIFIED IDStrategy = 0
+	// Random UUID (string fields)
+	IDStrategy_UUIDV4 IDStrategy = 1
+	// Time-ordered UUID (string fields)
+	IDStrategy_UUIDV7 IDStrategy = 2
+	// Time-ordered ULID (string fields)
+	IDStrategy_ULID IDStrategy = 3
+	// 64-bit time-ordered Snowflake ID (int64, uint64 or string fields)
+	IDStrategy_SNOWFLAKE IDStrategy = 4
+	// Assigned by the database on insert (GORM, integer fields)
+	IDStrategy_AUTO_INCREMENT IDStrategy = 5
+	// Numeric key ID allocated by Datastore on Put (Datastore, int64 fields)
+	IDStrategy_DATASTORE_ALLOCATED IDStrategy = 6
+)
+
+// Enum value maps for IDStrategy.
+var (
+	IDStrategy_name = map[int32]string{
+		0: "ID_STRATEGY_UNSPECIFIED",
+		1: "UUIDV4",
+		2: "UUIDV7",
+		3: "ULID",
+		4: "SNOWFLAKE",
+		5: "AUTO_INCREMENT",
+		6: "DATASTORE_ALLOCATED",
+	}
+	IDStrategy_value = map[string]int32{
+		"ID_STRATEGY_UNSPECIFIED": 0,
+		"UUIDV4":                  1,
+		"UUIDV7":                  2,
+		"ULID":                    3,
+		"SNOWFLAKE":               4,
+		"AUTO_INCREMENT":          5,
+		"DATASTORE_ALLOCATED":     6,
+	}
+)
+
+func (x IDStrategy) Enum() *IDStrategy {
+	p := new(IDStrategy)
+	*p = x
+	return p
+}
+
+func (x IDStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IDStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_dal_v1_annotations_proto_enumTypes[1].Descriptor()
+}
+
+func (IDStrategy) Type() protoreflect.EnumType {
+	return &file_dal_v1_annotations_proto_enumTypes[1]
+}
+
+func (x IDStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IDStrategy.Descriptor instead.
+func (IDStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+// Signature styles of custom converter functions
+type ConverterSignature int32
+
+const (
+	// func(T) U (default)
+	ConverterSignature_CONVERTER_SIGNATURE_UNSPECIFIED ConverterSignature = 0
+	// func(T) (U, error)
+	ConverterSignature_RETURNS_ERROR ConverterSignature = 1
+	// func(context.Context, T) (U, error)
+	ConverterSignature_CONTEXT_RETURNS_ERROR ConverterSignature = 2
+)
+
+// Enum value maps for ConverterSignature.
+var (
+	ConverterSignature_name = map[int32]string{
+		0: "CONVERTER_SIGNATURE_UNSPECIFIED",
+		1: "RETURNS_ERROR",
+		2: "CONTEXT_RETURNS_ERROR",
+	}
+	ConverterSignature_value = map[string]int32{
+		"CONVERTER_SIGNATURE_UNSPECIFIED": 0,
+		"RETURNS_ERROR":                   1,
+		"CONTEXT_RETURNS_ERROR":           2,
+	}
+)
+
+func (x ConverterSignature) Enum() *ConverterSignature {
+	p := new(ConverterSignature)
+	*p = x
+	return p
+}
+
+func (x ConverterSignature) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConverterSignature) Descriptor() protoreflect.EnumDescriptor {
+	return file_dal_v1_annotations_proto_enumTypes[2].Descriptor()
+}
+
+func (ConverterSignature) Type() protoreflect.EnumType {
+	return &file_dal_v1_annotations_proto_enumTypes[2]
+}
+
+func (x ConverterSignature) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConverterSignature.Descriptor instead.
+func (ConverterSignature) EnumDescriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{2}
+}
+
 // Referential actions for foreign keys
 type ReferentialAction int32
 
@@ -76,11 +251,11 @@ func (x ReferentialAction) String() string {
 }
 
 func (ReferentialAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_dal_v1_annotations_proto_enumTypes[0].Descriptor()
+	return file_dal_v1_annotations_proto_enumTypes[3].Descriptor()
 }
 
 func (ReferentialAction) Type() protoreflect.EnumType {
-	return &file_dal_v1_annotations_proto_enumTypes[0]
+	return &file_dal_v1_annotations_proto_enumTypes[3]
 }
 
 func (x ReferentialAction) Number() protoreflect.EnumNumber {
@@ -89,7 +264,57 @@ func (x ReferentialAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReferentialAction.Descriptor instead.
 func (ReferentialAction) EnumDescriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{0}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+// Targets supported by auto_sidecar
+type SidecarTarget int32
+
+const (
+	SidecarTarget_SIDECAR_TARGET_UNSPECIFIED SidecarTarget = 0
+	SidecarTarget_GORM                       SidecarTarget = 1
+	SidecarTarget_DATASTORE                  SidecarTarget = 2
+)
+
+// Enum value maps for SidecarTarget.
+var (
+	SidecarTarget_name = map[int32]string{
+		0: "SIDECAR_TARGET_UNSPECIFIED",
+		1: "GORM",
+		2: "DATASTORE",
+	}
+	SidecarTarget_value = map[string]int32{
+		"SIDECAR_TARGET_UNSPECIFIED": 0,
+		"GORM":                       1,
+		"DATASTORE":                  2,
+	}
+)
+
+func (x SidecarTarget) Enum() *SidecarTarget {
+	p := new(SidecarTarget)
+	*p = x
+	return p
+}
+
+func (x SidecarTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SidecarTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_dal_v1_annotations_proto_enumTypes[4].Descriptor()
+}
+
+func (SidecarTarget) Type() protoreflect.EnumType {
+	return &file_dal_v1_annotations_proto_enumTypes[4]
+}
+
+func (x SidecarTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SidecarTarget.Descriptor instead.
+func (SidecarTarget) EnumDescriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{4}
 }
 
 // Configuration for table mapping
@@ -210,6 +435,51 @@ type ColumnOptions struct {
 	// Example: ["noindex", "omitempty"]
 	// Generates: `datastore:"field_name,noindex,omitempty"`
 	DatastoreTags []string `protobuf:"bytes,14,rep,name=datastore_tags,json=datastoreTags,proto3" json:"datastore_tags,omitempty"`
+	// Store a nested message field as individual columns on the parent table
+	// instead of a single nested value. The field must be a singular message
+	// whose type has a sidecar for the target (declared or via auto_sidecar).
+	// GORM: generates `gorm:"embedded;embeddedPrefix:<prefix>"`
+	// Datastore: generates `datastore:"<name>,flatten"` (properties are
+	// named "<name>.<sub_field>"; the prefix does not apply)
+	// Example: flatten: { prefix: "addr_" }
+	Flatten *FlattenOptions `protobuf:"bytes,15,opt,name=flatten,proto3" json:"flatten,omitempty"`
+	// Store a message field as a serialized value instead of its converted
+	// sidecar struct. The field keeps the source message type and needs no
+	// sidecar; the generated converters do the marshalling.
+	// PROTO_BINARY: []byte column holding proto.Marshal output
+	// PROTOJSON: string column holding protojson output
+	// Datastore properties are always noindex.
+	// Example: api.Settings settings = 5 [(dal.v1.column) = { storage: PROTO_BINARY }];
+	Storage MessageStorage `protobuf:"varint,16,opt,name=storage,proto3,enum=dal.v1.MessageStorage" json:"storage,omitempty"`
+	// Store a repeated message field in a generated child table instead of a
+	// single column (GORM only). Each element becomes a row keyed by the
+	// parent's primary key and its position in the list. The generated DAL
+	// syncs the rows on write and preloads them, in order, on read. Requires
+	// a single-column primary key on the parent.
+	// Example: child_table: { table: "book_authors" }
+	ChildTable *ChildTableOptions `protobuf:"bytes,17,opt,name=child_table,json=childTable,proto3" json:"child_table,omitempty"`
+	// Name of a Go type generated for this column's values (e.g., "GameID"
+	// generates `type GameID string`). The generated struct field, DAL key
+	// parameters and composite key structs use it, and the converters cast to
+	// and from the API's plain field, so IDs of different entities cannot be
+	// mixed up. Fields with the same id_type share the type (e.g., a foreign
+	// key). Only singular, non-optional string and integer fields can have
+	// one; the source field must have the same proto type.
+	// Example: string id = 1 [(dal.v1.column) = { id_type: "GameID" }];
+	IdType string `protobuf:"bytes,18,opt,name=id_type,json=idType,proto3" json:"id_type,omitempty"`
+	// How the DAL fills this key when it is empty on create (Create/Save in
+	// GORM, Put in Datastore). Generated IDs come from the DAL's IDGenerator
+	// (pkg/idgen); the assigned ID is left in the entity, so converting it back
+	// gives the API message its ID. GORM: primary key fields only.
+	// Datastore: the id field only.
+	// Example: string id = 1 [(dal.v1.column) = { id_strategy: UUIDV7 }];
+	IdStrategy IDStrategy `protobuf:"varint,19,opt,name=id_strategy,json=idStrategy,proto3,enum=dal.v1.IDStrategy" json:"id_strategy,omitempty"`
+	// Exposes the entity's Datastore key, web-safe encoded, in this string
+	// field of the API message (Datastore only). The field is not stored as a
+	// property: To converters decode it into the entity's Key (when not empty)
+	// and From converters encode the Key into it.
+	// Example: string key = 7 [(dal.v1.column) = { encoded_key: true }];
+	EncodedKey    bool `protobuf:"varint,20,opt,name=encoded_key,json=encodedKey,proto3" json:"encoded_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -300,6 +570,163 @@ func (x *ColumnOptions) GetDatastoreTags() []string {
 	return nil
 }
 
+func (x *ColumnOptions) GetFlatten() *FlattenOptions {
+	if x != nil {
+		return x.Flatten
+	}
+	return nil
+}
+
+func (x *ColumnOptions) GetStorage() MessageStorage {
+	if x != nil {
+		return x.Storage
+	}
+	return MessageStorage_MESSAGE_STORAGE_UNSPECIFIED
+}
+
+func (x *ColumnOptions) GetChildTable() *ChildTableOptions {
+	if x != nil {
+		return x.ChildTable
+	}
+	return nil
+}
+
+func (x *ColumnOptions) GetIdType() string {
+	if x != nil {
+		return x.IdType
+	}
+	return ""
+}
+
+func (x *ColumnOptions) GetIdStrategy() IDStrategy {
+	if x != nil {
+		return x.IdStrategy
+	}
+	return IDStrategy_ID_STRATEGY_UNSPECIFIED
+}
+
+func (x *ColumnOptions) GetEncodedKey() bool {
+	if x != nil {
+		return x.EncodedKey
+	}
+	return false
+}
+
+// Options for flattening a nested message into its parent's columns
+type FlattenOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prefix for the flattened column names
+	// Defaults to the field's column name followed by "_"
+	// Example: "addr_" -> addr_street, addr_city
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlattenOptions) Reset() {
+	*x = FlattenOptions{}
+	mi := &file_dal_v1_annotations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlattenOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlattenOptions) ProtoMessage() {}
+
+func (x *FlattenOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dal_v1_annotations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlattenOptions.ProtoReflect.Descriptor instead.
+func (*FlattenOptions) Descriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlattenOptions) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// Options for storing a repeated message field in a child table
+type ChildTableOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Child table name
+	// Defaults to "<parent_table>_<column_name>"
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// Column holding the parent's primary key
+	// Defaults to "parent_id"
+	ForeignKey string `protobuf:"bytes,2,opt,name=foreign_key,json=foreignKey,proto3" json:"foreign_key,omitempty"`
+	// Column holding the element's position in the list
+	// Defaults to "ordinal"
+	OrdinalColumn string `protobuf:"bytes,3,opt,name=ordinal_column,json=ordinalColumn,proto3" json:"ordinal_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildTableOptions) Reset() {
+	*x = ChildTableOptions{}
+	mi := &file_dal_v1_annotations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildTableOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildTableOptions) ProtoMessage() {}
+
+func (x *ChildTableOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dal_v1_annotations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildTableOptions.ProtoReflect.Descriptor instead.
+func (*ChildTableOptions) Descriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChildTableOptions) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ChildTableOptions) GetForeignKey() string {
+	if x != nil {
+		return x.ForeignKey
+	}
+	return ""
+}
+
+func (x *ChildTableOptions) GetOrdinalColumn() string {
+	if x != nil {
+		return x.OrdinalColumn
+	}
+	return ""
+}
+
 // Specification for a custom converter function
 type ConverterFunc struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -313,14 +740,20 @@ type ConverterFunc struct {
 	// Function name to call
 	// Example: "TimestampToMillis"
 	// Generates call: alias.TimestampToMillis(value)
-	Function      string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// Signature of the function (defaults to func(T) U)
+	// Functions returning an error make the generated converter return it,
+	// wrapped with the field name
+	// Example: signature: RETURNS_ERROR
+	// Generates: out.Field, err = alias.ParseID(src.Field)
+	Signature     ConverterSignature `protobuf:"varint,4,opt,name=signature,proto3,enum=dal.v1.ConverterSignature" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConverterFunc) Reset() {
 	*x = ConverterFunc{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[2]
+	mi := &file_dal_v1_annotations_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +765,7 @@ func (x *ConverterFunc) String() string {
 func (*ConverterFunc) ProtoMessage() {}
 
 func (x *ConverterFunc) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[2]
+	mi := &file_dal_v1_annotations_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +778,7 @@ func (x *ConverterFunc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConverterFunc.ProtoReflect.Descriptor instead.
 func (*ConverterFunc) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{2}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ConverterFunc) GetPackage() string {
@@ -369,6 +802,13 @@ func (x *ConverterFunc) GetFunction() string {
 	return ""
 }
 
+func (x *ConverterFunc) GetSignature() ConverterSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return ConverterSignature_CONVERTER_SIGNATURE_UNSPECIFIED
+}
+
 // Configuration for indexes
 type IndexOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -388,7 +828,7 @@ type IndexOptions struct {
 
 func (x *IndexOptions) Reset() {
 	*x = IndexOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[3]
+	mi := &file_dal_v1_annotations_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +840,7 @@ func (x *IndexOptions) String() string {
 func (*IndexOptions) ProtoMessage() {}
 
 func (x *IndexOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[3]
+	mi := &file_dal_v1_annotations_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +853,7 @@ func (x *IndexOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexOptions.ProtoReflect.Descriptor instead.
 func (*IndexOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{3}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *IndexOptions) GetName() string {
@@ -468,7 +908,7 @@ type ForeignKeyOptions struct {
 
 func (x *ForeignKeyOptions) Reset() {
 	*x = ForeignKeyOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[4]
+	mi := &file_dal_v1_annotations_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +920,7 @@ func (x *ForeignKeyOptions) String() string {
 func (*ForeignKeyOptions) ProtoMessage() {}
 
 func (x *ForeignKeyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[4]
+	mi := &file_dal_v1_annotations_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +933,7 @@ func (x *ForeignKeyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForeignKeyOptions.ProtoReflect.Descriptor instead.
 func (*ForeignKeyOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{4}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ForeignKeyOptions) GetReferences() string {
@@ -544,14 +984,28 @@ type GormOptions struct {
 	// If not set: defaults to true when table is specified, false otherwise
 	// If explicitly true: generate DAL even without table (for late-binding)
 	// If explicitly false: skip DAL generation even with table
-	Dal           *bool `protobuf:"varint,5,opt,name=dal,proto3,oneof" json:"dal,omitempty"`
+	Dal *bool `protobuf:"varint,5,opt,name=dal,proto3,oneof" json:"dal,omitempty"`
+	// Column holding the tenant ID (optional, string field)
+	// When set, the generated DAL reads the tenant from the context (see
+	// pkg/tenant), filters Get/List/BatchGet/Update/Save/Delete by it, stamps
+	// it on Create, and fails with tenant.ErrMissingTenant if there is none.
+	// Example: tenant_column: "tenant_id"
+	TenantColumn string `protobuf:"bytes,6,opt,name=tenant_column,json=tenantColumn,proto3" json:"tenant_column,omitempty"`
+	// Audit columns filled by the generated DAL (optional)
+	// Replaces autoCreateTime/autoUpdateTime tags on those columns.
+	Audit *AuditOptions `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
+	// Generate a read-through cached DAL wrapper (optional, requires source)
+	// When true, also generates a <Name>CachedDAL whose Get and BatchGet read
+	// through a cache.Cache (see pkg/cache) holding entities as serialized
+	// source messages, and whose writes and deletes invalidate them.
+	Cache         bool `protobuf:"varint,8,opt,name=cache,proto3" json:"cache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GormOptions) Reset() {
 	*x = GormOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[5]
+	mi := &file_dal_v1_annotations_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -563,7 +1017,7 @@ func (x *GormOptions) String() string {
 func (*GormOptions) ProtoMessage() {}
 
 func (x *GormOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[5]
+	mi := &file_dal_v1_annotations_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +1030,7 @@ func (x *GormOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormOptions.ProtoReflect.Descriptor instead.
 func (*GormOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{5}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GormOptions) GetSource() string {
@@ -614,6 +1068,27 @@ func (x *GormOptions) GetDal() bool {
 	return false
 }
 
+func (x *GormOptions) GetTenantColumn() string {
+	if x != nil {
+		return x.TenantColumn
+	}
+	return ""
+}
+
+func (x *GormOptions) GetAudit() *AuditOptions {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *GormOptions) GetCache() bool {
+	if x != nil {
+		return x.Cache
+	}
+	return false
+}
+
 // PostgreSQL target options (raw SQL)
 type PostgresOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -629,7 +1104,7 @@ type PostgresOptions struct {
 
 func (x *PostgresOptions) Reset() {
 	*x = PostgresOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[6]
+	mi := &file_dal_v1_annotations_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +1116,7 @@ func (x *PostgresOptions) String() string {
 func (*PostgresOptions) ProtoMessage() {}
 
 func (x *PostgresOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[6]
+	mi := &file_dal_v1_annotations_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1129,7 @@ func (x *PostgresOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostgresOptions.ProtoReflect.Descriptor instead.
 func (*PostgresOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{6}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PostgresOptions) GetSource() string {
@@ -702,13 +1177,29 @@ type DatastoreOptions struct {
 	// to JSON properties. Required for map fields since Datastore doesn't natively support Go maps.
 	// Example: map[string]int64 will be stored as a JSON-encoded []byte property.
 	ImplementPropertyLoader bool `protobuf:"varint,7,opt,name=implement_property_loader,json=implementPropertyLoader,proto3" json:"implement_property_loader,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Use the tenant from the context (see pkg/tenant) as the namespace of
+	// every key and query in the generated DAL, overriding Namespace.
+	// Operations fail with tenant.ErrMissingTenant if there is no tenant.
+	TenantNamespace bool `protobuf:"varint,8,opt,name=tenant_namespace,json=tenantNamespace,proto3" json:"tenant_namespace,omitempty"`
+	// Audit properties filled by the generated DAL (optional)
+	Audit *AuditOptions `protobuf:"bytes,9,opt,name=audit,proto3" json:"audit,omitempty"`
+	// Generate a read-through cached DAL wrapper (optional, requires source)
+	// When true, also generates a <Name>CachedDAL whose Get and GetMulti read
+	// through a cache.Cache (see pkg/cache) holding entities as serialized
+	// source messages, and whose writes and deletes invalidate them.
+	Cache bool `protobuf:"varint,10,opt,name=cache,proto3" json:"cache,omitempty"`
+	// Generate a datastore.KeyLoader implementation (LoadKey plus Load/Save)
+	// When true, loading an entity sets its Key and fills the id field from the
+	// key's name (string ids) or numeric ID (integer ids), so ids tagged
+	// datastore:"-" live only in the key.
+	ImplementKeyLoader bool `protobuf:"varint,11,opt,name=implement_key_loader,json=implementKeyLoader,proto3" json:"implement_key_loader,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DatastoreOptions) Reset() {
 	*x = DatastoreOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[7]
+	mi := &file_dal_v1_annotations_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +1211,7 @@ func (x *DatastoreOptions) String() string {
 func (*DatastoreOptions) ProtoMessage() {}
 
 func (x *DatastoreOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[7]
+	mi := &file_dal_v1_annotations_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +1224,7 @@ func (x *DatastoreOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatastoreOptions.ProtoReflect.Descriptor instead.
 func (*DatastoreOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{7}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DatastoreOptions) GetKind() string {
@@ -785,6 +1276,120 @@ func (x *DatastoreOptions) GetImplementPropertyLoader() bool {
 	return false
 }
 
+func (x *DatastoreOptions) GetTenantNamespace() bool {
+	if x != nil {
+		return x.TenantNamespace
+	}
+	return false
+}
+
+func (x *DatastoreOptions) GetAudit() *AuditOptions {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *DatastoreOptions) GetCache() bool {
+	if x != nil {
+		return x.Cache
+	}
+	return false
+}
+
+func (x *DatastoreOptions) GetImplementKeyLoader() bool {
+	if x != nil {
+		return x.ImplementKeyLoader
+	}
+	return false
+}
+
+// AuditOptions names the columns the generated DAL fills on writes.
+// The *_by columns get the actor from the context (see pkg/audit) and must be
+// string fields; the *_at columns get the current time and must be
+// google.protobuf.Timestamp or int64 (Unix seconds) fields. Empty names are
+// not tracked. Fields from the source message are merged as usual, so API
+// messages with matching fields see the audit values through the converters.
+//
+// Example:
+//
+//	option (dal.v1.gorm) = {
+//	  source: "api.Book"
+//	  table: "books"
+//	  audit: { created_at: "created_at", updated_at: "updated_at", updated_by: "updated_by" }
+//	};
+type AuditOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Column holding the actor that created the record
+	CreatedBy string `protobuf:"bytes,1,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Column holding the actor that last wrote the record
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Column holding the creation time
+	CreatedAt string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Column holding the last write time
+	UpdatedAt     string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditOptions) Reset() {
+	*x = AuditOptions{}
+	mi := &file_dal_v1_annotations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditOptions) ProtoMessage() {}
+
+func (x *AuditOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dal_v1_annotations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditOptions.ProtoReflect.Descriptor instead.
+func (*AuditOptions) Descriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuditOptions) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AuditOptions) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *AuditOptions) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AuditOptions) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
 // Firestore target options
 type FirestoreOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -798,7 +1403,7 @@ type FirestoreOptions struct {
 
 func (x *FirestoreOptions) Reset() {
 	*x = FirestoreOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[8]
+	mi := &file_dal_v1_annotations_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +1415,7 @@ func (x *FirestoreOptions) String() string {
 func (*FirestoreOptions) ProtoMessage() {}
 
 func (x *FirestoreOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[8]
+	mi := &file_dal_v1_annotations_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1428,7 @@ func (x *FirestoreOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirestoreOptions.ProtoReflect.Descriptor instead.
 func (*FirestoreOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{8}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FirestoreOptions) GetSource() string {
@@ -855,7 +1460,7 @@ type MongoDBOptions struct {
 
 func (x *MongoDBOptions) Reset() {
 	*x = MongoDBOptions{}
-	mi := &file_dal_v1_annotations_proto_msgTypes[9]
+	mi := &file_dal_v1_annotations_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1472,7 @@ func (x *MongoDBOptions) String() string {
 func (*MongoDBOptions) ProtoMessage() {}
 
 func (x *MongoDBOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_dal_v1_annotations_proto_msgTypes[9]
+	mi := &file_dal_v1_annotations_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1485,7 @@ func (x *MongoDBOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MongoDBOptions.ProtoReflect.Descriptor instead.
 func (*MongoDBOptions) Descriptor() ([]byte, []int) {
-	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{9}
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MongoDBOptions) GetSource() string {
@@ -904,6 +1509,129 @@ func (x *MongoDBOptions) GetDatabase() string {
 	return ""
 }
 
+// Automatic sidecar generation for a file
+type AutoSidecarOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Target the sidecar messages are synthesized for
+	Target SidecarTarget `protobuf:"varint,1,opt,name=target,proto3,enum=dal.v1.SidecarTarget" json:"target,omitempty"`
+	// Source packages whose messages all get a sidecar (e.g., "weewar.v1")
+	PackageInclude []string `protobuf:"bytes,2,rep,name=package_include,json=packageInclude,proto3" json:"package_include,omitempty"`
+	// Fully qualified source messages that never get a synthesized sidecar
+	// (e.g., "weewar.v1.RulesEngine"). Messages marked with (dal.v1.skip_dal)
+	// are excluded as well.
+	MessageExclude []string `protobuf:"bytes,3,rep,name=message_exclude,json=messageExclude,proto3" json:"message_exclude,omitempty"`
+	// Suffix appended to the source message name
+	// (default: "Gorm" for GORM, "Datastore" for Datastore)
+	Suffix        string `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoSidecarOptions) Reset() {
+	*x = AutoSidecarOptions{}
+	mi := &file_dal_v1_annotations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoSidecarOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSidecarOptions) ProtoMessage() {}
+
+func (x *AutoSidecarOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dal_v1_annotations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSidecarOptions.ProtoReflect.Descriptor instead.
+func (*AutoSidecarOptions) Descriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AutoSidecarOptions) GetTarget() SidecarTarget {
+	if x != nil {
+		return x.Target
+	}
+	return SidecarTarget_SIDECAR_TARGET_UNSPECIFIED
+}
+
+func (x *AutoSidecarOptions) GetPackageInclude() []string {
+	if x != nil {
+		return x.PackageInclude
+	}
+	return nil
+}
+
+func (x *AutoSidecarOptions) GetMessageExclude() []string {
+	if x != nil {
+		return x.MessageExclude
+	}
+	return nil
+}
+
+func (x *AutoSidecarOptions) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+// DAL backing of a resource service (see protoc-gen-dal-service)
+type ServiceOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fully qualified GORM message whose DAL stores the resource
+	// (e.g., "gorm.NoteGorm"). Its source message is the resource type.
+	Target        string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceOptions) Reset() {
+	*x = ServiceOptions{}
+	mi := &file_dal_v1_annotations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOptions) ProtoMessage() {}
+
+func (x *ServiceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dal_v1_annotations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOptions.ProtoReflect.Descriptor instead.
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return file_dal_v1_annotations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ServiceOptions) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
 var file_dal_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
@@ -1001,6 +1729,22 @@ var file_dal_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,60012,opt,name=mongodb",
 		Filename:      "dal/v1/annotations.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*AutoSidecarOptions)(nil),
+		Field:         60013,
+		Name:          "dal.v1.auto_sidecar",
+		Tag:           "bytes,60013,rep,name=auto_sidecar",
+		Filename:      "dal/v1/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*ServiceOptions)(nil),
+		Field:         60014,
+		Name:          "dal.v1.service",
+		Tag:           "bytes,60014,opt,name=service",
+		Filename:      "dal/v1/annotations.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -1035,6 +1779,18 @@ var (
 	E_SkipField = &file_dal_v1_annotations_proto_extTypes[6]
 )
 
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// repeated dal.v1.AutoSidecarOptions auto_sidecar = 60013;
+	E_AutoSidecar = &file_dal_v1_annotations_proto_extTypes[12]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// optional dal.v1.ServiceOptions service = 60014;
+	E_Service = &file_dal_v1_annotations_proto_extTypes[13]
+)
+
 var File_dal_v1_annotations_proto protoreflect.FileDescriptor
 
 const file_dal_v1_annotations_proto_rawDesc = "" +
@@ -1044,7 +1800,7 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x18\n" +
 	"\acomment\x18\x03 \x01(\tR\acomment\x12\x16\n" +
-	"\x06source\x18\x04 \x01(\tR\x06source\"\xb0\x02\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"\xbf\x04\n" +
 	"\rColumnOptions\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
 	"\ato_func\x18\x02 \x01(\v2\x15.dal.v1.ConverterFuncR\x06toFunc\x122\n" +
@@ -1054,11 +1810,28 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"\bsql_tags\x18\v \x03(\tR\asqlTags\x12%\n" +
 	"\x0efirestore_tags\x18\f \x03(\tR\rfirestoreTags\x12!\n" +
 	"\fmongodb_tags\x18\r \x03(\tR\vmongodbTags\x12%\n" +
-	"\x0edatastore_tags\x18\x0e \x03(\tR\rdatastoreTags\"[\n" +
+	"\x0edatastore_tags\x18\x0e \x03(\tR\rdatastoreTags\x120\n" +
+	"\aflatten\x18\x0f \x01(\v2\x16.dal.v1.FlattenOptionsR\aflatten\x120\n" +
+	"\astorage\x18\x10 \x01(\x0e2\x16.dal.v1.MessageStorageR\astorage\x12:\n" +
+	"\vchild_table\x18\x11 \x01(\v2\x19.dal.v1.ChildTableOptionsR\n" +
+	"childTable\x12\x17\n" +
+	"\aid_type\x18\x12 \x01(\tR\x06idType\x123\n" +
+	"\vid_strategy\x18\x13 \x01(\x0e2\x12.dal.v1.IDStrategyR\n" +
+	"idStrategy\x12\x1f\n" +
+	"\vencoded_key\x18\x14 \x01(\bR\n" +
+	"encodedKey\"(\n" +
+	"\x0eFlattenOptions\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"q\n" +
+	"\x11ChildTableOptions\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x1f\n" +
+	"\vforeign_key\x18\x02 \x01(\tR\n" +
+	"foreignKey\x12%\n" +
+	"\x0eordinal_column\x18\x03 \x01(\tR\rordinalColumn\"\x95\x01\n" +
 	"\rConverterFunc\x12\x18\n" +
 	"\apackage\x18\x01 \x01(\tR\apackage\x12\x14\n" +
 	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x1a\n" +
-	"\bfunction\x18\x03 \x01(\tR\bfunction\"|\n" +
+	"\bfunction\x18\x03 \x01(\tR\bfunction\x128\n" +
+	"\tsignature\x18\x04 \x01(\x0e2\x1a.dal.v1.ConverterSignatureR\tsignature\"|\n" +
 	"\fIndexOptions\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06fields\x18\x02 \x01(\tR\x06fields\x12\x16\n" +
@@ -1071,18 +1844,21 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"references\x126\n" +
 	"\ton_delete\x18\x02 \x01(\x0e2\x19.dal.v1.ReferentialActionR\bonDelete\x126\n" +
 	"\ton_update\x18\x03 \x01(\x0e2\x19.dal.v1.ReferentialActionR\bonUpdate\x12'\n" +
-	"\x0fconstraint_name\x18\x04 \x01(\tR\x0econstraintName\"\xa3\x01\n" +
+	"\x0fconstraint_name\x18\x04 \x01(\tR\x0econstraintName\"\x8a\x02\n" +
 	"\vGormOptions\x12\x16\n" +
 	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
 	"\x05table\x18\x02 \x01(\tR\x05table\x12\x1a\n" +
 	"\bembedded\x18\x03 \x03(\tR\bembedded\x12+\n" +
 	"\x11implement_scanner\x18\x04 \x01(\bR\x10implementScanner\x12\x15\n" +
-	"\x03dal\x18\x05 \x01(\bH\x00R\x03dal\x88\x01\x01B\x06\n" +
+	"\x03dal\x18\x05 \x01(\bH\x00R\x03dal\x88\x01\x01\x12#\n" +
+	"\rtenant_column\x18\x06 \x01(\tR\ftenantColumn\x12*\n" +
+	"\x05audit\x18\a \x01(\v2\x14.dal.v1.AuditOptionsR\x05audit\x12\x14\n" +
+	"\x05cache\x18\b \x01(\bR\x05cacheB\x06\n" +
 	"\x04_dal\"W\n" +
 	"\x0fPostgresOptions\x12\x16\n" +
 	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
 	"\x05table\x18\x02 \x01(\tR\x05table\x12\x16\n" +
-	"\x06schema\x18\x03 \x01(\tR\x06schema\"\xfa\x01\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\"\x99\x03\n" +
 	"\x10DatastoreOptions\x12\x12\n" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12%\n" +
@@ -1090,8 +1866,22 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"\bancestor\x18\x04 \x01(\tR\bancestor\x12\x16\n" +
 	"\x06source\x18\x05 \x01(\tR\x06source\x12\x15\n" +
 	"\x03dal\x18\x06 \x01(\bH\x00R\x03dal\x88\x01\x01\x12:\n" +
-	"\x19implement_property_loader\x18\a \x01(\bR\x17implementPropertyLoaderB\x06\n" +
-	"\x04_dal\"J\n" +
+	"\x19implement_property_loader\x18\a \x01(\bR\x17implementPropertyLoader\x12)\n" +
+	"\x10tenant_namespace\x18\b \x01(\bR\x0ftenantNamespace\x12*\n" +
+	"\x05audit\x18\t \x01(\v2\x14.dal.v1.AuditOptionsR\x05audit\x12\x14\n" +
+	"\x05cache\x18\n" +
+	" \x01(\bR\x05cache\x120\n" +
+	"\x14implement_key_loader\x18\v \x01(\bR\x12implementKeyLoaderB\x06\n" +
+	"\x04_dal\"\x8a\x01\n" +
+	"\fAuditOptions\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x01 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"J\n" +
 	"\x10FirestoreOptions\x12\x16\n" +
 	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1e\n" +
 	"\n" +
@@ -1102,13 +1892,43 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"\n" +
 	"collection\x18\x02 \x01(\tR\n" +
 	"collection\x12\x1a\n" +
-	"\bdatabase\x18\x03 \x01(\tR\bdatabase*\\\n" +
+	"\bdatabase\x18\x03 \x01(\tR\bdatabase\"\xad\x01\n" +
+	"\x12AutoSidecarOptions\x12-\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x15.dal.v1.SidecarTargetR\x06target\x12'\n" +
+	"\x0fpackage_include\x18\x02 \x03(\tR\x0epackageInclude\x12'\n" +
+	"\x0fmessage_exclude\x18\x03 \x03(\tR\x0emessageExclude\x12\x16\n" +
+	"\x06suffix\x18\x04 \x01(\tR\x06suffix\"(\n" +
+	"\x0eServiceOptions\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target*R\n" +
+	"\x0eMessageStorage\x12\x1f\n" +
+	"\x1bMESSAGE_STORAGE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPROTO_BINARY\x10\x01\x12\r\n" +
+	"\tPROTOJSON\x10\x02*\x87\x01\n" +
+	"\n" +
+	"IDStrategy\x12\x1b\n" +
+	"\x17ID_STRATEGY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06UUIDV4\x10\x01\x12\n" +
+	"\n" +
+	"\x06UUIDV7\x10\x02\x12\b\n" +
+	"\x04ULID\x10\x03\x12\r\n" +
+	"\tSNOWFLAKE\x10\x04\x12\x12\n" +
+	"\x0eAUTO_INCREMENT\x10\x05\x12\x17\n" +
+	"\x13DATASTORE_ALLOCATED\x10\x06*g\n" +
+	"\x12ConverterSignature\x12#\n" +
+	"\x1fCONVERTER_SIGNATURE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rRETURNS_ERROR\x10\x01\x12\x19\n" +
+	"\x15CONTEXT_RETURNS_ERROR\x10\x02*\\\n" +
 	"\x11ReferentialAction\x12\r\n" +
 	"\tNO_ACTION\x10\x00\x12\f\n" +
 	"\bRESTRICT\x10\x01\x12\v\n" +
 	"\aCASCADE\x10\x02\x12\f\n" +
 	"\bSET_NULL\x10\x03\x12\x0f\n" +
-	"\vSET_DEFAULT\x10\x04:M\n" +
+	"\vSET_DEFAULT\x10\x04*H\n" +
+	"\rSidecarTarget\x12\x1e\n" +
+	"\x1aSIDECAR_TARGET_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04GORM\x10\x01\x12\r\n" +
+	"\tDATASTORE\x10\x02:M\n" +
 	"\x05table\x12\x1f.google.protobuf.MessageOptions\x18\xe1\xd4\x03 \x01(\v2\x14.dal.v1.TableOptionsR\x05table:N\n" +
 	"\x06column\x12\x1d.google.protobuf.FieldOptions\x18\xe2\xd4\x03 \x01(\v2\x15.dal.v1.ColumnOptionsR\x06column:M\n" +
 	"\x05index\x12\x1f.google.protobuf.MessageOptions\x18\xe3\xd4\x03 \x03(\v2\x14.dal.v1.IndexOptionsR\x05index:V\n" +
@@ -1123,7 +1943,9 @@ const file_dal_v1_annotations_proto_rawDesc = "" +
 	"\x04gorm\x12\x1f.google.protobuf.MessageOptions\x18\xe9\xd4\x03 \x01(\v2\x13.dal.v1.GormOptionsR\x04gorm:h\n" +
 	"\x11datastore_options\x12\x1f.google.protobuf.MessageOptions\x18\xea\xd4\x03 \x01(\v2\x18.dal.v1.DatastoreOptionsR\x10datastoreOptions:Y\n" +
 	"\tfirestore\x12\x1f.google.protobuf.MessageOptions\x18\xeb\xd4\x03 \x01(\v2\x18.dal.v1.FirestoreOptionsR\tfirestore:S\n" +
-	"\amongodb\x12\x1f.google.protobuf.MessageOptions\x18\xec\xd4\x03 \x01(\v2\x16.dal.v1.MongoDBOptionsR\amongodbB4Z2github.com/panyam/protoc-gen-dal/protos/gen/dal/v1b\x06proto3"
+	"\amongodb\x12\x1f.google.protobuf.MessageOptions\x18\xec\xd4\x03 \x01(\v2\x16.dal.v1.MongoDBOptionsR\amongodb:]\n" +
+	"\fauto_sidecar\x12\x1c.google.protobuf.FileOptions\x18\xed\xd4\x03 \x03(\v2\x1a.dal.v1.AutoSidecarOptionsR\vautoSidecar:S\n" +
+	"\aservice\x12\x1f.google.protobuf.ServiceOptions\x18\xee\xd4\x03 \x01(\v2\x16.dal.v1.ServiceOptionsR\aserviceB4Z2github.com/panyam/protoc-gen-dal/protos/gen/dal/v1b\x06proto3"
 
 var (
 	file_dal_v1_annotations_proto_rawDescOnce sync.Once
@@ -1137,55 +1959,78 @@ func file_dal_v1_annotations_proto_rawDescGZIP() []byte {
 	return file_dal_v1_annotations_proto_rawDescData
 }
 
-var file_dal_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_dal_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_dal_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_dal_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_dal_v1_annotations_proto_goTypes = []any{
-	(ReferentialAction)(0),              // 0: dal.v1.ReferentialAction
-	(*TableOptions)(nil),                // 1: dal.v1.TableOptions
-	(*ColumnOptions)(nil),               // 2: dal.v1.ColumnOptions
-	(*ConverterFunc)(nil),               // 3: dal.v1.ConverterFunc
-	(*IndexOptions)(nil),                // 4: dal.v1.IndexOptions
-	(*ForeignKeyOptions)(nil),           // 5: dal.v1.ForeignKeyOptions
-	(*GormOptions)(nil),                 // 6: dal.v1.GormOptions
-	(*PostgresOptions)(nil),             // 7: dal.v1.PostgresOptions
-	(*DatastoreOptions)(nil),            // 8: dal.v1.DatastoreOptions
-	(*FirestoreOptions)(nil),            // 9: dal.v1.FirestoreOptions
-	(*MongoDBOptions)(nil),              // 10: dal.v1.MongoDBOptions
-	(*descriptorpb.MessageOptions)(nil), // 11: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 12: google.protobuf.FieldOptions
+	(MessageStorage)(0),                 // 0: dal.v1.MessageStorage
+	(IDStrategy)(0),                     // 1: dal.v1.IDStrategy
+	(ConverterSignature)(0),             // 2: dal.v1.ConverterSignature
+	(ReferentialAction)(0),              // 3: dal.v1.ReferentialAction
+	(SidecarTarget)(0),                  // 4: dal.v1.SidecarTarget
+	(*TableOptions)(nil),                // 5: dal.v1.TableOptions
+	(*ColumnOptions)(nil),               // 6: dal.v1.ColumnOptions
+	(*FlattenOptions)(nil),              // 7: dal.v1.FlattenOptions
+	(*ChildTableOptions)(nil),           // 8: dal.v1.ChildTableOptions
+	(*ConverterFunc)(nil),               // 9: dal.v1.ConverterFunc
+	(*IndexOptions)(nil),                // 10: dal.v1.IndexOptions
+	(*ForeignKeyOptions)(nil),           // 11: dal.v1.ForeignKeyOptions
+	(*GormOptions)(nil),                 // 12: dal.v1.GormOptions
+	(*PostgresOptions)(nil),             // 13: dal.v1.PostgresOptions
+	(*DatastoreOptions)(nil),            // 14: dal.v1.DatastoreOptions
+	(*AuditOptions)(nil),                // 15: dal.v1.AuditOptions
+	(*FirestoreOptions)(nil),            // 16: dal.v1.FirestoreOptions
+	(*MongoDBOptions)(nil),              // 17: dal.v1.MongoDBOptions
+	(*AutoSidecarOptions)(nil),          // 18: dal.v1.AutoSidecarOptions
+	(*ServiceOptions)(nil),              // 19: dal.v1.ServiceOptions
+	(*descriptorpb.MessageOptions)(nil), // 20: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 21: google.protobuf.FieldOptions
+	(*descriptorpb.FileOptions)(nil),    // 22: google.protobuf.FileOptions
+	(*descriptorpb.ServiceOptions)(nil), // 23: google.protobuf.ServiceOptions
 }
 var file_dal_v1_annotations_proto_depIdxs = []int32{
-	3,  // 0: dal.v1.ColumnOptions.to_func:type_name -> dal.v1.ConverterFunc
-	3,  // 1: dal.v1.ColumnOptions.from_func:type_name -> dal.v1.ConverterFunc
-	0,  // 2: dal.v1.ForeignKeyOptions.on_delete:type_name -> dal.v1.ReferentialAction
-	0,  // 3: dal.v1.ForeignKeyOptions.on_update:type_name -> dal.v1.ReferentialAction
-	11, // 4: dal.v1.table:extendee -> google.protobuf.MessageOptions
-	12, // 5: dal.v1.column:extendee -> google.protobuf.FieldOptions
-	11, // 6: dal.v1.index:extendee -> google.protobuf.MessageOptions
-	12, // 7: dal.v1.field_index:extendee -> google.protobuf.FieldOptions
-	12, // 8: dal.v1.foreign_key:extendee -> google.protobuf.FieldOptions
-	11, // 9: dal.v1.skip_dal:extendee -> google.protobuf.MessageOptions
-	12, // 10: dal.v1.skip_field:extendee -> google.protobuf.FieldOptions
-	11, // 11: dal.v1.postgres:extendee -> google.protobuf.MessageOptions
-	11, // 12: dal.v1.gorm:extendee -> google.protobuf.MessageOptions
-	11, // 13: dal.v1.datastore_options:extendee -> google.protobuf.MessageOptions
-	11, // 14: dal.v1.firestore:extendee -> google.protobuf.MessageOptions
-	11, // 15: dal.v1.mongodb:extendee -> google.protobuf.MessageOptions
-	1,  // 16: dal.v1.table:type_name -> dal.v1.TableOptions
-	2,  // 17: dal.v1.column:type_name -> dal.v1.ColumnOptions
-	4,  // 18: dal.v1.index:type_name -> dal.v1.IndexOptions
-	4,  // 19: dal.v1.field_index:type_name -> dal.v1.IndexOptions
-	5,  // 20: dal.v1.foreign_key:type_name -> dal.v1.ForeignKeyOptions
-	7,  // 21: dal.v1.postgres:type_name -> dal.v1.PostgresOptions
-	6,  // 22: dal.v1.gorm:type_name -> dal.v1.GormOptions
-	8,  // 23: dal.v1.datastore_options:type_name -> dal.v1.DatastoreOptions
-	9,  // 24: dal.v1.firestore:type_name -> dal.v1.FirestoreOptions
-	10, // 25: dal.v1.mongodb:type_name -> dal.v1.MongoDBOptions
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	16, // [16:26] is the sub-list for extension type_name
-	4,  // [4:16] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	9,  // 0: dal.v1.ColumnOptions.to_func:type_name -> dal.v1.ConverterFunc
+	9,  // 1: dal.v1.ColumnOptions.from_func:type_name -> dal.v1.ConverterFunc
+	7,  // 2: dal.v1.ColumnOptions.flatten:type_name -> dal.v1.FlattenOptions
+	0,  // 3: dal.v1.ColumnOptions.storage:type_name -> dal.v1.MessageStorage
+	8,  // 4: dal.v1.ColumnOptions.child_table:type_name -> dal.v1.ChildTableOptions
+	1,  // 5: dal.v1.ColumnOptions.id_strategy:type_name -> dal.v1.IDStrategy
+	2,  // 6: dal.v1.ConverterFunc.signature:type_name -> dal.v1.ConverterSignature
+	3,  // 7: dal.v1.ForeignKeyOptions.on_delete:type_name -> dal.v1.ReferentialAction
+	3,  // 8: dal.v1.ForeignKeyOptions.on_update:type_name -> dal.v1.ReferentialAction
+	15, // 9: dal.v1.GormOptions.audit:type_name -> dal.v1.AuditOptions
+	15, // 10: dal.v1.DatastoreOptions.audit:type_name -> dal.v1.AuditOptions
+	4,  // 11: dal.v1.AutoSidecarOptions.target:type_name -> dal.v1.SidecarTarget
+	20, // 12: dal.v1.table:extendee -> google.protobuf.MessageOptions
+	21, // 13: dal.v1.column:extendee -> google.protobuf.FieldOptions
+	20, // 14: dal.v1.index:extendee -> google.protobuf.MessageOptions
+	21, // 15: dal.v1.field_index:extendee -> google.protobuf.FieldOptions
+	21, // 16: dal.v1.foreign_key:extendee -> google.protobuf.FieldOptions
+	20, // 17: dal.v1.skip_dal:extendee -> google.protobuf.MessageOptions
+	21, // 18: dal.v1.skip_field:extendee -> google.protobuf.FieldOptions
+	20, // 19: dal.v1.postgres:extendee -> google.protobuf.MessageOptions
+	20, // 20: dal.v1.gorm:extendee -> google.protobuf.MessageOptions
+	20, // 21: dal.v1.datastore_options:extendee -> google.protobuf.MessageOptions
+	20, // 22: dal.v1.firestore:extendee -> google.protobuf.MessageOptions
+	20, // 23: dal.v1.mongodb:extendee -> google.protobuf.MessageOptions
+	22, // 24: dal.v1.auto_sidecar:extendee -> google.protobuf.FileOptions
+	23, // 25: dal.v1.service:extendee -> google.protobuf.ServiceOptions
+	5,  // 26: dal.v1.table:type_name -> dal.v1.TableOptions
+	6,  // 27: dal.v1.column:type_name -> dal.v1.ColumnOptions
+	10, // 28: dal.v1.index:type_name -> dal.v1.IndexOptions
+	10, // 29: dal.v1.field_index:type_name -> dal.v1.IndexOptions
+	11, // 30: dal.v1.foreign_key:type_name -> dal.v1.ForeignKeyOptions
+	13, // 31: dal.v1.postgres:type_name -> dal.v1.PostgresOptions
+	12, // 32: dal.v1.gorm:type_name -> dal.v1.GormOptions
+	14, // 33: dal.v1.datastore_options:type_name -> dal.v1.DatastoreOptions
+	16, // 34: dal.v1.firestore:type_name -> dal.v1.FirestoreOptions
+	17, // 35: dal.v1.mongodb:type_name -> dal.v1.MongoDBOptions
+	18, // 36: dal.v1.auto_sidecar:type_name -> dal.v1.AutoSidecarOptions
+	19, // 37: dal.v1.service:type_name -> dal.v1.ServiceOptions
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	26, // [26:38] is the sub-list for extension type_name
+	12, // [12:26] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_dal_v1_annotations_proto_init() }
@@ -1193,16 +2038,16 @@ func file_dal_v1_annotations_proto_init() {
 	if File_dal_v1_annotations_proto != nil {
 		return
 	}
-	file_dal_v1_annotations_proto_msgTypes[5].OneofWrappers = []any{}
 	file_dal_v1_annotations_proto_msgTypes[7].OneofWrappers = []any{}
+	file_dal_v1_annotations_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dal_v1_annotations_proto_rawDesc), len(file_dal_v1_annotations_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
-			NumExtensions: 12,
+			NumEnums:      5,
+			NumMessages:   15,
+			NumExtensions: 14,
 			NumServices:   0,
 		},
 		GoTypes:           file_dal_v1_annotations_proto_goTypes,
@@ -5,7 +5,10 @@ import (
 	"context"
 
 	dslib "cloud.google.com/go/datastore"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
 	datastore "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"google.golang.org/api/iterator"
 )
 
 // DocumentDatastoreEmptyDAL provides database access helper methods for datastore.DocumentDatastoreEmpty.
@@ -40,21 +43,31 @@ func (d *DocumentDatastoreEmptyDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *DocumentDatastoreEmptyDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *DocumentDatastoreEmptyDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *DocumentDatastoreEmptyDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *DocumentDatastoreEmptyDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -74,8 +87,8 @@ func (d *DocumentDatastoreEmptyDAL) Put(ctx context.Context, client *dslib.Clien
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -93,6 +106,21 @@ func (d *DocumentDatastoreEmptyDAL) Put(ctx context.Context, client *dslib.Clien
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *DocumentDatastoreEmptyDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.DocumentDatastoreEmpty) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.DocumentDatastoreEmpty entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *DocumentDatastoreEmptyDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.DocumentDatastoreEmpty, error) {
@@ -170,8 +198,8 @@ func (d *DocumentDatastoreEmptyDAL) PutMulti(ctx context.Context, client *dslib.
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -202,9 +230,10 @@ func (d *DocumentDatastoreEmptyDAL) DeleteMulti(ctx context.Context, client *dsl
 
 // Query retrieves datastore.DocumentDatastoreEmpty entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *DocumentDatastoreEmptyDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.DocumentDatastoreEmpty, error) {
 	var entities []*datastore.DocumentDatastoreEmpty
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +246,100 @@ func (d *DocumentDatastoreEmptyDAL) Query(ctx context.Context, client *dslib.Cli
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.DocumentDatastoreEmpty entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *DocumentDatastoreEmptyDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.DocumentDatastoreEmpty, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.DocumentDatastoreEmpty
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with DocumentFromDocumentDatastoreEmpty
+// and calls fn with the resulting api.Document.
+func (d *DocumentDatastoreEmptyDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Document, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.DocumentDatastoreEmpty, cursor dslib.Cursor) error {
+		msg, err := datastore.DocumentFromDocumentDatastoreEmpty(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// DocumentDatastoreEmptyFilterSchema lists the api.Document fields that AIP-160
+// filters on DocumentDatastoreEmpty entities may use, and their properties.
+var DocumentDatastoreEmptyFilterSchema = filtering.MustSchema((&api.Document{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":         "id",
+	"title":      "title",
+	"content":    "content",
+	"author":     "author",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"published":  "published",
+	"view_count": "view_count",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Document fields (see DocumentDatastoreEmptyFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *DocumentDatastoreEmptyDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(DocumentDatastoreEmptyFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *DocumentDatastoreEmptyDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // DocumentDatastorePartialDAL provides database access helper methods for datastore.DocumentDatastorePartial.
@@ -254,21 +374,31 @@ func (d *DocumentDatastorePartialDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *DocumentDatastorePartialDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *DocumentDatastorePartialDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *DocumentDatastorePartialDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *DocumentDatastorePartialDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -288,8 +418,8 @@ func (d *DocumentDatastorePartialDAL) Put(ctx context.Context, client *dslib.Cli
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -309,6 +439,21 @@ func (d *DocumentDatastorePartialDAL) Put(ctx context.Context, client *dslib.Cli
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *DocumentDatastorePartialDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.DocumentDatastorePartial) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.DocumentDatastorePartial entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *DocumentDatastorePartialDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.DocumentDatastorePartial, error) {
@@ -386,8 +531,8 @@ func (d *DocumentDatastorePartialDAL) PutMulti(ctx context.Context, client *dsli
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -420,9 +565,10 @@ func (d *DocumentDatastorePartialDAL) DeleteMulti(ctx context.Context, client *d
 
 // Query retrieves datastore.DocumentDatastorePartial entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *DocumentDatastorePartialDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.DocumentDatastorePartial, error) {
 	var entities []*datastore.DocumentDatastorePartial
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -435,9 +581,99 @@ func (d *DocumentDatastorePartialDAL) Query(ctx context.Context, client *dslib.C
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.DocumentDatastorePartial entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *DocumentDatastorePartialDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.DocumentDatastorePartial, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.DocumentDatastorePartial
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with DocumentFromDocumentDatastorePartial
+// and calls fn with the resulting api.Document.
+func (d *DocumentDatastorePartialDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Document, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.DocumentDatastorePartial, cursor dslib.Cursor) error {
+		msg, err := datastore.DocumentFromDocumentDatastorePartial(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// DocumentDatastorePartialFilterSchema lists the api.Document fields that AIP-160
+// filters on DocumentDatastorePartial entities may use, and their properties.
+var DocumentDatastorePartialFilterSchema = filtering.MustSchema((&api.Document{}).ProtoReflect().Descriptor(), map[string]string{
+	"title":      "title",
+	"content":    "content",
+	"author":     "author",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"published":  "published",
+	"view_count": "view_count",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Document fields (see DocumentDatastorePartialFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *DocumentDatastorePartialDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(DocumentDatastorePartialFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *DocumentDatastorePartialDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.DocumentDatastorePartial entity by ID.
@@ -503,21 +739,31 @@ func (d *DocumentDatastoreSkipDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *DocumentDatastoreSkipDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *DocumentDatastoreSkipDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *DocumentDatastoreSkipDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *DocumentDatastoreSkipDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -537,8 +783,8 @@ func (d *DocumentDatastoreSkipDAL) Put(ctx context.Context, client *dslib.Client
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -558,6 +804,21 @@ func (d *DocumentDatastoreSkipDAL) Put(ctx context.Context, client *dslib.Client
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *DocumentDatastoreSkipDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.DocumentDatastoreSkip) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.DocumentDatastoreSkip entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *DocumentDatastoreSkipDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.DocumentDatastoreSkip, error) {
@@ -635,8 +896,8 @@ func (d *DocumentDatastoreSkipDAL) PutMulti(ctx context.Context, client *dslib.C
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -669,9 +930,10 @@ func (d *DocumentDatastoreSkipDAL) DeleteMulti(ctx context.Context, client *dsli
 
 // Query retrieves datastore.DocumentDatastoreSkip entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *DocumentDatastoreSkipDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.DocumentDatastoreSkip, error) {
 	var entities []*datastore.DocumentDatastoreSkip
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -684,9 +946,98 @@ func (d *DocumentDatastoreSkipDAL) Query(ctx context.Context, client *dslib.Clie
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.DocumentDatastoreSkip entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *DocumentDatastoreSkipDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.DocumentDatastoreSkip, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.DocumentDatastoreSkip
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with DocumentFromDocumentDatastoreSkip
+// and calls fn with the resulting api.Document.
+func (d *DocumentDatastoreSkipDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Document, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.DocumentDatastoreSkip, cursor dslib.Cursor) error {
+		msg, err := datastore.DocumentFromDocumentDatastoreSkip(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// DocumentDatastoreSkipFilterSchema lists the api.Document fields that AIP-160
+// filters on DocumentDatastoreSkip entities may use, and their properties.
+var DocumentDatastoreSkipFilterSchema = filtering.MustSchema((&api.Document{}).ProtoReflect().Descriptor(), map[string]string{
+	"title":      "title",
+	"author":     "author",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"published":  "published",
+	"view_count": "view_count",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Document fields (see DocumentDatastoreSkipFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *DocumentDatastoreSkipDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(DocumentDatastoreSkipFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *DocumentDatastoreSkipDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.DocumentDatastoreSkip entity by ID.
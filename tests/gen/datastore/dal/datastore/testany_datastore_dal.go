@@ -5,7 +5,11 @@ import (
 	"context"
 
 	dslib "cloud.google.com/go/datastore"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	"github.com/panyam/protoc-gen-dal/pkg/idgen"
 	datastore "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"google.golang.org/api/iterator"
 )
 
 // TestRecord1DatastoreDAL provides database access helper methods for datastore.TestRecord1Datastore.
@@ -40,21 +44,31 @@ func (d *TestRecord1DatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *TestRecord1DatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *TestRecord1DatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *TestRecord1DatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *TestRecord1DatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -74,8 +88,8 @@ func (d *TestRecord1DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -93,6 +107,21 @@ func (d *TestRecord1DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *TestRecord1DatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.TestRecord1Datastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.TestRecord1Datastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *TestRecord1DatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.TestRecord1Datastore, error) {
@@ -170,8 +199,8 @@ func (d *TestRecord1DatastoreDAL) PutMulti(ctx context.Context, client *dslib.Cl
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -202,9 +231,10 @@ func (d *TestRecord1DatastoreDAL) DeleteMulti(ctx context.Context, client *dslib
 
 // Query retrieves datastore.TestRecord1Datastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *TestRecord1DatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.TestRecord1Datastore, error) {
 	var entities []*datastore.TestRecord1Datastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +247,94 @@ func (d *TestRecord1DatastoreDAL) Query(ctx context.Context, client *dslib.Clien
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.TestRecord1Datastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *TestRecord1DatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.TestRecord1Datastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.TestRecord1Datastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with TestRecord1FromTestRecord1Datastore
+// and calls fn with the resulting api.TestRecord1.
+func (d *TestRecord1DatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.TestRecord1, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.TestRecord1Datastore, cursor dslib.Cursor) error {
+		msg, err := datastore.TestRecord1FromTestRecord1Datastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// TestRecord1DatastoreFilterSchema lists the api.TestRecord1 fields that AIP-160
+// filters on TestRecord1Datastore entities may use, and their properties.
+var TestRecord1DatastoreFilterSchema = filtering.MustSchema((&api.TestRecord1{}).ProtoReflect().Descriptor(), map[string]string{
+	"time_field": "time_field",
+	"an_enum":    "an_enum",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.TestRecord1 fields (see TestRecord1DatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *TestRecord1DatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(TestRecord1DatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *TestRecord1DatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // TestRecord2DatastoreDAL provides database access helper methods for datastore.TestRecord2Datastore.
@@ -254,21 +369,31 @@ func (d *TestRecord2DatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *TestRecord2DatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *TestRecord2DatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *TestRecord2DatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *TestRecord2DatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -288,8 +413,8 @@ func (d *TestRecord2DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -307,6 +432,21 @@ func (d *TestRecord2DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *TestRecord2DatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.TestRecord2Datastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.TestRecord2Datastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *TestRecord2DatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.TestRecord2Datastore, error) {
@@ -384,8 +524,8 @@ func (d *TestRecord2DatastoreDAL) PutMulti(ctx context.Context, client *dslib.Cl
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -416,9 +556,10 @@ func (d *TestRecord2DatastoreDAL) DeleteMulti(ctx context.Context, client *dslib
 
 // Query retrieves datastore.TestRecord2Datastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *TestRecord2DatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.TestRecord2Datastore, error) {
 	var entities []*datastore.TestRecord2Datastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -431,9 +572,93 @@ func (d *TestRecord2DatastoreDAL) Query(ctx context.Context, client *dslib.Clien
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.TestRecord2Datastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *TestRecord2DatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.TestRecord2Datastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.TestRecord2Datastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with TestRecord2FromTestRecord2Datastore
+// and calls fn with the resulting api.TestRecord2.
+func (d *TestRecord2DatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.TestRecord2, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.TestRecord2Datastore, cursor dslib.Cursor) error {
+		msg, err := datastore.TestRecord2FromTestRecord2Datastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// TestRecord2DatastoreFilterSchema lists the api.TestRecord2 fields that AIP-160
+// filters on TestRecord2Datastore entities may use, and their properties.
+var TestRecord2DatastoreFilterSchema = filtering.MustSchema((&api.TestRecord2{}).ProtoReflect().Descriptor(), map[string]string{
+	"name": "name",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.TestRecord2 fields (see TestRecord2DatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *TestRecord2DatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(TestRecord2DatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *TestRecord2DatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // TestRecord3DatastoreDAL provides database access helper methods for datastore.TestRecord3Datastore.
@@ -468,21 +693,31 @@ func (d *TestRecord3DatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *TestRecord3DatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *TestRecord3DatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *TestRecord3DatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *TestRecord3DatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -502,8 +737,8 @@ func (d *TestRecord3DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -523,6 +758,21 @@ func (d *TestRecord3DatastoreDAL) Put(ctx context.Context, client *dslib.Client,
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *TestRecord3DatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.TestRecord3Datastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.TestRecord3Datastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *TestRecord3DatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.TestRecord3Datastore, error) {
@@ -600,8 +850,8 @@ func (d *TestRecord3DatastoreDAL) PutMulti(ctx context.Context, client *dslib.Cl
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -634,9 +884,10 @@ func (d *TestRecord3DatastoreDAL) DeleteMulti(ctx context.Context, client *dslib
 
 // Query retrieves datastore.TestRecord3Datastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *TestRecord3DatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.TestRecord3Datastore, error) {
 	var entities []*datastore.TestRecord3Datastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -649,9 +900,96 @@ func (d *TestRecord3DatastoreDAL) Query(ctx context.Context, client *dslib.Clien
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.TestRecord3Datastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *TestRecord3DatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.TestRecord3Datastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.TestRecord3Datastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with TestRecord3FromTestRecord3Datastore
+// and calls fn with the resulting api.TestRecord3.
+func (d *TestRecord3DatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.TestRecord3, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.TestRecord3Datastore, cursor dslib.Cursor) error {
+		msg, err := datastore.TestRecord3FromTestRecord3Datastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// TestRecord3DatastoreFilterSchema lists the api.TestRecord3 fields that AIP-160
+// filters on TestRecord3Datastore entities may use, and their properties.
+var TestRecord3DatastoreFilterSchema = filtering.MustSchema((&api.TestRecord3{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":          "id",
+	"entity_type": "entity_type",
+	"entity_id":   "entity_id",
+	"total_count": "total_count",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.TestRecord3 fields (see TestRecord3DatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *TestRecord3DatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(TestRecord3DatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *TestRecord3DatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.TestRecord3Datastore entity by ID.
@@ -684,3 +1022,396 @@ func (d *TestRecord3DatastoreDAL) GetMultiByIDs(ctx context.Context, client *dsl
 
 	return d.GetMulti(ctx, client, keys)
 }
+
+// TestRecord4DatastoreDAL provides database access helper methods for datastore.TestRecord4Datastore.
+type TestRecord4DatastoreDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.TestRecord4Datastore) error
+
+	// IDGenerator creates the IDs of new entities with an empty Id.
+	// If nil, uses idgen.DefaultGenerator.
+	IDGenerator idgen.Generator
+}
+
+// NewTestRecord4DatastoreDAL creates a new TestRecord4DatastoreDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewTestRecord4DatastoreDAL(kind string) *TestRecord4DatastoreDAL {
+	return &TestRecord4DatastoreDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *TestRecord4DatastoreDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.TestRecord4Datastore
+	return entity.Kind()
+}
+
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *TestRecord4DatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *TestRecord4DatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
+// newKey creates a new Datastore key for the given ID.
+func (d *TestRecord4DatastoreDAL) newKey(id datastore.RecordID) *dslib.Key {
+	key := dslib.NameKey(d.getKind(), string(id), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *TestRecord4DatastoreDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// assignID fills an empty Id of obj with a generated ID (id_strategy).
+func (d *TestRecord4DatastoreDAL) assignID(ctx context.Context, obj *datastore.TestRecord4Datastore) error {
+	if obj.Id != "" {
+		return nil
+	}
+	id, err := idgen.String(ctx, d.IDGenerator, idgen.UUIDv7)
+	if err != nil {
+		return err
+	}
+	obj.Id = datastore.RecordID(id)
+	return nil
+}
+
+// Put saves a datastore.TestRecord4Datastore entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// A new entity with an empty ID gets a generated one first (see IDGenerator).
+// Returns the key used to store the entity.
+func (d *TestRecord4DatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.TestRecord4Datastore) (*dslib.Key, error) {
+	// New entities get a generated ID
+	if obj.Key == nil {
+		if err := d.assignID(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
+		}
+	} else if obj.Id != "" {
+		key = d.newKey(obj.Id)
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+
+	return resultKey, nil
+}
+
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *TestRecord4DatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.TestRecord4Datastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.TestRecord4Datastore entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *TestRecord4DatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.TestRecord4Datastore, error) {
+	var entity datastore.TestRecord4Datastore
+	err := client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.TestRecord4Datastore entity by key.
+func (d *TestRecord4DatastoreDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.TestRecord4Datastore entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *TestRecord4DatastoreDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.TestRecord4Datastore, error) {
+	if len(keys) == 0 {
+		return []*datastore.TestRecord4Datastore{}, nil
+	}
+
+	entities := make([]datastore.TestRecord4Datastore, len(keys))
+	err := client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.TestRecord4Datastore, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.TestRecord4Datastore, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.TestRecord4Datastore entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *TestRecord4DatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.TestRecord4Datastore) ([]*dslib.Key, error) {
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// New entities get a generated ID
+	for _, obj := range objs {
+		if obj.Key == nil {
+			if err := d.assignID(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
+			}
+		} else if obj.Id != "" {
+			keys[i] = d.newKey(obj.Id)
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.TestRecord4Datastore entities by keys.
+func (d *TestRecord4DatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, keys)
+}
+
+// Query retrieves datastore.TestRecord4Datastore entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *TestRecord4DatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.TestRecord4Datastore, error) {
+	var entities []*datastore.TestRecord4Datastore
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each datastore.TestRecord4Datastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *TestRecord4DatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.TestRecord4Datastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.TestRecord4Datastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with TestRecord4FromTestRecord4Datastore
+// and calls fn with the resulting api.TestRecord4.
+func (d *TestRecord4DatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.TestRecord4, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.TestRecord4Datastore, cursor dslib.Cursor) error {
+		msg, err := datastore.TestRecord4FromTestRecord4Datastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// TestRecord4DatastoreFilterSchema lists the api.TestRecord4 fields that AIP-160
+// filters on TestRecord4Datastore entities may use, and their properties.
+var TestRecord4DatastoreFilterSchema = filtering.MustSchema((&api.TestRecord4{}).ProtoReflect().Descriptor(), map[string]string{
+	"id": "id",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.TestRecord4 fields (see TestRecord4DatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *TestRecord4DatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(TestRecord4DatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *TestRecord4DatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
+}
+
+// GetByID retrieves a datastore.TestRecord4Datastore entity by ID.
+// This is a convenience method that creates a key from the ID.
+// Returns (nil, nil) if the entity is not found.
+func (d *TestRecord4DatastoreDAL) GetByID(ctx context.Context, client *dslib.Client, id datastore.RecordID) (*datastore.TestRecord4Datastore, error) {
+	key := d.newKey(id)
+	return d.Get(ctx, client, key)
+}
+
+// DeleteByID removes a datastore.TestRecord4Datastore entity by ID.
+// This is a convenience method that creates a key from the ID.
+func (d *TestRecord4DatastoreDAL) DeleteByID(ctx context.Context, client *dslib.Client, id datastore.RecordID) error {
+	key := d.newKey(id)
+	return d.Delete(ctx, client, key)
+}
+
+// GetMultiByIDs retrieves multiple datastore.TestRecord4Datastore entities by IDs.
+// This is a convenience method that creates keys from the IDs.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *TestRecord4DatastoreDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []datastore.RecordID) ([]*datastore.TestRecord4Datastore, error) {
+	if len(ids) == 0 {
+		return []*datastore.TestRecord4Datastore{}, nil
+	}
+
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+
+	return d.GetMulti(ctx, client, keys)
+}
@@ -3,9 +3,16 @@ package dal
 
 import (
 	"context"
+	"time"
 
 	dslib "cloud.google.com/go/datastore"
+	"github.com/panyam/protoc-gen-dal/pkg/audit"
+	"github.com/panyam/protoc-gen-dal/pkg/cache"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	"github.com/panyam/protoc-gen-dal/pkg/tenant"
 	datastore "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"google.golang.org/api/iterator"
 )
 
 // UserDatastoreDAL provides database access helper methods for datastore.UserDatastore.
@@ -40,21 +47,31 @@ func (d *UserDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *UserDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *UserDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *UserDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *UserDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -74,8 +91,8 @@ func (d *UserDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *d
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -95,6 +112,21 @@ func (d *UserDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *d
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *UserDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.UserDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *UserDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserDatastore, error) {
@@ -172,8 +204,8 @@ func (d *UserDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, o
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -206,9 +238,10 @@ func (d *UserDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client
 
 // Query retrieves datastore.UserDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *UserDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserDatastore, error) {
 	var entities []*datastore.UserDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -221,9 +254,99 @@ func (d *UserDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *d
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.UserDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *UserDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.UserDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.UserDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with UserFromUserDatastore
+// and calls fn with the resulting api.User.
+func (d *UserDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.User, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.UserDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.UserFromUserDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// UserDatastoreFilterSchema lists the api.User fields that AIP-160
+// filters on UserDatastore entities may use, and their properties.
+var UserDatastoreFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"name":          "name",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.User fields (see UserDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *UserDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(UserDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *UserDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.UserDatastore entity by ID.
@@ -257,6 +380,223 @@ func (d *UserDatastoreDAL) GetMultiByIDs(ctx context.Context, client *dslib.Clie
 	return d.GetMulti(ctx, client, keys)
 }
 
+// UserDatastoreCachedDAL is a UserDatastoreDAL whose Get and GetMulti read through
+// Cache. Entities are cached as serialized api.User messages (see
+// UserFromUserDatastore), so properties that api.User lacks read back
+// from the cache as zero values.
+// Put, PutMulti, Delete and DeleteMulti invalidate the cached entities they
+// change and return the cache's error if that fails. Changes made elsewhere
+// (and writes racing with a read) are only seen once cached entities expire.
+// Other methods are the embedded UserDatastoreDAL's and bypass the cache.
+type UserDatastoreCachedDAL struct {
+	UserDatastoreDAL
+
+	// Cache stores the entities. Read errors fall back to Datastore.
+	Cache cache.Cache
+
+	// TTL is how long entities stay cached. Zero keeps them until evicted.
+	TTL time.Duration
+
+	// NegativeTTL is how long entities that were not found stay cached as
+	// not found. Zero does not cache missing entities.
+	NegativeTTL time.Duration
+
+	// KeyPrefix prefixes the cache keys. If empty, uses "UserDatastore".
+	KeyPrefix string
+}
+
+// NewUserDatastoreCachedDAL creates a new UserDatastoreCachedDAL caching entities in c.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewUserDatastoreCachedDAL(kind string, c cache.Cache) *UserDatastoreCachedDAL {
+	return &UserDatastoreCachedDAL{UserDatastoreDAL: UserDatastoreDAL{Kind: kind}, Cache: c}
+}
+
+// cacheKey returns the cache key of key, and key as UserDatastoreDAL stores it.
+func (d *UserDatastoreCachedDAL) cacheKey(ctx context.Context, key *dslib.Key) (string, *dslib.Key, error) {
+	prefix := d.KeyPrefix
+	if prefix == "" {
+		prefix = "UserDatastore"
+	}
+	return cache.Key(prefix, key.Encode()), key, nil
+}
+
+// cached returns the entity cached under cacheKey with the given key, and
+// whether cacheKey was cached. A cached not-found result is returned as (nil, true).
+func (d *UserDatastoreCachedDAL) cached(ctx context.Context, cacheKey string, key *dslib.Key) (*datastore.UserDatastore, bool) {
+	data, ok, err := d.Cache.Get(ctx, cacheKey)
+	if err != nil || !ok {
+		return nil, false
+	}
+	var msg api.User
+	found, err := cache.Decode(data, &msg)
+	if err != nil {
+		return nil, false
+	}
+	if !found {
+		return nil, true
+	}
+	entity, err := datastore.UserToUserDatastore(&msg, nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	entity.Key = key
+	return entity, true
+}
+
+// store caches entity under cacheKey, or a not-found result if entity is nil
+// and NegativeTTL is set. Entities that fail to convert are not cached.
+func (d *UserDatastoreCachedDAL) store(ctx context.Context, cacheKey string, entity *datastore.UserDatastore) {
+	if entity == nil {
+		if d.NegativeTTL > 0 {
+			data, _ := cache.Encode(nil)
+			d.Cache.Set(ctx, cacheKey, data, d.NegativeTTL)
+		}
+		return
+	}
+	msg, err := datastore.UserFromUserDatastore(nil, entity, nil)
+	if err != nil {
+		return
+	}
+	data, err := cache.Encode(msg)
+	if err != nil {
+		return
+	}
+	d.Cache.Set(ctx, cacheKey, data, d.TTL)
+}
+
+// invalidate removes the cached entities with the given keys.
+func (d *UserDatastoreCachedDAL) invalidate(ctx context.Context, keys ...*dslib.Key) error {
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKey, _, err := d.cacheKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		cacheKeys[i] = cacheKey
+	}
+	return d.Cache.Delete(ctx, cacheKeys...)
+}
+
+// Put saves a datastore.UserDatastore entity to Datastore and invalidates its cached copy.
+// Returns the key used to store the entity.
+func (d *UserDatastoreCachedDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserDatastore) (*dslib.Key, error) {
+	key, err := d.UserDatastoreDAL.Put(ctx, client, obj)
+	if err != nil {
+		return nil, err
+	}
+	return key, d.invalidate(ctx, key)
+}
+
+// PutChanged puts obj unless it has the same properties as old, invalidating
+// its cached copy only when it is written.
+func (d *UserDatastoreCachedDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		return d.UserDatastoreDAL.PutChanged(ctx, client, old, obj)
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// PutMulti saves multiple datastore.UserDatastore entities to Datastore and invalidates their cached copies.
+// Returns the keys used to store the entities.
+func (d *UserDatastoreCachedDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserDatastore) ([]*dslib.Key, error) {
+	keys, err := d.UserDatastoreDAL.PutMulti(ctx, client, objs)
+	if err != nil {
+		return nil, err
+	}
+	return keys, d.invalidate(ctx, keys...)
+}
+
+// Delete removes a datastore.UserDatastore entity by key and invalidates its cached copy.
+func (d *UserDatastoreCachedDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	if err := d.UserDatastoreDAL.Delete(ctx, client, key); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, key)
+}
+
+// DeleteMulti removes multiple datastore.UserDatastore entities by keys and invalidates their cached copies.
+func (d *UserDatastoreCachedDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if err := d.UserDatastoreDAL.DeleteMulti(ctx, client, keys); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, keys...)
+}
+
+// Get retrieves a datastore.UserDatastore entity by key, reading through the cache.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserDatastoreCachedDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserDatastore, error) {
+	cacheKey, scoped, err := d.cacheKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if entity, ok := d.cached(ctx, cacheKey, scoped); ok {
+		return entity, nil
+	}
+	entity, err := d.UserDatastoreDAL.Get(ctx, client, key)
+	if err != nil {
+		return nil, err
+	}
+	d.store(ctx, cacheKey, entity)
+	return entity, nil
+}
+
+// GetMulti retrieves multiple datastore.UserDatastore entities by keys, reading through
+// the cache and loading only the uncached entities from Datastore.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *UserDatastoreCachedDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserDatastore, error) {
+	result := make([]*datastore.UserDatastore, len(keys))
+	var missing []*dslib.Key
+	var missingAt []int
+	var missingKeys []string
+	for i, key := range keys {
+		cacheKey, scoped, err := d.cacheKey(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if entity, ok := d.cached(ctx, cacheKey, scoped); ok {
+			result[i] = entity
+			continue
+		}
+		missing = append(missing, key)
+		missingAt = append(missingAt, i)
+		missingKeys = append(missingKeys, cacheKey)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	loaded, err := d.UserDatastoreDAL.GetMulti(ctx, client, missing)
+	if err != nil {
+		return nil, err
+	}
+	for j, entity := range loaded {
+		result[missingAt[j]] = entity
+		d.store(ctx, missingKeys[j], entity)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a datastore.UserDatastore entity by ID, reading through the cache.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserDatastoreCachedDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserDatastore, error) {
+	return d.Get(ctx, client, d.newKey(id))
+}
+
+// DeleteByID removes a datastore.UserDatastore entity by ID and invalidates its cached copy.
+func (d *UserDatastoreCachedDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
+	return d.Delete(ctx, client, d.newKey(id))
+}
+
+// GetMultiByIDs retrieves multiple datastore.UserDatastore entities by IDs, reading through the cache.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *UserDatastoreCachedDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserDatastore, error) {
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+	return d.GetMulti(ctx, client, keys)
+}
+
 // UserWithNamespaceDAL provides database access helper methods for datastore.UserWithNamespace.
 type UserWithNamespaceDAL struct {
 	// Kind overrides the Datastore kind for all operations.
@@ -264,7 +604,7 @@ type UserWithNamespaceDAL struct {
 	Kind string
 
 	// Namespace overrides the Datastore namespace for all operations.
-	// If empty, uses the default namespace.
+	// If empty, uses the "production" namespace of the entity.
 	Namespace string
 
 	// WillPut hook is called before Put operations.
@@ -289,21 +629,34 @@ func (d *UserWithNamespaceDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set, otherwise the entity's namespace option.
+func (d *UserWithNamespaceDAL) getNamespace() string {
+	if d.Namespace != "" {
+		return d.Namespace
+	}
+	return "production"
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *UserWithNamespaceDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *UserWithNamespaceDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *UserWithNamespaceDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -323,8 +676,8 @@ func (d *UserWithNamespaceDAL) Put(ctx context.Context, client *dslib.Client, ob
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -344,6 +697,21 @@ func (d *UserWithNamespaceDAL) Put(ctx context.Context, client *dslib.Client, ob
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *UserWithNamespaceDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserWithNamespace) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.UserWithNamespace entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *UserWithNamespaceDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserWithNamespace, error) {
@@ -421,8 +789,8 @@ func (d *UserWithNamespaceDAL) PutMulti(ctx context.Context, client *dslib.Clien
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -455,9 +823,10 @@ func (d *UserWithNamespaceDAL) DeleteMulti(ctx context.Context, client *dslib.Cl
 
 // Query retrieves datastore.UserWithNamespace entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *UserWithNamespaceDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserWithNamespace, error) {
 	var entities []*datastore.UserWithNamespace
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -470,9 +839,100 @@ func (d *UserWithNamespaceDAL) Query(ctx context.Context, client *dslib.Client,
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.UserWithNamespace entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *UserWithNamespaceDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.UserWithNamespace, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.UserWithNamespace
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with UserFromUserWithNamespace
+// and calls fn with the resulting api.User.
+func (d *UserWithNamespaceDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.User, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.UserWithNamespace, cursor dslib.Cursor) error {
+		msg, err := datastore.UserFromUserWithNamespace(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// UserWithNamespaceFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithNamespace entities may use, and their properties.
+var UserWithNamespaceFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.User fields (see UserWithNamespaceFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *UserWithNamespaceDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(UserWithNamespaceFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *UserWithNamespaceDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.UserWithNamespace entity by ID.
@@ -506,60 +966,94 @@ func (d *UserWithNamespaceDAL) GetMultiByIDs(ctx context.Context, client *dslib.
 	return d.GetMulti(ctx, client, keys)
 }
 
-// UserWithLargeTextDAL provides database access helper methods for datastore.UserWithLargeText.
-type UserWithLargeTextDAL struct {
+// UserPerTenantDAL provides database access helper methods for datastore.UserPerTenant.
+type UserPerTenantDAL struct {
 	// Kind overrides the Datastore kind for all operations.
 	// If empty, uses the struct's Kind() method (if any).
 	Kind string
 
 	// Namespace overrides the Datastore namespace for all operations.
 	// If empty, uses the default namespace.
+	// Ignored: every operation runs in the namespace of its context's tenant.
 	Namespace string
 
 	// WillPut hook is called before Put operations.
 	// Return an error to prevent the put.
-	WillPut func(context.Context, *datastore.UserWithLargeText) error
+	WillPut func(context.Context, *datastore.UserPerTenant) error
+
+	// TenantExtractor reads the tenant of each operation from its context.
+	// If nil, uses tenant.DefaultExtractor.
+	TenantExtractor tenant.Extractor
 }
 
-// NewUserWithLargeTextDAL creates a new UserWithLargeTextDAL instance.
+// NewUserPerTenantDAL creates a new UserPerTenantDAL instance.
 // If kind is empty, operations will use the struct's Kind() method.
-func NewUserWithLargeTextDAL(kind string) *UserWithLargeTextDAL {
-	return &UserWithLargeTextDAL{Kind: kind}
+func NewUserPerTenantDAL(kind string) *UserPerTenantDAL {
+	return &UserPerTenantDAL{Kind: kind}
 }
 
 // getKind returns the kind to use for operations.
 // Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
-func (d *UserWithLargeTextDAL) getKind() string {
+func (d *UserPerTenantDAL) getKind() string {
 	if d.Kind != "" {
 		return d.Kind
 	}
 	// Fall back to struct's Kind() method
-	var entity datastore.UserWithLargeText
+	var entity datastore.UserPerTenant
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *UserPerTenantDAL) getNamespace() string {
+	return d.Namespace
+}
+
 // newKey creates a new Datastore key for the given ID.
-func (d *UserWithLargeTextDAL) newKey(id string) *dslib.Key {
+func (d *UserPerTenantDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
-func (d *UserWithLargeTextDAL) newIncompleteKey() *dslib.Key {
+func (d *UserPerTenantDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
-// Put saves a datastore.UserWithLargeText entity to Datastore.
+// tenantKey returns a copy of key, and of its ancestors, in the tenant's namespace.
+// Keys are copied so that callers' keys are never moved between tenants.
+func (d *UserPerTenantDAL) tenantKey(tenantID string, key *dslib.Key) *dslib.Key {
+	if key == nil {
+		return nil
+	}
+	scoped := *key
+	scoped.Namespace = tenantID
+	scoped.Parent = d.tenantKey(tenantID, key.Parent)
+	return &scoped
+}
+
+// tenantKeys returns copies of keys in the tenant's namespace.
+func (d *UserPerTenantDAL) tenantKeys(tenantID string, keys []*dslib.Key) []*dslib.Key {
+	scoped := make([]*dslib.Key, len(keys))
+	for i, key := range keys {
+		scoped[i] = d.tenantKey(tenantID, key)
+	}
+	return scoped
+}
+
+// Put saves a datastore.UserPerTenant entity to Datastore.
 // If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// The key is placed in the namespace of the context's tenant.
 // Returns the key used to store the entity.
-func (d *UserWithLargeTextDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserWithLargeText) (*dslib.Key, error) {
+func (d *UserPerTenantDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserPerTenant) (*dslib.Key, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
 	// Call WillPut hook if set
 	if d.WillPut != nil {
 		if err := d.WillPut(ctx, obj); err != nil {
@@ -572,14 +1066,15 @@ func (d *UserWithLargeTextDAL) Put(ctx context.Context, client *dslib.Client, ob
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
 	} else {
 		key = d.newIncompleteKey()
 	}
+	key = d.tenantKey(tenantID, key)
 
 	// Put the entity
 	resultKey, err := client.Put(ctx, key, obj)
@@ -593,10 +1088,1564 @@ func (d *UserWithLargeTextDAL) Put(ctx context.Context, client *dslib.Client, ob
 	return resultKey, nil
 }
 
-// Get retrieves a datastore.UserWithLargeText entity by key.
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *UserPerTenantDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserPerTenant) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.UserPerTenant entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserPerTenantDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserPerTenant, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+	key = d.tenantKey(tenantID, key)
+
+	var entity datastore.UserPerTenant
+	err = client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.UserPerTenant entity by key.
+func (d *UserPerTenantDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+	key = d.tenantKey(tenantID, key)
+
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.UserPerTenant entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *UserPerTenantDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserPerTenant, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return []*datastore.UserPerTenant{}, nil
+	}
+	keys = d.tenantKeys(tenantID, keys)
+
+	entities := make([]datastore.UserPerTenant, len(keys))
+	err = client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.UserPerTenant, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.UserPerTenant, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.UserPerTenant entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *UserPerTenantDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserPerTenant) ([]*dslib.Key, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
+			}
+		} else if obj.Id != "" {
+			keys[i] = d.newKey(obj.Id)
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+		keys[i] = d.tenantKey(tenantID, keys[i])
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.UserPerTenant entities by keys.
+func (d *UserPerTenantDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, d.tenantKeys(tenantID, keys))
+}
+
+// Query retrieves datastore.UserPerTenant entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the namespace of the context's tenant.
+func (d *UserPerTenantDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserPerTenant, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []*datastore.UserPerTenant
+	keys, err := client.GetAll(ctx, q.Namespace(tenantID), &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each datastore.UserPerTenant entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *UserPerTenantDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.UserPerTenant, dslib.Cursor) error) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+
+	it := client.Run(ctx, q.Namespace(tenantID))
+	for {
+		var entity datastore.UserPerTenant
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with UserFromUserPerTenant
+// and calls fn with the resulting api.User.
+func (d *UserPerTenantDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.User, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.UserPerTenant, cursor dslib.Cursor) error {
+		msg, err := datastore.UserFromUserPerTenant(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// UserPerTenantFilterSchema lists the api.User fields that AIP-160
+// filters on UserPerTenant entities may use, and their properties.
+var UserPerTenantFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.User fields (see UserPerTenantFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *UserPerTenantDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(UserPerTenantFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *UserPerTenantDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return 0, err
+	}
+	return client.Count(ctx, q.Namespace(tenantID))
+}
+
+// GetByID retrieves a datastore.UserPerTenant entity by ID.
+// This is a convenience method that creates a key from the ID.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserPerTenantDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserPerTenant, error) {
+	key := d.newKey(id)
+	return d.Get(ctx, client, key)
+}
+
+// DeleteByID removes a datastore.UserPerTenant entity by ID.
+// This is a convenience method that creates a key from the ID.
+func (d *UserPerTenantDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
+	key := d.newKey(id)
+	return d.Delete(ctx, client, key)
+}
+
+// GetMultiByIDs retrieves multiple datastore.UserPerTenant entities by IDs.
+// This is a convenience method that creates keys from the IDs.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *UserPerTenantDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserPerTenant, error) {
+	if len(ids) == 0 {
+		return []*datastore.UserPerTenant{}, nil
+	}
+
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+
+	return d.GetMulti(ctx, client, keys)
+}
+
+// NoteDatastoreDAL provides database access helper methods for datastore.NoteDatastore.
+type NoteDatastoreDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.NoteDatastore) error
+
+	// ActorExtractor reads the actor of each write from its context for the
+	// audit columns. If nil, uses audit.DefaultExtractor.
+	ActorExtractor audit.Extractor
+
+	// Clock returns the time of each write for the audit columns.
+	// If nil, uses audit.DefaultClock.
+	Clock audit.Clock
+}
+
+// NewNoteDatastoreDAL creates a new NoteDatastoreDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewNoteDatastoreDAL(kind string) *NoteDatastoreDAL {
+	return &NoteDatastoreDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *NoteDatastoreDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.NoteDatastore
+	return entity.Kind()
+}
+
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *NoteDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *NoteDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
+// newKey creates a new Datastore key for the given ID.
+// Integer IDs are numeric key IDs.
+func (d *NoteDatastoreDAL) newKey(id uint32) *dslib.Key {
+	key := dslib.IDKey(d.getKind(), int64(id), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *NoteDatastoreDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// stampAudit fills the audit columns of obj before a write.
+// The created_* columns are only set when creating obj.
+func (d *NoteDatastoreDAL) stampAudit(ctx context.Context, obj *datastore.NoteDatastore, creating bool) {
+	now := audit.Now(d.Clock)
+	actor := audit.Actor(ctx, d.ActorExtractor)
+	if creating {
+		obj.CreatedAt = now
+		obj.CreatedBy = actor
+	}
+	obj.UpdatedAt = now
+	obj.UpdatedBy = actor
+}
+
+// Put saves a datastore.NoteDatastore entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// A new entity with an empty ID gets one allocated by Datastore, set in obj.Id.
+// Put does not read the stored entity, so an entity without a creation
+// audit is treated as new and gets one.
+// Returns the key used to store the entity.
+func (d *NoteDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.NoteDatastore) (*dslib.Key, error) {
+	d.stampAudit(ctx, obj, obj.CreatedAt.IsZero())
+
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
+		}
+	} else if obj.Id != 0 {
+		key = d.newKey(obj.Id)
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+	if obj.Id == 0 {
+		obj.Id = uint32(resultKey.ID)
+	}
+
+	return resultKey, nil
+}
+
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *NoteDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.NoteDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.NoteDatastore entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *NoteDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.NoteDatastore, error) {
+	var entity datastore.NoteDatastore
+	err := client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.NoteDatastore entity by key.
+func (d *NoteDatastoreDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.NoteDatastore entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *NoteDatastoreDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.NoteDatastore, error) {
+	if len(keys) == 0 {
+		return []*datastore.NoteDatastore{}, nil
+	}
+
+	entities := make([]datastore.NoteDatastore, len(keys))
+	err := client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.NoteDatastore, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.NoteDatastore, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.NoteDatastore entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *NoteDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.NoteDatastore) ([]*dslib.Key, error) {
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// Fill the audit columns, treating entities without a creation audit as new
+	for _, obj := range objs {
+		d.stampAudit(ctx, obj, obj.CreatedAt.IsZero())
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
+			}
+		} else if obj.Id != 0 {
+			keys[i] = d.newKey(obj.Id)
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+		if objs[i].Id == 0 {
+			objs[i].Id = uint32(key.ID)
+		}
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.NoteDatastore entities by keys.
+func (d *NoteDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, keys)
+}
+
+// Query retrieves datastore.NoteDatastore entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *NoteDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.NoteDatastore, error) {
+	var entities []*datastore.NoteDatastore
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each datastore.NoteDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *NoteDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.NoteDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.NoteDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with NoteFromNoteDatastore
+// and calls fn with the resulting api.Note.
+func (d *NoteDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Note, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.NoteDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.NoteFromNoteDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// NoteDatastoreFilterSchema lists the api.Note fields that AIP-160
+// filters on NoteDatastore entities may use, and their properties.
+var NoteDatastoreFilterSchema = filtering.MustSchema((&api.Note{}).ProtoReflect().Descriptor(), map[string]string{
+	"text":       "text",
+	"created_by": "created_by",
+	"updated_by": "updated_by",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Note fields (see NoteDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *NoteDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(NoteDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *NoteDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
+}
+
+// GetByID retrieves a datastore.NoteDatastore entity by ID.
+// This is a convenience method that creates a key from the ID.
+// Returns (nil, nil) if the entity is not found.
+func (d *NoteDatastoreDAL) GetByID(ctx context.Context, client *dslib.Client, id uint32) (*datastore.NoteDatastore, error) {
+	key := d.newKey(id)
+	return d.Get(ctx, client, key)
+}
+
+// DeleteByID removes a datastore.NoteDatastore entity by ID.
+// This is a convenience method that creates a key from the ID.
+func (d *NoteDatastoreDAL) DeleteByID(ctx context.Context, client *dslib.Client, id uint32) error {
+	key := d.newKey(id)
+	return d.Delete(ctx, client, key)
+}
+
+// GetMultiByIDs retrieves multiple datastore.NoteDatastore entities by IDs.
+// This is a convenience method that creates keys from the IDs.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *NoteDatastoreDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []uint32) ([]*datastore.NoteDatastore, error) {
+	if len(ids) == 0 {
+		return []*datastore.NoteDatastore{}, nil
+	}
+
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+
+	return d.GetMulti(ctx, client, keys)
+}
+
+// UserWithLargeTextDAL provides database access helper methods for datastore.UserWithLargeText.
+type UserWithLargeTextDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.UserWithLargeText) error
+}
+
+// NewUserWithLargeTextDAL creates a new UserWithLargeTextDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewUserWithLargeTextDAL(kind string) *UserWithLargeTextDAL {
+	return &UserWithLargeTextDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *UserWithLargeTextDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.UserWithLargeText
+	return entity.Kind()
+}
+
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *UserWithLargeTextDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *UserWithLargeTextDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
+// newKey creates a new Datastore key for the given ID.
+func (d *UserWithLargeTextDAL) newKey(id string) *dslib.Key {
+	key := dslib.NameKey(d.getKind(), id, nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *UserWithLargeTextDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// Put saves a datastore.UserWithLargeText entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// Returns the key used to store the entity.
+func (d *UserWithLargeTextDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserWithLargeText) (*dslib.Key, error) {
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
+		}
+	} else if obj.Id != "" {
+		key = d.newKey(obj.Id)
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+
+	return resultKey, nil
+}
+
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *UserWithLargeTextDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserWithLargeText) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.UserWithLargeText entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserWithLargeTextDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserWithLargeText, error) {
+	var entity datastore.UserWithLargeText
+	err := client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.UserWithLargeText entity by key.
+func (d *UserWithLargeTextDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.UserWithLargeText entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *UserWithLargeTextDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserWithLargeText, error) {
+	if len(keys) == 0 {
+		return []*datastore.UserWithLargeText{}, nil
+	}
+
+	entities := make([]datastore.UserWithLargeText, len(keys))
+	err := client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.UserWithLargeText, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.UserWithLargeText, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.UserWithLargeText entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *UserWithLargeTextDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserWithLargeText) ([]*dslib.Key, error) {
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
+			}
+		} else if obj.Id != "" {
+			keys[i] = d.newKey(obj.Id)
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.UserWithLargeText entities by keys.
+func (d *UserWithLargeTextDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, keys)
+}
+
+// Query retrieves datastore.UserWithLargeText entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *UserWithLargeTextDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserWithLargeText, error) {
+	var entities []*datastore.UserWithLargeText
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each datastore.UserWithLargeText entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *UserWithLargeTextDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.UserWithLargeText, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.UserWithLargeText
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with UserFromUserWithLargeText
+// and calls fn with the resulting api.User.
+func (d *UserWithLargeTextDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.User, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.UserWithLargeText, cursor dslib.Cursor) error {
+		msg, err := datastore.UserFromUserWithLargeText(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// UserWithLargeTextFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithLargeText entities may use, and their properties.
+var UserWithLargeTextFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"name":          "name",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.User fields (see UserWithLargeTextFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *UserWithLargeTextDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(UserWithLargeTextFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *UserWithLargeTextDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
+}
+
+// GetByID retrieves a datastore.UserWithLargeText entity by ID.
+// This is a convenience method that creates a key from the ID.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserWithLargeTextDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserWithLargeText, error) {
+	key := d.newKey(id)
+	return d.Get(ctx, client, key)
+}
+
+// DeleteByID removes a datastore.UserWithLargeText entity by ID.
+// This is a convenience method that creates a key from the ID.
+func (d *UserWithLargeTextDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
+	key := d.newKey(id)
+	return d.Delete(ctx, client, key)
+}
+
+// GetMultiByIDs retrieves multiple datastore.UserWithLargeText entities by IDs.
+// This is a convenience method that creates keys from the IDs.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *UserWithLargeTextDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserWithLargeText, error) {
+	if len(ids) == 0 {
+		return []*datastore.UserWithLargeText{}, nil
+	}
+
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+
+	return d.GetMulti(ctx, client, keys)
+}
+
+// UserSimpleDAL provides database access helper methods for datastore.UserSimple.
+type UserSimpleDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.UserSimple) error
+}
+
+// NewUserSimpleDAL creates a new UserSimpleDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewUserSimpleDAL(kind string) *UserSimpleDAL {
+	return &UserSimpleDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *UserSimpleDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.UserSimple
+	return entity.Kind()
+}
+
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *UserSimpleDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *UserSimpleDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
+// newKey creates a new Datastore key for the given ID.
+func (d *UserSimpleDAL) newKey(id string) *dslib.Key {
+	key := dslib.NameKey(d.getKind(), id, nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *UserSimpleDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// Put saves a datastore.UserSimple entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// Returns the key used to store the entity.
+func (d *UserSimpleDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserSimple) (*dslib.Key, error) {
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
+		}
+	} else if obj.Id != "" {
+		key = d.newKey(obj.Id)
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+
+	return resultKey, nil
+}
+
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *UserSimpleDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.UserSimple) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.UserSimple entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserSimpleDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserSimple, error) {
+	var entity datastore.UserSimple
+	err := client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.UserSimple entity by key.
+func (d *UserSimpleDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.UserSimple entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *UserSimpleDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserSimple, error) {
+	if len(keys) == 0 {
+		return []*datastore.UserSimple{}, nil
+	}
+
+	entities := make([]datastore.UserSimple, len(keys))
+	err := client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.UserSimple, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.UserSimple, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.UserSimple entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *UserSimpleDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserSimple) ([]*dslib.Key, error) {
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
+			}
+		} else if obj.Id != "" {
+			keys[i] = d.newKey(obj.Id)
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.UserSimple entities by keys.
+func (d *UserSimpleDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, keys)
+}
+
+// Query retrieves datastore.UserSimple entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *UserSimpleDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserSimple, error) {
+	var entities []*datastore.UserSimple
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each datastore.UserSimple entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *UserSimpleDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.UserSimple, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.UserSimple
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with UserFromUserSimple
+// and calls fn with the resulting api.User.
+func (d *UserSimpleDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.User, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.UserSimple, cursor dslib.Cursor) error {
+		msg, err := datastore.UserFromUserSimple(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// UserSimpleFilterSchema lists the api.User fields that AIP-160
+// filters on UserSimple entities may use, and their properties.
+var UserSimpleFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.User fields (see UserSimpleFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *UserSimpleDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(UserSimpleFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *UserSimpleDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
+}
+
+// GetByID retrieves a datastore.UserSimple entity by ID.
+// This is a convenience method that creates a key from the ID.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserSimpleDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserSimple, error) {
+	key := d.newKey(id)
+	return d.Get(ctx, client, key)
+}
+
+// DeleteByID removes a datastore.UserSimple entity by ID.
+// This is a convenience method that creates a key from the ID.
+func (d *UserSimpleDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
+	key := d.newKey(id)
+	return d.Delete(ctx, client, key)
+}
+
+// GetMultiByIDs retrieves multiple datastore.UserSimple entities by IDs.
+// This is a convenience method that creates keys from the IDs.
+// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
+func (d *UserSimpleDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserSimple, error) {
+	if len(ids) == 0 {
+		return []*datastore.UserSimple{}, nil
+	}
+
+	keys := make([]*dslib.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = d.newKey(id)
+	}
+
+	return d.GetMulti(ctx, client, keys)
+}
+
+// BlogDatastoreDAL provides database access helper methods for datastore.BlogDatastore.
+type BlogDatastoreDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.BlogDatastore) error
+}
+
+// NewBlogDatastoreDAL creates a new BlogDatastoreDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewBlogDatastoreDAL(kind string) *BlogDatastoreDAL {
+	return &BlogDatastoreDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *BlogDatastoreDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.BlogDatastore
+	return entity.Kind()
+}
+
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *BlogDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *BlogDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
+// newKey creates a new Datastore key for the given ID.
+func (d *BlogDatastoreDAL) newKey(id string) *dslib.Key {
+	key := dslib.NameKey(d.getKind(), id, nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *BlogDatastoreDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	key.Namespace = d.getNamespace()
+	return key
+}
+
+// Put saves a datastore.BlogDatastore entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// Returns the key used to store the entity.
+func (d *BlogDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.BlogDatastore) (*dslib.Key, error) {
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
+		}
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+
+	return resultKey, nil
+}
+
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *BlogDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.BlogDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.BlogDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
-func (d *UserWithLargeTextDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserWithLargeText, error) {
-	var entity datastore.UserWithLargeText
+func (d *BlogDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.BlogDatastore, error) {
+	var entity datastore.BlogDatastore
 	err := client.Get(ctx, key, &entity)
 	if err != nil {
 		if err == dslib.ErrNoSuchEntity {
@@ -608,24 +2657,24 @@ func (d *UserWithLargeTextDAL) Get(ctx context.Context, client *dslib.Client, ke
 	return &entity, nil
 }
 
-// Delete removes a datastore.UserWithLargeText entity by key.
-func (d *UserWithLargeTextDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+// Delete removes a datastore.BlogDatastore entity by key.
+func (d *BlogDatastoreDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
 	return client.Delete(ctx, key)
 }
 
-// GetMulti retrieves multiple datastore.UserWithLargeText entities by keys.
+// GetMulti retrieves multiple datastore.BlogDatastore entities by keys.
 // Returns entities in the same order as the keys. Missing entities are nil in the result slice.
-func (d *UserWithLargeTextDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserWithLargeText, error) {
+func (d *BlogDatastoreDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.BlogDatastore, error) {
 	if len(keys) == 0 {
-		return []*datastore.UserWithLargeText{}, nil
+		return []*datastore.BlogDatastore{}, nil
 	}
 
-	entities := make([]datastore.UserWithLargeText, len(keys))
+	entities := make([]datastore.BlogDatastore, len(keys))
 	err := client.GetMulti(ctx, keys, entities)
 	if err != nil {
 		// Handle partial errors (some entities not found)
 		if multiErr, ok := err.(dslib.MultiError); ok {
-			result := make([]*datastore.UserWithLargeText, len(keys))
+			result := make([]*datastore.BlogDatastore, len(keys))
 			for i, e := range multiErr {
 				if e == nil {
 					entities[i].Key = keys[i]
@@ -641,7 +2690,7 @@ func (d *UserWithLargeTextDAL) GetMulti(ctx context.Context, client *dslib.Clien
 	}
 
 	// All entities found
-	result := make([]*datastore.UserWithLargeText, len(keys))
+	result := make([]*datastore.BlogDatastore, len(keys))
 	for i := range entities {
 		entities[i].Key = keys[i]
 		result[i] = &entities[i]
@@ -649,9 +2698,9 @@ func (d *UserWithLargeTextDAL) GetMulti(ctx context.Context, client *dslib.Clien
 	return result, nil
 }
 
-// PutMulti saves multiple datastore.UserWithLargeText entities to Datastore.
+// PutMulti saves multiple datastore.BlogDatastore entities to Datastore.
 // Returns the keys used to store the entities.
-func (d *UserWithLargeTextDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserWithLargeText) ([]*dslib.Key, error) {
+func (d *BlogDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.BlogDatastore) ([]*dslib.Key, error) {
 	if len(objs) == 0 {
 		return []*dslib.Key{}, nil
 	}
@@ -670,11 +2719,9 @@ func (d *UserWithLargeTextDAL) PutMulti(ctx context.Context, client *dslib.Clien
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
-		} else if obj.Id != "" {
-			keys[i] = d.newKey(obj.Id)
 		} else {
 			keys[i] = d.newIncompleteKey()
 		}
@@ -694,19 +2741,20 @@ func (d *UserWithLargeTextDAL) PutMulti(ctx context.Context, client *dslib.Clien
 	return resultKeys, nil
 }
 
-// DeleteMulti removes multiple datastore.UserWithLargeText entities by keys.
-func (d *UserWithLargeTextDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+// DeleteMulti removes multiple datastore.BlogDatastore entities by keys.
+func (d *BlogDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
 	if len(keys) == 0 {
 		return nil
 	}
 	return client.DeleteMulti(ctx, keys)
 }
 
-// Query retrieves datastore.UserWithLargeText entities matching the query.
+// Query retrieves datastore.BlogDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
-func (d *UserWithLargeTextDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserWithLargeText, error) {
-	var entities []*datastore.UserWithLargeText
-	keys, err := client.GetAll(ctx, q, &entities)
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *BlogDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.BlogDatastore, error) {
+	var entities []*datastore.BlogDatastore
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -719,44 +2767,99 @@ func (d *UserWithLargeTextDAL) Query(ctx context.Context, client *dslib.Client,
 	return entities, nil
 }
 
-// Count returns the number of entities matching the query.
-func (d *UserWithLargeTextDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
-}
+// Iterate calls fn for each datastore.BlogDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *BlogDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.BlogDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.BlogDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
 
-// GetByID retrieves a datastore.UserWithLargeText entity by ID.
-// This is a convenience method that creates a key from the ID.
-// Returns (nil, nil) if the entity is not found.
-func (d *UserWithLargeTextDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserWithLargeText, error) {
-	key := d.newKey(id)
-	return d.Get(ctx, client, key)
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
 }
 
-// DeleteByID removes a datastore.UserWithLargeText entity by ID.
-// This is a convenience method that creates a key from the ID.
-func (d *UserWithLargeTextDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
-	key := d.newKey(id)
-	return d.Delete(ctx, client, key)
+// IterateAPI is like Iterate but converts each entity with BlogFromBlogDatastore
+// and calls fn with the resulting api.Blog.
+func (d *BlogDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Blog, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.BlogDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.BlogFromBlogDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
 }
 
-// GetMultiByIDs retrieves multiple datastore.UserWithLargeText entities by IDs.
-// This is a convenience method that creates keys from the IDs.
-// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
-func (d *UserWithLargeTextDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserWithLargeText, error) {
-	if len(ids) == 0 {
-		return []*datastore.UserWithLargeText{}, nil
+// BlogDatastoreFilterSchema lists the api.Blog fields that AIP-160
+// filters on BlogDatastore entities may use, and their properties.
+var BlogDatastoreFilterSchema = filtering.MustSchema((&api.Blog{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":      "id",
+	"upvotes": "upvotes",
+	"title":   "title",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Blog fields (see BlogDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *BlogDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(BlogDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
 	}
-
-	keys := make([]*dslib.Key, len(ids))
-	for i, id := range ids {
-		keys[i] = d.newKey(id)
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
 	}
+	return q.FilterEntity(cond), nil
+}
 
-	return d.GetMulti(ctx, client, keys)
+// Count returns the number of entities matching the query.
+func (d *BlogDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
 }
 
-// UserSimpleDAL provides database access helper methods for datastore.UserSimple.
-type UserSimpleDAL struct {
+// BlogJsonDatastoreDAL provides database access helper methods for datastore.BlogJsonDatastore.
+type BlogJsonDatastoreDAL struct {
 	// Kind overrides the Datastore kind for all operations.
 	// If empty, uses the struct's Kind() method (if any).
 	Kind string
@@ -767,48 +2870,58 @@ type UserSimpleDAL struct {
 
 	// WillPut hook is called before Put operations.
 	// Return an error to prevent the put.
-	WillPut func(context.Context, *datastore.UserSimple) error
+	WillPut func(context.Context, *datastore.BlogJsonDatastore) error
 }
 
-// NewUserSimpleDAL creates a new UserSimpleDAL instance.
+// NewBlogJsonDatastoreDAL creates a new BlogJsonDatastoreDAL instance.
 // If kind is empty, operations will use the struct's Kind() method.
-func NewUserSimpleDAL(kind string) *UserSimpleDAL {
-	return &UserSimpleDAL{Kind: kind}
+func NewBlogJsonDatastoreDAL(kind string) *BlogJsonDatastoreDAL {
+	return &BlogJsonDatastoreDAL{Kind: kind}
 }
 
 // getKind returns the kind to use for operations.
 // Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
-func (d *UserSimpleDAL) getKind() string {
+func (d *BlogJsonDatastoreDAL) getKind() string {
 	if d.Kind != "" {
 		return d.Kind
 	}
 	// Fall back to struct's Kind() method
-	var entity datastore.UserSimple
+	var entity datastore.BlogJsonDatastore
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *BlogJsonDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *BlogJsonDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
-func (d *UserSimpleDAL) newKey(id string) *dslib.Key {
+func (d *BlogJsonDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
-func (d *UserSimpleDAL) newIncompleteKey() *dslib.Key {
+func (d *BlogJsonDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
-// Put saves a datastore.UserSimple entity to Datastore.
+// Put saves a datastore.BlogJsonDatastore entity to Datastore.
 // If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
 // Returns the key used to store the entity.
-func (d *UserSimpleDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserSimple) (*dslib.Key, error) {
+func (d *BlogJsonDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.BlogJsonDatastore) (*dslib.Key, error) {
 	// Call WillPut hook if set
 	if d.WillPut != nil {
 		if err := d.WillPut(ctx, obj); err != nil {
@@ -821,11 +2934,9 @@ func (d *UserSimpleDAL) Put(ctx context.Context, client *dslib.Client, obj *data
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
-	} else if obj.Id != "" {
-		key = d.newKey(obj.Id)
 	} else {
 		key = d.newIncompleteKey()
 	}
@@ -842,10 +2953,25 @@ func (d *UserSimpleDAL) Put(ctx context.Context, client *dslib.Client, obj *data
 	return resultKey, nil
 }
 
-// Get retrieves a datastore.UserSimple entity by key.
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *BlogJsonDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.BlogJsonDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
+// Get retrieves a datastore.BlogJsonDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
-func (d *UserSimpleDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserSimple, error) {
-	var entity datastore.UserSimple
+func (d *BlogJsonDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.BlogJsonDatastore, error) {
+	var entity datastore.BlogJsonDatastore
 	err := client.Get(ctx, key, &entity)
 	if err != nil {
 		if err == dslib.ErrNoSuchEntity {
@@ -857,24 +2983,24 @@ func (d *UserSimpleDAL) Get(ctx context.Context, client *dslib.Client, key *dsli
 	return &entity, nil
 }
 
-// Delete removes a datastore.UserSimple entity by key.
-func (d *UserSimpleDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+// Delete removes a datastore.BlogJsonDatastore entity by key.
+func (d *BlogJsonDatastoreDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
 	return client.Delete(ctx, key)
 }
 
-// GetMulti retrieves multiple datastore.UserSimple entities by keys.
+// GetMulti retrieves multiple datastore.BlogJsonDatastore entities by keys.
 // Returns entities in the same order as the keys. Missing entities are nil in the result slice.
-func (d *UserSimpleDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserSimple, error) {
+func (d *BlogJsonDatastoreDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.BlogJsonDatastore, error) {
 	if len(keys) == 0 {
-		return []*datastore.UserSimple{}, nil
+		return []*datastore.BlogJsonDatastore{}, nil
 	}
 
-	entities := make([]datastore.UserSimple, len(keys))
+	entities := make([]datastore.BlogJsonDatastore, len(keys))
 	err := client.GetMulti(ctx, keys, entities)
 	if err != nil {
 		// Handle partial errors (some entities not found)
 		if multiErr, ok := err.(dslib.MultiError); ok {
-			result := make([]*datastore.UserSimple, len(keys))
+			result := make([]*datastore.BlogJsonDatastore, len(keys))
 			for i, e := range multiErr {
 				if e == nil {
 					entities[i].Key = keys[i]
@@ -890,7 +3016,7 @@ func (d *UserSimpleDAL) GetMulti(ctx context.Context, client *dslib.Client, keys
 	}
 
 	// All entities found
-	result := make([]*datastore.UserSimple, len(keys))
+	result := make([]*datastore.BlogJsonDatastore, len(keys))
 	for i := range entities {
 		entities[i].Key = keys[i]
 		result[i] = &entities[i]
@@ -898,9 +3024,9 @@ func (d *UserSimpleDAL) GetMulti(ctx context.Context, client *dslib.Client, keys
 	return result, nil
 }
 
-// PutMulti saves multiple datastore.UserSimple entities to Datastore.
+// PutMulti saves multiple datastore.BlogJsonDatastore entities to Datastore.
 // Returns the keys used to store the entities.
-func (d *UserSimpleDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserSimple) ([]*dslib.Key, error) {
+func (d *BlogJsonDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.BlogJsonDatastore) ([]*dslib.Key, error) {
 	if len(objs) == 0 {
 		return []*dslib.Key{}, nil
 	}
@@ -919,11 +3045,9 @@ func (d *UserSimpleDAL) PutMulti(ctx context.Context, client *dslib.Client, objs
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
-		} else if obj.Id != "" {
-			keys[i] = d.newKey(obj.Id)
 		} else {
 			keys[i] = d.newIncompleteKey()
 		}
@@ -943,19 +3067,20 @@ func (d *UserSimpleDAL) PutMulti(ctx context.Context, client *dslib.Client, objs
 	return resultKeys, nil
 }
 
-// DeleteMulti removes multiple datastore.UserSimple entities by keys.
-func (d *UserSimpleDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+// DeleteMulti removes multiple datastore.BlogJsonDatastore entities by keys.
+func (d *BlogJsonDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
 	if len(keys) == 0 {
 		return nil
 	}
 	return client.DeleteMulti(ctx, keys)
 }
 
-// Query retrieves datastore.UserSimple entities matching the query.
+// Query retrieves datastore.BlogJsonDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
-func (d *UserSimpleDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserSimple, error) {
-	var entities []*datastore.UserSimple
-	keys, err := client.GetAll(ctx, q, &entities)
+// The query runs in the DAL's namespace, if any (see Namespace).
+func (d *BlogJsonDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.BlogJsonDatastore, error) {
+	var entities []*datastore.BlogJsonDatastore
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -968,40 +3093,95 @@ func (d *UserSimpleDAL) Query(ctx context.Context, client *dslib.Client, q *dsli
 	return entities, nil
 }
 
-// Count returns the number of entities matching the query.
-func (d *UserSimpleDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
-}
+// Iterate calls fn for each datastore.BlogJsonDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *BlogJsonDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.BlogJsonDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.BlogJsonDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
 
-// GetByID retrieves a datastore.UserSimple entity by ID.
-// This is a convenience method that creates a key from the ID.
-// Returns (nil, nil) if the entity is not found.
-func (d *UserSimpleDAL) GetByID(ctx context.Context, client *dslib.Client, id string) (*datastore.UserSimple, error) {
-	key := d.newKey(id)
-	return d.Get(ctx, client, key)
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
 }
 
-// DeleteByID removes a datastore.UserSimple entity by ID.
-// This is a convenience method that creates a key from the ID.
-func (d *UserSimpleDAL) DeleteByID(ctx context.Context, client *dslib.Client, id string) error {
-	key := d.newKey(id)
-	return d.Delete(ctx, client, key)
+// IterateAPI is like Iterate but converts each entity with BlogFromBlogJsonDatastore
+// and calls fn with the resulting api.Blog.
+func (d *BlogJsonDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Blog, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.BlogJsonDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.BlogFromBlogJsonDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
 }
 
-// GetMultiByIDs retrieves multiple datastore.UserSimple entities by IDs.
-// This is a convenience method that creates keys from the IDs.
-// Returns entities in the same order as the IDs. Missing entities are nil in the result slice.
-func (d *UserSimpleDAL) GetMultiByIDs(ctx context.Context, client *dslib.Client, ids []string) ([]*datastore.UserSimple, error) {
-	if len(ids) == 0 {
-		return []*datastore.UserSimple{}, nil
+// BlogJsonDatastoreFilterSchema lists the api.Blog fields that AIP-160
+// filters on BlogJsonDatastore entities may use, and their properties.
+var BlogJsonDatastoreFilterSchema = filtering.MustSchema((&api.Blog{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":      "id",
+	"upvotes": "upvotes",
+	"title":   "title",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Blog fields (see BlogJsonDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *BlogJsonDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(BlogJsonDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
 	}
-
-	keys := make([]*dslib.Key, len(ids))
-	for i, id := range ids {
-		keys[i] = d.newKey(id)
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
 	}
+	return q.FilterEntity(cond), nil
+}
 
-	return d.GetMulti(ctx, client, keys)
+// Count returns the number of entities matching the query.
+func (d *BlogJsonDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // ProductDatastoreDAL provides database access helper methods for datastore.ProductDatastore.
@@ -1036,21 +3216,31 @@ func (d *ProductDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *ProductDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *ProductDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *ProductDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *ProductDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -1070,8 +3260,8 @@ func (d *ProductDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -1091,6 +3281,21 @@ func (d *ProductDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *ProductDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.ProductDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.ProductDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *ProductDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.ProductDatastore, error) {
@@ -1168,8 +3373,8 @@ func (d *ProductDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -1202,9 +3407,10 @@ func (d *ProductDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Cli
 
 // Query retrieves datastore.ProductDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *ProductDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.ProductDatastore, error) {
 	var entities []*datastore.ProductDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -1217,9 +3423,93 @@ func (d *ProductDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.ProductDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *ProductDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.ProductDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.ProductDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with ProductFromProductDatastore
+// and calls fn with the resulting api.Product.
+func (d *ProductDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Product, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.ProductDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.ProductFromProductDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// ProductDatastoreFilterSchema lists the api.Product fields that AIP-160
+// filters on ProductDatastore entities may use, and their properties.
+var ProductDatastoreFilterSchema = filtering.MustSchema((&api.Product{}).ProtoReflect().Descriptor(), map[string]string{
+	"name": "name",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Product fields (see ProductDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *ProductDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(ProductDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *ProductDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.ProductDatastore entity by ID.
@@ -1285,21 +3575,31 @@ func (d *LibraryDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *LibraryDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *LibraryDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *LibraryDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *LibraryDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -1319,8 +3619,8 @@ func (d *LibraryDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -1340,6 +3640,21 @@ func (d *LibraryDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *LibraryDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.LibraryDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.LibraryDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *LibraryDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.LibraryDatastore, error) {
@@ -1417,8 +3732,8 @@ func (d *LibraryDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -1451,9 +3766,10 @@ func (d *LibraryDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Cli
 
 // Query retrieves datastore.LibraryDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *LibraryDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.LibraryDatastore, error) {
 	var entities []*datastore.LibraryDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -1466,9 +3782,93 @@ func (d *LibraryDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.LibraryDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *LibraryDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.LibraryDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.LibraryDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with LibraryFromLibraryDatastore
+// and calls fn with the resulting api.Library.
+func (d *LibraryDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Library, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.LibraryDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.LibraryFromLibraryDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// LibraryDatastoreFilterSchema lists the api.Library fields that AIP-160
+// filters on LibraryDatastore entities may use, and their properties.
+var LibraryDatastoreFilterSchema = filtering.MustSchema((&api.Library{}).ProtoReflect().Descriptor(), map[string]string{
+	"name": "name",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Library fields (see LibraryDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *LibraryDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(LibraryDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *LibraryDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.LibraryDatastore entity by ID.
@@ -1534,21 +3934,31 @@ func (d *OrganizationDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *OrganizationDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *OrganizationDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *OrganizationDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *OrganizationDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -1568,8 +3978,8 @@ func (d *OrganizationDatastoreDAL) Put(ctx context.Context, client *dslib.Client
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else if obj.Id != "" {
 		key = d.newKey(obj.Id)
@@ -1589,6 +3999,21 @@ func (d *OrganizationDatastoreDAL) Put(ctx context.Context, client *dslib.Client
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *OrganizationDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.OrganizationDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.OrganizationDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *OrganizationDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.OrganizationDatastore, error) {
@@ -1666,8 +4091,8 @@ func (d *OrganizationDatastoreDAL) PutMulti(ctx context.Context, client *dslib.C
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else if obj.Id != "" {
 			keys[i] = d.newKey(obj.Id)
@@ -1700,9 +4125,10 @@ func (d *OrganizationDatastoreDAL) DeleteMulti(ctx context.Context, client *dsli
 
 // Query retrieves datastore.OrganizationDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *OrganizationDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.OrganizationDatastore, error) {
 	var entities []*datastore.OrganizationDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -1715,9 +4141,93 @@ func (d *OrganizationDatastoreDAL) Query(ctx context.Context, client *dslib.Clie
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.OrganizationDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *OrganizationDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.OrganizationDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.OrganizationDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with OrganizationFromOrganizationDatastore
+// and calls fn with the resulting api.Organization.
+func (d *OrganizationDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*api.Organization, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.OrganizationDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.OrganizationFromOrganizationDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// OrganizationDatastoreFilterSchema lists the api.Organization fields that AIP-160
+// filters on OrganizationDatastore entities may use, and their properties.
+var OrganizationDatastoreFilterSchema = filtering.MustSchema((&api.Organization{}).ProtoReflect().Descriptor(), map[string]string{
+	"name": "name",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// api.Organization fields (see OrganizationDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *OrganizationDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(OrganizationDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *OrganizationDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GetByID retrieves a datastore.OrganizationDatastore entity by ID.
@@ -5,7 +5,10 @@ import (
 	"context"
 
 	dslib "cloud.google.com/go/datastore"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
 	datastore "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
+	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
+	"google.golang.org/api/iterator"
 )
 
 // WorldDatastoreDAL provides database access helper methods for datastore.WorldDatastore.
@@ -40,21 +43,31 @@ func (d *WorldDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *WorldDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *WorldDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *WorldDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *WorldDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -74,8 +87,8 @@ func (d *WorldDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -93,6 +106,21 @@ func (d *WorldDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *WorldDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.WorldDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.WorldDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *WorldDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.WorldDatastore, error) {
@@ -170,8 +198,8 @@ func (d *WorldDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client,
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -202,9 +230,10 @@ func (d *WorldDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Clien
 
 // Query retrieves datastore.WorldDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *WorldDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.WorldDatastore, error) {
 	var entities []*datastore.WorldDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +246,100 @@ func (d *WorldDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.WorldDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *WorldDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.WorldDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.WorldDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with WorldFromWorldDatastore
+// and calls fn with the resulting v1.World.
+func (d *WorldDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*v1.World, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.WorldDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.WorldFromWorldDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// WorldDatastoreFilterSchema lists the v1.World fields that AIP-160
+// filters on WorldDatastore entities may use, and their properties.
+var WorldDatastoreFilterSchema = filtering.MustSchema((&v1.World{}).ProtoReflect().Descriptor(), map[string]string{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"id":          "id",
+	"creator_id":  "creator_id",
+	"name":        "name",
+	"description": "description",
+	"image_url":   "image_url",
+	"difficulty":  "difficulty",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// v1.World fields (see WorldDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *WorldDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(WorldDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *WorldDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // WorldDataDatastoreDAL provides database access helper methods for datastore.WorldDataDatastore.
@@ -254,21 +374,31 @@ func (d *WorldDataDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *WorldDataDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *WorldDataDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *WorldDataDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *WorldDataDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -288,8 +418,8 @@ func (d *WorldDataDatastoreDAL) Put(ctx context.Context, client *dslib.Client, o
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -307,6 +437,21 @@ func (d *WorldDataDatastoreDAL) Put(ctx context.Context, client *dslib.Client, o
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *WorldDataDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.WorldDataDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.WorldDataDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *WorldDataDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.WorldDataDatastore, error) {
@@ -384,8 +529,8 @@ func (d *WorldDataDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Clie
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -416,9 +561,10 @@ func (d *WorldDataDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.C
 
 // Query retrieves datastore.WorldDataDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *WorldDataDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.WorldDataDatastore, error) {
 	var entities []*datastore.WorldDataDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -431,9 +577,49 @@ func (d *WorldDataDatastoreDAL) Query(ctx context.Context, client *dslib.Client,
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.WorldDataDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *WorldDataDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.WorldDataDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.WorldDataDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with WorldDataFromWorldDataDatastore
+// and calls fn with the resulting v1.WorldData.
+func (d *WorldDataDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*v1.WorldData, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.WorldDataDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.WorldDataFromWorldDataDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
 // Count returns the number of entities matching the query.
 func (d *WorldDataDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
 
 // GameDatastoreDAL provides database access helper methods for datastore.GameDatastore.
@@ -468,21 +654,31 @@ func (d *GameDatastoreDAL) getKind() string {
 	return entity.Kind()
 }
 
+// getNamespace returns the namespace to use for operations.
+// Uses the DAL's Namespace field if set.
+func (d *GameDatastoreDAL) getNamespace() string {
+	return d.Namespace
+}
+
+// inNamespace returns q in the namespace of the DAL, if any.
+func (d *GameDatastoreDAL) inNamespace(q *dslib.Query) *dslib.Query {
+	if ns := d.getNamespace(); ns != "" {
+		return q.Namespace(ns)
+	}
+	return q
+}
+
 // newKey creates a new Datastore key for the given ID.
 func (d *GameDatastoreDAL) newKey(id string) *dslib.Key {
 	key := dslib.NameKey(d.getKind(), id, nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
 // newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
 func (d *GameDatastoreDAL) newIncompleteKey() *dslib.Key {
 	key := dslib.IncompleteKey(d.getKind(), nil)
-	if d.Namespace != "" {
-		key.Namespace = d.Namespace
-	}
+	key.Namespace = d.getNamespace()
 	return key
 }
 
@@ -502,8 +698,8 @@ func (d *GameDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *d
 	if obj.Key != nil {
 		key = obj.Key
 		// Apply namespace override if set
-		if d.Namespace != "" {
-			key.Namespace = d.Namespace
+		if ns := d.getNamespace(); ns != "" {
+			key.Namespace = ns
 		}
 	} else {
 		key = d.newIncompleteKey()
@@ -521,6 +717,21 @@ func (d *GameDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *d
 	return resultKey, nil
 }
 
+// PutChanged puts obj unless it has the same properties as old, the entity as
+// it was read, skipping the write entirely when nothing changed. Datastore
+// writes whole entities, so any change puts all of obj.
+// A nil old always puts obj.
+// Returns the key of the entity; obj.Key is set from old.Key when the write is skipped.
+func (d *GameDatastoreDAL) PutChanged(ctx context.Context, client *dslib.Client, old, obj *datastore.GameDatastore) (*dslib.Key, error) {
+	if old != nil && obj.Equal(old) {
+		if obj.Key == nil {
+			obj.Key = old.Key
+		}
+		return obj.Key, nil
+	}
+	return d.Put(ctx, client, obj)
+}
+
 // Get retrieves a datastore.GameDatastore entity by key.
 // Returns (nil, nil) if the entity is not found.
 func (d *GameDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.GameDatastore, error) {
@@ -598,8 +809,8 @@ func (d *GameDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, o
 	for i, obj := range objs {
 		if obj.Key != nil {
 			keys[i] = obj.Key
-			if d.Namespace != "" {
-				keys[i].Namespace = d.Namespace
+			if ns := d.getNamespace(); ns != "" {
+				keys[i].Namespace = ns
 			}
 		} else {
 			keys[i] = d.newIncompleteKey()
@@ -630,9 +841,10 @@ func (d *GameDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client
 
 // Query retrieves datastore.GameDatastore entities matching the query.
 // The caller should create a query using dslib.NewQuery(dal.getKind()).
+// The query runs in the DAL's namespace, if any (see Namespace).
 func (d *GameDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.GameDatastore, error) {
 	var entities []*datastore.GameDatastore
-	keys, err := client.GetAll(ctx, q, &entities)
+	keys, err := client.GetAll(ctx, d.inNamespace(q), &entities)
 	if err != nil {
 		return nil, err
 	}
@@ -645,7 +857,99 @@ func (d *GameDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *d
 	return entities, nil
 }
 
+// Iterate calls fn for each datastore.GameDatastore entity matching the query, streaming
+// results with client.Run so large result sets are never held in memory.
+// fn also receives the cursor just after the entity: a job can save it and
+// later resume from there with q.Start(cursor).
+// Iteration stops at the first error from fn, which Iterate returns.
+func (d *GameDatastoreDAL) Iterate(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*datastore.GameDatastore, dslib.Cursor) error) error {
+	it := client.Run(ctx, d.inNamespace(q))
+	for {
+		var entity datastore.GameDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entity.Key = key
+
+		cursor, err := it.Cursor()
+		if err != nil {
+			return err
+		}
+		if err := fn(&entity, cursor); err != nil {
+			return err
+		}
+	}
+}
+
+// IterateAPI is like Iterate but converts each entity with GameFromGameDatastore
+// and calls fn with the resulting v1.Game.
+func (d *GameDatastoreDAL) IterateAPI(ctx context.Context, client *dslib.Client, q *dslib.Query, fn func(*v1.Game, dslib.Cursor) error) error {
+	return d.Iterate(ctx, client, q, func(entity *datastore.GameDatastore, cursor dslib.Cursor) error {
+		msg, err := datastore.GameFromGameDatastore(nil, entity, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg, cursor)
+	})
+}
+
+// GameDatastoreFilterSchema lists the v1.Game fields that AIP-160
+// filters on GameDatastore entities may use, and their properties.
+var GameDatastoreFilterSchema = filtering.MustSchema((&v1.Game{}).ProtoReflect().Descriptor(), map[string]string{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"id":          "id",
+	"creator_id":  "creator_id",
+	"world_id":    "world_id",
+	"name":        "name",
+	"description": "description",
+	"image_url":   "image_url",
+	"difficulty":  "difficulty",
+})
+
+// Filter returns q restricted to the entities matching an AIP-160 filter on
+// v1.Game fields (see GameDatastoreFilterSchema). An empty filter
+// returns q as it is. Invalid filters, including wildcards, which Datastore
+// cannot match, are returned as a *filtering.Error. Unsigned literals are
+// compared as int64, the only integer type Datastore stores.
+// Queries combining inequalities on several properties or using OR may need
+// composite indexes.
+func (d *GameDatastoreDAL) Filter(q *dslib.Query, filter string) (*dslib.Query, error) {
+	expr, err := filtering.Parse(GameDatastoreFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return q, nil
+	}
+	cond, err := filtering.Build(expr, func(c *filtering.Compare) (dslib.EntityFilter, error) {
+		if c.Pattern {
+			return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "wildcards are not supported"}
+		}
+		value := c.Value
+		if n, ok := value.(uint64); ok {
+			if int64(n) < 0 {
+				return nil, &filtering.Error{Filter: filter, Pos: c.Pos, Msg: "value out of range for an int64 property"}
+			}
+			value = int64(n)
+		}
+		return dslib.PropertyFilter{FieldName: c.Field.Column, Operator: string(c.Op), Value: value}, nil
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.AndFilter{Filters: filters}
+	}, func(filters []dslib.EntityFilter) dslib.EntityFilter {
+		return dslib.OrFilter{Filters: filters}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.FilterEntity(cond), nil
+}
+
 // Count returns the number of entities matching the query.
 func (d *GameDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
-	return client.Count(ctx, q)
+	return client.Count(ctx, d.inNamespace(q))
 }
@@ -2,6 +2,8 @@
 package datastore
 
 import (
+	"reflect"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/datastore"
@@ -30,6 +32,89 @@ type DocumentDatastoreEmpty struct {
 	Tags []string `datastore:"tags"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *DocumentDatastoreEmpty) Equal(other *DocumentDatastoreEmpty) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Content == other.Content &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *DocumentDatastoreEmpty) ChangedColumns(other *DocumentDatastoreEmpty) []string {
+	if m == nil {
+		m = &DocumentDatastoreEmpty{}
+	}
+	if other == nil {
+		other = &DocumentDatastoreEmpty{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Content != other.Content {
+		columns = append(columns, "content")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *DocumentDatastoreEmpty) Clone() *DocumentDatastoreEmpty {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a DocumentDatastoreEmpty with copies of their own.
+func (m *DocumentDatastoreEmpty) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // Kind returns the Datastore kind name for DocumentDatastoreEmpty.
 func (*DocumentDatastoreEmpty) Kind() string {
 	return "DocumentEmpty"
@@ -58,6 +143,89 @@ type DocumentDatastorePartial struct {
 	Tags []string `datastore:"tags"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *DocumentDatastorePartial) Equal(other *DocumentDatastorePartial) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Content == other.Content &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *DocumentDatastorePartial) ChangedColumns(other *DocumentDatastorePartial) []string {
+	if m == nil {
+		m = &DocumentDatastorePartial{}
+	}
+	if other == nil {
+		other = &DocumentDatastorePartial{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Content != other.Content {
+		columns = append(columns, "content")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *DocumentDatastorePartial) Clone() *DocumentDatastorePartial {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a DocumentDatastorePartial with copies of their own.
+func (m *DocumentDatastorePartial) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // Kind returns the Datastore kind name for DocumentDatastorePartial.
 func (*DocumentDatastorePartial) Kind() string {
 	return "DocumentPartial"
@@ -84,6 +252,85 @@ type DocumentDatastoreSkip struct {
 	Tags []string `datastore:"tags"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *DocumentDatastoreSkip) Equal(other *DocumentDatastoreSkip) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *DocumentDatastoreSkip) ChangedColumns(other *DocumentDatastoreSkip) []string {
+	if m == nil {
+		m = &DocumentDatastoreSkip{}
+	}
+	if other == nil {
+		other = &DocumentDatastoreSkip{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *DocumentDatastoreSkip) Clone() *DocumentDatastoreSkip {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a DocumentDatastoreSkip with copies of their own.
+func (m *DocumentDatastoreSkip) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // Kind returns the Datastore kind name for DocumentDatastoreSkip.
 func (*DocumentDatastoreSkip) Kind() string {
 	return "DocumentSkip"
@@ -2,6 +2,7 @@
 package datastore
 
 import (
+	"context"
 	"strconv"
 
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
@@ -9,6 +10,22 @@ import (
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 )
 
+// conversionDocumentToDocumentDatastoreEmpty describes DocumentToDocumentDatastoreEmpty in conversion errors.
+var conversionDocumentToDocumentDatastoreEmpty = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentDatastoreEmpty",
+}
+
+// conversionDocumentFromDocumentDatastoreEmpty describes DocumentFromDocumentDatastoreEmpty in conversion errors.
+var conversionDocumentFromDocumentDatastoreEmpty = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentDatastoreEmpty",
+	TargetType: "api.Document",
+}
+
 // DocumentToDocumentDatastoreEmpty converts a Document to DocumentDatastoreEmpty.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -25,10 +42,47 @@ func DocumentToDocumentDatastoreEmpty(
 	src *api.Document,
 	dest *DocumentDatastoreEmpty,
 	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) (out *DocumentDatastoreEmpty, err error) {
+	out, err = DocumentToDocumentDatastoreEmptyWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentToDocumentDatastoreEmptyWithOptions converts a Document to DocumentDatastoreEmpty under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Document message to convert from
+//   - dest: Destination DocumentDatastoreEmpty entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted DocumentDatastoreEmpty entity
+//   - Error if conversion fails
+func DocumentToDocumentDatastoreEmptyWithOptions(
+	ctx context.Context,
+	src *api.Document,
+	dest *DocumentDatastoreEmpty,
+	opts ...converters.ConvertOption,
 ) (out *DocumentDatastoreEmpty, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentDatastoreEmpty{}
 	}
@@ -53,13 +107,6 @@ func DocumentToDocumentDatastoreEmpty(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -79,10 +126,47 @@ func DocumentFromDocumentDatastoreEmpty(
 	dest *api.Document,
 	src *DocumentDatastoreEmpty,
 	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentDatastoreEmptyWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentFromDocumentDatastoreEmptyWithOptions converts a DocumentDatastoreEmpty back to Document under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Document message (if nil, a new one is created)
+//   - src: Source DocumentDatastoreEmpty entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Document message
+//   - Error if conversion fails
+func DocumentFromDocumentDatastoreEmptyWithOptions(
+	ctx context.Context,
+	dest *api.Document,
+	src *DocumentDatastoreEmpty,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -101,16 +185,69 @@ func DocumentFromDocumentDatastoreEmpty(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// DocumentSliceToDocumentDatastoreEmptySlice converts a slice of api.Document with DocumentToDocumentDatastoreEmpty,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentDatastoreEmptySlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) ([]*DocumentDatastoreEmpty, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentDatastoreEmpty, error) {
+		return DocumentToDocumentDatastoreEmpty(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentDatastoreEmptySlice converts a slice of DocumentDatastoreEmpty with DocumentFromDocumentDatastoreEmpty,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentDatastoreEmptySlice(
+	src []*DocumentDatastoreEmpty,
+	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentDatastoreEmpty) (*api.Document, error) {
+		return DocumentFromDocumentDatastoreEmpty(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentDatastoreEmptyMap converts the values of a map with DocumentToDocumentDatastoreEmpty,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentDatastoreEmptyMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) (map[K]*DocumentDatastoreEmpty, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentDatastoreEmpty, error) {
+		return DocumentToDocumentDatastoreEmpty(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentDatastoreEmptyMap converts the values of a map with DocumentFromDocumentDatastoreEmpty,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentDatastoreEmptyMap[K comparable](
+	src map[K]*DocumentDatastoreEmpty,
+	decorator func(*api.Document, *DocumentDatastoreEmpty) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentDatastoreEmpty) (*api.Document, error) {
+		return DocumentFromDocumentDatastoreEmpty(nil, value, decorator)
+	})
+}
+
+// conversionDocumentToDocumentDatastorePartial describes DocumentToDocumentDatastorePartial in conversion errors.
+var conversionDocumentToDocumentDatastorePartial = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentDatastorePartial",
+}
+
+// conversionDocumentFromDocumentDatastorePartial describes DocumentFromDocumentDatastorePartial in conversion errors.
+var conversionDocumentFromDocumentDatastorePartial = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentDatastorePartial",
+	TargetType: "api.Document",
+}
+
 // DocumentToDocumentDatastorePartial converts a Document to DocumentDatastorePartial.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -127,10 +264,47 @@ func DocumentToDocumentDatastorePartial(
 	src *api.Document,
 	dest *DocumentDatastorePartial,
 	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) (out *DocumentDatastorePartial, err error) {
+	out, err = DocumentToDocumentDatastorePartialWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentToDocumentDatastorePartialWithOptions converts a Document to DocumentDatastorePartial under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Document message to convert from
+//   - dest: Destination DocumentDatastorePartial entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted DocumentDatastorePartial entity
+//   - Error if conversion fails
+func DocumentToDocumentDatastorePartialWithOptions(
+	ctx context.Context,
+	src *api.Document,
+	dest *DocumentDatastorePartial,
+	opts ...converters.ConvertOption,
 ) (out *DocumentDatastorePartial, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentDatastorePartial{}
 	}
@@ -155,13 +329,6 @@ func DocumentToDocumentDatastorePartial(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -181,10 +348,47 @@ func DocumentFromDocumentDatastorePartial(
 	dest *api.Document,
 	src *DocumentDatastorePartial,
 	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentDatastorePartialWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentFromDocumentDatastorePartialWithOptions converts a DocumentDatastorePartial back to Document under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Document message (if nil, a new one is created)
+//   - src: Source DocumentDatastorePartial entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Document message
+//   - Error if conversion fails
+func DocumentFromDocumentDatastorePartialWithOptions(
+	ctx context.Context,
+	dest *api.Document,
+	src *DocumentDatastorePartial,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -203,16 +407,69 @@ func DocumentFromDocumentDatastorePartial(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// DocumentSliceToDocumentDatastorePartialSlice converts a slice of api.Document with DocumentToDocumentDatastorePartial,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentDatastorePartialSlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) ([]*DocumentDatastorePartial, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentDatastorePartial, error) {
+		return DocumentToDocumentDatastorePartial(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentDatastorePartialSlice converts a slice of DocumentDatastorePartial with DocumentFromDocumentDatastorePartial,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentDatastorePartialSlice(
+	src []*DocumentDatastorePartial,
+	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentDatastorePartial) (*api.Document, error) {
+		return DocumentFromDocumentDatastorePartial(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentDatastorePartialMap converts the values of a map with DocumentToDocumentDatastorePartial,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentDatastorePartialMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) (map[K]*DocumentDatastorePartial, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentDatastorePartial, error) {
+		return DocumentToDocumentDatastorePartial(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentDatastorePartialMap converts the values of a map with DocumentFromDocumentDatastorePartial,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentDatastorePartialMap[K comparable](
+	src map[K]*DocumentDatastorePartial,
+	decorator func(*api.Document, *DocumentDatastorePartial) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentDatastorePartial) (*api.Document, error) {
+		return DocumentFromDocumentDatastorePartial(nil, value, decorator)
+	})
+}
+
+// conversionDocumentToDocumentDatastoreSkip describes DocumentToDocumentDatastoreSkip in conversion errors.
+var conversionDocumentToDocumentDatastoreSkip = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentDatastoreSkip",
+}
+
+// conversionDocumentFromDocumentDatastoreSkip describes DocumentFromDocumentDatastoreSkip in conversion errors.
+var conversionDocumentFromDocumentDatastoreSkip = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentDatastoreSkip",
+	TargetType: "api.Document",
+}
+
 // DocumentToDocumentDatastoreSkip converts a Document to DocumentDatastoreSkip.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -229,10 +486,47 @@ func DocumentToDocumentDatastoreSkip(
 	src *api.Document,
 	dest *DocumentDatastoreSkip,
 	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) (out *DocumentDatastoreSkip, err error) {
+	out, err = DocumentToDocumentDatastoreSkipWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentToDocumentDatastoreSkipWithOptions converts a Document to DocumentDatastoreSkip under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Document message to convert from
+//   - dest: Destination DocumentDatastoreSkip entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted DocumentDatastoreSkip entity
+//   - Error if conversion fails
+func DocumentToDocumentDatastoreSkipWithOptions(
+	ctx context.Context,
+	src *api.Document,
+	dest *DocumentDatastoreSkip,
+	opts ...converters.ConvertOption,
 ) (out *DocumentDatastoreSkip, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentDatastoreSkip{}
 	}
@@ -256,13 +550,6 @@ func DocumentToDocumentDatastoreSkip(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -282,10 +569,47 @@ func DocumentFromDocumentDatastoreSkip(
 	dest *api.Document,
 	src *DocumentDatastoreSkip,
 	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentDatastoreSkipWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentFromDocumentDatastoreSkipWithOptions converts a DocumentDatastoreSkip back to Document under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Document message (if nil, a new one is created)
+//   - src: Source DocumentDatastoreSkip entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Document message
+//   - Error if conversion fails
+func DocumentFromDocumentDatastoreSkipWithOptions(
+	ctx context.Context,
+	dest *api.Document,
+	src *DocumentDatastoreSkip,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -303,12 +627,49 @@ func DocumentFromDocumentDatastoreSkip(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
+
+// DocumentSliceToDocumentDatastoreSkipSlice converts a slice of api.Document with DocumentToDocumentDatastoreSkip,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentDatastoreSkipSlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) ([]*DocumentDatastoreSkip, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentDatastoreSkip, error) {
+		return DocumentToDocumentDatastoreSkip(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentDatastoreSkipSlice converts a slice of DocumentDatastoreSkip with DocumentFromDocumentDatastoreSkip,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentDatastoreSkipSlice(
+	src []*DocumentDatastoreSkip,
+	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentDatastoreSkip) (*api.Document, error) {
+		return DocumentFromDocumentDatastoreSkip(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentDatastoreSkipMap converts the values of a map with DocumentToDocumentDatastoreSkip,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentDatastoreSkipMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) (map[K]*DocumentDatastoreSkip, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentDatastoreSkip, error) {
+		return DocumentToDocumentDatastoreSkip(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentDatastoreSkipMap converts the values of a map with DocumentFromDocumentDatastoreSkip,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentDatastoreSkipMap[K comparable](
+	src map[K]*DocumentDatastoreSkip,
+	decorator func(*api.Document, *DocumentDatastoreSkip) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentDatastoreSkip) (*api.Document, error) {
+		return DocumentFromDocumentDatastoreSkip(nil, value, decorator)
+	})
+}
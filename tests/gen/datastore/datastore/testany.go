@@ -2,14 +2,21 @@
 package datastore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/datastore"
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 )
 
+// RecordID is the id_type of datastore.TestRecord4Datastore.id.
+type RecordID string
+
 // TestRecord1Datastore is the Datastore entity for the source message.
 type TestRecord1Datastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -25,6 +32,75 @@ type TestRecord1Datastore struct {
 	MapStringToEnum map[string]api.SampleEnum `datastore:"map_string_to_enum"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *TestRecord1Datastore) Equal(other *TestRecord1Datastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.TimeField.Equal(other.TimeField) &&
+		bytes.Equal(m.ExtraData, other.ExtraData) &&
+		m.AnEnum == other.AnEnum &&
+		reflect.DeepEqual(m.ListOfEnums, other.ListOfEnums) &&
+		reflect.DeepEqual(m.MapStringToEnum, other.MapStringToEnum)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *TestRecord1Datastore) ChangedColumns(other *TestRecord1Datastore) []string {
+	if m == nil {
+		m = &TestRecord1Datastore{}
+	}
+	if other == nil {
+		other = &TestRecord1Datastore{}
+	}
+	var columns []string
+	if !m.TimeField.Equal(other.TimeField) {
+		columns = append(columns, "time_field")
+	}
+	if !bytes.Equal(m.ExtraData, other.ExtraData) {
+		columns = append(columns, "extra_data")
+	}
+	if m.AnEnum != other.AnEnum {
+		columns = append(columns, "an_enum")
+	}
+	if !reflect.DeepEqual(m.ListOfEnums, other.ListOfEnums) {
+		columns = append(columns, "list_of_enums")
+	}
+	if !reflect.DeepEqual(m.MapStringToEnum, other.MapStringToEnum) {
+		columns = append(columns, "map_string_to_enum")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *TestRecord1Datastore) Clone() *TestRecord1Datastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a TestRecord1Datastore with copies of their own.
+func (m *TestRecord1Datastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.ExtraData = bytes.Clone(m.ExtraData)
+	m.ListOfEnums = slices.Clone(m.ListOfEnums)
+	m.MapStringToEnum = maps.Clone(m.MapStringToEnum)
+}
+
 // Kind returns the Datastore kind name for TestRecord1Datastore.
 func (*TestRecord1Datastore) Kind() string {
 	return "test_records"
@@ -39,6 +115,60 @@ type MapValueMessageDatastore struct {
 	Count int32 `datastore:"count"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *MapValueMessageDatastore) Equal(other *MapValueMessageDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Label == other.Label &&
+		m.Count == other.Count
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *MapValueMessageDatastore) ChangedColumns(other *MapValueMessageDatastore) []string {
+	if m == nil {
+		m = &MapValueMessageDatastore{}
+	}
+	if other == nil {
+		other = &MapValueMessageDatastore{}
+	}
+	var columns []string
+	if m.Label != other.Label {
+		columns = append(columns, "label")
+	}
+	if m.Count != other.Count {
+		columns = append(columns, "count")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *MapValueMessageDatastore) Clone() *MapValueMessageDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a MapValueMessageDatastore with copies of their own.
+func (m *MapValueMessageDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // TestRecord2Datastore is the Datastore entity for the source message.
 type TestRecord2Datastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -54,6 +184,92 @@ type TestRecord2Datastore struct {
 	BoolToMessage map[bool]MapValueMessageDatastore `datastore:"bool_to_message"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *TestRecord2Datastore) Equal(other *TestRecord2Datastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Name == other.Name &&
+		reflect.DeepEqual(m.Int32ToMessage, other.Int32ToMessage) &&
+		reflect.DeepEqual(m.Int64ToMessage, other.Int64ToMessage) &&
+		reflect.DeepEqual(m.Uint32ToMessage, other.Uint32ToMessage) &&
+		reflect.DeepEqual(m.BoolToMessage, other.BoolToMessage)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *TestRecord2Datastore) ChangedColumns(other *TestRecord2Datastore) []string {
+	if m == nil {
+		m = &TestRecord2Datastore{}
+	}
+	if other == nil {
+		other = &TestRecord2Datastore{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Int32ToMessage, other.Int32ToMessage) {
+		columns = append(columns, "int32_to_message")
+	}
+	if !reflect.DeepEqual(m.Int64ToMessage, other.Int64ToMessage) {
+		columns = append(columns, "int64_to_message")
+	}
+	if !reflect.DeepEqual(m.Uint32ToMessage, other.Uint32ToMessage) {
+		columns = append(columns, "uint32_to_message")
+	}
+	if !reflect.DeepEqual(m.BoolToMessage, other.BoolToMessage) {
+		columns = append(columns, "bool_to_message")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *TestRecord2Datastore) Clone() *TestRecord2Datastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a TestRecord2Datastore with copies of their own.
+func (m *TestRecord2Datastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Int32ToMessage = maps.Clone(m.Int32ToMessage)
+	for key, value := range m.Int32ToMessage {
+		value.cloneFields()
+		m.Int32ToMessage[key] = value
+	}
+	m.Int64ToMessage = maps.Clone(m.Int64ToMessage)
+	for key, value := range m.Int64ToMessage {
+		value.cloneFields()
+		m.Int64ToMessage[key] = value
+	}
+	m.Uint32ToMessage = maps.Clone(m.Uint32ToMessage)
+	for key, value := range m.Uint32ToMessage {
+		value.cloneFields()
+		m.Uint32ToMessage[key] = value
+	}
+	m.BoolToMessage = maps.Clone(m.BoolToMessage)
+	for key, value := range m.BoolToMessage {
+		value.cloneFields()
+		m.BoolToMessage[key] = value
+	}
+}
+
 // Kind returns the Datastore kind name for TestRecord2Datastore.
 func (*TestRecord2Datastore) Kind() string {
 	return "test_records2"
@@ -74,6 +290,73 @@ type TestRecord3Datastore struct {
 	CountsByType map[string]int64 `datastore:"counts_by_type,noindex"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *TestRecord3Datastore) Equal(other *TestRecord3Datastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.EntityType == other.EntityType &&
+		m.EntityId == other.EntityId &&
+		m.TotalCount == other.TotalCount &&
+		reflect.DeepEqual(m.CountsByType, other.CountsByType)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *TestRecord3Datastore) ChangedColumns(other *TestRecord3Datastore) []string {
+	if m == nil {
+		m = &TestRecord3Datastore{}
+	}
+	if other == nil {
+		other = &TestRecord3Datastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.EntityType != other.EntityType {
+		columns = append(columns, "entity_type")
+	}
+	if m.EntityId != other.EntityId {
+		columns = append(columns, "entity_id")
+	}
+	if m.TotalCount != other.TotalCount {
+		columns = append(columns, "total_count")
+	}
+	if !reflect.DeepEqual(m.CountsByType, other.CountsByType) {
+		columns = append(columns, "counts_by_type")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *TestRecord3Datastore) Clone() *TestRecord3Datastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a TestRecord3Datastore with copies of their own.
+func (m *TestRecord3Datastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.CountsByType = maps.Clone(m.CountsByType)
+}
+
 // Kind returns the Datastore kind name for TestRecord3Datastore.
 func (*TestRecord3Datastore) Kind() string {
 	return "test_records3"
@@ -206,3 +489,212 @@ func (m *TestRecord3Datastore) Load(props []datastore.Property) error {
 
 	return nil
 }
+
+// TestRecord4Datastore is the Datastore entity for the source message.
+type TestRecord4Datastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Id RecordID `datastore:"id"`
+
+	SeenAt []time.Time `datastore:"seen_at"`
+
+	MemberIds []string `datastore:"member_ids"`
+
+	Deadlines map[string]time.Time `datastore:"deadlines,noindex"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *TestRecord4Datastore) Equal(other *TestRecord4Datastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		reflect.DeepEqual(m.SeenAt, other.SeenAt) &&
+		reflect.DeepEqual(m.MemberIds, other.MemberIds) &&
+		reflect.DeepEqual(m.Deadlines, other.Deadlines)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *TestRecord4Datastore) ChangedColumns(other *TestRecord4Datastore) []string {
+	if m == nil {
+		m = &TestRecord4Datastore{}
+	}
+	if other == nil {
+		other = &TestRecord4Datastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if !reflect.DeepEqual(m.SeenAt, other.SeenAt) {
+		columns = append(columns, "seen_at")
+	}
+	if !reflect.DeepEqual(m.MemberIds, other.MemberIds) {
+		columns = append(columns, "member_ids")
+	}
+	if !reflect.DeepEqual(m.Deadlines, other.Deadlines) {
+		columns = append(columns, "deadlines")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *TestRecord4Datastore) Clone() *TestRecord4Datastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a TestRecord4Datastore with copies of their own.
+func (m *TestRecord4Datastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.SeenAt = slices.Clone(m.SeenAt)
+	m.MemberIds = slices.Clone(m.MemberIds)
+	m.Deadlines = maps.Clone(m.Deadlines)
+}
+
+// Kind returns the Datastore kind name for TestRecord4Datastore.
+func (*TestRecord4Datastore) Kind() string {
+	return "test_records4"
+}
+
+// Save implements the PropertyLoadSaver interface for TestRecord4Datastore.
+// It serializes map fields to JSON since Datastore doesn't natively support Go maps.
+func (m *TestRecord4Datastore) Save() ([]datastore.Property, error) {
+	// First, get the default properties using datastore.SaveStruct
+	// We need a copy without the map fields to avoid the "unsupported struct field type" error
+	props, err := m.saveNonMapFields()
+	if err != nil {
+		return nil, err
+	}
+
+	// Serialize Deadlines map to JSON
+	if m.Deadlines != nil {
+		DeadlinesJSON, err := json.Marshal(m.Deadlines)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal Deadlines: %w", err)
+		}
+		props = append(props, datastore.Property{
+			Name:    "deadlines",
+			Value:   DeadlinesJSON,
+			NoIndex: true, // Maps are typically not indexed
+		})
+	}
+
+	return props, nil
+}
+
+// saveNonMapFields saves all non-map fields using a temporary struct.
+func (m *TestRecord4Datastore) saveNonMapFields() ([]datastore.Property, error) {
+	// Create a temporary struct with only the non-map fields
+	type nonMapFields struct {
+		Key *datastore.Key `datastore:"-"`
+
+		Id RecordID `datastore:"id"`
+
+		SeenAt []time.Time `datastore:"seen_at"`
+
+		MemberIds []string `datastore:"member_ids"`
+	}
+
+	tmp := nonMapFields{
+
+		Key: m.Key,
+
+		Id: m.Id,
+
+		SeenAt: m.SeenAt,
+
+		MemberIds: m.MemberIds,
+	}
+
+	return datastore.SaveStruct(&tmp)
+}
+
+// Load implements the PropertyLoadSaver interface for TestRecord4Datastore.
+// It deserializes JSON-encoded map fields back to Go maps.
+func (m *TestRecord4Datastore) Load(props []datastore.Property) error {
+	// Separate map properties from regular properties
+	var regularProps []datastore.Property
+
+	var DeadlinesProp *datastore.Property
+
+	for i := range props {
+		switch props[i].Name {
+
+		case "deadlines":
+			DeadlinesProp = &props[i]
+
+		default:
+			regularProps = append(regularProps, props[i])
+		}
+	}
+
+	// Load non-map fields using a temporary struct
+	type nonMapFields struct {
+		Key *datastore.Key `datastore:"-"`
+
+		Id RecordID `datastore:"id"`
+
+		SeenAt []time.Time `datastore:"seen_at"`
+
+		MemberIds []string `datastore:"member_ids"`
+	}
+
+	var tmp nonMapFields
+	if err := datastore.LoadStruct(&tmp, regularProps); err != nil {
+		return err
+	}
+
+	// Copy non-map fields back (LoadKey already set the key fields)
+
+	m.SeenAt = tmp.SeenAt
+
+	m.MemberIds = tmp.MemberIds
+
+	// Deserialize Deadlines from JSON
+	if DeadlinesProp != nil {
+		var jsonBytes []byte
+		switch v := DeadlinesProp.Value.(type) {
+		case []byte:
+			jsonBytes = v
+		case string:
+			jsonBytes = []byte(v)
+		default:
+			return fmt.Errorf("unexpected type for deadlines: %T", DeadlinesProp.Value)
+		}
+		if len(jsonBytes) > 0 {
+			m.Deadlines = make(map[string]time.Time)
+			if err := json.Unmarshal(jsonBytes, &m.Deadlines); err != nil {
+				return fmt.Errorf("failed to unmarshal Deadlines: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKey implements the KeyLoader interface for TestRecord4Datastore.
+// It sets Key and fills Id from the key.
+func (m *TestRecord4Datastore) LoadKey(k *datastore.Key) error {
+	m.Key = k
+	if k != nil {
+		m.Id = RecordID(k.Name)
+	}
+	return nil
+}
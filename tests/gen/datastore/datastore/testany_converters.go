@@ -2,13 +2,32 @@
 package datastore
 
 import (
-	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"context"
+	"strconv"
+	"time"
 
-	"fmt"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 )
 
+// conversionTestRecord1ToTestRecord1Datastore describes TestRecord1ToTestRecord1Datastore in conversion errors.
+var conversionTestRecord1ToTestRecord1Datastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record1",
+	SourceType: "api.TestRecord1",
+	TargetType: "TestRecord1Datastore",
+}
+
+// conversionTestRecord1FromTestRecord1Datastore describes TestRecord1FromTestRecord1Datastore in conversion errors.
+var conversionTestRecord1FromTestRecord1Datastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record1",
+	SourceType: "TestRecord1Datastore",
+	TargetType: "api.TestRecord1",
+}
+
 // TestRecord1ToTestRecord1Datastore converts a TestRecord1 to TestRecord1Datastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -25,10 +44,47 @@ func TestRecord1ToTestRecord1Datastore(
 	src *api.TestRecord1,
 	dest *TestRecord1Datastore,
 	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) (out *TestRecord1Datastore, err error) {
+	out, err = TestRecord1ToTestRecord1DatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord1ToTestRecord1DatastoreWithOptions converts a TestRecord1 to TestRecord1Datastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source TestRecord1 message to convert from
+//   - dest: Destination TestRecord1Datastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord1Datastore entity
+//   - Error if conversion fails
+func TestRecord1ToTestRecord1DatastoreWithOptions(
+	ctx context.Context,
+	src *api.TestRecord1,
+	dest *TestRecord1Datastore,
+	opts ...converters.ConvertOption,
 ) (out *TestRecord1Datastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TestRecord1Datastore{}
 	}
@@ -47,7 +103,7 @@ func TestRecord1ToTestRecord1Datastore(
 	if src.ExtraData != nil {
 		out.ExtraData, err = converters.AnyToBytes(src.ExtraData)
 		if err != nil {
-			return nil, fmt.Errorf("converting ExtraData: %w", err)
+			return nil, conversionTestRecord1ToTestRecord1Datastore.FieldError(err, "extra_data")
 		}
 	}
 
@@ -55,13 +111,6 @@ func TestRecord1ToTestRecord1Datastore(
 		out.MapStringToEnum = src.MapStringToEnum
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -81,10 +130,47 @@ func TestRecord1FromTestRecord1Datastore(
 	dest *api.TestRecord1,
 	src *TestRecord1Datastore,
 	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) (out *api.TestRecord1, err error) {
+	out, err = TestRecord1FromTestRecord1DatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord1FromTestRecord1DatastoreWithOptions converts a TestRecord1Datastore back to TestRecord1 under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination TestRecord1 message (if nil, a new one is created)
+//   - src: Source TestRecord1Datastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord1 message
+//   - Error if conversion fails
+func TestRecord1FromTestRecord1DatastoreWithOptions(
+	ctx context.Context,
+	dest *api.TestRecord1,
+	src *TestRecord1Datastore,
+	opts ...converters.ConvertOption,
 ) (out *api.TestRecord1, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.TestRecord1{}
 	}
@@ -100,19 +186,72 @@ func TestRecord1FromTestRecord1Datastore(
 
 	out.ExtraData, err = converters.BytesToAny(src.ExtraData)
 	if err != nil {
-		return nil, fmt.Errorf("converting ExtraData: %w", err)
-	}
-
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
+		return nil, conversionTestRecord1FromTestRecord1Datastore.FieldError(err, "extra_data")
 	}
 
 	return dest, nil
 }
 
+// TestRecord1SliceToTestRecord1DatastoreSlice converts a slice of api.TestRecord1 with TestRecord1ToTestRecord1Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord1SliceToTestRecord1DatastoreSlice(
+	src []*api.TestRecord1,
+	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) ([]*TestRecord1Datastore, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord1) (*TestRecord1Datastore, error) {
+		return TestRecord1ToTestRecord1Datastore(item, nil, decorator)
+	})
+}
+
+// TestRecord1SliceFromTestRecord1DatastoreSlice converts a slice of TestRecord1Datastore with TestRecord1FromTestRecord1Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord1SliceFromTestRecord1DatastoreSlice(
+	src []*TestRecord1Datastore,
+	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) ([]*api.TestRecord1, error) {
+	return converters.MapSlice(src, func(item *TestRecord1Datastore) (*api.TestRecord1, error) {
+		return TestRecord1FromTestRecord1Datastore(nil, item, decorator)
+	})
+}
+
+// TestRecord1MapToTestRecord1DatastoreMap converts the values of a map with TestRecord1ToTestRecord1Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord1MapToTestRecord1DatastoreMap[K comparable](
+	src map[K]*api.TestRecord1,
+	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) (map[K]*TestRecord1Datastore, error) {
+	return converters.MapValues(src, func(value *api.TestRecord1) (*TestRecord1Datastore, error) {
+		return TestRecord1ToTestRecord1Datastore(value, nil, decorator)
+	})
+}
+
+// TestRecord1MapFromTestRecord1DatastoreMap converts the values of a map with TestRecord1FromTestRecord1Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord1MapFromTestRecord1DatastoreMap[K comparable](
+	src map[K]*TestRecord1Datastore,
+	decorator func(*api.TestRecord1, *TestRecord1Datastore) error,
+) (map[K]*api.TestRecord1, error) {
+	return converters.MapValues(src, func(value *TestRecord1Datastore) (*api.TestRecord1, error) {
+		return TestRecord1FromTestRecord1Datastore(nil, value, decorator)
+	})
+}
+
+// conversionMapValueMessageToMapValueMessageDatastore describes MapValueMessageToMapValueMessageDatastore in conversion errors.
+var conversionMapValueMessageToMapValueMessageDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "map_value_message",
+	SourceType: "api.MapValueMessage",
+	TargetType: "MapValueMessageDatastore",
+}
+
+// conversionMapValueMessageFromMapValueMessageDatastore describes MapValueMessageFromMapValueMessageDatastore in conversion errors.
+var conversionMapValueMessageFromMapValueMessageDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "map_value_message",
+	SourceType: "MapValueMessageDatastore",
+	TargetType: "api.MapValueMessage",
+}
+
 // MapValueMessageToMapValueMessageDatastore converts a MapValueMessage to MapValueMessageDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -129,10 +268,47 @@ func MapValueMessageToMapValueMessageDatastore(
 	src *api.MapValueMessage,
 	dest *MapValueMessageDatastore,
 	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) (out *MapValueMessageDatastore, err error) {
+	out, err = MapValueMessageToMapValueMessageDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// MapValueMessageToMapValueMessageDatastoreWithOptions converts a MapValueMessage to MapValueMessageDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source MapValueMessage message to convert from
+//   - dest: Destination MapValueMessageDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted MapValueMessageDatastore entity
+//   - Error if conversion fails
+func MapValueMessageToMapValueMessageDatastoreWithOptions(
+	ctx context.Context,
+	src *api.MapValueMessage,
+	dest *MapValueMessageDatastore,
+	opts ...converters.ConvertOption,
 ) (out *MapValueMessageDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &MapValueMessageDatastore{}
 	}
@@ -144,13 +320,6 @@ func MapValueMessageToMapValueMessageDatastore(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -170,10 +339,47 @@ func MapValueMessageFromMapValueMessageDatastore(
 	dest *api.MapValueMessage,
 	src *MapValueMessageDatastore,
 	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) (out *api.MapValueMessage, err error) {
+	out, err = MapValueMessageFromMapValueMessageDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// MapValueMessageFromMapValueMessageDatastoreWithOptions converts a MapValueMessageDatastore back to MapValueMessage under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination MapValueMessage message (if nil, a new one is created)
+//   - src: Source MapValueMessageDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted MapValueMessage message
+//   - Error if conversion fails
+func MapValueMessageFromMapValueMessageDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.MapValueMessage,
+	src *MapValueMessageDatastore,
+	opts ...converters.ConvertOption,
 ) (out *api.MapValueMessage, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.MapValueMessage{}
 	}
@@ -185,16 +391,69 @@ func MapValueMessageFromMapValueMessageDatastore(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// MapValueMessageSliceToMapValueMessageDatastoreSlice converts a slice of api.MapValueMessage with MapValueMessageToMapValueMessageDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func MapValueMessageSliceToMapValueMessageDatastoreSlice(
+	src []*api.MapValueMessage,
+	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) ([]*MapValueMessageDatastore, error) {
+	return converters.MapSlice(src, func(item *api.MapValueMessage) (*MapValueMessageDatastore, error) {
+		return MapValueMessageToMapValueMessageDatastore(item, nil, decorator)
+	})
+}
+
+// MapValueMessageSliceFromMapValueMessageDatastoreSlice converts a slice of MapValueMessageDatastore with MapValueMessageFromMapValueMessageDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func MapValueMessageSliceFromMapValueMessageDatastoreSlice(
+	src []*MapValueMessageDatastore,
+	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) ([]*api.MapValueMessage, error) {
+	return converters.MapSlice(src, func(item *MapValueMessageDatastore) (*api.MapValueMessage, error) {
+		return MapValueMessageFromMapValueMessageDatastore(nil, item, decorator)
+	})
+}
+
+// MapValueMessageMapToMapValueMessageDatastoreMap converts the values of a map with MapValueMessageToMapValueMessageDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func MapValueMessageMapToMapValueMessageDatastoreMap[K comparable](
+	src map[K]*api.MapValueMessage,
+	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) (map[K]*MapValueMessageDatastore, error) {
+	return converters.MapValues(src, func(value *api.MapValueMessage) (*MapValueMessageDatastore, error) {
+		return MapValueMessageToMapValueMessageDatastore(value, nil, decorator)
+	})
+}
+
+// MapValueMessageMapFromMapValueMessageDatastoreMap converts the values of a map with MapValueMessageFromMapValueMessageDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func MapValueMessageMapFromMapValueMessageDatastoreMap[K comparable](
+	src map[K]*MapValueMessageDatastore,
+	decorator func(*api.MapValueMessage, *MapValueMessageDatastore) error,
+) (map[K]*api.MapValueMessage, error) {
+	return converters.MapValues(src, func(value *MapValueMessageDatastore) (*api.MapValueMessage, error) {
+		return MapValueMessageFromMapValueMessageDatastore(nil, value, decorator)
+	})
+}
+
+// conversionTestRecord2ToTestRecord2Datastore describes TestRecord2ToTestRecord2Datastore in conversion errors.
+var conversionTestRecord2ToTestRecord2Datastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record2",
+	SourceType: "api.TestRecord2",
+	TargetType: "TestRecord2Datastore",
+}
+
+// conversionTestRecord2FromTestRecord2Datastore describes TestRecord2FromTestRecord2Datastore in conversion errors.
+var conversionTestRecord2FromTestRecord2Datastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record2",
+	SourceType: "TestRecord2Datastore",
+	TargetType: "api.TestRecord2",
+}
+
 // TestRecord2ToTestRecord2Datastore converts a TestRecord2 to TestRecord2Datastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -211,10 +470,47 @@ func TestRecord2ToTestRecord2Datastore(
 	src *api.TestRecord2,
 	dest *TestRecord2Datastore,
 	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) (out *TestRecord2Datastore, err error) {
+	out, err = TestRecord2ToTestRecord2DatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord2ToTestRecord2DatastoreWithOptions converts a TestRecord2 to TestRecord2Datastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source TestRecord2 message to convert from
+//   - dest: Destination TestRecord2Datastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord2Datastore entity
+//   - Error if conversion fails
+func TestRecord2ToTestRecord2DatastoreWithOptions(
+	ctx context.Context,
+	src *api.TestRecord2,
+	dest *TestRecord2Datastore,
+	opts ...converters.ConvertOption,
 ) (out *TestRecord2Datastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TestRecord2Datastore{}
 	}
@@ -229,9 +525,9 @@ func TestRecord2ToTestRecord2Datastore(
 		out.Int32ToMessage = make(map[int32]MapValueMessageDatastore, len(src.Int32ToMessage))
 		for key, value := range src.Int32ToMessage {
 			var converted MapValueMessageDatastore
-			_, err = MapValueMessageToMapValueMessageDatastore(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageDatastoreWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2Datastore.ElementError(err, "int32_to_message", key)
 			}
 			out.Int32ToMessage[key] = converted
 		}
@@ -240,9 +536,9 @@ func TestRecord2ToTestRecord2Datastore(
 		out.Int64ToMessage = make(map[int64]MapValueMessageDatastore, len(src.Int64ToMessage))
 		for key, value := range src.Int64ToMessage {
 			var converted MapValueMessageDatastore
-			_, err = MapValueMessageToMapValueMessageDatastore(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageDatastoreWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int64ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2Datastore.ElementError(err, "int64_to_message", key)
 			}
 			out.Int64ToMessage[key] = converted
 		}
@@ -251,9 +547,9 @@ func TestRecord2ToTestRecord2Datastore(
 		out.Uint32ToMessage = make(map[uint32]MapValueMessageDatastore, len(src.Uint32ToMessage))
 		for key, value := range src.Uint32ToMessage {
 			var converted MapValueMessageDatastore
-			_, err = MapValueMessageToMapValueMessageDatastore(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageDatastoreWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Uint32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2Datastore.ElementError(err, "uint32_to_message", key)
 			}
 			out.Uint32ToMessage[key] = converted
 		}
@@ -262,21 +558,14 @@ func TestRecord2ToTestRecord2Datastore(
 		out.BoolToMessage = make(map[bool]MapValueMessageDatastore, len(src.BoolToMessage))
 		for key, value := range src.BoolToMessage {
 			var converted MapValueMessageDatastore
-			_, err = MapValueMessageToMapValueMessageDatastore(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageDatastoreWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting BoolToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2Datastore.ElementError(err, "bool_to_message", key)
 			}
 			out.BoolToMessage[key] = converted
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -296,10 +585,47 @@ func TestRecord2FromTestRecord2Datastore(
 	dest *api.TestRecord2,
 	src *TestRecord2Datastore,
 	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) (out *api.TestRecord2, err error) {
+	out, err = TestRecord2FromTestRecord2DatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord2FromTestRecord2DatastoreWithOptions converts a TestRecord2Datastore back to TestRecord2 under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination TestRecord2 message (if nil, a new one is created)
+//   - src: Source TestRecord2Datastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord2 message
+//   - Error if conversion fails
+func TestRecord2FromTestRecord2DatastoreWithOptions(
+	ctx context.Context,
+	dest *api.TestRecord2,
+	src *TestRecord2Datastore,
+	opts ...converters.ConvertOption,
 ) (out *api.TestRecord2, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.TestRecord2{}
 	}
@@ -313,50 +639,103 @@ func TestRecord2FromTestRecord2Datastore(
 	if src.Int32ToMessage != nil {
 		out.Int32ToMessage = make(map[int32]*api.MapValueMessage, len(src.Int32ToMessage))
 		for key, value := range src.Int32ToMessage {
-			out.Int32ToMessage[key], err = MapValueMessageFromMapValueMessageDatastore(nil, &value, nil)
+			out.Int32ToMessage[key], err = MapValueMessageFromMapValueMessageDatastoreWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2Datastore.ElementError(err, "int32_to_message", key)
 			}
 		}
 	}
 	if src.Int64ToMessage != nil {
 		out.Int64ToMessage = make(map[int64]*api.MapValueMessage, len(src.Int64ToMessage))
 		for key, value := range src.Int64ToMessage {
-			out.Int64ToMessage[key], err = MapValueMessageFromMapValueMessageDatastore(nil, &value, nil)
+			out.Int64ToMessage[key], err = MapValueMessageFromMapValueMessageDatastoreWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int64ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2Datastore.ElementError(err, "int64_to_message", key)
 			}
 		}
 	}
 	if src.Uint32ToMessage != nil {
 		out.Uint32ToMessage = make(map[uint32]*api.MapValueMessage, len(src.Uint32ToMessage))
 		for key, value := range src.Uint32ToMessage {
-			out.Uint32ToMessage[key], err = MapValueMessageFromMapValueMessageDatastore(nil, &value, nil)
+			out.Uint32ToMessage[key], err = MapValueMessageFromMapValueMessageDatastoreWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Uint32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2Datastore.ElementError(err, "uint32_to_message", key)
 			}
 		}
 	}
 	if src.BoolToMessage != nil {
 		out.BoolToMessage = make(map[bool]*api.MapValueMessage, len(src.BoolToMessage))
 		for key, value := range src.BoolToMessage {
-			out.BoolToMessage[key], err = MapValueMessageFromMapValueMessageDatastore(nil, &value, nil)
+			out.BoolToMessage[key], err = MapValueMessageFromMapValueMessageDatastoreWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting BoolToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2Datastore.ElementError(err, "bool_to_message", key)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// TestRecord2SliceToTestRecord2DatastoreSlice converts a slice of api.TestRecord2 with TestRecord2ToTestRecord2Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord2SliceToTestRecord2DatastoreSlice(
+	src []*api.TestRecord2,
+	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) ([]*TestRecord2Datastore, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord2) (*TestRecord2Datastore, error) {
+		return TestRecord2ToTestRecord2Datastore(item, nil, decorator)
+	})
+}
+
+// TestRecord2SliceFromTestRecord2DatastoreSlice converts a slice of TestRecord2Datastore with TestRecord2FromTestRecord2Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord2SliceFromTestRecord2DatastoreSlice(
+	src []*TestRecord2Datastore,
+	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) ([]*api.TestRecord2, error) {
+	return converters.MapSlice(src, func(item *TestRecord2Datastore) (*api.TestRecord2, error) {
+		return TestRecord2FromTestRecord2Datastore(nil, item, decorator)
+	})
+}
+
+// TestRecord2MapToTestRecord2DatastoreMap converts the values of a map with TestRecord2ToTestRecord2Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord2MapToTestRecord2DatastoreMap[K comparable](
+	src map[K]*api.TestRecord2,
+	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) (map[K]*TestRecord2Datastore, error) {
+	return converters.MapValues(src, func(value *api.TestRecord2) (*TestRecord2Datastore, error) {
+		return TestRecord2ToTestRecord2Datastore(value, nil, decorator)
+	})
+}
+
+// TestRecord2MapFromTestRecord2DatastoreMap converts the values of a map with TestRecord2FromTestRecord2Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord2MapFromTestRecord2DatastoreMap[K comparable](
+	src map[K]*TestRecord2Datastore,
+	decorator func(*api.TestRecord2, *TestRecord2Datastore) error,
+) (map[K]*api.TestRecord2, error) {
+	return converters.MapValues(src, func(value *TestRecord2Datastore) (*api.TestRecord2, error) {
+		return TestRecord2FromTestRecord2Datastore(nil, value, decorator)
+	})
+}
+
+// conversionTestRecord3ToTestRecord3Datastore describes TestRecord3ToTestRecord3Datastore in conversion errors.
+var conversionTestRecord3ToTestRecord3Datastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record3",
+	SourceType: "api.TestRecord3",
+	TargetType: "TestRecord3Datastore",
+}
+
+// conversionTestRecord3FromTestRecord3Datastore describes TestRecord3FromTestRecord3Datastore in conversion errors.
+var conversionTestRecord3FromTestRecord3Datastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record3",
+	SourceType: "TestRecord3Datastore",
+	TargetType: "api.TestRecord3",
+}
+
 // TestRecord3ToTestRecord3Datastore converts a TestRecord3 to TestRecord3Datastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -373,10 +752,47 @@ func TestRecord3ToTestRecord3Datastore(
 	src *api.TestRecord3,
 	dest *TestRecord3Datastore,
 	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) (out *TestRecord3Datastore, err error) {
+	out, err = TestRecord3ToTestRecord3DatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord3ToTestRecord3DatastoreWithOptions converts a TestRecord3 to TestRecord3Datastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source TestRecord3 message to convert from
+//   - dest: Destination TestRecord3Datastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord3Datastore entity
+//   - Error if conversion fails
+func TestRecord3ToTestRecord3DatastoreWithOptions(
+	ctx context.Context,
+	src *api.TestRecord3,
+	dest *TestRecord3Datastore,
+	opts ...converters.ConvertOption,
 ) (out *TestRecord3Datastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TestRecord3Datastore{}
 	}
@@ -394,13 +810,6 @@ func TestRecord3ToTestRecord3Datastore(
 		out.CountsByType = src.CountsByType
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -420,10 +829,47 @@ func TestRecord3FromTestRecord3Datastore(
 	dest *api.TestRecord3,
 	src *TestRecord3Datastore,
 	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) (out *api.TestRecord3, err error) {
+	out, err = TestRecord3FromTestRecord3DatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord3FromTestRecord3DatastoreWithOptions converts a TestRecord3Datastore back to TestRecord3 under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination TestRecord3 message (if nil, a new one is created)
+//   - src: Source TestRecord3Datastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord3 message
+//   - Error if conversion fails
+func TestRecord3FromTestRecord3DatastoreWithOptions(
+	ctx context.Context,
+	dest *api.TestRecord3,
+	src *TestRecord3Datastore,
+	opts ...converters.ConvertOption,
 ) (out *api.TestRecord3, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.TestRecord3{}
 	}
@@ -438,12 +884,290 @@ func TestRecord3FromTestRecord3Datastore(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// TestRecord3SliceToTestRecord3DatastoreSlice converts a slice of api.TestRecord3 with TestRecord3ToTestRecord3Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord3SliceToTestRecord3DatastoreSlice(
+	src []*api.TestRecord3,
+	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) ([]*TestRecord3Datastore, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord3) (*TestRecord3Datastore, error) {
+		return TestRecord3ToTestRecord3Datastore(item, nil, decorator)
+	})
+}
+
+// TestRecord3SliceFromTestRecord3DatastoreSlice converts a slice of TestRecord3Datastore with TestRecord3FromTestRecord3Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord3SliceFromTestRecord3DatastoreSlice(
+	src []*TestRecord3Datastore,
+	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) ([]*api.TestRecord3, error) {
+	return converters.MapSlice(src, func(item *TestRecord3Datastore) (*api.TestRecord3, error) {
+		return TestRecord3FromTestRecord3Datastore(nil, item, decorator)
+	})
+}
+
+// TestRecord3MapToTestRecord3DatastoreMap converts the values of a map with TestRecord3ToTestRecord3Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord3MapToTestRecord3DatastoreMap[K comparable](
+	src map[K]*api.TestRecord3,
+	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) (map[K]*TestRecord3Datastore, error) {
+	return converters.MapValues(src, func(value *api.TestRecord3) (*TestRecord3Datastore, error) {
+		return TestRecord3ToTestRecord3Datastore(value, nil, decorator)
+	})
+}
+
+// TestRecord3MapFromTestRecord3DatastoreMap converts the values of a map with TestRecord3FromTestRecord3Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord3MapFromTestRecord3DatastoreMap[K comparable](
+	src map[K]*TestRecord3Datastore,
+	decorator func(*api.TestRecord3, *TestRecord3Datastore) error,
+) (map[K]*api.TestRecord3, error) {
+	return converters.MapValues(src, func(value *TestRecord3Datastore) (*api.TestRecord3, error) {
+		return TestRecord3FromTestRecord3Datastore(nil, value, decorator)
+	})
+}
+
+// conversionTestRecord4ToTestRecord4Datastore describes TestRecord4ToTestRecord4Datastore in conversion errors.
+var conversionTestRecord4ToTestRecord4Datastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record4",
+	SourceType: "api.TestRecord4",
+	TargetType: "TestRecord4Datastore",
+}
+
+// conversionTestRecord4FromTestRecord4Datastore describes TestRecord4FromTestRecord4Datastore in conversion errors.
+var conversionTestRecord4FromTestRecord4Datastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record4",
+	SourceType: "TestRecord4Datastore",
+	TargetType: "api.TestRecord4",
+}
+
+// TestRecord4ToTestRecord4Datastore converts a TestRecord4 to TestRecord4Datastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source TestRecord4 message to convert from
+//   - dest: Destination TestRecord4Datastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TestRecord4Datastore entity
+//   - Error if conversion fails
+func TestRecord4ToTestRecord4Datastore(
+	src *api.TestRecord4,
+	dest *TestRecord4Datastore,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) (out *TestRecord4Datastore, err error) {
+	out, err = TestRecord4ToTestRecord4DatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
+	return out, nil
+}
+
+// TestRecord4ToTestRecord4DatastoreWithOptions converts a TestRecord4 to TestRecord4Datastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source TestRecord4 message to convert from
+//   - dest: Destination TestRecord4Datastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord4Datastore entity
+//   - Error if conversion fails
+func TestRecord4ToTestRecord4DatastoreWithOptions(
+	ctx context.Context,
+	src *api.TestRecord4,
+	dest *TestRecord4Datastore,
+	opts ...converters.ConvertOption,
+) (out *TestRecord4Datastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &TestRecord4Datastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TestRecord4Datastore{
+		Id: RecordID(src.Id),
+	}
+	out = dest
+
+	if src.SeenAt != nil {
+		out.SeenAt = make([]time.Time, len(src.SeenAt))
+		for i, item := range src.SeenAt {
+			out.SeenAt[i] = converters.TimestampToTime(item)
+		}
+	}
+	if src.MemberIds != nil {
+		out.MemberIds = make([]string, len(src.MemberIds))
+		for i, item := range src.MemberIds {
+			out.MemberIds[i] = strconv.FormatUint(uint64(item), 10)
+		}
+	}
+	if src.Deadlines != nil {
+		out.Deadlines = make(map[string]time.Time, len(src.Deadlines))
+		for key, value := range src.Deadlines {
+			out.Deadlines[key] = converters.TimestampToTime(value)
+		}
+	}
+
 	return dest, nil
 }
+
+// TestRecord4FromTestRecord4Datastore converts a TestRecord4Datastore back to TestRecord4.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination TestRecord4 message (if nil, a new one is created)
+//   - src: Source TestRecord4Datastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TestRecord4 message
+//   - Error if conversion fails
+func TestRecord4FromTestRecord4Datastore(
+	dest *api.TestRecord4,
+	src *TestRecord4Datastore,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) (out *api.TestRecord4, err error) {
+	out, err = TestRecord4FromTestRecord4DatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord4FromTestRecord4DatastoreWithOptions converts a TestRecord4Datastore back to TestRecord4 under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination TestRecord4 message (if nil, a new one is created)
+//   - src: Source TestRecord4Datastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TestRecord4 message
+//   - Error if conversion fails
+func TestRecord4FromTestRecord4DatastoreWithOptions(
+	ctx context.Context,
+	dest *api.TestRecord4,
+	src *TestRecord4Datastore,
+	opts ...converters.ConvertOption,
+) (out *api.TestRecord4, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.TestRecord4{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.TestRecord4{
+		Id: string(src.Id),
+	}
+	out = dest
+
+	if src.SeenAt != nil {
+		out.SeenAt = make([]*timestamppb.Timestamp, len(src.SeenAt))
+		for i, item := range src.SeenAt {
+			out.SeenAt[i] = converters.TimeToTimestamp(item)
+		}
+	}
+	if src.MemberIds != nil {
+		out.MemberIds = make([]uint32, len(src.MemberIds))
+		for i, item := range src.MemberIds {
+			out.MemberIds[i], err = converters.ParseUint32(item)
+			if err != nil {
+				return nil, conversionTestRecord4FromTestRecord4Datastore.ElementError(err, "member_ids", i)
+			}
+		}
+	}
+	if src.Deadlines != nil {
+		out.Deadlines = make(map[string]*timestamppb.Timestamp, len(src.Deadlines))
+		for key, value := range src.Deadlines {
+			out.Deadlines[key] = converters.TimeToTimestamp(value)
+		}
+	}
+
+	return dest, nil
+}
+
+// TestRecord4SliceToTestRecord4DatastoreSlice converts a slice of api.TestRecord4 with TestRecord4ToTestRecord4Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord4SliceToTestRecord4DatastoreSlice(
+	src []*api.TestRecord4,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) ([]*TestRecord4Datastore, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord4) (*TestRecord4Datastore, error) {
+		return TestRecord4ToTestRecord4Datastore(item, nil, decorator)
+	})
+}
+
+// TestRecord4SliceFromTestRecord4DatastoreSlice converts a slice of TestRecord4Datastore with TestRecord4FromTestRecord4Datastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord4SliceFromTestRecord4DatastoreSlice(
+	src []*TestRecord4Datastore,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) ([]*api.TestRecord4, error) {
+	return converters.MapSlice(src, func(item *TestRecord4Datastore) (*api.TestRecord4, error) {
+		return TestRecord4FromTestRecord4Datastore(nil, item, decorator)
+	})
+}
+
+// TestRecord4MapToTestRecord4DatastoreMap converts the values of a map with TestRecord4ToTestRecord4Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord4MapToTestRecord4DatastoreMap[K comparable](
+	src map[K]*api.TestRecord4,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) (map[K]*TestRecord4Datastore, error) {
+	return converters.MapValues(src, func(value *api.TestRecord4) (*TestRecord4Datastore, error) {
+		return TestRecord4ToTestRecord4Datastore(value, nil, decorator)
+	})
+}
+
+// TestRecord4MapFromTestRecord4DatastoreMap converts the values of a map with TestRecord4FromTestRecord4Datastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord4MapFromTestRecord4DatastoreMap[K comparable](
+	src map[K]*TestRecord4Datastore,
+	decorator func(*api.TestRecord4, *TestRecord4Datastore) error,
+) (map[K]*api.TestRecord4, error) {
+	return converters.MapValues(src, func(value *TestRecord4Datastore) (*api.TestRecord4, error) {
+		return TestRecord4FromTestRecord4Datastore(nil, value, decorator)
+	})
+}
@@ -2,6 +2,9 @@
 package datastore
 
 import (
+	"maps"
+	"reflect"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/datastore"
@@ -30,6 +33,85 @@ type UserDatastore struct {
 	UpdatedAt time.Time `datastore:"updated_at"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UserDatastore) Equal(other *UserDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UserDatastore) ChangedColumns(other *UserDatastore) []string {
+	if m == nil {
+		m = &UserDatastore{}
+	}
+	if other == nil {
+		other = &UserDatastore{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UserDatastore) Clone() *UserDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UserDatastore with copies of their own.
+func (m *UserDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // Kind returns the Datastore kind name for UserDatastore.
 func (*UserDatastore) Kind() string {
 	return "User"
@@ -58,11 +140,314 @@ type UserWithNamespace struct {
 	UpdatedAt time.Time `datastore:"updated_at"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UserWithNamespace) Equal(other *UserWithNamespace) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UserWithNamespace) ChangedColumns(other *UserWithNamespace) []string {
+	if m == nil {
+		m = &UserWithNamespace{}
+	}
+	if other == nil {
+		other = &UserWithNamespace{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UserWithNamespace) Clone() *UserWithNamespace {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UserWithNamespace with copies of their own.
+func (m *UserWithNamespace) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // Kind returns the Datastore kind name for UserWithNamespace.
 func (*UserWithNamespace) Kind() string {
 	return "User"
 }
 
+// UserPerTenant is the Datastore entity for the source message.
+type UserPerTenant struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Id string `datastore:"id"`
+
+	Name string `datastore:"name"`
+
+	Email string `datastore:"email"`
+
+	Age uint32 `datastore:"age"`
+
+	Birthday time.Time `datastore:"birthday"`
+
+	MemberNumber string `datastore:"member_number"`
+
+	ActivatedAt time.Time `datastore:"activated_at"`
+
+	CreatedAt time.Time `datastore:"created_at"`
+
+	UpdatedAt time.Time `datastore:"updated_at"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UserPerTenant) Equal(other *UserPerTenant) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UserPerTenant) ChangedColumns(other *UserPerTenant) []string {
+	if m == nil {
+		m = &UserPerTenant{}
+	}
+	if other == nil {
+		other = &UserPerTenant{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UserPerTenant) Clone() *UserPerTenant {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UserPerTenant with copies of their own.
+func (m *UserPerTenant) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// Kind returns the Datastore kind name for UserPerTenant.
+func (*UserPerTenant) Kind() string {
+	return "User"
+}
+
+// NoteDatastore is the Datastore entity for the source message.
+type NoteDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Id uint32 `datastore:"-"`
+
+	Text string `datastore:"text"`
+
+	CreatedBy string `datastore:"created_by"`
+
+	UpdatedBy string `datastore:"updated_by"`
+
+	CreatedAt time.Time `datastore:"created_at"`
+
+	UpdatedAt time.Time `datastore:"updated_at"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *NoteDatastore) Equal(other *NoteDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Text == other.Text &&
+		m.CreatedBy == other.CreatedBy &&
+		m.UpdatedBy == other.UpdatedBy &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *NoteDatastore) ChangedColumns(other *NoteDatastore) []string {
+	if m == nil {
+		m = &NoteDatastore{}
+	}
+	if other == nil {
+		other = &NoteDatastore{}
+	}
+	var columns []string
+	if m.Text != other.Text {
+		columns = append(columns, "text")
+	}
+	if m.CreatedBy != other.CreatedBy {
+		columns = append(columns, "created_by")
+	}
+	if m.UpdatedBy != other.UpdatedBy {
+		columns = append(columns, "updated_by")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *NoteDatastore) Clone() *NoteDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a NoteDatastore with copies of their own.
+func (m *NoteDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// Kind returns the Datastore kind name for NoteDatastore.
+func (*NoteDatastore) Kind() string {
+	return "Note"
+}
+
+// LoadKey implements the KeyLoader interface for NoteDatastore.
+// It sets Key and fills Id from the key.
+func (m *NoteDatastore) LoadKey(k *datastore.Key) error {
+	m.Key = k
+	if k != nil {
+		m.Id = uint32(k.ID)
+	}
+	return nil
+}
+
+// Load implements the PropertyLoadSaver interface for NoteDatastore, as
+// required by KeyLoader.
+func (m *NoteDatastore) Load(props []datastore.Property) error {
+	return datastore.LoadStruct(m, props)
+}
+
+// Save implements the PropertyLoadSaver interface for NoteDatastore, as
+// required by KeyLoader.
+func (m *NoteDatastore) Save() ([]datastore.Property, error) {
+	return datastore.SaveStruct(m)
+}
+
 // UserWithLargeText is the Datastore entity for the source message.
 type UserWithLargeText struct {
 	Key *datastore.Key `datastore:"-"`
@@ -86,6 +471,85 @@ type UserWithLargeText struct {
 	UpdatedAt time.Time `datastore:"updated_at"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UserWithLargeText) Equal(other *UserWithLargeText) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UserWithLargeText) ChangedColumns(other *UserWithLargeText) []string {
+	if m == nil {
+		m = &UserWithLargeText{}
+	}
+	if other == nil {
+		other = &UserWithLargeText{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UserWithLargeText) Clone() *UserWithLargeText {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UserWithLargeText with copies of their own.
+func (m *UserWithLargeText) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // Kind returns the Datastore kind name for UserWithLargeText.
 func (*UserWithLargeText) Kind() string {
 	return "UserProfile"
@@ -114,6 +578,88 @@ type UserSimple struct {
 	UpdatedAt time.Time `datastore:"updated_at"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UserSimple) Equal(other *UserSimple) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UserSimple) ChangedColumns(other *UserSimple) []string {
+	if m == nil {
+		m = &UserSimple{}
+	}
+	if other == nil {
+		other = &UserSimple{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UserSimple) Clone() *UserSimple {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UserSimple with copies of their own.
+func (m *UserSimple) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // Kind returns the Datastore kind name for UserSimple.
 func (*UserSimple) Kind() string {
 	return "SimpleUser"
@@ -128,6 +674,221 @@ type AuthorDatastore struct {
 	Email string `datastore:"email"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *AuthorDatastore) Equal(other *AuthorDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Name == other.Name &&
+		m.Email == other.Email
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *AuthorDatastore) ChangedColumns(other *AuthorDatastore) []string {
+	if m == nil {
+		m = &AuthorDatastore{}
+	}
+	if other == nil {
+		other = &AuthorDatastore{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *AuthorDatastore) Clone() *AuthorDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a AuthorDatastore with copies of their own.
+func (m *AuthorDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// BlogDatastore is the Datastore entity for the source message.
+type BlogDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Id uint32 `datastore:"id"`
+
+	Author AuthorDatastore `datastore:"author,flatten"`
+
+	Upvotes int32 `datastore:"upvotes"`
+
+	Title string `datastore:"title"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *BlogDatastore) Equal(other *BlogDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Author.Equal(&other.Author) &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *BlogDatastore) ChangedColumns(other *BlogDatastore) []string {
+	if m == nil {
+		m = &BlogDatastore{}
+	}
+	if other == nil {
+		other = &BlogDatastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	for _, column := range m.Author.ChangedColumns(&other.Author) {
+		columns = append(columns, "author."+column)
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *BlogDatastore) Clone() *BlogDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a BlogDatastore with copies of their own.
+func (m *BlogDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Author.cloneFields()
+}
+
+// Kind returns the Datastore kind name for BlogDatastore.
+func (*BlogDatastore) Kind() string {
+	return "Blog"
+}
+
+// BlogJsonDatastore is the Datastore entity for the source message.
+type BlogJsonDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Id uint32 `datastore:"id"`
+
+	Author string `datastore:"author,noindex"`
+
+	Upvotes int32 `datastore:"upvotes"`
+
+	Title string `datastore:"title"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *BlogJsonDatastore) Equal(other *BlogJsonDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Author == other.Author &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *BlogJsonDatastore) ChangedColumns(other *BlogJsonDatastore) []string {
+	if m == nil {
+		m = &BlogJsonDatastore{}
+	}
+	if other == nil {
+		other = &BlogJsonDatastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *BlogJsonDatastore) Clone() *BlogJsonDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a BlogJsonDatastore with copies of their own.
+func (m *BlogJsonDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// Kind returns the Datastore kind name for BlogJsonDatastore.
+func (*BlogJsonDatastore) Kind() string {
+	return "BlogJson"
+}
+
 // ProductDatastore is the Datastore entity for the source message.
 type ProductDatastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -145,6 +906,77 @@ type ProductDatastore struct {
 	Ratings []int32 `datastore:"ratings"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *ProductDatastore) Equal(other *ProductDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		reflect.DeepEqual(m.Categories, other.Categories) &&
+		reflect.DeepEqual(m.Metadata, other.Metadata) &&
+		reflect.DeepEqual(m.Ratings, other.Ratings)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *ProductDatastore) ChangedColumns(other *ProductDatastore) []string {
+	if m == nil {
+		m = &ProductDatastore{}
+	}
+	if other == nil {
+		other = &ProductDatastore{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if !reflect.DeepEqual(m.Categories, other.Categories) {
+		columns = append(columns, "categories")
+	}
+	if !reflect.DeepEqual(m.Metadata, other.Metadata) {
+		columns = append(columns, "metadata")
+	}
+	if !reflect.DeepEqual(m.Ratings, other.Ratings) {
+		columns = append(columns, "ratings")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *ProductDatastore) Clone() *ProductDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a ProductDatastore with copies of their own.
+func (m *ProductDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+	m.Categories = slices.Clone(m.Categories)
+	m.Metadata = maps.Clone(m.Metadata)
+	m.Ratings = slices.Clone(m.Ratings)
+}
+
 // Kind returns the Datastore kind name for ProductDatastore.
 func (*ProductDatastore) Kind() string {
 	return "Product"
@@ -161,6 +993,68 @@ type LibraryDatastore struct {
 	Contributors []AuthorDatastore `datastore:"contributors"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *LibraryDatastore) Equal(other *LibraryDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Contributors, other.Contributors)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *LibraryDatastore) ChangedColumns(other *LibraryDatastore) []string {
+	if m == nil {
+		m = &LibraryDatastore{}
+	}
+	if other == nil {
+		other = &LibraryDatastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Contributors, other.Contributors) {
+		columns = append(columns, "contributors")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *LibraryDatastore) Clone() *LibraryDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a LibraryDatastore with copies of their own.
+func (m *LibraryDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Contributors = slices.Clone(m.Contributors)
+	for i := range m.Contributors {
+		m.Contributors[i].cloneFields()
+	}
+}
+
 // Kind returns the Datastore kind name for LibraryDatastore.
 func (*LibraryDatastore) Kind() string {
 	return "Library"
@@ -177,6 +1071,69 @@ type OrganizationDatastore struct {
 	Departments map[string]AuthorDatastore `datastore:"departments"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *OrganizationDatastore) Equal(other *OrganizationDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Departments, other.Departments)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *OrganizationDatastore) ChangedColumns(other *OrganizationDatastore) []string {
+	if m == nil {
+		m = &OrganizationDatastore{}
+	}
+	if other == nil {
+		other = &OrganizationDatastore{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Departments, other.Departments) {
+		columns = append(columns, "departments")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *OrganizationDatastore) Clone() *OrganizationDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a OrganizationDatastore with copies of their own.
+func (m *OrganizationDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Departments = maps.Clone(m.Departments)
+	for key, value := range m.Departments {
+		value.cloneFields()
+		m.Departments[key] = value
+	}
+}
+
 // Kind returns the Datastore kind name for OrganizationDatastore.
 func (*OrganizationDatastore) Kind() string {
 	return "Organization"
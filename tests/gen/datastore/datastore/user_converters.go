@@ -4,13 +4,31 @@ package datastore
 import (
 	"strconv"
 
-	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"cloud.google.com/go/datastore"
+
+	"context"
 
-	"fmt"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 )
 
+// conversionUserToUserDatastore describes UserToUserDatastore in conversion errors.
+var conversionUserToUserDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserDatastore",
+}
+
+// conversionUserFromUserDatastore describes UserFromUserDatastore in conversion errors.
+var conversionUserFromUserDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserDatastore",
+	TargetType: "api.User",
+}
+
 // UserToUserDatastore converts a User to UserDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -27,10 +45,47 @@ func UserToUserDatastore(
 	src *api.User,
 	dest *UserDatastore,
 	decorator func(*api.User, *UserDatastore) error,
+) (out *UserDatastore, err error) {
+	out, err = UserToUserDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserToUserDatastoreWithOptions converts a User to UserDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source User message to convert from
+//   - dest: Destination UserDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted UserDatastore entity
+//   - Error if conversion fails
+func UserToUserDatastoreWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *UserDatastore,
+	opts ...converters.ConvertOption,
 ) (out *UserDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserDatastore{}
 	}
@@ -61,13 +116,6 @@ func UserToUserDatastore(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -87,10 +135,47 @@ func UserFromUserDatastore(
 	dest *api.User,
 	src *UserDatastore,
 	decorator func(*api.User, *UserDatastore) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserFromUserDatastoreWithOptions converts a UserDatastore back to User under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.User,
+	src *UserDatastore,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -109,16 +194,69 @@ func UserFromUserDatastore(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// UserSliceToUserDatastoreSlice converts a slice of api.User with UserToUserDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserDatastoreSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserDatastore) error,
+) ([]*UserDatastore, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserDatastore, error) {
+		return UserToUserDatastore(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserDatastoreSlice converts a slice of UserDatastore with UserFromUserDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserDatastoreSlice(
+	src []*UserDatastore,
+	decorator func(*api.User, *UserDatastore) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserDatastore) (*api.User, error) {
+		return UserFromUserDatastore(nil, item, decorator)
+	})
+}
+
+// UserMapToUserDatastoreMap converts the values of a map with UserToUserDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserDatastoreMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserDatastore) error,
+) (map[K]*UserDatastore, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserDatastore, error) {
+		return UserToUserDatastore(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserDatastoreMap converts the values of a map with UserFromUserDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserDatastoreMap[K comparable](
+	src map[K]*UserDatastore,
+	decorator func(*api.User, *UserDatastore) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserDatastore) (*api.User, error) {
+		return UserFromUserDatastore(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithNamespace describes UserToUserWithNamespace in conversion errors.
+var conversionUserToUserWithNamespace = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithNamespace",
+}
+
+// conversionUserFromUserWithNamespace describes UserFromUserWithNamespace in conversion errors.
+var conversionUserFromUserWithNamespace = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithNamespace",
+	TargetType: "api.User",
+}
+
 // UserToUserWithNamespace converts a User to UserWithNamespace.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -135,10 +273,47 @@ func UserToUserWithNamespace(
 	src *api.User,
 	dest *UserWithNamespace,
 	decorator func(*api.User, *UserWithNamespace) error,
+) (out *UserWithNamespace, err error) {
+	out, err = UserToUserWithNamespaceWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserToUserWithNamespaceWithOptions converts a User to UserWithNamespace under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source User message to convert from
+//   - dest: Destination UserWithNamespace entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted UserWithNamespace entity
+//   - Error if conversion fails
+func UserToUserWithNamespaceWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *UserWithNamespace,
+	opts ...converters.ConvertOption,
 ) (out *UserWithNamespace, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserWithNamespace{}
 	}
@@ -169,13 +344,6 @@ func UserToUserWithNamespace(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -195,10 +363,47 @@ func UserFromUserWithNamespace(
 	dest *api.User,
 	src *UserWithNamespace,
 	decorator func(*api.User, *UserWithNamespace) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithNamespaceWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserFromUserWithNamespaceWithOptions converts a UserWithNamespace back to User under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserWithNamespace entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserWithNamespaceWithOptions(
+	ctx context.Context,
+	dest *api.User,
+	src *UserWithNamespace,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -217,42 +422,132 @@ func UserFromUserWithNamespace(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// UserSliceToUserWithNamespaceSlice converts a slice of api.User with UserToUserWithNamespace,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithNamespaceSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithNamespace) error,
+) ([]*UserWithNamespace, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithNamespace, error) {
+		return UserToUserWithNamespace(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithNamespaceSlice converts a slice of UserWithNamespace with UserFromUserWithNamespace,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithNamespaceSlice(
+	src []*UserWithNamespace,
+	decorator func(*api.User, *UserWithNamespace) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithNamespace) (*api.User, error) {
+		return UserFromUserWithNamespace(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithNamespaceMap converts the values of a map with UserToUserWithNamespace,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithNamespaceMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithNamespace) error,
+) (map[K]*UserWithNamespace, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithNamespace, error) {
+		return UserToUserWithNamespace(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithNamespaceMap converts the values of a map with UserFromUserWithNamespace,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithNamespaceMap[K comparable](
+	src map[K]*UserWithNamespace,
+	decorator func(*api.User, *UserWithNamespace) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithNamespace) (*api.User, error) {
+		return UserFromUserWithNamespace(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserPerTenant describes UserToUserPerTenant in conversion errors.
+var conversionUserToUserPerTenant = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserPerTenant",
+}
+
+// conversionUserFromUserPerTenant describes UserFromUserPerTenant in conversion errors.
+var conversionUserFromUserPerTenant = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserPerTenant",
+	TargetType: "api.User",
+}
+
+// UserToUserPerTenant converts a User to UserPerTenant.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source User message to convert from
+//   - dest: Destination UserPerTenant entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UserPerTenant entity
+//   - Error if conversion fails
+func UserToUserPerTenant(
+	src *api.User,
+	dest *UserPerTenant,
+	decorator func(*api.User, *UserPerTenant) error,
+) (out *UserPerTenant, err error) {
+	out, err = UserToUserPerTenantWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserToUserWithLargeText converts a User to UserWithLargeText.
+// UserToUserPerTenantWithOptions converts a User to UserPerTenant under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
 //   - src: Source User message to convert from
-//   - dest: Destination UserWithLargeText entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - dest: Destination UserPerTenant entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted UserWithLargeText entity
+//   - Converted UserPerTenant entity
 //   - Error if conversion fails
-func UserToUserWithLargeText(
+func UserToUserPerTenantWithOptions(
+	ctx context.Context,
 	src *api.User,
-	dest *UserWithLargeText,
-	decorator func(*api.User, *UserWithLargeText) error,
-) (out *UserWithLargeText, err error) {
+	dest *UserPerTenant,
+	opts ...converters.ConvertOption,
+) (out *UserPerTenant, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &UserWithLargeText{}
+		dest = &UserPerTenant{}
 	}
 
 	// Initialize struct with inline values
-	*dest = UserWithLargeText{
+	*dest = UserPerTenant{
 		Id:           strconv.FormatUint(uint64(src.Id), 10),
 		Name:         src.Name,
 		Email:        src.Email,
@@ -277,36 +572,66 @@ func UserToUserWithLargeText(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserPerTenant converts a UserPerTenant back to User.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserPerTenant entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserPerTenant(
+	dest *api.User,
+	src *UserPerTenant,
+	decorator func(*api.User, *UserPerTenant) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserPerTenantWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserWithLargeText converts a UserWithLargeText back to User.
+// UserFromUserPerTenantWithOptions converts a UserPerTenant back to User under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
 //   - dest: Destination User message (if nil, a new one is created)
-//   - src: Source UserWithLargeText entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - src: Source UserPerTenant entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
 //   - Converted User message
 //   - Error if conversion fails
-func UserFromUserWithLargeText(
+func UserFromUserPerTenantWithOptions(
+	ctx context.Context,
 	dest *api.User,
-	src *UserWithLargeText,
-	decorator func(*api.User, *UserWithLargeText) error,
+	src *UserPerTenant,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -325,58 +650,139 @@ func UserFromUserWithLargeText(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// UserSliceToUserPerTenantSlice converts a slice of api.User with UserToUserPerTenant,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserPerTenantSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserPerTenant) error,
+) ([]*UserPerTenant, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserPerTenant, error) {
+		return UserToUserPerTenant(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserPerTenantSlice converts a slice of UserPerTenant with UserFromUserPerTenant,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserPerTenantSlice(
+	src []*UserPerTenant,
+	decorator func(*api.User, *UserPerTenant) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserPerTenant) (*api.User, error) {
+		return UserFromUserPerTenant(nil, item, decorator)
+	})
+}
+
+// UserMapToUserPerTenantMap converts the values of a map with UserToUserPerTenant,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserPerTenantMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserPerTenant) error,
+) (map[K]*UserPerTenant, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserPerTenant, error) {
+		return UserToUserPerTenant(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserPerTenantMap converts the values of a map with UserFromUserPerTenant,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserPerTenantMap[K comparable](
+	src map[K]*UserPerTenant,
+	decorator func(*api.User, *UserPerTenant) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserPerTenant) (*api.User, error) {
+		return UserFromUserPerTenant(nil, value, decorator)
+	})
+}
+
+// conversionNoteToNoteDatastore describes NoteToNoteDatastore in conversion errors.
+var conversionNoteToNoteDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "note",
+	SourceType: "api.Note",
+	TargetType: "NoteDatastore",
+}
+
+// conversionNoteFromNoteDatastore describes NoteFromNoteDatastore in conversion errors.
+var conversionNoteFromNoteDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "note",
+	SourceType: "NoteDatastore",
+	TargetType: "api.Note",
+}
+
+// NoteToNoteDatastore converts a Note to NoteDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Note message to convert from
+//   - dest: Destination NoteDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted NoteDatastore entity
+//   - Error if conversion fails
+func NoteToNoteDatastore(
+	src *api.Note,
+	dest *NoteDatastore,
+	decorator func(*api.Note, *NoteDatastore) error,
+) (out *NoteDatastore, err error) {
+	out, err = NoteToNoteDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserToUserSimple converts a User to UserSimple.
+// NoteToNoteDatastoreWithOptions converts a Note to NoteDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source User message to convert from
-//   - dest: Destination UserSimple entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Note message to convert from
+//   - dest: Destination NoteDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted UserSimple entity
+//   - Converted NoteDatastore entity
 //   - Error if conversion fails
-func UserToUserSimple(
-	src *api.User,
-	dest *UserSimple,
-	decorator func(*api.User, *UserSimple) error,
-) (out *UserSimple, err error) {
+func NoteToNoteDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Note,
+	dest *NoteDatastore,
+	opts ...converters.ConvertOption,
+) (out *NoteDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &UserSimple{}
+		dest = &NoteDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = UserSimple{
-		Id:           strconv.FormatUint(uint64(src.Id), 10),
-		Name:         src.Name,
-		Email:        src.Email,
-		Age:          src.Age,
-		MemberNumber: src.MemberNumber,
+	*dest = NoteDatastore{
+		Id:        src.Id,
+		Text:      src.Text,
+		CreatedBy: src.CreatedBy,
+		UpdatedBy: src.UpdatedBy,
 	}
 	out = dest
 
-	if src.Birthday != nil {
-		out.Birthday = converters.TimestampToTime(src.Birthday)
-	}
-
-	if src.ActivatedAt != nil {
-		out.ActivatedAt = converters.TimestampToTime(src.ActivatedAt)
-	}
-
 	if src.CreatedAt != nil {
 		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
 	}
@@ -385,36 +791,301 @@ func UserToUserSimple(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	if src.Key != "" {
+		if out.Key, err = datastore.DecodeKey(src.Key); err != nil {
+			return nil, conversionNoteToNoteDatastore.FieldError(err, "key")
+		}
+	}
+
+	return dest, nil
+}
+
+// NoteFromNoteDatastore converts a NoteDatastore back to Note.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Note message (if nil, a new one is created)
+//   - src: Source NoteDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Note message
+//   - Error if conversion fails
+func NoteFromNoteDatastore(
+	dest *api.Note,
+	src *NoteDatastore,
+	decorator func(*api.Note, *NoteDatastore) error,
+) (out *api.Note, err error) {
+	out, err = NoteFromNoteDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
+	return out, nil
+}
+
+// NoteFromNoteDatastoreWithOptions converts a NoteDatastore back to Note under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Note message (if nil, a new one is created)
+//   - src: Source NoteDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Note message
+//   - Error if conversion fails
+func NoteFromNoteDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Note,
+	src *NoteDatastore,
+	opts ...converters.ConvertOption,
+) (out *api.Note, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Note{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Note{
+		Id:        src.Id,
+		Text:      src.Text,
+		CreatedBy: src.CreatedBy,
+		UpdatedBy: src.UpdatedBy,
+		CreatedAt: converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt: converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	if src.Key != nil {
+		out.Key = src.Key.Encode()
+	}
+
 	return dest, nil
 }
 
-// UserFromUserSimple converts a UserSimple back to User.
+// NoteSliceToNoteDatastoreSlice converts a slice of api.Note with NoteToNoteDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func NoteSliceToNoteDatastoreSlice(
+	src []*api.Note,
+	decorator func(*api.Note, *NoteDatastore) error,
+) ([]*NoteDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Note) (*NoteDatastore, error) {
+		return NoteToNoteDatastore(item, nil, decorator)
+	})
+}
+
+// NoteSliceFromNoteDatastoreSlice converts a slice of NoteDatastore with NoteFromNoteDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func NoteSliceFromNoteDatastoreSlice(
+	src []*NoteDatastore,
+	decorator func(*api.Note, *NoteDatastore) error,
+) ([]*api.Note, error) {
+	return converters.MapSlice(src, func(item *NoteDatastore) (*api.Note, error) {
+		return NoteFromNoteDatastore(nil, item, decorator)
+	})
+}
+
+// NoteMapToNoteDatastoreMap converts the values of a map with NoteToNoteDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func NoteMapToNoteDatastoreMap[K comparable](
+	src map[K]*api.Note,
+	decorator func(*api.Note, *NoteDatastore) error,
+) (map[K]*NoteDatastore, error) {
+	return converters.MapValues(src, func(value *api.Note) (*NoteDatastore, error) {
+		return NoteToNoteDatastore(value, nil, decorator)
+	})
+}
+
+// NoteMapFromNoteDatastoreMap converts the values of a map with NoteFromNoteDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func NoteMapFromNoteDatastoreMap[K comparable](
+	src map[K]*NoteDatastore,
+	decorator func(*api.Note, *NoteDatastore) error,
+) (map[K]*api.Note, error) {
+	return converters.MapValues(src, func(value *NoteDatastore) (*api.Note, error) {
+		return NoteFromNoteDatastore(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithLargeText describes UserToUserWithLargeText in conversion errors.
+var conversionUserToUserWithLargeText = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithLargeText",
+}
+
+// conversionUserFromUserWithLargeText describes UserFromUserWithLargeText in conversion errors.
+var conversionUserFromUserWithLargeText = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithLargeText",
+	TargetType: "api.User",
+}
+
+// UserToUserWithLargeText converts a User to UserWithLargeText.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source User message to convert from
+//   - dest: Destination UserWithLargeText entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UserWithLargeText entity
+//   - Error if conversion fails
+func UserToUserWithLargeText(
+	src *api.User,
+	dest *UserWithLargeText,
+	decorator func(*api.User, *UserWithLargeText) error,
+) (out *UserWithLargeText, err error) {
+	out, err = UserToUserWithLargeTextWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserToUserWithLargeTextWithOptions converts a User to UserWithLargeText under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source User message to convert from
+//   - dest: Destination UserWithLargeText entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted UserWithLargeText entity
+//   - Error if conversion fails
+func UserToUserWithLargeTextWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *UserWithLargeText,
+	opts ...converters.ConvertOption,
+) (out *UserWithLargeText, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &UserWithLargeText{}
+	}
+
+	// Initialize struct with inline values
+	*dest = UserWithLargeText{
+		Id:           strconv.FormatUint(uint64(src.Id), 10),
+		Name:         src.Name,
+		Email:        src.Email,
+		Age:          src.Age,
+		MemberNumber: src.MemberNumber,
+	}
+	out = dest
+
+	if src.Birthday != nil {
+		out.Birthday = converters.TimestampToTime(src.Birthday)
+	}
+
+	if src.ActivatedAt != nil {
+		out.ActivatedAt = converters.TimestampToTime(src.ActivatedAt)
+	}
+
+	if src.CreatedAt != nil {
+		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
+	}
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	return dest, nil
+}
+
+// UserFromUserWithLargeText converts a UserWithLargeText back to User.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
 //   - dest: Destination User message (if nil, a new one is created)
-//   - src: Source UserSimple entity to convert from
+//   - src: Source UserWithLargeText entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
 //   - Converted User message
 //   - Error if conversion fails
-func UserFromUserSimple(
+func UserFromUserWithLargeText(
 	dest *api.User,
-	src *UserSimple,
-	decorator func(*api.User, *UserSimple) error,
+	src *UserWithLargeText,
+	decorator func(*api.User, *UserWithLargeText) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithLargeTextWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserFromUserWithLargeTextWithOptions converts a UserWithLargeText back to User under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserWithLargeText entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserWithLargeTextWithOptions(
+	ctx context.Context,
+	dest *api.User,
+	src *UserWithLargeText,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -433,98 +1104,931 @@ func UserFromUserSimple(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// UserSliceToUserWithLargeTextSlice converts a slice of api.User with UserToUserWithLargeText,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithLargeTextSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithLargeText) error,
+) ([]*UserWithLargeText, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithLargeText, error) {
+		return UserToUserWithLargeText(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithLargeTextSlice converts a slice of UserWithLargeText with UserFromUserWithLargeText,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithLargeTextSlice(
+	src []*UserWithLargeText,
+	decorator func(*api.User, *UserWithLargeText) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithLargeText) (*api.User, error) {
+		return UserFromUserWithLargeText(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithLargeTextMap converts the values of a map with UserToUserWithLargeText,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithLargeTextMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithLargeText) error,
+) (map[K]*UserWithLargeText, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithLargeText, error) {
+		return UserToUserWithLargeText(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithLargeTextMap converts the values of a map with UserFromUserWithLargeText,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithLargeTextMap[K comparable](
+	src map[K]*UserWithLargeText,
+	decorator func(*api.User, *UserWithLargeText) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithLargeText) (*api.User, error) {
+		return UserFromUserWithLargeText(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserSimple describes UserToUserSimple in conversion errors.
+var conversionUserToUserSimple = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserSimple",
+}
+
+// conversionUserFromUserSimple describes UserFromUserSimple in conversion errors.
+var conversionUserFromUserSimple = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserSimple",
+	TargetType: "api.User",
+}
+
+// UserToUserSimple converts a User to UserSimple.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source User message to convert from
+//   - dest: Destination UserSimple entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UserSimple entity
+//   - Error if conversion fails
+func UserToUserSimple(
+	src *api.User,
+	dest *UserSimple,
+	decorator func(*api.User, *UserSimple) error,
+) (out *UserSimple, err error) {
+	out, err = UserToUserSimpleWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// AuthorToAuthorDatastore converts a Author to AuthorDatastore.
+// UserToUserSimpleWithOptions converts a User to UserSimple under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source Author message to convert from
-//   - dest: Destination AuthorDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source User message to convert from
+//   - dest: Destination UserSimple entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted AuthorDatastore entity
+//   - Converted UserSimple entity
 //   - Error if conversion fails
-func AuthorToAuthorDatastore(
-	src *api.Author,
-	dest *AuthorDatastore,
-	decorator func(*api.Author, *AuthorDatastore) error,
-) (out *AuthorDatastore, err error) {
+func UserToUserSimpleWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *UserSimple,
+	opts ...converters.ConvertOption,
+) (out *UserSimple, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &AuthorDatastore{}
+		dest = &UserSimple{}
 	}
 
 	// Initialize struct with inline values
-	*dest = AuthorDatastore{
-		Name:  src.Name,
-		Email: src.Email,
+	*dest = UserSimple{
+		Id:           strconv.FormatUint(uint64(src.Id), 10),
+		Name:         src.Name,
+		Email:        src.Email,
+		Age:          src.Age,
+		MemberNumber: src.MemberNumber,
+	}
+	out = dest
+
+	if src.Birthday != nil {
+		out.Birthday = converters.TimestampToTime(src.Birthday)
+	}
+
+	if src.ActivatedAt != nil {
+		out.ActivatedAt = converters.TimestampToTime(src.ActivatedAt)
+	}
+
+	if src.CreatedAt != nil {
+		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
+	}
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	return dest, nil
+}
+
+// UserFromUserSimple converts a UserSimple back to User.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserSimple entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserSimple(
+	dest *api.User,
+	src *UserSimple,
+	decorator func(*api.User, *UserSimple) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserSimpleWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserFromUserSimpleWithOptions converts a UserSimple back to User under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination User message (if nil, a new one is created)
+//   - src: Source UserSimple entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted User message
+//   - Error if conversion fails
+func UserFromUserSimpleWithOptions(
+	ctx context.Context,
+	dest *api.User,
+	src *UserSimple,
+	opts ...converters.ConvertOption,
+) (out *api.User, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.User{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.User{
+		Id:           uint32(converters.MustParseUint(src.Id)),
+		Name:         src.Name,
+		Email:        src.Email,
+		Age:          src.Age,
+		Birthday:     converters.TimeToTimestamp(src.Birthday),
+		MemberNumber: src.MemberNumber,
+		ActivatedAt:  converters.TimeToTimestamp(src.ActivatedAt),
+		CreatedAt:    converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:    converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// UserSliceToUserSimpleSlice converts a slice of api.User with UserToUserSimple,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserSimpleSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserSimple) error,
+) ([]*UserSimple, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserSimple, error) {
+		return UserToUserSimple(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserSimpleSlice converts a slice of UserSimple with UserFromUserSimple,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserSimpleSlice(
+	src []*UserSimple,
+	decorator func(*api.User, *UserSimple) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserSimple) (*api.User, error) {
+		return UserFromUserSimple(nil, item, decorator)
+	})
+}
+
+// UserMapToUserSimpleMap converts the values of a map with UserToUserSimple,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserSimpleMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserSimple) error,
+) (map[K]*UserSimple, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserSimple, error) {
+		return UserToUserSimple(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserSimpleMap converts the values of a map with UserFromUserSimple,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserSimpleMap[K comparable](
+	src map[K]*UserSimple,
+	decorator func(*api.User, *UserSimple) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserSimple) (*api.User, error) {
+		return UserFromUserSimple(nil, value, decorator)
+	})
+}
+
+// conversionAuthorToAuthorDatastore describes AuthorToAuthorDatastore in conversion errors.
+var conversionAuthorToAuthorDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "author",
+	SourceType: "api.Author",
+	TargetType: "AuthorDatastore",
+}
+
+// conversionAuthorFromAuthorDatastore describes AuthorFromAuthorDatastore in conversion errors.
+var conversionAuthorFromAuthorDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "author",
+	SourceType: "AuthorDatastore",
+	TargetType: "api.Author",
+}
+
+// AuthorToAuthorDatastore converts a Author to AuthorDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Author message to convert from
+//   - dest: Destination AuthorDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted AuthorDatastore entity
+//   - Error if conversion fails
+func AuthorToAuthorDatastore(
+	src *api.Author,
+	dest *AuthorDatastore,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) (out *AuthorDatastore, err error) {
+	out, err = AuthorToAuthorDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// AuthorToAuthorDatastoreWithOptions converts a Author to AuthorDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Author message to convert from
+//   - dest: Destination AuthorDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted AuthorDatastore entity
+//   - Error if conversion fails
+func AuthorToAuthorDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Author,
+	dest *AuthorDatastore,
+	opts ...converters.ConvertOption,
+) (out *AuthorDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &AuthorDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = AuthorDatastore{
+		Name:  src.Name,
+		Email: src.Email,
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// AuthorFromAuthorDatastore converts a AuthorDatastore back to Author.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Author message (if nil, a new one is created)
+//   - src: Source AuthorDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Author message
+//   - Error if conversion fails
+func AuthorFromAuthorDatastore(
+	dest *api.Author,
+	src *AuthorDatastore,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) (out *api.Author, err error) {
+	out, err = AuthorFromAuthorDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// AuthorFromAuthorDatastoreWithOptions converts a AuthorDatastore back to Author under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Author message (if nil, a new one is created)
+//   - src: Source AuthorDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Author message
+//   - Error if conversion fails
+func AuthorFromAuthorDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Author,
+	src *AuthorDatastore,
+	opts ...converters.ConvertOption,
+) (out *api.Author, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Author{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Author{
+		Name:  src.Name,
+		Email: src.Email,
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// AuthorSliceToAuthorDatastoreSlice converts a slice of api.Author with AuthorToAuthorDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func AuthorSliceToAuthorDatastoreSlice(
+	src []*api.Author,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) ([]*AuthorDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Author) (*AuthorDatastore, error) {
+		return AuthorToAuthorDatastore(item, nil, decorator)
+	})
+}
+
+// AuthorSliceFromAuthorDatastoreSlice converts a slice of AuthorDatastore with AuthorFromAuthorDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func AuthorSliceFromAuthorDatastoreSlice(
+	src []*AuthorDatastore,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) ([]*api.Author, error) {
+	return converters.MapSlice(src, func(item *AuthorDatastore) (*api.Author, error) {
+		return AuthorFromAuthorDatastore(nil, item, decorator)
+	})
+}
+
+// AuthorMapToAuthorDatastoreMap converts the values of a map with AuthorToAuthorDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func AuthorMapToAuthorDatastoreMap[K comparable](
+	src map[K]*api.Author,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) (map[K]*AuthorDatastore, error) {
+	return converters.MapValues(src, func(value *api.Author) (*AuthorDatastore, error) {
+		return AuthorToAuthorDatastore(value, nil, decorator)
+	})
+}
+
+// AuthorMapFromAuthorDatastoreMap converts the values of a map with AuthorFromAuthorDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func AuthorMapFromAuthorDatastoreMap[K comparable](
+	src map[K]*AuthorDatastore,
+	decorator func(*api.Author, *AuthorDatastore) error,
+) (map[K]*api.Author, error) {
+	return converters.MapValues(src, func(value *AuthorDatastore) (*api.Author, error) {
+		return AuthorFromAuthorDatastore(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogDatastore describes BlogToBlogDatastore in conversion errors.
+var conversionBlogToBlogDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogDatastore",
+}
+
+// conversionBlogFromBlogDatastore describes BlogFromBlogDatastore in conversion errors.
+var conversionBlogFromBlogDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogDatastore",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogDatastore converts a Blog to BlogDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Blog message to convert from
+//   - dest: Destination BlogDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted BlogDatastore entity
+//   - Error if conversion fails
+func BlogToBlogDatastore(
+	src *api.Blog,
+	dest *BlogDatastore,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) (out *BlogDatastore, err error) {
+	out, err = BlogToBlogDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// BlogToBlogDatastoreWithOptions converts a Blog to BlogDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Blog message to convert from
+//   - dest: Destination BlogDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted BlogDatastore entity
+//   - Error if conversion fails
+func BlogToBlogDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Blog,
+	dest *BlogDatastore,
+	opts ...converters.ConvertOption,
+) (out *BlogDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &BlogDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = BlogDatastore{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
+	}
+	out = dest
+
+	if src.Author != nil {
+		_, err = AuthorToAuthorDatastoreWithOptions(ctx, src.Author, &out.Author)
+		if err != nil {
+			return nil, conversionBlogToBlogDatastore.FieldError(err, "author")
+		}
+	}
+
+	return dest, nil
+}
+
+// BlogFromBlogDatastore converts a BlogDatastore back to Blog.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Blog message (if nil, a new one is created)
+//   - src: Source BlogDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Blog message
+//   - Error if conversion fails
+func BlogFromBlogDatastore(
+	dest *api.Blog,
+	src *BlogDatastore,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// BlogFromBlogDatastoreWithOptions converts a BlogDatastore back to Blog under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Blog message (if nil, a new one is created)
+//   - src: Source BlogDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Blog message
+//   - Error if conversion fails
+func BlogFromBlogDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Blog,
+	src *BlogDatastore,
+	opts ...converters.ConvertOption,
+) (out *api.Blog, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Blog{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Blog{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
+	}
+	out = dest
+
+	out.Author, err = AuthorFromAuthorDatastoreWithOptions(ctx, nil, &src.Author)
+	if err != nil {
+		return nil, conversionBlogFromBlogDatastore.FieldError(err, "author")
+	}
+
+	return dest, nil
+}
+
+// BlogSliceToBlogDatastoreSlice converts a slice of api.Blog with BlogToBlogDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogDatastoreSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) ([]*BlogDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogDatastore, error) {
+		return BlogToBlogDatastore(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogDatastoreSlice converts a slice of BlogDatastore with BlogFromBlogDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogDatastoreSlice(
+	src []*BlogDatastore,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogDatastore) (*api.Blog, error) {
+		return BlogFromBlogDatastore(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogDatastoreMap converts the values of a map with BlogToBlogDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogDatastoreMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) (map[K]*BlogDatastore, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogDatastore, error) {
+		return BlogToBlogDatastore(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogDatastoreMap converts the values of a map with BlogFromBlogDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogDatastoreMap[K comparable](
+	src map[K]*BlogDatastore,
+	decorator func(*api.Blog, *BlogDatastore) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogDatastore) (*api.Blog, error) {
+		return BlogFromBlogDatastore(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogJsonDatastore describes BlogToBlogJsonDatastore in conversion errors.
+var conversionBlogToBlogJsonDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogJsonDatastore",
+}
+
+// conversionBlogFromBlogJsonDatastore describes BlogFromBlogJsonDatastore in conversion errors.
+var conversionBlogFromBlogJsonDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogJsonDatastore",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogJsonDatastore converts a Blog to BlogJsonDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Blog message to convert from
+//   - dest: Destination BlogJsonDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted BlogJsonDatastore entity
+//   - Error if conversion fails
+func BlogToBlogJsonDatastore(
+	src *api.Blog,
+	dest *BlogJsonDatastore,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) (out *BlogJsonDatastore, err error) {
+	out, err = BlogToBlogJsonDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// BlogToBlogJsonDatastoreWithOptions converts a Blog to BlogJsonDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Blog message to convert from
+//   - dest: Destination BlogJsonDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted BlogJsonDatastore entity
+//   - Error if conversion fails
+func BlogToBlogJsonDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Blog,
+	dest *BlogJsonDatastore,
+	opts ...converters.ConvertOption,
+) (out *BlogJsonDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &BlogJsonDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = BlogJsonDatastore{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
+	}
+	out = dest
+
+	if src.Author != nil {
+		out.Author, err = converters.MessageToJSON(src.Author)
+		if err != nil {
+			return nil, conversionBlogToBlogJsonDatastore.FieldError(err, "author")
+		}
+	}
+
+	return dest, nil
+}
+
+// BlogFromBlogJsonDatastore converts a BlogJsonDatastore back to Blog.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Blog message (if nil, a new one is created)
+//   - src: Source BlogJsonDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Blog message
+//   - Error if conversion fails
+func BlogFromBlogJsonDatastore(
+	dest *api.Blog,
+	src *BlogJsonDatastore,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogJsonDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// AuthorFromAuthorDatastore converts a AuthorDatastore back to Author.
+// BlogFromBlogJsonDatastoreWithOptions converts a BlogJsonDatastore back to Blog under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination Author message (if nil, a new one is created)
-//   - src: Source AuthorDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Blog message (if nil, a new one is created)
+//   - src: Source BlogJsonDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted Author message
+//   - Converted Blog message
 //   - Error if conversion fails
-func AuthorFromAuthorDatastore(
-	dest *api.Author,
-	src *AuthorDatastore,
-	decorator func(*api.Author, *AuthorDatastore) error,
-) (out *api.Author, err error) {
+func BlogFromBlogJsonDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Blog,
+	src *BlogJsonDatastore,
+	opts ...converters.ConvertOption,
+) (out *api.Blog, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &api.Author{}
+		dest = &api.Blog{}
 	}
 
 	// Initialize struct with inline values
-	*dest = api.Author{
-		Name:  src.Name,
-		Email: src.Email,
+	*dest = api.Blog{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
+	out.Author, err = converters.JSONToMessage[*api.Author](src.Author)
+	if err != nil {
+		return nil, conversionBlogFromBlogJsonDatastore.FieldError(err, "author")
 	}
 
 	return dest, nil
 }
 
+// BlogSliceToBlogJsonDatastoreSlice converts a slice of api.Blog with BlogToBlogJsonDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogJsonDatastoreSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) ([]*BlogJsonDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogJsonDatastore, error) {
+		return BlogToBlogJsonDatastore(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogJsonDatastoreSlice converts a slice of BlogJsonDatastore with BlogFromBlogJsonDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogJsonDatastoreSlice(
+	src []*BlogJsonDatastore,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogJsonDatastore) (*api.Blog, error) {
+		return BlogFromBlogJsonDatastore(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogJsonDatastoreMap converts the values of a map with BlogToBlogJsonDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogJsonDatastoreMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) (map[K]*BlogJsonDatastore, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogJsonDatastore, error) {
+		return BlogToBlogJsonDatastore(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogJsonDatastoreMap converts the values of a map with BlogFromBlogJsonDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogJsonDatastoreMap[K comparable](
+	src map[K]*BlogJsonDatastore,
+	decorator func(*api.Blog, *BlogJsonDatastore) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogJsonDatastore) (*api.Blog, error) {
+		return BlogFromBlogJsonDatastore(nil, value, decorator)
+	})
+}
+
+// conversionProductToProductDatastore describes ProductToProductDatastore in conversion errors.
+var conversionProductToProductDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "product",
+	SourceType: "api.Product",
+	TargetType: "ProductDatastore",
+}
+
+// conversionProductFromProductDatastore describes ProductFromProductDatastore in conversion errors.
+var conversionProductFromProductDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "product",
+	SourceType: "ProductDatastore",
+	TargetType: "api.Product",
+}
+
 // ProductToProductDatastore converts a Product to ProductDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -541,10 +2045,47 @@ func ProductToProductDatastore(
 	src *api.Product,
 	dest *ProductDatastore,
 	decorator func(*api.Product, *ProductDatastore) error,
+) (out *ProductDatastore, err error) {
+	out, err = ProductToProductDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ProductToProductDatastoreWithOptions converts a Product to ProductDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Product message to convert from
+//   - dest: Destination ProductDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted ProductDatastore entity
+//   - Error if conversion fails
+func ProductToProductDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Product,
+	dest *ProductDatastore,
+	opts ...converters.ConvertOption,
 ) (out *ProductDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &ProductDatastore{}
 	}
@@ -563,13 +2104,6 @@ func ProductToProductDatastore(
 		out.Metadata = src.Metadata
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -589,10 +2123,47 @@ func ProductFromProductDatastore(
 	dest *api.Product,
 	src *ProductDatastore,
 	decorator func(*api.Product, *ProductDatastore) error,
+) (out *api.Product, err error) {
+	out, err = ProductFromProductDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ProductFromProductDatastoreWithOptions converts a ProductDatastore back to Product under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Product message (if nil, a new one is created)
+//   - src: Source ProductDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Product message
+//   - Error if conversion fails
+func ProductFromProductDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Product,
+	src *ProductDatastore,
+	opts ...converters.ConvertOption,
 ) (out *api.Product, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Product{}
 	}
@@ -608,16 +2179,69 @@ func ProductFromProductDatastore(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// ProductSliceToProductDatastoreSlice converts a slice of api.Product with ProductToProductDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func ProductSliceToProductDatastoreSlice(
+	src []*api.Product,
+	decorator func(*api.Product, *ProductDatastore) error,
+) ([]*ProductDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Product) (*ProductDatastore, error) {
+		return ProductToProductDatastore(item, nil, decorator)
+	})
+}
+
+// ProductSliceFromProductDatastoreSlice converts a slice of ProductDatastore with ProductFromProductDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func ProductSliceFromProductDatastoreSlice(
+	src []*ProductDatastore,
+	decorator func(*api.Product, *ProductDatastore) error,
+) ([]*api.Product, error) {
+	return converters.MapSlice(src, func(item *ProductDatastore) (*api.Product, error) {
+		return ProductFromProductDatastore(nil, item, decorator)
+	})
+}
+
+// ProductMapToProductDatastoreMap converts the values of a map with ProductToProductDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func ProductMapToProductDatastoreMap[K comparable](
+	src map[K]*api.Product,
+	decorator func(*api.Product, *ProductDatastore) error,
+) (map[K]*ProductDatastore, error) {
+	return converters.MapValues(src, func(value *api.Product) (*ProductDatastore, error) {
+		return ProductToProductDatastore(value, nil, decorator)
+	})
+}
+
+// ProductMapFromProductDatastoreMap converts the values of a map with ProductFromProductDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func ProductMapFromProductDatastoreMap[K comparable](
+	src map[K]*ProductDatastore,
+	decorator func(*api.Product, *ProductDatastore) error,
+) (map[K]*api.Product, error) {
+	return converters.MapValues(src, func(value *ProductDatastore) (*api.Product, error) {
+		return ProductFromProductDatastore(nil, value, decorator)
+	})
+}
+
+// conversionLibraryToLibraryDatastore describes LibraryToLibraryDatastore in conversion errors.
+var conversionLibraryToLibraryDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "library",
+	SourceType: "api.Library",
+	TargetType: "LibraryDatastore",
+}
+
+// conversionLibraryFromLibraryDatastore describes LibraryFromLibraryDatastore in conversion errors.
+var conversionLibraryFromLibraryDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "library",
+	SourceType: "LibraryDatastore",
+	TargetType: "api.Library",
+}
+
 // LibraryToLibraryDatastore converts a Library to LibraryDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -634,10 +2258,47 @@ func LibraryToLibraryDatastore(
 	src *api.Library,
 	dest *LibraryDatastore,
 	decorator func(*api.Library, *LibraryDatastore) error,
+) (out *LibraryDatastore, err error) {
+	out, err = LibraryToLibraryDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// LibraryToLibraryDatastoreWithOptions converts a Library to LibraryDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Library message to convert from
+//   - dest: Destination LibraryDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted LibraryDatastore entity
+//   - Error if conversion fails
+func LibraryToLibraryDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Library,
+	dest *LibraryDatastore,
+	opts ...converters.ConvertOption,
 ) (out *LibraryDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &LibraryDatastore{}
 	}
@@ -652,20 +2313,13 @@ func LibraryToLibraryDatastore(
 	if src.Contributors != nil {
 		out.Contributors = make([]AuthorDatastore, len(src.Contributors))
 		for i, item := range src.Contributors {
-			_, err = AuthorToAuthorDatastore(item, &out.Contributors[i], nil)
+			_, err = AuthorToAuthorDatastoreWithOptions(ctx, item, &out.Contributors[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Contributors[%d]: %w", i, err)
+				return nil, conversionLibraryToLibraryDatastore.ElementError(err, "contributors", i)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -685,10 +2339,47 @@ func LibraryFromLibraryDatastore(
 	dest *api.Library,
 	src *LibraryDatastore,
 	decorator func(*api.Library, *LibraryDatastore) error,
+) (out *api.Library, err error) {
+	out, err = LibraryFromLibraryDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// LibraryFromLibraryDatastoreWithOptions converts a LibraryDatastore back to Library under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Library message (if nil, a new one is created)
+//   - src: Source LibraryDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Library message
+//   - Error if conversion fails
+func LibraryFromLibraryDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Library,
+	src *LibraryDatastore,
+	opts ...converters.ConvertOption,
 ) (out *api.Library, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Library{}
 	}
@@ -703,23 +2394,76 @@ func LibraryFromLibraryDatastore(
 	if src.Contributors != nil {
 		out.Contributors = make([]*api.Author, len(src.Contributors))
 		for i, item := range src.Contributors {
-			out.Contributors[i], err = AuthorFromAuthorDatastore(nil, &item, nil)
+			out.Contributors[i], err = AuthorFromAuthorDatastoreWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Contributors[%d]: %w", i, err)
+				return nil, conversionLibraryFromLibraryDatastore.ElementError(err, "contributors", i)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
+// LibrarySliceToLibraryDatastoreSlice converts a slice of api.Library with LibraryToLibraryDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceToLibraryDatastoreSlice(
+	src []*api.Library,
+	decorator func(*api.Library, *LibraryDatastore) error,
+) ([]*LibraryDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Library) (*LibraryDatastore, error) {
+		return LibraryToLibraryDatastore(item, nil, decorator)
+	})
+}
+
+// LibrarySliceFromLibraryDatastoreSlice converts a slice of LibraryDatastore with LibraryFromLibraryDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceFromLibraryDatastoreSlice(
+	src []*LibraryDatastore,
+	decorator func(*api.Library, *LibraryDatastore) error,
+) ([]*api.Library, error) {
+	return converters.MapSlice(src, func(item *LibraryDatastore) (*api.Library, error) {
+		return LibraryFromLibraryDatastore(nil, item, decorator)
+	})
+}
+
+// LibraryMapToLibraryDatastoreMap converts the values of a map with LibraryToLibraryDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapToLibraryDatastoreMap[K comparable](
+	src map[K]*api.Library,
+	decorator func(*api.Library, *LibraryDatastore) error,
+) (map[K]*LibraryDatastore, error) {
+	return converters.MapValues(src, func(value *api.Library) (*LibraryDatastore, error) {
+		return LibraryToLibraryDatastore(value, nil, decorator)
+	})
+}
+
+// LibraryMapFromLibraryDatastoreMap converts the values of a map with LibraryFromLibraryDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapFromLibraryDatastoreMap[K comparable](
+	src map[K]*LibraryDatastore,
+	decorator func(*api.Library, *LibraryDatastore) error,
+) (map[K]*api.Library, error) {
+	return converters.MapValues(src, func(value *LibraryDatastore) (*api.Library, error) {
+		return LibraryFromLibraryDatastore(nil, value, decorator)
+	})
+}
+
+// conversionOrganizationToOrganizationDatastore describes OrganizationToOrganizationDatastore in conversion errors.
+var conversionOrganizationToOrganizationDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "organization",
+	SourceType: "api.Organization",
+	TargetType: "OrganizationDatastore",
+}
+
+// conversionOrganizationFromOrganizationDatastore describes OrganizationFromOrganizationDatastore in conversion errors.
+var conversionOrganizationFromOrganizationDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "organization",
+	SourceType: "OrganizationDatastore",
+	TargetType: "api.Organization",
+}
+
 // OrganizationToOrganizationDatastore converts a Organization to OrganizationDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
@@ -736,10 +2480,47 @@ func OrganizationToOrganizationDatastore(
 	src *api.Organization,
 	dest *OrganizationDatastore,
 	decorator func(*api.Organization, *OrganizationDatastore) error,
+) (out *OrganizationDatastore, err error) {
+	out, err = OrganizationToOrganizationDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// OrganizationToOrganizationDatastoreWithOptions converts a Organization to OrganizationDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Organization message to convert from
+//   - dest: Destination OrganizationDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted OrganizationDatastore entity
+//   - Error if conversion fails
+func OrganizationToOrganizationDatastoreWithOptions(
+	ctx context.Context,
+	src *api.Organization,
+	dest *OrganizationDatastore,
+	opts ...converters.ConvertOption,
 ) (out *OrganizationDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &OrganizationDatastore{}
 	}
@@ -755,21 +2536,14 @@ func OrganizationToOrganizationDatastore(
 		out.Departments = make(map[string]AuthorDatastore, len(src.Departments))
 		for key, value := range src.Departments {
 			var converted AuthorDatastore
-			_, err = AuthorToAuthorDatastore(value, &converted, nil)
+			_, err = AuthorToAuthorDatastoreWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Departments[%v]: %w", key, err)
+				return nil, conversionOrganizationToOrganizationDatastore.ElementError(err, "departments", key)
 			}
 			out.Departments[key] = converted
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -789,10 +2563,47 @@ func OrganizationFromOrganizationDatastore(
 	dest *api.Organization,
 	src *OrganizationDatastore,
 	decorator func(*api.Organization, *OrganizationDatastore) error,
+) (out *api.Organization, err error) {
+	out, err = OrganizationFromOrganizationDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// OrganizationFromOrganizationDatastoreWithOptions converts a OrganizationDatastore back to Organization under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Organization message (if nil, a new one is created)
+//   - src: Source OrganizationDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Organization message
+//   - Error if conversion fails
+func OrganizationFromOrganizationDatastoreWithOptions(
+	ctx context.Context,
+	dest *api.Organization,
+	src *OrganizationDatastore,
+	opts ...converters.ConvertOption,
 ) (out *api.Organization, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Organization{}
 	}
@@ -807,19 +2618,56 @@ func OrganizationFromOrganizationDatastore(
 	if src.Departments != nil {
 		out.Departments = make(map[string]*api.Author, len(src.Departments))
 		for key, value := range src.Departments {
-			out.Departments[key], err = AuthorFromAuthorDatastore(nil, &value, nil)
+			out.Departments[key], err = AuthorFromAuthorDatastoreWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Departments[%v]: %w", key, err)
+				return nil, conversionOrganizationFromOrganizationDatastore.ElementError(err, "departments", key)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
+
+// OrganizationSliceToOrganizationDatastoreSlice converts a slice of api.Organization with OrganizationToOrganizationDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func OrganizationSliceToOrganizationDatastoreSlice(
+	src []*api.Organization,
+	decorator func(*api.Organization, *OrganizationDatastore) error,
+) ([]*OrganizationDatastore, error) {
+	return converters.MapSlice(src, func(item *api.Organization) (*OrganizationDatastore, error) {
+		return OrganizationToOrganizationDatastore(item, nil, decorator)
+	})
+}
+
+// OrganizationSliceFromOrganizationDatastoreSlice converts a slice of OrganizationDatastore with OrganizationFromOrganizationDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func OrganizationSliceFromOrganizationDatastoreSlice(
+	src []*OrganizationDatastore,
+	decorator func(*api.Organization, *OrganizationDatastore) error,
+) ([]*api.Organization, error) {
+	return converters.MapSlice(src, func(item *OrganizationDatastore) (*api.Organization, error) {
+		return OrganizationFromOrganizationDatastore(nil, item, decorator)
+	})
+}
+
+// OrganizationMapToOrganizationDatastoreMap converts the values of a map with OrganizationToOrganizationDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func OrganizationMapToOrganizationDatastoreMap[K comparable](
+	src map[K]*api.Organization,
+	decorator func(*api.Organization, *OrganizationDatastore) error,
+) (map[K]*OrganizationDatastore, error) {
+	return converters.MapValues(src, func(value *api.Organization) (*OrganizationDatastore, error) {
+		return OrganizationToOrganizationDatastore(value, nil, decorator)
+	})
+}
+
+// OrganizationMapFromOrganizationDatastoreMap converts the values of a map with OrganizationFromOrganizationDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func OrganizationMapFromOrganizationDatastoreMap[K comparable](
+	src map[K]*OrganizationDatastore,
+	decorator func(*api.Organization, *OrganizationDatastore) error,
+) (map[K]*api.Organization, error) {
+	return converters.MapValues(src, func(value *OrganizationDatastore) (*api.Organization, error) {
+		return OrganizationFromOrganizationDatastore(nil, value, decorator)
+	})
+}
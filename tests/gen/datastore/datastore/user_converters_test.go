@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-dal-datastore. DO NOT EDIT.
+package datastore
+
+import (
+	"math/rand"
+	"testing"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/panyam/protoc-gen-dal/pkg/roundtrip"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+)
+
+// TestUserToUserDatastoreRoundTrip checks that UserFromUserDatastore restores what
+// UserToUserDatastore stored, for random api.User messages.
+func TestUserToUserDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserDatastore(%v): %v", src, err)
+		}
+		got, err := UserFromUserDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserDatastoreClone checks that Clone copies the UserDatastore
+// that UserToUserDatastore makes without sharing memory with it.
+func TestUserToUserDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UserDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserDatastore returns the api.User that UserFromUserDatastore
+// should return for the UserDatastore that UserToUserDatastore makes from src.
+func expectedUserFromUserDatastore(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserWithNamespaceRoundTrip checks that UserFromUserWithNamespace restores what
+// UserToUserWithNamespace stored, for random api.User messages.
+func TestUserToUserWithNamespaceRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithNamespace(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithNamespace(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithNamespace(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithNamespace(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithNamespace(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithNamespaceClone checks that Clone copies the UserWithNamespace
+// that UserToUserWithNamespace makes without sharing memory with it.
+func TestUserToUserWithNamespaceClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithNamespace(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithNamespace(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UserWithNamespace", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithNamespace returns the api.User that UserFromUserWithNamespace
+// should return for the UserWithNamespace that UserToUserWithNamespace makes from src.
+func expectedUserFromUserWithNamespace(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserPerTenantRoundTrip checks that UserFromUserPerTenant restores what
+// UserToUserPerTenant stored, for random api.User messages.
+func TestUserToUserPerTenantRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserPerTenant(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserPerTenant(%v): %v", src, err)
+		}
+		got, err := UserFromUserPerTenant(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserPerTenant(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserPerTenant(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserPerTenantClone checks that Clone copies the UserPerTenant
+// that UserToUserPerTenant makes without sharing memory with it.
+func TestUserToUserPerTenantClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserPerTenant(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserPerTenant(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UserPerTenant", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserPerTenant returns the api.User that UserFromUserPerTenant
+// should return for the UserPerTenant that UserToUserPerTenant makes from src.
+func expectedUserFromUserPerTenant(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestNoteToNoteDatastoreRoundTrip checks that NoteFromNoteDatastore restores what
+// NoteToNoteDatastore stored, for random api.Note messages.
+func TestNoteToNoteDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Note{}
+		roundtrip.Fill(src, rng)
+		src.Key = datastore.IDKey("NoteDatastore", int64(i+1), nil).Encode()
+
+		target, err := NoteToNoteDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("NoteToNoteDatastore(%v): %v", src, err)
+		}
+		got, err := NoteFromNoteDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("NoteFromNoteDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedNoteFromNoteDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestNoteToNoteDatastoreClone checks that Clone copies the NoteDatastore
+// that NoteToNoteDatastore makes without sharing memory with it.
+func TestNoteToNoteDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Note{}
+		roundtrip.Fill(src, rng)
+		src.Key = datastore.IDKey("NoteDatastore", int64(i+1), nil).Encode()
+
+		target, err := NoteToNoteDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("NoteToNoteDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("NoteDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedNoteFromNoteDatastore returns the api.Note that NoteFromNoteDatastore
+// should return for the NoteDatastore that NoteToNoteDatastore makes from src.
+func expectedNoteFromNoteDatastore(src *api.Note) *api.Note {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Note)
+	return want
+}
+
+// TestUserToUserWithLargeTextRoundTrip checks that UserFromUserWithLargeText restores what
+// UserToUserWithLargeText stored, for random api.User messages.
+func TestUserToUserWithLargeTextRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithLargeText(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithLargeText(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithLargeText(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithLargeText(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithLargeText(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithLargeTextClone checks that Clone copies the UserWithLargeText
+// that UserToUserWithLargeText makes without sharing memory with it.
+func TestUserToUserWithLargeTextClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithLargeText(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithLargeText(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UserWithLargeText", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithLargeText returns the api.User that UserFromUserWithLargeText
+// should return for the UserWithLargeText that UserToUserWithLargeText makes from src.
+func expectedUserFromUserWithLargeText(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserSimpleRoundTrip checks that UserFromUserSimple restores what
+// UserToUserSimple stored, for random api.User messages.
+func TestUserToUserSimpleRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserSimple(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserSimple(%v): %v", src, err)
+		}
+		got, err := UserFromUserSimple(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserSimple(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserSimple(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserSimpleClone checks that Clone copies the UserSimple
+// that UserToUserSimple makes without sharing memory with it.
+func TestUserToUserSimpleClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserSimple(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserSimple(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UserSimple", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserSimple returns the api.User that UserFromUserSimple
+// should return for the UserSimple that UserToUserSimple makes from src.
+func expectedUserFromUserSimple(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestAuthorToAuthorDatastoreRoundTrip checks that AuthorFromAuthorDatastore restores what
+// AuthorToAuthorDatastore stored, for random api.Author messages.
+func TestAuthorToAuthorDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Author{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AuthorToAuthorDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AuthorToAuthorDatastore(%v): %v", src, err)
+		}
+		got, err := AuthorFromAuthorDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("AuthorFromAuthorDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedAuthorFromAuthorDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestAuthorToAuthorDatastoreClone checks that Clone copies the AuthorDatastore
+// that AuthorToAuthorDatastore makes without sharing memory with it.
+func TestAuthorToAuthorDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Author{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AuthorToAuthorDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AuthorToAuthorDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("AuthorDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedAuthorFromAuthorDatastore returns the api.Author that AuthorFromAuthorDatastore
+// should return for the AuthorDatastore that AuthorToAuthorDatastore makes from src.
+func expectedAuthorFromAuthorDatastore(src *api.Author) *api.Author {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Author)
+	return want
+}
+
+// TestBlogToBlogDatastoreRoundTrip checks that BlogFromBlogDatastore restores what
+// BlogToBlogDatastore stored, for random api.Blog messages.
+func TestBlogToBlogDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogDatastore(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogDatastoreClone checks that Clone copies the BlogDatastore
+// that BlogToBlogDatastore makes without sharing memory with it.
+func TestBlogToBlogDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("BlogDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogDatastore returns the api.Blog that BlogFromBlogDatastore
+// should return for the BlogDatastore that BlogToBlogDatastore makes from src.
+func expectedBlogFromBlogDatastore(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+
+	want.Author = expectedAuthorFromAuthorDatastore(want.Author)
+	return want
+}
+
+// TestBlogToBlogJsonDatastoreRoundTrip checks that BlogFromBlogJsonDatastore restores what
+// BlogToBlogJsonDatastore stored, for random api.Blog messages.
+func TestBlogToBlogJsonDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogJsonDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogJsonDatastore(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogJsonDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogJsonDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogJsonDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogJsonDatastoreClone checks that Clone copies the BlogJsonDatastore
+// that BlogToBlogJsonDatastore makes without sharing memory with it.
+func TestBlogToBlogJsonDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogJsonDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogJsonDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("BlogJsonDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogJsonDatastore returns the api.Blog that BlogFromBlogJsonDatastore
+// should return for the BlogJsonDatastore that BlogToBlogJsonDatastore makes from src.
+func expectedBlogFromBlogJsonDatastore(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+	return want
+}
+
+// TestProductToProductDatastoreRoundTrip checks that ProductFromProductDatastore restores what
+// ProductToProductDatastore stored, for random api.Product messages.
+func TestProductToProductDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Product{}
+		roundtrip.Fill(src, rng)
+
+		target, err := ProductToProductDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("ProductToProductDatastore(%v): %v", src, err)
+		}
+		got, err := ProductFromProductDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("ProductFromProductDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedProductFromProductDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestProductToProductDatastoreClone checks that Clone copies the ProductDatastore
+// that ProductToProductDatastore makes without sharing memory with it.
+func TestProductToProductDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Product{}
+		roundtrip.Fill(src, rng)
+
+		target, err := ProductToProductDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("ProductToProductDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("ProductDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedProductFromProductDatastore returns the api.Product that ProductFromProductDatastore
+// should return for the ProductDatastore that ProductToProductDatastore makes from src.
+func expectedProductFromProductDatastore(src *api.Product) *api.Product {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Product)
+	return want
+}
+
+// TestLibraryToLibraryDatastoreRoundTrip checks that LibraryFromLibraryDatastore restores what
+// LibraryToLibraryDatastore stored, for random api.Library messages.
+func TestLibraryToLibraryDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryDatastore(%v): %v", src, err)
+		}
+		got, err := LibraryFromLibraryDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("LibraryFromLibraryDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedLibraryFromLibraryDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestLibraryToLibraryDatastoreClone checks that Clone copies the LibraryDatastore
+// that LibraryToLibraryDatastore makes without sharing memory with it.
+func TestLibraryToLibraryDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("LibraryDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedLibraryFromLibraryDatastore returns the api.Library that LibraryFromLibraryDatastore
+// should return for the LibraryDatastore that LibraryToLibraryDatastore makes from src.
+func expectedLibraryFromLibraryDatastore(src *api.Library) *api.Library {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Library)
+
+	for i, item := range want.Contributors {
+		want.Contributors[i] = expectedAuthorFromAuthorDatastore(item)
+	}
+	return want
+}
+
+// TestOrganizationToOrganizationDatastoreRoundTrip checks that OrganizationFromOrganizationDatastore restores what
+// OrganizationToOrganizationDatastore stored, for random api.Organization messages.
+func TestOrganizationToOrganizationDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Organization{}
+		roundtrip.Fill(src, rng)
+
+		target, err := OrganizationToOrganizationDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("OrganizationToOrganizationDatastore(%v): %v", src, err)
+		}
+		got, err := OrganizationFromOrganizationDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("OrganizationFromOrganizationDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedOrganizationFromOrganizationDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestOrganizationToOrganizationDatastoreClone checks that Clone copies the OrganizationDatastore
+// that OrganizationToOrganizationDatastore makes without sharing memory with it.
+func TestOrganizationToOrganizationDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Organization{}
+		roundtrip.Fill(src, rng)
+
+		target, err := OrganizationToOrganizationDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("OrganizationToOrganizationDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("OrganizationDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedOrganizationFromOrganizationDatastore returns the api.Organization that OrganizationFromOrganizationDatastore
+// should return for the OrganizationDatastore that OrganizationToOrganizationDatastore makes from src.
+func expectedOrganizationFromOrganizationDatastore(src *api.Organization) *api.Organization {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Organization)
+
+	for key, value := range want.Departments {
+		want.Departments[key] = expectedAuthorFromAuthorDatastore(value)
+	}
+	return want
+}
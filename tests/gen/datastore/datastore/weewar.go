@@ -2,86 +2,15 @@
 package datastore
 
 import (
+	"bytes"
+	"reflect"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/datastore"
 	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
 )
 
-// IndexInfoDatastore is the Datastore entity for the source message.
-type IndexInfoDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	LastUpdatedAt time.Time `datastore:"last_updated_at"`
-
-	LastIndexedAt time.Time `datastore:"last_indexed_at"`
-
-	NeedsIndexing bool `datastore:"needs_indexing"`
-}
-
-// TileDatastore is the Datastore entity for the source message.
-type TileDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	Q int32 `datastore:"q"`
-
-	R int32 `datastore:"r"`
-
-	TileType int32 `datastore:"tile_type"`
-
-	Player int32 `datastore:"player"`
-
-	Shortcut string `datastore:"shortcut"`
-
-	LastActedTurn int32 `datastore:"last_acted_turn"`
-
-	LastToppedupTurn int32 `datastore:"last_toppedup_turn"`
-}
-
-// UnitDatastore is the Datastore entity for the source message.
-type UnitDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	Q int32 `datastore:"q"`
-
-	R int32 `datastore:"r"`
-
-	Player int32 `datastore:"player"`
-
-	UnitType int32 `datastore:"unit_type"`
-
-	Shortcut string `datastore:"shortcut"`
-
-	AvailableHealth int32 `datastore:"available_health"`
-
-	DistanceLeft float64 `datastore:"distance_left"`
-
-	LastActedTurn int32 `datastore:"last_acted_turn"`
-
-	LastToppedupTurn int32 `datastore:"last_toppedup_turn"`
-
-	AttacksReceivedThisTurn int32 `datastore:"attacks_received_this_turn"`
-
-	AttackHistory []AttackRecordDatastore `datastore:"attack_history"`
-
-	ProgressionStep int32 `datastore:"progression_step"`
-
-	ChosenAlternative string `datastore:"chosen_alternative"`
-}
-
-// AttackRecordDatastore is the Datastore entity for the source message.
-type AttackRecordDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	Q int32 `datastore:"q"`
-
-	R int32 `datastore:"r"`
-
-	IsRanged bool `datastore:"is_ranged"`
-
-	TurnNumber int32 `datastore:"turn_number"`
-}
-
 // WorldDatastore is the Datastore entity for the source message.
 type WorldDatastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -115,6 +44,114 @@ type WorldDatastore struct {
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *WorldDatastore) Equal(other *WorldDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Id == other.Id &&
+		m.CreatorId == other.CreatorId &&
+		m.Name == other.Name &&
+		m.Description == other.Description &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		m.ImageUrl == other.ImageUrl &&
+		m.Difficulty == other.Difficulty &&
+		m.WorldData.Equal(&other.WorldData) &&
+		reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) &&
+		m.DefaultGameConfig.Equal(&other.DefaultGameConfig) &&
+		m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) &&
+		m.SearchIndexInfo.Equal(&other.SearchIndexInfo)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *WorldDatastore) ChangedColumns(other *WorldDatastore) []string {
+	if m == nil {
+		m = &WorldDatastore{}
+	}
+	if other == nil {
+		other = &WorldDatastore{}
+	}
+	var columns []string
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.CreatorId != other.CreatorId {
+		columns = append(columns, "creator_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Description != other.Description {
+		columns = append(columns, "description")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if m.ImageUrl != other.ImageUrl {
+		columns = append(columns, "image_url")
+	}
+	if m.Difficulty != other.Difficulty {
+		columns = append(columns, "difficulty")
+	}
+	if !m.WorldData.Equal(&other.WorldData) {
+		columns = append(columns, "world_data")
+	}
+	if !reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) {
+		columns = append(columns, "preview_urls")
+	}
+	if !m.DefaultGameConfig.Equal(&other.DefaultGameConfig) {
+		columns = append(columns, "default_game_config")
+	}
+	if !m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) {
+		columns = append(columns, "screenshot_index_info")
+	}
+	if !m.SearchIndexInfo.Equal(&other.SearchIndexInfo) {
+		columns = append(columns, "search_index_info")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *WorldDatastore) Clone() *WorldDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a WorldDatastore with copies of their own.
+func (m *WorldDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+	m.WorldData.cloneFields()
+	m.PreviewUrls = slices.Clone(m.PreviewUrls)
+	m.DefaultGameConfig.cloneFields()
+	m.ScreenshotIndexInfo.cloneFields()
+	m.SearchIndexInfo.cloneFields()
+}
+
 // Kind returns the Datastore kind name for WorldDatastore.
 func (*WorldDatastore) Kind() string {
 	return "worlds"
@@ -129,6 +166,68 @@ type WorldDataDatastore struct {
 	Units []UnitDatastore `datastore:"units"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *WorldDataDatastore) Equal(other *WorldDataDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.Tiles, other.Tiles) &&
+		reflect.DeepEqual(m.Units, other.Units)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *WorldDataDatastore) ChangedColumns(other *WorldDataDatastore) []string {
+	if m == nil {
+		m = &WorldDataDatastore{}
+	}
+	if other == nil {
+		other = &WorldDataDatastore{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.Tiles, other.Tiles) {
+		columns = append(columns, "tiles")
+	}
+	if !reflect.DeepEqual(m.Units, other.Units) {
+		columns = append(columns, "units")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *WorldDataDatastore) Clone() *WorldDataDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a WorldDataDatastore with copies of their own.
+func (m *WorldDataDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tiles = slices.Clone(m.Tiles)
+	for i := range m.Tiles {
+		m.Tiles[i].cloneFields()
+	}
+	m.Units = slices.Clone(m.Units)
+	for i := range m.Units {
+		m.Units[i].cloneFields()
+	}
+}
+
 // Kind returns the Datastore kind name for WorldDataDatastore.
 func (*WorldDataDatastore) Kind() string {
 	return "world_data"
@@ -167,11 +266,483 @@ type GameDatastore struct {
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameDatastore) Equal(other *GameDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Id == other.Id &&
+		m.CreatorId == other.CreatorId &&
+		m.WorldId == other.WorldId &&
+		m.Name == other.Name &&
+		m.Description == other.Description &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		m.ImageUrl == other.ImageUrl &&
+		m.Difficulty == other.Difficulty &&
+		m.Config.Equal(&other.Config) &&
+		reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) &&
+		m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) &&
+		m.SearchIndexInfo.Equal(&other.SearchIndexInfo)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameDatastore) ChangedColumns(other *GameDatastore) []string {
+	if m == nil {
+		m = &GameDatastore{}
+	}
+	if other == nil {
+		other = &GameDatastore{}
+	}
+	var columns []string
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.CreatorId != other.CreatorId {
+		columns = append(columns, "creator_id")
+	}
+	if m.WorldId != other.WorldId {
+		columns = append(columns, "world_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Description != other.Description {
+		columns = append(columns, "description")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if m.ImageUrl != other.ImageUrl {
+		columns = append(columns, "image_url")
+	}
+	if m.Difficulty != other.Difficulty {
+		columns = append(columns, "difficulty")
+	}
+	if !m.Config.Equal(&other.Config) {
+		columns = append(columns, "config")
+	}
+	if !reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) {
+		columns = append(columns, "preview_urls")
+	}
+	if !m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) {
+		columns = append(columns, "screenshot_index_info")
+	}
+	if !m.SearchIndexInfo.Equal(&other.SearchIndexInfo) {
+		columns = append(columns, "search_index_info")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameDatastore) Clone() *GameDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameDatastore with copies of their own.
+func (m *GameDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Tags = slices.Clone(m.Tags)
+	m.Config.cloneFields()
+	m.PreviewUrls = slices.Clone(m.PreviewUrls)
+	m.ScreenshotIndexInfo.cloneFields()
+	m.SearchIndexInfo.cloneFields()
+}
+
 // Kind returns the Datastore kind name for GameDatastore.
 func (*GameDatastore) Kind() string {
 	return "games"
 }
 
+// GameStateDatastore is the Datastore entity for the source message.
+type GameStateDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	UpdatedAt time.Time `datastore:"updated_at"`
+
+	GameId string `datastore:"game_id"`
+
+	TurnCounter int32 `datastore:"turn_counter"`
+
+	CurrentPlayer int32 `datastore:"current_player"`
+
+	WorldData WorldDataDatastore `datastore:"world_data"`
+
+	StateHash string `datastore:"state_hash"`
+
+	Version int64 `datastore:"version"`
+
+	Status v1.GameStatus `datastore:"status"`
+
+	Finished bool `datastore:"finished"`
+
+	WinningPlayer int32 `datastore:"winning_player"`
+
+	WinningTeam int32 `datastore:"winning_team"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameStateDatastore) Equal(other *GameStateDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.GameId == other.GameId &&
+		m.TurnCounter == other.TurnCounter &&
+		m.CurrentPlayer == other.CurrentPlayer &&
+		m.WorldData.Equal(&other.WorldData) &&
+		m.StateHash == other.StateHash &&
+		m.Version == other.Version &&
+		m.Status == other.Status &&
+		m.Finished == other.Finished &&
+		m.WinningPlayer == other.WinningPlayer &&
+		m.WinningTeam == other.WinningTeam
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameStateDatastore) ChangedColumns(other *GameStateDatastore) []string {
+	if m == nil {
+		m = &GameStateDatastore{}
+	}
+	if other == nil {
+		other = &GameStateDatastore{}
+	}
+	var columns []string
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.GameId != other.GameId {
+		columns = append(columns, "game_id")
+	}
+	if m.TurnCounter != other.TurnCounter {
+		columns = append(columns, "turn_counter")
+	}
+	if m.CurrentPlayer != other.CurrentPlayer {
+		columns = append(columns, "current_player")
+	}
+	if !m.WorldData.Equal(&other.WorldData) {
+		columns = append(columns, "world_data")
+	}
+	if m.StateHash != other.StateHash {
+		columns = append(columns, "state_hash")
+	}
+	if m.Version != other.Version {
+		columns = append(columns, "version")
+	}
+	if m.Status != other.Status {
+		columns = append(columns, "status")
+	}
+	if m.Finished != other.Finished {
+		columns = append(columns, "finished")
+	}
+	if m.WinningPlayer != other.WinningPlayer {
+		columns = append(columns, "winning_player")
+	}
+	if m.WinningTeam != other.WinningTeam {
+		columns = append(columns, "winning_team")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameStateDatastore) Clone() *GameStateDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameStateDatastore with copies of their own.
+func (m *GameStateDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.WorldData.cloneFields()
+}
+
+// GameMoveHistoryDatastore is the Datastore entity for the source message.
+type GameMoveHistoryDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	GameId string `datastore:"game_id"`
+
+	Groups []GameMoveGroupDatastore `datastore:"groups"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameMoveHistoryDatastore) Equal(other *GameMoveHistoryDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.GameId == other.GameId &&
+		reflect.DeepEqual(m.Groups, other.Groups)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameMoveHistoryDatastore) ChangedColumns(other *GameMoveHistoryDatastore) []string {
+	if m == nil {
+		m = &GameMoveHistoryDatastore{}
+	}
+	if other == nil {
+		other = &GameMoveHistoryDatastore{}
+	}
+	var columns []string
+	if m.GameId != other.GameId {
+		columns = append(columns, "game_id")
+	}
+	if !reflect.DeepEqual(m.Groups, other.Groups) {
+		columns = append(columns, "groups")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameMoveHistoryDatastore) Clone() *GameMoveHistoryDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameMoveHistoryDatastore with copies of their own.
+func (m *GameMoveHistoryDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Groups = slices.Clone(m.Groups)
+	for i := range m.Groups {
+		m.Groups[i].cloneFields()
+	}
+}
+
+// MoveUnitActionDatastore is the Datastore entity for the source message.
+type MoveUnitActionDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	FromQ int32 `datastore:"from_q"`
+
+	FromR int32 `datastore:"from_r"`
+
+	ToQ int32 `datastore:"to_q"`
+
+	ToR int32 `datastore:"to_r"`
+
+	MovementCost float64 `datastore:"movement_cost"`
+
+	ReconstructedPath []byte `datastore:"reconstructed_path"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *MoveUnitActionDatastore) Equal(other *MoveUnitActionDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.FromQ == other.FromQ &&
+		m.FromR == other.FromR &&
+		m.ToQ == other.ToQ &&
+		m.ToR == other.ToR &&
+		m.MovementCost == other.MovementCost &&
+		bytes.Equal(m.ReconstructedPath, other.ReconstructedPath)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *MoveUnitActionDatastore) ChangedColumns(other *MoveUnitActionDatastore) []string {
+	if m == nil {
+		m = &MoveUnitActionDatastore{}
+	}
+	if other == nil {
+		other = &MoveUnitActionDatastore{}
+	}
+	var columns []string
+	if m.FromQ != other.FromQ {
+		columns = append(columns, "from_q")
+	}
+	if m.FromR != other.FromR {
+		columns = append(columns, "from_r")
+	}
+	if m.ToQ != other.ToQ {
+		columns = append(columns, "to_q")
+	}
+	if m.ToR != other.ToR {
+		columns = append(columns, "to_r")
+	}
+	if m.MovementCost != other.MovementCost {
+		columns = append(columns, "movement_cost")
+	}
+	if !bytes.Equal(m.ReconstructedPath, other.ReconstructedPath) {
+		columns = append(columns, "reconstructed_path")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *MoveUnitActionDatastore) Clone() *MoveUnitActionDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a MoveUnitActionDatastore with copies of their own.
+func (m *MoveUnitActionDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.ReconstructedPath = bytes.Clone(m.ReconstructedPath)
+}
+
+// GameMoveDatastore is the Datastore entity for the source message.
+type GameMoveDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	MoveType []byte `datastore:"move_type"`
+
+	Player int32 `datastore:"player"`
+
+	Timestamp time.Time `datastore:"timestamp"`
+
+	SequenceNum int64 `datastore:"sequence_num"`
+
+	IsPermanent bool `datastore:"is_permanent"`
+
+	Changes [][]byte `datastore:"changes"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameMoveDatastore) Equal(other *GameMoveDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return bytes.Equal(m.MoveType, other.MoveType) &&
+		m.Player == other.Player &&
+		m.Timestamp.Equal(other.Timestamp) &&
+		m.SequenceNum == other.SequenceNum &&
+		m.IsPermanent == other.IsPermanent &&
+		reflect.DeepEqual(m.Changes, other.Changes)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameMoveDatastore) ChangedColumns(other *GameMoveDatastore) []string {
+	if m == nil {
+		m = &GameMoveDatastore{}
+	}
+	if other == nil {
+		other = &GameMoveDatastore{}
+	}
+	var columns []string
+	if !bytes.Equal(m.MoveType, other.MoveType) {
+		columns = append(columns, "move_type")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if !m.Timestamp.Equal(other.Timestamp) {
+		columns = append(columns, "timestamp")
+	}
+	if m.SequenceNum != other.SequenceNum {
+		columns = append(columns, "sequence_num")
+	}
+	if m.IsPermanent != other.IsPermanent {
+		columns = append(columns, "is_permanent")
+	}
+	if !reflect.DeepEqual(m.Changes, other.Changes) {
+		columns = append(columns, "changes")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameMoveDatastore) Clone() *GameMoveDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameMoveDatastore with copies of their own.
+func (m *GameMoveDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.MoveType = bytes.Clone(m.MoveType)
+	m.Changes = slices.Clone(m.Changes)
+	for i := range m.Changes {
+		m.Changes[i] = bytes.Clone(m.Changes[i])
+	}
+}
+
 // GameConfigurationDatastore is the Datastore entity for the source message.
 type GameConfigurationDatastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -185,23 +756,444 @@ type GameConfigurationDatastore struct {
 	Settings GameSettingsDatastore `datastore:"settings"`
 }
 
-// IncomeConfigDatastore is the Datastore entity for the source message.
-type IncomeConfigDatastore struct {
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameConfigurationDatastore) Equal(other *GameConfigurationDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.Players, other.Players) &&
+		reflect.DeepEqual(m.Teams, other.Teams) &&
+		m.IncomeConfigs.Equal(&other.IncomeConfigs) &&
+		m.Settings.Equal(&other.Settings)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameConfigurationDatastore) ChangedColumns(other *GameConfigurationDatastore) []string {
+	if m == nil {
+		m = &GameConfigurationDatastore{}
+	}
+	if other == nil {
+		other = &GameConfigurationDatastore{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.Players, other.Players) {
+		columns = append(columns, "players")
+	}
+	if !reflect.DeepEqual(m.Teams, other.Teams) {
+		columns = append(columns, "teams")
+	}
+	if !m.IncomeConfigs.Equal(&other.IncomeConfigs) {
+		columns = append(columns, "income_configs")
+	}
+	if !m.Settings.Equal(&other.Settings) {
+		columns = append(columns, "settings")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameConfigurationDatastore) Clone() *GameConfigurationDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameConfigurationDatastore with copies of their own.
+func (m *GameConfigurationDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Players = slices.Clone(m.Players)
+	for i := range m.Players {
+		m.Players[i].cloneFields()
+	}
+	m.Teams = slices.Clone(m.Teams)
+	for i := range m.Teams {
+		m.Teams[i].cloneFields()
+	}
+	m.IncomeConfigs.cloneFields()
+	m.Settings.cloneFields()
+}
+
+// IndexInfoDatastore is the Datastore entity for the source message.
+type IndexInfoDatastore struct {
 	Key *datastore.Key `datastore:"-"`
 
-	StartingCoins int32 `datastore:"starting_coins"`
+	LastUpdatedAt time.Time `datastore:"last_updated_at"`
 
-	GameIncome int32 `datastore:"game_income"`
+	LastIndexedAt time.Time `datastore:"last_indexed_at"`
 
-	LandbaseIncome int32 `datastore:"landbase_income"`
+	NeedsIndexing bool `datastore:"needs_indexing"`
+}
 
-	NavalbaseIncome int32 `datastore:"navalbase_income"`
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *IndexInfoDatastore) Equal(other *IndexInfoDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.LastUpdatedAt.Equal(other.LastUpdatedAt) &&
+		m.LastIndexedAt.Equal(other.LastIndexedAt) &&
+		m.NeedsIndexing == other.NeedsIndexing
+}
 
-	AirportbaseIncome int32 `datastore:"airportbase_income"`
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *IndexInfoDatastore) ChangedColumns(other *IndexInfoDatastore) []string {
+	if m == nil {
+		m = &IndexInfoDatastore{}
+	}
+	if other == nil {
+		other = &IndexInfoDatastore{}
+	}
+	var columns []string
+	if !m.LastUpdatedAt.Equal(other.LastUpdatedAt) {
+		columns = append(columns, "last_updated_at")
+	}
+	if !m.LastIndexedAt.Equal(other.LastIndexedAt) {
+		columns = append(columns, "last_indexed_at")
+	}
+	if m.NeedsIndexing != other.NeedsIndexing {
+		columns = append(columns, "needs_indexing")
+	}
+	return columns
+}
 
-	MissilesiloIncome int32 `datastore:"missilesilo_income"`
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *IndexInfoDatastore) Clone() *IndexInfoDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
 
-	MinesIncome int32 `datastore:"mines_income"`
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a IndexInfoDatastore with copies of their own.
+func (m *IndexInfoDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// TileDatastore is the Datastore entity for the source message.
+type TileDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Q int32 `datastore:"q"`
+
+	R int32 `datastore:"r"`
+
+	TileType int32 `datastore:"tile_type"`
+
+	Player int32 `datastore:"player"`
+
+	Shortcut string `datastore:"shortcut"`
+
+	LastActedTurn int32 `datastore:"last_acted_turn"`
+
+	LastToppedupTurn int32 `datastore:"last_toppedup_turn"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *TileDatastore) Equal(other *TileDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.TileType == other.TileType &&
+		m.Player == other.Player &&
+		m.Shortcut == other.Shortcut &&
+		m.LastActedTurn == other.LastActedTurn &&
+		m.LastToppedupTurn == other.LastToppedupTurn
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *TileDatastore) ChangedColumns(other *TileDatastore) []string {
+	if m == nil {
+		m = &TileDatastore{}
+	}
+	if other == nil {
+		other = &TileDatastore{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.TileType != other.TileType {
+		columns = append(columns, "tile_type")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if m.Shortcut != other.Shortcut {
+		columns = append(columns, "shortcut")
+	}
+	if m.LastActedTurn != other.LastActedTurn {
+		columns = append(columns, "last_acted_turn")
+	}
+	if m.LastToppedupTurn != other.LastToppedupTurn {
+		columns = append(columns, "last_toppedup_turn")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *TileDatastore) Clone() *TileDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a TileDatastore with copies of their own.
+func (m *TileDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
+// UnitDatastore is the Datastore entity for the source message.
+type UnitDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Q int32 `datastore:"q"`
+
+	R int32 `datastore:"r"`
+
+	Player int32 `datastore:"player"`
+
+	UnitType int32 `datastore:"unit_type"`
+
+	Shortcut string `datastore:"shortcut"`
+
+	AvailableHealth int32 `datastore:"available_health"`
+
+	DistanceLeft float64 `datastore:"distance_left"`
+
+	LastActedTurn int32 `datastore:"last_acted_turn"`
+
+	LastToppedupTurn int32 `datastore:"last_toppedup_turn"`
+
+	AttacksReceivedThisTurn int32 `datastore:"attacks_received_this_turn"`
+
+	AttackHistory []AttackRecordDatastore `datastore:"attack_history"`
+
+	ProgressionStep int32 `datastore:"progression_step"`
+
+	ChosenAlternative string `datastore:"chosen_alternative"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *UnitDatastore) Equal(other *UnitDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.Player == other.Player &&
+		m.UnitType == other.UnitType &&
+		m.Shortcut == other.Shortcut &&
+		m.AvailableHealth == other.AvailableHealth &&
+		m.DistanceLeft == other.DistanceLeft &&
+		m.LastActedTurn == other.LastActedTurn &&
+		m.LastToppedupTurn == other.LastToppedupTurn &&
+		m.AttacksReceivedThisTurn == other.AttacksReceivedThisTurn &&
+		reflect.DeepEqual(m.AttackHistory, other.AttackHistory) &&
+		m.ProgressionStep == other.ProgressionStep &&
+		m.ChosenAlternative == other.ChosenAlternative
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *UnitDatastore) ChangedColumns(other *UnitDatastore) []string {
+	if m == nil {
+		m = &UnitDatastore{}
+	}
+	if other == nil {
+		other = &UnitDatastore{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if m.UnitType != other.UnitType {
+		columns = append(columns, "unit_type")
+	}
+	if m.Shortcut != other.Shortcut {
+		columns = append(columns, "shortcut")
+	}
+	if m.AvailableHealth != other.AvailableHealth {
+		columns = append(columns, "available_health")
+	}
+	if m.DistanceLeft != other.DistanceLeft {
+		columns = append(columns, "distance_left")
+	}
+	if m.LastActedTurn != other.LastActedTurn {
+		columns = append(columns, "last_acted_turn")
+	}
+	if m.LastToppedupTurn != other.LastToppedupTurn {
+		columns = append(columns, "last_toppedup_turn")
+	}
+	if m.AttacksReceivedThisTurn != other.AttacksReceivedThisTurn {
+		columns = append(columns, "attacks_received_this_turn")
+	}
+	if !reflect.DeepEqual(m.AttackHistory, other.AttackHistory) {
+		columns = append(columns, "attack_history")
+	}
+	if m.ProgressionStep != other.ProgressionStep {
+		columns = append(columns, "progression_step")
+	}
+	if m.ChosenAlternative != other.ChosenAlternative {
+		columns = append(columns, "chosen_alternative")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *UnitDatastore) Clone() *UnitDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a UnitDatastore with copies of their own.
+func (m *UnitDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.AttackHistory = slices.Clone(m.AttackHistory)
+	for i := range m.AttackHistory {
+		m.AttackHistory[i].cloneFields()
+	}
+}
+
+// GameMoveGroupDatastore is the Datastore entity for the source message.
+type GameMoveGroupDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	StartedAt time.Time `datastore:"started_at"`
+
+	EndedAt time.Time `datastore:"ended_at"`
+
+	Moves []GameMoveDatastore `datastore:"moves"`
+}
+
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameMoveGroupDatastore) Equal(other *GameMoveGroupDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.StartedAt.Equal(other.StartedAt) &&
+		m.EndedAt.Equal(other.EndedAt) &&
+		reflect.DeepEqual(m.Moves, other.Moves)
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameMoveGroupDatastore) ChangedColumns(other *GameMoveGroupDatastore) []string {
+	if m == nil {
+		m = &GameMoveGroupDatastore{}
+	}
+	if other == nil {
+		other = &GameMoveGroupDatastore{}
+	}
+	var columns []string
+	if !m.StartedAt.Equal(other.StartedAt) {
+		columns = append(columns, "started_at")
+	}
+	if !m.EndedAt.Equal(other.EndedAt) {
+		columns = append(columns, "ended_at")
+	}
+	if !reflect.DeepEqual(m.Moves, other.Moves) {
+		columns = append(columns, "moves")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameMoveGroupDatastore) Clone() *GameMoveGroupDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameMoveGroupDatastore with copies of their own.
+func (m *GameMoveGroupDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.Moves = slices.Clone(m.Moves)
+	for i := range m.Moves {
+		m.Moves[i].cloneFields()
+	}
 }
 
 // GamePlayerDatastore is the Datastore entity for the source message.
@@ -225,6 +1217,84 @@ type GamePlayerDatastore struct {
 	Coins int32 `datastore:"coins"`
 }
 
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GamePlayerDatastore) Equal(other *GamePlayerDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.PlayerId == other.PlayerId &&
+		m.PlayerType == other.PlayerType &&
+		m.Color == other.Color &&
+		m.TeamId == other.TeamId &&
+		m.Name == other.Name &&
+		m.IsActive == other.IsActive &&
+		m.StartingCoins == other.StartingCoins &&
+		m.Coins == other.Coins
+}
+
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GamePlayerDatastore) ChangedColumns(other *GamePlayerDatastore) []string {
+	if m == nil {
+		m = &GamePlayerDatastore{}
+	}
+	if other == nil {
+		other = &GamePlayerDatastore{}
+	}
+	var columns []string
+	if m.PlayerId != other.PlayerId {
+		columns = append(columns, "player_id")
+	}
+	if m.PlayerType != other.PlayerType {
+		columns = append(columns, "player_type")
+	}
+	if m.Color != other.Color {
+		columns = append(columns, "color")
+	}
+	if m.TeamId != other.TeamId {
+		columns = append(columns, "team_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.IsActive != other.IsActive {
+		columns = append(columns, "is_active")
+	}
+	if m.StartingCoins != other.StartingCoins {
+		columns = append(columns, "starting_coins")
+	}
+	if m.Coins != other.Coins {
+		columns = append(columns, "coins")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GamePlayerDatastore) Clone() *GamePlayerDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GamePlayerDatastore with copies of their own.
+func (m *GamePlayerDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+}
+
 // GameTeamDatastore is the Datastore entity for the source message.
 type GameTeamDatastore struct {
 	Key *datastore.Key `datastore:"-"`
@@ -238,96 +1308,308 @@ type GameTeamDatastore struct {
 	IsActive bool `datastore:"is_active"`
 }
 
-// GameSettingsDatastore is the Datastore entity for the source message.
-type GameSettingsDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	AllowedUnits []int32 `datastore:"allowed_units"`
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameTeamDatastore) Equal(other *GameTeamDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.TeamId == other.TeamId &&
+		m.Name == other.Name &&
+		m.Color == other.Color &&
+		m.IsActive == other.IsActive
+}
 
-	TurnTimeLimit int32 `datastore:"turn_time_limit"`
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameTeamDatastore) ChangedColumns(other *GameTeamDatastore) []string {
+	if m == nil {
+		m = &GameTeamDatastore{}
+	}
+	if other == nil {
+		other = &GameTeamDatastore{}
+	}
+	var columns []string
+	if m.TeamId != other.TeamId {
+		columns = append(columns, "team_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Color != other.Color {
+		columns = append(columns, "color")
+	}
+	if m.IsActive != other.IsActive {
+		columns = append(columns, "is_active")
+	}
+	return columns
+}
 
-	TeamMode string `datastore:"team_mode"`
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameTeamDatastore) Clone() *GameTeamDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
 
-	MaxTurns int32 `datastore:"max_turns"`
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameTeamDatastore with copies of their own.
+func (m *GameTeamDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
 }
 
-// GameStateDatastore is the Datastore entity for the source message.
-type GameStateDatastore struct {
+// IncomeConfigDatastore is the Datastore entity for the source message.
+type IncomeConfigDatastore struct {
 	Key *datastore.Key `datastore:"-"`
 
-	UpdatedAt time.Time `datastore:"updated_at"`
+	StartingCoins int32 `datastore:"starting_coins"`
 
-	GameId string `datastore:"game_id"`
+	GameIncome int32 `datastore:"game_income"`
 
-	TurnCounter int32 `datastore:"turn_counter"`
+	LandbaseIncome int32 `datastore:"landbase_income"`
 
-	CurrentPlayer int32 `datastore:"current_player"`
+	NavalbaseIncome int32 `datastore:"navalbase_income"`
 
-	WorldData WorldDataDatastore `datastore:"world_data"`
+	AirportbaseIncome int32 `datastore:"airportbase_income"`
 
-	StateHash string `datastore:"state_hash"`
+	MissilesiloIncome int32 `datastore:"missilesilo_income"`
 
-	Version int64 `datastore:"version"`
+	MinesIncome int32 `datastore:"mines_income"`
+}
 
-	Status v1.GameStatus `datastore:"status"`
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *IncomeConfigDatastore) Equal(other *IncomeConfigDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.StartingCoins == other.StartingCoins &&
+		m.GameIncome == other.GameIncome &&
+		m.LandbaseIncome == other.LandbaseIncome &&
+		m.NavalbaseIncome == other.NavalbaseIncome &&
+		m.AirportbaseIncome == other.AirportbaseIncome &&
+		m.MissilesiloIncome == other.MissilesiloIncome &&
+		m.MinesIncome == other.MinesIncome
+}
 
-	Finished bool `datastore:"finished"`
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *IncomeConfigDatastore) ChangedColumns(other *IncomeConfigDatastore) []string {
+	if m == nil {
+		m = &IncomeConfigDatastore{}
+	}
+	if other == nil {
+		other = &IncomeConfigDatastore{}
+	}
+	var columns []string
+	if m.StartingCoins != other.StartingCoins {
+		columns = append(columns, "starting_coins")
+	}
+	if m.GameIncome != other.GameIncome {
+		columns = append(columns, "game_income")
+	}
+	if m.LandbaseIncome != other.LandbaseIncome {
+		columns = append(columns, "landbase_income")
+	}
+	if m.NavalbaseIncome != other.NavalbaseIncome {
+		columns = append(columns, "navalbase_income")
+	}
+	if m.AirportbaseIncome != other.AirportbaseIncome {
+		columns = append(columns, "airportbase_income")
+	}
+	if m.MissilesiloIncome != other.MissilesiloIncome {
+		columns = append(columns, "missilesilo_income")
+	}
+	if m.MinesIncome != other.MinesIncome {
+		columns = append(columns, "mines_income")
+	}
+	return columns
+}
 
-	WinningPlayer int32 `datastore:"winning_player"`
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *IncomeConfigDatastore) Clone() *IncomeConfigDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
 
-	WinningTeam int32 `datastore:"winning_team"`
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a IncomeConfigDatastore with copies of their own.
+func (m *IncomeConfigDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
 }
 
-// GameMoveHistoryDatastore is the Datastore entity for the source message.
-type GameMoveHistoryDatastore struct {
+// GameSettingsDatastore is the Datastore entity for the source message.
+type GameSettingsDatastore struct {
 	Key *datastore.Key `datastore:"-"`
 
-	GameId string `datastore:"game_id"`
+	AllowedUnits []int32 `datastore:"allowed_units"`
 
-	Groups []GameMoveGroupDatastore `datastore:"groups"`
-}
+	TurnTimeLimit int32 `datastore:"turn_time_limit"`
 
-// GameMoveGroupDatastore is the Datastore entity for the source message.
-type GameMoveGroupDatastore struct {
-	Key *datastore.Key `datastore:"-"`
+	TeamMode string `datastore:"team_mode"`
 
-	StartedAt time.Time `datastore:"started_at"`
+	MaxTurns int32 `datastore:"max_turns"`
+}
 
-	EndedAt time.Time `datastore:"ended_at"`
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *GameSettingsDatastore) Equal(other *GameSettingsDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.AllowedUnits, other.AllowedUnits) &&
+		m.TurnTimeLimit == other.TurnTimeLimit &&
+		m.TeamMode == other.TeamMode &&
+		m.MaxTurns == other.MaxTurns
+}
 
-	Moves []GameMoveDatastore `datastore:"moves"`
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *GameSettingsDatastore) ChangedColumns(other *GameSettingsDatastore) []string {
+	if m == nil {
+		m = &GameSettingsDatastore{}
+	}
+	if other == nil {
+		other = &GameSettingsDatastore{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.AllowedUnits, other.AllowedUnits) {
+		columns = append(columns, "allowed_units")
+	}
+	if m.TurnTimeLimit != other.TurnTimeLimit {
+		columns = append(columns, "turn_time_limit")
+	}
+	if m.TeamMode != other.TeamMode {
+		columns = append(columns, "team_mode")
+	}
+	if m.MaxTurns != other.MaxTurns {
+		columns = append(columns, "max_turns")
+	}
+	return columns
 }
 
-// MoveUnitActionDatastore is the Datastore entity for the source message.
-type MoveUnitActionDatastore struct {
-	Key *datastore.Key `datastore:"-"`
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *GameSettingsDatastore) Clone() *GameSettingsDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
 
-	FromQ int32 `datastore:"from_q"`
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a GameSettingsDatastore with copies of their own.
+func (m *GameSettingsDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
+	m.AllowedUnits = slices.Clone(m.AllowedUnits)
+}
 
-	FromR int32 `datastore:"from_r"`
+// AttackRecordDatastore is the Datastore entity for the source message.
+type AttackRecordDatastore struct {
+	Key *datastore.Key `datastore:"-"`
 
-	ToQ int32 `datastore:"to_q"`
+	Q int32 `datastore:"q"`
 
-	ToR int32 `datastore:"to_r"`
+	R int32 `datastore:"r"`
 
-	MovementCost float64 `datastore:"movement_cost"`
+	IsRanged bool `datastore:"is_ranged"`
 
-	ReconstructedPath []byte `datastore:"reconstructed_path"`
+	TurnNumber int32 `datastore:"turn_number"`
 }
 
-// GameMoveDatastore is the Datastore entity for the source message.
-type GameMoveDatastore struct {
-	Key *datastore.Key `datastore:"-"`
-
-	MoveType []byte `datastore:"move_type"`
-
-	Player int32 `datastore:"player"`
-
-	Timestamp time.Time `datastore:"timestamp"`
+// Equal reports whether m and other have the same property values.
+// Keys are not compared.
+func (m *AttackRecordDatastore) Equal(other *AttackRecordDatastore) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.IsRanged == other.IsRanged &&
+		m.TurnNumber == other.TurnNumber
+}
 
-	SequenceNum int64 `datastore:"sequence_num"`
+// ChangedColumns returns the properties of m whose values differ from other,
+// in field order. Properties of flattened structs are included as
+// "field.sub". A nil m or other is compared as the zero value.
+func (m *AttackRecordDatastore) ChangedColumns(other *AttackRecordDatastore) []string {
+	if m == nil {
+		m = &AttackRecordDatastore{}
+	}
+	if other == nil {
+		other = &AttackRecordDatastore{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.IsRanged != other.IsRanged {
+		columns = append(columns, "is_ranged")
+	}
+	if m.TurnNumber != other.TurnNumber {
+		columns = append(columns, "turn_number")
+	}
+	return columns
+}
 
-	IsPermanent bool `datastore:"is_permanent"`
+// Clone returns a deep copy of m that shares no slices, maps, nested structs
+// or keys with it, or nil if m is nil.
+func (m *AttackRecordDatastore) Clone() *AttackRecordDatastore {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
 
-	Changes [][]byte `datastore:"changes"`
+// cloneFields replaces the slices, maps, nested structs and key of a shallow
+// copy of a AttackRecordDatastore with copies of their own.
+func (m *AttackRecordDatastore) cloneFields() {
+	if m.Key != nil {
+		key := *m.Key
+		m.Key = &key
+		for k := m.Key; k.Parent != nil; k = k.Parent {
+			parent := *k.Parent
+			k.Parent = &parent
+		}
+	}
 }
@@ -2,431 +2,598 @@
 package datastore
 
 import (
-	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
+	"context"
 
-	"fmt"
+	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 )
 
-// IndexInfoToIndexInfoDatastore converts a IndexInfo to IndexInfoDatastore.
+// conversionWorldToWorldDatastore describes WorldToWorldDatastore in conversion errors.
+var conversionWorldToWorldDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "world",
+	SourceType: "v1.World",
+	TargetType: "WorldDatastore",
+}
+
+// conversionWorldFromWorldDatastore describes WorldFromWorldDatastore in conversion errors.
+var conversionWorldFromWorldDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "world",
+	SourceType: "WorldDatastore",
+	TargetType: "v1.World",
+}
+
+// WorldToWorldDatastore converts a World to WorldDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - src: Source IndexInfo message to convert from
-//   - dest: Destination IndexInfoDatastore entity (if nil, a new one is created)
+//   - src: Source World message to convert from
+//   - dest: Destination WorldDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted IndexInfoDatastore entity
+//   - Converted WorldDatastore entity
 //   - Error if conversion fails
-func IndexInfoToIndexInfoDatastore(
-	src *v1.IndexInfo,
-	dest *IndexInfoDatastore,
-	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
-) (out *IndexInfoDatastore, err error) {
+func WorldToWorldDatastore(
+	src *v1.World,
+	dest *WorldDatastore,
+	decorator func(*v1.World, *WorldDatastore) error,
+) (out *WorldDatastore, err error) {
+	out, err = WorldToWorldDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// WorldToWorldDatastoreWithOptions converts a World to WorldDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source World message to convert from
+//   - dest: Destination WorldDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted WorldDatastore entity
+//   - Error if conversion fails
+func WorldToWorldDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.World,
+	dest *WorldDatastore,
+	opts ...converters.ConvertOption,
+) (out *WorldDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &IndexInfoDatastore{}
+		dest = &WorldDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = IndexInfoDatastore{
-		NeedsIndexing: src.NeedsIndexing,
+	*dest = WorldDatastore{
+		Id:          src.Id,
+		CreatorId:   src.CreatorId,
+		Name:        src.Name,
+		Description: src.Description,
+		Tags:        src.Tags,
+		ImageUrl:    src.ImageUrl,
+		Difficulty:  src.Difficulty,
+		PreviewUrls: src.PreviewUrls,
 	}
 	out = dest
 
-	if src.LastUpdatedAt != nil {
-		out.LastUpdatedAt = converters.TimestampToTime(src.LastUpdatedAt)
+	if src.CreatedAt != nil {
+		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
 	}
 
-	if src.LastIndexedAt != nil {
-		out.LastIndexedAt = converters.TimestampToTime(src.LastIndexedAt)
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.WorldData != nil {
+		_, err = WorldDataToWorldDataDatastoreWithOptions(ctx, src.WorldData, &out.WorldData)
+		if err != nil {
+			return nil, conversionWorldToWorldDatastore.FieldError(err, "world_data")
+		}
+	}
+	if src.DefaultGameConfig != nil {
+		_, err = GameConfigurationToGameConfigurationDatastoreWithOptions(ctx, src.DefaultGameConfig, &out.DefaultGameConfig)
+		if err != nil {
+			return nil, conversionWorldToWorldDatastore.FieldError(err, "default_game_config")
+		}
+	}
+	if src.ScreenshotIndexInfo != nil {
+		_, err = IndexInfoToIndexInfoDatastoreWithOptions(ctx, src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo)
+		if err != nil {
+			return nil, conversionWorldToWorldDatastore.FieldError(err, "screenshot_index_info")
+		}
+	}
+	if src.SearchIndexInfo != nil {
+		_, err = IndexInfoToIndexInfoDatastoreWithOptions(ctx, src.SearchIndexInfo, &out.SearchIndexInfo)
+		if err != nil {
+			return nil, conversionWorldToWorldDatastore.FieldError(err, "search_index_info")
 		}
 	}
 
 	return dest, nil
 }
 
-// IndexInfoFromIndexInfoDatastore converts a IndexInfoDatastore back to IndexInfo.
+// WorldFromWorldDatastore converts a WorldDatastore back to World.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination IndexInfo message (if nil, a new one is created)
-//   - src: Source IndexInfoDatastore entity to convert from
+//   - dest: Destination World message (if nil, a new one is created)
+//   - src: Source WorldDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted IndexInfo message
+//   - Converted World message
 //   - Error if conversion fails
-func IndexInfoFromIndexInfoDatastore(
-	dest *v1.IndexInfo,
-	src *IndexInfoDatastore,
-	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
-) (out *v1.IndexInfo, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.IndexInfo{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.IndexInfo{
-		LastUpdatedAt: converters.TimeToTimestamp(src.LastUpdatedAt),
-		LastIndexedAt: converters.TimeToTimestamp(src.LastIndexedAt),
-		NeedsIndexing: src.NeedsIndexing,
+func WorldFromWorldDatastore(
+	dest *v1.World,
+	src *WorldDatastore,
+	decorator func(*v1.World, *WorldDatastore) error,
+) (out *v1.World, err error) {
+	out, err = WorldFromWorldDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// TileToTileDatastore converts a Tile to TileDatastore.
+// WorldFromWorldDatastoreWithOptions converts a WorldDatastore back to World under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source Tile message to convert from
-//   - dest: Destination TileDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination World message (if nil, a new one is created)
+//   - src: Source WorldDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted TileDatastore entity
+//   - Converted World message
 //   - Error if conversion fails
-func TileToTileDatastore(
-	src *v1.Tile,
-	dest *TileDatastore,
-	decorator func(*v1.Tile, *TileDatastore) error,
-) (out *TileDatastore, err error) {
+func WorldFromWorldDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.World,
+	src *WorldDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.World, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &TileDatastore{}
+		dest = &v1.World{}
 	}
 
 	// Initialize struct with inline values
-	*dest = TileDatastore{
-		Q:                src.Q,
-		R:                src.R,
-		TileType:         src.TileType,
-		Player:           src.Player,
-		Shortcut:         src.Shortcut,
-		LastActedTurn:    src.LastActedTurn,
-		LastToppedupTurn: src.LastToppedupTurn,
+	*dest = v1.World{
+		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
+		Id:          src.Id,
+		CreatorId:   src.CreatorId,
+		Name:        src.Name,
+		Description: src.Description,
+		Tags:        src.Tags,
+		ImageUrl:    src.ImageUrl,
+		Difficulty:  src.Difficulty,
+		PreviewUrls: src.PreviewUrls,
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
+	out.WorldData, err = WorldDataFromWorldDataDatastoreWithOptions(ctx, nil, &src.WorldData)
+	if err != nil {
+		return nil, conversionWorldFromWorldDatastore.FieldError(err, "world_data")
+	}
+
+	out.DefaultGameConfig, err = GameConfigurationFromGameConfigurationDatastoreWithOptions(ctx, nil, &src.DefaultGameConfig)
+	if err != nil {
+		return nil, conversionWorldFromWorldDatastore.FieldError(err, "default_game_config")
+	}
+
+	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoDatastoreWithOptions(ctx, nil, &src.ScreenshotIndexInfo)
+	if err != nil {
+		return nil, conversionWorldFromWorldDatastore.FieldError(err, "screenshot_index_info")
+	}
+
+	out.SearchIndexInfo, err = IndexInfoFromIndexInfoDatastoreWithOptions(ctx, nil, &src.SearchIndexInfo)
+	if err != nil {
+		return nil, conversionWorldFromWorldDatastore.FieldError(err, "search_index_info")
 	}
 
 	return dest, nil
 }
 
-// TileFromTileDatastore converts a TileDatastore back to Tile.
+// WorldSliceToWorldDatastoreSlice converts a slice of v1.World with WorldToWorldDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldSliceToWorldDatastoreSlice(
+	src []*v1.World,
+	decorator func(*v1.World, *WorldDatastore) error,
+) ([]*WorldDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.World) (*WorldDatastore, error) {
+		return WorldToWorldDatastore(item, nil, decorator)
+	})
+}
+
+// WorldSliceFromWorldDatastoreSlice converts a slice of WorldDatastore with WorldFromWorldDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldSliceFromWorldDatastoreSlice(
+	src []*WorldDatastore,
+	decorator func(*v1.World, *WorldDatastore) error,
+) ([]*v1.World, error) {
+	return converters.MapSlice(src, func(item *WorldDatastore) (*v1.World, error) {
+		return WorldFromWorldDatastore(nil, item, decorator)
+	})
+}
+
+// WorldMapToWorldDatastoreMap converts the values of a map with WorldToWorldDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldMapToWorldDatastoreMap[K comparable](
+	src map[K]*v1.World,
+	decorator func(*v1.World, *WorldDatastore) error,
+) (map[K]*WorldDatastore, error) {
+	return converters.MapValues(src, func(value *v1.World) (*WorldDatastore, error) {
+		return WorldToWorldDatastore(value, nil, decorator)
+	})
+}
+
+// WorldMapFromWorldDatastoreMap converts the values of a map with WorldFromWorldDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldMapFromWorldDatastoreMap[K comparable](
+	src map[K]*WorldDatastore,
+	decorator func(*v1.World, *WorldDatastore) error,
+) (map[K]*v1.World, error) {
+	return converters.MapValues(src, func(value *WorldDatastore) (*v1.World, error) {
+		return WorldFromWorldDatastore(nil, value, decorator)
+	})
+}
+
+// conversionWorldDataToWorldDataDatastore describes WorldDataToWorldDataDatastore in conversion errors.
+var conversionWorldDataToWorldDataDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "world_data",
+	SourceType: "v1.WorldData",
+	TargetType: "WorldDataDatastore",
+}
+
+// conversionWorldDataFromWorldDataDatastore describes WorldDataFromWorldDataDatastore in conversion errors.
+var conversionWorldDataFromWorldDataDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "world_data",
+	SourceType: "WorldDataDatastore",
+	TargetType: "v1.WorldData",
+}
+
+// WorldDataToWorldDataDatastore converts a WorldData to WorldDataDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination Tile message (if nil, a new one is created)
-//   - src: Source TileDatastore entity to convert from
+//   - src: Source WorldData message to convert from
+//   - dest: Destination WorldDataDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted Tile message
+//   - Converted WorldDataDatastore entity
 //   - Error if conversion fails
-func TileFromTileDatastore(
-	dest *v1.Tile,
-	src *TileDatastore,
-	decorator func(*v1.Tile, *TileDatastore) error,
-) (out *v1.Tile, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.Tile{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.Tile{
-		Q:                src.Q,
-		R:                src.R,
-		TileType:         src.TileType,
-		Player:           src.Player,
-		Shortcut:         src.Shortcut,
-		LastActedTurn:    src.LastActedTurn,
-		LastToppedupTurn: src.LastToppedupTurn,
+func WorldDataToWorldDataDatastore(
+	src *v1.WorldData,
+	dest *WorldDataDatastore,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) (out *WorldDataDatastore, err error) {
+	out, err = WorldDataToWorldDataDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UnitToUnitDatastore converts a Unit to UnitDatastore.
+// WorldDataToWorldDataDatastoreWithOptions converts a WorldData to WorldDataDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source Unit message to convert from
-//   - dest: Destination UnitDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source WorldData message to convert from
+//   - dest: Destination WorldDataDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted UnitDatastore entity
+//   - Converted WorldDataDatastore entity
 //   - Error if conversion fails
-func UnitToUnitDatastore(
-	src *v1.Unit,
-	dest *UnitDatastore,
-	decorator func(*v1.Unit, *UnitDatastore) error,
-) (out *UnitDatastore, err error) {
+func WorldDataToWorldDataDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.WorldData,
+	dest *WorldDataDatastore,
+	opts ...converters.ConvertOption,
+) (out *WorldDataDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &UnitDatastore{}
+		dest = &WorldDataDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = UnitDatastore{
-		Q:                       src.Q,
-		R:                       src.R,
-		Player:                  src.Player,
-		UnitType:                src.UnitType,
-		Shortcut:                src.Shortcut,
-		AvailableHealth:         src.AvailableHealth,
-		DistanceLeft:            src.DistanceLeft,
-		LastActedTurn:           src.LastActedTurn,
-		LastToppedupTurn:        src.LastToppedupTurn,
-		AttacksReceivedThisTurn: src.AttacksReceivedThisTurn,
-		ProgressionStep:         src.ProgressionStep,
-		ChosenAlternative:       src.ChosenAlternative,
-	}
+	*dest = WorldDataDatastore{}
 	out = dest
 
-	if src.AttackHistory != nil {
-		out.AttackHistory = make([]AttackRecordDatastore, len(src.AttackHistory))
-		for i, item := range src.AttackHistory {
-			_, err = AttackRecordToAttackRecordDatastore(item, &out.AttackHistory[i], nil)
+	if src.Tiles != nil {
+		out.Tiles = make([]TileDatastore, len(src.Tiles))
+		for i, item := range src.Tiles {
+			_, err = TileToTileDatastoreWithOptions(ctx, item, &out.Tiles[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting AttackHistory[%d]: %w", i, err)
+				return nil, conversionWorldDataToWorldDataDatastore.ElementError(err, "tiles", i)
 			}
 		}
 	}
-
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.Units != nil {
+		out.Units = make([]UnitDatastore, len(src.Units))
+		for i, item := range src.Units {
+			_, err = UnitToUnitDatastoreWithOptions(ctx, item, &out.Units[i])
+			if err != nil {
+				return nil, conversionWorldDataToWorldDataDatastore.ElementError(err, "units", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// UnitFromUnitDatastore converts a UnitDatastore back to Unit.
+// WorldDataFromWorldDataDatastore converts a WorldDataDatastore back to WorldData.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination Unit message (if nil, a new one is created)
-//   - src: Source UnitDatastore entity to convert from
+//   - dest: Destination WorldData message (if nil, a new one is created)
+//   - src: Source WorldDataDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted Unit message
+//   - Converted WorldData message
 //   - Error if conversion fails
-func UnitFromUnitDatastore(
-	dest *v1.Unit,
-	src *UnitDatastore,
-	decorator func(*v1.Unit, *UnitDatastore) error,
-) (out *v1.Unit, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.Unit{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.Unit{
-		Q:                       src.Q,
-		R:                       src.R,
-		Player:                  src.Player,
-		UnitType:                src.UnitType,
-		Shortcut:                src.Shortcut,
-		AvailableHealth:         src.AvailableHealth,
-		DistanceLeft:            src.DistanceLeft,
-		LastActedTurn:           src.LastActedTurn,
-		LastToppedupTurn:        src.LastToppedupTurn,
-		AttacksReceivedThisTurn: src.AttacksReceivedThisTurn,
-		ProgressionStep:         src.ProgressionStep,
-		ChosenAlternative:       src.ChosenAlternative,
-	}
-	out = dest
-
-	if src.AttackHistory != nil {
-		out.AttackHistory = make([]*v1.AttackRecord, len(src.AttackHistory))
-		for i, item := range src.AttackHistory {
-			out.AttackHistory[i], err = AttackRecordFromAttackRecordDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting AttackHistory[%d]: %w", i, err)
-			}
-		}
+func WorldDataFromWorldDataDatastore(
+	dest *v1.WorldData,
+	src *WorldDataDatastore,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) (out *v1.WorldData, err error) {
+	out, err = WorldDataFromWorldDataDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// AttackRecordToAttackRecordDatastore converts a AttackRecord to AttackRecordDatastore.
+// WorldDataFromWorldDataDatastoreWithOptions converts a WorldDataDatastore back to WorldData under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source AttackRecord message to convert from
-//   - dest: Destination AttackRecordDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination WorldData message (if nil, a new one is created)
+//   - src: Source WorldDataDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted AttackRecordDatastore entity
+//   - Converted WorldData message
 //   - Error if conversion fails
-func AttackRecordToAttackRecordDatastore(
-	src *v1.AttackRecord,
-	dest *AttackRecordDatastore,
-	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
-) (out *AttackRecordDatastore, err error) {
+func WorldDataFromWorldDataDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.WorldData,
+	src *WorldDataDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.WorldData, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &AttackRecordDatastore{}
+		dest = &v1.WorldData{}
 	}
 
 	// Initialize struct with inline values
-	*dest = AttackRecordDatastore{
-		Q:          src.Q,
-		R:          src.R,
-		IsRanged:   src.IsRanged,
-		TurnNumber: src.TurnNumber,
-	}
+	*dest = v1.WorldData{}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.Tiles != nil {
+		out.Tiles = make([]*v1.Tile, len(src.Tiles))
+		for i, item := range src.Tiles {
+			out.Tiles[i], err = TileFromTileDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionWorldDataFromWorldDataDatastore.ElementError(err, "tiles", i)
+			}
+		}
+	}
+	if src.Units != nil {
+		out.Units = make([]*v1.Unit, len(src.Units))
+		for i, item := range src.Units {
+			out.Units[i], err = UnitFromUnitDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionWorldDataFromWorldDataDatastore.ElementError(err, "units", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// AttackRecordFromAttackRecordDatastore converts a AttackRecordDatastore back to AttackRecord.
+// WorldDataSliceToWorldDataDatastoreSlice converts a slice of v1.WorldData with WorldDataToWorldDataDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldDataSliceToWorldDataDatastoreSlice(
+	src []*v1.WorldData,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) ([]*WorldDataDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.WorldData) (*WorldDataDatastore, error) {
+		return WorldDataToWorldDataDatastore(item, nil, decorator)
+	})
+}
+
+// WorldDataSliceFromWorldDataDatastoreSlice converts a slice of WorldDataDatastore with WorldDataFromWorldDataDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldDataSliceFromWorldDataDatastoreSlice(
+	src []*WorldDataDatastore,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) ([]*v1.WorldData, error) {
+	return converters.MapSlice(src, func(item *WorldDataDatastore) (*v1.WorldData, error) {
+		return WorldDataFromWorldDataDatastore(nil, item, decorator)
+	})
+}
+
+// WorldDataMapToWorldDataDatastoreMap converts the values of a map with WorldDataToWorldDataDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldDataMapToWorldDataDatastoreMap[K comparable](
+	src map[K]*v1.WorldData,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) (map[K]*WorldDataDatastore, error) {
+	return converters.MapValues(src, func(value *v1.WorldData) (*WorldDataDatastore, error) {
+		return WorldDataToWorldDataDatastore(value, nil, decorator)
+	})
+}
+
+// WorldDataMapFromWorldDataDatastoreMap converts the values of a map with WorldDataFromWorldDataDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldDataMapFromWorldDataDatastoreMap[K comparable](
+	src map[K]*WorldDataDatastore,
+	decorator func(*v1.WorldData, *WorldDataDatastore) error,
+) (map[K]*v1.WorldData, error) {
+	return converters.MapValues(src, func(value *WorldDataDatastore) (*v1.WorldData, error) {
+		return WorldDataFromWorldDataDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameToGameDatastore describes GameToGameDatastore in conversion errors.
+var conversionGameToGameDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game",
+	SourceType: "v1.Game",
+	TargetType: "GameDatastore",
+}
+
+// conversionGameFromGameDatastore describes GameFromGameDatastore in conversion errors.
+var conversionGameFromGameDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game",
+	SourceType: "GameDatastore",
+	TargetType: "v1.Game",
+}
+
+// GameToGameDatastore converts a Game to GameDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination AttackRecord message (if nil, a new one is created)
-//   - src: Source AttackRecordDatastore entity to convert from
+//   - src: Source Game message to convert from
+//   - dest: Destination GameDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted AttackRecord message
+//   - Converted GameDatastore entity
 //   - Error if conversion fails
-func AttackRecordFromAttackRecordDatastore(
-	dest *v1.AttackRecord,
-	src *AttackRecordDatastore,
-	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
-) (out *v1.AttackRecord, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.AttackRecord{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.AttackRecord{
-		Q:          src.Q,
-		R:          src.R,
-		IsRanged:   src.IsRanged,
-		TurnNumber: src.TurnNumber,
+func GameToGameDatastore(
+	src *v1.Game,
+	dest *GameDatastore,
+	decorator func(*v1.Game, *GameDatastore) error,
+) (out *GameDatastore, err error) {
+	out, err = GameToGameDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// WorldToWorldDatastore converts a World to WorldDatastore.
+// GameToGameDatastoreWithOptions converts a Game to GameDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source World message to convert from
-//   - dest: Destination WorldDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Game message to convert from
+//   - dest: Destination GameDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted WorldDatastore entity
+//   - Converted GameDatastore entity
 //   - Error if conversion fails
-func WorldToWorldDatastore(
-	src *v1.World,
-	dest *WorldDatastore,
-	decorator func(*v1.World, *WorldDatastore) error,
-) (out *WorldDatastore, err error) {
+func GameToGameDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.Game,
+	dest *GameDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &WorldDatastore{}
+		dest = &GameDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = WorldDatastore{
+	*dest = GameDatastore{
 		Id:          src.Id,
 		CreatorId:   src.CreatorId,
+		WorldId:     src.WorldId,
 		Name:        src.Name,
 		Description: src.Description,
 		Tags:        src.Tags,
@@ -444,71 +611,96 @@ func WorldToWorldDatastore(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	if src.WorldData != nil {
-		_, err = WorldDataToWorldDataDatastore(src.WorldData, &out.WorldData, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting WorldData: %w", err)
-		}
-	}
-	if src.DefaultGameConfig != nil {
-		_, err = GameConfigurationToGameConfigurationDatastore(src.DefaultGameConfig, &out.DefaultGameConfig, nil)
+	if src.Config != nil {
+		_, err = GameConfigurationToGameConfigurationDatastoreWithOptions(ctx, src.Config, &out.Config)
 		if err != nil {
-			return nil, fmt.Errorf("converting DefaultGameConfig: %w", err)
+			return nil, conversionGameToGameDatastore.FieldError(err, "config")
 		}
 	}
 	if src.ScreenshotIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoDatastore(src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoDatastoreWithOptions(ctx, src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+			return nil, conversionGameToGameDatastore.FieldError(err, "screenshot_index_info")
 		}
 	}
 	if src.SearchIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoDatastore(src.SearchIndexInfo, &out.SearchIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoDatastoreWithOptions(ctx, src.SearchIndexInfo, &out.SearchIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
+			return nil, conversionGameToGameDatastore.FieldError(err, "search_index_info")
 		}
 	}
 
+	return dest, nil
+}
+
+// GameFromGameDatastore converts a GameDatastore back to Game.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Game message (if nil, a new one is created)
+//   - src: Source GameDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Game message
+//   - Error if conversion fails
+func GameFromGameDatastore(
+	dest *v1.Game,
+	src *GameDatastore,
+	decorator func(*v1.Game, *GameDatastore) error,
+) (out *v1.Game, err error) {
+	out, err = GameFromGameDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// WorldFromWorldDatastore converts a WorldDatastore back to World.
+// GameFromGameDatastoreWithOptions converts a GameDatastore back to Game under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination World message (if nil, a new one is created)
-//   - src: Source WorldDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Game message (if nil, a new one is created)
+//   - src: Source GameDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted World message
+//   - Converted Game message
 //   - Error if conversion fails
-func WorldFromWorldDatastore(
-	dest *v1.World,
-	src *WorldDatastore,
-	decorator func(*v1.World, *WorldDatastore) error,
-) (out *v1.World, err error) {
+func GameFromGameDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.Game,
+	src *GameDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.Game, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.World{}
+		dest = &v1.Game{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.World{
+	*dest = v1.Game{
 		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
 		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
 		Id:          src.Id,
 		CreatorId:   src.CreatorId,
+		WorldId:     src.WorldId,
 		Name:        src.Name,
 		Description: src.Description,
 		Tags:        src.Tags,
@@ -518,1310 +710,3158 @@ func WorldFromWorldDatastore(
 	}
 	out = dest
 
-	out.WorldData, err = WorldDataFromWorldDataDatastore(nil, &src.WorldData, nil)
+	out.Config, err = GameConfigurationFromGameConfigurationDatastoreWithOptions(ctx, nil, &src.Config)
 	if err != nil {
-		return nil, fmt.Errorf("converting WorldData: %w", err)
+		return nil, conversionGameFromGameDatastore.FieldError(err, "config")
 	}
 
-	out.DefaultGameConfig, err = GameConfigurationFromGameConfigurationDatastore(nil, &src.DefaultGameConfig, nil)
+	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoDatastoreWithOptions(ctx, nil, &src.ScreenshotIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting DefaultGameConfig: %w", err)
+		return nil, conversionGameFromGameDatastore.FieldError(err, "screenshot_index_info")
 	}
 
-	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoDatastore(nil, &src.ScreenshotIndexInfo, nil)
+	out.SearchIndexInfo, err = IndexInfoFromIndexInfoDatastoreWithOptions(ctx, nil, &src.SearchIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+		return nil, conversionGameFromGameDatastore.FieldError(err, "search_index_info")
 	}
 
-	out.SearchIndexInfo, err = IndexInfoFromIndexInfoDatastore(nil, &src.SearchIndexInfo, nil)
-	if err != nil {
-		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
-	}
+	return dest, nil
+}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
+// GameSliceToGameDatastoreSlice converts a slice of v1.Game with GameToGameDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSliceToGameDatastoreSlice(
+	src []*v1.Game,
+	decorator func(*v1.Game, *GameDatastore) error,
+) ([]*GameDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.Game) (*GameDatastore, error) {
+		return GameToGameDatastore(item, nil, decorator)
+	})
+}
 
-	return dest, nil
+// GameSliceFromGameDatastoreSlice converts a slice of GameDatastore with GameFromGameDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSliceFromGameDatastoreSlice(
+	src []*GameDatastore,
+	decorator func(*v1.Game, *GameDatastore) error,
+) ([]*v1.Game, error) {
+	return converters.MapSlice(src, func(item *GameDatastore) (*v1.Game, error) {
+		return GameFromGameDatastore(nil, item, decorator)
+	})
 }
 
-// WorldDataToWorldDataDatastore converts a WorldData to WorldDataDatastore.
+// GameMapToGameDatastoreMap converts the values of a map with GameToGameDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMapToGameDatastoreMap[K comparable](
+	src map[K]*v1.Game,
+	decorator func(*v1.Game, *GameDatastore) error,
+) (map[K]*GameDatastore, error) {
+	return converters.MapValues(src, func(value *v1.Game) (*GameDatastore, error) {
+		return GameToGameDatastore(value, nil, decorator)
+	})
+}
+
+// GameMapFromGameDatastoreMap converts the values of a map with GameFromGameDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMapFromGameDatastoreMap[K comparable](
+	src map[K]*GameDatastore,
+	decorator func(*v1.Game, *GameDatastore) error,
+) (map[K]*v1.Game, error) {
+	return converters.MapValues(src, func(value *GameDatastore) (*v1.Game, error) {
+		return GameFromGameDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameStateToGameStateDatastore describes GameStateToGameStateDatastore in conversion errors.
+var conversionGameStateToGameStateDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_state",
+	SourceType: "v1.GameState",
+	TargetType: "GameStateDatastore",
+}
+
+// conversionGameStateFromGameStateDatastore describes GameStateFromGameStateDatastore in conversion errors.
+var conversionGameStateFromGameStateDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_state",
+	SourceType: "GameStateDatastore",
+	TargetType: "v1.GameState",
+}
+
+// GameStateToGameStateDatastore converts a GameState to GameStateDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - src: Source WorldData message to convert from
-//   - dest: Destination WorldDataDatastore entity (if nil, a new one is created)
+//   - src: Source GameState message to convert from
+//   - dest: Destination GameStateDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted WorldDataDatastore entity
+//   - Converted GameStateDatastore entity
 //   - Error if conversion fails
-func WorldDataToWorldDataDatastore(
-	src *v1.WorldData,
-	dest *WorldDataDatastore,
-	decorator func(*v1.WorldData, *WorldDataDatastore) error,
-) (out *WorldDataDatastore, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &WorldDataDatastore{}
-	}
-
-	// Initialize struct with inline values
-	*dest = WorldDataDatastore{}
-	out = dest
-
-	if src.Tiles != nil {
-		out.Tiles = make([]TileDatastore, len(src.Tiles))
-		for i, item := range src.Tiles {
-			_, err = TileToTileDatastore(item, &out.Tiles[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Tiles[%d]: %w", i, err)
-			}
-		}
-	}
-	if src.Units != nil {
-		out.Units = make([]UnitDatastore, len(src.Units))
-		for i, item := range src.Units {
-			_, err = UnitToUnitDatastore(item, &out.Units[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Units[%d]: %w", i, err)
-			}
-		}
+func GameStateToGameStateDatastore(
+	src *v1.GameState,
+	dest *GameStateDatastore,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) (out *GameStateDatastore, err error) {
+	out, err = GameStateToGameStateDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// WorldDataFromWorldDataDatastore converts a WorldDataDatastore back to WorldData.
+// GameStateToGameStateDatastoreWithOptions converts a GameState to GameStateDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination WorldData message (if nil, a new one is created)
-//   - src: Source WorldDataDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameState message to convert from
+//   - dest: Destination GameStateDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted WorldData message
+//   - Converted GameStateDatastore entity
 //   - Error if conversion fails
-func WorldDataFromWorldDataDatastore(
-	dest *v1.WorldData,
-	src *WorldDataDatastore,
-	decorator func(*v1.WorldData, *WorldDataDatastore) error,
-) (out *v1.WorldData, err error) {
+func GameStateToGameStateDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameState,
+	dest *GameStateDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameStateDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.WorldData{}
+		dest = &GameStateDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.WorldData{}
+	*dest = GameStateDatastore{
+		GameId:        src.GameId,
+		TurnCounter:   src.TurnCounter,
+		CurrentPlayer: src.CurrentPlayer,
+		StateHash:     src.StateHash,
+		Version:       src.Version,
+		Status:        src.Status,
+		Finished:      src.Finished,
+		WinningPlayer: src.WinningPlayer,
+		WinningTeam:   src.WinningTeam,
+	}
 	out = dest
 
-	if src.Tiles != nil {
-		out.Tiles = make([]*v1.Tile, len(src.Tiles))
-		for i, item := range src.Tiles {
-			out.Tiles[i], err = TileFromTileDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Tiles[%d]: %w", i, err)
-			}
-		}
-	}
-	if src.Units != nil {
-		out.Units = make([]*v1.Unit, len(src.Units))
-		for i, item := range src.Units {
-			out.Units[i], err = UnitFromUnitDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Units[%d]: %w", i, err)
-			}
-		}
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
+	if src.WorldData != nil {
+		_, err = WorldDataToWorldDataDatastoreWithOptions(ctx, src.WorldData, &out.WorldData)
+		if err != nil {
+			return nil, conversionGameStateToGameStateDatastore.FieldError(err, "world_data")
 		}
 	}
 
 	return dest, nil
 }
 
-// GameToGameDatastore converts a Game to GameDatastore.
+// GameStateFromGameStateDatastore converts a GameStateDatastore back to GameState.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - src: Source Game message to convert from
-//   - dest: Destination GameDatastore entity (if nil, a new one is created)
+//   - dest: Destination GameState message (if nil, a new one is created)
+//   - src: Source GameStateDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameDatastore entity
+//   - Converted GameState message
 //   - Error if conversion fails
-func GameToGameDatastore(
-	src *v1.Game,
-	dest *GameDatastore,
-	decorator func(*v1.Game, *GameDatastore) error,
-) (out *GameDatastore, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &GameDatastore{}
-	}
-
-	// Initialize struct with inline values
-	*dest = GameDatastore{
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
-	}
-	out = dest
-
-	if src.CreatedAt != nil {
-		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
-	}
-
-	if src.UpdatedAt != nil {
-		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
-	}
-
-	if src.Config != nil {
-		_, err = GameConfigurationToGameConfigurationDatastore(src.Config, &out.Config, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting Config: %w", err)
-		}
-	}
-	if src.ScreenshotIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoDatastore(src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
-		}
-	}
-	if src.SearchIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoDatastore(src.SearchIndexInfo, &out.SearchIndexInfo, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
-		}
+func GameStateFromGameStateDatastore(
+	dest *v1.GameState,
+	src *GameStateDatastore,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) (out *v1.GameState, err error) {
+	out, err = GameStateFromGameStateDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameFromGameDatastore converts a GameDatastore back to Game.
+// GameStateFromGameStateDatastoreWithOptions converts a GameStateDatastore back to GameState under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination Game message (if nil, a new one is created)
-//   - src: Source GameDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameState message (if nil, a new one is created)
+//   - src: Source GameStateDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted Game message
+//   - Converted GameState message
 //   - Error if conversion fails
-func GameFromGameDatastore(
-	dest *v1.Game,
-	src *GameDatastore,
-	decorator func(*v1.Game, *GameDatastore) error,
-) (out *v1.Game, err error) {
+func GameStateFromGameStateDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameState,
+	src *GameStateDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameState, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.Game{}
+		dest = &v1.GameState{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.Game{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+	*dest = v1.GameState{
+		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
+		GameId:        src.GameId,
+		TurnCounter:   src.TurnCounter,
+		CurrentPlayer: src.CurrentPlayer,
+		StateHash:     src.StateHash,
+		Version:       src.Version,
+		Status:        src.Status,
+		Finished:      src.Finished,
+		WinningPlayer: src.WinningPlayer,
+		WinningTeam:   src.WinningTeam,
 	}
 	out = dest
 
-	out.Config, err = GameConfigurationFromGameConfigurationDatastore(nil, &src.Config, nil)
+	out.WorldData, err = WorldDataFromWorldDataDatastoreWithOptions(ctx, nil, &src.WorldData)
 	if err != nil {
-		return nil, fmt.Errorf("converting Config: %w", err)
+		return nil, conversionGameStateFromGameStateDatastore.FieldError(err, "world_data")
 	}
 
-	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoDatastore(nil, &src.ScreenshotIndexInfo, nil)
-	if err != nil {
-		return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
-	}
+	return dest, nil
+}
 
-	out.SearchIndexInfo, err = IndexInfoFromIndexInfoDatastore(nil, &src.SearchIndexInfo, nil)
-	if err != nil {
-		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
+// GameStateSliceToGameStateDatastoreSlice converts a slice of v1.GameState with GameStateToGameStateDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameStateSliceToGameStateDatastoreSlice(
+	src []*v1.GameState,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) ([]*GameStateDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameState) (*GameStateDatastore, error) {
+		return GameStateToGameStateDatastore(item, nil, decorator)
+	})
+}
+
+// GameStateSliceFromGameStateDatastoreSlice converts a slice of GameStateDatastore with GameStateFromGameStateDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameStateSliceFromGameStateDatastoreSlice(
+	src []*GameStateDatastore,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) ([]*v1.GameState, error) {
+	return converters.MapSlice(src, func(item *GameStateDatastore) (*v1.GameState, error) {
+		return GameStateFromGameStateDatastore(nil, item, decorator)
+	})
+}
+
+// GameStateMapToGameStateDatastoreMap converts the values of a map with GameStateToGameStateDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameStateMapToGameStateDatastoreMap[K comparable](
+	src map[K]*v1.GameState,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) (map[K]*GameStateDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameState) (*GameStateDatastore, error) {
+		return GameStateToGameStateDatastore(value, nil, decorator)
+	})
+}
+
+// GameStateMapFromGameStateDatastoreMap converts the values of a map with GameStateFromGameStateDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameStateMapFromGameStateDatastoreMap[K comparable](
+	src map[K]*GameStateDatastore,
+	decorator func(*v1.GameState, *GameStateDatastore) error,
+) (map[K]*v1.GameState, error) {
+	return converters.MapValues(src, func(value *GameStateDatastore) (*v1.GameState, error) {
+		return GameStateFromGameStateDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveHistoryToGameMoveHistoryDatastore describes GameMoveHistoryToGameMoveHistoryDatastore in conversion errors.
+var conversionGameMoveHistoryToGameMoveHistoryDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move_history",
+	SourceType: "v1.GameMoveHistory",
+	TargetType: "GameMoveHistoryDatastore",
+}
+
+// conversionGameMoveHistoryFromGameMoveHistoryDatastore describes GameMoveHistoryFromGameMoveHistoryDatastore in conversion errors.
+var conversionGameMoveHistoryFromGameMoveHistoryDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move_history",
+	SourceType: "GameMoveHistoryDatastore",
+	TargetType: "v1.GameMoveHistory",
+}
+
+// GameMoveHistoryToGameMoveHistoryDatastore converts a GameMoveHistory to GameMoveHistoryDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameMoveHistory message to convert from
+//   - dest: Destination GameMoveHistoryDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameMoveHistoryDatastore entity
+//   - Error if conversion fails
+func GameMoveHistoryToGameMoveHistoryDatastore(
+	src *v1.GameMoveHistory,
+	dest *GameMoveHistoryDatastore,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) (out *GameMoveHistoryDatastore, err error) {
+	out, err = GameMoveHistoryToGameMoveHistoryDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameConfigurationToGameConfigurationDatastore converts a GameConfiguration to GameConfigurationDatastore.
+// GameMoveHistoryToGameMoveHistoryDatastoreWithOptions converts a GameMoveHistory to GameMoveHistoryDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameConfiguration message to convert from
-//   - dest: Destination GameConfigurationDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameMoveHistory message to convert from
+//   - dest: Destination GameMoveHistoryDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameConfigurationDatastore entity
+//   - Converted GameMoveHistoryDatastore entity
 //   - Error if conversion fails
-func GameConfigurationToGameConfigurationDatastore(
-	src *v1.GameConfiguration,
-	dest *GameConfigurationDatastore,
-	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
-) (out *GameConfigurationDatastore, err error) {
+func GameMoveHistoryToGameMoveHistoryDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameMoveHistory,
+	dest *GameMoveHistoryDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameMoveHistoryDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameConfigurationDatastore{}
+		dest = &GameMoveHistoryDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameConfigurationDatastore{}
-	out = dest
+	*dest = GameMoveHistoryDatastore{
+		GameId: src.GameId,
+	}
+	out = dest
 
-	if src.IncomeConfigs != nil {
-		_, err = IncomeConfigToIncomeConfigDatastore(src.IncomeConfigs, &out.IncomeConfigs, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting IncomeConfigs: %w", err)
+	if src.Groups != nil {
+		out.Groups = make([]GameMoveGroupDatastore, len(src.Groups))
+		for i, item := range src.Groups {
+			_, err = GameMoveGroupToGameMoveGroupDatastoreWithOptions(ctx, item, &out.Groups[i])
+			if err != nil {
+				return nil, conversionGameMoveHistoryToGameMoveHistoryDatastore.ElementError(err, "groups", i)
+			}
 		}
 	}
-	if src.Settings != nil {
-		_, err = GameSettingsToGameSettingsDatastore(src.Settings, &out.Settings, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting Settings: %w", err)
-		}
+
+	return dest, nil
+}
+
+// GameMoveHistoryFromGameMoveHistoryDatastore converts a GameMoveHistoryDatastore back to GameMoveHistory.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameMoveHistory message (if nil, a new one is created)
+//   - src: Source GameMoveHistoryDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameMoveHistory message
+//   - Error if conversion fails
+func GameMoveHistoryFromGameMoveHistoryDatastore(
+	dest *v1.GameMoveHistory,
+	src *GameMoveHistoryDatastore,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) (out *v1.GameMoveHistory, err error) {
+	out, err = GameMoveHistoryFromGameMoveHistoryDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
-	if src.Players != nil {
-		out.Players = make([]GamePlayerDatastore, len(src.Players))
-		for i, item := range src.Players {
-			_, err = GamePlayerToGamePlayerDatastore(item, &out.Players[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Players[%d]: %w", i, err)
-			}
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
 		}
 	}
-	if src.Teams != nil {
-		out.Teams = make([]GameTeamDatastore, len(src.Teams))
-		for i, item := range src.Teams {
-			_, err = GameTeamToGameTeamDatastore(item, &out.Teams[i], nil)
+
+	return out, nil
+}
+
+// GameMoveHistoryFromGameMoveHistoryDatastoreWithOptions converts a GameMoveHistoryDatastore back to GameMoveHistory under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameMoveHistory message (if nil, a new one is created)
+//   - src: Source GameMoveHistoryDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted GameMoveHistory message
+//   - Error if conversion fails
+func GameMoveHistoryFromGameMoveHistoryDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameMoveHistory,
+	src *GameMoveHistoryDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameMoveHistory, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.GameMoveHistory{}
+	}
+
+	// Initialize struct with inline values
+	*dest = v1.GameMoveHistory{
+		GameId: src.GameId,
+	}
+	out = dest
+
+	if src.Groups != nil {
+		out.Groups = make([]*v1.GameMoveGroup, len(src.Groups))
+		for i, item := range src.Groups {
+			out.Groups[i], err = GameMoveGroupFromGameMoveGroupDatastoreWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Teams[%d]: %w", i, err)
+				return nil, conversionGameMoveHistoryFromGameMoveHistoryDatastore.ElementError(err, "groups", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// GameMoveHistorySliceToGameMoveHistoryDatastoreSlice converts a slice of v1.GameMoveHistory with GameMoveHistoryToGameMoveHistoryDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveHistorySliceToGameMoveHistoryDatastoreSlice(
+	src []*v1.GameMoveHistory,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) ([]*GameMoveHistoryDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameMoveHistory) (*GameMoveHistoryDatastore, error) {
+		return GameMoveHistoryToGameMoveHistoryDatastore(item, nil, decorator)
+	})
+}
+
+// GameMoveHistorySliceFromGameMoveHistoryDatastoreSlice converts a slice of GameMoveHistoryDatastore with GameMoveHistoryFromGameMoveHistoryDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveHistorySliceFromGameMoveHistoryDatastoreSlice(
+	src []*GameMoveHistoryDatastore,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) ([]*v1.GameMoveHistory, error) {
+	return converters.MapSlice(src, func(item *GameMoveHistoryDatastore) (*v1.GameMoveHistory, error) {
+		return GameMoveHistoryFromGameMoveHistoryDatastore(nil, item, decorator)
+	})
+}
+
+// GameMoveHistoryMapToGameMoveHistoryDatastoreMap converts the values of a map with GameMoveHistoryToGameMoveHistoryDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveHistoryMapToGameMoveHistoryDatastoreMap[K comparable](
+	src map[K]*v1.GameMoveHistory,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) (map[K]*GameMoveHistoryDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameMoveHistory) (*GameMoveHistoryDatastore, error) {
+		return GameMoveHistoryToGameMoveHistoryDatastore(value, nil, decorator)
+	})
+}
+
+// GameMoveHistoryMapFromGameMoveHistoryDatastoreMap converts the values of a map with GameMoveHistoryFromGameMoveHistoryDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveHistoryMapFromGameMoveHistoryDatastoreMap[K comparable](
+	src map[K]*GameMoveHistoryDatastore,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
+) (map[K]*v1.GameMoveHistory, error) {
+	return converters.MapValues(src, func(value *GameMoveHistoryDatastore) (*v1.GameMoveHistory, error) {
+		return GameMoveHistoryFromGameMoveHistoryDatastore(nil, value, decorator)
+	})
+}
+
+// conversionMoveUnitActionToMoveUnitActionDatastore describes MoveUnitActionToMoveUnitActionDatastore in conversion errors.
+var conversionMoveUnitActionToMoveUnitActionDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "move_unit_action",
+	SourceType: "v1.MoveUnitAction",
+	TargetType: "MoveUnitActionDatastore",
+}
+
+// conversionMoveUnitActionFromMoveUnitActionDatastore describes MoveUnitActionFromMoveUnitActionDatastore in conversion errors.
+var conversionMoveUnitActionFromMoveUnitActionDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "move_unit_action",
+	SourceType: "MoveUnitActionDatastore",
+	TargetType: "v1.MoveUnitAction",
+}
+
+// MoveUnitActionToMoveUnitActionDatastore converts a MoveUnitAction to MoveUnitActionDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source MoveUnitAction message to convert from
+//   - dest: Destination MoveUnitActionDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted MoveUnitActionDatastore entity
+//   - Error if conversion fails
+func MoveUnitActionToMoveUnitActionDatastore(
+	src *v1.MoveUnitAction,
+	dest *MoveUnitActionDatastore,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) (out *MoveUnitActionDatastore, err error) {
+	out, err = MoveUnitActionToMoveUnitActionDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
+	return out, nil
+}
+
+// MoveUnitActionToMoveUnitActionDatastoreWithOptions converts a MoveUnitAction to MoveUnitActionDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source MoveUnitAction message to convert from
+//   - dest: Destination MoveUnitActionDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted MoveUnitActionDatastore entity
+//   - Error if conversion fails
+func MoveUnitActionToMoveUnitActionDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.MoveUnitAction,
+	dest *MoveUnitActionDatastore,
+	opts ...converters.ConvertOption,
+) (out *MoveUnitActionDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &MoveUnitActionDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = MoveUnitActionDatastore{
+		FromQ:        src.FromQ,
+		FromR:        src.FromR,
+		ToQ:          src.ToQ,
+		ToR:          src.ToR,
+		MovementCost: src.MovementCost,
+	}
+	out = dest
+
+	if src.ReconstructedPath != nil {
+		out.ReconstructedPath, err = converters.MessageToAnyBytes(src.ReconstructedPath)
+		if err != nil {
+			return nil, conversionMoveUnitActionToMoveUnitActionDatastore.FieldError(err, "reconstructed_path")
+		}
+	}
+
 	return dest, nil
 }
 
-// GameConfigurationFromGameConfigurationDatastore converts a GameConfigurationDatastore back to GameConfiguration.
+// MoveUnitActionFromMoveUnitActionDatastore converts a MoveUnitActionDatastore back to MoveUnitAction.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination GameConfiguration message (if nil, a new one is created)
-//   - src: Source GameConfigurationDatastore entity to convert from
+//   - dest: Destination MoveUnitAction message (if nil, a new one is created)
+//   - src: Source MoveUnitActionDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameConfiguration message
+//   - Converted MoveUnitAction message
 //   - Error if conversion fails
-func GameConfigurationFromGameConfigurationDatastore(
-	dest *v1.GameConfiguration,
-	src *GameConfigurationDatastore,
-	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
-) (out *v1.GameConfiguration, err error) {
+func MoveUnitActionFromMoveUnitActionDatastore(
+	dest *v1.MoveUnitAction,
+	src *MoveUnitActionDatastore,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) (out *v1.MoveUnitAction, err error) {
+	out, err = MoveUnitActionFromMoveUnitActionDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// MoveUnitActionFromMoveUnitActionDatastoreWithOptions converts a MoveUnitActionDatastore back to MoveUnitAction under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination MoveUnitAction message (if nil, a new one is created)
+//   - src: Source MoveUnitActionDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted MoveUnitAction message
+//   - Error if conversion fails
+func MoveUnitActionFromMoveUnitActionDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.MoveUnitAction,
+	src *MoveUnitActionDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.MoveUnitAction, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.GameConfiguration{}
+		dest = &v1.MoveUnitAction{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.GameConfiguration{}
+	*dest = v1.MoveUnitAction{
+		FromQ:        src.FromQ,
+		FromR:        src.FromR,
+		ToQ:          src.ToQ,
+		ToR:          src.ToR,
+		MovementCost: src.MovementCost,
+	}
 	out = dest
 
-	out.IncomeConfigs, err = IncomeConfigFromIncomeConfigDatastore(nil, &src.IncomeConfigs, nil)
+	out.ReconstructedPath, err = converters.AnyBytesToMessage[*v1.Path](src.ReconstructedPath)
 	if err != nil {
-		return nil, fmt.Errorf("converting IncomeConfigs: %w", err)
+		return nil, conversionMoveUnitActionFromMoveUnitActionDatastore.FieldError(err, "reconstructed_path")
 	}
 
-	out.Settings, err = GameSettingsFromGameSettingsDatastore(nil, &src.Settings, nil)
-	if err != nil {
-		return nil, fmt.Errorf("converting Settings: %w", err)
+	return dest, nil
+}
+
+// MoveUnitActionSliceToMoveUnitActionDatastoreSlice converts a slice of v1.MoveUnitAction with MoveUnitActionToMoveUnitActionDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func MoveUnitActionSliceToMoveUnitActionDatastoreSlice(
+	src []*v1.MoveUnitAction,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) ([]*MoveUnitActionDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.MoveUnitAction) (*MoveUnitActionDatastore, error) {
+		return MoveUnitActionToMoveUnitActionDatastore(item, nil, decorator)
+	})
+}
+
+// MoveUnitActionSliceFromMoveUnitActionDatastoreSlice converts a slice of MoveUnitActionDatastore with MoveUnitActionFromMoveUnitActionDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func MoveUnitActionSliceFromMoveUnitActionDatastoreSlice(
+	src []*MoveUnitActionDatastore,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) ([]*v1.MoveUnitAction, error) {
+	return converters.MapSlice(src, func(item *MoveUnitActionDatastore) (*v1.MoveUnitAction, error) {
+		return MoveUnitActionFromMoveUnitActionDatastore(nil, item, decorator)
+	})
+}
+
+// MoveUnitActionMapToMoveUnitActionDatastoreMap converts the values of a map with MoveUnitActionToMoveUnitActionDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func MoveUnitActionMapToMoveUnitActionDatastoreMap[K comparable](
+	src map[K]*v1.MoveUnitAction,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) (map[K]*MoveUnitActionDatastore, error) {
+	return converters.MapValues(src, func(value *v1.MoveUnitAction) (*MoveUnitActionDatastore, error) {
+		return MoveUnitActionToMoveUnitActionDatastore(value, nil, decorator)
+	})
+}
+
+// MoveUnitActionMapFromMoveUnitActionDatastoreMap converts the values of a map with MoveUnitActionFromMoveUnitActionDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func MoveUnitActionMapFromMoveUnitActionDatastoreMap[K comparable](
+	src map[K]*MoveUnitActionDatastore,
+	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
+) (map[K]*v1.MoveUnitAction, error) {
+	return converters.MapValues(src, func(value *MoveUnitActionDatastore) (*v1.MoveUnitAction, error) {
+		return MoveUnitActionFromMoveUnitActionDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveToGameMoveDatastore describes GameMoveToGameMoveDatastore in conversion errors.
+var conversionGameMoveToGameMoveDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move",
+	SourceType: "v1.GameMove",
+	TargetType: "GameMoveDatastore",
+}
+
+// conversionGameMoveFromGameMoveDatastore describes GameMoveFromGameMoveDatastore in conversion errors.
+var conversionGameMoveFromGameMoveDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move",
+	SourceType: "GameMoveDatastore",
+	TargetType: "v1.GameMove",
+}
+
+// GameMoveToGameMoveDatastore converts a GameMove to GameMoveDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameMove message to convert from
+//   - dest: Destination GameMoveDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameMoveDatastore entity
+//   - Error if conversion fails
+func GameMoveToGameMoveDatastore(
+	src *v1.GameMove,
+	dest *GameMoveDatastore,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) (out *GameMoveDatastore, err error) {
+	out, err = GameMoveToGameMoveDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
 	}
 
-	if src.Players != nil {
-		out.Players = make([]*v1.GamePlayer, len(src.Players))
-		for i, item := range src.Players {
-			out.Players[i], err = GamePlayerFromGamePlayerDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Players[%d]: %w", i, err)
-			}
-		}
-	}
-	if src.Teams != nil {
-		out.Teams = make([]*v1.GameTeam, len(src.Teams))
-		for i, item := range src.Teams {
-			out.Teams[i], err = GameTeamFromGameTeamDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Teams[%d]: %w", i, err)
-			}
-		}
+	return out, nil
+}
+
+// GameMoveToGameMoveDatastoreWithOptions converts a GameMove to GameMoveDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameMove message to convert from
+//   - dest: Destination GameMoveDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted GameMoveDatastore entity
+//   - Error if conversion fails
+func GameMoveToGameMoveDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameMove,
+	dest *GameMoveDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameMoveDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &GameMoveDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = GameMoveDatastore{
+		Player:      src.Player,
+		SequenceNum: src.SequenceNum,
+		IsPermanent: src.IsPermanent,
+	}
+	out = dest
+
+	if src.Timestamp != nil {
+		out.Timestamp = converters.TimestampToTime(src.Timestamp)
+	}
+
+	if src.Changes != nil {
+		out.Changes = make([][]byte, len(src.Changes))
+		for i, item := range src.Changes {
+			_, err = converters.MessageToAnyBytesConverterWithOptions(ctx, item, &out.Changes[i])
+			if err != nil {
+				return nil, conversionGameMoveToGameMoveDatastore.ElementError(err, "changes", i)
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// GameMoveFromGameMoveDatastore converts a GameMoveDatastore back to GameMove.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameMove message (if nil, a new one is created)
+//   - src: Source GameMoveDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameMove message
+//   - Error if conversion fails
+func GameMoveFromGameMoveDatastore(
+	dest *v1.GameMove,
+	src *GameMoveDatastore,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) (out *v1.GameMove, err error) {
+	out, err = GameMoveFromGameMoveDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GameMoveFromGameMoveDatastoreWithOptions converts a GameMoveDatastore back to GameMove under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameMove message (if nil, a new one is created)
+//   - src: Source GameMoveDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted GameMove message
+//   - Error if conversion fails
+func GameMoveFromGameMoveDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameMove,
+	src *GameMoveDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameMove, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.GameMove{}
+	}
+
+	// Initialize struct with inline values
+	*dest = v1.GameMove{
+		Player:      src.Player,
+		Timestamp:   converters.TimeToTimestamp(src.Timestamp),
+		SequenceNum: src.SequenceNum,
+		IsPermanent: src.IsPermanent,
+	}
+	out = dest
+
+	if src.Changes != nil {
+		out.Changes = make([]*v1.WorldChange, len(src.Changes))
+		for i, item := range src.Changes {
+			out.Changes[i], err = converters.AnyBytesToMessageConverterWithOptions[*v1.WorldChange](ctx, nil, &item)
+			if err != nil {
+				return nil, conversionGameMoveFromGameMoveDatastore.ElementError(err, "changes", i)
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// GameMoveSliceToGameMoveDatastoreSlice converts a slice of v1.GameMove with GameMoveToGameMoveDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveSliceToGameMoveDatastoreSlice(
+	src []*v1.GameMove,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) ([]*GameMoveDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameMove) (*GameMoveDatastore, error) {
+		return GameMoveToGameMoveDatastore(item, nil, decorator)
+	})
+}
+
+// GameMoveSliceFromGameMoveDatastoreSlice converts a slice of GameMoveDatastore with GameMoveFromGameMoveDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveSliceFromGameMoveDatastoreSlice(
+	src []*GameMoveDatastore,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) ([]*v1.GameMove, error) {
+	return converters.MapSlice(src, func(item *GameMoveDatastore) (*v1.GameMove, error) {
+		return GameMoveFromGameMoveDatastore(nil, item, decorator)
+	})
+}
+
+// GameMoveMapToGameMoveDatastoreMap converts the values of a map with GameMoveToGameMoveDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveMapToGameMoveDatastoreMap[K comparable](
+	src map[K]*v1.GameMove,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) (map[K]*GameMoveDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameMove) (*GameMoveDatastore, error) {
+		return GameMoveToGameMoveDatastore(value, nil, decorator)
+	})
+}
+
+// GameMoveMapFromGameMoveDatastoreMap converts the values of a map with GameMoveFromGameMoveDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveMapFromGameMoveDatastoreMap[K comparable](
+	src map[K]*GameMoveDatastore,
+	decorator func(*v1.GameMove, *GameMoveDatastore) error,
+) (map[K]*v1.GameMove, error) {
+	return converters.MapValues(src, func(value *GameMoveDatastore) (*v1.GameMove, error) {
+		return GameMoveFromGameMoveDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameConfigurationToGameConfigurationDatastore describes GameConfigurationToGameConfigurationDatastore in conversion errors.
+var conversionGameConfigurationToGameConfigurationDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_configuration",
+	SourceType: "v1.GameConfiguration",
+	TargetType: "GameConfigurationDatastore",
+}
+
+// conversionGameConfigurationFromGameConfigurationDatastore describes GameConfigurationFromGameConfigurationDatastore in conversion errors.
+var conversionGameConfigurationFromGameConfigurationDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_configuration",
+	SourceType: "GameConfigurationDatastore",
+	TargetType: "v1.GameConfiguration",
+}
+
+// GameConfigurationToGameConfigurationDatastore converts a GameConfiguration to GameConfigurationDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameConfiguration message to convert from
+//   - dest: Destination GameConfigurationDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameConfigurationDatastore entity
+//   - Error if conversion fails
+func GameConfigurationToGameConfigurationDatastore(
+	src *v1.GameConfiguration,
+	dest *GameConfigurationDatastore,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) (out *GameConfigurationDatastore, err error) {
+	out, err = GameConfigurationToGameConfigurationDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GameConfigurationToGameConfigurationDatastoreWithOptions converts a GameConfiguration to GameConfigurationDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameConfiguration message to convert from
+//   - dest: Destination GameConfigurationDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted GameConfigurationDatastore entity
+//   - Error if conversion fails
+func GameConfigurationToGameConfigurationDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameConfiguration,
+	dest *GameConfigurationDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameConfigurationDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &GameConfigurationDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = GameConfigurationDatastore{}
+	out = dest
+
+	if src.IncomeConfigs != nil {
+		_, err = IncomeConfigToIncomeConfigDatastoreWithOptions(ctx, src.IncomeConfigs, &out.IncomeConfigs)
+		if err != nil {
+			return nil, conversionGameConfigurationToGameConfigurationDatastore.FieldError(err, "income_configs")
+		}
+	}
+	if src.Settings != nil {
+		_, err = GameSettingsToGameSettingsDatastoreWithOptions(ctx, src.Settings, &out.Settings)
+		if err != nil {
+			return nil, conversionGameConfigurationToGameConfigurationDatastore.FieldError(err, "settings")
+		}
+	}
+
+	if src.Players != nil {
+		out.Players = make([]GamePlayerDatastore, len(src.Players))
+		for i, item := range src.Players {
+			_, err = GamePlayerToGamePlayerDatastoreWithOptions(ctx, item, &out.Players[i])
+			if err != nil {
+				return nil, conversionGameConfigurationToGameConfigurationDatastore.ElementError(err, "players", i)
+			}
+		}
+	}
+	if src.Teams != nil {
+		out.Teams = make([]GameTeamDatastore, len(src.Teams))
+		for i, item := range src.Teams {
+			_, err = GameTeamToGameTeamDatastoreWithOptions(ctx, item, &out.Teams[i])
+			if err != nil {
+				return nil, conversionGameConfigurationToGameConfigurationDatastore.ElementError(err, "teams", i)
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// GameConfigurationFromGameConfigurationDatastore converts a GameConfigurationDatastore back to GameConfiguration.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameConfiguration message (if nil, a new one is created)
+//   - src: Source GameConfigurationDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameConfiguration message
+//   - Error if conversion fails
+func GameConfigurationFromGameConfigurationDatastore(
+	dest *v1.GameConfiguration,
+	src *GameConfigurationDatastore,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) (out *v1.GameConfiguration, err error) {
+	out, err = GameConfigurationFromGameConfigurationDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GameConfigurationFromGameConfigurationDatastoreWithOptions converts a GameConfigurationDatastore back to GameConfiguration under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameConfiguration message (if nil, a new one is created)
+//   - src: Source GameConfigurationDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted GameConfiguration message
+//   - Error if conversion fails
+func GameConfigurationFromGameConfigurationDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameConfiguration,
+	src *GameConfigurationDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameConfiguration, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.GameConfiguration{}
+	}
+
+	// Initialize struct with inline values
+	*dest = v1.GameConfiguration{}
+	out = dest
+
+	out.IncomeConfigs, err = IncomeConfigFromIncomeConfigDatastoreWithOptions(ctx, nil, &src.IncomeConfigs)
+	if err != nil {
+		return nil, conversionGameConfigurationFromGameConfigurationDatastore.FieldError(err, "income_configs")
+	}
+
+	out.Settings, err = GameSettingsFromGameSettingsDatastoreWithOptions(ctx, nil, &src.Settings)
+	if err != nil {
+		return nil, conversionGameConfigurationFromGameConfigurationDatastore.FieldError(err, "settings")
+	}
+
+	if src.Players != nil {
+		out.Players = make([]*v1.GamePlayer, len(src.Players))
+		for i, item := range src.Players {
+			out.Players[i], err = GamePlayerFromGamePlayerDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionGameConfigurationFromGameConfigurationDatastore.ElementError(err, "players", i)
+			}
+		}
+	}
+	if src.Teams != nil {
+		out.Teams = make([]*v1.GameTeam, len(src.Teams))
+		for i, item := range src.Teams {
+			out.Teams[i], err = GameTeamFromGameTeamDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionGameConfigurationFromGameConfigurationDatastore.ElementError(err, "teams", i)
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// GameConfigurationSliceToGameConfigurationDatastoreSlice converts a slice of v1.GameConfiguration with GameConfigurationToGameConfigurationDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameConfigurationSliceToGameConfigurationDatastoreSlice(
+	src []*v1.GameConfiguration,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) ([]*GameConfigurationDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameConfiguration) (*GameConfigurationDatastore, error) {
+		return GameConfigurationToGameConfigurationDatastore(item, nil, decorator)
+	})
+}
+
+// GameConfigurationSliceFromGameConfigurationDatastoreSlice converts a slice of GameConfigurationDatastore with GameConfigurationFromGameConfigurationDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameConfigurationSliceFromGameConfigurationDatastoreSlice(
+	src []*GameConfigurationDatastore,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) ([]*v1.GameConfiguration, error) {
+	return converters.MapSlice(src, func(item *GameConfigurationDatastore) (*v1.GameConfiguration, error) {
+		return GameConfigurationFromGameConfigurationDatastore(nil, item, decorator)
+	})
+}
+
+// GameConfigurationMapToGameConfigurationDatastoreMap converts the values of a map with GameConfigurationToGameConfigurationDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameConfigurationMapToGameConfigurationDatastoreMap[K comparable](
+	src map[K]*v1.GameConfiguration,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) (map[K]*GameConfigurationDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameConfiguration) (*GameConfigurationDatastore, error) {
+		return GameConfigurationToGameConfigurationDatastore(value, nil, decorator)
+	})
+}
+
+// GameConfigurationMapFromGameConfigurationDatastoreMap converts the values of a map with GameConfigurationFromGameConfigurationDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameConfigurationMapFromGameConfigurationDatastoreMap[K comparable](
+	src map[K]*GameConfigurationDatastore,
+	decorator func(*v1.GameConfiguration, *GameConfigurationDatastore) error,
+) (map[K]*v1.GameConfiguration, error) {
+	return converters.MapValues(src, func(value *GameConfigurationDatastore) (*v1.GameConfiguration, error) {
+		return GameConfigurationFromGameConfigurationDatastore(nil, value, decorator)
+	})
+}
+
+// conversionIndexInfoToIndexInfoDatastore describes IndexInfoToIndexInfoDatastore in conversion errors.
+var conversionIndexInfoToIndexInfoDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "index_info",
+	SourceType: "v1.IndexInfo",
+	TargetType: "IndexInfoDatastore",
+}
+
+// conversionIndexInfoFromIndexInfoDatastore describes IndexInfoFromIndexInfoDatastore in conversion errors.
+var conversionIndexInfoFromIndexInfoDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "index_info",
+	SourceType: "IndexInfoDatastore",
+	TargetType: "v1.IndexInfo",
+}
+
+// IndexInfoToIndexInfoDatastore converts a IndexInfo to IndexInfoDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source IndexInfo message to convert from
+//   - dest: Destination IndexInfoDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted IndexInfoDatastore entity
+//   - Error if conversion fails
+func IndexInfoToIndexInfoDatastore(
+	src *v1.IndexInfo,
+	dest *IndexInfoDatastore,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) (out *IndexInfoDatastore, err error) {
+	out, err = IndexInfoToIndexInfoDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// IndexInfoToIndexInfoDatastoreWithOptions converts a IndexInfo to IndexInfoDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source IndexInfo message to convert from
+//   - dest: Destination IndexInfoDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted IndexInfoDatastore entity
+//   - Error if conversion fails
+func IndexInfoToIndexInfoDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.IndexInfo,
+	dest *IndexInfoDatastore,
+	opts ...converters.ConvertOption,
+) (out *IndexInfoDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &IndexInfoDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = IndexInfoDatastore{
+		NeedsIndexing: src.NeedsIndexing,
+	}
+	out = dest
+
+	if src.LastUpdatedAt != nil {
+		out.LastUpdatedAt = converters.TimestampToTime(src.LastUpdatedAt)
+	}
+
+	if src.LastIndexedAt != nil {
+		out.LastIndexedAt = converters.TimestampToTime(src.LastIndexedAt)
+	}
+
+	return dest, nil
+}
+
+// IndexInfoFromIndexInfoDatastore converts a IndexInfoDatastore back to IndexInfo.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination IndexInfo message (if nil, a new one is created)
+//   - src: Source IndexInfoDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted IndexInfo message
+//   - Error if conversion fails
+func IndexInfoFromIndexInfoDatastore(
+	dest *v1.IndexInfo,
+	src *IndexInfoDatastore,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) (out *v1.IndexInfo, err error) {
+	out, err = IndexInfoFromIndexInfoDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// IndexInfoFromIndexInfoDatastoreWithOptions converts a IndexInfoDatastore back to IndexInfo under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination IndexInfo message (if nil, a new one is created)
+//   - src: Source IndexInfoDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted IndexInfo message
+//   - Error if conversion fails
+func IndexInfoFromIndexInfoDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.IndexInfo,
+	src *IndexInfoDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.IndexInfo, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.IndexInfo{}
+	}
+
+	// Initialize struct with inline values
+	*dest = v1.IndexInfo{
+		LastUpdatedAt: converters.TimeToTimestamp(src.LastUpdatedAt),
+		LastIndexedAt: converters.TimeToTimestamp(src.LastIndexedAt),
+		NeedsIndexing: src.NeedsIndexing,
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// IndexInfoSliceToIndexInfoDatastoreSlice converts a slice of v1.IndexInfo with IndexInfoToIndexInfoDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func IndexInfoSliceToIndexInfoDatastoreSlice(
+	src []*v1.IndexInfo,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) ([]*IndexInfoDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.IndexInfo) (*IndexInfoDatastore, error) {
+		return IndexInfoToIndexInfoDatastore(item, nil, decorator)
+	})
+}
+
+// IndexInfoSliceFromIndexInfoDatastoreSlice converts a slice of IndexInfoDatastore with IndexInfoFromIndexInfoDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func IndexInfoSliceFromIndexInfoDatastoreSlice(
+	src []*IndexInfoDatastore,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) ([]*v1.IndexInfo, error) {
+	return converters.MapSlice(src, func(item *IndexInfoDatastore) (*v1.IndexInfo, error) {
+		return IndexInfoFromIndexInfoDatastore(nil, item, decorator)
+	})
+}
+
+// IndexInfoMapToIndexInfoDatastoreMap converts the values of a map with IndexInfoToIndexInfoDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func IndexInfoMapToIndexInfoDatastoreMap[K comparable](
+	src map[K]*v1.IndexInfo,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) (map[K]*IndexInfoDatastore, error) {
+	return converters.MapValues(src, func(value *v1.IndexInfo) (*IndexInfoDatastore, error) {
+		return IndexInfoToIndexInfoDatastore(value, nil, decorator)
+	})
+}
+
+// IndexInfoMapFromIndexInfoDatastoreMap converts the values of a map with IndexInfoFromIndexInfoDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func IndexInfoMapFromIndexInfoDatastoreMap[K comparable](
+	src map[K]*IndexInfoDatastore,
+	decorator func(*v1.IndexInfo, *IndexInfoDatastore) error,
+) (map[K]*v1.IndexInfo, error) {
+	return converters.MapValues(src, func(value *IndexInfoDatastore) (*v1.IndexInfo, error) {
+		return IndexInfoFromIndexInfoDatastore(nil, value, decorator)
+	})
+}
+
+// conversionTileToTileDatastore describes TileToTileDatastore in conversion errors.
+var conversionTileToTileDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "tile",
+	SourceType: "v1.Tile",
+	TargetType: "TileDatastore",
+}
+
+// conversionTileFromTileDatastore describes TileFromTileDatastore in conversion errors.
+var conversionTileFromTileDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "tile",
+	SourceType: "TileDatastore",
+	TargetType: "v1.Tile",
+}
+
+// TileToTileDatastore converts a Tile to TileDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Tile message to convert from
+//   - dest: Destination TileDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TileDatastore entity
+//   - Error if conversion fails
+func TileToTileDatastore(
+	src *v1.Tile,
+	dest *TileDatastore,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) (out *TileDatastore, err error) {
+	out, err = TileToTileDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TileToTileDatastoreWithOptions converts a Tile to TileDatastore under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Tile message to convert from
+//   - dest: Destination TileDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted TileDatastore entity
+//   - Error if conversion fails
+func TileToTileDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.Tile,
+	dest *TileDatastore,
+	opts ...converters.ConvertOption,
+) (out *TileDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &TileDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TileDatastore{
+		Q:                src.Q,
+		R:                src.R,
+		TileType:         src.TileType,
+		Player:           src.Player,
+		Shortcut:         src.Shortcut,
+		LastActedTurn:    src.LastActedTurn,
+		LastToppedupTurn: src.LastToppedupTurn,
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// TileFromTileDatastore converts a TileDatastore back to Tile.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination Tile message (if nil, a new one is created)
+//   - src: Source TileDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted Tile message
+//   - Error if conversion fails
+func TileFromTileDatastore(
+	dest *v1.Tile,
+	src *TileDatastore,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) (out *v1.Tile, err error) {
+	out, err = TileFromTileDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TileFromTileDatastoreWithOptions converts a TileDatastore back to Tile under a context and options.
+//
+// The context and options propagate into nested message converters.
+//
+// Parameters:
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Tile message (if nil, a new one is created)
+//   - src: Source TileDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
+//
+// Returns:
+//   - Converted Tile message
+//   - Error if conversion fails
+func TileFromTileDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.Tile,
+	src *TileDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.Tile, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.Tile{}
+	}
+
+	// Initialize struct with inline values
+	*dest = v1.Tile{
+		Q:                src.Q,
+		R:                src.R,
+		TileType:         src.TileType,
+		Player:           src.Player,
+		Shortcut:         src.Shortcut,
+		LastActedTurn:    src.LastActedTurn,
+		LastToppedupTurn: src.LastToppedupTurn,
+	}
+	out = dest
+
+	return dest, nil
+}
+
+// TileSliceToTileDatastoreSlice converts a slice of v1.Tile with TileToTileDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TileSliceToTileDatastoreSlice(
+	src []*v1.Tile,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) ([]*TileDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.Tile) (*TileDatastore, error) {
+		return TileToTileDatastore(item, nil, decorator)
+	})
+}
+
+// TileSliceFromTileDatastoreSlice converts a slice of TileDatastore with TileFromTileDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func TileSliceFromTileDatastoreSlice(
+	src []*TileDatastore,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) ([]*v1.Tile, error) {
+	return converters.MapSlice(src, func(item *TileDatastore) (*v1.Tile, error) {
+		return TileFromTileDatastore(nil, item, decorator)
+	})
+}
+
+// TileMapToTileDatastoreMap converts the values of a map with TileToTileDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TileMapToTileDatastoreMap[K comparable](
+	src map[K]*v1.Tile,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) (map[K]*TileDatastore, error) {
+	return converters.MapValues(src, func(value *v1.Tile) (*TileDatastore, error) {
+		return TileToTileDatastore(value, nil, decorator)
+	})
+}
+
+// TileMapFromTileDatastoreMap converts the values of a map with TileFromTileDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func TileMapFromTileDatastoreMap[K comparable](
+	src map[K]*TileDatastore,
+	decorator func(*v1.Tile, *TileDatastore) error,
+) (map[K]*v1.Tile, error) {
+	return converters.MapValues(src, func(value *TileDatastore) (*v1.Tile, error) {
+		return TileFromTileDatastore(nil, value, decorator)
+	})
+}
+
+// conversionUnitToUnitDatastore describes UnitToUnitDatastore in conversion errors.
+var conversionUnitToUnitDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "unit",
+	SourceType: "v1.Unit",
+	TargetType: "UnitDatastore",
+}
+
+// conversionUnitFromUnitDatastore describes UnitFromUnitDatastore in conversion errors.
+var conversionUnitFromUnitDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "unit",
+	SourceType: "UnitDatastore",
+	TargetType: "v1.Unit",
+}
+
+// UnitToUnitDatastore converts a Unit to UnitDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source Unit message to convert from
+//   - dest: Destination UnitDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UnitDatastore entity
+//   - Error if conversion fails
+func UnitToUnitDatastore(
+	src *v1.Unit,
+	dest *UnitDatastore,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) (out *UnitDatastore, err error) {
+	out, err = UnitToUnitDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// IncomeConfigToIncomeConfigDatastore converts a IncomeConfig to IncomeConfigDatastore.
+// UnitToUnitDatastoreWithOptions converts a Unit to UnitDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source IncomeConfig message to convert from
-//   - dest: Destination IncomeConfigDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source Unit message to convert from
+//   - dest: Destination UnitDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted IncomeConfigDatastore entity
+//   - Converted UnitDatastore entity
 //   - Error if conversion fails
-func IncomeConfigToIncomeConfigDatastore(
-	src *v1.IncomeConfig,
-	dest *IncomeConfigDatastore,
-	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
-) (out *IncomeConfigDatastore, err error) {
+func UnitToUnitDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.Unit,
+	dest *UnitDatastore,
+	opts ...converters.ConvertOption,
+) (out *UnitDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &IncomeConfigDatastore{}
+		dest = &UnitDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = IncomeConfigDatastore{
-		StartingCoins:     src.StartingCoins,
-		GameIncome:        src.GameIncome,
-		LandbaseIncome:    src.LandbaseIncome,
-		NavalbaseIncome:   src.NavalbaseIncome,
-		AirportbaseIncome: src.AirportbaseIncome,
-		MissilesiloIncome: src.MissilesiloIncome,
-		MinesIncome:       src.MinesIncome,
+	*dest = UnitDatastore{
+		Q:                       src.Q,
+		R:                       src.R,
+		Player:                  src.Player,
+		UnitType:                src.UnitType,
+		Shortcut:                src.Shortcut,
+		AvailableHealth:         src.AvailableHealth,
+		DistanceLeft:            src.DistanceLeft,
+		LastActedTurn:           src.LastActedTurn,
+		LastToppedupTurn:        src.LastToppedupTurn,
+		AttacksReceivedThisTurn: src.AttacksReceivedThisTurn,
+		ProgressionStep:         src.ProgressionStep,
+		ChosenAlternative:       src.ChosenAlternative,
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.AttackHistory != nil {
+		out.AttackHistory = make([]AttackRecordDatastore, len(src.AttackHistory))
+		for i, item := range src.AttackHistory {
+			_, err = AttackRecordToAttackRecordDatastoreWithOptions(ctx, item, &out.AttackHistory[i])
+			if err != nil {
+				return nil, conversionUnitToUnitDatastore.ElementError(err, "attack_history", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// IncomeConfigFromIncomeConfigDatastore converts a IncomeConfigDatastore back to IncomeConfig.
+// UnitFromUnitDatastore converts a UnitDatastore back to Unit.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination IncomeConfig message (if nil, a new one is created)
-//   - src: Source IncomeConfigDatastore entity to convert from
+//   - dest: Destination Unit message (if nil, a new one is created)
+//   - src: Source UnitDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted IncomeConfig message
+//   - Converted Unit message
 //   - Error if conversion fails
-func IncomeConfigFromIncomeConfigDatastore(
-	dest *v1.IncomeConfig,
-	src *IncomeConfigDatastore,
-	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
-) (out *v1.IncomeConfig, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.IncomeConfig{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.IncomeConfig{
-		StartingCoins:     src.StartingCoins,
-		GameIncome:        src.GameIncome,
-		LandbaseIncome:    src.LandbaseIncome,
-		NavalbaseIncome:   src.NavalbaseIncome,
-		AirportbaseIncome: src.AirportbaseIncome,
-		MissilesiloIncome: src.MissilesiloIncome,
-		MinesIncome:       src.MinesIncome,
+func UnitFromUnitDatastore(
+	dest *v1.Unit,
+	src *UnitDatastore,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) (out *v1.Unit, err error) {
+	out, err = UnitFromUnitDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GamePlayerToGamePlayerDatastore converts a GamePlayer to GamePlayerDatastore.
+// UnitFromUnitDatastoreWithOptions converts a UnitDatastore back to Unit under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GamePlayer message to convert from
-//   - dest: Destination GamePlayerDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination Unit message (if nil, a new one is created)
+//   - src: Source UnitDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GamePlayerDatastore entity
+//   - Converted Unit message
 //   - Error if conversion fails
-func GamePlayerToGamePlayerDatastore(
-	src *v1.GamePlayer,
-	dest *GamePlayerDatastore,
-	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
-) (out *GamePlayerDatastore, err error) {
+func UnitFromUnitDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.Unit,
+	src *UnitDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.Unit, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GamePlayerDatastore{}
+		dest = &v1.Unit{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GamePlayerDatastore{
-		PlayerId:      src.PlayerId,
-		PlayerType:    src.PlayerType,
-		Color:         src.Color,
-		TeamId:        src.TeamId,
-		Name:          src.Name,
-		IsActive:      src.IsActive,
-		StartingCoins: src.StartingCoins,
-		Coins:         src.Coins,
+	*dest = v1.Unit{
+		Q:                       src.Q,
+		R:                       src.R,
+		Player:                  src.Player,
+		UnitType:                src.UnitType,
+		Shortcut:                src.Shortcut,
+		AvailableHealth:         src.AvailableHealth,
+		DistanceLeft:            src.DistanceLeft,
+		LastActedTurn:           src.LastActedTurn,
+		LastToppedupTurn:        src.LastToppedupTurn,
+		AttacksReceivedThisTurn: src.AttacksReceivedThisTurn,
+		ProgressionStep:         src.ProgressionStep,
+		ChosenAlternative:       src.ChosenAlternative,
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.AttackHistory != nil {
+		out.AttackHistory = make([]*v1.AttackRecord, len(src.AttackHistory))
+		for i, item := range src.AttackHistory {
+			out.AttackHistory[i], err = AttackRecordFromAttackRecordDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionUnitFromUnitDatastore.ElementError(err, "attack_history", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// GamePlayerFromGamePlayerDatastore converts a GamePlayerDatastore back to GamePlayer.
+// UnitSliceToUnitDatastoreSlice converts a slice of v1.Unit with UnitToUnitDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func UnitSliceToUnitDatastoreSlice(
+	src []*v1.Unit,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) ([]*UnitDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.Unit) (*UnitDatastore, error) {
+		return UnitToUnitDatastore(item, nil, decorator)
+	})
+}
+
+// UnitSliceFromUnitDatastoreSlice converts a slice of UnitDatastore with UnitFromUnitDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func UnitSliceFromUnitDatastoreSlice(
+	src []*UnitDatastore,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) ([]*v1.Unit, error) {
+	return converters.MapSlice(src, func(item *UnitDatastore) (*v1.Unit, error) {
+		return UnitFromUnitDatastore(nil, item, decorator)
+	})
+}
+
+// UnitMapToUnitDatastoreMap converts the values of a map with UnitToUnitDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func UnitMapToUnitDatastoreMap[K comparable](
+	src map[K]*v1.Unit,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) (map[K]*UnitDatastore, error) {
+	return converters.MapValues(src, func(value *v1.Unit) (*UnitDatastore, error) {
+		return UnitToUnitDatastore(value, nil, decorator)
+	})
+}
+
+// UnitMapFromUnitDatastoreMap converts the values of a map with UnitFromUnitDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func UnitMapFromUnitDatastoreMap[K comparable](
+	src map[K]*UnitDatastore,
+	decorator func(*v1.Unit, *UnitDatastore) error,
+) (map[K]*v1.Unit, error) {
+	return converters.MapValues(src, func(value *UnitDatastore) (*v1.Unit, error) {
+		return UnitFromUnitDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveGroupToGameMoveGroupDatastore describes GameMoveGroupToGameMoveGroupDatastore in conversion errors.
+var conversionGameMoveGroupToGameMoveGroupDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move_group",
+	SourceType: "v1.GameMoveGroup",
+	TargetType: "GameMoveGroupDatastore",
+}
+
+// conversionGameMoveGroupFromGameMoveGroupDatastore describes GameMoveGroupFromGameMoveGroupDatastore in conversion errors.
+var conversionGameMoveGroupFromGameMoveGroupDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move_group",
+	SourceType: "GameMoveGroupDatastore",
+	TargetType: "v1.GameMoveGroup",
+}
+
+// GameMoveGroupToGameMoveGroupDatastore converts a GameMoveGroup to GameMoveGroupDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination GamePlayer message (if nil, a new one is created)
-//   - src: Source GamePlayerDatastore entity to convert from
+//   - src: Source GameMoveGroup message to convert from
+//   - dest: Destination GameMoveGroupDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GamePlayer message
+//   - Converted GameMoveGroupDatastore entity
 //   - Error if conversion fails
-func GamePlayerFromGamePlayerDatastore(
-	dest *v1.GamePlayer,
-	src *GamePlayerDatastore,
-	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
-) (out *v1.GamePlayer, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.GamePlayer{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.GamePlayer{
-		PlayerId:      src.PlayerId,
-		PlayerType:    src.PlayerType,
-		Color:         src.Color,
-		TeamId:        src.TeamId,
-		Name:          src.Name,
-		IsActive:      src.IsActive,
-		StartingCoins: src.StartingCoins,
-		Coins:         src.Coins,
+func GameMoveGroupToGameMoveGroupDatastore(
+	src *v1.GameMoveGroup,
+	dest *GameMoveGroupDatastore,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) (out *GameMoveGroupDatastore, err error) {
+	out, err = GameMoveGroupToGameMoveGroupDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameTeamToGameTeamDatastore converts a GameTeam to GameTeamDatastore.
+// GameMoveGroupToGameMoveGroupDatastoreWithOptions converts a GameMoveGroup to GameMoveGroupDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameTeam message to convert from
-//   - dest: Destination GameTeamDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameMoveGroup message to convert from
+//   - dest: Destination GameMoveGroupDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameTeamDatastore entity
+//   - Converted GameMoveGroupDatastore entity
 //   - Error if conversion fails
-func GameTeamToGameTeamDatastore(
-	src *v1.GameTeam,
-	dest *GameTeamDatastore,
-	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
-) (out *GameTeamDatastore, err error) {
+func GameMoveGroupToGameMoveGroupDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameMoveGroup,
+	dest *GameMoveGroupDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameMoveGroupDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameTeamDatastore{}
+		dest = &GameMoveGroupDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameTeamDatastore{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
-	}
+	*dest = GameMoveGroupDatastore{}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.StartedAt != nil {
+		out.StartedAt = converters.TimestampToTime(src.StartedAt)
+	}
+
+	if src.EndedAt != nil {
+		out.EndedAt = converters.TimestampToTime(src.EndedAt)
+	}
+
+	if src.Moves != nil {
+		out.Moves = make([]GameMoveDatastore, len(src.Moves))
+		for i, item := range src.Moves {
+			_, err = GameMoveToGameMoveDatastoreWithOptions(ctx, item, &out.Moves[i])
+			if err != nil {
+				return nil, conversionGameMoveGroupToGameMoveGroupDatastore.ElementError(err, "moves", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// GameTeamFromGameTeamDatastore converts a GameTeamDatastore back to GameTeam.
+// GameMoveGroupFromGameMoveGroupDatastore converts a GameMoveGroupDatastore back to GameMoveGroup.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination GameTeam message (if nil, a new one is created)
-//   - src: Source GameTeamDatastore entity to convert from
+//   - dest: Destination GameMoveGroup message (if nil, a new one is created)
+//   - src: Source GameMoveGroupDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameTeam message
+//   - Converted GameMoveGroup message
 //   - Error if conversion fails
-func GameTeamFromGameTeamDatastore(
-	dest *v1.GameTeam,
-	src *GameTeamDatastore,
-	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
-) (out *v1.GameTeam, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.GameTeam{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.GameTeam{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
+func GameMoveGroupFromGameMoveGroupDatastore(
+	dest *v1.GameMoveGroup,
+	src *GameMoveGroupDatastore,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) (out *v1.GameMoveGroup, err error) {
+	out, err = GameMoveGroupFromGameMoveGroupDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameSettingsToGameSettingsDatastore converts a GameSettings to GameSettingsDatastore.
+// GameMoveGroupFromGameMoveGroupDatastoreWithOptions converts a GameMoveGroupDatastore back to GameMoveGroup under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameSettings message to convert from
-//   - dest: Destination GameSettingsDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameMoveGroup message (if nil, a new one is created)
+//   - src: Source GameMoveGroupDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameSettingsDatastore entity
+//   - Converted GameMoveGroup message
 //   - Error if conversion fails
-func GameSettingsToGameSettingsDatastore(
-	src *v1.GameSettings,
-	dest *GameSettingsDatastore,
-	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
-) (out *GameSettingsDatastore, err error) {
+func GameMoveGroupFromGameMoveGroupDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameMoveGroup,
+	src *GameMoveGroupDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameMoveGroup, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameSettingsDatastore{}
+		dest = &v1.GameMoveGroup{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameSettingsDatastore{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+	*dest = v1.GameMoveGroup{
+		StartedAt: converters.TimeToTimestamp(src.StartedAt),
+		EndedAt:   converters.TimeToTimestamp(src.EndedAt),
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.Moves != nil {
+		out.Moves = make([]*v1.GameMove, len(src.Moves))
+		for i, item := range src.Moves {
+			out.Moves[i], err = GameMoveFromGameMoveDatastoreWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionGameMoveGroupFromGameMoveGroupDatastore.ElementError(err, "moves", i)
+			}
 		}
 	}
 
 	return dest, nil
 }
 
-// GameSettingsFromGameSettingsDatastore converts a GameSettingsDatastore back to GameSettings.
+// GameMoveGroupSliceToGameMoveGroupDatastoreSlice converts a slice of v1.GameMoveGroup with GameMoveGroupToGameMoveGroupDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveGroupSliceToGameMoveGroupDatastoreSlice(
+	src []*v1.GameMoveGroup,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) ([]*GameMoveGroupDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameMoveGroup) (*GameMoveGroupDatastore, error) {
+		return GameMoveGroupToGameMoveGroupDatastore(item, nil, decorator)
+	})
+}
+
+// GameMoveGroupSliceFromGameMoveGroupDatastoreSlice converts a slice of GameMoveGroupDatastore with GameMoveGroupFromGameMoveGroupDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveGroupSliceFromGameMoveGroupDatastoreSlice(
+	src []*GameMoveGroupDatastore,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) ([]*v1.GameMoveGroup, error) {
+	return converters.MapSlice(src, func(item *GameMoveGroupDatastore) (*v1.GameMoveGroup, error) {
+		return GameMoveGroupFromGameMoveGroupDatastore(nil, item, decorator)
+	})
+}
+
+// GameMoveGroupMapToGameMoveGroupDatastoreMap converts the values of a map with GameMoveGroupToGameMoveGroupDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveGroupMapToGameMoveGroupDatastoreMap[K comparable](
+	src map[K]*v1.GameMoveGroup,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) (map[K]*GameMoveGroupDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameMoveGroup) (*GameMoveGroupDatastore, error) {
+		return GameMoveGroupToGameMoveGroupDatastore(value, nil, decorator)
+	})
+}
+
+// GameMoveGroupMapFromGameMoveGroupDatastoreMap converts the values of a map with GameMoveGroupFromGameMoveGroupDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveGroupMapFromGameMoveGroupDatastoreMap[K comparable](
+	src map[K]*GameMoveGroupDatastore,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
+) (map[K]*v1.GameMoveGroup, error) {
+	return converters.MapValues(src, func(value *GameMoveGroupDatastore) (*v1.GameMoveGroup, error) {
+		return GameMoveGroupFromGameMoveGroupDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGamePlayerToGamePlayerDatastore describes GamePlayerToGamePlayerDatastore in conversion errors.
+var conversionGamePlayerToGamePlayerDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_player",
+	SourceType: "v1.GamePlayer",
+	TargetType: "GamePlayerDatastore",
+}
+
+// conversionGamePlayerFromGamePlayerDatastore describes GamePlayerFromGamePlayerDatastore in conversion errors.
+var conversionGamePlayerFromGamePlayerDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_player",
+	SourceType: "GamePlayerDatastore",
+	TargetType: "v1.GamePlayer",
+}
+
+// GamePlayerToGamePlayerDatastore converts a GamePlayer to GamePlayerDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination GameSettings message (if nil, a new one is created)
-//   - src: Source GameSettingsDatastore entity to convert from
+//   - src: Source GamePlayer message to convert from
+//   - dest: Destination GamePlayerDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameSettings message
+//   - Converted GamePlayerDatastore entity
 //   - Error if conversion fails
-func GameSettingsFromGameSettingsDatastore(
-	dest *v1.GameSettings,
-	src *GameSettingsDatastore,
-	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
-) (out *v1.GameSettings, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.GameSettings{}
-	}
-
-	// Initialize struct with inline values
-	*dest = v1.GameSettings{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+func GamePlayerToGamePlayerDatastore(
+	src *v1.GamePlayer,
+	dest *GamePlayerDatastore,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) (out *GamePlayerDatastore, err error) {
+	out, err = GamePlayerToGamePlayerDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameStateToGameStateDatastore converts a GameState to GameStateDatastore.
+// GamePlayerToGamePlayerDatastoreWithOptions converts a GamePlayer to GamePlayerDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameState message to convert from
-//   - dest: Destination GameStateDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GamePlayer message to convert from
+//   - dest: Destination GamePlayerDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameStateDatastore entity
+//   - Converted GamePlayerDatastore entity
 //   - Error if conversion fails
-func GameStateToGameStateDatastore(
-	src *v1.GameState,
-	dest *GameStateDatastore,
-	decorator func(*v1.GameState, *GameStateDatastore) error,
-) (out *GameStateDatastore, err error) {
+func GamePlayerToGamePlayerDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GamePlayer,
+	dest *GamePlayerDatastore,
+	opts ...converters.ConvertOption,
+) (out *GamePlayerDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameStateDatastore{}
+		dest = &GamePlayerDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameStateDatastore{
-		GameId:        src.GameId,
-		TurnCounter:   src.TurnCounter,
-		CurrentPlayer: src.CurrentPlayer,
-		StateHash:     src.StateHash,
-		Version:       src.Version,
-		Status:        src.Status,
-		Finished:      src.Finished,
-		WinningPlayer: src.WinningPlayer,
-		WinningTeam:   src.WinningTeam,
+	*dest = GamePlayerDatastore{
+		PlayerId:      src.PlayerId,
+		PlayerType:    src.PlayerType,
+		Color:         src.Color,
+		TeamId:        src.TeamId,
+		Name:          src.Name,
+		IsActive:      src.IsActive,
+		StartingCoins: src.StartingCoins,
+		Coins:         src.Coins,
 	}
 	out = dest
 
-	if src.UpdatedAt != nil {
-		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
-	}
+	return dest, nil
+}
 
-	if src.WorldData != nil {
-		_, err = WorldDataToWorldDataDatastore(src.WorldData, &out.WorldData, nil)
-		if err != nil {
-			return nil, fmt.Errorf("converting WorldData: %w", err)
-		}
+// GamePlayerFromGamePlayerDatastore converts a GamePlayerDatastore back to GamePlayer.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GamePlayer message (if nil, a new one is created)
+//   - src: Source GamePlayerDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GamePlayer message
+//   - Error if conversion fails
+func GamePlayerFromGamePlayerDatastore(
+	dest *v1.GamePlayer,
+	src *GamePlayerDatastore,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) (out *v1.GamePlayer, err error) {
+	out, err = GamePlayerFromGamePlayerDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameStateFromGameStateDatastore converts a GameStateDatastore back to GameState.
+// GamePlayerFromGamePlayerDatastoreWithOptions converts a GamePlayerDatastore back to GamePlayer under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination GameState message (if nil, a new one is created)
-//   - src: Source GameStateDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GamePlayer message (if nil, a new one is created)
+//   - src: Source GamePlayerDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameState message
+//   - Converted GamePlayer message
 //   - Error if conversion fails
-func GameStateFromGameStateDatastore(
-	dest *v1.GameState,
-	src *GameStateDatastore,
-	decorator func(*v1.GameState, *GameStateDatastore) error,
-) (out *v1.GameState, err error) {
+func GamePlayerFromGamePlayerDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GamePlayer,
+	src *GamePlayerDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GamePlayer, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.GameState{}
+		dest = &v1.GamePlayer{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.GameState{
-		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
-		GameId:        src.GameId,
-		TurnCounter:   src.TurnCounter,
-		CurrentPlayer: src.CurrentPlayer,
-		StateHash:     src.StateHash,
-		Version:       src.Version,
-		Status:        src.Status,
-		Finished:      src.Finished,
-		WinningPlayer: src.WinningPlayer,
-		WinningTeam:   src.WinningTeam,
+	*dest = v1.GamePlayer{
+		PlayerId:      src.PlayerId,
+		PlayerType:    src.PlayerType,
+		Color:         src.Color,
+		TeamId:        src.TeamId,
+		Name:          src.Name,
+		IsActive:      src.IsActive,
+		StartingCoins: src.StartingCoins,
+		Coins:         src.Coins,
 	}
 	out = dest
 
-	out.WorldData, err = WorldDataFromWorldDataDatastore(nil, &src.WorldData, nil)
-	if err != nil {
-		return nil, fmt.Errorf("converting WorldData: %w", err)
+	return dest, nil
+}
+
+// GamePlayerSliceToGamePlayerDatastoreSlice converts a slice of v1.GamePlayer with GamePlayerToGamePlayerDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GamePlayerSliceToGamePlayerDatastoreSlice(
+	src []*v1.GamePlayer,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) ([]*GamePlayerDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GamePlayer) (*GamePlayerDatastore, error) {
+		return GamePlayerToGamePlayerDatastore(item, nil, decorator)
+	})
+}
+
+// GamePlayerSliceFromGamePlayerDatastoreSlice converts a slice of GamePlayerDatastore with GamePlayerFromGamePlayerDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GamePlayerSliceFromGamePlayerDatastoreSlice(
+	src []*GamePlayerDatastore,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) ([]*v1.GamePlayer, error) {
+	return converters.MapSlice(src, func(item *GamePlayerDatastore) (*v1.GamePlayer, error) {
+		return GamePlayerFromGamePlayerDatastore(nil, item, decorator)
+	})
+}
+
+// GamePlayerMapToGamePlayerDatastoreMap converts the values of a map with GamePlayerToGamePlayerDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GamePlayerMapToGamePlayerDatastoreMap[K comparable](
+	src map[K]*v1.GamePlayer,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) (map[K]*GamePlayerDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GamePlayer) (*GamePlayerDatastore, error) {
+		return GamePlayerToGamePlayerDatastore(value, nil, decorator)
+	})
+}
+
+// GamePlayerMapFromGamePlayerDatastoreMap converts the values of a map with GamePlayerFromGamePlayerDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GamePlayerMapFromGamePlayerDatastoreMap[K comparable](
+	src map[K]*GamePlayerDatastore,
+	decorator func(*v1.GamePlayer, *GamePlayerDatastore) error,
+) (map[K]*v1.GamePlayer, error) {
+	return converters.MapValues(src, func(value *GamePlayerDatastore) (*v1.GamePlayer, error) {
+		return GamePlayerFromGamePlayerDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameTeamToGameTeamDatastore describes GameTeamToGameTeamDatastore in conversion errors.
+var conversionGameTeamToGameTeamDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_team",
+	SourceType: "v1.GameTeam",
+	TargetType: "GameTeamDatastore",
+}
+
+// conversionGameTeamFromGameTeamDatastore describes GameTeamFromGameTeamDatastore in conversion errors.
+var conversionGameTeamFromGameTeamDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_team",
+	SourceType: "GameTeamDatastore",
+	TargetType: "v1.GameTeam",
+}
+
+// GameTeamToGameTeamDatastore converts a GameTeam to GameTeamDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameTeam message to convert from
+//   - dest: Destination GameTeamDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameTeamDatastore entity
+//   - Error if conversion fails
+func GameTeamToGameTeamDatastore(
+	src *v1.GameTeam,
+	dest *GameTeamDatastore,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) (out *GameTeamDatastore, err error) {
+	out, err = GameTeamToGameTeamDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveHistoryToGameMoveHistoryDatastore converts a GameMoveHistory to GameMoveHistoryDatastore.
+// GameTeamToGameTeamDatastoreWithOptions converts a GameTeam to GameTeamDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameMoveHistory message to convert from
-//   - dest: Destination GameMoveHistoryDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameTeam message to convert from
+//   - dest: Destination GameTeamDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMoveHistoryDatastore entity
+//   - Converted GameTeamDatastore entity
 //   - Error if conversion fails
-func GameMoveHistoryToGameMoveHistoryDatastore(
-	src *v1.GameMoveHistory,
-	dest *GameMoveHistoryDatastore,
-	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
-) (out *GameMoveHistoryDatastore, err error) {
+func GameTeamToGameTeamDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameTeam,
+	dest *GameTeamDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameTeamDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameMoveHistoryDatastore{}
+		dest = &GameTeamDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameMoveHistoryDatastore{
-		GameId: src.GameId,
+	*dest = GameTeamDatastore{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
 	}
 	out = dest
 
-	if src.Groups != nil {
-		out.Groups = make([]GameMoveGroupDatastore, len(src.Groups))
-		for i, item := range src.Groups {
-			_, err = GameMoveGroupToGameMoveGroupDatastore(item, &out.Groups[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Groups[%d]: %w", i, err)
-			}
-		}
+	return dest, nil
+}
+
+// GameTeamFromGameTeamDatastore converts a GameTeamDatastore back to GameTeam.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameTeam message (if nil, a new one is created)
+//   - src: Source GameTeamDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameTeam message
+//   - Error if conversion fails
+func GameTeamFromGameTeamDatastore(
+	dest *v1.GameTeam,
+	src *GameTeamDatastore,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) (out *v1.GameTeam, err error) {
+	out, err = GameTeamFromGameTeamDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveHistoryFromGameMoveHistoryDatastore converts a GameMoveHistoryDatastore back to GameMoveHistory.
+// GameTeamFromGameTeamDatastoreWithOptions converts a GameTeamDatastore back to GameTeam under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination GameMoveHistory message (if nil, a new one is created)
-//   - src: Source GameMoveHistoryDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameTeam message (if nil, a new one is created)
+//   - src: Source GameTeamDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMoveHistory message
+//   - Converted GameTeam message
 //   - Error if conversion fails
-func GameMoveHistoryFromGameMoveHistoryDatastore(
-	dest *v1.GameMoveHistory,
-	src *GameMoveHistoryDatastore,
-	decorator func(*v1.GameMoveHistory, *GameMoveHistoryDatastore) error,
-) (out *v1.GameMoveHistory, err error) {
+func GameTeamFromGameTeamDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameTeam,
+	src *GameTeamDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameTeam, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.GameMoveHistory{}
+		dest = &v1.GameTeam{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.GameMoveHistory{
-		GameId: src.GameId,
+	*dest = v1.GameTeam{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
 	}
 	out = dest
 
-	if src.Groups != nil {
-		out.Groups = make([]*v1.GameMoveGroup, len(src.Groups))
-		for i, item := range src.Groups {
-			out.Groups[i], err = GameMoveGroupFromGameMoveGroupDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Groups[%d]: %w", i, err)
-			}
-		}
+	return dest, nil
+}
+
+// GameTeamSliceToGameTeamDatastoreSlice converts a slice of v1.GameTeam with GameTeamToGameTeamDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameTeamSliceToGameTeamDatastoreSlice(
+	src []*v1.GameTeam,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) ([]*GameTeamDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameTeam) (*GameTeamDatastore, error) {
+		return GameTeamToGameTeamDatastore(item, nil, decorator)
+	})
+}
+
+// GameTeamSliceFromGameTeamDatastoreSlice converts a slice of GameTeamDatastore with GameTeamFromGameTeamDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameTeamSliceFromGameTeamDatastoreSlice(
+	src []*GameTeamDatastore,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) ([]*v1.GameTeam, error) {
+	return converters.MapSlice(src, func(item *GameTeamDatastore) (*v1.GameTeam, error) {
+		return GameTeamFromGameTeamDatastore(nil, item, decorator)
+	})
+}
+
+// GameTeamMapToGameTeamDatastoreMap converts the values of a map with GameTeamToGameTeamDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameTeamMapToGameTeamDatastoreMap[K comparable](
+	src map[K]*v1.GameTeam,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) (map[K]*GameTeamDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameTeam) (*GameTeamDatastore, error) {
+		return GameTeamToGameTeamDatastore(value, nil, decorator)
+	})
+}
+
+// GameTeamMapFromGameTeamDatastoreMap converts the values of a map with GameTeamFromGameTeamDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameTeamMapFromGameTeamDatastoreMap[K comparable](
+	src map[K]*GameTeamDatastore,
+	decorator func(*v1.GameTeam, *GameTeamDatastore) error,
+) (map[K]*v1.GameTeam, error) {
+	return converters.MapValues(src, func(value *GameTeamDatastore) (*v1.GameTeam, error) {
+		return GameTeamFromGameTeamDatastore(nil, value, decorator)
+	})
+}
+
+// conversionIncomeConfigToIncomeConfigDatastore describes IncomeConfigToIncomeConfigDatastore in conversion errors.
+var conversionIncomeConfigToIncomeConfigDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "income_config",
+	SourceType: "v1.IncomeConfig",
+	TargetType: "IncomeConfigDatastore",
+}
+
+// conversionIncomeConfigFromIncomeConfigDatastore describes IncomeConfigFromIncomeConfigDatastore in conversion errors.
+var conversionIncomeConfigFromIncomeConfigDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "income_config",
+	SourceType: "IncomeConfigDatastore",
+	TargetType: "v1.IncomeConfig",
+}
+
+// IncomeConfigToIncomeConfigDatastore converts a IncomeConfig to IncomeConfigDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source IncomeConfig message to convert from
+//   - dest: Destination IncomeConfigDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted IncomeConfigDatastore entity
+//   - Error if conversion fails
+func IncomeConfigToIncomeConfigDatastore(
+	src *v1.IncomeConfig,
+	dest *IncomeConfigDatastore,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) (out *IncomeConfigDatastore, err error) {
+	out, err = IncomeConfigToIncomeConfigDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveGroupToGameMoveGroupDatastore converts a GameMoveGroup to GameMoveGroupDatastore.
+// IncomeConfigToIncomeConfigDatastoreWithOptions converts a IncomeConfig to IncomeConfigDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameMoveGroup message to convert from
-//   - dest: Destination GameMoveGroupDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source IncomeConfig message to convert from
+//   - dest: Destination IncomeConfigDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMoveGroupDatastore entity
+//   - Converted IncomeConfigDatastore entity
 //   - Error if conversion fails
-func GameMoveGroupToGameMoveGroupDatastore(
-	src *v1.GameMoveGroup,
-	dest *GameMoveGroupDatastore,
-	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
-) (out *GameMoveGroupDatastore, err error) {
+func IncomeConfigToIncomeConfigDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.IncomeConfig,
+	dest *IncomeConfigDatastore,
+	opts ...converters.ConvertOption,
+) (out *IncomeConfigDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameMoveGroupDatastore{}
+		dest = &IncomeConfigDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameMoveGroupDatastore{}
-	out = dest
-
-	if src.StartedAt != nil {
-		out.StartedAt = converters.TimestampToTime(src.StartedAt)
+	*dest = IncomeConfigDatastore{
+		StartingCoins:     src.StartingCoins,
+		GameIncome:        src.GameIncome,
+		LandbaseIncome:    src.LandbaseIncome,
+		NavalbaseIncome:   src.NavalbaseIncome,
+		AirportbaseIncome: src.AirportbaseIncome,
+		MissilesiloIncome: src.MissilesiloIncome,
+		MinesIncome:       src.MinesIncome,
 	}
+	out = dest
 
-	if src.EndedAt != nil {
-		out.EndedAt = converters.TimestampToTime(src.EndedAt)
-	}
+	return dest, nil
+}
 
-	if src.Moves != nil {
-		out.Moves = make([]GameMoveDatastore, len(src.Moves))
-		for i, item := range src.Moves {
-			_, err = GameMoveToGameMoveDatastore(item, &out.Moves[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
-			}
-		}
+// IncomeConfigFromIncomeConfigDatastore converts a IncomeConfigDatastore back to IncomeConfig.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination IncomeConfig message (if nil, a new one is created)
+//   - src: Source IncomeConfigDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted IncomeConfig message
+//   - Error if conversion fails
+func IncomeConfigFromIncomeConfigDatastore(
+	dest *v1.IncomeConfig,
+	src *IncomeConfigDatastore,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) (out *v1.IncomeConfig, err error) {
+	out, err = IncomeConfigFromIncomeConfigDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveGroupFromGameMoveGroupDatastore converts a GameMoveGroupDatastore back to GameMoveGroup.
+// IncomeConfigFromIncomeConfigDatastoreWithOptions converts a IncomeConfigDatastore back to IncomeConfig under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination GameMoveGroup message (if nil, a new one is created)
-//   - src: Source GameMoveGroupDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination IncomeConfig message (if nil, a new one is created)
+//   - src: Source IncomeConfigDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMoveGroup message
+//   - Converted IncomeConfig message
 //   - Error if conversion fails
-func GameMoveGroupFromGameMoveGroupDatastore(
-	dest *v1.GameMoveGroup,
-	src *GameMoveGroupDatastore,
-	decorator func(*v1.GameMoveGroup, *GameMoveGroupDatastore) error,
-) (out *v1.GameMoveGroup, err error) {
+func IncomeConfigFromIncomeConfigDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.IncomeConfig,
+	src *IncomeConfigDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.IncomeConfig, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.GameMoveGroup{}
+		dest = &v1.IncomeConfig{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.GameMoveGroup{
-		StartedAt: converters.TimeToTimestamp(src.StartedAt),
-		EndedAt:   converters.TimeToTimestamp(src.EndedAt),
+	*dest = v1.IncomeConfig{
+		StartingCoins:     src.StartingCoins,
+		GameIncome:        src.GameIncome,
+		LandbaseIncome:    src.LandbaseIncome,
+		NavalbaseIncome:   src.NavalbaseIncome,
+		AirportbaseIncome: src.AirportbaseIncome,
+		MissilesiloIncome: src.MissilesiloIncome,
+		MinesIncome:       src.MinesIncome,
 	}
 	out = dest
 
-	if src.Moves != nil {
-		out.Moves = make([]*v1.GameMove, len(src.Moves))
-		for i, item := range src.Moves {
-			out.Moves[i], err = GameMoveFromGameMoveDatastore(nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
-			}
-		}
+	return dest, nil
+}
+
+// IncomeConfigSliceToIncomeConfigDatastoreSlice converts a slice of v1.IncomeConfig with IncomeConfigToIncomeConfigDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func IncomeConfigSliceToIncomeConfigDatastoreSlice(
+	src []*v1.IncomeConfig,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) ([]*IncomeConfigDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.IncomeConfig) (*IncomeConfigDatastore, error) {
+		return IncomeConfigToIncomeConfigDatastore(item, nil, decorator)
+	})
+}
+
+// IncomeConfigSliceFromIncomeConfigDatastoreSlice converts a slice of IncomeConfigDatastore with IncomeConfigFromIncomeConfigDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func IncomeConfigSliceFromIncomeConfigDatastoreSlice(
+	src []*IncomeConfigDatastore,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) ([]*v1.IncomeConfig, error) {
+	return converters.MapSlice(src, func(item *IncomeConfigDatastore) (*v1.IncomeConfig, error) {
+		return IncomeConfigFromIncomeConfigDatastore(nil, item, decorator)
+	})
+}
+
+// IncomeConfigMapToIncomeConfigDatastoreMap converts the values of a map with IncomeConfigToIncomeConfigDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func IncomeConfigMapToIncomeConfigDatastoreMap[K comparable](
+	src map[K]*v1.IncomeConfig,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) (map[K]*IncomeConfigDatastore, error) {
+	return converters.MapValues(src, func(value *v1.IncomeConfig) (*IncomeConfigDatastore, error) {
+		return IncomeConfigToIncomeConfigDatastore(value, nil, decorator)
+	})
+}
+
+// IncomeConfigMapFromIncomeConfigDatastoreMap converts the values of a map with IncomeConfigFromIncomeConfigDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func IncomeConfigMapFromIncomeConfigDatastoreMap[K comparable](
+	src map[K]*IncomeConfigDatastore,
+	decorator func(*v1.IncomeConfig, *IncomeConfigDatastore) error,
+) (map[K]*v1.IncomeConfig, error) {
+	return converters.MapValues(src, func(value *IncomeConfigDatastore) (*v1.IncomeConfig, error) {
+		return IncomeConfigFromIncomeConfigDatastore(nil, value, decorator)
+	})
+}
+
+// conversionGameSettingsToGameSettingsDatastore describes GameSettingsToGameSettingsDatastore in conversion errors.
+var conversionGameSettingsToGameSettingsDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_settings",
+	SourceType: "v1.GameSettings",
+	TargetType: "GameSettingsDatastore",
+}
+
+// conversionGameSettingsFromGameSettingsDatastore describes GameSettingsFromGameSettingsDatastore in conversion errors.
+var conversionGameSettingsFromGameSettingsDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_settings",
+	SourceType: "GameSettingsDatastore",
+	TargetType: "v1.GameSettings",
+}
+
+// GameSettingsToGameSettingsDatastore converts a GameSettings to GameSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameSettings message to convert from
+//   - dest: Destination GameSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameSettingsDatastore entity
+//   - Error if conversion fails
+func GameSettingsToGameSettingsDatastore(
+	src *v1.GameSettings,
+	dest *GameSettingsDatastore,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) (out *GameSettingsDatastore, err error) {
+	out, err = GameSettingsToGameSettingsDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// MoveUnitActionToMoveUnitActionDatastore converts a MoveUnitAction to MoveUnitActionDatastore.
+// GameSettingsToGameSettingsDatastoreWithOptions converts a GameSettings to GameSettingsDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source MoveUnitAction message to convert from
-//   - dest: Destination MoveUnitActionDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source GameSettings message to convert from
+//   - dest: Destination GameSettingsDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted MoveUnitActionDatastore entity
+//   - Converted GameSettingsDatastore entity
 //   - Error if conversion fails
-func MoveUnitActionToMoveUnitActionDatastore(
-	src *v1.MoveUnitAction,
-	dest *MoveUnitActionDatastore,
-	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
-) (out *MoveUnitActionDatastore, err error) {
+func GameSettingsToGameSettingsDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.GameSettings,
+	dest *GameSettingsDatastore,
+	opts ...converters.ConvertOption,
+) (out *GameSettingsDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &MoveUnitActionDatastore{}
+		dest = &GameSettingsDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = MoveUnitActionDatastore{
-		FromQ:        src.FromQ,
-		FromR:        src.FromR,
-		ToQ:          src.ToQ,
-		ToR:          src.ToR,
-		MovementCost: src.MovementCost,
+	*dest = GameSettingsDatastore{
+		AllowedUnits:  src.AllowedUnits,
+		TurnTimeLimit: src.TurnTimeLimit,
+		TeamMode:      src.TeamMode,
+		MaxTurns:      src.MaxTurns,
 	}
 	out = dest
 
-	if src.ReconstructedPath != nil {
-		out.ReconstructedPath, err = converters.MessageToAnyBytes(src.ReconstructedPath)
-		if err != nil {
-			return nil, fmt.Errorf("converting ReconstructedPath: %w", err)
-		}
+	return dest, nil
+}
+
+// GameSettingsFromGameSettingsDatastore converts a GameSettingsDatastore back to GameSettings.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameSettings message (if nil, a new one is created)
+//   - src: Source GameSettingsDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameSettings message
+//   - Error if conversion fails
+func GameSettingsFromGameSettingsDatastore(
+	dest *v1.GameSettings,
+	src *GameSettingsDatastore,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) (out *v1.GameSettings, err error) {
+	out, err = GameSettingsFromGameSettingsDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// MoveUnitActionFromMoveUnitActionDatastore converts a MoveUnitActionDatastore back to MoveUnitAction.
+// GameSettingsFromGameSettingsDatastoreWithOptions converts a GameSettingsDatastore back to GameSettings under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination MoveUnitAction message (if nil, a new one is created)
-//   - src: Source MoveUnitActionDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination GameSettings message (if nil, a new one is created)
+//   - src: Source GameSettingsDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted MoveUnitAction message
+//   - Converted GameSettings message
 //   - Error if conversion fails
-func MoveUnitActionFromMoveUnitActionDatastore(
-	dest *v1.MoveUnitAction,
-	src *MoveUnitActionDatastore,
-	decorator func(*v1.MoveUnitAction, *MoveUnitActionDatastore) error,
-) (out *v1.MoveUnitAction, err error) {
+func GameSettingsFromGameSettingsDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.GameSettings,
+	src *GameSettingsDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.GameSettings, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.MoveUnitAction{}
+		dest = &v1.GameSettings{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.MoveUnitAction{
-		FromQ:        src.FromQ,
-		FromR:        src.FromR,
-		ToQ:          src.ToQ,
-		ToR:          src.ToR,
-		MovementCost: src.MovementCost,
+	*dest = v1.GameSettings{
+		AllowedUnits:  src.AllowedUnits,
+		TurnTimeLimit: src.TurnTimeLimit,
+		TeamMode:      src.TeamMode,
+		MaxTurns:      src.MaxTurns,
 	}
 	out = dest
 
-	out.ReconstructedPath, err = converters.AnyBytesToMessage[*v1.Path](src.ReconstructedPath)
-	if err != nil {
-		return nil, fmt.Errorf("converting ReconstructedPath: %w", err)
+	return dest, nil
+}
+
+// GameSettingsSliceToGameSettingsDatastoreSlice converts a slice of v1.GameSettings with GameSettingsToGameSettingsDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSettingsSliceToGameSettingsDatastoreSlice(
+	src []*v1.GameSettings,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) ([]*GameSettingsDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.GameSettings) (*GameSettingsDatastore, error) {
+		return GameSettingsToGameSettingsDatastore(item, nil, decorator)
+	})
+}
+
+// GameSettingsSliceFromGameSettingsDatastoreSlice converts a slice of GameSettingsDatastore with GameSettingsFromGameSettingsDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSettingsSliceFromGameSettingsDatastoreSlice(
+	src []*GameSettingsDatastore,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) ([]*v1.GameSettings, error) {
+	return converters.MapSlice(src, func(item *GameSettingsDatastore) (*v1.GameSettings, error) {
+		return GameSettingsFromGameSettingsDatastore(nil, item, decorator)
+	})
+}
+
+// GameSettingsMapToGameSettingsDatastoreMap converts the values of a map with GameSettingsToGameSettingsDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameSettingsMapToGameSettingsDatastoreMap[K comparable](
+	src map[K]*v1.GameSettings,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) (map[K]*GameSettingsDatastore, error) {
+	return converters.MapValues(src, func(value *v1.GameSettings) (*GameSettingsDatastore, error) {
+		return GameSettingsToGameSettingsDatastore(value, nil, decorator)
+	})
+}
+
+// GameSettingsMapFromGameSettingsDatastoreMap converts the values of a map with GameSettingsFromGameSettingsDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameSettingsMapFromGameSettingsDatastoreMap[K comparable](
+	src map[K]*GameSettingsDatastore,
+	decorator func(*v1.GameSettings, *GameSettingsDatastore) error,
+) (map[K]*v1.GameSettings, error) {
+	return converters.MapValues(src, func(value *GameSettingsDatastore) (*v1.GameSettings, error) {
+		return GameSettingsFromGameSettingsDatastore(nil, value, decorator)
+	})
+}
+
+// conversionAttackRecordToAttackRecordDatastore describes AttackRecordToAttackRecordDatastore in conversion errors.
+var conversionAttackRecordToAttackRecordDatastore = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "attack_record",
+	SourceType: "v1.AttackRecord",
+	TargetType: "AttackRecordDatastore",
+}
+
+// conversionAttackRecordFromAttackRecordDatastore describes AttackRecordFromAttackRecordDatastore in conversion errors.
+var conversionAttackRecordFromAttackRecordDatastore = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "attack_record",
+	SourceType: "AttackRecordDatastore",
+	TargetType: "v1.AttackRecord",
+}
+
+// AttackRecordToAttackRecordDatastore converts a AttackRecord to AttackRecordDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source AttackRecord message to convert from
+//   - dest: Destination AttackRecordDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted AttackRecordDatastore entity
+//   - Error if conversion fails
+func AttackRecordToAttackRecordDatastore(
+	src *v1.AttackRecord,
+	dest *AttackRecordDatastore,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) (out *AttackRecordDatastore, err error) {
+	out, err = AttackRecordToAttackRecordDatastoreWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveToGameMoveDatastore converts a GameMove to GameMoveDatastore.
+// AttackRecordToAttackRecordDatastoreWithOptions converts a AttackRecord to AttackRecordDatastore under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - src: Source GameMove message to convert from
-//   - dest: Destination GameMoveDatastore entity (if nil, a new one is created)
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - src: Source AttackRecord message to convert from
+//   - dest: Destination AttackRecordDatastore entity (if nil, a new one is created)
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMoveDatastore entity
+//   - Converted AttackRecordDatastore entity
 //   - Error if conversion fails
-func GameMoveToGameMoveDatastore(
-	src *v1.GameMove,
-	dest *GameMoveDatastore,
-	decorator func(*v1.GameMove, *GameMoveDatastore) error,
-) (out *GameMoveDatastore, err error) {
+func AttackRecordToAttackRecordDatastoreWithOptions(
+	ctx context.Context,
+	src *v1.AttackRecord,
+	dest *AttackRecordDatastore,
+	opts ...converters.ConvertOption,
+) (out *AttackRecordDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &GameMoveDatastore{}
+		dest = &AttackRecordDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameMoveDatastore{
-		Player:      src.Player,
-		SequenceNum: src.SequenceNum,
-		IsPermanent: src.IsPermanent,
+	*dest = AttackRecordDatastore{
+		Q:          src.Q,
+		R:          src.R,
+		IsRanged:   src.IsRanged,
+		TurnNumber: src.TurnNumber,
 	}
 	out = dest
 
-	if src.Timestamp != nil {
-		out.Timestamp = converters.TimestampToTime(src.Timestamp)
-	}
+	return dest, nil
+}
 
-	if src.Changes != nil {
-		out.Changes = make([][]byte, len(src.Changes))
-		for i, item := range src.Changes {
-			_, err = converters.MessageToAnyBytesConverter(item, &out.Changes[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Changes[%d]: %w", i, err)
-			}
-		}
+// AttackRecordFromAttackRecordDatastore converts a AttackRecordDatastore back to AttackRecord.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination AttackRecord message (if nil, a new one is created)
+//   - src: Source AttackRecordDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted AttackRecord message
+//   - Error if conversion fails
+func AttackRecordFromAttackRecordDatastore(
+	dest *v1.AttackRecord,
+	src *AttackRecordDatastore,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) (out *v1.AttackRecord, err error) {
+	out, err = AttackRecordFromAttackRecordDatastoreWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveFromGameMoveDatastore converts a GameMoveDatastore back to GameMove.
+// AttackRecordFromAttackRecordDatastoreWithOptions converts a AttackRecordDatastore back to AttackRecord under a context and options.
 //
-// The optional decorator function allows custom field transformations after conversion.
+// The context and options propagate into nested message converters.
 //
 // Parameters:
-//   - dest: Destination GameMove message (if nil, a new one is created)
-//   - src: Source GameMoveDatastore entity to convert from
-//   - decorator: Optional function for custom transformations
+//   - ctx: Context passed to nested converters and context-aware custom converters
+//   - dest: Destination AttackRecord message (if nil, a new one is created)
+//   - src: Source AttackRecordDatastore entity to convert from
+//   - opts: Conversion options (clock, strict mode, encryption, type converters, max depth)
 //
 // Returns:
-//   - Converted GameMove message
+//   - Converted AttackRecord message
 //   - Error if conversion fails
-func GameMoveFromGameMoveDatastore(
-	dest *v1.GameMove,
-	src *GameMoveDatastore,
-	decorator func(*v1.GameMove, *GameMoveDatastore) error,
-) (out *v1.GameMove, err error) {
+func AttackRecordFromAttackRecordDatastoreWithOptions(
+	ctx context.Context,
+	dest *v1.AttackRecord,
+	src *AttackRecordDatastore,
+	opts ...converters.ConvertOption,
+) (out *v1.AttackRecord, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &v1.GameMove{}
+		dest = &v1.AttackRecord{}
 	}
 
 	// Initialize struct with inline values
-	*dest = v1.GameMove{
-		Player:      src.Player,
-		Timestamp:   converters.TimeToTimestamp(src.Timestamp),
-		SequenceNum: src.SequenceNum,
-		IsPermanent: src.IsPermanent,
+	*dest = v1.AttackRecord{
+		Q:          src.Q,
+		R:          src.R,
+		IsRanged:   src.IsRanged,
+		TurnNumber: src.TurnNumber,
 	}
 	out = dest
 
-	if src.Changes != nil {
-		out.Changes = make([]*v1.WorldChange, len(src.Changes))
-		for i, item := range src.Changes {
-			out.Changes[i], err = converters.AnyBytesToMessageConverter[*v1.WorldChange](nil, &item, nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Changes[%d]: %w", i, err)
-			}
-		}
-	}
+	return dest, nil
+}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
+// AttackRecordSliceToAttackRecordDatastoreSlice converts a slice of v1.AttackRecord with AttackRecordToAttackRecordDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func AttackRecordSliceToAttackRecordDatastoreSlice(
+	src []*v1.AttackRecord,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) ([]*AttackRecordDatastore, error) {
+	return converters.MapSlice(src, func(item *v1.AttackRecord) (*AttackRecordDatastore, error) {
+		return AttackRecordToAttackRecordDatastore(item, nil, decorator)
+	})
+}
 
-	return dest, nil
+// AttackRecordSliceFromAttackRecordDatastoreSlice converts a slice of AttackRecordDatastore with AttackRecordFromAttackRecordDatastore,
+// applying the optional decorator to each item. Nil items stay nil.
+func AttackRecordSliceFromAttackRecordDatastoreSlice(
+	src []*AttackRecordDatastore,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) ([]*v1.AttackRecord, error) {
+	return converters.MapSlice(src, func(item *AttackRecordDatastore) (*v1.AttackRecord, error) {
+		return AttackRecordFromAttackRecordDatastore(nil, item, decorator)
+	})
+}
+
+// AttackRecordMapToAttackRecordDatastoreMap converts the values of a map with AttackRecordToAttackRecordDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func AttackRecordMapToAttackRecordDatastoreMap[K comparable](
+	src map[K]*v1.AttackRecord,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) (map[K]*AttackRecordDatastore, error) {
+	return converters.MapValues(src, func(value *v1.AttackRecord) (*AttackRecordDatastore, error) {
+		return AttackRecordToAttackRecordDatastore(value, nil, decorator)
+	})
+}
+
+// AttackRecordMapFromAttackRecordDatastoreMap converts the values of a map with AttackRecordFromAttackRecordDatastore,
+// applying the optional decorator to each value. Nil values stay nil.
+func AttackRecordMapFromAttackRecordDatastoreMap[K comparable](
+	src map[K]*AttackRecordDatastore,
+	decorator func(*v1.AttackRecord, *AttackRecordDatastore) error,
+) (map[K]*v1.AttackRecord, error) {
+	return converters.MapValues(src, func(value *AttackRecordDatastore) (*v1.AttackRecord, error) {
+		return AttackRecordFromAttackRecordDatastore(nil, value, decorator)
+	})
 }
@@ -0,0 +1,1038 @@
+// Code generated by protoc-gen-dal-datastore. DO NOT EDIT.
+package datastore
+
+import (
+	"math/rand"
+	"testing"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/panyam/protoc-gen-dal/pkg/roundtrip"
+	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
+)
+
+// TestWorldToWorldDatastoreRoundTrip checks that WorldFromWorldDatastore restores what
+// WorldToWorldDatastore stored, for random v1.World messages.
+func TestWorldToWorldDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.World{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldToWorldDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldToWorldDatastore(%v): %v", src, err)
+		}
+		got, err := WorldFromWorldDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("WorldFromWorldDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedWorldFromWorldDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestWorldToWorldDatastoreClone checks that Clone copies the WorldDatastore
+// that WorldToWorldDatastore makes without sharing memory with it.
+func TestWorldToWorldDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.World{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldToWorldDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldToWorldDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("WorldDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedWorldFromWorldDatastore returns the v1.World that WorldFromWorldDatastore
+// should return for the WorldDatastore that WorldToWorldDatastore makes from src.
+func expectedWorldFromWorldDatastore(src *v1.World) *v1.World {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.World)
+
+	want.WorldData = expectedWorldDataFromWorldDataDatastore(want.WorldData)
+
+	want.DefaultGameConfig = expectedGameConfigurationFromGameConfigurationDatastore(want.DefaultGameConfig)
+
+	want.ScreenshotIndexInfo = expectedIndexInfoFromIndexInfoDatastore(want.ScreenshotIndexInfo)
+
+	want.SearchIndexInfo = expectedIndexInfoFromIndexInfoDatastore(want.SearchIndexInfo)
+	return want
+}
+
+// TestWorldDataToWorldDataDatastoreRoundTrip checks that WorldDataFromWorldDataDatastore restores what
+// WorldDataToWorldDataDatastore stored, for random v1.WorldData messages.
+func TestWorldDataToWorldDataDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.WorldData{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldDataToWorldDataDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldDataToWorldDataDatastore(%v): %v", src, err)
+		}
+		got, err := WorldDataFromWorldDataDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("WorldDataFromWorldDataDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedWorldDataFromWorldDataDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestWorldDataToWorldDataDatastoreClone checks that Clone copies the WorldDataDatastore
+// that WorldDataToWorldDataDatastore makes without sharing memory with it.
+func TestWorldDataToWorldDataDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.WorldData{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldDataToWorldDataDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldDataToWorldDataDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("WorldDataDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedWorldDataFromWorldDataDatastore returns the v1.WorldData that WorldDataFromWorldDataDatastore
+// should return for the WorldDataDatastore that WorldDataToWorldDataDatastore makes from src.
+func expectedWorldDataFromWorldDataDatastore(src *v1.WorldData) *v1.WorldData {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.WorldData)
+
+	for i, item := range want.Tiles {
+		want.Tiles[i] = expectedTileFromTileDatastore(item)
+	}
+
+	for i, item := range want.Units {
+		want.Units[i] = expectedUnitFromUnitDatastore(item)
+	}
+	return want
+}
+
+// TestGameToGameDatastoreRoundTrip checks that GameFromGameDatastore restores what
+// GameToGameDatastore stored, for random v1.Game messages.
+func TestGameToGameDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Game{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameToGameDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameToGameDatastore(%v): %v", src, err)
+		}
+		got, err := GameFromGameDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameFromGameDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameFromGameDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameToGameDatastoreClone checks that Clone copies the GameDatastore
+// that GameToGameDatastore makes without sharing memory with it.
+func TestGameToGameDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Game{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameToGameDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameToGameDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameFromGameDatastore returns the v1.Game that GameFromGameDatastore
+// should return for the GameDatastore that GameToGameDatastore makes from src.
+func expectedGameFromGameDatastore(src *v1.Game) *v1.Game {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Game)
+
+	want.Config = expectedGameConfigurationFromGameConfigurationDatastore(want.Config)
+
+	want.ScreenshotIndexInfo = expectedIndexInfoFromIndexInfoDatastore(want.ScreenshotIndexInfo)
+
+	want.SearchIndexInfo = expectedIndexInfoFromIndexInfoDatastore(want.SearchIndexInfo)
+	return want
+}
+
+// TestGameStateToGameStateDatastoreRoundTrip checks that GameStateFromGameStateDatastore restores what
+// GameStateToGameStateDatastore stored, for random v1.GameState messages.
+func TestGameStateToGameStateDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameState{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameStateToGameStateDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameStateToGameStateDatastore(%v): %v", src, err)
+		}
+		got, err := GameStateFromGameStateDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameStateFromGameStateDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameStateFromGameStateDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameStateToGameStateDatastoreClone checks that Clone copies the GameStateDatastore
+// that GameStateToGameStateDatastore makes without sharing memory with it.
+func TestGameStateToGameStateDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameState{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameStateToGameStateDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameStateToGameStateDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameStateDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameStateFromGameStateDatastore returns the v1.GameState that GameStateFromGameStateDatastore
+// should return for the GameStateDatastore that GameStateToGameStateDatastore makes from src.
+func expectedGameStateFromGameStateDatastore(src *v1.GameState) *v1.GameState {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameState)
+
+	want.WorldData = expectedWorldDataFromWorldDataDatastore(want.WorldData)
+	return want
+}
+
+// TestGameMoveHistoryToGameMoveHistoryDatastoreRoundTrip checks that GameMoveHistoryFromGameMoveHistoryDatastore restores what
+// GameMoveHistoryToGameMoveHistoryDatastore stored, for random v1.GameMoveHistory messages.
+func TestGameMoveHistoryToGameMoveHistoryDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveHistory{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveHistoryToGameMoveHistoryDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryToGameMoveHistoryDatastore(%v): %v", src, err)
+		}
+		got, err := GameMoveHistoryFromGameMoveHistoryDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryFromGameMoveHistoryDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveHistoryFromGameMoveHistoryDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveHistoryToGameMoveHistoryDatastoreClone checks that Clone copies the GameMoveHistoryDatastore
+// that GameMoveHistoryToGameMoveHistoryDatastore makes without sharing memory with it.
+func TestGameMoveHistoryToGameMoveHistoryDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveHistory{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveHistoryToGameMoveHistoryDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryToGameMoveHistoryDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameMoveHistoryDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveHistoryFromGameMoveHistoryDatastore returns the v1.GameMoveHistory that GameMoveHistoryFromGameMoveHistoryDatastore
+// should return for the GameMoveHistoryDatastore that GameMoveHistoryToGameMoveHistoryDatastore makes from src.
+func expectedGameMoveHistoryFromGameMoveHistoryDatastore(src *v1.GameMoveHistory) *v1.GameMoveHistory {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMoveHistory)
+
+	for i, item := range want.Groups {
+		want.Groups[i] = expectedGameMoveGroupFromGameMoveGroupDatastore(item)
+	}
+	return want
+}
+
+// TestMoveUnitActionToMoveUnitActionDatastoreRoundTrip checks that MoveUnitActionFromMoveUnitActionDatastore restores what
+// MoveUnitActionToMoveUnitActionDatastore stored, for random v1.MoveUnitAction messages.
+func TestMoveUnitActionToMoveUnitActionDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.MoveUnitAction{}
+		roundtrip.Fill(src, rng)
+
+		target, err := MoveUnitActionToMoveUnitActionDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("MoveUnitActionToMoveUnitActionDatastore(%v): %v", src, err)
+		}
+		got, err := MoveUnitActionFromMoveUnitActionDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("MoveUnitActionFromMoveUnitActionDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedMoveUnitActionFromMoveUnitActionDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestMoveUnitActionToMoveUnitActionDatastoreClone checks that Clone copies the MoveUnitActionDatastore
+// that MoveUnitActionToMoveUnitActionDatastore makes without sharing memory with it.
+func TestMoveUnitActionToMoveUnitActionDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.MoveUnitAction{}
+		roundtrip.Fill(src, rng)
+
+		target, err := MoveUnitActionToMoveUnitActionDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("MoveUnitActionToMoveUnitActionDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("MoveUnitActionDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedMoveUnitActionFromMoveUnitActionDatastore returns the v1.MoveUnitAction that MoveUnitActionFromMoveUnitActionDatastore
+// should return for the MoveUnitActionDatastore that MoveUnitActionToMoveUnitActionDatastore makes from src.
+func expectedMoveUnitActionFromMoveUnitActionDatastore(src *v1.MoveUnitAction) *v1.MoveUnitAction {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.MoveUnitAction)
+	return want
+}
+
+// TestGameMoveToGameMoveDatastoreRoundTrip checks that GameMoveFromGameMoveDatastore restores what
+// GameMoveToGameMoveDatastore stored, for random v1.GameMove messages.
+func TestGameMoveToGameMoveDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMove{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveToGameMoveDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveToGameMoveDatastore(%v): %v", src, err)
+		}
+		got, err := GameMoveFromGameMoveDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveFromGameMoveDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveFromGameMoveDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveToGameMoveDatastoreClone checks that Clone copies the GameMoveDatastore
+// that GameMoveToGameMoveDatastore makes without sharing memory with it.
+func TestGameMoveToGameMoveDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMove{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveToGameMoveDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveToGameMoveDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameMoveDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveFromGameMoveDatastore returns the v1.GameMove that GameMoveFromGameMoveDatastore
+// should return for the GameMoveDatastore that GameMoveToGameMoveDatastore makes from src.
+func expectedGameMoveFromGameMoveDatastore(src *v1.GameMove) *v1.GameMove {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMove)
+
+	// Not restored by GameMoveFromGameMoveDatastore
+	roundtrip.ClearFields(want,
+		"move_unit",   // oneof_replaced
+		"attack_unit", // oneof_replaced
+		"end_turn",    // oneof_replaced
+		"build_unit",  // oneof_replaced
+	)
+	return want
+}
+
+// TestGameConfigurationToGameConfigurationDatastoreRoundTrip checks that GameConfigurationFromGameConfigurationDatastore restores what
+// GameConfigurationToGameConfigurationDatastore stored, for random v1.GameConfiguration messages.
+func TestGameConfigurationToGameConfigurationDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameConfiguration{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameConfigurationToGameConfigurationDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationToGameConfigurationDatastore(%v): %v", src, err)
+		}
+		got, err := GameConfigurationFromGameConfigurationDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationFromGameConfigurationDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameConfigurationFromGameConfigurationDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameConfigurationToGameConfigurationDatastoreClone checks that Clone copies the GameConfigurationDatastore
+// that GameConfigurationToGameConfigurationDatastore makes without sharing memory with it.
+func TestGameConfigurationToGameConfigurationDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameConfiguration{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameConfigurationToGameConfigurationDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationToGameConfigurationDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameConfigurationDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameConfigurationFromGameConfigurationDatastore returns the v1.GameConfiguration that GameConfigurationFromGameConfigurationDatastore
+// should return for the GameConfigurationDatastore that GameConfigurationToGameConfigurationDatastore makes from src.
+func expectedGameConfigurationFromGameConfigurationDatastore(src *v1.GameConfiguration) *v1.GameConfiguration {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameConfiguration)
+
+	for i, item := range want.Players {
+		want.Players[i] = expectedGamePlayerFromGamePlayerDatastore(item)
+	}
+
+	for i, item := range want.Teams {
+		want.Teams[i] = expectedGameTeamFromGameTeamDatastore(item)
+	}
+
+	want.IncomeConfigs = expectedIncomeConfigFromIncomeConfigDatastore(want.IncomeConfigs)
+
+	want.Settings = expectedGameSettingsFromGameSettingsDatastore(want.Settings)
+	return want
+}
+
+// TestIndexInfoToIndexInfoDatastoreRoundTrip checks that IndexInfoFromIndexInfoDatastore restores what
+// IndexInfoToIndexInfoDatastore stored, for random v1.IndexInfo messages.
+func TestIndexInfoToIndexInfoDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IndexInfo{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IndexInfoToIndexInfoDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoToIndexInfoDatastore(%v): %v", src, err)
+		}
+		got, err := IndexInfoFromIndexInfoDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoFromIndexInfoDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedIndexInfoFromIndexInfoDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestIndexInfoToIndexInfoDatastoreClone checks that Clone copies the IndexInfoDatastore
+// that IndexInfoToIndexInfoDatastore makes without sharing memory with it.
+func TestIndexInfoToIndexInfoDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IndexInfo{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IndexInfoToIndexInfoDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoToIndexInfoDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("IndexInfoDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedIndexInfoFromIndexInfoDatastore returns the v1.IndexInfo that IndexInfoFromIndexInfoDatastore
+// should return for the IndexInfoDatastore that IndexInfoToIndexInfoDatastore makes from src.
+func expectedIndexInfoFromIndexInfoDatastore(src *v1.IndexInfo) *v1.IndexInfo {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.IndexInfo)
+	return want
+}
+
+// TestTileToTileDatastoreRoundTrip checks that TileFromTileDatastore restores what
+// TileToTileDatastore stored, for random v1.Tile messages.
+func TestTileToTileDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Tile{}
+		roundtrip.Fill(src, rng)
+
+		target, err := TileToTileDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("TileToTileDatastore(%v): %v", src, err)
+		}
+		got, err := TileFromTileDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("TileFromTileDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedTileFromTileDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestTileToTileDatastoreClone checks that Clone copies the TileDatastore
+// that TileToTileDatastore makes without sharing memory with it.
+func TestTileToTileDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Tile{}
+		roundtrip.Fill(src, rng)
+
+		target, err := TileToTileDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("TileToTileDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("TileDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedTileFromTileDatastore returns the v1.Tile that TileFromTileDatastore
+// should return for the TileDatastore that TileToTileDatastore makes from src.
+func expectedTileFromTileDatastore(src *v1.Tile) *v1.Tile {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Tile)
+	return want
+}
+
+// TestUnitToUnitDatastoreRoundTrip checks that UnitFromUnitDatastore restores what
+// UnitToUnitDatastore stored, for random v1.Unit messages.
+func TestUnitToUnitDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Unit{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UnitToUnitDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UnitToUnitDatastore(%v): %v", src, err)
+		}
+		got, err := UnitFromUnitDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UnitFromUnitDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedUnitFromUnitDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUnitToUnitDatastoreClone checks that Clone copies the UnitDatastore
+// that UnitToUnitDatastore makes without sharing memory with it.
+func TestUnitToUnitDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Unit{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UnitToUnitDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UnitToUnitDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("UnitDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUnitFromUnitDatastore returns the v1.Unit that UnitFromUnitDatastore
+// should return for the UnitDatastore that UnitToUnitDatastore makes from src.
+func expectedUnitFromUnitDatastore(src *v1.Unit) *v1.Unit {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Unit)
+
+	for i, item := range want.AttackHistory {
+		want.AttackHistory[i] = expectedAttackRecordFromAttackRecordDatastore(item)
+	}
+	return want
+}
+
+// TestGameMoveGroupToGameMoveGroupDatastoreRoundTrip checks that GameMoveGroupFromGameMoveGroupDatastore restores what
+// GameMoveGroupToGameMoveGroupDatastore stored, for random v1.GameMoveGroup messages.
+func TestGameMoveGroupToGameMoveGroupDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveGroup{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveGroupToGameMoveGroupDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupToGameMoveGroupDatastore(%v): %v", src, err)
+		}
+		got, err := GameMoveGroupFromGameMoveGroupDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupFromGameMoveGroupDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveGroupFromGameMoveGroupDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveGroupToGameMoveGroupDatastoreClone checks that Clone copies the GameMoveGroupDatastore
+// that GameMoveGroupToGameMoveGroupDatastore makes without sharing memory with it.
+func TestGameMoveGroupToGameMoveGroupDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveGroup{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveGroupToGameMoveGroupDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupToGameMoveGroupDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameMoveGroupDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveGroupFromGameMoveGroupDatastore returns the v1.GameMoveGroup that GameMoveGroupFromGameMoveGroupDatastore
+// should return for the GameMoveGroupDatastore that GameMoveGroupToGameMoveGroupDatastore makes from src.
+func expectedGameMoveGroupFromGameMoveGroupDatastore(src *v1.GameMoveGroup) *v1.GameMoveGroup {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMoveGroup)
+
+	for i, item := range want.Moves {
+		want.Moves[i] = expectedGameMoveFromGameMoveDatastore(item)
+	}
+	return want
+}
+
+// TestGamePlayerToGamePlayerDatastoreRoundTrip checks that GamePlayerFromGamePlayerDatastore restores what
+// GamePlayerToGamePlayerDatastore stored, for random v1.GamePlayer messages.
+func TestGamePlayerToGamePlayerDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GamePlayer{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GamePlayerToGamePlayerDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerToGamePlayerDatastore(%v): %v", src, err)
+		}
+		got, err := GamePlayerFromGamePlayerDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerFromGamePlayerDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGamePlayerFromGamePlayerDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGamePlayerToGamePlayerDatastoreClone checks that Clone copies the GamePlayerDatastore
+// that GamePlayerToGamePlayerDatastore makes without sharing memory with it.
+func TestGamePlayerToGamePlayerDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GamePlayer{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GamePlayerToGamePlayerDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerToGamePlayerDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GamePlayerDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGamePlayerFromGamePlayerDatastore returns the v1.GamePlayer that GamePlayerFromGamePlayerDatastore
+// should return for the GamePlayerDatastore that GamePlayerToGamePlayerDatastore makes from src.
+func expectedGamePlayerFromGamePlayerDatastore(src *v1.GamePlayer) *v1.GamePlayer {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GamePlayer)
+	return want
+}
+
+// TestGameTeamToGameTeamDatastoreRoundTrip checks that GameTeamFromGameTeamDatastore restores what
+// GameTeamToGameTeamDatastore stored, for random v1.GameTeam messages.
+func TestGameTeamToGameTeamDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameTeam{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameTeamToGameTeamDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameTeamToGameTeamDatastore(%v): %v", src, err)
+		}
+		got, err := GameTeamFromGameTeamDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameTeamFromGameTeamDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameTeamFromGameTeamDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameTeamToGameTeamDatastoreClone checks that Clone copies the GameTeamDatastore
+// that GameTeamToGameTeamDatastore makes without sharing memory with it.
+func TestGameTeamToGameTeamDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameTeam{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameTeamToGameTeamDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameTeamToGameTeamDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameTeamDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameTeamFromGameTeamDatastore returns the v1.GameTeam that GameTeamFromGameTeamDatastore
+// should return for the GameTeamDatastore that GameTeamToGameTeamDatastore makes from src.
+func expectedGameTeamFromGameTeamDatastore(src *v1.GameTeam) *v1.GameTeam {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameTeam)
+	return want
+}
+
+// TestIncomeConfigToIncomeConfigDatastoreRoundTrip checks that IncomeConfigFromIncomeConfigDatastore restores what
+// IncomeConfigToIncomeConfigDatastore stored, for random v1.IncomeConfig messages.
+func TestIncomeConfigToIncomeConfigDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IncomeConfig{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IncomeConfigToIncomeConfigDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigToIncomeConfigDatastore(%v): %v", src, err)
+		}
+		got, err := IncomeConfigFromIncomeConfigDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigFromIncomeConfigDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedIncomeConfigFromIncomeConfigDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestIncomeConfigToIncomeConfigDatastoreClone checks that Clone copies the IncomeConfigDatastore
+// that IncomeConfigToIncomeConfigDatastore makes without sharing memory with it.
+func TestIncomeConfigToIncomeConfigDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IncomeConfig{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IncomeConfigToIncomeConfigDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigToIncomeConfigDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("IncomeConfigDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedIncomeConfigFromIncomeConfigDatastore returns the v1.IncomeConfig that IncomeConfigFromIncomeConfigDatastore
+// should return for the IncomeConfigDatastore that IncomeConfigToIncomeConfigDatastore makes from src.
+func expectedIncomeConfigFromIncomeConfigDatastore(src *v1.IncomeConfig) *v1.IncomeConfig {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.IncomeConfig)
+	return want
+}
+
+// TestGameSettingsToGameSettingsDatastoreRoundTrip checks that GameSettingsFromGameSettingsDatastore restores what
+// GameSettingsToGameSettingsDatastore stored, for random v1.GameSettings messages.
+func TestGameSettingsToGameSettingsDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameSettings{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameSettingsToGameSettingsDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsToGameSettingsDatastore(%v): %v", src, err)
+		}
+		got, err := GameSettingsFromGameSettingsDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsFromGameSettingsDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedGameSettingsFromGameSettingsDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameSettingsToGameSettingsDatastoreClone checks that Clone copies the GameSettingsDatastore
+// that GameSettingsToGameSettingsDatastore makes without sharing memory with it.
+func TestGameSettingsToGameSettingsDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameSettings{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameSettingsToGameSettingsDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsToGameSettingsDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("GameSettingsDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameSettingsFromGameSettingsDatastore returns the v1.GameSettings that GameSettingsFromGameSettingsDatastore
+// should return for the GameSettingsDatastore that GameSettingsToGameSettingsDatastore makes from src.
+func expectedGameSettingsFromGameSettingsDatastore(src *v1.GameSettings) *v1.GameSettings {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameSettings)
+	return want
+}
+
+// TestAttackRecordToAttackRecordDatastoreRoundTrip checks that AttackRecordFromAttackRecordDatastore restores what
+// AttackRecordToAttackRecordDatastore stored, for random v1.AttackRecord messages.
+func TestAttackRecordToAttackRecordDatastoreRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.AttackRecord{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AttackRecordToAttackRecordDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordToAttackRecordDatastore(%v): %v", src, err)
+		}
+		got, err := AttackRecordFromAttackRecordDatastore(nil, target, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordFromAttackRecordDatastore(%v): %v", target, err)
+		}
+
+		if want := expectedAttackRecordFromAttackRecordDatastore(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestAttackRecordToAttackRecordDatastoreClone checks that Clone copies the AttackRecordDatastore
+// that AttackRecordToAttackRecordDatastore makes without sharing memory with it.
+func TestAttackRecordToAttackRecordDatastoreClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.AttackRecord{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AttackRecordToAttackRecordDatastore(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordToAttackRecordDatastore(%v): %v", src, err)
+		}
+
+		target.Key = datastore.NameKey("AttackRecordDatastore", "clone", datastore.IDKey("Parent", 1, nil))
+		clone := target.Clone()
+		if !clone.Equal(target) || !clone.Key.Equal(target.Key) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedAttackRecordFromAttackRecordDatastore returns the v1.AttackRecord that AttackRecordFromAttackRecordDatastore
+// should return for the AttackRecordDatastore that AttackRecordToAttackRecordDatastore makes from src.
+func expectedAttackRecordFromAttackRecordDatastore(src *v1.AttackRecord) *v1.AttackRecord {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.AttackRecord)
+	return want
+}
@@ -363,6 +363,76 @@ func (x *TestRecord3) GetCountsByType() map[string]int64 {
 	return nil
 }
 
+// TestRecord4 tests collections whose elements or map values need a type
+// conversion (applied element by element in generated loops).
+type TestRecord4 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Id            string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeenAt        []*timestamppb.Timestamp          `protobuf:"bytes,2,rep,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+	MemberIds     []uint32                          `protobuf:"varint,3,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Deadlines     map[string]*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=deadlines,proto3" json:"deadlines,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestRecord4) Reset() {
+	*x = TestRecord4{}
+	mi := &file_api_testany_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRecord4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRecord4) ProtoMessage() {}
+
+func (x *TestRecord4) ProtoReflect() protoreflect.Message {
+	mi := &file_api_testany_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRecord4.ProtoReflect.Descriptor instead.
+func (*TestRecord4) Descriptor() ([]byte, []int) {
+	return file_api_testany_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TestRecord4) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestRecord4) GetSeenAt() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
+func (x *TestRecord4) GetMemberIds() []uint32 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *TestRecord4) GetDeadlines() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadlines
+	}
+	return nil
+}
+
 var File_api_testany_proto protoreflect.FileDescriptor
 
 const file_api_testany_proto_rawDesc = "" +
@@ -410,7 +480,16 @@ const file_api_testany_proto_rawDesc = "" +
 	"\x0ecounts_by_type\x18\x05 \x03(\v2\".api.TestRecord3.CountsByTypeEntryR\fcountsByType\x1a?\n" +
 	"\x11CountsByTypeEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01*b\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x8a\x02\n" +
+	"\vTestRecord4\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\aseen_at\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x06seenAt\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\rR\tmemberIds\x12=\n" +
+	"\tdeadlines\x18\x04 \x03(\v2\x1f.api.TestRecord4.DeadlinesEntryR\tdeadlines\x1aX\n" +
+	"\x0eDeadlinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01*b\n" +
 	"\n" +
 	"SampleEnum\x12\x1b\n" +
 	"\x17SAMPLE_ENUM_UNSPECIFIED\x10\x00\x12\x11\n" +
@@ -432,43 +511,48 @@ func file_api_testany_proto_rawDescGZIP() []byte {
 }
 
 var file_api_testany_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_testany_proto_goTypes = []any{
 	(SampleEnum)(0),               // 0: api.SampleEnum
 	(*TestRecord1)(nil),           // 1: api.TestRecord1
 	(*MapValueMessage)(nil),       // 2: api.MapValueMessage
 	(*TestRecord2)(nil),           // 3: api.TestRecord2
 	(*TestRecord3)(nil),           // 4: api.TestRecord3
-	nil,                           // 5: api.TestRecord1.MapStringToEnumEntry
-	nil,                           // 6: api.TestRecord2.Int32ToMessageEntry
-	nil,                           // 7: api.TestRecord2.Int64ToMessageEntry
-	nil,                           // 8: api.TestRecord2.Uint32ToMessageEntry
-	nil,                           // 9: api.TestRecord2.BoolToMessageEntry
-	nil,                           // 10: api.TestRecord3.CountsByTypeEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*anypb.Any)(nil),             // 12: google.protobuf.Any
+	(*TestRecord4)(nil),           // 5: api.TestRecord4
+	nil,                           // 6: api.TestRecord1.MapStringToEnumEntry
+	nil,                           // 7: api.TestRecord2.Int32ToMessageEntry
+	nil,                           // 8: api.TestRecord2.Int64ToMessageEntry
+	nil,                           // 9: api.TestRecord2.Uint32ToMessageEntry
+	nil,                           // 10: api.TestRecord2.BoolToMessageEntry
+	nil,                           // 11: api.TestRecord3.CountsByTypeEntry
+	nil,                           // 12: api.TestRecord4.DeadlinesEntry
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 14: google.protobuf.Any
 }
 var file_api_testany_proto_depIdxs = []int32{
-	11, // 0: api.TestRecord1.time_field:type_name -> google.protobuf.Timestamp
-	12, // 1: api.TestRecord1.extra_data:type_name -> google.protobuf.Any
+	13, // 0: api.TestRecord1.time_field:type_name -> google.protobuf.Timestamp
+	14, // 1: api.TestRecord1.extra_data:type_name -> google.protobuf.Any
 	0,  // 2: api.TestRecord1.an_enum:type_name -> api.SampleEnum
 	0,  // 3: api.TestRecord1.list_of_enums:type_name -> api.SampleEnum
-	5,  // 4: api.TestRecord1.map_string_to_enum:type_name -> api.TestRecord1.MapStringToEnumEntry
-	6,  // 5: api.TestRecord2.int32_to_message:type_name -> api.TestRecord2.Int32ToMessageEntry
-	7,  // 6: api.TestRecord2.int64_to_message:type_name -> api.TestRecord2.Int64ToMessageEntry
-	8,  // 7: api.TestRecord2.uint32_to_message:type_name -> api.TestRecord2.Uint32ToMessageEntry
-	9,  // 8: api.TestRecord2.bool_to_message:type_name -> api.TestRecord2.BoolToMessageEntry
-	10, // 9: api.TestRecord3.counts_by_type:type_name -> api.TestRecord3.CountsByTypeEntry
-	0,  // 10: api.TestRecord1.MapStringToEnumEntry.value:type_name -> api.SampleEnum
-	2,  // 11: api.TestRecord2.Int32ToMessageEntry.value:type_name -> api.MapValueMessage
-	2,  // 12: api.TestRecord2.Int64ToMessageEntry.value:type_name -> api.MapValueMessage
-	2,  // 13: api.TestRecord2.Uint32ToMessageEntry.value:type_name -> api.MapValueMessage
-	2,  // 14: api.TestRecord2.BoolToMessageEntry.value:type_name -> api.MapValueMessage
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	6,  // 4: api.TestRecord1.map_string_to_enum:type_name -> api.TestRecord1.MapStringToEnumEntry
+	7,  // 5: api.TestRecord2.int32_to_message:type_name -> api.TestRecord2.Int32ToMessageEntry
+	8,  // 6: api.TestRecord2.int64_to_message:type_name -> api.TestRecord2.Int64ToMessageEntry
+	9,  // 7: api.TestRecord2.uint32_to_message:type_name -> api.TestRecord2.Uint32ToMessageEntry
+	10, // 8: api.TestRecord2.bool_to_message:type_name -> api.TestRecord2.BoolToMessageEntry
+	11, // 9: api.TestRecord3.counts_by_type:type_name -> api.TestRecord3.CountsByTypeEntry
+	13, // 10: api.TestRecord4.seen_at:type_name -> google.protobuf.Timestamp
+	12, // 11: api.TestRecord4.deadlines:type_name -> api.TestRecord4.DeadlinesEntry
+	0,  // 12: api.TestRecord1.MapStringToEnumEntry.value:type_name -> api.SampleEnum
+	2,  // 13: api.TestRecord2.Int32ToMessageEntry.value:type_name -> api.MapValueMessage
+	2,  // 14: api.TestRecord2.Int64ToMessageEntry.value:type_name -> api.MapValueMessage
+	2,  // 15: api.TestRecord2.Uint32ToMessageEntry.value:type_name -> api.MapValueMessage
+	2,  // 16: api.TestRecord2.BoolToMessageEntry.value:type_name -> api.MapValueMessage
+	13, // 17: api.TestRecord4.DeadlinesEntry.value:type_name -> google.protobuf.Timestamp
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_testany_proto_init() }
@@ -482,7 +566,7 @@ func file_api_testany_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_testany_proto_rawDesc), len(file_api_testany_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
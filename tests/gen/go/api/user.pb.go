@@ -461,6 +461,100 @@ func (x *Organization) GetDepartments() map[string]*Author {
 	return nil
 }
 
+// Note demonstrates audit fields filled by the DAL and exposed to the API
+type Note struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Web-safe encoded Datastore key of notes stored in Datastore
+	Key           string `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_api_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_api_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Note) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Note) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Note) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Note) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Note) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Note) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Note) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 var File_api_user_proto protoreflect.FileDescriptor
 
 const file_api_user_proto_rawDesc = "" +
@@ -508,7 +602,19 @@ const file_api_user_proto_rawDesc = "" +
 	"\vdepartments\x18\x03 \x03(\v2\".api.Organization.DepartmentsEntryR\vdepartments\x1aK\n" +
 	"\x10DepartmentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
-	"\x05value\x18\x02 \x01(\v2\v.api.AuthorR\x05value:\x028\x01Bs\n" +
+	"\x05value\x18\x02 \x01(\v2\v.api.AuthorR\x05value:\x028\x01\"\xf0\x01\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x10\n" +
+	"\x03key\x18\a \x01(\tR\x03keyBs\n" +
 	"\acom.apiB\tUserProtoP\x01Z1github.com/panyam/protoc-gen-dal/tests/gen/go/api\xa2\x02\x03AXX\xaa\x02\x03Api\xca\x02\x03Api\xe2\x02\x0fApi\\GPBMetadata\xea\x02\x03Apib\x06proto3"
 
 var (
@@ -523,7 +629,7 @@ func file_api_user_proto_rawDescGZIP() []byte {
 	return file_api_user_proto_rawDescData
 }
 
-var file_api_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_user_proto_goTypes = []any{
 	(*User)(nil),                  // 0: api.User
 	(*Author)(nil),                // 1: api.Author
@@ -531,25 +637,28 @@ var file_api_user_proto_goTypes = []any{
 	(*Product)(nil),               // 3: api.Product
 	(*Library)(nil),               // 4: api.Library
 	(*Organization)(nil),          // 5: api.Organization
-	nil,                           // 6: api.Product.MetadataEntry
-	nil,                           // 7: api.Organization.DepartmentsEntry
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*Note)(nil),                  // 6: api.Note
+	nil,                           // 7: api.Product.MetadataEntry
+	nil,                           // 8: api.Organization.DepartmentsEntry
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_api_user_proto_depIdxs = []int32{
-	8, // 0: api.User.birthday:type_name -> google.protobuf.Timestamp
-	8, // 1: api.User.activated_at:type_name -> google.protobuf.Timestamp
-	8, // 2: api.User.created_at:type_name -> google.protobuf.Timestamp
-	8, // 3: api.User.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 4: api.Blog.author:type_name -> api.Author
-	6, // 5: api.Product.metadata:type_name -> api.Product.MetadataEntry
-	1, // 6: api.Library.contributors:type_name -> api.Author
-	7, // 7: api.Organization.departments:type_name -> api.Organization.DepartmentsEntry
-	1, // 8: api.Organization.DepartmentsEntry.value:type_name -> api.Author
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	9,  // 0: api.User.birthday:type_name -> google.protobuf.Timestamp
+	9,  // 1: api.User.activated_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: api.User.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: api.User.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: api.Blog.author:type_name -> api.Author
+	7,  // 5: api.Product.metadata:type_name -> api.Product.MetadataEntry
+	1,  // 6: api.Library.contributors:type_name -> api.Author
+	8,  // 7: api.Organization.departments:type_name -> api.Organization.DepartmentsEntry
+	9,  // 8: api.Note.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 9: api.Note.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: api.Organization.DepartmentsEntry.value:type_name -> api.Author
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_user_proto_init() }
@@ -563,7 +672,7 @@ func file_api_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_user_proto_rawDesc), len(file_api_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,7 +15,7 @@ import (
 	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/dal/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -273,6 +273,77 @@ func (x *TestRecord3Datastore) GetCountsByType() map[string]int64 {
 	return nil
 }
 
+// TestRecord4Datastore tests element-wise conversion of repeated and map fields.
+// The map of Timestamps becomes map[string]time.Time, serialized as JSON by the
+// generated PropertyLoadSaver.
+type TestRecord4Datastore struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Id            string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeenAt        []*timestamppb.Timestamp          `protobuf:"bytes,2,rep,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+	MemberIds     []string                          `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Deadlines     map[string]*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=deadlines,proto3" json:"deadlines,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestRecord4Datastore) Reset() {
+	*x = TestRecord4Datastore{}
+	mi := &file_datastore_testany_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRecord4Datastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRecord4Datastore) ProtoMessage() {}
+
+func (x *TestRecord4Datastore) ProtoReflect() protoreflect.Message {
+	mi := &file_datastore_testany_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRecord4Datastore.ProtoReflect.Descriptor instead.
+func (*TestRecord4Datastore) Descriptor() ([]byte, []int) {
+	return file_datastore_testany_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TestRecord4Datastore) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestRecord4Datastore) GetSeenAt() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
+func (x *TestRecord4Datastore) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *TestRecord4Datastore) GetDeadlines() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadlines
+	}
+	return nil
+}
+
 var File_datastore_testany_proto protoreflect.FileDescriptor
 
 const file_datastore_testany_proto_rawDesc = "" +
@@ -312,7 +383,17 @@ const file_datastore_testany_proto_rawDesc = "" +
 	"\x11CountsByTypeEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01:&Ҧ\x1d\"\n" +
-	"\rtest_records3*\x0fapi.TestRecord38\x01B\x9a\x01\n" +
+	"\rtest_records3*\x0fapi.TestRecord38\x01\"\xef\x02\n" +
+	"\x14TestRecord4Datastore\x12\"\n" +
+	"\x02id\x18\x01 \x01(\tB\x12\x92\xa6\x1d\x0e\x92\x01\bRecordID\x98\x01\x02R\x02id\x123\n" +
+	"\aseen_at\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x06seenAt\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\tR\tmemberIds\x12[\n" +
+	"\tdeadlines\x18\x04 \x03(\v2..datastore.TestRecord4Datastore.DeadlinesEntryB\r\x92\xa6\x1d\tr\anoindexR\tdeadlines\x1aX\n" +
+	"\x0eDeadlinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01:(Ҧ\x1d$\n" +
+	"\rtest_records4*\x0fapi.TestRecord48\x01X\x01B\x9a\x01\n" +
 	"\rcom.datastoreB\fTestanyProtoP\x01Z7github.com/panyam/protoc-gen-dal/tests/gen/go/datastore\xa2\x02\x03DXX\xaa\x02\tDatastore\xca\x02\tDatastore\xe2\x02\x15Datastore\\GPBMetadata\xea\x02\tDatastoreb\x06proto3"
 
 var (
@@ -327,33 +408,39 @@ func file_datastore_testany_proto_rawDescGZIP() []byte {
 	return file_datastore_testany_proto_rawDescData
 }
 
-var file_datastore_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_datastore_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_datastore_testany_proto_goTypes = []any{
 	(*TestRecord1Datastore)(nil),     // 0: datastore.TestRecord1Datastore
 	(*MapValueMessageDatastore)(nil), // 1: datastore.MapValueMessageDatastore
 	(*TestRecord2Datastore)(nil),     // 2: datastore.TestRecord2Datastore
 	(*TestRecord3Datastore)(nil),     // 3: datastore.TestRecord3Datastore
-	nil,                              // 4: datastore.TestRecord2Datastore.Int32ToMessageEntry
-	nil,                              // 5: datastore.TestRecord2Datastore.Int64ToMessageEntry
-	nil,                              // 6: datastore.TestRecord2Datastore.Uint32ToMessageEntry
-	nil,                              // 7: datastore.TestRecord2Datastore.BoolToMessageEntry
-	nil,                              // 8: datastore.TestRecord3Datastore.CountsByTypeEntry
+	(*TestRecord4Datastore)(nil),     // 4: datastore.TestRecord4Datastore
+	nil,                              // 5: datastore.TestRecord2Datastore.Int32ToMessageEntry
+	nil,                              // 6: datastore.TestRecord2Datastore.Int64ToMessageEntry
+	nil,                              // 7: datastore.TestRecord2Datastore.Uint32ToMessageEntry
+	nil,                              // 8: datastore.TestRecord2Datastore.BoolToMessageEntry
+	nil,                              // 9: datastore.TestRecord3Datastore.CountsByTypeEntry
+	nil,                              // 10: datastore.TestRecord4Datastore.DeadlinesEntry
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
 }
 var file_datastore_testany_proto_depIdxs = []int32{
-	4, // 0: datastore.TestRecord2Datastore.int32_to_message:type_name -> datastore.TestRecord2Datastore.Int32ToMessageEntry
-	5, // 1: datastore.TestRecord2Datastore.int64_to_message:type_name -> datastore.TestRecord2Datastore.Int64ToMessageEntry
-	6, // 2: datastore.TestRecord2Datastore.uint32_to_message:type_name -> datastore.TestRecord2Datastore.Uint32ToMessageEntry
-	7, // 3: datastore.TestRecord2Datastore.bool_to_message:type_name -> datastore.TestRecord2Datastore.BoolToMessageEntry
-	8, // 4: datastore.TestRecord3Datastore.counts_by_type:type_name -> datastore.TestRecord3Datastore.CountsByTypeEntry
-	1, // 5: datastore.TestRecord2Datastore.Int32ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
-	1, // 6: datastore.TestRecord2Datastore.Int64ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
-	1, // 7: datastore.TestRecord2Datastore.Uint32ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
-	1, // 8: datastore.TestRecord2Datastore.BoolToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	5,  // 0: datastore.TestRecord2Datastore.int32_to_message:type_name -> datastore.TestRecord2Datastore.Int32ToMessageEntry
+	6,  // 1: datastore.TestRecord2Datastore.int64_to_message:type_name -> datastore.TestRecord2Datastore.Int64ToMessageEntry
+	7,  // 2: datastore.TestRecord2Datastore.uint32_to_message:type_name -> datastore.TestRecord2Datastore.Uint32ToMessageEntry
+	8,  // 3: datastore.TestRecord2Datastore.bool_to_message:type_name -> datastore.TestRecord2Datastore.BoolToMessageEntry
+	9,  // 4: datastore.TestRecord3Datastore.counts_by_type:type_name -> datastore.TestRecord3Datastore.CountsByTypeEntry
+	11, // 5: datastore.TestRecord4Datastore.seen_at:type_name -> google.protobuf.Timestamp
+	10, // 6: datastore.TestRecord4Datastore.deadlines:type_name -> datastore.TestRecord4Datastore.DeadlinesEntry
+	1,  // 7: datastore.TestRecord2Datastore.Int32ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
+	1,  // 8: datastore.TestRecord2Datastore.Int64ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
+	1,  // 9: datastore.TestRecord2Datastore.Uint32ToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
+	1,  // 10: datastore.TestRecord2Datastore.BoolToMessageEntry.value:type_name -> datastore.MapValueMessageDatastore
+	11, // 11: datastore.TestRecord4Datastore.DeadlinesEntry.value:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_datastore_testany_proto_init() }
@@ -367,7 +454,7 @@ func file_datastore_testany_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_datastore_testany_proto_rawDesc), len(file_datastore_testany_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
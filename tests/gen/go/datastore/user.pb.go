@@ -11,7 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
-	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/dal/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -184,6 +184,123 @@ func (x *UserWithNamespace) GetEmail() string {
 	return ""
 }
 
+// UserPerTenant demonstrates tenant namespaces: every DAL operation runs in
+// the namespace of the tenant in the context
+type UserPerTenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPerTenant) Reset() {
+	*x = UserPerTenant{}
+	mi := &file_datastore_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPerTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPerTenant) ProtoMessage() {}
+
+func (x *UserPerTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_datastore_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPerTenant.ProtoReflect.Descriptor instead.
+func (*UserPerTenant) Descriptor() ([]byte, []int) {
+	return file_datastore_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserPerTenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserPerTenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPerTenant) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// NoteDatastore demonstrates audit properties filled by the DAL on Put, and
+// numeric keys: the id is the key's numeric ID, filled by LoadKey, and the API
+// exposes the encoded key
+type NoteDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteDatastore) Reset() {
+	*x = NoteDatastore{}
+	mi := &file_datastore_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteDatastore) ProtoMessage() {}
+
+func (x *NoteDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_datastore_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteDatastore.ProtoReflect.Descriptor instead.
+func (*NoteDatastore) Descriptor() ([]byte, []int) {
+	return file_datastore_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NoteDatastore) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NoteDatastore) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 // UserWithLargeText demonstrates noindex for large text fields
 type UserWithLargeText struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -197,7 +314,7 @@ type UserWithLargeText struct {
 
 func (x *UserWithLargeText) Reset() {
 	*x = UserWithLargeText{}
-	mi := &file_datastore_user_proto_msgTypes[2]
+	mi := &file_datastore_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +326,7 @@ func (x *UserWithLargeText) String() string {
 func (*UserWithLargeText) ProtoMessage() {}
 
 func (x *UserWithLargeText) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[2]
+	mi := &file_datastore_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +339,7 @@ func (x *UserWithLargeText) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserWithLargeText.ProtoReflect.Descriptor instead.
 func (*UserWithLargeText) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{2}
+	return file_datastore_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UserWithLargeText) GetId() string {
@@ -257,7 +374,7 @@ type UserSimple struct {
 
 func (x *UserSimple) Reset() {
 	*x = UserSimple{}
-	mi := &file_datastore_user_proto_msgTypes[3]
+	mi := &file_datastore_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +386,7 @@ func (x *UserSimple) String() string {
 func (*UserSimple) ProtoMessage() {}
 
 func (x *UserSimple) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[3]
+	mi := &file_datastore_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +399,7 @@ func (x *UserSimple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSimple.ProtoReflect.Descriptor instead.
 func (*UserSimple) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{3}
+	return file_datastore_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserSimple) GetId() string {
@@ -310,7 +427,7 @@ type AuthorDatastore struct {
 
 func (x *AuthorDatastore) Reset() {
 	*x = AuthorDatastore{}
-	mi := &file_datastore_user_proto_msgTypes[4]
+	mi := &file_datastore_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +439,7 @@ func (x *AuthorDatastore) String() string {
 func (*AuthorDatastore) ProtoMessage() {}
 
 func (x *AuthorDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[4]
+	mi := &file_datastore_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +452,7 @@ func (x *AuthorDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorDatastore.ProtoReflect.Descriptor instead.
 func (*AuthorDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{4}
+	return file_datastore_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AuthorDatastore) GetName() string {
@@ -352,6 +469,98 @@ func (x *AuthorDatastore) GetEmail() string {
 	return ""
 }
 
+// BlogDatastore demonstrates flattening a nested message into indexable
+// properties (author.name, author.email)
+type BlogDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *AuthorDatastore       `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogDatastore) Reset() {
+	*x = BlogDatastore{}
+	mi := &file_datastore_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogDatastore) ProtoMessage() {}
+
+func (x *BlogDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_datastore_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogDatastore.ProtoReflect.Descriptor instead.
+func (*BlogDatastore) Descriptor() ([]byte, []int) {
+	return file_datastore_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlogDatastore) GetAuthor() *AuthorDatastore {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// BlogJsonDatastore demonstrates storing a nested message as protojson text
+// (a noindex property)
+type BlogJsonDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *api.Author            `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogJsonDatastore) Reset() {
+	*x = BlogJsonDatastore{}
+	mi := &file_datastore_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogJsonDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogJsonDatastore) ProtoMessage() {}
+
+func (x *BlogJsonDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_datastore_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogJsonDatastore.ProtoReflect.Descriptor instead.
+func (*BlogJsonDatastore) Descriptor() ([]byte, []int) {
+	return file_datastore_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlogJsonDatastore) GetAuthor() *api.Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
 // ProductDatastore demonstrates repeated and map fields with DAL generation
 // Datastore natively supports repeated scalar values as array properties
 type ProductDatastore struct {
@@ -372,7 +581,7 @@ type ProductDatastore struct {
 
 func (x *ProductDatastore) Reset() {
 	*x = ProductDatastore{}
-	mi := &file_datastore_user_proto_msgTypes[5]
+	mi := &file_datastore_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +593,7 @@ func (x *ProductDatastore) String() string {
 func (*ProductDatastore) ProtoMessage() {}
 
 func (x *ProductDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[5]
+	mi := &file_datastore_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +606,7 @@ func (x *ProductDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductDatastore.ProtoReflect.Descriptor instead.
 func (*ProductDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{5}
+	return file_datastore_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProductDatastore) GetId() string {
@@ -456,7 +665,7 @@ type LibraryDatastore struct {
 
 func (x *LibraryDatastore) Reset() {
 	*x = LibraryDatastore{}
-	mi := &file_datastore_user_proto_msgTypes[6]
+	mi := &file_datastore_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +677,7 @@ func (x *LibraryDatastore) String() string {
 func (*LibraryDatastore) ProtoMessage() {}
 
 func (x *LibraryDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[6]
+	mi := &file_datastore_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +690,7 @@ func (x *LibraryDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LibraryDatastore.ProtoReflect.Descriptor instead.
 func (*LibraryDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{6}
+	return file_datastore_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LibraryDatastore) GetId() string {
@@ -518,7 +727,7 @@ type OrganizationDatastore struct {
 
 func (x *OrganizationDatastore) Reset() {
 	*x = OrganizationDatastore{}
-	mi := &file_datastore_user_proto_msgTypes[7]
+	mi := &file_datastore_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +739,7 @@ func (x *OrganizationDatastore) String() string {
 func (*OrganizationDatastore) ProtoMessage() {}
 
 func (x *OrganizationDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_user_proto_msgTypes[7]
+	mi := &file_datastore_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +752,7 @@ func (x *OrganizationDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationDatastore.ProtoReflect.Descriptor instead.
 func (*OrganizationDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_user_proto_rawDescGZIP(), []int{7}
+	return file_datastore_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OrganizationDatastore) GetId() string {
@@ -571,7 +780,7 @@ var File_datastore_user_proto protoreflect.FileDescriptor
 
 const file_datastore_user_proto_rawDesc = "" +
 	"\n" +
-	"\x14datastore/user.proto\x12\tdatastore\x1a\x18dal/v1/annotations.proto\x1a\x0eapi/user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x02\n" +
+	"\x14datastore/user.proto\x12\tdatastore\x1a\x18dal/v1/annotations.proto\x1a\x0eapi/user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x02\n" +
 	"\rUserDatastore\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\x92\xa6\x1d\x03r\x01-R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -581,14 +790,28 @@ const file_datastore_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:\x16Ҧ\x1d\x12\n" +
-	"\x04User*\bapi.User0\x01\"o\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:\x18Ҧ\x1d\x14\n" +
+	"\x04User*\bapi.User0\x01P\x01\"o\n" +
 	"\x11UserWithNamespace\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email: Ҧ\x1d\x1c\n" +
 	"\x04User\x12\n" +
-	"production*\bapi.User\"\x82\x01\n" +
+	"production*\bapi.User\"c\n" +
+	"\rUserPerTenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email:\x18Ҧ\x1d\x14\n" +
+	"\x04User*\bapi.User0\x01@\x01\"\x8f\x01\n" +
+	"\rNoteDatastore\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\x92\xa6\x1d\x03r\x01-R\x02id\x12\x19\n" +
+	"\x03key\x18\a \x01(\tB\a\x92\xa6\x1d\x03\xa0\x01\x01R\x03key:JҦ\x1dF\n" +
+	"\x04Note*\bapi.Note0\x01J0\n" +
+	"\n" +
+	"created_by\x12\n" +
+	"updated_by\x1a\n" +
+	"created_at\"\n" +
+	"updated_atX\x01\"\x82\x01\n" +
 	"\x11UserWithLargeText\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\x92\xa6\x1d\x03r\x01-R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -603,7 +826,13 @@ const file_datastore_user_proto_rawDesc = "" +
 	"\x0fAuthorDatastore\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email:\x10Ҧ\x1d\f*\n" +
-	"api.Author\"\xc9\x02\n" +
+	"api.Author\"a\n" +
+	"\rBlogDatastore\x12:\n" +
+	"\x06author\x18\x02 \x01(\v2\x1a.datastore.AuthorDatastoreB\x06\x92\xa6\x1d\x02z\x00R\x06author:\x14Ҧ\x1d\x10\n" +
+	"\x04Blog*\bapi.Blog\"[\n" +
+	"\x11BlogJsonDatastore\x12,\n" +
+	"\x06author\x18\x02 \x01(\v2\v.api.AuthorB\a\x92\xa6\x1d\x03\x80\x01\x02R\x06author:\x18Ҧ\x1d\x14\n" +
+	"\bBlogJson*\bapi.Blog\"\xc9\x02\n" +
 	"\x10ProductDatastore\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\x92\xa6\x1d\x03r\x01-R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -644,33 +873,40 @@ func file_datastore_user_proto_rawDescGZIP() []byte {
 	return file_datastore_user_proto_rawDescData
 }
 
-var file_datastore_user_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_datastore_user_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_datastore_user_proto_goTypes = []any{
 	(*UserDatastore)(nil),         // 0: datastore.UserDatastore
 	(*UserWithNamespace)(nil),     // 1: datastore.UserWithNamespace
-	(*UserWithLargeText)(nil),     // 2: datastore.UserWithLargeText
-	(*UserSimple)(nil),            // 3: datastore.UserSimple
-	(*AuthorDatastore)(nil),       // 4: datastore.AuthorDatastore
-	(*ProductDatastore)(nil),      // 5: datastore.ProductDatastore
-	(*LibraryDatastore)(nil),      // 6: datastore.LibraryDatastore
-	(*OrganizationDatastore)(nil), // 7: datastore.OrganizationDatastore
-	nil,                           // 8: datastore.ProductDatastore.MetadataEntry
-	nil,                           // 9: datastore.OrganizationDatastore.DepartmentsEntry
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*UserPerTenant)(nil),         // 2: datastore.UserPerTenant
+	(*NoteDatastore)(nil),         // 3: datastore.NoteDatastore
+	(*UserWithLargeText)(nil),     // 4: datastore.UserWithLargeText
+	(*UserSimple)(nil),            // 5: datastore.UserSimple
+	(*AuthorDatastore)(nil),       // 6: datastore.AuthorDatastore
+	(*BlogDatastore)(nil),         // 7: datastore.BlogDatastore
+	(*BlogJsonDatastore)(nil),     // 8: datastore.BlogJsonDatastore
+	(*ProductDatastore)(nil),      // 9: datastore.ProductDatastore
+	(*LibraryDatastore)(nil),      // 10: datastore.LibraryDatastore
+	(*OrganizationDatastore)(nil), // 11: datastore.OrganizationDatastore
+	nil,                           // 12: datastore.ProductDatastore.MetadataEntry
+	nil,                           // 13: datastore.OrganizationDatastore.DepartmentsEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*api.Author)(nil),            // 15: api.Author
 }
 var file_datastore_user_proto_depIdxs = []int32{
-	10, // 0: datastore.UserDatastore.birthday:type_name -> google.protobuf.Timestamp
-	10, // 1: datastore.UserDatastore.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: datastore.UserDatastore.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: datastore.ProductDatastore.metadata:type_name -> datastore.ProductDatastore.MetadataEntry
-	4,  // 4: datastore.LibraryDatastore.contributors:type_name -> datastore.AuthorDatastore
-	9,  // 5: datastore.OrganizationDatastore.departments:type_name -> datastore.OrganizationDatastore.DepartmentsEntry
-	4,  // 6: datastore.OrganizationDatastore.DepartmentsEntry.value:type_name -> datastore.AuthorDatastore
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 0: datastore.UserDatastore.birthday:type_name -> google.protobuf.Timestamp
+	14, // 1: datastore.UserDatastore.created_at:type_name -> google.protobuf.Timestamp
+	14, // 2: datastore.UserDatastore.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: datastore.BlogDatastore.author:type_name -> datastore.AuthorDatastore
+	15, // 4: datastore.BlogJsonDatastore.author:type_name -> api.Author
+	12, // 5: datastore.ProductDatastore.metadata:type_name -> datastore.ProductDatastore.MetadataEntry
+	6,  // 6: datastore.LibraryDatastore.contributors:type_name -> datastore.AuthorDatastore
+	13, // 7: datastore.OrganizationDatastore.departments:type_name -> datastore.OrganizationDatastore.DepartmentsEntry
+	6,  // 8: datastore.OrganizationDatastore.DepartmentsEntry.value:type_name -> datastore.AuthorDatastore
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_datastore_user_proto_init() }
@@ -684,7 +920,7 @@ func file_datastore_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_datastore_user_proto_rawDesc), len(file_datastore_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
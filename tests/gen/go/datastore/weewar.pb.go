@@ -25,150 +25,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type IndexInfoDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *IndexInfoDatastore) Reset() {
-	*x = IndexInfoDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *IndexInfoDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*IndexInfoDatastore) ProtoMessage() {}
-
-func (x *IndexInfoDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use IndexInfoDatastore.ProtoReflect.Descriptor instead.
-func (*IndexInfoDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{0}
-}
-
-type TileDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TileDatastore) Reset() {
-	*x = TileDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TileDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TileDatastore) ProtoMessage() {}
-
-func (x *TileDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TileDatastore.ProtoReflect.Descriptor instead.
-func (*TileDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{1}
-}
-
-type UnitDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnitDatastore) Reset() {
-	*x = UnitDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnitDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnitDatastore) ProtoMessage() {}
-
-func (x *UnitDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnitDatastore.ProtoReflect.Descriptor instead.
-func (*UnitDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{2}
-}
-
-type AttackRecordDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AttackRecordDatastore) Reset() {
-	*x = AttackRecordDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AttackRecordDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AttackRecordDatastore) ProtoMessage() {}
-
-func (x *AttackRecordDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AttackRecordDatastore.ProtoReflect.Descriptor instead.
-func (*AttackRecordDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{3}
-}
-
 type WorldDatastore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -177,7 +33,7 @@ type WorldDatastore struct {
 
 func (x *WorldDatastore) Reset() {
 	*x = WorldDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[4]
+	mi := &file_datastore_weewar_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +45,7 @@ func (x *WorldDatastore) String() string {
 func (*WorldDatastore) ProtoMessage() {}
 
 func (x *WorldDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[4]
+	mi := &file_datastore_weewar_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +58,7 @@ func (x *WorldDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldDatastore.ProtoReflect.Descriptor instead.
 func (*WorldDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{4}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{0}
 }
 
 type WorldDataDatastore struct {
@@ -213,7 +69,7 @@ type WorldDataDatastore struct {
 
 func (x *WorldDataDatastore) Reset() {
 	*x = WorldDataDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[5]
+	mi := &file_datastore_weewar_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +81,7 @@ func (x *WorldDataDatastore) String() string {
 func (*WorldDataDatastore) ProtoMessage() {}
 
 func (x *WorldDataDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[5]
+	mi := &file_datastore_weewar_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +94,7 @@ func (x *WorldDataDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldDataDatastore.ProtoReflect.Descriptor instead.
 func (*WorldDataDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{5}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{1}
 }
 
 // Describes a game and its metadata
@@ -250,7 +106,7 @@ type GameDatastore struct {
 
 func (x *GameDatastore) Reset() {
 	*x = GameDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[6]
+	mi := &file_datastore_weewar_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -262,7 +118,7 @@ func (x *GameDatastore) String() string {
 func (*GameDatastore) ProtoMessage() {}
 
 func (x *GameDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[6]
+	mi := &file_datastore_weewar_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,187 +131,7 @@ func (x *GameDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameDatastore.ProtoReflect.Descriptor instead.
 func (*GameDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{6}
-}
-
-type GameConfigurationDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GameConfigurationDatastore) Reset() {
-	*x = GameConfigurationDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GameConfigurationDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameConfigurationDatastore) ProtoMessage() {}
-
-func (x *GameConfigurationDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GameConfigurationDatastore.ProtoReflect.Descriptor instead.
-func (*GameConfigurationDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{7}
-}
-
-type IncomeConfigDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *IncomeConfigDatastore) Reset() {
-	*x = IncomeConfigDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *IncomeConfigDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*IncomeConfigDatastore) ProtoMessage() {}
-
-func (x *IncomeConfigDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use IncomeConfigDatastore.ProtoReflect.Descriptor instead.
-func (*IncomeConfigDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{8}
-}
-
-type GamePlayerDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GamePlayerDatastore) Reset() {
-	*x = GamePlayerDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GamePlayerDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GamePlayerDatastore) ProtoMessage() {}
-
-func (x *GamePlayerDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GamePlayerDatastore.ProtoReflect.Descriptor instead.
-func (*GamePlayerDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{9}
-}
-
-type GameTeamDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GameTeamDatastore) Reset() {
-	*x = GameTeamDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GameTeamDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameTeamDatastore) ProtoMessage() {}
-
-func (x *GameTeamDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GameTeamDatastore.ProtoReflect.Descriptor instead.
-func (*GameTeamDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{10}
-}
-
-type GameSettingsDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GameSettingsDatastore) Reset() {
-	*x = GameSettingsDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GameSettingsDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameSettingsDatastore) ProtoMessage() {}
-
-func (x *GameSettingsDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GameSettingsDatastore.ProtoReflect.Descriptor instead.
-func (*GameSettingsDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{11}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{2}
 }
 
 // Holds the game's Active/Current state (eg world state)
@@ -467,7 +143,7 @@ type GameStateDatastore struct {
 
 func (x *GameStateDatastore) Reset() {
 	*x = GameStateDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[12]
+	mi := &file_datastore_weewar_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -479,7 +155,7 @@ func (x *GameStateDatastore) String() string {
 func (*GameStateDatastore) ProtoMessage() {}
 
 func (x *GameStateDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[12]
+	mi := &file_datastore_weewar_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +168,7 @@ func (x *GameStateDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateDatastore.ProtoReflect.Descriptor instead.
 func (*GameStateDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{12}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{3}
 }
 
 // Holds the game's move history (can be used as a replay log)
@@ -504,7 +180,7 @@ type GameMoveHistoryDatastore struct {
 
 func (x *GameMoveHistoryDatastore) Reset() {
 	*x = GameMoveHistoryDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[13]
+	mi := &file_datastore_weewar_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +192,7 @@ func (x *GameMoveHistoryDatastore) String() string {
 func (*GameMoveHistoryDatastore) ProtoMessage() {}
 
 func (x *GameMoveHistoryDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[13]
+	mi := &file_datastore_weewar_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,44 +205,7 @@ func (x *GameMoveHistoryDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveHistoryDatastore.ProtoReflect.Descriptor instead.
 func (*GameMoveHistoryDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{13}
-}
-
-// A move group - we can allow X moves in one "tick"
-type GameMoveGroupDatastore struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GameMoveGroupDatastore) Reset() {
-	*x = GameMoveGroupDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GameMoveGroupDatastore) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameMoveGroupDatastore) ProtoMessage() {}
-
-func (x *GameMoveGroupDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GameMoveGroupDatastore.ProtoReflect.Descriptor instead.
-func (*GameMoveGroupDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{14}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{4}
 }
 
 // A move group - we can allow X moves in one "tick"
@@ -579,7 +218,7 @@ type MoveUnitActionDatastore struct {
 
 func (x *MoveUnitActionDatastore) Reset() {
 	*x = MoveUnitActionDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[15]
+	mi := &file_datastore_weewar_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +230,7 @@ func (x *MoveUnitActionDatastore) String() string {
 func (*MoveUnitActionDatastore) ProtoMessage() {}
 
 func (x *MoveUnitActionDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[15]
+	mi := &file_datastore_weewar_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +243,7 @@ func (x *MoveUnitActionDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveUnitActionDatastore.ProtoReflect.Descriptor instead.
 func (*MoveUnitActionDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{15}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MoveUnitActionDatastore) GetReconstructedPath() *anypb.Any {
@@ -628,7 +267,7 @@ type GameMoveDatastore struct {
 
 func (x *GameMoveDatastore) Reset() {
 	*x = GameMoveDatastore{}
-	mi := &file_datastore_weewar_proto_msgTypes[16]
+	mi := &file_datastore_weewar_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +279,7 @@ func (x *GameMoveDatastore) String() string {
 func (*GameMoveDatastore) ProtoMessage() {}
 
 func (x *GameMoveDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_datastore_weewar_proto_msgTypes[16]
+	mi := &file_datastore_weewar_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +292,7 @@ func (x *GameMoveDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveDatastore.ProtoReflect.Descriptor instead.
 func (*GameMoveDatastore) Descriptor() ([]byte, []int) {
-	return file_datastore_weewar_proto_rawDescGZIP(), []int{16}
+	return file_datastore_weewar_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GameMoveDatastore) GetMoveType() *anypb.Any {
@@ -675,30 +314,20 @@ var File_datastore_weewar_proto protoreflect.FileDescriptor
 const file_datastore_weewar_proto_rawDesc = "" +
 	"\n" +
 	"\x16datastore/weewar.proto\x12\tdatastore\x1a\x18dal/v1/annotations.proto\x1a\x16weewar/v1/models.proto\x1a\x19google/protobuf/any.proto\"/\n" +
-	"\x12IndexInfoDatastore:\x19Ҧ\x1d\x15*\x13weewar.v1.IndexInfo\"%\n" +
-	"\rTileDatastore:\x14Ҧ\x1d\x10*\x0eweewar.v1.Tile\"%\n" +
-	"\rUnitDatastore:\x14Ҧ\x1d\x10*\x0eweewar.v1.Unit\"5\n" +
-	"\x15AttackRecordDatastore:\x1cҦ\x1d\x18*\x16weewar.v1.AttackRecord\"/\n" +
 	"\x0eWorldDatastore:\x1dҦ\x1d\x19\n" +
 	"\x06worlds*\x0fweewar.v1.World\";\n" +
 	"\x12WorldDataDatastore:%Ҧ\x1d!\n" +
 	"\n" +
 	"world_data*\x13weewar.v1.WorldData\",\n" +
 	"\rGameDatastore:\x1bҦ\x1d\x17\n" +
-	"\x05games*\x0eweewar.v1.Game\"?\n" +
-	"\x1aGameConfigurationDatastore:!Ҧ\x1d\x1d*\x1bweewar.v1.GameConfiguration\"5\n" +
-	"\x15IncomeConfigDatastore:\x1cҦ\x1d\x18*\x16weewar.v1.IncomeConfig\"1\n" +
-	"\x13GamePlayerDatastore:\x1aҦ\x1d\x16*\x14weewar.v1.GamePlayer\"-\n" +
-	"\x11GameTeamDatastore:\x18Ҧ\x1d\x14*\x12weewar.v1.GameTeam\"5\n" +
-	"\x15GameSettingsDatastore:\x1cҦ\x1d\x18*\x16weewar.v1.GameSettings\"/\n" +
+	"\x05games*\x0eweewar.v1.Game\"/\n" +
 	"\x12GameStateDatastore:\x19Ҧ\x1d\x15*\x13weewar.v1.GameState\";\n" +
-	"\x18GameMoveHistoryDatastore:\x1fҦ\x1d\x1b*\x19weewar.v1.GameMoveHistory\"7\n" +
-	"\x16GameMoveGroupDatastore:\x1dҦ\x1d\x19*\x17weewar.v1.GameMoveGroup\"~\n" +
+	"\x18GameMoveHistoryDatastore:\x1fҦ\x1d\x1b*\x19weewar.v1.GameMoveHistory\"~\n" +
 	"\x17MoveUnitActionDatastore\x12C\n" +
 	"\x12reconstructed_path\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\x11reconstructedPath:\x1eҦ\x1d\x1a*\x18weewar.v1.MoveUnitAction\"\x90\x01\n" +
 	"\x11GameMoveDatastore\x121\n" +
 	"\tmove_type\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\bmoveType\x12.\n" +
-	"\achanges\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\achanges:\x18Ҧ\x1d\x14*\x12weewar.v1.GameMoveB\x99\x01\n" +
+	"\achanges\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\achanges:\x18Ҧ\x1d\x14*\x12weewar.v1.GameMoveB\x9f\x01\xea\xa6\x1d\x02\b\x02\n" +
 	"\rcom.datastoreB\vWeewarProtoP\x01Z7github.com/panyam/protoc-gen-dal/tests/gen/go/datastore\xa2\x02\x03DXX\xaa\x02\tDatastore\xca\x02\tDatastore\xe2\x02\x15Datastore\\GPBMetadata\xea\x02\tDatastoreb\x06proto3"
 
 var (
@@ -713,36 +342,26 @@ func file_datastore_weewar_proto_rawDescGZIP() []byte {
 	return file_datastore_weewar_proto_rawDescData
 }
 
-var file_datastore_weewar_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_datastore_weewar_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_datastore_weewar_proto_goTypes = []any{
-	(*IndexInfoDatastore)(nil),         // 0: datastore.IndexInfoDatastore
-	(*TileDatastore)(nil),              // 1: datastore.TileDatastore
-	(*UnitDatastore)(nil),              // 2: datastore.UnitDatastore
-	(*AttackRecordDatastore)(nil),      // 3: datastore.AttackRecordDatastore
-	(*WorldDatastore)(nil),             // 4: datastore.WorldDatastore
-	(*WorldDataDatastore)(nil),         // 5: datastore.WorldDataDatastore
-	(*GameDatastore)(nil),              // 6: datastore.GameDatastore
-	(*GameConfigurationDatastore)(nil), // 7: datastore.GameConfigurationDatastore
-	(*IncomeConfigDatastore)(nil),      // 8: datastore.IncomeConfigDatastore
-	(*GamePlayerDatastore)(nil),        // 9: datastore.GamePlayerDatastore
-	(*GameTeamDatastore)(nil),          // 10: datastore.GameTeamDatastore
-	(*GameSettingsDatastore)(nil),      // 11: datastore.GameSettingsDatastore
-	(*GameStateDatastore)(nil),         // 12: datastore.GameStateDatastore
-	(*GameMoveHistoryDatastore)(nil),   // 13: datastore.GameMoveHistoryDatastore
-	(*GameMoveGroupDatastore)(nil),     // 14: datastore.GameMoveGroupDatastore
-	(*MoveUnitActionDatastore)(nil),    // 15: datastore.MoveUnitActionDatastore
-	(*GameMoveDatastore)(nil),          // 16: datastore.GameMoveDatastore
-	(*anypb.Any)(nil),                  // 17: google.protobuf.Any
+	(*WorldDatastore)(nil),           // 0: datastore.WorldDatastore
+	(*WorldDataDatastore)(nil),       // 1: datastore.WorldDataDatastore
+	(*GameDatastore)(nil),            // 2: datastore.GameDatastore
+	(*GameStateDatastore)(nil),       // 3: datastore.GameStateDatastore
+	(*GameMoveHistoryDatastore)(nil), // 4: datastore.GameMoveHistoryDatastore
+	(*MoveUnitActionDatastore)(nil),  // 5: datastore.MoveUnitActionDatastore
+	(*GameMoveDatastore)(nil),        // 6: datastore.GameMoveDatastore
+	(*anypb.Any)(nil),                // 7: google.protobuf.Any
 }
 var file_datastore_weewar_proto_depIdxs = []int32{
-	17, // 0: datastore.MoveUnitActionDatastore.reconstructed_path:type_name -> google.protobuf.Any
-	17, // 1: datastore.GameMoveDatastore.move_type:type_name -> google.protobuf.Any
-	17, // 2: datastore.GameMoveDatastore.changes:type_name -> google.protobuf.Any
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	7, // 0: datastore.MoveUnitActionDatastore.reconstructed_path:type_name -> google.protobuf.Any
+	7, // 1: datastore.GameMoveDatastore.move_type:type_name -> google.protobuf.Any
+	7, // 2: datastore.GameMoveDatastore.changes:type_name -> google.protobuf.Any
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_datastore_weewar_proto_init() }
@@ -756,7 +375,7 @@ func file_datastore_weewar_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_datastore_weewar_proto_rawDesc), len(file_datastore_weewar_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,6 +15,7 @@ import (
 	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/dal/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -207,11 +208,82 @@ func (x *TestRecord2Gorm) GetBoolToMessage() map[bool]*MapValueMessageGorm {
 	return nil
 }
 
+// TestRecord4Gorm tests element-wise conversion of repeated and map fields:
+// []time.Time from repeated Timestamp, uint32 IDs stored as strings and
+// Timestamp map values stored as Unix seconds.
+type TestRecord4Gorm struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeenAt        []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+	MemberIds     []string                 `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Deadlines     map[string]int64         `protobuf:"bytes,4,rep,name=deadlines,proto3" json:"deadlines,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestRecord4Gorm) Reset() {
+	*x = TestRecord4Gorm{}
+	mi := &file_gorm_testany_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRecord4Gorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRecord4Gorm) ProtoMessage() {}
+
+func (x *TestRecord4Gorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_testany_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRecord4Gorm.ProtoReflect.Descriptor instead.
+func (*TestRecord4Gorm) Descriptor() ([]byte, []int) {
+	return file_gorm_testany_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TestRecord4Gorm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestRecord4Gorm) GetSeenAt() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
+func (x *TestRecord4Gorm) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *TestRecord4Gorm) GetDeadlines() map[string]int64 {
+	if x != nil {
+		return x.Deadlines
+	}
+	return nil
+}
+
 var File_gorm_testany_proto protoreflect.FileDescriptor
 
 const file_gorm_testany_proto_rawDesc = "" +
 	"\n" +
-	"\x12gorm/testany.proto\x12\x04gorm\x1a\x18dal/v1/annotations.proto\x1a\x11api/testany.proto\"\xc7\x02\n" +
+	"\x12gorm/testany.proto\x12\x04gorm\x1a\x18dal/v1/annotations.proto\x1a\x11api/testany.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x02\n" +
 	"\x0fTestRecord1Gorm\x12J\n" +
 	"\rlist_of_enums\x18\x04 \x03(\x0e2\x0f.api.SampleEnumB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\vlistOfEnums\x12n\n" +
 	"\x12map_string_to_enum\x18\x05 \x03(\v2*.gorm.TestRecord1Gorm.MapStringToEnumEntryB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x0fmapStringToEnum\x1aS\n" +
@@ -240,7 +312,18 @@ const file_gorm_testany_proto_rawDesc = "" +
 	"\x12BoolToMessageEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\bR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.gorm.MapValueMessageGormR\x05value:\x028\x01:$ʦ\x1d \n" +
-	"\x0fapi.TestRecord2\x12\rtest_records2B|\n" +
+	"\x0fapi.TestRecord2\x12\rtest_records2\"\xf4\x02\n" +
+	"\x0fTestRecord4Gorm\x12 \n" +
+	"\x02id\x18\x01 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
+	"primaryKeyR\x02id\x12J\n" +
+	"\aseen_at\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x06seenAt\x124\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\tB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\tmemberIds\x12Y\n" +
+	"\tdeadlines\x18\x04 \x03(\v2$.gorm.TestRecord4Gorm.DeadlinesEntryB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\tdeadlines\x1a<\n" +
+	"\x0eDeadlinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01:$ʦ\x1d \n" +
+	"\x0fapi.TestRecord4\x12\rtest_records4B|\n" +
 	"\bcom.gormB\fTestanyProtoP\x01Z2github.com/panyam/protoc-gen-dal/tests/gen/go/gorm\xa2\x02\x03GXX\xaa\x02\x04Gorm\xca\x02\x04Gorm\xe2\x02\x10Gorm\\GPBMetadata\xea\x02\x04Gormb\x06proto3"
 
 var (
@@ -255,35 +338,40 @@ func file_gorm_testany_proto_rawDescGZIP() []byte {
 	return file_gorm_testany_proto_rawDescData
 }
 
-var file_gorm_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_gorm_testany_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_gorm_testany_proto_goTypes = []any{
-	(*TestRecord1Gorm)(nil),     // 0: gorm.TestRecord1Gorm
-	(*MapValueMessageGorm)(nil), // 1: gorm.MapValueMessageGorm
-	(*TestRecord2Gorm)(nil),     // 2: gorm.TestRecord2Gorm
-	nil,                         // 3: gorm.TestRecord1Gorm.MapStringToEnumEntry
-	nil,                         // 4: gorm.TestRecord2Gorm.Int32ToMessageEntry
-	nil,                         // 5: gorm.TestRecord2Gorm.Int64ToMessageEntry
-	nil,                         // 6: gorm.TestRecord2Gorm.Uint32ToMessageEntry
-	nil,                         // 7: gorm.TestRecord2Gorm.BoolToMessageEntry
-	(api.SampleEnum)(0),         // 8: api.SampleEnum
+	(*TestRecord1Gorm)(nil),       // 0: gorm.TestRecord1Gorm
+	(*MapValueMessageGorm)(nil),   // 1: gorm.MapValueMessageGorm
+	(*TestRecord2Gorm)(nil),       // 2: gorm.TestRecord2Gorm
+	(*TestRecord4Gorm)(nil),       // 3: gorm.TestRecord4Gorm
+	nil,                           // 4: gorm.TestRecord1Gorm.MapStringToEnumEntry
+	nil,                           // 5: gorm.TestRecord2Gorm.Int32ToMessageEntry
+	nil,                           // 6: gorm.TestRecord2Gorm.Int64ToMessageEntry
+	nil,                           // 7: gorm.TestRecord2Gorm.Uint32ToMessageEntry
+	nil,                           // 8: gorm.TestRecord2Gorm.BoolToMessageEntry
+	nil,                           // 9: gorm.TestRecord4Gorm.DeadlinesEntry
+	(api.SampleEnum)(0),           // 10: api.SampleEnum
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_gorm_testany_proto_depIdxs = []int32{
-	8,  // 0: gorm.TestRecord1Gorm.list_of_enums:type_name -> api.SampleEnum
-	3,  // 1: gorm.TestRecord1Gorm.map_string_to_enum:type_name -> gorm.TestRecord1Gorm.MapStringToEnumEntry
-	4,  // 2: gorm.TestRecord2Gorm.int32_to_message:type_name -> gorm.TestRecord2Gorm.Int32ToMessageEntry
-	5,  // 3: gorm.TestRecord2Gorm.int64_to_message:type_name -> gorm.TestRecord2Gorm.Int64ToMessageEntry
-	6,  // 4: gorm.TestRecord2Gorm.uint32_to_message:type_name -> gorm.TestRecord2Gorm.Uint32ToMessageEntry
-	7,  // 5: gorm.TestRecord2Gorm.bool_to_message:type_name -> gorm.TestRecord2Gorm.BoolToMessageEntry
-	8,  // 6: gorm.TestRecord1Gorm.MapStringToEnumEntry.value:type_name -> api.SampleEnum
-	1,  // 7: gorm.TestRecord2Gorm.Int32ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
-	1,  // 8: gorm.TestRecord2Gorm.Int64ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
-	1,  // 9: gorm.TestRecord2Gorm.Uint32ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
-	1,  // 10: gorm.TestRecord2Gorm.BoolToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 0: gorm.TestRecord1Gorm.list_of_enums:type_name -> api.SampleEnum
+	4,  // 1: gorm.TestRecord1Gorm.map_string_to_enum:type_name -> gorm.TestRecord1Gorm.MapStringToEnumEntry
+	5,  // 2: gorm.TestRecord2Gorm.int32_to_message:type_name -> gorm.TestRecord2Gorm.Int32ToMessageEntry
+	6,  // 3: gorm.TestRecord2Gorm.int64_to_message:type_name -> gorm.TestRecord2Gorm.Int64ToMessageEntry
+	7,  // 4: gorm.TestRecord2Gorm.uint32_to_message:type_name -> gorm.TestRecord2Gorm.Uint32ToMessageEntry
+	8,  // 5: gorm.TestRecord2Gorm.bool_to_message:type_name -> gorm.TestRecord2Gorm.BoolToMessageEntry
+	11, // 6: gorm.TestRecord4Gorm.seen_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: gorm.TestRecord4Gorm.deadlines:type_name -> gorm.TestRecord4Gorm.DeadlinesEntry
+	10, // 8: gorm.TestRecord1Gorm.MapStringToEnumEntry.value:type_name -> api.SampleEnum
+	1,  // 9: gorm.TestRecord2Gorm.Int32ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
+	1,  // 10: gorm.TestRecord2Gorm.Int64ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
+	1,  // 11: gorm.TestRecord2Gorm.Uint32ToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
+	1,  // 12: gorm.TestRecord2Gorm.BoolToMessageEntry.value:type_name -> gorm.MapValueMessageGorm
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_gorm_testany_proto_init() }
@@ -297,7 +385,7 @@ func file_gorm_testany_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gorm_testany_proto_rawDesc), len(file_gorm_testany_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,7 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
-	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	_ "github.com/panyam/protoc-gen-dal/tests/gen/go/dal/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -42,7 +42,7 @@ type UserGorm struct {
 	MemberNumber string `protobuf:"bytes,6,opt,name=member_number,json=memberNumber,proto3" json:"member_number,omitempty"`
 	// Nullable timestamp
 	ActivatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
-	// Auto-managed timestamps (GORM conventions)
+	// Audit timestamps (see audit above)
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Soft delete support (gorm.DeletedAt equivalent)
@@ -630,6 +630,114 @@ func (x *BlogGorm) GetTitle() string {
 	return ""
 }
 
+// BlogFlatGorm demonstrates flattening a nested message into prefixed
+// columns (by_name, by_email in DB)
+type BlogFlatGorm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author        *AuthorGorm            `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogFlatGorm) Reset() {
+	*x = BlogFlatGorm{}
+	mi := &file_gorm_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogFlatGorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogFlatGorm) ProtoMessage() {}
+
+func (x *BlogFlatGorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogFlatGorm.ProtoReflect.Descriptor instead.
+func (*BlogFlatGorm) Descriptor() ([]byte, []int) {
+	return file_gorm_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlogFlatGorm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlogFlatGorm) GetAuthor() *AuthorGorm {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// BlogBlobGorm demonstrates storing a nested message as proto.Marshal bytes
+// (no AuthorGorm conversion involved)
+type BlogBlobGorm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author        *api.Author            `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogBlobGorm) Reset() {
+	*x = BlogBlobGorm{}
+	mi := &file_gorm_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogBlobGorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogBlobGorm) ProtoMessage() {}
+
+func (x *BlogBlobGorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogBlobGorm.ProtoReflect.Descriptor instead.
+func (*BlogBlobGorm) Descriptor() ([]byte, []int) {
+	return file_gorm_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BlogBlobGorm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlogBlobGorm) GetAuthor() *api.Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
 // ProductGorm demonstrates repeated and map field storage strategies
 type ProductGorm struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -649,7 +757,7 @@ type ProductGorm struct {
 
 func (x *ProductGorm) Reset() {
 	*x = ProductGorm{}
-	mi := &file_gorm_user_proto_msgTypes[8]
+	mi := &file_gorm_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +769,7 @@ func (x *ProductGorm) String() string {
 func (*ProductGorm) ProtoMessage() {}
 
 func (x *ProductGorm) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_user_proto_msgTypes[8]
+	mi := &file_gorm_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +782,7 @@ func (x *ProductGorm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductGorm.ProtoReflect.Descriptor instead.
 func (*ProductGorm) Descriptor() ([]byte, []int) {
-	return file_gorm_user_proto_rawDescGZIP(), []int{8}
+	return file_gorm_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProductGorm) GetId() uint32 {
@@ -732,7 +840,7 @@ type LibraryGorm struct {
 
 func (x *LibraryGorm) Reset() {
 	*x = LibraryGorm{}
-	mi := &file_gorm_user_proto_msgTypes[9]
+	mi := &file_gorm_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +852,7 @@ func (x *LibraryGorm) String() string {
 func (*LibraryGorm) ProtoMessage() {}
 
 func (x *LibraryGorm) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_user_proto_msgTypes[9]
+	mi := &file_gorm_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +865,7 @@ func (x *LibraryGorm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LibraryGorm.ProtoReflect.Descriptor instead.
 func (*LibraryGorm) Descriptor() ([]byte, []int) {
-	return file_gorm_user_proto_rawDescGZIP(), []int{9}
+	return file_gorm_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LibraryGorm) GetId() uint32 {
@@ -781,6 +889,187 @@ func (x *LibraryGorm) GetContributors() []*AuthorGorm {
 	return nil
 }
 
+// LibraryChildGorm demonstrates repeated message types stored in a child
+// table (child_libraries_contributors, one row per contributor)
+type LibraryChildGorm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Contributors  []*AuthorGorm          `protobuf:"bytes,3,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibraryChildGorm) Reset() {
+	*x = LibraryChildGorm{}
+	mi := &file_gorm_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryChildGorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryChildGorm) ProtoMessage() {}
+
+func (x *LibraryChildGorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryChildGorm.ProtoReflect.Descriptor instead.
+func (*LibraryChildGorm) Descriptor() ([]byte, []int) {
+	return file_gorm_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LibraryChildGorm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LibraryChildGorm) GetContributors() []*AuthorGorm {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+// TenantUserGorm demonstrates tenant scoping: every DAL operation is limited
+// to the tenant in the context, stored in the tenant_id column. It also gets a
+// read-through cached DAL, keyed by tenant
+type TenantUserGorm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Target-only column owning the row; stamped by the DAL on writes
+	TenantId      string `protobuf:"bytes,100,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUserGorm) Reset() {
+	*x = TenantUserGorm{}
+	mi := &file_gorm_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUserGorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUserGorm) ProtoMessage() {}
+
+func (x *TenantUserGorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUserGorm.ProtoReflect.Descriptor instead.
+func (*TenantUserGorm) Descriptor() ([]byte, []int) {
+	return file_gorm_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TenantUserGorm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TenantUserGorm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantUserGorm) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantUserGorm) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// NoteGorm demonstrates audit columns: the DAL fills who and when, and the
+// converters expose them through api.Note
+type NoteGorm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only Datastore notes have keys
+	Key           string `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteGorm) Reset() {
+	*x = NoteGorm{}
+	mi := &file_gorm_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteGorm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteGorm) ProtoMessage() {}
+
+func (x *NoteGorm) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteGorm.ProtoReflect.Descriptor instead.
+func (*NoteGorm) Descriptor() ([]byte, []int) {
+	return file_gorm_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NoteGorm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NoteGorm) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 // OrganizationGorm demonstrates map with message values stored as JSONB
 type OrganizationGorm struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -794,7 +1083,7 @@ type OrganizationGorm struct {
 
 func (x *OrganizationGorm) Reset() {
 	*x = OrganizationGorm{}
-	mi := &file_gorm_user_proto_msgTypes[10]
+	mi := &file_gorm_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +1095,7 @@ func (x *OrganizationGorm) String() string {
 func (*OrganizationGorm) ProtoMessage() {}
 
 func (x *OrganizationGorm) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_user_proto_msgTypes[10]
+	mi := &file_gorm_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1108,7 @@ func (x *OrganizationGorm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationGorm.ProtoReflect.Descriptor instead.
 func (*OrganizationGorm) Descriptor() ([]byte, []int) {
-	return file_gorm_user_proto_rawDescGZIP(), []int{10}
+	return file_gorm_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OrganizationGorm) GetId() uint32 {
@@ -847,7 +1136,7 @@ var File_gorm_user_proto protoreflect.FileDescriptor
 
 const file_gorm_user_proto_rawDesc = "" +
 	"\n" +
-	"\x0fgorm/user.proto\x12\x04gorm\x1a\x18dal/v1/annotations.proto\x1a\x0eapi/user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x05\n" +
+	"\x0fgorm/user.proto\x12\x04gorm\x1a\x18dal/v1/annotations.proto\x1a\x0eapi/user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x04\n" +
 	"\bUserGorm\x12/\n" +
 	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
 	"primaryKeyR\rautoIncrementR\x02id\x125\n" +
@@ -856,15 +1145,17 @@ const file_gorm_user_proto_rawDesc = "" +
 	"\x03age\x18\x04 \x01(\rB\x13\x92\xa6\x1d\x0fR\rtype:smallintR\x03age\x126\n" +
 	"\bbirthday\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bbirthday\x12;\n" +
 	"\rmember_number\x18\x06 \x01(\tB\x16\x92\xa6\x1d\x12R\x10type:varchar(50)R\fmemberNumber\x12=\n" +
-	"\factivated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vactivatedAt\x12O\n" +
+	"\factivated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vactivatedAt\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x14\x92\xa6\x1d\x10R\x0eautoCreateTimeR\tcreatedAt\x12O\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x14\x92\xa6\x1d\x10R\x0eautoUpdateTimeR\tupdatedAt\x12F\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
 	"\n" +
 	"deleted_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampB\v\x92\xa6\x1d\aR\x05indexR\tdeletedAt:\x15ʦ\x1d\x11\n" +
-	"\bapi.User\x12\x05users\"\xcf\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\v\x92\xa6\x1d\aR\x05indexR\tdeletedAt:/ʦ\x1d+\n" +
+	"\bapi.User\x12\x05users:\x18\x1a\n" +
+	"created_at\"\n" +
+	"updated_at\"\xcf\x02\n" +
 	"\x13UserWithPermissions\x12 \n" +
 	"\x02id\x18\x01 \x01(\rB\x10\x92\xa6\x1d\fR\n" +
 	"primaryKeyR\x02id\x12#\n" +
@@ -916,7 +1207,20 @@ const file_gorm_user_proto_rawDesc = "" +
 	"\x06author\x18\x02 \x01(\v2\x10.gorm.AuthorGormB&\x92\xa6\x1d\"R\bembeddedR\x16embeddedPrefix:author_R\x06author\x12)\n" +
 	"\aupvotes\x18\x03 \x01(\x05B\x0f\x92\xa6\x1d\vR\tdefault:0R\aupvotes\x127\n" +
 	"\x05title\x18\x04 \x01(\tB!\x92\xa6\x1d\x1dR\x11type:varchar(255)R\bnot nullR\x05title:\x15ʦ\x1d\x11\n" +
-	"\bapi.Blog\x12\x05blogs\"\xb6\x03\n" +
+	"\bapi.Blog\x12\x05blogs\"\x92\x01\n" +
+	"\fBlogFlatGorm\x12/\n" +
+	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
+	"primaryKeyR\rautoIncrementR\x02id\x125\n" +
+	"\x06author\x18\x02 \x01(\v2\x10.gorm.AuthorGormB\v\x92\xa6\x1d\az\x05\n" +
+	"\x03by_R\x06author:\x1aʦ\x1d\x16\n" +
+	"\bapi.Blog\x12\n" +
+	"flat_blogs\"\x89\x01\n" +
+	"\fBlogBlobGorm\x12/\n" +
+	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
+	"primaryKeyR\rautoIncrementR\x02id\x12,\n" +
+	"\x06author\x18\x02 \x01(\v2\v.api.AuthorB\a\x92\xa6\x1d\x03\x80\x01\x01R\x06author:\x1aʦ\x1d\x16\n" +
+	"\bapi.Blog\x12\n" +
+	"blob_blogs\"\xb6\x03\n" +
 	"\vProductGorm\x12/\n" +
 	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
 	"primaryKeyR\rautoIncrementR\x02id\x125\n" +
@@ -936,7 +1240,29 @@ const file_gorm_user_proto_rawDesc = "" +
 	"primaryKeyR\rautoIncrementR\x02id\x125\n" +
 	"\x04name\x18\x02 \x01(\tB!\x92\xa6\x1d\x1dR\x11type:varchar(255)R\bnot nullR\x04name\x12K\n" +
 	"\fcontributors\x18\x03 \x03(\v2\x10.gorm.AuthorGormB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\fcontributors:\x1cʦ\x1d\x18\n" +
-	"\vapi.Library\x12\tlibraries\"\xd5\x02\n" +
+	"\vapi.Library\x12\tlibraries\"\xa6\x01\n" +
+	"\x10LibraryChildGorm\x12/\n" +
+	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
+	"primaryKeyR\rautoIncrementR\x02id\x12=\n" +
+	"\fcontributors\x18\x03 \x03(\v2\x10.gorm.AuthorGormB\a\x92\xa6\x1d\x03\x8a\x01\x00R\fcontributors:\"ʦ\x1d\x1e\n" +
+	"\vapi.Library\x12\x0fchild_libraries\"\xa4\x01\n" +
+	"\x0eTenantUserGorm\x12 \n" +
+	"\x02id\x18\x01 \x01(\rB\x10\x92\xa6\x1d\fR\n" +
+	"primaryKeyR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\ttenant_id\x18d \x01(\tR\btenantId:)ʦ\x1d%\n" +
+	"\bapi.User\x12\ftenant_users2\ttenant_id@\x01\"\x99\x01\n" +
+	"\bNoteGorm\x12,\n" +
+	"\x02id\x18\x01 \x01(\rB\x1c\x92\xa6\x1d\x18R\n" +
+	"primaryKey\x92\x01\x06NoteID\x98\x01\x05R\x02id\x12\x16\n" +
+	"\x03key\x18\a \x01(\tB\x04\xb8\xa6\x1d\x01R\x03key:Gʦ\x1dC\n" +
+	"\bapi.Note\x12\x05notes:0\n" +
+	"\n" +
+	"created_by\x12\n" +
+	"updated_by\x1a\n" +
+	"created_at\"\n" +
+	"updated_at\"\xd5\x02\n" +
 	"\x10OrganizationGorm\x12/\n" +
 	"\x02id\x18\x01 \x01(\rB\x1f\x92\xa6\x1d\x1bR\n" +
 	"primaryKeyR\rautoIncrementR\x02id\x125\n" +
@@ -960,7 +1286,7 @@ func file_gorm_user_proto_rawDescGZIP() []byte {
 	return file_gorm_user_proto_rawDescData
 }
 
-var file_gorm_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_gorm_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_gorm_user_proto_goTypes = []any{
 	(*UserGorm)(nil),                 // 0: gorm.UserGorm
 	(*UserWithPermissions)(nil),      // 1: gorm.UserWithPermissions
@@ -970,32 +1296,41 @@ var file_gorm_user_proto_goTypes = []any{
 	(*AuthorGorm)(nil),               // 5: gorm.AuthorGorm
 	(*BlogAsIsGorm)(nil),             // 6: gorm.BlogAsIsGorm
 	(*BlogGorm)(nil),                 // 7: gorm.BlogGorm
-	(*ProductGorm)(nil),              // 8: gorm.ProductGorm
-	(*LibraryGorm)(nil),              // 9: gorm.LibraryGorm
-	(*OrganizationGorm)(nil),         // 10: gorm.OrganizationGorm
-	nil,                              // 11: gorm.ProductGorm.MetadataEntry
-	nil,                              // 12: gorm.OrganizationGorm.DepartmentsEntry
-	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(*BlogFlatGorm)(nil),             // 8: gorm.BlogFlatGorm
+	(*BlogBlobGorm)(nil),             // 9: gorm.BlogBlobGorm
+	(*ProductGorm)(nil),              // 10: gorm.ProductGorm
+	(*LibraryGorm)(nil),              // 11: gorm.LibraryGorm
+	(*LibraryChildGorm)(nil),         // 12: gorm.LibraryChildGorm
+	(*TenantUserGorm)(nil),           // 13: gorm.TenantUserGorm
+	(*NoteGorm)(nil),                 // 14: gorm.NoteGorm
+	(*OrganizationGorm)(nil),         // 15: gorm.OrganizationGorm
+	nil,                              // 16: gorm.ProductGorm.MetadataEntry
+	nil,                              // 17: gorm.OrganizationGorm.DepartmentsEntry
+	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
+	(*api.Author)(nil),               // 19: api.Author
 }
 var file_gorm_user_proto_depIdxs = []int32{
-	13, // 0: gorm.UserGorm.birthday:type_name -> google.protobuf.Timestamp
-	13, // 1: gorm.UserGorm.activated_at:type_name -> google.protobuf.Timestamp
-	13, // 2: gorm.UserGorm.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: gorm.UserGorm.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 4: gorm.UserGorm.deleted_at:type_name -> google.protobuf.Timestamp
-	13, // 5: gorm.UserWithPermissions.created_at:type_name -> google.protobuf.Timestamp
-	13, // 6: gorm.UserWithPermissions.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 7: gorm.UserWithDefaults.created_at:type_name -> google.protobuf.Timestamp
+	18, // 0: gorm.UserGorm.birthday:type_name -> google.protobuf.Timestamp
+	18, // 1: gorm.UserGorm.activated_at:type_name -> google.protobuf.Timestamp
+	18, // 2: gorm.UserGorm.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: gorm.UserGorm.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 4: gorm.UserGorm.deleted_at:type_name -> google.protobuf.Timestamp
+	18, // 5: gorm.UserWithPermissions.created_at:type_name -> google.protobuf.Timestamp
+	18, // 6: gorm.UserWithPermissions.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 7: gorm.UserWithDefaults.created_at:type_name -> google.protobuf.Timestamp
 	5,  // 8: gorm.BlogGorm.author:type_name -> gorm.AuthorGorm
-	11, // 9: gorm.ProductGorm.metadata:type_name -> gorm.ProductGorm.MetadataEntry
-	5,  // 10: gorm.LibraryGorm.contributors:type_name -> gorm.AuthorGorm
-	12, // 11: gorm.OrganizationGorm.departments:type_name -> gorm.OrganizationGorm.DepartmentsEntry
-	5,  // 12: gorm.OrganizationGorm.DepartmentsEntry.value:type_name -> gorm.AuthorGorm
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 9: gorm.BlogFlatGorm.author:type_name -> gorm.AuthorGorm
+	19, // 10: gorm.BlogBlobGorm.author:type_name -> api.Author
+	16, // 11: gorm.ProductGorm.metadata:type_name -> gorm.ProductGorm.MetadataEntry
+	5,  // 12: gorm.LibraryGorm.contributors:type_name -> gorm.AuthorGorm
+	5,  // 13: gorm.LibraryChildGorm.contributors:type_name -> gorm.AuthorGorm
+	17, // 14: gorm.OrganizationGorm.departments:type_name -> gorm.OrganizationGorm.DepartmentsEntry
+	5,  // 15: gorm.OrganizationGorm.DepartmentsEntry.value:type_name -> gorm.AuthorGorm
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_gorm_user_proto_init() }
@@ -1009,7 +1344,7 @@ func file_gorm_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gorm_user_proto_rawDesc), len(file_gorm_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -324,7 +324,8 @@ func (x *WorldDataGORM) GetUnits() []*UnitGORM {
 // Describes a game and its metadata
 type GameGORM struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Typed ID shared with the game's state and moves, generated on create
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Tags as JSON for cross-DB compatibility
 	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 	// PreviewUrls as JSON for cross-DB compatibility
@@ -730,6 +731,7 @@ func (*GameMoveGroupGORM) Descriptor() ([]byte, []int) {
 
 // *
 // Represents a single move which can be one of many actions in the game
+// Moves are read far more often than written, so the DAL is read-through cached
 type GameMoveGORM struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	GameId      string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
@@ -837,10 +839,10 @@ const file_gorm_weewar_proto_rawDesc = "" +
 	"primaryKeyR\aworldId\x12;\n" +
 	"\x05units\x18\x03 \x03(\v2\x0e.gorm.UnitGORMB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x05units:'ʦ\x1d#\n" +
 	"\x13weewar.v1.WorldData\x12\n" +
-	"world_data \x01\"\x98\x03\n" +
-	"\bGameGORM\x12 \n" +
-	"\x02id\x18\x01 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
-	"primaryKeyR\x02id\x12)\n" +
+	"world_data \x01\"\xa4\x03\n" +
+	"\bGameGORM\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\x92\xa6\x1d\x18R\n" +
+	"primaryKey\x92\x01\x06GameID\x98\x01\x03R\x02id\x12)\n" +
 	"\x04tags\x18\a \x03(\tB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x04tags\x128\n" +
 	"\fpreview_urls\x18\v \x03(\tB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\vpreviewUrls\x12y\n" +
 	"\x15screenshot_index_info\x18\f \x01(\v2\x13.gorm.IndexInfoGORMB0\x92\xa6\x1d,R\bembeddedR embeddedPrefix:screenshot_index_R\x13screenshotIndexInfo\x12m\n" +
@@ -859,26 +861,27 @@ const file_gorm_weewar_proto_rawDesc = "" +
 	"\x12weewar.v1.GameTeam \x01\"l\n" +
 	"\x10GameSettingsGORM\x12:\n" +
 	"\rallowed_units\x18\x01 \x03(\x05B\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\fallowedUnits:\x1cʦ\x1d\x18\n" +
-	"\x16weewar.v1.GameSettings\"U\n" +
-	"\rGameStateGORM\x12)\n" +
-	"\agame_id\x18\x01 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
-	"primaryKeyR\x06gameId:\x19ʦ\x1d\x15\n" +
+	"\x16weewar.v1.GameSettings\"^\n" +
+	"\rGameStateGORM\x122\n" +
+	"\agame_id\x18\x01 \x01(\tB\x19\x92\xa6\x1d\x15R\n" +
+	"primaryKey\x92\x01\x06GameIDR\x06gameId:\x19ʦ\x1d\x15\n" +
 	"\x13weewar.v1.GameState\"6\n" +
 	"\x13GameMoveHistoryGORM:\x1fʦ\x1d\x1b\n" +
 	"\x19weewar.v1.GameMoveHistory\"2\n" +
 	"\x11GameMoveGroupGORM:\x1dʦ\x1d\x19\n" +
-	"\x17weewar.v1.GameMoveGroup\"\xcc\x02\n" +
-	"\fGameMoveGORM\x12)\n" +
-	"\agame_id\x18\x01 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
-	"primaryKeyR\x06gameId\x123\n" +
+	"\x17weewar.v1.GameMoveGroup\"\xe3\x02\n" +
+	"\fGameMoveGORM\x122\n" +
+	"\agame_id\x18\x01 \x01(\tB\x19\x92\xa6\x1d\x15R\n" +
+	"primaryKey\x92\x01\x06GameIDR\x06gameId\x123\n" +
 	"\fgroup_number\x18\x02 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
 	"primaryKeyR\vgroupNumber\x121\n" +
 	"\vmove_number\x18\x03 \x01(\x05B\x10\x92\xa6\x1d\fR\n" +
 	"primaryKeyR\n" +
 	"moveNumber\x12H\n" +
 	"\tmove_type\x18\x04 \x01(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\bmoveType\x12E\n" +
-	"\achanges\x18\x05 \x03(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\achanges:\x18ʦ\x1d\x14\n" +
-	"\x12weewar.v1.GameMoveB{\n" +
+	"\achanges\x18\x05 \x03(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\achanges:&ʦ\x1d\"\n" +
+	"\x12weewar.v1.GameMove\x12\n" +
+	"game_moves@\x01B{\n" +
 	"\bcom.gormB\vWeewarProtoP\x01Z2github.com/panyam/protoc-gen-dal/tests/gen/go/gorm\xa2\x02\x03GXX\xaa\x02\x04Gorm\xca\x02\x04Gorm\xe2\x02\x10Gorm\\GPBMetadata\xea\x02\x04Gormb\x06proto3"
 
 var (
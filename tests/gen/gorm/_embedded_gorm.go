@@ -1,56 +1,522 @@
 // Code generated by protoc-gen-dal-gorm. DO NOT EDIT.
 package gorm
 
+import (
+	"bytes"
+)
+
 // Any
 type Any struct {
 	TypeUrl string
 	Value   []byte
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *Any) Equal(other *Any) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.TypeUrl == other.TypeUrl &&
+		bytes.Equal(m.Value, other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *Any) ChangedColumns(other *Any) []string {
+	if m == nil {
+		m = &Any{}
+	}
+	if other == nil {
+		other = &Any{}
+	}
+	var columns []string
+	if m.TypeUrl != other.TypeUrl {
+		columns = append(columns, "type_url")
+	}
+	if !bytes.Equal(m.Value, other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *Any) Clone() *Any {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a Any with copies of their own.
+func (m *Any) cloneFields() {
+	m.Value = bytes.Clone(m.Value)
+}
+
 // Timestamp
 type Timestamp struct {
 	Seconds int64
 	Nanos   int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *Timestamp) Equal(other *Timestamp) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Seconds == other.Seconds &&
+		m.Nanos == other.Nanos
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *Timestamp) ChangedColumns(other *Timestamp) []string {
+	if m == nil {
+		m = &Timestamp{}
+	}
+	if other == nil {
+		other = &Timestamp{}
+	}
+	var columns []string
+	if m.Seconds != other.Seconds {
+		columns = append(columns, "seconds")
+	}
+	if m.Nanos != other.Nanos {
+		columns = append(columns, "nanos")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *Timestamp) Clone() *Timestamp {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a Timestamp with copies of their own.
+func (m *Timestamp) cloneFields() {
+}
+
 // DepartmentsEntry
 type DepartmentsEntry struct {
 	Key   string
 	Value AuthorGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *DepartmentsEntry) Equal(other *DepartmentsEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DepartmentsEntry) ChangedColumns(other *DepartmentsEntry) []string {
+	if m == nil {
+		m = &DepartmentsEntry{}
+	}
+	if other == nil {
+		other = &DepartmentsEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if !m.Value.Equal(&other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DepartmentsEntry) Clone() *DepartmentsEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DepartmentsEntry with copies of their own.
+func (m *DepartmentsEntry) cloneFields() {
+	m.Value.cloneFields()
+}
+
 // MetadataEntry
 type MetadataEntry struct {
 	Key   string
 	Value string
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *MetadataEntry) Equal(other *MetadataEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value == other.Value
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *MetadataEntry) ChangedColumns(other *MetadataEntry) []string {
+	if m == nil {
+		m = &MetadataEntry{}
+	}
+	if other == nil {
+		other = &MetadataEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if m.Value != other.Value {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *MetadataEntry) Clone() *MetadataEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a MetadataEntry with copies of their own.
+func (m *MetadataEntry) cloneFields() {
+}
+
 // MapStringToEnumEntry
 type MapStringToEnumEntry struct {
 	Key   string
 	Value gorm.SampleEnum
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *MapStringToEnumEntry) Equal(other *MapStringToEnumEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value == other.Value
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *MapStringToEnumEntry) ChangedColumns(other *MapStringToEnumEntry) []string {
+	if m == nil {
+		m = &MapStringToEnumEntry{}
+	}
+	if other == nil {
+		other = &MapStringToEnumEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if m.Value != other.Value {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *MapStringToEnumEntry) Clone() *MapStringToEnumEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a MapStringToEnumEntry with copies of their own.
+func (m *MapStringToEnumEntry) cloneFields() {
+}
+
 // BoolToMessageEntry
 type BoolToMessageEntry struct {
 	Key   bool
 	Value MapValueMessageGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *BoolToMessageEntry) Equal(other *BoolToMessageEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *BoolToMessageEntry) ChangedColumns(other *BoolToMessageEntry) []string {
+	if m == nil {
+		m = &BoolToMessageEntry{}
+	}
+	if other == nil {
+		other = &BoolToMessageEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if !m.Value.Equal(&other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *BoolToMessageEntry) Clone() *BoolToMessageEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a BoolToMessageEntry with copies of their own.
+func (m *BoolToMessageEntry) cloneFields() {
+	m.Value.cloneFields()
+}
+
 // Int32ToMessageEntry
 type Int32ToMessageEntry struct {
 	Key   int32
 	Value MapValueMessageGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *Int32ToMessageEntry) Equal(other *Int32ToMessageEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *Int32ToMessageEntry) ChangedColumns(other *Int32ToMessageEntry) []string {
+	if m == nil {
+		m = &Int32ToMessageEntry{}
+	}
+	if other == nil {
+		other = &Int32ToMessageEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if !m.Value.Equal(&other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *Int32ToMessageEntry) Clone() *Int32ToMessageEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a Int32ToMessageEntry with copies of their own.
+func (m *Int32ToMessageEntry) cloneFields() {
+	m.Value.cloneFields()
+}
+
 // Int64ToMessageEntry
 type Int64ToMessageEntry struct {
 	Key   int64
 	Value MapValueMessageGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *Int64ToMessageEntry) Equal(other *Int64ToMessageEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *Int64ToMessageEntry) ChangedColumns(other *Int64ToMessageEntry) []string {
+	if m == nil {
+		m = &Int64ToMessageEntry{}
+	}
+	if other == nil {
+		other = &Int64ToMessageEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if !m.Value.Equal(&other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *Int64ToMessageEntry) Clone() *Int64ToMessageEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a Int64ToMessageEntry with copies of their own.
+func (m *Int64ToMessageEntry) cloneFields() {
+	m.Value.cloneFields()
+}
+
 // Uint32ToMessageEntry
 type Uint32ToMessageEntry struct {
 	Key   uint32
 	Value MapValueMessageGORM
 }
+
+// Equal reports whether m and other have the same field values.
+func (m *Uint32ToMessageEntry) Equal(other *Uint32ToMessageEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *Uint32ToMessageEntry) ChangedColumns(other *Uint32ToMessageEntry) []string {
+	if m == nil {
+		m = &Uint32ToMessageEntry{}
+	}
+	if other == nil {
+		other = &Uint32ToMessageEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if !m.Value.Equal(&other.Value) {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *Uint32ToMessageEntry) Clone() *Uint32ToMessageEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a Uint32ToMessageEntry with copies of their own.
+func (m *Uint32ToMessageEntry) cloneFields() {
+	m.Value.cloneFields()
+}
+
+// DeadlinesEntry
+type DeadlinesEntry struct {
+	Key   string
+	Value int64
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *DeadlinesEntry) Equal(other *DeadlinesEntry) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Key == other.Key &&
+		m.Value == other.Value
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DeadlinesEntry) ChangedColumns(other *DeadlinesEntry) []string {
+	if m == nil {
+		m = &DeadlinesEntry{}
+	}
+	if other == nil {
+		other = &DeadlinesEntry{}
+	}
+	var columns []string
+	if m.Key != other.Key {
+		columns = append(columns, "key")
+	}
+	if m.Value != other.Value {
+		columns = append(columns, "value")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DeadlinesEntry) Clone() *DeadlinesEntry {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DeadlinesEntry with copies of their own.
+func (m *DeadlinesEntry) cloneFields() {
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	gorm "github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	gormlib "gorm.io/gorm"
 )
@@ -62,6 +64,30 @@ func (d *DocumentGormPartialDAL) Update(ctx context.Context, db *gormlib.DB, obj
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *DocumentGormPartialDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.DocumentGormPartial) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.DocumentGormPartial record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -122,6 +148,69 @@ func (d *DocumentGormPartialDAL) List(ctx context.Context, query *gormlib.DB) ([
 	return out, err
 }
 
+// Iterate calls fn for each gorm.DocumentGormPartial record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *DocumentGormPartialDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.DocumentGormPartial) error) error {
+	var batch []*gorm.DocumentGormPartial
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with DocumentFromDocumentGormPartial
+// and calls fn with the resulting api.Document.
+func (d *DocumentGormPartialDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Document) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.DocumentGormPartial) error {
+		msg, err := gorm.DocumentFromDocumentGormPartial(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// DocumentGormPartialFilterSchema lists the api.Document fields that AIP-160
+// filters on DocumentGormPartial records may use, and their columns.
+var DocumentGormPartialFilterSchema = filtering.MustSchema((&api.Document{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":         "id",
+	"title":      "title",
+	"content":    "content",
+	"author":     "author",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"published":  "published",
+	"view_count": "view_count",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Document fields (see DocumentGormPartialFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *DocumentGormPartialDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(DocumentGormPartialFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.DocumentGormPartial records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *DocumentGormPartialDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.DocumentGormPartial, error) {
@@ -187,6 +276,30 @@ func (d *DocumentGormSkipDAL) Update(ctx context.Context, db *gormlib.DB, obj *g
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *DocumentGormSkipDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.DocumentGormSkip) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.DocumentGormSkip record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -247,6 +360,68 @@ func (d *DocumentGormSkipDAL) List(ctx context.Context, query *gormlib.DB) ([]*g
 	return out, err
 }
 
+// Iterate calls fn for each gorm.DocumentGormSkip record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *DocumentGormSkipDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.DocumentGormSkip) error) error {
+	var batch []*gorm.DocumentGormSkip
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with DocumentFromDocumentGormSkip
+// and calls fn with the resulting api.Document.
+func (d *DocumentGormSkipDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Document) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.DocumentGormSkip) error {
+		msg, err := gorm.DocumentFromDocumentGormSkip(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// DocumentGormSkipFilterSchema lists the api.Document fields that AIP-160
+// filters on DocumentGormSkip records may use, and their columns.
+var DocumentGormSkipFilterSchema = filtering.MustSchema((&api.Document{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":         "id",
+	"title":      "title",
+	"author":     "author",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"published":  "published",
+	"view_count": "view_count",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Document fields (see DocumentGormSkipFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *DocumentGormSkipDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(DocumentGormSkipFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.DocumentGormSkip records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *DocumentGormSkipDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.DocumentGormSkip, error) {
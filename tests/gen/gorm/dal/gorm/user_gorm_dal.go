@@ -4,9 +4,16 @@ package dal
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/panyam/protoc-gen-dal/pkg/audit"
+	"github.com/panyam/protoc-gen-dal/pkg/cache"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	"github.com/panyam/protoc-gen-dal/pkg/tenant"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	gorm "github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	gormlib "gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // UserGORMDAL provides database access helper methods for gorm.UserGORM.
@@ -18,6 +25,14 @@ type UserGORMDAL struct {
 	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
 	// Return an error to prevent creation.
 	WillCreate func(context.Context, *gorm.UserGORM) error
+
+	// ActorExtractor reads the actor of each write from its context for the
+	// audit columns. If nil, uses audit.DefaultExtractor.
+	ActorExtractor audit.Extractor
+
+	// Clock returns the time of each write for the audit columns.
+	// If nil, uses audit.DefaultClock.
+	Clock audit.Clock
 }
 
 // NewUserGORMDAL creates a new UserGORMDAL instance.
@@ -37,9 +52,21 @@ func (d *UserGORMDAL) db(db *gormlib.DB) *gormlib.DB {
 	return db
 }
 
+// stampAudit fills the audit columns of obj before a write.
+// The created_* columns are only set when creating obj.
+func (d *UserGORMDAL) stampAudit(ctx context.Context, obj *gorm.UserGORM, creating bool) {
+	now := audit.Now(d.Clock)
+	if creating {
+		obj.CreatedAt = now
+	}
+	obj.UpdatedAt = now
+}
+
 // Create creates a new gorm.UserGORM record.
 // Returns an error if the record already exists.
 func (d *UserGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.UserGORM) error {
+	d.stampAudit(ctx, obj, true)
+
 	return d.db(db).Create(obj).Error
 }
 
@@ -49,6 +76,8 @@ func (d *UserGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.User
 //
 //	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
 func (d *UserGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.UserGORM) error {
+	d.stampAudit(ctx, obj, false)
+
 	result := d.db(db).Updates(obj)
 	if result.Error != nil {
 		return result.Error
@@ -62,6 +91,32 @@ func (d *UserGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.User
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns (and the updated_* audit columns) are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *UserGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.UserGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+	d.stampAudit(ctx, obj, false)
+	columns = append(columns, "updated_at")
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.UserGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -79,6 +134,7 @@ func (d *UserGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.UserGO
 
 	if err != nil {
 		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			d.stampAudit(ctx, obj, true)
 			// Record doesn't exist - call WillCreate hook before saving
 			if d.WillCreate != nil {
 				if err := d.WillCreate(ctx, obj); err != nil {
@@ -91,6 +147,12 @@ func (d *UserGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.UserGO
 		}
 	}
 
+	// Keep the existing record's creation audit
+	if err == nil {
+		obj.CreatedAt = existing.CreatedAt
+		d.stampAudit(ctx, obj, false)
+	}
+
 	// Save (create or update)
 	return d.db(db).Save(obj).Error
 }
@@ -122,6 +184,70 @@ func (d *UserGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.User
 	return out, err
 }
 
+// Iterate calls fn for each gorm.UserGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *UserGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.UserGORM) error) error {
+	var batch []*gorm.UserGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromUserGORM
+// and calls fn with the resulting api.User.
+func (d *UserGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.UserGORM) error {
+		msg, err := gorm.UserFromUserGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// UserGORMFilterSchema lists the api.User fields that AIP-160
+// filters on UserGORM records may use, and their columns.
+var UserGORMFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see UserGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *UserGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(UserGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.UserGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *UserGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.UserGORM, error) {
@@ -187,6 +313,30 @@ func (d *UserWithPermissionsDAL) Update(ctx context.Context, db *gormlib.DB, obj
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *UserWithPermissionsDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.UserWithPermissions) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.UserWithPermissions record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -247,6 +397,69 @@ func (d *UserWithPermissionsDAL) List(ctx context.Context, query *gormlib.DB) ([
 	return out, err
 }
 
+// Iterate calls fn for each gorm.UserWithPermissions record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *UserWithPermissionsDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.UserWithPermissions) error) error {
+	var batch []*gorm.UserWithPermissions
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromUserWithPermissions
+// and calls fn with the resulting api.User.
+func (d *UserWithPermissionsDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.UserWithPermissions) error {
+		msg, err := gorm.UserFromUserWithPermissions(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// UserWithPermissionsFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithPermissions records may use, and their columns.
+var UserWithPermissionsFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see UserWithPermissionsFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *UserWithPermissionsDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(UserWithPermissionsFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.UserWithPermissions records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *UserWithPermissionsDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.UserWithPermissions, error) {
@@ -312,6 +525,30 @@ func (d *UserWithCustomTimestampsDAL) Update(ctx context.Context, db *gormlib.DB
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *UserWithCustomTimestampsDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.UserWithCustomTimestamps) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.UserWithCustomTimestamps record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -372,6 +609,69 @@ func (d *UserWithCustomTimestampsDAL) List(ctx context.Context, query *gormlib.D
 	return out, err
 }
 
+// Iterate calls fn for each gorm.UserWithCustomTimestamps record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *UserWithCustomTimestampsDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.UserWithCustomTimestamps) error) error {
+	var batch []*gorm.UserWithCustomTimestamps
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromUserWithCustomTimestamps
+// and calls fn with the resulting api.User.
+func (d *UserWithCustomTimestampsDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.UserWithCustomTimestamps) error {
+		msg, err := gorm.UserFromUserWithCustomTimestamps(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// UserWithCustomTimestampsFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithCustomTimestamps records may use, and their columns.
+var UserWithCustomTimestampsFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see UserWithCustomTimestampsFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *UserWithCustomTimestampsDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(UserWithCustomTimestampsFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.UserWithCustomTimestamps records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *UserWithCustomTimestampsDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.UserWithCustomTimestamps, error) {
@@ -437,6 +737,30 @@ func (d *UserWithIndexesDAL) Update(ctx context.Context, db *gormlib.DB, obj *go
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *UserWithIndexesDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.UserWithIndexes) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.UserWithIndexes record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -497,6 +821,70 @@ func (d *UserWithIndexesDAL) List(ctx context.Context, query *gormlib.DB) ([]*go
 	return out, err
 }
 
+// Iterate calls fn for each gorm.UserWithIndexes record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *UserWithIndexesDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.UserWithIndexes) error) error {
+	var batch []*gorm.UserWithIndexes
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromUserWithIndexes
+// and calls fn with the resulting api.User.
+func (d *UserWithIndexesDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.UserWithIndexes) error {
+		msg, err := gorm.UserFromUserWithIndexes(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// UserWithIndexesFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithIndexes records may use, and their columns.
+var UserWithIndexesFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see UserWithIndexesFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *UserWithIndexesDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(UserWithIndexesFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.UserWithIndexes records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *UserWithIndexesDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.UserWithIndexes, error) {
@@ -562,6 +950,30 @@ func (d *UserWithDefaultsDAL) Update(ctx context.Context, db *gormlib.DB, obj *g
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *UserWithDefaultsDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.UserWithDefaults) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.UserWithDefaults record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -622,6 +1034,70 @@ func (d *UserWithDefaultsDAL) List(ctx context.Context, query *gormlib.DB) ([]*g
 	return out, err
 }
 
+// Iterate calls fn for each gorm.UserWithDefaults record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *UserWithDefaultsDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.UserWithDefaults) error) error {
+	var batch []*gorm.UserWithDefaults
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromUserWithDefaults
+// and calls fn with the resulting api.User.
+func (d *UserWithDefaultsDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.UserWithDefaults) error {
+		msg, err := gorm.UserFromUserWithDefaults(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// UserWithDefaultsFilterSchema lists the api.User fields that AIP-160
+// filters on UserWithDefaults records may use, and their columns.
+var UserWithDefaultsFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see UserWithDefaultsFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *UserWithDefaultsDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(UserWithDefaultsFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.UserWithDefaults records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *UserWithDefaultsDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.UserWithDefaults, error) {
@@ -687,6 +1163,30 @@ func (d *BlogGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.Blog
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *BlogGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.BlogGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.BlogGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -747,6 +1247,64 @@ func (d *BlogGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.Blog
 	return out, err
 }
 
+// Iterate calls fn for each gorm.BlogGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *BlogGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.BlogGORM) error) error {
+	var batch []*gorm.BlogGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with BlogFromBlogGORM
+// and calls fn with the resulting api.Blog.
+func (d *BlogGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Blog) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.BlogGORM) error {
+		msg, err := gorm.BlogFromBlogGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// BlogGORMFilterSchema lists the api.Blog fields that AIP-160
+// filters on BlogGORM records may use, and their columns.
+var BlogGORMFilterSchema = filtering.MustSchema((&api.Blog{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":      "id",
+	"upvotes": "upvotes",
+	"title":   "title",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Blog fields (see BlogGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *BlogGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(BlogGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.BlogGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *BlogGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.BlogGORM, error) {
@@ -759,46 +1317,46 @@ func (d *BlogGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32
 	return out, err
 }
 
-// ProductGORMDAL provides database access helper methods for gorm.ProductGORM.
-type ProductGORMDAL struct {
+// BlogFlatGORMDAL provides database access helper methods for gorm.BlogFlatGORM.
+type BlogFlatGORMDAL struct {
 	// TableName overrides the table for all operations.
 	// If empty, uses the struct's TableName() method (if any) or GORM's default.
 	TableName string
 
 	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
 	// Return an error to prevent creation.
-	WillCreate func(context.Context, *gorm.ProductGORM) error
+	WillCreate func(context.Context, *gorm.BlogFlatGORM) error
 }
 
-// NewProductGORMDAL creates a new ProductGORMDAL instance.
+// NewBlogFlatGORMDAL creates a new BlogFlatGORMDAL instance.
 // If tableName is empty, operations will use the struct's TableName() method
 // or GORM's default table naming convention.
-func NewProductGORMDAL(tableName string) *ProductGORMDAL {
-	return &ProductGORMDAL{TableName: tableName}
+func NewBlogFlatGORMDAL(tableName string) *BlogFlatGORMDAL {
+	return &BlogFlatGORMDAL{TableName: tableName}
 }
 
 // db returns a *gorm.DB scoped to the correct table.
 // If TableName is set, uses db.Table(); otherwise returns db unchanged
 // to let GORM resolve the table name from the struct's TableName() method.
-func (d *ProductGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+func (d *BlogFlatGORMDAL) db(db *gormlib.DB) *gormlib.DB {
 	if d.TableName != "" {
 		return db.Table(d.TableName)
 	}
 	return db
 }
 
-// Create creates a new gorm.ProductGORM record.
+// Create creates a new gorm.BlogFlatGORM record.
 // Returns an error if the record already exists.
-func (d *ProductGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+func (d *BlogFlatGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.BlogFlatGORM) error {
 	return d.db(db).Create(obj).Error
 }
 
-// Update updates an existing gorm.ProductGORM record.
+// Update updates an existing gorm.BlogFlatGORM record.
 // Returns ErrRecordNotFound if the record doesn't exist.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
 //
 //	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
-func (d *ProductGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+func (d *BlogFlatGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.BlogFlatGORM) error {
 	result := d.db(db).Updates(obj)
 	if result.Error != nil {
 		return result.Error
@@ -812,19 +1370,43 @@ func (d *ProductGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.P
 	return nil
 }
 
-// Save creates or updates a gorm.ProductGORM record (upsert).
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *BlogFlatGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.BlogFlatGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.BlogFlatGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
 //
 //	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
-func (d *ProductGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+func (d *BlogFlatGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.BlogFlatGORM) error {
 	// Validate primary key(s)
 	if obj.Id == 0 {
 		return errors.New("primary key 'Id' cannot be empty")
 	}
 
 	// Check if record exists by trying to fetch it
-	var existing gorm.ProductGORM
+	var existing gorm.BlogFlatGORM
 	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
 
 	if err != nil {
@@ -845,10 +1427,10 @@ func (d *ProductGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.Pro
 	return d.db(db).Save(obj).Error
 }
 
-// Get retrieves a gorm.ProductGORM record by primary key.
+// Get retrieves a gorm.BlogFlatGORM record by primary key.
 // Returns (nil, nil) if the record is not found (not an error).
-func (d *ProductGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.ProductGORM, error) {
-	var out gorm.ProductGORM
+func (d *BlogFlatGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.BlogFlatGORM, error) {
+	var out gorm.BlogFlatGORM
 	err := d.db(db).First(&out, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gormlib.ErrRecordNotFound) {
@@ -859,71 +1441,129 @@ func (d *ProductGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*g
 	return &out, nil
 }
 
-// Delete removes a gorm.ProductGORM record by primary key.
-func (d *ProductGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
-	return d.db(db).Where("id = ?", id).Delete(&gorm.ProductGORM{}).Error
+// Delete removes a gorm.BlogFlatGORM record by primary key.
+func (d *BlogFlatGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	return d.db(db).Where("id = ?", id).Delete(&gorm.BlogFlatGORM{}).Error
 }
 
-// List retrieves multiple gorm.ProductGORM records using the provided query.
+// List retrieves multiple gorm.BlogFlatGORM records using the provided query.
 // The caller is responsible for adding filters, ordering, and pagination to the query.
-func (d *ProductGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.ProductGORM, error) {
-	var out []*gorm.ProductGORM
+func (d *BlogFlatGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.BlogFlatGORM, error) {
+	var out []*gorm.BlogFlatGORM
 	err := d.db(query).Find(&out).Error
 	return out, err
 }
 
-// BatchGet retrieves multiple gorm.ProductGORM records by primary key.
+// Iterate calls fn for each gorm.BlogFlatGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *BlogFlatGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.BlogFlatGORM) error) error {
+	var batch []*gorm.BlogFlatGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with BlogFromBlogFlatGORM
+// and calls fn with the resulting api.Blog.
+func (d *BlogFlatGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Blog) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.BlogFlatGORM) error {
+		msg, err := gorm.BlogFromBlogFlatGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// BlogFlatGORMFilterSchema lists the api.Blog fields that AIP-160
+// filters on BlogFlatGORM records may use, and their columns.
+var BlogFlatGORMFilterSchema = filtering.MustSchema((&api.Blog{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":      "id",
+	"upvotes": "upvotes",
+	"title":   "title",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Blog fields (see BlogFlatGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *BlogFlatGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(BlogFlatGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.BlogFlatGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
-func (d *ProductGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.ProductGORM, error) {
+func (d *BlogFlatGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.BlogFlatGORM, error) {
 	if len(ids) == 0 {
-		return []*gorm.ProductGORM{}, nil
+		return []*gorm.BlogFlatGORM{}, nil
 	}
 
-	var out []*gorm.ProductGORM
+	var out []*gorm.BlogFlatGORM
 	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
 	return out, err
 }
 
-// LibraryGORMDAL provides database access helper methods for gorm.LibraryGORM.
-type LibraryGORMDAL struct {
+// BlogBlobGORMDAL provides database access helper methods for gorm.BlogBlobGORM.
+type BlogBlobGORMDAL struct {
 	// TableName overrides the table for all operations.
 	// If empty, uses the struct's TableName() method (if any) or GORM's default.
 	TableName string
 
 	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
 	// Return an error to prevent creation.
-	WillCreate func(context.Context, *gorm.LibraryGORM) error
+	WillCreate func(context.Context, *gorm.BlogBlobGORM) error
 }
 
-// NewLibraryGORMDAL creates a new LibraryGORMDAL instance.
+// NewBlogBlobGORMDAL creates a new BlogBlobGORMDAL instance.
 // If tableName is empty, operations will use the struct's TableName() method
 // or GORM's default table naming convention.
-func NewLibraryGORMDAL(tableName string) *LibraryGORMDAL {
-	return &LibraryGORMDAL{TableName: tableName}
+func NewBlogBlobGORMDAL(tableName string) *BlogBlobGORMDAL {
+	return &BlogBlobGORMDAL{TableName: tableName}
 }
 
 // db returns a *gorm.DB scoped to the correct table.
 // If TableName is set, uses db.Table(); otherwise returns db unchanged
 // to let GORM resolve the table name from the struct's TableName() method.
-func (d *LibraryGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+func (d *BlogBlobGORMDAL) db(db *gormlib.DB) *gormlib.DB {
 	if d.TableName != "" {
 		return db.Table(d.TableName)
 	}
 	return db
 }
 
-// Create creates a new gorm.LibraryGORM record.
+// Create creates a new gorm.BlogBlobGORM record.
 // Returns an error if the record already exists.
-func (d *LibraryGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+func (d *BlogBlobGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.BlogBlobGORM) error {
 	return d.db(db).Create(obj).Error
 }
 
-// Update updates an existing gorm.LibraryGORM record.
+// Update updates an existing gorm.BlogBlobGORM record.
 // Returns ErrRecordNotFound if the record doesn't exist.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
 //
 //	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
-func (d *LibraryGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+func (d *BlogBlobGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.BlogBlobGORM) error {
 	result := d.db(db).Updates(obj)
 	if result.Error != nil {
 		return result.Error
@@ -937,19 +1577,43 @@ func (d *LibraryGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.L
 	return nil
 }
 
-// Save creates or updates a gorm.LibraryGORM record (upsert).
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *BlogBlobGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.BlogBlobGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.BlogBlobGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
 //
 //	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
-func (d *LibraryGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+func (d *BlogBlobGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.BlogBlobGORM) error {
 	// Validate primary key(s)
 	if obj.Id == 0 {
 		return errors.New("primary key 'Id' cannot be empty")
 	}
 
 	// Check if record exists by trying to fetch it
-	var existing gorm.LibraryGORM
+	var existing gorm.BlogBlobGORM
 	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
 
 	if err != nil {
@@ -970,10 +1634,10 @@ func (d *LibraryGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.Lib
 	return d.db(db).Save(obj).Error
 }
 
-// Get retrieves a gorm.LibraryGORM record by primary key.
+// Get retrieves a gorm.BlogBlobGORM record by primary key.
 // Returns (nil, nil) if the record is not found (not an error).
-func (d *LibraryGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.LibraryGORM, error) {
-	var out gorm.LibraryGORM
+func (d *BlogBlobGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.BlogBlobGORM, error) {
+	var out gorm.BlogBlobGORM
 	err := d.db(db).First(&out, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gormlib.ErrRecordNotFound) {
@@ -984,27 +1648,1463 @@ func (d *LibraryGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*g
 	return &out, nil
 }
 
-// Delete removes a gorm.LibraryGORM record by primary key.
-func (d *LibraryGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
-	return d.db(db).Where("id = ?", id).Delete(&gorm.LibraryGORM{}).Error
+// Delete removes a gorm.BlogBlobGORM record by primary key.
+func (d *BlogBlobGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	return d.db(db).Where("id = ?", id).Delete(&gorm.BlogBlobGORM{}).Error
 }
 
-// List retrieves multiple gorm.LibraryGORM records using the provided query.
+// List retrieves multiple gorm.BlogBlobGORM records using the provided query.
 // The caller is responsible for adding filters, ordering, and pagination to the query.
-func (d *LibraryGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.LibraryGORM, error) {
-	var out []*gorm.LibraryGORM
+func (d *BlogBlobGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.BlogBlobGORM, error) {
+	var out []*gorm.BlogBlobGORM
 	err := d.db(query).Find(&out).Error
 	return out, err
 }
 
-// BatchGet retrieves multiple gorm.LibraryGORM records by primary key.
-// Results are returned in the order provided by the database (not necessarily the input order).
+// Iterate calls fn for each gorm.BlogBlobGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *BlogBlobGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.BlogBlobGORM) error) error {
+	var batch []*gorm.BlogBlobGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with BlogFromBlogBlobGORM
+// and calls fn with the resulting api.Blog.
+func (d *BlogBlobGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Blog) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.BlogBlobGORM) error {
+		msg, err := gorm.BlogFromBlogBlobGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// BlogBlobGORMFilterSchema lists the api.Blog fields that AIP-160
+// filters on BlogBlobGORM records may use, and their columns.
+var BlogBlobGORMFilterSchema = filtering.MustSchema((&api.Blog{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":      "id",
+	"upvotes": "upvotes",
+	"title":   "title",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Blog fields (see BlogBlobGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *BlogBlobGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(BlogBlobGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.BlogBlobGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *BlogBlobGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.BlogBlobGORM, error) {
+	if len(ids) == 0 {
+		return []*gorm.BlogBlobGORM{}, nil
+	}
+
+	var out []*gorm.BlogBlobGORM
+	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
+	return out, err
+}
+
+// ProductGORMDAL provides database access helper methods for gorm.ProductGORM.
+type ProductGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.ProductGORM) error
+}
+
+// NewProductGORMDAL creates a new ProductGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewProductGORMDAL(tableName string) *ProductGORMDAL {
+	return &ProductGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *ProductGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// Create creates a new gorm.ProductGORM record.
+// Returns an error if the record already exists.
+func (d *ProductGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.ProductGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *ProductGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+	result := d.db(db).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *ProductGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.ProductGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.ProductGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *ProductGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.ProductGORM) error {
+	// Validate primary key(s)
+	if obj.Id == 0 {
+		return errors.New("primary key 'Id' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.ProductGORM
+	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Save (create or update)
+	return d.db(db).Save(obj).Error
+}
+
+// Get retrieves a gorm.ProductGORM record by primary key.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *ProductGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.ProductGORM, error) {
+	var out gorm.ProductGORM
+	err := d.db(db).First(&out, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.ProductGORM record by primary key.
+func (d *ProductGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	return d.db(db).Where("id = ?", id).Delete(&gorm.ProductGORM{}).Error
+}
+
+// List retrieves multiple gorm.ProductGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *ProductGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.ProductGORM, error) {
+	var out []*gorm.ProductGORM
+	err := d.db(query).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.ProductGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *ProductGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.ProductGORM) error) error {
+	var batch []*gorm.ProductGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with ProductFromProductGORM
+// and calls fn with the resulting api.Product.
+func (d *ProductGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Product) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.ProductGORM) error {
+		msg, err := gorm.ProductFromProductGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// ProductGORMFilterSchema lists the api.Product fields that AIP-160
+// filters on ProductGORM records may use, and their columns.
+var ProductGORMFilterSchema = filtering.MustSchema((&api.Product{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":   "id",
+	"name": "name",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Product fields (see ProductGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *ProductGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(ProductGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.ProductGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *ProductGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.ProductGORM, error) {
+	if len(ids) == 0 {
+		return []*gorm.ProductGORM{}, nil
+	}
+
+	var out []*gorm.ProductGORM
+	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
+	return out, err
+}
+
+// LibraryGORMDAL provides database access helper methods for gorm.LibraryGORM.
+type LibraryGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.LibraryGORM) error
+}
+
+// NewLibraryGORMDAL creates a new LibraryGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewLibraryGORMDAL(tableName string) *LibraryGORMDAL {
+	return &LibraryGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *LibraryGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// Create creates a new gorm.LibraryGORM record.
+// Returns an error if the record already exists.
+func (d *LibraryGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.LibraryGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *LibraryGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+	result := d.db(db).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *LibraryGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.LibraryGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.LibraryGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *LibraryGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryGORM) error {
+	// Validate primary key(s)
+	if obj.Id == 0 {
+		return errors.New("primary key 'Id' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.LibraryGORM
+	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Save (create or update)
+	return d.db(db).Save(obj).Error
+}
+
+// Get retrieves a gorm.LibraryGORM record by primary key.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *LibraryGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.LibraryGORM, error) {
+	var out gorm.LibraryGORM
+	err := d.db(db).First(&out, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.LibraryGORM record by primary key.
+func (d *LibraryGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	return d.db(db).Where("id = ?", id).Delete(&gorm.LibraryGORM{}).Error
+}
+
+// List retrieves multiple gorm.LibraryGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *LibraryGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.LibraryGORM, error) {
+	var out []*gorm.LibraryGORM
+	err := d.db(query).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.LibraryGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *LibraryGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.LibraryGORM) error) error {
+	var batch []*gorm.LibraryGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with LibraryFromLibraryGORM
+// and calls fn with the resulting api.Library.
+func (d *LibraryGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Library) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.LibraryGORM) error {
+		msg, err := gorm.LibraryFromLibraryGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// LibraryGORMFilterSchema lists the api.Library fields that AIP-160
+// filters on LibraryGORM records may use, and their columns.
+var LibraryGORMFilterSchema = filtering.MustSchema((&api.Library{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":   "id",
+	"name": "name",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Library fields (see LibraryGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *LibraryGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(LibraryGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.LibraryGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
 func (d *LibraryGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.LibraryGORM, error) {
 	if len(ids) == 0 {
-		return []*gorm.LibraryGORM{}, nil
+		return []*gorm.LibraryGORM{}, nil
+	}
+
+	var out []*gorm.LibraryGORM
+	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
+	return out, err
+}
+
+// LibraryChildGORMDAL provides database access helper methods for gorm.LibraryChildGORM.
+type LibraryChildGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.LibraryChildGORM) error
+}
+
+// NewLibraryChildGORMDAL creates a new LibraryChildGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewLibraryChildGORMDAL(tableName string) *LibraryChildGORMDAL {
+	return &LibraryChildGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *LibraryChildGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// preload loads the child table rows of the records fetched with db, in list order.
+func (d *LibraryChildGORMDAL) preload(db *gormlib.DB) *gormlib.DB {
+	return db.
+		Preload("Contributors", func(db *gormlib.DB) *gormlib.DB {
+			return db.Order("ordinal")
+		})
+}
+
+// syncChildren writes the child table rows of obj within tx.
+// Each element is upserted at its list position and rows past the end of
+// the list are deleted, so the stored rows match obj exactly.
+func (d *LibraryChildGORMDAL) syncChildren(tx *gormlib.DB, obj *gorm.LibraryChildGORM) error {
+	// Child rows live in their own tables; drop the parent's table and conditions
+	tx = tx.Session(&gormlib.Session{NewDB: true})
+
+	for i := range obj.Contributors {
+		obj.Contributors[i].ParentID = obj.Id
+		obj.Contributors[i].Ordinal = i
+	}
+	if len(obj.Contributors) > 0 {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&obj.Contributors).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Where("parent_id = ? AND ordinal >= ?", obj.Id, len(obj.Contributors)).Delete(&gorm.LibraryChildGORMContributorsChild{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Create creates a new gorm.LibraryChildGORM record.
+// Returns an error if the record already exists.
+func (d *LibraryChildGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryChildGORM) error {
+	return d.db(db).Transaction(func(tx *gormlib.DB) error {
+		if err := tx.Omit(clause.Associations).Create(obj).Error; err != nil {
+			return err
+		}
+		return d.syncChildren(tx, obj)
+	})
+}
+
+// Update updates an existing gorm.LibraryChildGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *LibraryChildGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryChildGORM) error {
+	return d.db(db).Transaction(func(tx *gormlib.DB) error {
+		result := tx.Omit(clause.Associations).Updates(obj)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Check if record was found and updated
+		if result.RowsAffected == 0 {
+			return gormlib.ErrRecordNotFound
+		}
+
+		return d.syncChildren(tx, obj)
+	})
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// When anything changed, the whole record and its child table rows are
+// written with Update.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *LibraryChildGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.LibraryChildGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	if obj.Equal(old) {
+		return nil
+	}
+	return d.Update(ctx, db, obj)
+}
+
+// Save creates or updates a gorm.LibraryChildGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *LibraryChildGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.LibraryChildGORM) error {
+	// Validate primary key(s)
+	if obj.Id == 0 {
+		return errors.New("primary key 'Id' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.LibraryChildGORM
+	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Save (create or update)
+	return d.db(db).Transaction(func(tx *gormlib.DB) error {
+		if err := tx.Omit(clause.Associations).Save(obj).Error; err != nil {
+			return err
+		}
+		return d.syncChildren(tx, obj)
+	})
+}
+
+// Get retrieves a gorm.LibraryChildGORM record by primary key.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *LibraryChildGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.LibraryChildGORM, error) {
+	var out gorm.LibraryChildGORM
+	err := d.preload(d.db(db)).First(&out, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.LibraryChildGORM record by primary key.
+func (d *LibraryChildGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	return d.db(db).Transaction(func(tx *gormlib.DB) error {
+		children := tx.Session(&gormlib.Session{NewDB: true})
+		if err := children.Where("parent_id = ?", id).Delete(&gorm.LibraryChildGORMContributorsChild{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&gorm.LibraryChildGORM{}).Error
+	})
+}
+
+// List retrieves multiple gorm.LibraryChildGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *LibraryChildGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.LibraryChildGORM, error) {
+	var out []*gorm.LibraryChildGORM
+	err := d.preload(d.db(query)).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.LibraryChildGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *LibraryChildGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.LibraryChildGORM) error) error {
+	var batch []*gorm.LibraryChildGORM
+	return d.preload(d.db(query)).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with LibraryFromLibraryChildGORM
+// and calls fn with the resulting api.Library.
+func (d *LibraryChildGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Library) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.LibraryChildGORM) error {
+		msg, err := gorm.LibraryFromLibraryChildGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// LibraryChildGORMFilterSchema lists the api.Library fields that AIP-160
+// filters on LibraryChildGORM records may use, and their columns.
+var LibraryChildGORMFilterSchema = filtering.MustSchema((&api.Library{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":   "id",
+	"name": "name",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Library fields (see LibraryChildGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *LibraryChildGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(LibraryChildGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.LibraryChildGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *LibraryChildGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.LibraryChildGORM, error) {
+	if len(ids) == 0 {
+		return []*gorm.LibraryChildGORM{}, nil
+	}
+
+	var out []*gorm.LibraryChildGORM
+	err := d.preload(d.db(db)).Where("id IN ?", ids).Find(&out).Error
+	return out, err
+}
+
+// TenantUserGORMDAL provides database access helper methods for gorm.TenantUserGORM.
+type TenantUserGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.TenantUserGORM) error
+
+	// TenantExtractor reads the tenant of each operation from its context.
+	// If nil, uses tenant.DefaultExtractor.
+	TenantExtractor tenant.Extractor
+}
+
+// NewTenantUserGORMDAL creates a new TenantUserGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewTenantUserGORMDAL(tableName string) *TenantUserGORMDAL {
+	return &TenantUserGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *TenantUserGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// Create creates a new gorm.TenantUserGORM record.
+// Returns an error if the record already exists.
+func (d *TenantUserGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+	obj.TenantId = tenantID
+
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.TenantUserGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist in the context's tenant.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *TenantUserGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+	obj.TenantId = tenantID
+
+	result := d.db(db).Where("tenant_id = ?", tenantID).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist in the context's tenant.
+func (d *TenantUserGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.TenantUserGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+	obj.TenantId = tenantID
+
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Where("tenant_id = ?", tenantID).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.TenantUserGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// Returns tenant.ErrCrossTenant if the record belongs to another tenant.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *TenantUserGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+	obj.TenantId = tenantID
+
+	// Validate primary key(s)
+	if obj.Id == 0 {
+		return errors.New("primary key 'Id' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.TenantUserGORM
+	err = d.db(db).First(&existing, "id = ?", obj.Id).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Never overwrite another tenant's record
+	if err == nil && existing.TenantId != tenantID {
+		return tenant.ErrCrossTenant
+	}
+
+	// Save (create or update)
+	return d.db(db).Where("tenant_id = ?", tenantID).Save(obj).Error
+}
+
+// Get retrieves a gorm.TenantUserGORM record by primary key.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *TenantUserGORMDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.TenantUserGORM, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	var out gorm.TenantUserGORM
+	err = d.db(db).Where("tenant_id = ?", tenantID).First(&out, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.TenantUserGORM record by primary key.
+func (d *TenantUserGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+
+	return d.db(db).Where("tenant_id = ?", tenantID).Where("id = ?", id).Delete(&gorm.TenantUserGORM{}).Error
+}
+
+// List retrieves multiple gorm.TenantUserGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *TenantUserGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.TenantUserGORM, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*gorm.TenantUserGORM
+	err = d.db(query).Where("tenant_id = ?", tenantID).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.TenantUserGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *TenantUserGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.TenantUserGORM) error) error {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return err
+	}
+
+	var batch []*gorm.TenantUserGORM
+	return d.db(query).Where("tenant_id = ?", tenantID).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with UserFromTenantUserGORM
+// and calls fn with the resulting api.User.
+func (d *TenantUserGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.User) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.TenantUserGORM) error {
+		msg, err := gorm.UserFromTenantUserGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// TenantUserGORMFilterSchema lists the api.User fields that AIP-160
+// filters on TenantUserGORM records may use, and their columns.
+var TenantUserGORMFilterSchema = filtering.MustSchema((&api.User{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":            "id",
+	"name":          "name",
+	"email":         "email",
+	"age":           "age",
+	"birthday":      "birthday",
+	"member_number": "member_number",
+	"activated_at":  "activated_at",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.User fields (see TenantUserGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *TenantUserGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(TenantUserGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.TenantUserGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *TenantUserGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.TenantUserGORM, error) {
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*gorm.TenantUserGORM{}, nil
 	}
 
-	var out []*gorm.LibraryGORM
+	var out []*gorm.TenantUserGORM
+	err = d.db(db).Where("tenant_id = ?", tenantID).Where("id IN ?", ids).Find(&out).Error
+	return out, err
+}
+
+// TenantUserGORMCachedDAL is a TenantUserGORMDAL whose Get and BatchGet read through
+// Cache. Records are cached as serialized api.User messages (see
+// UserFromTenantUserGORM), so columns that api.User lacks read back from
+// the cache as zero values.
+// Create, Update, Save and Delete invalidate the cached records they change
+// and return the cache's error if that fails. Changes made elsewhere (and
+// writes racing with a read) are only seen once cached records expire.
+// Other methods are the embedded TenantUserGORMDAL's and bypass the cache.
+type TenantUserGORMCachedDAL struct {
+	TenantUserGORMDAL
+
+	// Cache stores the records. Read errors fall back to the database.
+	Cache cache.Cache
+
+	// TTL is how long records stay cached. Zero keeps them until evicted.
+	TTL time.Duration
+
+	// NegativeTTL is how long records that were not found stay cached as
+	// not found. Zero does not cache missing records.
+	NegativeTTL time.Duration
+
+	// KeyPrefix prefixes the cache keys. If empty, uses "TenantUserGORM".
+	KeyPrefix string
+}
+
+// NewTenantUserGORMCachedDAL creates a new TenantUserGORMCachedDAL caching records in c.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewTenantUserGORMCachedDAL(tableName string, c cache.Cache) *TenantUserGORMCachedDAL {
+	return &TenantUserGORMCachedDAL{TenantUserGORMDAL: TenantUserGORMDAL{TableName: tableName}, Cache: c}
+}
+
+// cacheKey returns the cache key of the record with the given primary key
+// in the context's tenant.
+func (d *TenantUserGORMCachedDAL) cacheKey(ctx context.Context, id uint32) (string, error) {
+	prefix := d.KeyPrefix
+	if prefix == "" {
+		prefix = "TenantUserGORM"
+	}
+	tenantID, err := tenant.Get(ctx, d.TenantExtractor)
+	if err != nil {
+		return "", err
+	}
+	return cache.Key(prefix, d.TableName, tenantID, id), nil
+}
+
+// cached returns the record cached under key, and whether key was cached.
+// A cached not-found result is returned as (nil, true).
+func (d *TenantUserGORMCachedDAL) cached(ctx context.Context, key string) (*gorm.TenantUserGORM, bool) {
+	data, ok, err := d.Cache.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false
+	}
+	var msg api.User
+	found, err := cache.Decode(data, &msg)
+	if err != nil {
+		return nil, false
+	}
+	if !found {
+		return nil, true
+	}
+	obj, err := gorm.UserToTenantUserGORM(&msg, nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	obj.TenantId, _ = tenant.Get(ctx, d.TenantExtractor)
+	return obj, true
+}
+
+// store caches obj under key, or a not-found result if obj is nil and
+// NegativeTTL is set. Records that fail to convert are not cached.
+func (d *TenantUserGORMCachedDAL) store(ctx context.Context, key string, obj *gorm.TenantUserGORM) {
+	if obj == nil {
+		if d.NegativeTTL > 0 {
+			data, _ := cache.Encode(nil)
+			d.Cache.Set(ctx, key, data, d.NegativeTTL)
+		}
+		return
+	}
+	msg, err := gorm.UserFromTenantUserGORM(nil, obj, nil)
+	if err != nil {
+		return
+	}
+	data, err := cache.Encode(msg)
+	if err != nil {
+		return
+	}
+	d.Cache.Set(ctx, key, data, d.TTL)
+}
+
+// invalidate removes the cached record with the given primary key.
+func (d *TenantUserGORMCachedDAL) invalidate(ctx context.Context, id uint32) error {
+	key, err := d.cacheKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	return d.Cache.Delete(ctx, key)
+}
+
+// Create creates a new gorm.TenantUserGORM record and invalidates its cached not-found result.
+func (d *TenantUserGORMCachedDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	if err := d.TenantUserGORMDAL.Create(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.Id)
+}
+
+// Update updates an existing gorm.TenantUserGORM record and invalidates its cached copy.
+func (d *TenantUserGORMCachedDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	if err := d.TenantUserGORMDAL.Update(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.Id)
+}
+
+// UpdateChanged updates the changed columns of a gorm.TenantUserGORM record and invalidates
+// its cached copy. Nothing is written or invalidated when nothing changed.
+func (d *TenantUserGORMCachedDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.TenantUserGORM) error {
+	if old != nil && obj.Equal(old) {
+		return nil
+	}
+	if err := d.TenantUserGORMDAL.UpdateChanged(ctx, db, old, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.Id)
+}
+
+// Save creates or updates a gorm.TenantUserGORM record (upsert) and invalidates its cached copy.
+func (d *TenantUserGORMCachedDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.TenantUserGORM) error {
+	if err := d.TenantUserGORMDAL.Save(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.Id)
+}
+
+// Delete removes a gorm.TenantUserGORM record by primary key and invalidates its cached copy.
+func (d *TenantUserGORMCachedDAL) Delete(ctx context.Context, db *gormlib.DB, id uint32) error {
+	if err := d.TenantUserGORMDAL.Delete(ctx, db, id); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, id)
+}
+
+// Get retrieves a gorm.TenantUserGORM record by primary key, reading through the cache.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *TenantUserGORMCachedDAL) Get(ctx context.Context, db *gormlib.DB, id uint32) (*gorm.TenantUserGORM, error) {
+	key, err := d.cacheKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := d.cached(ctx, key); ok {
+		return obj, nil
+	}
+	obj, err := d.TenantUserGORMDAL.Get(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	d.store(ctx, key, obj)
+	return obj, nil
+}
+
+// BatchGet retrieves multiple gorm.TenantUserGORM records by primary key, reading through
+// the cache and loading only the uncached records from the database.
+// Cached records come first, followed by the loaded records in database order.
+func (d *TenantUserGORMCachedDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.TenantUserGORM, error) {
+	out := []*gorm.TenantUserGORM{}
+	var missing []uint32
+	var missingKeys []string
+	for _, pk := range ids {
+		key, err := d.cacheKey(ctx, pk)
+		if err != nil {
+			return nil, err
+		}
+		if obj, ok := d.cached(ctx, key); ok {
+			if obj != nil {
+				out = append(out, obj)
+			}
+			continue
+		}
+		missing = append(missing, pk)
+		missingKeys = append(missingKeys, key)
+	}
+	if len(missing) == 0 {
+		return out, nil
+	}
+
+	loaded, err := d.TenantUserGORMDAL.BatchGet(ctx, db, missing)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]bool, len(loaded))
+	for _, obj := range loaded {
+		key, err := d.cacheKey(ctx, obj.Id)
+		if err != nil {
+			return nil, err
+		}
+		d.store(ctx, key, obj)
+		stored[key] = true
+	}
+	for _, key := range missingKeys {
+		if !stored[key] {
+			d.store(ctx, key, nil)
+		}
+	}
+	return append(out, loaded...), nil
+}
+
+// NoteGORMDAL provides database access helper methods for gorm.NoteGORM.
+type NoteGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.NoteGORM) error
+
+	// ActorExtractor reads the actor of each write from its context for the
+	// audit columns. If nil, uses audit.DefaultExtractor.
+	ActorExtractor audit.Extractor
+
+	// Clock returns the time of each write for the audit columns.
+	// If nil, uses audit.DefaultClock.
+	Clock audit.Clock
+}
+
+// NewNoteGORMDAL creates a new NoteGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewNoteGORMDAL(tableName string) *NoteGORMDAL {
+	return &NoteGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *NoteGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// stampAudit fills the audit columns of obj before a write.
+// The created_* columns are only set when creating obj.
+func (d *NoteGORMDAL) stampAudit(ctx context.Context, obj *gorm.NoteGORM, creating bool) {
+	now := audit.Now(d.Clock)
+	actor := audit.Actor(ctx, d.ActorExtractor)
+	if creating {
+		obj.CreatedAt = now
+		obj.CreatedBy = actor
+	}
+	obj.UpdatedAt = now
+	obj.UpdatedBy = actor
+}
+
+// Create creates a new gorm.NoteGORM record.
+// Returns an error if the record already exists.
+func (d *NoteGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.NoteGORM) error {
+	d.stampAudit(ctx, obj, true)
+
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.NoteGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *NoteGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.NoteGORM) error {
+	d.stampAudit(ctx, obj, false)
+
+	result := d.db(db).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns (and the updated_* audit columns) are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *NoteGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.NoteGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+	d.stampAudit(ctx, obj, false)
+	columns = append(columns, "updated_at")
+	columns = append(columns, "updated_by")
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.NoteGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *NoteGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.NoteGORM) error {
+	// Check if record exists by trying to fetch it
+	var existing gorm.NoteGORM
+	err := d.db(db).First(&existing, "id = ?", obj.Id).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			d.stampAudit(ctx, obj, true)
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Keep the existing record's creation audit
+	if err == nil {
+		obj.CreatedAt = existing.CreatedAt
+		obj.CreatedBy = existing.CreatedBy
+		d.stampAudit(ctx, obj, false)
+	}
+
+	// Save (create or update)
+	return d.db(db).Save(obj).Error
+}
+
+// Get retrieves a gorm.NoteGORM record by primary key.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *NoteGORMDAL) Get(ctx context.Context, db *gormlib.DB, id gorm.NoteID) (*gorm.NoteGORM, error) {
+	var out gorm.NoteGORM
+	err := d.db(db).First(&out, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.NoteGORM record by primary key.
+func (d *NoteGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id gorm.NoteID) error {
+	return d.db(db).Where("id = ?", id).Delete(&gorm.NoteGORM{}).Error
+}
+
+// List retrieves multiple gorm.NoteGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *NoteGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.NoteGORM, error) {
+	var out []*gorm.NoteGORM
+	err := d.db(query).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.NoteGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *NoteGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.NoteGORM) error) error {
+	var batch []*gorm.NoteGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with NoteFromNoteGORM
+// and calls fn with the resulting api.Note.
+func (d *NoteGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Note) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.NoteGORM) error {
+		msg, err := gorm.NoteFromNoteGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// NoteGORMFilterSchema lists the api.Note fields that AIP-160
+// filters on NoteGORM records may use, and their columns.
+var NoteGORMFilterSchema = filtering.MustSchema((&api.Note{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":         "id",
+	"text":       "text",
+	"created_by": "created_by",
+	"updated_by": "updated_by",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Note fields (see NoteGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *NoteGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(NoteGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.NoteGORM records by primary key.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *NoteGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []gorm.NoteID) ([]*gorm.NoteGORM, error) {
+	if len(ids) == 0 {
+		return []*gorm.NoteGORM{}, nil
+	}
+
+	var out []*gorm.NoteGORM
 	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
 	return out, err
 }
@@ -1062,6 +3162,30 @@ func (d *OrganizationGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *g
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *OrganizationGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.OrganizationGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.OrganizationGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -1122,6 +3246,63 @@ func (d *OrganizationGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*g
 	return out, err
 }
 
+// Iterate calls fn for each gorm.OrganizationGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *OrganizationGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.OrganizationGORM) error) error {
+	var batch []*gorm.OrganizationGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with OrganizationFromOrganizationGORM
+// and calls fn with the resulting api.Organization.
+func (d *OrganizationGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*api.Organization) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.OrganizationGORM) error {
+		msg, err := gorm.OrganizationFromOrganizationGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// OrganizationGORMFilterSchema lists the api.Organization fields that AIP-160
+// filters on OrganizationGORM records may use, and their columns.
+var OrganizationGORMFilterSchema = filtering.MustSchema((&api.Organization{}).ProtoReflect().Descriptor(), map[string]string{
+	"id":   "id",
+	"name": "name",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on api.Organization fields (see OrganizationGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *OrganizationGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(OrganizationGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.OrganizationGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *OrganizationGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []uint32) ([]*gorm.OrganizationGORM, error) {
@@ -4,7 +4,12 @@ package dal
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/panyam/protoc-gen-dal/pkg/cache"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	"github.com/panyam/protoc-gen-dal/pkg/idgen"
+	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
 	gorm "github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	gormlib "gorm.io/gorm"
 )
@@ -62,6 +67,30 @@ func (d *WorldGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.Wor
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *WorldGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.WorldGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.WorldGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -122,6 +151,69 @@ func (d *WorldGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.Wor
 	return out, err
 }
 
+// Iterate calls fn for each gorm.WorldGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *WorldGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.WorldGORM) error) error {
+	var batch []*gorm.WorldGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with WorldFromWorldGORM
+// and calls fn with the resulting v1.World.
+func (d *WorldGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*v1.World) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.WorldGORM) error {
+		msg, err := gorm.WorldFromWorldGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// WorldGORMFilterSchema lists the v1.World fields that AIP-160
+// filters on WorldGORM records may use, and their columns.
+var WorldGORMFilterSchema = filtering.MustSchema((&v1.World{}).ProtoReflect().Descriptor(), map[string]string{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"id":          "id",
+	"creator_id":  "creator_id",
+	"name":        "name",
+	"description": "description",
+	"image_url":   "image_url",
+	"difficulty":  "difficulty",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on v1.World fields (see WorldGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *WorldGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(WorldGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.WorldGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *WorldGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []string) ([]*gorm.WorldGORM, error) {
@@ -187,6 +279,30 @@ func (d *WorldDataGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *WorldDataGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.WorldDataGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.WorldDataGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
@@ -247,6 +363,38 @@ func (d *WorldDataGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm
 	return out, err
 }
 
+// Iterate calls fn for each gorm.WorldDataGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *WorldDataGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.WorldDataGORM) error) error {
+	var batch []*gorm.WorldDataGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with WorldDataFromWorldDataGORM
+// and calls fn with the resulting v1.WorldData.
+func (d *WorldDataGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*v1.WorldData) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.WorldDataGORM) error {
+		msg, err := gorm.WorldDataFromWorldDataGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
 // BatchGet retrieves multiple gorm.WorldDataGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
 func (d *WorldDataGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, worldIds []string) ([]*gorm.WorldDataGORM, error) {
@@ -268,6 +416,10 @@ type GameGORMDAL struct {
 	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
 	// Return an error to prevent creation.
 	WillCreate func(context.Context, *gorm.GameGORM) error
+
+	// IDGenerator creates the IDs of empty generated primary keys.
+	// If nil, uses idgen.DefaultGenerator.
+	IDGenerator idgen.Generator
 }
 
 // NewGameGORMDAL creates a new GameGORMDAL instance.
@@ -287,9 +439,26 @@ func (d *GameGORMDAL) db(db *gormlib.DB) *gormlib.DB {
 	return db
 }
 
+// assignIDs fills the empty generated primary keys of obj (id_strategy).
+func (d *GameGORMDAL) assignIDs(ctx context.Context, obj *gorm.GameGORM) error {
+	if obj.Id == "" {
+		id, err := idgen.String(ctx, d.IDGenerator, idgen.ULID)
+		if err != nil {
+			return err
+		}
+		obj.Id = gorm.GameID(id)
+	}
+	return nil
+}
+
 // Create creates a new gorm.GameGORM record.
+// Empty generated primary keys are filled first (see IDGenerator).
 // Returns an error if the record already exists.
 func (d *GameGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.GameGORM) error {
+	if err := d.assignIDs(ctx, obj); err != nil {
+		return err
+	}
+
 	return d.db(db).Create(obj).Error
 }
 
@@ -312,15 +481,39 @@ func (d *GameGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.Game
 	return nil
 }
 
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *GameGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.GameGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Save creates or updates a gorm.GameGORM record (upsert).
 // If the record doesn't exist, it will call WillCreate hook before saving.
+// Empty generated primary keys are filled first (see IDGenerator).
 // For conditional updates (optimistic locking), pass a db with WHERE conditions:
 //
 //	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
 func (d *GameGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.GameGORM) error {
-	// Validate primary key(s)
-	if obj.Id == "" {
-		return errors.New("primary key 'Id' cannot be empty")
+	if err := d.assignIDs(ctx, obj); err != nil {
+		return err
 	}
 
 	// Check if record exists by trying to fetch it
@@ -347,7 +540,7 @@ func (d *GameGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.GameGO
 
 // Get retrieves a gorm.GameGORM record by primary key.
 // Returns (nil, nil) if the record is not found (not an error).
-func (d *GameGORMDAL) Get(ctx context.Context, db *gormlib.DB, id string) (*gorm.GameGORM, error) {
+func (d *GameGORMDAL) Get(ctx context.Context, db *gormlib.DB, id gorm.GameID) (*gorm.GameGORM, error) {
 	var out gorm.GameGORM
 	err := d.db(db).First(&out, "id = ?", id).Error
 	if err != nil {
@@ -360,7 +553,7 @@ func (d *GameGORMDAL) Get(ctx context.Context, db *gormlib.DB, id string) (*gorm
 }
 
 // Delete removes a gorm.GameGORM record by primary key.
-func (d *GameGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id string) error {
+func (d *GameGORMDAL) Delete(ctx context.Context, db *gormlib.DB, id gorm.GameID) error {
 	return d.db(db).Where("id = ?", id).Delete(&gorm.GameGORM{}).Error
 }
 
@@ -372,9 +565,73 @@ func (d *GameGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.Game
 	return out, err
 }
 
+// Iterate calls fn for each gorm.GameGORM record matching query, loading
+// batchSize records at a time so large result sets are never held in memory.
+// Records are visited in primary key order, which batching relies on, so
+// query must not set its own order.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *GameGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.GameGORM) error) error {
+	var batch []*gorm.GameGORM
+	return d.db(query).FindInBatches(&batch, batchSize, func(tx *gormlib.DB, _ int) error {
+		for _, obj := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+// IterateAPI is like Iterate but converts each record with GameFromGameGORM
+// and calls fn with the resulting v1.Game.
+func (d *GameGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*v1.Game) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.GameGORM) error {
+		msg, err := gorm.GameFromGameGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// GameGORMFilterSchema lists the v1.Game fields that AIP-160
+// filters on GameGORM records may use, and their columns.
+var GameGORMFilterSchema = filtering.MustSchema((&v1.Game{}).ProtoReflect().Descriptor(), map[string]string{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"id":          "id",
+	"creator_id":  "creator_id",
+	"world_id":    "world_id",
+	"name":        "name",
+	"description": "description",
+	"image_url":   "image_url",
+	"difficulty":  "difficulty",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on v1.Game fields (see GameGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *GameGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(GameGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
 // BatchGet retrieves multiple gorm.GameGORM records by primary key.
 // Results are returned in the order provided by the database (not necessarily the input order).
-func (d *GameGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []string) ([]*gorm.GameGORM, error) {
+func (d *GameGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []gorm.GameID) ([]*gorm.GameGORM, error) {
 	if len(ids) == 0 {
 		return []*gorm.GameGORM{}, nil
 	}
@@ -383,3 +640,437 @@ func (d *GameGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, ids []string
 	err := d.db(db).Where("id IN ?", ids).Find(&out).Error
 	return out, err
 }
+
+// GameMoveKey represents the composite primary key for gorm.GameMoveGORM
+type GameMoveKey struct {
+	GameId      gorm.GameID
+	GroupNumber string
+	MoveNumber  int32
+}
+
+// GameMoveGORMDAL provides database access helper methods for gorm.GameMoveGORM.
+type GameMoveGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.GameMoveGORM) error
+}
+
+// NewGameMoveGORMDAL creates a new GameMoveGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewGameMoveGORMDAL(tableName string) *GameMoveGORMDAL {
+	return &GameMoveGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *GameMoveGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// Create creates a new gorm.GameMoveGORM record.
+// Returns an error if the record already exists.
+func (d *GameMoveGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.GameMoveGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *GameMoveGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	result := d.db(db).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateChanged updates the record obj was read as, old, with the values of obj
+// that differ from it, and skips the write entirely when nothing changed.
+// Only the changed columns are written.
+// A nil old updates every column with Update.
+// Returns ErrRecordNotFound if the record doesn't exist.
+func (d *GameMoveGORMDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.GameMoveGORM) error {
+	if old == nil {
+		return d.Update(ctx, db, obj)
+	}
+	columns := obj.ChangedColumns(old)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := d.db(db).Select(columns).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Save creates or updates a gorm.GameMoveGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *GameMoveGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	// Validate primary key(s)
+	if obj.GameId == "" {
+		return errors.New("primary key 'GameId' cannot be empty")
+	}
+	if obj.GroupNumber == "" {
+		return errors.New("primary key 'GroupNumber' cannot be empty")
+	}
+	if obj.MoveNumber == 0 {
+		return errors.New("primary key 'MoveNumber' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.GameMoveGORM
+	err := d.db(db).First(&existing, "game_id = ?", "group_number = ?", "move_number = ?", obj.GameId, obj.GroupNumber, obj.MoveNumber).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Save (create or update)
+	return d.db(db).Save(obj).Error
+}
+
+// Get retrieves a gorm.GameMoveGORM record by primary keys.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *GameMoveGORMDAL) Get(ctx context.Context, db *gormlib.DB, gameId gorm.GameID, groupNumber string, moveNumber int32) (*gorm.GameMoveGORM, error) {
+	var out gorm.GameMoveGORM
+	err := d.db(db).First(&out, "game_id = ? AND group_number = ? AND move_number = ?", gameId, groupNumber, moveNumber).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.GameMoveGORM record by primary keys.
+func (d *GameMoveGORMDAL) Delete(ctx context.Context, db *gormlib.DB, gameId gorm.GameID, groupNumber string, moveNumber int32) error {
+	return d.db(db).Where("game_id = ? AND group_number = ? AND move_number = ?", gameId, groupNumber, moveNumber).Delete(&gorm.GameMoveGORM{}).Error
+}
+
+// List retrieves multiple gorm.GameMoveGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *GameMoveGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.GameMoveGORM, error) {
+	var out []*gorm.GameMoveGORM
+	err := d.db(query).Find(&out).Error
+	return out, err
+}
+
+// Iterate calls fn for each gorm.GameMoveGORM record matching query, streaming
+// rows from the database so large result sets are never held in memory.
+// batchSize is unused: batching needs a single-column primary key.
+// Iteration stops at the first error from fn or ctx, which Iterate returns.
+func (d *GameMoveGORMDAL) Iterate(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*gorm.GameMoveGORM) error) error {
+	rows, err := d.db(query).Model(&gorm.GameMoveGORM{}).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var obj gorm.GameMoveGORM
+		if err := query.ScanRows(rows, &obj); err != nil {
+			return err
+		}
+		if err := fn(&obj); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// IterateAPI is like Iterate but converts each record with GameMoveFromGameMoveGORM
+// and calls fn with the resulting v1.GameMove.
+func (d *GameMoveGORMDAL) IterateAPI(ctx context.Context, query *gormlib.DB, batchSize int, fn func(*v1.GameMove) error) error {
+	return d.Iterate(ctx, query, batchSize, func(obj *gorm.GameMoveGORM) error {
+		msg, err := gorm.GameMoveFromGameMoveGORM(nil, obj, nil)
+		if err != nil {
+			return err
+		}
+		return fn(msg)
+	})
+}
+
+// GameMoveGORMFilterSchema lists the v1.GameMove fields that AIP-160
+// filters on GameMoveGORM records may use, and their columns.
+var GameMoveGORMFilterSchema = filtering.MustSchema((&v1.GameMove{}).ProtoReflect().Descriptor(), map[string]string{
+	"player":       "player",
+	"timestamp":    "timestamp",
+	"sequence_num": "sequence_num",
+	"is_permanent": "is_permanent",
+})
+
+// FilterScope returns a scope (for Scopes) restricting a query to the records
+// matching an AIP-160 filter on v1.GameMove fields (see GameMoveGORMFilterSchema).
+// An empty filter matches all records. Invalid filters are returned as a
+// *filtering.Error.
+func (d *GameMoveGORMDAL) FilterScope(filter string) (func(*gormlib.DB) *gormlib.DB, error) {
+	expr, err := filtering.Parse(GameMoveGORMFilterSchema, filter)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gormlib.DB) *gormlib.DB {
+		if expr == nil {
+			return db
+		}
+		cond, args := filtering.SQL(expr)
+		return db.Where(cond, args...)
+	}, nil
+}
+
+// BatchGet retrieves multiple gorm.GameMoveGORM records by primary keys.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *GameMoveGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, keys []GameMoveKey) ([]*gorm.GameMoveGORM, error) {
+	if len(keys) == 0 {
+		return []*gorm.GameMoveGORM{}, nil
+	}
+
+	// Build OR query for each key combination
+	query := d.db(db).Where("1 = 0") // Start with false condition
+	for _, key := range keys {
+		query = query.Or("game_id = ? AND group_number = ? AND move_number = ?", key.GameId, key.GroupNumber, key.MoveNumber)
+	}
+
+	var out []*gorm.GameMoveGORM
+	err := query.Find(&out).Error
+	return out, err
+}
+
+// GameMoveGORMCachedDAL is a GameMoveGORMDAL whose Get and BatchGet read through
+// Cache. Records are cached as serialized v1.GameMove messages (see
+// GameMoveFromGameMoveGORM), so columns that v1.GameMove lacks read back from
+// the cache as zero values.
+// Create, Update, Save and Delete invalidate the cached records they change
+// and return the cache's error if that fails. Changes made elsewhere (and
+// writes racing with a read) are only seen once cached records expire.
+// Other methods are the embedded GameMoveGORMDAL's and bypass the cache.
+type GameMoveGORMCachedDAL struct {
+	GameMoveGORMDAL
+
+	// Cache stores the records. Read errors fall back to the database.
+	Cache cache.Cache
+
+	// TTL is how long records stay cached. Zero keeps them until evicted.
+	TTL time.Duration
+
+	// NegativeTTL is how long records that were not found stay cached as
+	// not found. Zero does not cache missing records.
+	NegativeTTL time.Duration
+
+	// KeyPrefix prefixes the cache keys. If empty, uses "GameMoveGORM".
+	KeyPrefix string
+}
+
+// NewGameMoveGORMCachedDAL creates a new GameMoveGORMCachedDAL caching records in c.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewGameMoveGORMCachedDAL(tableName string, c cache.Cache) *GameMoveGORMCachedDAL {
+	return &GameMoveGORMCachedDAL{GameMoveGORMDAL: GameMoveGORMDAL{TableName: tableName}, Cache: c}
+}
+
+// cacheKey returns the cache key of the record with the given primary keys.
+func (d *GameMoveGORMCachedDAL) cacheKey(ctx context.Context, gameId gorm.GameID, groupNumber string, moveNumber int32) (string, error) {
+	prefix := d.KeyPrefix
+	if prefix == "" {
+		prefix = "GameMoveGORM"
+	}
+	return cache.Key(prefix, d.TableName, gameId, groupNumber, moveNumber), nil
+}
+
+// cached returns the record cached under key, and whether key was cached.
+// A cached not-found result is returned as (nil, true).
+func (d *GameMoveGORMCachedDAL) cached(ctx context.Context, key string) (*gorm.GameMoveGORM, bool) {
+	data, ok, err := d.Cache.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false
+	}
+	var msg v1.GameMove
+	found, err := cache.Decode(data, &msg)
+	if err != nil {
+		return nil, false
+	}
+	if !found {
+		return nil, true
+	}
+	obj, err := gorm.GameMoveToGameMoveGORM(&msg, nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	return obj, true
+}
+
+// store caches obj under key, or a not-found result if obj is nil and
+// NegativeTTL is set. Records that fail to convert are not cached.
+func (d *GameMoveGORMCachedDAL) store(ctx context.Context, key string, obj *gorm.GameMoveGORM) {
+	if obj == nil {
+		if d.NegativeTTL > 0 {
+			data, _ := cache.Encode(nil)
+			d.Cache.Set(ctx, key, data, d.NegativeTTL)
+		}
+		return
+	}
+	msg, err := gorm.GameMoveFromGameMoveGORM(nil, obj, nil)
+	if err != nil {
+		return
+	}
+	data, err := cache.Encode(msg)
+	if err != nil {
+		return
+	}
+	d.Cache.Set(ctx, key, data, d.TTL)
+}
+
+// invalidate removes the cached record with the given primary keys.
+func (d *GameMoveGORMCachedDAL) invalidate(ctx context.Context, gameId gorm.GameID, groupNumber string, moveNumber int32) error {
+	key, err := d.cacheKey(ctx, gameId, groupNumber, moveNumber)
+	if err != nil {
+		return err
+	}
+	return d.Cache.Delete(ctx, key)
+}
+
+// Create creates a new gorm.GameMoveGORM record and invalidates its cached not-found result.
+func (d *GameMoveGORMCachedDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	if err := d.GameMoveGORMDAL.Create(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.GameId, obj.GroupNumber, obj.MoveNumber)
+}
+
+// Update updates an existing gorm.GameMoveGORM record and invalidates its cached copy.
+func (d *GameMoveGORMCachedDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	if err := d.GameMoveGORMDAL.Update(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.GameId, obj.GroupNumber, obj.MoveNumber)
+}
+
+// UpdateChanged updates the changed columns of a gorm.GameMoveGORM record and invalidates
+// its cached copy. Nothing is written or invalidated when nothing changed.
+func (d *GameMoveGORMCachedDAL) UpdateChanged(ctx context.Context, db *gormlib.DB, old, obj *gorm.GameMoveGORM) error {
+	if old != nil && obj.Equal(old) {
+		return nil
+	}
+	if err := d.GameMoveGORMDAL.UpdateChanged(ctx, db, old, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.GameId, obj.GroupNumber, obj.MoveNumber)
+}
+
+// Save creates or updates a gorm.GameMoveGORM record (upsert) and invalidates its cached copy.
+func (d *GameMoveGORMCachedDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.GameMoveGORM) error {
+	if err := d.GameMoveGORMDAL.Save(ctx, db, obj); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, obj.GameId, obj.GroupNumber, obj.MoveNumber)
+}
+
+// Delete removes a gorm.GameMoveGORM record by primary keys and invalidates its cached copy.
+func (d *GameMoveGORMCachedDAL) Delete(ctx context.Context, db *gormlib.DB, gameId gorm.GameID, groupNumber string, moveNumber int32) error {
+	if err := d.GameMoveGORMDAL.Delete(ctx, db, gameId, groupNumber, moveNumber); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, gameId, groupNumber, moveNumber)
+}
+
+// Get retrieves a gorm.GameMoveGORM record by primary keys, reading through the cache.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *GameMoveGORMCachedDAL) Get(ctx context.Context, db *gormlib.DB, gameId gorm.GameID, groupNumber string, moveNumber int32) (*gorm.GameMoveGORM, error) {
+	key, err := d.cacheKey(ctx, gameId, groupNumber, moveNumber)
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := d.cached(ctx, key); ok {
+		return obj, nil
+	}
+	obj, err := d.GameMoveGORMDAL.Get(ctx, db, gameId, groupNumber, moveNumber)
+	if err != nil {
+		return nil, err
+	}
+	d.store(ctx, key, obj)
+	return obj, nil
+}
+
+// BatchGet retrieves multiple gorm.GameMoveGORM records by primary keys, reading through
+// the cache and loading only the uncached records from the database.
+// Cached records come first, followed by the loaded records in database order.
+func (d *GameMoveGORMCachedDAL) BatchGet(ctx context.Context, db *gormlib.DB, keys []GameMoveKey) ([]*gorm.GameMoveGORM, error) {
+	out := []*gorm.GameMoveGORM{}
+	var missing []GameMoveKey
+	var missingKeys []string
+	for _, pk := range keys {
+		key, err := d.cacheKey(ctx, pk.GameId, pk.GroupNumber, pk.MoveNumber)
+		if err != nil {
+			return nil, err
+		}
+		if obj, ok := d.cached(ctx, key); ok {
+			if obj != nil {
+				out = append(out, obj)
+			}
+			continue
+		}
+		missing = append(missing, pk)
+		missingKeys = append(missingKeys, key)
+	}
+	if len(missing) == 0 {
+		return out, nil
+	}
+
+	loaded, err := d.GameMoveGORMDAL.BatchGet(ctx, db, missing)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]bool, len(loaded))
+	for _, obj := range loaded {
+		key, err := d.cacheKey(ctx, obj.GameId, obj.GroupNumber, obj.MoveNumber)
+		if err != nil {
+			return nil, err
+		}
+		d.store(ctx, key, obj)
+		stored[key] = true
+	}
+	for _, key := range missingKeys {
+		if !stored[key] {
+			d.store(ctx, key, nil)
+		}
+	}
+	return append(out, loaded...), nil
+}
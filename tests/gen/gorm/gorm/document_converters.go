@@ -2,20 +2,64 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 )
 
+// conversionDocumentToDocumentGormEmpty describes DocumentToDocumentGormEmpty in conversion errors.
+var conversionDocumentToDocumentGormEmpty = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentGormEmpty",
+}
+
+// conversionDocumentFromDocumentGormEmpty describes DocumentFromDocumentGormEmpty in conversion errors.
+var conversionDocumentFromDocumentGormEmpty = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentGormEmpty",
+	TargetType: "api.Document",
+}
+
 // DocumentToDocumentGormEmpty converts a api.Document to DocumentGormEmpty.
 // The optional decorator function allows custom field transformations.
 func DocumentToDocumentGormEmpty(
 	src *api.Document,
 	dest *DocumentGormEmpty,
 	decorator func(*api.Document, *DocumentGormEmpty) error,
+) (out *DocumentGormEmpty, err error) {
+	out, err = DocumentToDocumentGormEmptyWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DocumentToDocumentGormEmptyWithOptions is DocumentToDocumentGormEmpty without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentToDocumentGormEmptyWithOptions(
+	ctx context.Context,
+	src *api.Document,
+	dest *DocumentGormEmpty,
+	opts ...converters.ConvertOption,
 ) (out *DocumentGormEmpty, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentGormEmpty{}
 	}
@@ -40,26 +84,45 @@ func DocumentToDocumentGormEmpty(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// DocumentFromDocumentGormEmpty converts a DocumentGormEmpty back to api.Document.
+// The optional decorator function allows custom field transformations.
+func DocumentFromDocumentGormEmpty(
+	dest *api.Document,
+	src *DocumentGormEmpty,
+	decorator func(dest *api.Document, src *DocumentGormEmpty) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentGormEmptyWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// DocumentFromDocumentGormEmpty converts a DocumentGormEmpty back to api.Document.
-// The optional decorator function allows custom field transformations.
-func DocumentFromDocumentGormEmpty(
+// DocumentFromDocumentGormEmptyWithOptions is DocumentFromDocumentGormEmpty without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentFromDocumentGormEmptyWithOptions(
+	ctx context.Context,
 	dest *api.Document,
 	src *DocumentGormEmpty,
-	decorator func(dest *api.Document, src *DocumentGormEmpty) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -78,9 +141,84 @@ func DocumentFromDocumentGormEmpty(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// DocumentSliceToDocumentGormEmptySlice converts a slice of api.Document with DocumentToDocumentGormEmpty,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentGormEmptySlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentGormEmpty) error,
+) ([]*DocumentGormEmpty, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentGormEmpty, error) {
+		return DocumentToDocumentGormEmpty(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentGormEmptySlice converts a slice of DocumentGormEmpty with DocumentFromDocumentGormEmpty,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentGormEmptySlice(
+	src []*DocumentGormEmpty,
+	decorator func(*api.Document, *DocumentGormEmpty) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentGormEmpty) (*api.Document, error) {
+		return DocumentFromDocumentGormEmpty(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentGormEmptyMap converts the values of a map with DocumentToDocumentGormEmpty,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentGormEmptyMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentGormEmpty) error,
+) (map[K]*DocumentGormEmpty, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentGormEmpty, error) {
+		return DocumentToDocumentGormEmpty(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentGormEmptyMap converts the values of a map with DocumentFromDocumentGormEmpty,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentGormEmptyMap[K comparable](
+	src map[K]*DocumentGormEmpty,
+	decorator func(*api.Document, *DocumentGormEmpty) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentGormEmpty) (*api.Document, error) {
+		return DocumentFromDocumentGormEmpty(nil, value, decorator)
+	})
+}
+
+// conversionDocumentToDocumentGormPartial describes DocumentToDocumentGormPartial in conversion errors.
+var conversionDocumentToDocumentGormPartial = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentGormPartial",
+}
+
+// conversionDocumentFromDocumentGormPartial describes DocumentFromDocumentGormPartial in conversion errors.
+var conversionDocumentFromDocumentGormPartial = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentGormPartial",
+	TargetType: "api.Document",
+}
+
+// DocumentToDocumentGormPartial converts a api.Document to DocumentGormPartial.
+// The optional decorator function allows custom field transformations.
+func DocumentToDocumentGormPartial(
+	src *api.Document,
+	dest *DocumentGormPartial,
+	decorator func(*api.Document, *DocumentGormPartial) error,
+) (out *DocumentGormPartial, err error) {
+	out, err = DocumentToDocumentGormPartialWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -88,16 +226,20 @@ func DocumentFromDocumentGormEmpty(
 	return out, nil
 }
 
-// DocumentToDocumentGormPartial converts a api.Document to DocumentGormPartial.
-// The optional decorator function allows custom field transformations.
-func DocumentToDocumentGormPartial(
+// DocumentToDocumentGormPartialWithOptions is DocumentToDocumentGormPartial without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentToDocumentGormPartialWithOptions(
+	ctx context.Context,
 	src *api.Document,
 	dest *DocumentGormPartial,
-	decorator func(*api.Document, *DocumentGormPartial) error,
+	opts ...converters.ConvertOption,
 ) (out *DocumentGormPartial, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentGormPartial{}
 	}
@@ -122,26 +264,45 @@ func DocumentToDocumentGormPartial(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// DocumentFromDocumentGormPartial converts a DocumentGormPartial back to api.Document.
+// The optional decorator function allows custom field transformations.
+func DocumentFromDocumentGormPartial(
+	dest *api.Document,
+	src *DocumentGormPartial,
+	decorator func(dest *api.Document, src *DocumentGormPartial) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentGormPartialWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// DocumentFromDocumentGormPartial converts a DocumentGormPartial back to api.Document.
-// The optional decorator function allows custom field transformations.
-func DocumentFromDocumentGormPartial(
+// DocumentFromDocumentGormPartialWithOptions is DocumentFromDocumentGormPartial without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentFromDocumentGormPartialWithOptions(
+	ctx context.Context,
 	dest *api.Document,
 	src *DocumentGormPartial,
-	decorator func(dest *api.Document, src *DocumentGormPartial) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -160,9 +321,84 @@ func DocumentFromDocumentGormPartial(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// DocumentSliceToDocumentGormPartialSlice converts a slice of api.Document with DocumentToDocumentGormPartial,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentGormPartialSlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentGormPartial) error,
+) ([]*DocumentGormPartial, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentGormPartial, error) {
+		return DocumentToDocumentGormPartial(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentGormPartialSlice converts a slice of DocumentGormPartial with DocumentFromDocumentGormPartial,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentGormPartialSlice(
+	src []*DocumentGormPartial,
+	decorator func(*api.Document, *DocumentGormPartial) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentGormPartial) (*api.Document, error) {
+		return DocumentFromDocumentGormPartial(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentGormPartialMap converts the values of a map with DocumentToDocumentGormPartial,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentGormPartialMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentGormPartial) error,
+) (map[K]*DocumentGormPartial, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentGormPartial, error) {
+		return DocumentToDocumentGormPartial(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentGormPartialMap converts the values of a map with DocumentFromDocumentGormPartial,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentGormPartialMap[K comparable](
+	src map[K]*DocumentGormPartial,
+	decorator func(*api.Document, *DocumentGormPartial) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentGormPartial) (*api.Document, error) {
+		return DocumentFromDocumentGormPartial(nil, value, decorator)
+	})
+}
+
+// conversionDocumentToDocumentGormSkip describes DocumentToDocumentGormSkip in conversion errors.
+var conversionDocumentToDocumentGormSkip = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentGormSkip",
+}
+
+// conversionDocumentFromDocumentGormSkip describes DocumentFromDocumentGormSkip in conversion errors.
+var conversionDocumentFromDocumentGormSkip = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentGormSkip",
+	TargetType: "api.Document",
+}
+
+// DocumentToDocumentGormSkip converts a api.Document to DocumentGormSkip.
+// The optional decorator function allows custom field transformations.
+func DocumentToDocumentGormSkip(
+	src *api.Document,
+	dest *DocumentGormSkip,
+	decorator func(*api.Document, *DocumentGormSkip) error,
+) (out *DocumentGormSkip, err error) {
+	out, err = DocumentToDocumentGormSkipWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -170,16 +406,20 @@ func DocumentFromDocumentGormPartial(
 	return out, nil
 }
 
-// DocumentToDocumentGormSkip converts a api.Document to DocumentGormSkip.
-// The optional decorator function allows custom field transformations.
-func DocumentToDocumentGormSkip(
+// DocumentToDocumentGormSkipWithOptions is DocumentToDocumentGormSkip without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentToDocumentGormSkipWithOptions(
+	ctx context.Context,
 	src *api.Document,
 	dest *DocumentGormSkip,
-	decorator func(*api.Document, *DocumentGormSkip) error,
+	opts ...converters.ConvertOption,
 ) (out *DocumentGormSkip, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentGormSkip{}
 	}
@@ -203,26 +443,45 @@ func DocumentToDocumentGormSkip(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// DocumentFromDocumentGormSkip converts a DocumentGormSkip back to api.Document.
+// The optional decorator function allows custom field transformations.
+func DocumentFromDocumentGormSkip(
+	dest *api.Document,
+	src *DocumentGormSkip,
+	decorator func(dest *api.Document, src *DocumentGormSkip) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentGormSkipWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// DocumentFromDocumentGormSkip converts a DocumentGormSkip back to api.Document.
-// The optional decorator function allows custom field transformations.
-func DocumentFromDocumentGormSkip(
+// DocumentFromDocumentGormSkipWithOptions is DocumentFromDocumentGormSkip without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentFromDocumentGormSkipWithOptions(
+	ctx context.Context,
 	dest *api.Document,
 	src *DocumentGormSkip,
-	decorator func(dest *api.Document, src *DocumentGormSkip) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -240,9 +499,84 @@ func DocumentFromDocumentGormSkip(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// DocumentSliceToDocumentGormSkipSlice converts a slice of api.Document with DocumentToDocumentGormSkip,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentGormSkipSlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentGormSkip) error,
+) ([]*DocumentGormSkip, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentGormSkip, error) {
+		return DocumentToDocumentGormSkip(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentGormSkipSlice converts a slice of DocumentGormSkip with DocumentFromDocumentGormSkip,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentGormSkipSlice(
+	src []*DocumentGormSkip,
+	decorator func(*api.Document, *DocumentGormSkip) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentGormSkip) (*api.Document, error) {
+		return DocumentFromDocumentGormSkip(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentGormSkipMap converts the values of a map with DocumentToDocumentGormSkip,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentGormSkipMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentGormSkip) error,
+) (map[K]*DocumentGormSkip, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentGormSkip, error) {
+		return DocumentToDocumentGormSkip(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentGormSkipMap converts the values of a map with DocumentFromDocumentGormSkip,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentGormSkipMap[K comparable](
+	src map[K]*DocumentGormSkip,
+	decorator func(*api.Document, *DocumentGormSkip) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentGormSkip) (*api.Document, error) {
+		return DocumentFromDocumentGormSkip(nil, value, decorator)
+	})
+}
+
+// conversionDocumentToDocumentGormExtra describes DocumentToDocumentGormExtra in conversion errors.
+var conversionDocumentToDocumentGormExtra = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "document",
+	SourceType: "api.Document",
+	TargetType: "DocumentGormExtra",
+}
+
+// conversionDocumentFromDocumentGormExtra describes DocumentFromDocumentGormExtra in conversion errors.
+var conversionDocumentFromDocumentGormExtra = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "document",
+	SourceType: "DocumentGormExtra",
+	TargetType: "api.Document",
+}
+
+// DocumentToDocumentGormExtra converts a api.Document to DocumentGormExtra.
+// The optional decorator function allows custom field transformations.
+func DocumentToDocumentGormExtra(
+	src *api.Document,
+	dest *DocumentGormExtra,
+	decorator func(*api.Document, *DocumentGormExtra) error,
+) (out *DocumentGormExtra, err error) {
+	out, err = DocumentToDocumentGormExtraWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -250,16 +584,20 @@ func DocumentFromDocumentGormSkip(
 	return out, nil
 }
 
-// DocumentToDocumentGormExtra converts a api.Document to DocumentGormExtra.
-// The optional decorator function allows custom field transformations.
-func DocumentToDocumentGormExtra(
+// DocumentToDocumentGormExtraWithOptions is DocumentToDocumentGormExtra without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentToDocumentGormExtraWithOptions(
+	ctx context.Context,
 	src *api.Document,
 	dest *DocumentGormExtra,
-	decorator func(*api.Document, *DocumentGormExtra) error,
+	opts ...converters.ConvertOption,
 ) (out *DocumentGormExtra, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &DocumentGormExtra{}
 	}
@@ -284,26 +622,45 @@ func DocumentToDocumentGormExtra(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// DocumentFromDocumentGormExtra converts a DocumentGormExtra back to api.Document.
+// The optional decorator function allows custom field transformations.
+func DocumentFromDocumentGormExtra(
+	dest *api.Document,
+	src *DocumentGormExtra,
+	decorator func(dest *api.Document, src *DocumentGormExtra) error,
+) (out *api.Document, err error) {
+	out, err = DocumentFromDocumentGormExtraWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// DocumentFromDocumentGormExtra converts a DocumentGormExtra back to api.Document.
-// The optional decorator function allows custom field transformations.
-func DocumentFromDocumentGormExtra(
+// DocumentFromDocumentGormExtraWithOptions is DocumentFromDocumentGormExtra without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func DocumentFromDocumentGormExtraWithOptions(
+	ctx context.Context,
 	dest *api.Document,
 	src *DocumentGormExtra,
-	decorator func(dest *api.Document, src *DocumentGormExtra) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Document, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Document{}
 	}
@@ -322,12 +679,49 @@ func DocumentFromDocumentGormExtra(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return out, nil
 }
+
+// DocumentSliceToDocumentGormExtraSlice converts a slice of api.Document with DocumentToDocumentGormExtra,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceToDocumentGormExtraSlice(
+	src []*api.Document,
+	decorator func(*api.Document, *DocumentGormExtra) error,
+) ([]*DocumentGormExtra, error) {
+	return converters.MapSlice(src, func(item *api.Document) (*DocumentGormExtra, error) {
+		return DocumentToDocumentGormExtra(item, nil, decorator)
+	})
+}
+
+// DocumentSliceFromDocumentGormExtraSlice converts a slice of DocumentGormExtra with DocumentFromDocumentGormExtra,
+// applying the optional decorator to each item. Nil items stay nil.
+func DocumentSliceFromDocumentGormExtraSlice(
+	src []*DocumentGormExtra,
+	decorator func(*api.Document, *DocumentGormExtra) error,
+) ([]*api.Document, error) {
+	return converters.MapSlice(src, func(item *DocumentGormExtra) (*api.Document, error) {
+		return DocumentFromDocumentGormExtra(nil, item, decorator)
+	})
+}
+
+// DocumentMapToDocumentGormExtraMap converts the values of a map with DocumentToDocumentGormExtra,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapToDocumentGormExtraMap[K comparable](
+	src map[K]*api.Document,
+	decorator func(*api.Document, *DocumentGormExtra) error,
+) (map[K]*DocumentGormExtra, error) {
+	return converters.MapValues(src, func(value *api.Document) (*DocumentGormExtra, error) {
+		return DocumentToDocumentGormExtra(value, nil, decorator)
+	})
+}
+
+// DocumentMapFromDocumentGormExtraMap converts the values of a map with DocumentFromDocumentGormExtra,
+// applying the optional decorator to each value. Nil values stay nil.
+func DocumentMapFromDocumentGormExtraMap[K comparable](
+	src map[K]*DocumentGormExtra,
+	decorator func(*api.Document, *DocumentGormExtra) error,
+) (map[K]*api.Document, error) {
+	return converters.MapValues(src, func(value *DocumentGormExtra) (*api.Document, error) {
+		return DocumentFromDocumentGormExtra(nil, value, decorator)
+	})
+}
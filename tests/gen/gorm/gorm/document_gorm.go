@@ -2,6 +2,8 @@
 package gorm
 
 import (
+	"reflect"
+	"slices"
 	"time"
 )
 
@@ -18,6 +20,80 @@ type DocumentGormEmpty struct {
 	Tags      []string `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *DocumentGormEmpty) Equal(other *DocumentGormEmpty) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Content == other.Content &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DocumentGormEmpty) ChangedColumns(other *DocumentGormEmpty) []string {
+	if m == nil {
+		m = &DocumentGormEmpty{}
+	}
+	if other == nil {
+		other = &DocumentGormEmpty{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Content != other.Content {
+		columns = append(columns, "content")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DocumentGormEmpty) Clone() *DocumentGormEmpty {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DocumentGormEmpty with copies of their own.
+func (m *DocumentGormEmpty) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // TableName returns the table name for DocumentGormEmpty
 func (*DocumentGormEmpty) TableName() string {
 	return "documents_empty"
@@ -36,6 +112,80 @@ type DocumentGormPartial struct {
 	Tags      []string `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *DocumentGormPartial) Equal(other *DocumentGormPartial) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Content == other.Content &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DocumentGormPartial) ChangedColumns(other *DocumentGormPartial) []string {
+	if m == nil {
+		m = &DocumentGormPartial{}
+	}
+	if other == nil {
+		other = &DocumentGormPartial{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Content != other.Content {
+		columns = append(columns, "content")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DocumentGormPartial) Clone() *DocumentGormPartial {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DocumentGormPartial with copies of their own.
+func (m *DocumentGormPartial) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // TableName returns the table name for DocumentGormPartial
 func (*DocumentGormPartial) TableName() string {
 	return "documents_partial"
@@ -53,6 +203,76 @@ type DocumentGormSkip struct {
 	Tags      []string `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *DocumentGormSkip) Equal(other *DocumentGormSkip) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DocumentGormSkip) ChangedColumns(other *DocumentGormSkip) []string {
+	if m == nil {
+		m = &DocumentGormSkip{}
+	}
+	if other == nil {
+		other = &DocumentGormSkip{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DocumentGormSkip) Clone() *DocumentGormSkip {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DocumentGormSkip with copies of their own.
+func (m *DocumentGormSkip) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // TableName returns the table name for DocumentGormSkip
 func (*DocumentGormSkip) TableName() string {
 	return "documents_skip"
@@ -73,6 +293,88 @@ type DocumentGormExtra struct {
 	Version   int32     `gorm:"default:1"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *DocumentGormExtra) Equal(other *DocumentGormExtra) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Title == other.Title &&
+		m.Content == other.Content &&
+		m.Author == other.Author &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Published == other.Published &&
+		m.ViewCount == other.ViewCount &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		m.DeletedAt.Equal(other.DeletedAt) &&
+		m.Version == other.Version
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *DocumentGormExtra) ChangedColumns(other *DocumentGormExtra) []string {
+	if m == nil {
+		m = &DocumentGormExtra{}
+	}
+	if other == nil {
+		other = &DocumentGormExtra{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	if m.Content != other.Content {
+		columns = append(columns, "content")
+	}
+	if m.Author != other.Author {
+		columns = append(columns, "author")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Published != other.Published {
+		columns = append(columns, "published")
+	}
+	if m.ViewCount != other.ViewCount {
+		columns = append(columns, "view_count")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if !m.DeletedAt.Equal(other.DeletedAt) {
+		columns = append(columns, "deleted_at")
+	}
+	if m.Version != other.Version {
+		columns = append(columns, "version")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *DocumentGormExtra) Clone() *DocumentGormExtra {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a DocumentGormExtra with copies of their own.
+func (m *DocumentGormExtra) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+}
+
 // TableName returns the table name for DocumentGormExtra
 func (*DocumentGormExtra) TableName() string {
 	return "documents_extra"
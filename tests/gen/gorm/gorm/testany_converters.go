@@ -2,22 +2,67 @@
 package gorm
 
 import (
-	"fmt"
+	"context"
+	"strconv"
+	"time"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// conversionTestRecord1ToTestRecord1GORM describes TestRecord1ToTestRecord1GORM in conversion errors.
+var conversionTestRecord1ToTestRecord1GORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record1",
+	SourceType: "api.TestRecord1",
+	TargetType: "TestRecord1GORM",
+}
+
+// conversionTestRecord1FromTestRecord1GORM describes TestRecord1FromTestRecord1GORM in conversion errors.
+var conversionTestRecord1FromTestRecord1GORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record1",
+	SourceType: "TestRecord1GORM",
+	TargetType: "api.TestRecord1",
+}
+
 // TestRecord1ToTestRecord1GORM converts a api.TestRecord1 to TestRecord1GORM.
 // The optional decorator function allows custom field transformations.
 func TestRecord1ToTestRecord1GORM(
 	src *api.TestRecord1,
 	dest *TestRecord1GORM,
 	decorator func(*api.TestRecord1, *TestRecord1GORM) error,
+) (out *TestRecord1GORM, err error) {
+	out, err = TestRecord1ToTestRecord1GORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord1ToTestRecord1GORMWithOptions is TestRecord1ToTestRecord1GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord1ToTestRecord1GORMWithOptions(
+	ctx context.Context,
+	src *api.TestRecord1,
+	dest *TestRecord1GORM,
+	opts ...converters.ConvertOption,
 ) (out *TestRecord1GORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TestRecord1GORM{}
 	}
@@ -36,7 +81,7 @@ func TestRecord1ToTestRecord1GORM(
 	if src.ExtraData != nil {
 		out.ExtraData, err = converters.AnyToBytes(src.ExtraData)
 		if err != nil {
-			return nil, fmt.Errorf("converting ExtraData: %w", err)
+			return nil, conversionTestRecord1ToTestRecord1GORM.FieldError(err, "extra_data")
 		}
 	}
 
@@ -44,26 +89,45 @@ func TestRecord1ToTestRecord1GORM(
 		out.MapStringToEnum = src.MapStringToEnum
 	}
 
+	return dest, nil
+}
+
+// TestRecord1FromTestRecord1GORM converts a TestRecord1GORM back to api.TestRecord1.
+// The optional decorator function allows custom field transformations.
+func TestRecord1FromTestRecord1GORM(
+	dest *api.TestRecord1,
+	src *TestRecord1GORM,
+	decorator func(dest *api.TestRecord1, src *TestRecord1GORM) error,
+) (out *api.TestRecord1, err error) {
+	out, err = TestRecord1FromTestRecord1GORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// TestRecord1FromTestRecord1GORM converts a TestRecord1GORM back to api.TestRecord1.
-// The optional decorator function allows custom field transformations.
-func TestRecord1FromTestRecord1GORM(
+// TestRecord1FromTestRecord1GORMWithOptions is TestRecord1FromTestRecord1GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord1FromTestRecord1GORMWithOptions(
+	ctx context.Context,
 	dest *api.TestRecord1,
 	src *TestRecord1GORM,
-	decorator func(dest *api.TestRecord1, src *TestRecord1GORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.TestRecord1, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.TestRecord1{}
 	}
@@ -79,12 +143,87 @@ func TestRecord1FromTestRecord1GORM(
 
 	out.ExtraData, err = converters.BytesToAny(src.ExtraData)
 	if err != nil {
-		return nil, fmt.Errorf("converting ExtraData: %w", err)
+		return nil, conversionTestRecord1FromTestRecord1GORM.FieldError(err, "extra_data")
+	}
+
+	return out, nil
+}
+
+// TestRecord1SliceToTestRecord1GORMSlice converts a slice of api.TestRecord1 with TestRecord1ToTestRecord1GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord1SliceToTestRecord1GORMSlice(
+	src []*api.TestRecord1,
+	decorator func(*api.TestRecord1, *TestRecord1GORM) error,
+) ([]*TestRecord1GORM, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord1) (*TestRecord1GORM, error) {
+		return TestRecord1ToTestRecord1GORM(item, nil, decorator)
+	})
+}
+
+// TestRecord1SliceFromTestRecord1GORMSlice converts a slice of TestRecord1GORM with TestRecord1FromTestRecord1GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord1SliceFromTestRecord1GORMSlice(
+	src []*TestRecord1GORM,
+	decorator func(*api.TestRecord1, *TestRecord1GORM) error,
+) ([]*api.TestRecord1, error) {
+	return converters.MapSlice(src, func(item *TestRecord1GORM) (*api.TestRecord1, error) {
+		return TestRecord1FromTestRecord1GORM(nil, item, decorator)
+	})
+}
+
+// TestRecord1MapToTestRecord1GORMMap converts the values of a map with TestRecord1ToTestRecord1GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord1MapToTestRecord1GORMMap[K comparable](
+	src map[K]*api.TestRecord1,
+	decorator func(*api.TestRecord1, *TestRecord1GORM) error,
+) (map[K]*TestRecord1GORM, error) {
+	return converters.MapValues(src, func(value *api.TestRecord1) (*TestRecord1GORM, error) {
+		return TestRecord1ToTestRecord1GORM(value, nil, decorator)
+	})
+}
+
+// TestRecord1MapFromTestRecord1GORMMap converts the values of a map with TestRecord1FromTestRecord1GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord1MapFromTestRecord1GORMMap[K comparable](
+	src map[K]*TestRecord1GORM,
+	decorator func(*api.TestRecord1, *TestRecord1GORM) error,
+) (map[K]*api.TestRecord1, error) {
+	return converters.MapValues(src, func(value *TestRecord1GORM) (*api.TestRecord1, error) {
+		return TestRecord1FromTestRecord1GORM(nil, value, decorator)
+	})
+}
+
+// conversionMapValueMessageToMapValueMessageGORM describes MapValueMessageToMapValueMessageGORM in conversion errors.
+var conversionMapValueMessageToMapValueMessageGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "map_value_message",
+	SourceType: "api.MapValueMessage",
+	TargetType: "MapValueMessageGORM",
+}
+
+// conversionMapValueMessageFromMapValueMessageGORM describes MapValueMessageFromMapValueMessageGORM in conversion errors.
+var conversionMapValueMessageFromMapValueMessageGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "map_value_message",
+	SourceType: "MapValueMessageGORM",
+	TargetType: "api.MapValueMessage",
+}
+
+// MapValueMessageToMapValueMessageGORM converts a api.MapValueMessage to MapValueMessageGORM.
+// The optional decorator function allows custom field transformations.
+func MapValueMessageToMapValueMessageGORM(
+	src *api.MapValueMessage,
+	dest *MapValueMessageGORM,
+	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+) (out *MapValueMessageGORM, err error) {
+	out, err = MapValueMessageToMapValueMessageGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -92,16 +231,20 @@ func TestRecord1FromTestRecord1GORM(
 	return out, nil
 }
 
-// MapValueMessageToMapValueMessageGORM converts a api.MapValueMessage to MapValueMessageGORM.
-// The optional decorator function allows custom field transformations.
-func MapValueMessageToMapValueMessageGORM(
+// MapValueMessageToMapValueMessageGORMWithOptions is MapValueMessageToMapValueMessageGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func MapValueMessageToMapValueMessageGORMWithOptions(
+	ctx context.Context,
 	src *api.MapValueMessage,
 	dest *MapValueMessageGORM,
-	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *MapValueMessageGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &MapValueMessageGORM{}
 	}
@@ -113,26 +256,45 @@ func MapValueMessageToMapValueMessageGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// MapValueMessageFromMapValueMessageGORM converts a MapValueMessageGORM back to api.MapValueMessage.
+// The optional decorator function allows custom field transformations.
+func MapValueMessageFromMapValueMessageGORM(
+	dest *api.MapValueMessage,
+	src *MapValueMessageGORM,
+	decorator func(dest *api.MapValueMessage, src *MapValueMessageGORM) error,
+) (out *api.MapValueMessage, err error) {
+	out, err = MapValueMessageFromMapValueMessageGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// MapValueMessageFromMapValueMessageGORM converts a MapValueMessageGORM back to api.MapValueMessage.
-// The optional decorator function allows custom field transformations.
-func MapValueMessageFromMapValueMessageGORM(
+// MapValueMessageFromMapValueMessageGORMWithOptions is MapValueMessageFromMapValueMessageGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func MapValueMessageFromMapValueMessageGORMWithOptions(
+	ctx context.Context,
 	dest *api.MapValueMessage,
 	src *MapValueMessageGORM,
-	decorator func(dest *api.MapValueMessage, src *MapValueMessageGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.MapValueMessage, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.MapValueMessage{}
 	}
@@ -144,9 +306,84 @@ func MapValueMessageFromMapValueMessageGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// MapValueMessageSliceToMapValueMessageGORMSlice converts a slice of api.MapValueMessage with MapValueMessageToMapValueMessageGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func MapValueMessageSliceToMapValueMessageGORMSlice(
+	src []*api.MapValueMessage,
+	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+) ([]*MapValueMessageGORM, error) {
+	return converters.MapSlice(src, func(item *api.MapValueMessage) (*MapValueMessageGORM, error) {
+		return MapValueMessageToMapValueMessageGORM(item, nil, decorator)
+	})
+}
+
+// MapValueMessageSliceFromMapValueMessageGORMSlice converts a slice of MapValueMessageGORM with MapValueMessageFromMapValueMessageGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func MapValueMessageSliceFromMapValueMessageGORMSlice(
+	src []*MapValueMessageGORM,
+	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+) ([]*api.MapValueMessage, error) {
+	return converters.MapSlice(src, func(item *MapValueMessageGORM) (*api.MapValueMessage, error) {
+		return MapValueMessageFromMapValueMessageGORM(nil, item, decorator)
+	})
+}
+
+// MapValueMessageMapToMapValueMessageGORMMap converts the values of a map with MapValueMessageToMapValueMessageGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func MapValueMessageMapToMapValueMessageGORMMap[K comparable](
+	src map[K]*api.MapValueMessage,
+	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+) (map[K]*MapValueMessageGORM, error) {
+	return converters.MapValues(src, func(value *api.MapValueMessage) (*MapValueMessageGORM, error) {
+		return MapValueMessageToMapValueMessageGORM(value, nil, decorator)
+	})
+}
+
+// MapValueMessageMapFromMapValueMessageGORMMap converts the values of a map with MapValueMessageFromMapValueMessageGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func MapValueMessageMapFromMapValueMessageGORMMap[K comparable](
+	src map[K]*MapValueMessageGORM,
+	decorator func(*api.MapValueMessage, *MapValueMessageGORM) error,
+) (map[K]*api.MapValueMessage, error) {
+	return converters.MapValues(src, func(value *MapValueMessageGORM) (*api.MapValueMessage, error) {
+		return MapValueMessageFromMapValueMessageGORM(nil, value, decorator)
+	})
+}
+
+// conversionTestRecord2ToTestRecord2GORM describes TestRecord2ToTestRecord2GORM in conversion errors.
+var conversionTestRecord2ToTestRecord2GORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record2",
+	SourceType: "api.TestRecord2",
+	TargetType: "TestRecord2GORM",
+}
+
+// conversionTestRecord2FromTestRecord2GORM describes TestRecord2FromTestRecord2GORM in conversion errors.
+var conversionTestRecord2FromTestRecord2GORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record2",
+	SourceType: "TestRecord2GORM",
+	TargetType: "api.TestRecord2",
+}
+
+// TestRecord2ToTestRecord2GORM converts a api.TestRecord2 to TestRecord2GORM.
+// The optional decorator function allows custom field transformations.
+func TestRecord2ToTestRecord2GORM(
+	src *api.TestRecord2,
+	dest *TestRecord2GORM,
+	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+) (out *TestRecord2GORM, err error) {
+	out, err = TestRecord2ToTestRecord2GORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -154,16 +391,20 @@ func MapValueMessageFromMapValueMessageGORM(
 	return out, nil
 }
 
-// TestRecord2ToTestRecord2GORM converts a api.TestRecord2 to TestRecord2GORM.
-// The optional decorator function allows custom field transformations.
-func TestRecord2ToTestRecord2GORM(
+// TestRecord2ToTestRecord2GORMWithOptions is TestRecord2ToTestRecord2GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord2ToTestRecord2GORMWithOptions(
+	ctx context.Context,
 	src *api.TestRecord2,
 	dest *TestRecord2GORM,
-	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+	opts ...converters.ConvertOption,
 ) (out *TestRecord2GORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TestRecord2GORM{}
 	}
@@ -178,9 +419,9 @@ func TestRecord2ToTestRecord2GORM(
 		out.Int32ToMessage = make(map[int32]MapValueMessageGORM, len(src.Int32ToMessage))
 		for key, value := range src.Int32ToMessage {
 			var converted MapValueMessageGORM
-			_, err = MapValueMessageToMapValueMessageGORM(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageGORMWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2GORM.ElementError(err, "int32_to_message", key)
 			}
 			out.Int32ToMessage[key] = converted
 		}
@@ -189,9 +430,9 @@ func TestRecord2ToTestRecord2GORM(
 		out.Int64ToMessage = make(map[int64]MapValueMessageGORM, len(src.Int64ToMessage))
 		for key, value := range src.Int64ToMessage {
 			var converted MapValueMessageGORM
-			_, err = MapValueMessageToMapValueMessageGORM(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageGORMWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int64ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2GORM.ElementError(err, "int64_to_message", key)
 			}
 			out.Int64ToMessage[key] = converted
 		}
@@ -200,9 +441,9 @@ func TestRecord2ToTestRecord2GORM(
 		out.Uint32ToMessage = make(map[uint32]MapValueMessageGORM, len(src.Uint32ToMessage))
 		for key, value := range src.Uint32ToMessage {
 			var converted MapValueMessageGORM
-			_, err = MapValueMessageToMapValueMessageGORM(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageGORMWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Uint32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2GORM.ElementError(err, "uint32_to_message", key)
 			}
 			out.Uint32ToMessage[key] = converted
 		}
@@ -211,34 +452,53 @@ func TestRecord2ToTestRecord2GORM(
 		out.BoolToMessage = make(map[bool]MapValueMessageGORM, len(src.BoolToMessage))
 		for key, value := range src.BoolToMessage {
 			var converted MapValueMessageGORM
-			_, err = MapValueMessageToMapValueMessageGORM(value, &converted, nil)
+			_, err = MapValueMessageToMapValueMessageGORMWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting BoolToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2ToTestRecord2GORM.ElementError(err, "bool_to_message", key)
 			}
 			out.BoolToMessage[key] = converted
 		}
 	}
 
+	return dest, nil
+}
+
+// TestRecord2FromTestRecord2GORM converts a TestRecord2GORM back to api.TestRecord2.
+// The optional decorator function allows custom field transformations.
+func TestRecord2FromTestRecord2GORM(
+	dest *api.TestRecord2,
+	src *TestRecord2GORM,
+	decorator func(dest *api.TestRecord2, src *TestRecord2GORM) error,
+) (out *api.TestRecord2, err error) {
+	out, err = TestRecord2FromTestRecord2GORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// TestRecord2FromTestRecord2GORM converts a TestRecord2GORM back to api.TestRecord2.
-// The optional decorator function allows custom field transformations.
-func TestRecord2FromTestRecord2GORM(
+// TestRecord2FromTestRecord2GORMWithOptions is TestRecord2FromTestRecord2GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord2FromTestRecord2GORMWithOptions(
+	ctx context.Context,
 	dest *api.TestRecord2,
 	src *TestRecord2GORM,
-	decorator func(dest *api.TestRecord2, src *TestRecord2GORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.TestRecord2, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.TestRecord2{}
 	}
@@ -252,46 +512,282 @@ func TestRecord2FromTestRecord2GORM(
 	if src.Int32ToMessage != nil {
 		out.Int32ToMessage = make(map[int32]*api.MapValueMessage, len(src.Int32ToMessage))
 		for key, value := range src.Int32ToMessage {
-			out.Int32ToMessage[key], err = MapValueMessageFromMapValueMessageGORM(nil, &value, nil)
+			out.Int32ToMessage[key], err = MapValueMessageFromMapValueMessageGORMWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2GORM.ElementError(err, "int32_to_message", key)
 			}
 		}
 	}
 	if src.Int64ToMessage != nil {
 		out.Int64ToMessage = make(map[int64]*api.MapValueMessage, len(src.Int64ToMessage))
 		for key, value := range src.Int64ToMessage {
-			out.Int64ToMessage[key], err = MapValueMessageFromMapValueMessageGORM(nil, &value, nil)
+			out.Int64ToMessage[key], err = MapValueMessageFromMapValueMessageGORMWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Int64ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2GORM.ElementError(err, "int64_to_message", key)
 			}
 		}
 	}
 	if src.Uint32ToMessage != nil {
 		out.Uint32ToMessage = make(map[uint32]*api.MapValueMessage, len(src.Uint32ToMessage))
 		for key, value := range src.Uint32ToMessage {
-			out.Uint32ToMessage[key], err = MapValueMessageFromMapValueMessageGORM(nil, &value, nil)
+			out.Uint32ToMessage[key], err = MapValueMessageFromMapValueMessageGORMWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Uint32ToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2GORM.ElementError(err, "uint32_to_message", key)
 			}
 		}
 	}
 	if src.BoolToMessage != nil {
 		out.BoolToMessage = make(map[bool]*api.MapValueMessage, len(src.BoolToMessage))
 		for key, value := range src.BoolToMessage {
-			out.BoolToMessage[key], err = MapValueMessageFromMapValueMessageGORM(nil, &value, nil)
+			out.BoolToMessage[key], err = MapValueMessageFromMapValueMessageGORMWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting BoolToMessage[%v]: %w", key, err)
+				return nil, conversionTestRecord2FromTestRecord2GORM.ElementError(err, "bool_to_message", key)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// TestRecord2SliceToTestRecord2GORMSlice converts a slice of api.TestRecord2 with TestRecord2ToTestRecord2GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord2SliceToTestRecord2GORMSlice(
+	src []*api.TestRecord2,
+	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+) ([]*TestRecord2GORM, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord2) (*TestRecord2GORM, error) {
+		return TestRecord2ToTestRecord2GORM(item, nil, decorator)
+	})
+}
+
+// TestRecord2SliceFromTestRecord2GORMSlice converts a slice of TestRecord2GORM with TestRecord2FromTestRecord2GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord2SliceFromTestRecord2GORMSlice(
+	src []*TestRecord2GORM,
+	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+) ([]*api.TestRecord2, error) {
+	return converters.MapSlice(src, func(item *TestRecord2GORM) (*api.TestRecord2, error) {
+		return TestRecord2FromTestRecord2GORM(nil, item, decorator)
+	})
+}
+
+// TestRecord2MapToTestRecord2GORMMap converts the values of a map with TestRecord2ToTestRecord2GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord2MapToTestRecord2GORMMap[K comparable](
+	src map[K]*api.TestRecord2,
+	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+) (map[K]*TestRecord2GORM, error) {
+	return converters.MapValues(src, func(value *api.TestRecord2) (*TestRecord2GORM, error) {
+		return TestRecord2ToTestRecord2GORM(value, nil, decorator)
+	})
+}
+
+// TestRecord2MapFromTestRecord2GORMMap converts the values of a map with TestRecord2FromTestRecord2GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord2MapFromTestRecord2GORMMap[K comparable](
+	src map[K]*TestRecord2GORM,
+	decorator func(*api.TestRecord2, *TestRecord2GORM) error,
+) (map[K]*api.TestRecord2, error) {
+	return converters.MapValues(src, func(value *TestRecord2GORM) (*api.TestRecord2, error) {
+		return TestRecord2FromTestRecord2GORM(nil, value, decorator)
+	})
+}
+
+// conversionTestRecord4ToTestRecord4GORM describes TestRecord4ToTestRecord4GORM in conversion errors.
+var conversionTestRecord4ToTestRecord4GORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "test_record4",
+	SourceType: "api.TestRecord4",
+	TargetType: "TestRecord4GORM",
+}
+
+// conversionTestRecord4FromTestRecord4GORM describes TestRecord4FromTestRecord4GORM in conversion errors.
+var conversionTestRecord4FromTestRecord4GORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "test_record4",
+	SourceType: "TestRecord4GORM",
+	TargetType: "api.TestRecord4",
+}
+
+// TestRecord4ToTestRecord4GORM converts a api.TestRecord4 to TestRecord4GORM.
+// The optional decorator function allows custom field transformations.
+func TestRecord4ToTestRecord4GORM(
+	src *api.TestRecord4,
+	dest *TestRecord4GORM,
+	decorator func(*api.TestRecord4, *TestRecord4GORM) error,
+) (out *TestRecord4GORM, err error) {
+	out, err = TestRecord4ToTestRecord4GORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord4ToTestRecord4GORMWithOptions is TestRecord4ToTestRecord4GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord4ToTestRecord4GORMWithOptions(
+	ctx context.Context,
+	src *api.TestRecord4,
+	dest *TestRecord4GORM,
+	opts ...converters.ConvertOption,
+) (out *TestRecord4GORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &TestRecord4GORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TestRecord4GORM{
+		Id: src.Id,
+	}
+	out = dest
+
+	if src.SeenAt != nil {
+		out.SeenAt = make([]time.Time, len(src.SeenAt))
+		for i, item := range src.SeenAt {
+			out.SeenAt[i] = converters.TimestampToTime(item)
+		}
+	}
+	if src.MemberIds != nil {
+		out.MemberIds = make([]string, len(src.MemberIds))
+		for i, item := range src.MemberIds {
+			out.MemberIds[i] = strconv.FormatUint(uint64(item), 10)
+		}
+	}
+	if src.Deadlines != nil {
+		out.Deadlines = make(map[string]int64, len(src.Deadlines))
+		for key, value := range src.Deadlines {
+			out.Deadlines[key] = converters.TimestampToInt64(value)
+		}
+	}
+
+	return dest, nil
+}
+
+// TestRecord4FromTestRecord4GORM converts a TestRecord4GORM back to api.TestRecord4.
+// The optional decorator function allows custom field transformations.
+func TestRecord4FromTestRecord4GORM(
+	dest *api.TestRecord4,
+	src *TestRecord4GORM,
+	decorator func(dest *api.TestRecord4, src *TestRecord4GORM) error,
+) (out *api.TestRecord4, err error) {
+	out, err = TestRecord4FromTestRecord4GORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
 	return out, nil
 }
+
+// TestRecord4FromTestRecord4GORMWithOptions is TestRecord4FromTestRecord4GORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TestRecord4FromTestRecord4GORMWithOptions(
+	ctx context.Context,
+	dest *api.TestRecord4,
+	src *TestRecord4GORM,
+	opts ...converters.ConvertOption,
+) (out *api.TestRecord4, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.TestRecord4{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.TestRecord4{
+		Id: src.Id,
+	}
+	out = dest
+
+	if src.SeenAt != nil {
+		out.SeenAt = make([]*timestamppb.Timestamp, len(src.SeenAt))
+		for i, item := range src.SeenAt {
+			out.SeenAt[i] = converters.TimeToTimestamp(item)
+		}
+	}
+	if src.MemberIds != nil {
+		out.MemberIds = make([]uint32, len(src.MemberIds))
+		for i, item := range src.MemberIds {
+			out.MemberIds[i], err = converters.ParseUint32(item)
+			if err != nil {
+				return nil, conversionTestRecord4FromTestRecord4GORM.ElementError(err, "member_ids", i)
+			}
+		}
+	}
+	if src.Deadlines != nil {
+		out.Deadlines = make(map[string]*timestamppb.Timestamp, len(src.Deadlines))
+		for key, value := range src.Deadlines {
+			out.Deadlines[key] = converters.Int64ToTimestamp(value)
+		}
+	}
+
+	return out, nil
+}
+
+// TestRecord4SliceToTestRecord4GORMSlice converts a slice of api.TestRecord4 with TestRecord4ToTestRecord4GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord4SliceToTestRecord4GORMSlice(
+	src []*api.TestRecord4,
+	decorator func(*api.TestRecord4, *TestRecord4GORM) error,
+) ([]*TestRecord4GORM, error) {
+	return converters.MapSlice(src, func(item *api.TestRecord4) (*TestRecord4GORM, error) {
+		return TestRecord4ToTestRecord4GORM(item, nil, decorator)
+	})
+}
+
+// TestRecord4SliceFromTestRecord4GORMSlice converts a slice of TestRecord4GORM with TestRecord4FromTestRecord4GORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TestRecord4SliceFromTestRecord4GORMSlice(
+	src []*TestRecord4GORM,
+	decorator func(*api.TestRecord4, *TestRecord4GORM) error,
+) ([]*api.TestRecord4, error) {
+	return converters.MapSlice(src, func(item *TestRecord4GORM) (*api.TestRecord4, error) {
+		return TestRecord4FromTestRecord4GORM(nil, item, decorator)
+	})
+}
+
+// TestRecord4MapToTestRecord4GORMMap converts the values of a map with TestRecord4ToTestRecord4GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord4MapToTestRecord4GORMMap[K comparable](
+	src map[K]*api.TestRecord4,
+	decorator func(*api.TestRecord4, *TestRecord4GORM) error,
+) (map[K]*TestRecord4GORM, error) {
+	return converters.MapValues(src, func(value *api.TestRecord4) (*TestRecord4GORM, error) {
+		return TestRecord4ToTestRecord4GORM(value, nil, decorator)
+	})
+}
+
+// TestRecord4MapFromTestRecord4GORMMap converts the values of a map with TestRecord4FromTestRecord4GORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TestRecord4MapFromTestRecord4GORMMap[K comparable](
+	src map[K]*TestRecord4GORM,
+	decorator func(*api.TestRecord4, *TestRecord4GORM) error,
+) (map[K]*api.TestRecord4, error) {
+	return converters.MapValues(src, func(value *TestRecord4GORM) (*api.TestRecord4, error) {
+		return TestRecord4FromTestRecord4GORM(nil, value, decorator)
+	})
+}
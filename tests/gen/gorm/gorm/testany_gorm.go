@@ -2,6 +2,10 @@
 package gorm
 
 import (
+	"bytes"
+	"maps"
+	"reflect"
+	"slices"
 	"time"
 
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
@@ -16,6 +20,66 @@ type TestRecord1GORM struct {
 	MapStringToEnum map[string]api.SampleEnum `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *TestRecord1GORM) Equal(other *TestRecord1GORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.TimeField.Equal(other.TimeField) &&
+		bytes.Equal(m.ExtraData, other.ExtraData) &&
+		m.AnEnum == other.AnEnum &&
+		reflect.DeepEqual(m.ListOfEnums, other.ListOfEnums) &&
+		reflect.DeepEqual(m.MapStringToEnum, other.MapStringToEnum)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *TestRecord1GORM) ChangedColumns(other *TestRecord1GORM) []string {
+	if m == nil {
+		m = &TestRecord1GORM{}
+	}
+	if other == nil {
+		other = &TestRecord1GORM{}
+	}
+	var columns []string
+	if !m.TimeField.Equal(other.TimeField) {
+		columns = append(columns, "time_field")
+	}
+	if !bytes.Equal(m.ExtraData, other.ExtraData) {
+		columns = append(columns, "extra_data")
+	}
+	if m.AnEnum != other.AnEnum {
+		columns = append(columns, "an_enum")
+	}
+	if !reflect.DeepEqual(m.ListOfEnums, other.ListOfEnums) {
+		columns = append(columns, "list_of_enums")
+	}
+	if !reflect.DeepEqual(m.MapStringToEnum, other.MapStringToEnum) {
+		columns = append(columns, "map_string_to_enum")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *TestRecord1GORM) Clone() *TestRecord1GORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a TestRecord1GORM with copies of their own.
+func (m *TestRecord1GORM) cloneFields() {
+	m.ExtraData = bytes.Clone(m.ExtraData)
+	m.ListOfEnums = slices.Clone(m.ListOfEnums)
+	m.MapStringToEnum = maps.Clone(m.MapStringToEnum)
+}
+
 // TableName returns the table name for TestRecord1GORM
 func (*TestRecord1GORM) TableName() string {
 	return "test_records"
@@ -27,6 +91,51 @@ type MapValueMessageGORM struct {
 	Count int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *MapValueMessageGORM) Equal(other *MapValueMessageGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Label == other.Label &&
+		m.Count == other.Count
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *MapValueMessageGORM) ChangedColumns(other *MapValueMessageGORM) []string {
+	if m == nil {
+		m = &MapValueMessageGORM{}
+	}
+	if other == nil {
+		other = &MapValueMessageGORM{}
+	}
+	var columns []string
+	if m.Label != other.Label {
+		columns = append(columns, "label")
+	}
+	if m.Count != other.Count {
+		columns = append(columns, "count")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *MapValueMessageGORM) Clone() *MapValueMessageGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a MapValueMessageGORM with copies of their own.
+func (m *MapValueMessageGORM) cloneFields() {
+}
+
 // TestRecord2GORM is the GORM model for api.TestRecord2
 type TestRecord2GORM struct {
 	Name            string
@@ -36,7 +145,153 @@ type TestRecord2GORM struct {
 	BoolToMessage   map[bool]MapValueMessageGORM   `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *TestRecord2GORM) Equal(other *TestRecord2GORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Name == other.Name &&
+		reflect.DeepEqual(m.Int32ToMessage, other.Int32ToMessage) &&
+		reflect.DeepEqual(m.Int64ToMessage, other.Int64ToMessage) &&
+		reflect.DeepEqual(m.Uint32ToMessage, other.Uint32ToMessage) &&
+		reflect.DeepEqual(m.BoolToMessage, other.BoolToMessage)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *TestRecord2GORM) ChangedColumns(other *TestRecord2GORM) []string {
+	if m == nil {
+		m = &TestRecord2GORM{}
+	}
+	if other == nil {
+		other = &TestRecord2GORM{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Int32ToMessage, other.Int32ToMessage) {
+		columns = append(columns, "int32_to_message")
+	}
+	if !reflect.DeepEqual(m.Int64ToMessage, other.Int64ToMessage) {
+		columns = append(columns, "int64_to_message")
+	}
+	if !reflect.DeepEqual(m.Uint32ToMessage, other.Uint32ToMessage) {
+		columns = append(columns, "uint32_to_message")
+	}
+	if !reflect.DeepEqual(m.BoolToMessage, other.BoolToMessage) {
+		columns = append(columns, "bool_to_message")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *TestRecord2GORM) Clone() *TestRecord2GORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a TestRecord2GORM with copies of their own.
+func (m *TestRecord2GORM) cloneFields() {
+	m.Int32ToMessage = maps.Clone(m.Int32ToMessage)
+	for key, value := range m.Int32ToMessage {
+		value.cloneFields()
+		m.Int32ToMessage[key] = value
+	}
+	m.Int64ToMessage = maps.Clone(m.Int64ToMessage)
+	for key, value := range m.Int64ToMessage {
+		value.cloneFields()
+		m.Int64ToMessage[key] = value
+	}
+	m.Uint32ToMessage = maps.Clone(m.Uint32ToMessage)
+	for key, value := range m.Uint32ToMessage {
+		value.cloneFields()
+		m.Uint32ToMessage[key] = value
+	}
+	m.BoolToMessage = maps.Clone(m.BoolToMessage)
+	for key, value := range m.BoolToMessage {
+		value.cloneFields()
+		m.BoolToMessage[key] = value
+	}
+}
+
 // TableName returns the table name for TestRecord2GORM
 func (*TestRecord2GORM) TableName() string {
 	return "test_records2"
 }
+
+// TestRecord4GORM is the GORM model for api.TestRecord4
+type TestRecord4GORM struct {
+	Id        string           `gorm:"primaryKey"`
+	SeenAt    []time.Time      `gorm:"serializer:json"`
+	MemberIds []string         `gorm:"serializer:json"`
+	Deadlines map[string]int64 `gorm:"serializer:json"`
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *TestRecord4GORM) Equal(other *TestRecord4GORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		reflect.DeepEqual(m.SeenAt, other.SeenAt) &&
+		reflect.DeepEqual(m.MemberIds, other.MemberIds) &&
+		reflect.DeepEqual(m.Deadlines, other.Deadlines)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *TestRecord4GORM) ChangedColumns(other *TestRecord4GORM) []string {
+	if m == nil {
+		m = &TestRecord4GORM{}
+	}
+	if other == nil {
+		other = &TestRecord4GORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if !reflect.DeepEqual(m.SeenAt, other.SeenAt) {
+		columns = append(columns, "seen_at")
+	}
+	if !reflect.DeepEqual(m.MemberIds, other.MemberIds) {
+		columns = append(columns, "member_ids")
+	}
+	if !reflect.DeepEqual(m.Deadlines, other.Deadlines) {
+		columns = append(columns, "deadlines")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *TestRecord4GORM) Clone() *TestRecord4GORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a TestRecord4GORM with copies of their own.
+func (m *TestRecord4GORM) cloneFields() {
+	m.SeenAt = slices.Clone(m.SeenAt)
+	m.MemberIds = slices.Clone(m.MemberIds)
+	m.Deadlines = maps.Clone(m.Deadlines)
+}
+
+// TableName returns the table name for TestRecord4GORM
+func (*TestRecord4GORM) TableName() string {
+	return "test_records4"
+}
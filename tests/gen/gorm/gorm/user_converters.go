@@ -2,22 +2,64 @@
 package gorm
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 )
 
+// conversionUserToUserGORM describes UserToUserGORM in conversion errors.
+var conversionUserToUserGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserGORM",
+}
+
+// conversionUserFromUserGORM describes UserFromUserGORM in conversion errors.
+var conversionUserFromUserGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserGORM",
+	TargetType: "api.User",
+}
+
 // UserToUserGORM converts a api.User to UserGORM.
 // The optional decorator function allows custom field transformations.
 func UserToUserGORM(
 	src *api.User,
 	dest *UserGORM,
 	decorator func(*api.User, *UserGORM) error,
+) (out *UserGORM, err error) {
+	out, err = UserToUserGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserToUserGORMWithOptions is UserToUserGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToUserGORMWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *UserGORM,
+	opts ...converters.ConvertOption,
 ) (out *UserGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserGORM{}
 	}
@@ -48,26 +90,45 @@ func UserToUserGORM(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserGORM converts a UserGORM back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromUserGORM(
+	dest *api.User,
+	src *UserGORM,
+	decorator func(dest *api.User, src *UserGORM) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserGORM converts a UserGORM back to api.User.
-// The optional decorator function allows custom field transformations.
-func UserFromUserGORM(
+// UserFromUserGORMWithOptions is UserFromUserGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromUserGORMWithOptions(
+	ctx context.Context,
 	dest *api.User,
 	src *UserGORM,
-	decorator func(dest *api.User, src *UserGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -86,9 +147,84 @@ func UserFromUserGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// UserSliceToUserGORMSlice converts a slice of api.User with UserToUserGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserGORMSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserGORM) error,
+) ([]*UserGORM, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserGORM, error) {
+		return UserToUserGORM(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserGORMSlice converts a slice of UserGORM with UserFromUserGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserGORMSlice(
+	src []*UserGORM,
+	decorator func(*api.User, *UserGORM) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserGORM) (*api.User, error) {
+		return UserFromUserGORM(nil, item, decorator)
+	})
+}
+
+// UserMapToUserGORMMap converts the values of a map with UserToUserGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserGORMMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserGORM) error,
+) (map[K]*UserGORM, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserGORM, error) {
+		return UserToUserGORM(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserGORMMap converts the values of a map with UserFromUserGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserGORMMap[K comparable](
+	src map[K]*UserGORM,
+	decorator func(*api.User, *UserGORM) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserGORM) (*api.User, error) {
+		return UserFromUserGORM(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithPermissions describes UserToUserWithPermissions in conversion errors.
+var conversionUserToUserWithPermissions = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithPermissions",
+}
+
+// conversionUserFromUserWithPermissions describes UserFromUserWithPermissions in conversion errors.
+var conversionUserFromUserWithPermissions = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithPermissions",
+	TargetType: "api.User",
+}
+
+// UserToUserWithPermissions converts a api.User to UserWithPermissions.
+// The optional decorator function allows custom field transformations.
+func UserToUserWithPermissions(
+	src *api.User,
+	dest *UserWithPermissions,
+	decorator func(*api.User, *UserWithPermissions) error,
+) (out *UserWithPermissions, err error) {
+	out, err = UserToUserWithPermissionsWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -96,16 +232,20 @@ func UserFromUserGORM(
 	return out, nil
 }
 
-// UserToUserWithPermissions converts a api.User to UserWithPermissions.
-// The optional decorator function allows custom field transformations.
-func UserToUserWithPermissions(
+// UserToUserWithPermissionsWithOptions is UserToUserWithPermissions without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToUserWithPermissionsWithOptions(
+	ctx context.Context,
 	src *api.User,
 	dest *UserWithPermissions,
-	decorator func(*api.User, *UserWithPermissions) error,
+	opts ...converters.ConvertOption,
 ) (out *UserWithPermissions, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserWithPermissions{}
 	}
@@ -136,26 +276,45 @@ func UserToUserWithPermissions(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserWithPermissions converts a UserWithPermissions back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromUserWithPermissions(
+	dest *api.User,
+	src *UserWithPermissions,
+	decorator func(dest *api.User, src *UserWithPermissions) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithPermissionsWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserWithPermissions converts a UserWithPermissions back to api.User.
-// The optional decorator function allows custom field transformations.
-func UserFromUserWithPermissions(
+// UserFromUserWithPermissionsWithOptions is UserFromUserWithPermissions without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromUserWithPermissionsWithOptions(
+	ctx context.Context,
 	dest *api.User,
 	src *UserWithPermissions,
-	decorator func(dest *api.User, src *UserWithPermissions) error,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -174,9 +333,84 @@ func UserFromUserWithPermissions(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// UserSliceToUserWithPermissionsSlice converts a slice of api.User with UserToUserWithPermissions,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithPermissionsSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithPermissions) error,
+) ([]*UserWithPermissions, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithPermissions, error) {
+		return UserToUserWithPermissions(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithPermissionsSlice converts a slice of UserWithPermissions with UserFromUserWithPermissions,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithPermissionsSlice(
+	src []*UserWithPermissions,
+	decorator func(*api.User, *UserWithPermissions) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithPermissions) (*api.User, error) {
+		return UserFromUserWithPermissions(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithPermissionsMap converts the values of a map with UserToUserWithPermissions,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithPermissionsMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithPermissions) error,
+) (map[K]*UserWithPermissions, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithPermissions, error) {
+		return UserToUserWithPermissions(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithPermissionsMap converts the values of a map with UserFromUserWithPermissions,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithPermissionsMap[K comparable](
+	src map[K]*UserWithPermissions,
+	decorator func(*api.User, *UserWithPermissions) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithPermissions) (*api.User, error) {
+		return UserFromUserWithPermissions(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithCustomTimestamps describes UserToUserWithCustomTimestamps in conversion errors.
+var conversionUserToUserWithCustomTimestamps = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithCustomTimestamps",
+}
+
+// conversionUserFromUserWithCustomTimestamps describes UserFromUserWithCustomTimestamps in conversion errors.
+var conversionUserFromUserWithCustomTimestamps = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithCustomTimestamps",
+	TargetType: "api.User",
+}
+
+// UserToUserWithCustomTimestamps converts a api.User to UserWithCustomTimestamps.
+// The optional decorator function allows custom field transformations.
+func UserToUserWithCustomTimestamps(
+	src *api.User,
+	dest *UserWithCustomTimestamps,
+	decorator func(*api.User, *UserWithCustomTimestamps) error,
+) (out *UserWithCustomTimestamps, err error) {
+	out, err = UserToUserWithCustomTimestampsWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -184,16 +418,20 @@ func UserFromUserWithPermissions(
 	return out, nil
 }
 
-// UserToUserWithCustomTimestamps converts a api.User to UserWithCustomTimestamps.
-// The optional decorator function allows custom field transformations.
-func UserToUserWithCustomTimestamps(
+// UserToUserWithCustomTimestampsWithOptions is UserToUserWithCustomTimestamps without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToUserWithCustomTimestampsWithOptions(
+	ctx context.Context,
 	src *api.User,
 	dest *UserWithCustomTimestamps,
-	decorator func(*api.User, *UserWithCustomTimestamps) error,
+	opts ...converters.ConvertOption,
 ) (out *UserWithCustomTimestamps, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserWithCustomTimestamps{}
 	}
@@ -224,26 +462,45 @@ func UserToUserWithCustomTimestamps(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserWithCustomTimestamps converts a UserWithCustomTimestamps back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromUserWithCustomTimestamps(
+	dest *api.User,
+	src *UserWithCustomTimestamps,
+	decorator func(dest *api.User, src *UserWithCustomTimestamps) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithCustomTimestampsWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserWithCustomTimestamps converts a UserWithCustomTimestamps back to api.User.
-// The optional decorator function allows custom field transformations.
-func UserFromUserWithCustomTimestamps(
+// UserFromUserWithCustomTimestampsWithOptions is UserFromUserWithCustomTimestamps without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromUserWithCustomTimestampsWithOptions(
+	ctx context.Context,
 	dest *api.User,
 	src *UserWithCustomTimestamps,
-	decorator func(dest *api.User, src *UserWithCustomTimestamps) error,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -262,9 +519,84 @@ func UserFromUserWithCustomTimestamps(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// UserSliceToUserWithCustomTimestampsSlice converts a slice of api.User with UserToUserWithCustomTimestamps,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithCustomTimestampsSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithCustomTimestamps) error,
+) ([]*UserWithCustomTimestamps, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithCustomTimestamps, error) {
+		return UserToUserWithCustomTimestamps(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithCustomTimestampsSlice converts a slice of UserWithCustomTimestamps with UserFromUserWithCustomTimestamps,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithCustomTimestampsSlice(
+	src []*UserWithCustomTimestamps,
+	decorator func(*api.User, *UserWithCustomTimestamps) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithCustomTimestamps) (*api.User, error) {
+		return UserFromUserWithCustomTimestamps(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithCustomTimestampsMap converts the values of a map with UserToUserWithCustomTimestamps,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithCustomTimestampsMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithCustomTimestamps) error,
+) (map[K]*UserWithCustomTimestamps, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithCustomTimestamps, error) {
+		return UserToUserWithCustomTimestamps(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithCustomTimestampsMap converts the values of a map with UserFromUserWithCustomTimestamps,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithCustomTimestampsMap[K comparable](
+	src map[K]*UserWithCustomTimestamps,
+	decorator func(*api.User, *UserWithCustomTimestamps) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithCustomTimestamps) (*api.User, error) {
+		return UserFromUserWithCustomTimestamps(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithIndexes describes UserToUserWithIndexes in conversion errors.
+var conversionUserToUserWithIndexes = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithIndexes",
+}
+
+// conversionUserFromUserWithIndexes describes UserFromUserWithIndexes in conversion errors.
+var conversionUserFromUserWithIndexes = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithIndexes",
+	TargetType: "api.User",
+}
+
+// UserToUserWithIndexes converts a api.User to UserWithIndexes.
+// The optional decorator function allows custom field transformations.
+func UserToUserWithIndexes(
+	src *api.User,
+	dest *UserWithIndexes,
+	decorator func(*api.User, *UserWithIndexes) error,
+) (out *UserWithIndexes, err error) {
+	out, err = UserToUserWithIndexesWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -272,16 +604,20 @@ func UserFromUserWithCustomTimestamps(
 	return out, nil
 }
 
-// UserToUserWithIndexes converts a api.User to UserWithIndexes.
-// The optional decorator function allows custom field transformations.
-func UserToUserWithIndexes(
+// UserToUserWithIndexesWithOptions is UserToUserWithIndexes without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToUserWithIndexesWithOptions(
+	ctx context.Context,
 	src *api.User,
 	dest *UserWithIndexes,
-	decorator func(*api.User, *UserWithIndexes) error,
+	opts ...converters.ConvertOption,
 ) (out *UserWithIndexes, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserWithIndexes{}
 	}
@@ -312,26 +648,45 @@ func UserToUserWithIndexes(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserWithIndexes converts a UserWithIndexes back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromUserWithIndexes(
+	dest *api.User,
+	src *UserWithIndexes,
+	decorator func(dest *api.User, src *UserWithIndexes) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithIndexesWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserWithIndexes converts a UserWithIndexes back to api.User.
-// The optional decorator function allows custom field transformations.
-func UserFromUserWithIndexes(
+// UserFromUserWithIndexesWithOptions is UserFromUserWithIndexes without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromUserWithIndexesWithOptions(
+	ctx context.Context,
 	dest *api.User,
 	src *UserWithIndexes,
-	decorator func(dest *api.User, src *UserWithIndexes) error,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -350,9 +705,84 @@ func UserFromUserWithIndexes(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// UserSliceToUserWithIndexesSlice converts a slice of api.User with UserToUserWithIndexes,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithIndexesSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithIndexes) error,
+) ([]*UserWithIndexes, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithIndexes, error) {
+		return UserToUserWithIndexes(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithIndexesSlice converts a slice of UserWithIndexes with UserFromUserWithIndexes,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithIndexesSlice(
+	src []*UserWithIndexes,
+	decorator func(*api.User, *UserWithIndexes) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithIndexes) (*api.User, error) {
+		return UserFromUserWithIndexes(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithIndexesMap converts the values of a map with UserToUserWithIndexes,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithIndexesMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithIndexes) error,
+) (map[K]*UserWithIndexes, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithIndexes, error) {
+		return UserToUserWithIndexes(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithIndexesMap converts the values of a map with UserFromUserWithIndexes,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithIndexesMap[K comparable](
+	src map[K]*UserWithIndexes,
+	decorator func(*api.User, *UserWithIndexes) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithIndexes) (*api.User, error) {
+		return UserFromUserWithIndexes(nil, value, decorator)
+	})
+}
+
+// conversionUserToUserWithDefaults describes UserToUserWithDefaults in conversion errors.
+var conversionUserToUserWithDefaults = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "UserWithDefaults",
+}
+
+// conversionUserFromUserWithDefaults describes UserFromUserWithDefaults in conversion errors.
+var conversionUserFromUserWithDefaults = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "UserWithDefaults",
+	TargetType: "api.User",
+}
+
+// UserToUserWithDefaults converts a api.User to UserWithDefaults.
+// The optional decorator function allows custom field transformations.
+func UserToUserWithDefaults(
+	src *api.User,
+	dest *UserWithDefaults,
+	decorator func(*api.User, *UserWithDefaults) error,
+) (out *UserWithDefaults, err error) {
+	out, err = UserToUserWithDefaultsWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -360,16 +790,20 @@ func UserFromUserWithIndexes(
 	return out, nil
 }
 
-// UserToUserWithDefaults converts a api.User to UserWithDefaults.
-// The optional decorator function allows custom field transformations.
-func UserToUserWithDefaults(
+// UserToUserWithDefaultsWithOptions is UserToUserWithDefaults without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToUserWithDefaultsWithOptions(
+	ctx context.Context,
 	src *api.User,
 	dest *UserWithDefaults,
-	decorator func(*api.User, *UserWithDefaults) error,
+	opts ...converters.ConvertOption,
 ) (out *UserWithDefaults, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UserWithDefaults{}
 	}
@@ -400,26 +834,45 @@ func UserToUserWithDefaults(
 		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
 	}
 
+	return dest, nil
+}
+
+// UserFromUserWithDefaults converts a UserWithDefaults back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromUserWithDefaults(
+	dest *api.User,
+	src *UserWithDefaults,
+	decorator func(dest *api.User, src *UserWithDefaults) error,
+) (out *api.User, err error) {
+	out, err = UserFromUserWithDefaultsWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UserFromUserWithDefaults converts a UserWithDefaults back to api.User.
-// The optional decorator function allows custom field transformations.
-func UserFromUserWithDefaults(
+// UserFromUserWithDefaultsWithOptions is UserFromUserWithDefaults without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromUserWithDefaultsWithOptions(
+	ctx context.Context,
 	dest *api.User,
 	src *UserWithDefaults,
-	decorator func(dest *api.User, src *UserWithDefaults) error,
+	opts ...converters.ConvertOption,
 ) (out *api.User, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.User{}
 	}
@@ -438,26 +891,105 @@ func UserFromUserWithDefaults(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return out, nil
 }
 
-// AuthorToAuthorGORM converts a api.Author to AuthorGORM.
-// The optional decorator function allows custom field transformations.
-func AuthorToAuthorGORM(
-	src *api.Author,
+// UserSliceToUserWithDefaultsSlice converts a slice of api.User with UserToUserWithDefaults,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToUserWithDefaultsSlice(
+	src []*api.User,
+	decorator func(*api.User, *UserWithDefaults) error,
+) ([]*UserWithDefaults, error) {
+	return converters.MapSlice(src, func(item *api.User) (*UserWithDefaults, error) {
+		return UserToUserWithDefaults(item, nil, decorator)
+	})
+}
+
+// UserSliceFromUserWithDefaultsSlice converts a slice of UserWithDefaults with UserFromUserWithDefaults,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromUserWithDefaultsSlice(
+	src []*UserWithDefaults,
+	decorator func(*api.User, *UserWithDefaults) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *UserWithDefaults) (*api.User, error) {
+		return UserFromUserWithDefaults(nil, item, decorator)
+	})
+}
+
+// UserMapToUserWithDefaultsMap converts the values of a map with UserToUserWithDefaults,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToUserWithDefaultsMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *UserWithDefaults) error,
+) (map[K]*UserWithDefaults, error) {
+	return converters.MapValues(src, func(value *api.User) (*UserWithDefaults, error) {
+		return UserToUserWithDefaults(value, nil, decorator)
+	})
+}
+
+// UserMapFromUserWithDefaultsMap converts the values of a map with UserFromUserWithDefaults,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromUserWithDefaultsMap[K comparable](
+	src map[K]*UserWithDefaults,
+	decorator func(*api.User, *UserWithDefaults) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *UserWithDefaults) (*api.User, error) {
+		return UserFromUserWithDefaults(nil, value, decorator)
+	})
+}
+
+// conversionAuthorToAuthorGORM describes AuthorToAuthorGORM in conversion errors.
+var conversionAuthorToAuthorGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "author",
+	SourceType: "api.Author",
+	TargetType: "AuthorGORM",
+}
+
+// conversionAuthorFromAuthorGORM describes AuthorFromAuthorGORM in conversion errors.
+var conversionAuthorFromAuthorGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "author",
+	SourceType: "AuthorGORM",
+	TargetType: "api.Author",
+}
+
+// AuthorToAuthorGORM converts a api.Author to AuthorGORM.
+// The optional decorator function allows custom field transformations.
+func AuthorToAuthorGORM(
+	src *api.Author,
 	dest *AuthorGORM,
 	decorator func(*api.Author, *AuthorGORM) error,
+) (out *AuthorGORM, err error) {
+	out, err = AuthorToAuthorGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// AuthorToAuthorGORMWithOptions is AuthorToAuthorGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func AuthorToAuthorGORMWithOptions(
+	ctx context.Context,
+	src *api.Author,
+	dest *AuthorGORM,
+	opts ...converters.ConvertOption,
 ) (out *AuthorGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &AuthorGORM{}
 	}
@@ -469,26 +1001,45 @@ func AuthorToAuthorGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// AuthorFromAuthorGORM converts a AuthorGORM back to api.Author.
+// The optional decorator function allows custom field transformations.
+func AuthorFromAuthorGORM(
+	dest *api.Author,
+	src *AuthorGORM,
+	decorator func(dest *api.Author, src *AuthorGORM) error,
+) (out *api.Author, err error) {
+	out, err = AuthorFromAuthorGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// AuthorFromAuthorGORM converts a AuthorGORM back to api.Author.
-// The optional decorator function allows custom field transformations.
-func AuthorFromAuthorGORM(
+// AuthorFromAuthorGORMWithOptions is AuthorFromAuthorGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func AuthorFromAuthorGORMWithOptions(
+	ctx context.Context,
 	dest *api.Author,
 	src *AuthorGORM,
-	decorator func(dest *api.Author, src *AuthorGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Author, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Author{}
 	}
@@ -500,9 +1051,84 @@ func AuthorFromAuthorGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// AuthorSliceToAuthorGORMSlice converts a slice of api.Author with AuthorToAuthorGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func AuthorSliceToAuthorGORMSlice(
+	src []*api.Author,
+	decorator func(*api.Author, *AuthorGORM) error,
+) ([]*AuthorGORM, error) {
+	return converters.MapSlice(src, func(item *api.Author) (*AuthorGORM, error) {
+		return AuthorToAuthorGORM(item, nil, decorator)
+	})
+}
+
+// AuthorSliceFromAuthorGORMSlice converts a slice of AuthorGORM with AuthorFromAuthorGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func AuthorSliceFromAuthorGORMSlice(
+	src []*AuthorGORM,
+	decorator func(*api.Author, *AuthorGORM) error,
+) ([]*api.Author, error) {
+	return converters.MapSlice(src, func(item *AuthorGORM) (*api.Author, error) {
+		return AuthorFromAuthorGORM(nil, item, decorator)
+	})
+}
+
+// AuthorMapToAuthorGORMMap converts the values of a map with AuthorToAuthorGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func AuthorMapToAuthorGORMMap[K comparable](
+	src map[K]*api.Author,
+	decorator func(*api.Author, *AuthorGORM) error,
+) (map[K]*AuthorGORM, error) {
+	return converters.MapValues(src, func(value *api.Author) (*AuthorGORM, error) {
+		return AuthorToAuthorGORM(value, nil, decorator)
+	})
+}
+
+// AuthorMapFromAuthorGORMMap converts the values of a map with AuthorFromAuthorGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func AuthorMapFromAuthorGORMMap[K comparable](
+	src map[K]*AuthorGORM,
+	decorator func(*api.Author, *AuthorGORM) error,
+) (map[K]*api.Author, error) {
+	return converters.MapValues(src, func(value *AuthorGORM) (*api.Author, error) {
+		return AuthorFromAuthorGORM(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogAsIsGORM describes BlogToBlogAsIsGORM in conversion errors.
+var conversionBlogToBlogAsIsGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogAsIsGORM",
+}
+
+// conversionBlogFromBlogAsIsGORM describes BlogFromBlogAsIsGORM in conversion errors.
+var conversionBlogFromBlogAsIsGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogAsIsGORM",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogAsIsGORM converts a api.Blog to BlogAsIsGORM.
+// The optional decorator function allows custom field transformations.
+func BlogToBlogAsIsGORM(
+	src *api.Blog,
+	dest *BlogAsIsGORM,
+	decorator func(*api.Blog, *BlogAsIsGORM) error,
+) (out *BlogAsIsGORM, err error) {
+	out, err = BlogToBlogAsIsGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -510,16 +1136,20 @@ func AuthorFromAuthorGORM(
 	return out, nil
 }
 
-// BlogToBlogAsIsGORM converts a api.Blog to BlogAsIsGORM.
-// The optional decorator function allows custom field transformations.
-func BlogToBlogAsIsGORM(
+// BlogToBlogAsIsGORMWithOptions is BlogToBlogAsIsGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogToBlogAsIsGORMWithOptions(
+	ctx context.Context,
 	src *api.Blog,
 	dest *BlogAsIsGORM,
-	decorator func(*api.Blog, *BlogAsIsGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *BlogAsIsGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &BlogAsIsGORM{}
 	}
@@ -533,32 +1163,51 @@ func BlogToBlogAsIsGORM(
 	out = dest
 
 	if src.Author != nil {
-		_, err = AuthorToAuthorGORM(src.Author, &out.Author, nil)
+		_, err = AuthorToAuthorGORMWithOptions(ctx, src.Author, &out.Author)
 		if err != nil {
-			return nil, fmt.Errorf("converting Author: %w", err)
+			return nil, conversionBlogToBlogAsIsGORM.FieldError(err, "author")
 		}
 	}
 
+	return dest, nil
+}
+
+// BlogFromBlogAsIsGORM converts a BlogAsIsGORM back to api.Blog.
+// The optional decorator function allows custom field transformations.
+func BlogFromBlogAsIsGORM(
+	dest *api.Blog,
+	src *BlogAsIsGORM,
+	decorator func(dest *api.Blog, src *BlogAsIsGORM) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogAsIsGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// BlogFromBlogAsIsGORM converts a BlogAsIsGORM back to api.Blog.
-// The optional decorator function allows custom field transformations.
-func BlogFromBlogAsIsGORM(
+// BlogFromBlogAsIsGORMWithOptions is BlogFromBlogAsIsGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogFromBlogAsIsGORMWithOptions(
+	ctx context.Context,
 	dest *api.Blog,
 	src *BlogAsIsGORM,
-	decorator func(dest *api.Blog, src *BlogAsIsGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Blog, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Blog{}
 	}
@@ -571,14 +1220,89 @@ func BlogFromBlogAsIsGORM(
 	}
 	out = dest
 
-	out.Author, err = AuthorFromAuthorGORM(nil, &src.Author, nil)
+	out.Author, err = AuthorFromAuthorGORMWithOptions(ctx, nil, &src.Author)
 	if err != nil {
-		return nil, fmt.Errorf("converting Author: %w", err)
+		return nil, conversionBlogFromBlogAsIsGORM.FieldError(err, "author")
+	}
+
+	return out, nil
+}
+
+// BlogSliceToBlogAsIsGORMSlice converts a slice of api.Blog with BlogToBlogAsIsGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogAsIsGORMSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogAsIsGORM) error,
+) ([]*BlogAsIsGORM, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogAsIsGORM, error) {
+		return BlogToBlogAsIsGORM(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogAsIsGORMSlice converts a slice of BlogAsIsGORM with BlogFromBlogAsIsGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogAsIsGORMSlice(
+	src []*BlogAsIsGORM,
+	decorator func(*api.Blog, *BlogAsIsGORM) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogAsIsGORM) (*api.Blog, error) {
+		return BlogFromBlogAsIsGORM(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogAsIsGORMMap converts the values of a map with BlogToBlogAsIsGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogAsIsGORMMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogAsIsGORM) error,
+) (map[K]*BlogAsIsGORM, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogAsIsGORM, error) {
+		return BlogToBlogAsIsGORM(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogAsIsGORMMap converts the values of a map with BlogFromBlogAsIsGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogAsIsGORMMap[K comparable](
+	src map[K]*BlogAsIsGORM,
+	decorator func(*api.Blog, *BlogAsIsGORM) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogAsIsGORM) (*api.Blog, error) {
+		return BlogFromBlogAsIsGORM(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogGORM describes BlogToBlogGORM in conversion errors.
+var conversionBlogToBlogGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogGORM",
+}
+
+// conversionBlogFromBlogGORM describes BlogFromBlogGORM in conversion errors.
+var conversionBlogFromBlogGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogGORM",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogGORM converts a api.Blog to BlogGORM.
+// The optional decorator function allows custom field transformations.
+func BlogToBlogGORM(
+	src *api.Blog,
+	dest *BlogGORM,
+	decorator func(*api.Blog, *BlogGORM) error,
+) (out *BlogGORM, err error) {
+	out, err = BlogToBlogGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -586,16 +1310,20 @@ func BlogFromBlogAsIsGORM(
 	return out, nil
 }
 
-// BlogToBlogGORM converts a api.Blog to BlogGORM.
-// The optional decorator function allows custom field transformations.
-func BlogToBlogGORM(
+// BlogToBlogGORMWithOptions is BlogToBlogGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogToBlogGORMWithOptions(
+	ctx context.Context,
 	src *api.Blog,
 	dest *BlogGORM,
-	decorator func(*api.Blog, *BlogGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *BlogGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &BlogGORM{}
 	}
@@ -609,32 +1337,51 @@ func BlogToBlogGORM(
 	out = dest
 
 	if src.Author != nil {
-		_, err = AuthorToAuthorGORM(src.Author, &out.Author, nil)
+		_, err = AuthorToAuthorGORMWithOptions(ctx, src.Author, &out.Author)
 		if err != nil {
-			return nil, fmt.Errorf("converting Author: %w", err)
+			return nil, conversionBlogToBlogGORM.FieldError(err, "author")
 		}
 	}
 
+	return dest, nil
+}
+
+// BlogFromBlogGORM converts a BlogGORM back to api.Blog.
+// The optional decorator function allows custom field transformations.
+func BlogFromBlogGORM(
+	dest *api.Blog,
+	src *BlogGORM,
+	decorator func(dest *api.Blog, src *BlogGORM) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// BlogFromBlogGORM converts a BlogGORM back to api.Blog.
-// The optional decorator function allows custom field transformations.
-func BlogFromBlogGORM(
+// BlogFromBlogGORMWithOptions is BlogFromBlogGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogFromBlogGORMWithOptions(
+	ctx context.Context,
 	dest *api.Blog,
 	src *BlogGORM,
-	decorator func(dest *api.Blog, src *BlogGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Blog, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Blog{}
 	}
@@ -647,14 +1394,89 @@ func BlogFromBlogGORM(
 	}
 	out = dest
 
-	out.Author, err = AuthorFromAuthorGORM(nil, &src.Author, nil)
+	out.Author, err = AuthorFromAuthorGORMWithOptions(ctx, nil, &src.Author)
 	if err != nil {
-		return nil, fmt.Errorf("converting Author: %w", err)
+		return nil, conversionBlogFromBlogGORM.FieldError(err, "author")
+	}
+
+	return out, nil
+}
+
+// BlogSliceToBlogGORMSlice converts a slice of api.Blog with BlogToBlogGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogGORMSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogGORM) error,
+) ([]*BlogGORM, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogGORM, error) {
+		return BlogToBlogGORM(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogGORMSlice converts a slice of BlogGORM with BlogFromBlogGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogGORMSlice(
+	src []*BlogGORM,
+	decorator func(*api.Blog, *BlogGORM) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogGORM) (*api.Blog, error) {
+		return BlogFromBlogGORM(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogGORMMap converts the values of a map with BlogToBlogGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogGORMMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogGORM) error,
+) (map[K]*BlogGORM, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogGORM, error) {
+		return BlogToBlogGORM(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogGORMMap converts the values of a map with BlogFromBlogGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogGORMMap[K comparable](
+	src map[K]*BlogGORM,
+	decorator func(*api.Blog, *BlogGORM) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogGORM) (*api.Blog, error) {
+		return BlogFromBlogGORM(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogFlatGORM describes BlogToBlogFlatGORM in conversion errors.
+var conversionBlogToBlogFlatGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogFlatGORM",
+}
+
+// conversionBlogFromBlogFlatGORM describes BlogFromBlogFlatGORM in conversion errors.
+var conversionBlogFromBlogFlatGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogFlatGORM",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogFlatGORM converts a api.Blog to BlogFlatGORM.
+// The optional decorator function allows custom field transformations.
+func BlogToBlogFlatGORM(
+	src *api.Blog,
+	dest *BlogFlatGORM,
+	decorator func(*api.Blog, *BlogFlatGORM) error,
+) (out *BlogFlatGORM, err error) {
+	out, err = BlogToBlogFlatGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -662,72 +1484,57 @@ func BlogFromBlogGORM(
 	return out, nil
 }
 
-// ProductToProductGORM converts a api.Product to ProductGORM.
-// The optional decorator function allows custom field transformations.
-func ProductToProductGORM(
-	src *api.Product,
-	dest *ProductGORM,
-	decorator func(*api.Product, *ProductGORM) error,
-) (out *ProductGORM, err error) {
+// BlogToBlogFlatGORMWithOptions is BlogToBlogFlatGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogToBlogFlatGORMWithOptions(
+	ctx context.Context,
+	src *api.Blog,
+	dest *BlogFlatGORM,
+	opts ...converters.ConvertOption,
+) (out *BlogFlatGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &ProductGORM{}
+		dest = &BlogFlatGORM{}
 	}
 
 	// Initialize struct with inline values
-	*dest = ProductGORM{
-		Id:         src.Id,
-		Name:       src.Name,
-		Tags:       src.Tags,
-		Categories: src.Categories,
-		Ratings:    src.Ratings,
+	*dest = BlogFlatGORM{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
 	}
 	out = dest
 
-	if src.Metadata != nil {
-		out.Metadata = src.Metadata
-	}
-
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
+	if src.Author != nil {
+		_, err = AuthorToAuthorGORMWithOptions(ctx, src.Author, &out.Author)
+		if err != nil {
+			return nil, conversionBlogToBlogFlatGORM.FieldError(err, "author")
 		}
 	}
 
 	return dest, nil
 }
 
-// ProductFromProductGORM converts a ProductGORM back to api.Product.
+// BlogFromBlogFlatGORM converts a BlogFlatGORM back to api.Blog.
 // The optional decorator function allows custom field transformations.
-func ProductFromProductGORM(
-	dest *api.Product,
-	src *ProductGORM,
-	decorator func(dest *api.Product, src *ProductGORM) error,
-) (out *api.Product, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &api.Product{}
-	}
-
-	// Initialize struct with inline values
-	*dest = api.Product{
-		Id:         src.Id,
-		Name:       src.Name,
-		Tags:       src.Tags,
-		Categories: src.Categories,
-		Metadata:   src.Metadata,
-		Ratings:    src.Ratings,
+func BlogFromBlogFlatGORM(
+	dest *api.Blog,
+	src *BlogFlatGORM,
+	decorator func(dest *api.Blog, src *BlogFlatGORM) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogFlatGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
 	}
-	out = dest
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
@@ -735,57 +1542,722 @@ func ProductFromProductGORM(
 	return out, nil
 }
 
-// LibraryToLibraryGORM converts a api.Library to LibraryGORM.
-// The optional decorator function allows custom field transformations.
-func LibraryToLibraryGORM(
-	src *api.Library,
-	dest *LibraryGORM,
-	decorator func(*api.Library, *LibraryGORM) error,
-) (out *LibraryGORM, err error) {
+// BlogFromBlogFlatGORMWithOptions is BlogFromBlogFlatGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogFromBlogFlatGORMWithOptions(
+	ctx context.Context,
+	dest *api.Blog,
+	src *BlogFlatGORM,
+	opts ...converters.ConvertOption,
+) (out *api.Blog, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
-		dest = &LibraryGORM{}
+		dest = &api.Blog{}
 	}
 
 	// Initialize struct with inline values
-	*dest = LibraryGORM{
-		Id:   src.Id,
-		Name: src.Name,
+	*dest = api.Blog{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
 	}
 	out = dest
 
-	if src.Contributors != nil {
-		out.Contributors = make([]AuthorGORM, len(src.Contributors))
-		for i, item := range src.Contributors {
-			_, err = AuthorToAuthorGORM(item, &out.Contributors[i], nil)
-			if err != nil {
-				return nil, fmt.Errorf("converting Contributors[%d]: %w", i, err)
+	out.Author, err = AuthorFromAuthorGORMWithOptions(ctx, nil, &src.Author)
+	if err != nil {
+		return nil, conversionBlogFromBlogFlatGORM.FieldError(err, "author")
+	}
+
+	return out, nil
+}
+
+// BlogSliceToBlogFlatGORMSlice converts a slice of api.Blog with BlogToBlogFlatGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogFlatGORMSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogFlatGORM) error,
+) ([]*BlogFlatGORM, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogFlatGORM, error) {
+		return BlogToBlogFlatGORM(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogFlatGORMSlice converts a slice of BlogFlatGORM with BlogFromBlogFlatGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogFlatGORMSlice(
+	src []*BlogFlatGORM,
+	decorator func(*api.Blog, *BlogFlatGORM) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogFlatGORM) (*api.Blog, error) {
+		return BlogFromBlogFlatGORM(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogFlatGORMMap converts the values of a map with BlogToBlogFlatGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogFlatGORMMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogFlatGORM) error,
+) (map[K]*BlogFlatGORM, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogFlatGORM, error) {
+		return BlogToBlogFlatGORM(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogFlatGORMMap converts the values of a map with BlogFromBlogFlatGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogFlatGORMMap[K comparable](
+	src map[K]*BlogFlatGORM,
+	decorator func(*api.Blog, *BlogFlatGORM) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogFlatGORM) (*api.Blog, error) {
+		return BlogFromBlogFlatGORM(nil, value, decorator)
+	})
+}
+
+// conversionBlogToBlogBlobGORM describes BlogToBlogBlobGORM in conversion errors.
+var conversionBlogToBlogBlobGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "blog",
+	SourceType: "api.Blog",
+	TargetType: "BlogBlobGORM",
+}
+
+// conversionBlogFromBlogBlobGORM describes BlogFromBlogBlobGORM in conversion errors.
+var conversionBlogFromBlogBlobGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "blog",
+	SourceType: "BlogBlobGORM",
+	TargetType: "api.Blog",
+}
+
+// BlogToBlogBlobGORM converts a api.Blog to BlogBlobGORM.
+// The optional decorator function allows custom field transformations.
+func BlogToBlogBlobGORM(
+	src *api.Blog,
+	dest *BlogBlobGORM,
+	decorator func(*api.Blog, *BlogBlobGORM) error,
+) (out *BlogBlobGORM, err error) {
+	out, err = BlogToBlogBlobGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// BlogToBlogBlobGORMWithOptions is BlogToBlogBlobGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogToBlogBlobGORMWithOptions(
+	ctx context.Context,
+	src *api.Blog,
+	dest *BlogBlobGORM,
+	opts ...converters.ConvertOption,
+) (out *BlogBlobGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &BlogBlobGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = BlogBlobGORM{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
+	}
+	out = dest
+
+	if src.Author != nil {
+		out.Author, err = converters.MessageToBytes(src.Author)
+		if err != nil {
+			return nil, conversionBlogToBlogBlobGORM.FieldError(err, "author")
+		}
+	}
+
+	return dest, nil
+}
+
+// BlogFromBlogBlobGORM converts a BlogBlobGORM back to api.Blog.
+// The optional decorator function allows custom field transformations.
+func BlogFromBlogBlobGORM(
+	dest *api.Blog,
+	src *BlogBlobGORM,
+	decorator func(dest *api.Blog, src *BlogBlobGORM) error,
+) (out *api.Blog, err error) {
+	out, err = BlogFromBlogBlobGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// BlogFromBlogBlobGORMWithOptions is BlogFromBlogBlobGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func BlogFromBlogBlobGORMWithOptions(
+	ctx context.Context,
+	dest *api.Blog,
+	src *BlogBlobGORM,
+	opts ...converters.ConvertOption,
+) (out *api.Blog, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Blog{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Blog{
+		Id:      src.Id,
+		Upvotes: src.Upvotes,
+		Title:   src.Title,
+	}
+	out = dest
+
+	out.Author, err = converters.BytesToMessage[*api.Author](src.Author)
+	if err != nil {
+		return nil, conversionBlogFromBlogBlobGORM.FieldError(err, "author")
+	}
+
+	return out, nil
+}
+
+// BlogSliceToBlogBlobGORMSlice converts a slice of api.Blog with BlogToBlogBlobGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceToBlogBlobGORMSlice(
+	src []*api.Blog,
+	decorator func(*api.Blog, *BlogBlobGORM) error,
+) ([]*BlogBlobGORM, error) {
+	return converters.MapSlice(src, func(item *api.Blog) (*BlogBlobGORM, error) {
+		return BlogToBlogBlobGORM(item, nil, decorator)
+	})
+}
+
+// BlogSliceFromBlogBlobGORMSlice converts a slice of BlogBlobGORM with BlogFromBlogBlobGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func BlogSliceFromBlogBlobGORMSlice(
+	src []*BlogBlobGORM,
+	decorator func(*api.Blog, *BlogBlobGORM) error,
+) ([]*api.Blog, error) {
+	return converters.MapSlice(src, func(item *BlogBlobGORM) (*api.Blog, error) {
+		return BlogFromBlogBlobGORM(nil, item, decorator)
+	})
+}
+
+// BlogMapToBlogBlobGORMMap converts the values of a map with BlogToBlogBlobGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapToBlogBlobGORMMap[K comparable](
+	src map[K]*api.Blog,
+	decorator func(*api.Blog, *BlogBlobGORM) error,
+) (map[K]*BlogBlobGORM, error) {
+	return converters.MapValues(src, func(value *api.Blog) (*BlogBlobGORM, error) {
+		return BlogToBlogBlobGORM(value, nil, decorator)
+	})
+}
+
+// BlogMapFromBlogBlobGORMMap converts the values of a map with BlogFromBlogBlobGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func BlogMapFromBlogBlobGORMMap[K comparable](
+	src map[K]*BlogBlobGORM,
+	decorator func(*api.Blog, *BlogBlobGORM) error,
+) (map[K]*api.Blog, error) {
+	return converters.MapValues(src, func(value *BlogBlobGORM) (*api.Blog, error) {
+		return BlogFromBlogBlobGORM(nil, value, decorator)
+	})
+}
+
+// conversionProductToProductGORM describes ProductToProductGORM in conversion errors.
+var conversionProductToProductGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "product",
+	SourceType: "api.Product",
+	TargetType: "ProductGORM",
+}
+
+// conversionProductFromProductGORM describes ProductFromProductGORM in conversion errors.
+var conversionProductFromProductGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "product",
+	SourceType: "ProductGORM",
+	TargetType: "api.Product",
+}
+
+// ProductToProductGORM converts a api.Product to ProductGORM.
+// The optional decorator function allows custom field transformations.
+func ProductToProductGORM(
+	src *api.Product,
+	dest *ProductGORM,
+	decorator func(*api.Product, *ProductGORM) error,
+) (out *ProductGORM, err error) {
+	out, err = ProductToProductGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ProductToProductGORMWithOptions is ProductToProductGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func ProductToProductGORMWithOptions(
+	ctx context.Context,
+	src *api.Product,
+	dest *ProductGORM,
+	opts ...converters.ConvertOption,
+) (out *ProductGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &ProductGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = ProductGORM{
+		Id:         src.Id,
+		Name:       src.Name,
+		Tags:       src.Tags,
+		Categories: src.Categories,
+		Ratings:    src.Ratings,
+	}
+	out = dest
+
+	if src.Metadata != nil {
+		out.Metadata = src.Metadata
+	}
+
+	return dest, nil
+}
+
+// ProductFromProductGORM converts a ProductGORM back to api.Product.
+// The optional decorator function allows custom field transformations.
+func ProductFromProductGORM(
+	dest *api.Product,
+	src *ProductGORM,
+	decorator func(dest *api.Product, src *ProductGORM) error,
+) (out *api.Product, err error) {
+	out, err = ProductFromProductGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ProductFromProductGORMWithOptions is ProductFromProductGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func ProductFromProductGORMWithOptions(
+	ctx context.Context,
+	dest *api.Product,
+	src *ProductGORM,
+	opts ...converters.ConvertOption,
+) (out *api.Product, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Product{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Product{
+		Id:         src.Id,
+		Name:       src.Name,
+		Tags:       src.Tags,
+		Categories: src.Categories,
+		Metadata:   src.Metadata,
+		Ratings:    src.Ratings,
+	}
+	out = dest
+
+	return out, nil
+}
+
+// ProductSliceToProductGORMSlice converts a slice of api.Product with ProductToProductGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func ProductSliceToProductGORMSlice(
+	src []*api.Product,
+	decorator func(*api.Product, *ProductGORM) error,
+) ([]*ProductGORM, error) {
+	return converters.MapSlice(src, func(item *api.Product) (*ProductGORM, error) {
+		return ProductToProductGORM(item, nil, decorator)
+	})
+}
+
+// ProductSliceFromProductGORMSlice converts a slice of ProductGORM with ProductFromProductGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func ProductSliceFromProductGORMSlice(
+	src []*ProductGORM,
+	decorator func(*api.Product, *ProductGORM) error,
+) ([]*api.Product, error) {
+	return converters.MapSlice(src, func(item *ProductGORM) (*api.Product, error) {
+		return ProductFromProductGORM(nil, item, decorator)
+	})
+}
+
+// ProductMapToProductGORMMap converts the values of a map with ProductToProductGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func ProductMapToProductGORMMap[K comparable](
+	src map[K]*api.Product,
+	decorator func(*api.Product, *ProductGORM) error,
+) (map[K]*ProductGORM, error) {
+	return converters.MapValues(src, func(value *api.Product) (*ProductGORM, error) {
+		return ProductToProductGORM(value, nil, decorator)
+	})
+}
+
+// ProductMapFromProductGORMMap converts the values of a map with ProductFromProductGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func ProductMapFromProductGORMMap[K comparable](
+	src map[K]*ProductGORM,
+	decorator func(*api.Product, *ProductGORM) error,
+) (map[K]*api.Product, error) {
+	return converters.MapValues(src, func(value *ProductGORM) (*api.Product, error) {
+		return ProductFromProductGORM(nil, value, decorator)
+	})
+}
+
+// conversionLibraryToLibraryGORM describes LibraryToLibraryGORM in conversion errors.
+var conversionLibraryToLibraryGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "library",
+	SourceType: "api.Library",
+	TargetType: "LibraryGORM",
+}
+
+// conversionLibraryFromLibraryGORM describes LibraryFromLibraryGORM in conversion errors.
+var conversionLibraryFromLibraryGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "library",
+	SourceType: "LibraryGORM",
+	TargetType: "api.Library",
+}
+
+// LibraryToLibraryGORM converts a api.Library to LibraryGORM.
+// The optional decorator function allows custom field transformations.
+func LibraryToLibraryGORM(
+	src *api.Library,
+	dest *LibraryGORM,
+	decorator func(*api.Library, *LibraryGORM) error,
+) (out *LibraryGORM, err error) {
+	out, err = LibraryToLibraryGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// LibraryToLibraryGORMWithOptions is LibraryToLibraryGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func LibraryToLibraryGORMWithOptions(
+	ctx context.Context,
+	src *api.Library,
+	dest *LibraryGORM,
+	opts ...converters.ConvertOption,
+) (out *LibraryGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &LibraryGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = LibraryGORM{
+		Id:   src.Id,
+		Name: src.Name,
+	}
+	out = dest
+
+	if src.Contributors != nil {
+		out.Contributors = make([]AuthorGORM, len(src.Contributors))
+		for i, item := range src.Contributors {
+			_, err = AuthorToAuthorGORMWithOptions(ctx, item, &out.Contributors[i])
+			if err != nil {
+				return nil, conversionLibraryToLibraryGORM.ElementError(err, "contributors", i)
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// LibraryFromLibraryGORM converts a LibraryGORM back to api.Library.
+// The optional decorator function allows custom field transformations.
+func LibraryFromLibraryGORM(
+	dest *api.Library,
+	src *LibraryGORM,
+	decorator func(dest *api.Library, src *LibraryGORM) error,
+) (out *api.Library, err error) {
+	out, err = LibraryFromLibraryGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// LibraryFromLibraryGORMWithOptions is LibraryFromLibraryGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func LibraryFromLibraryGORMWithOptions(
+	ctx context.Context,
+	dest *api.Library,
+	src *LibraryGORM,
+	opts ...converters.ConvertOption,
+) (out *api.Library, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Library{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Library{
+		Id:   src.Id,
+		Name: src.Name,
+	}
+	out = dest
+
+	if src.Contributors != nil {
+		out.Contributors = make([]*api.Author, len(src.Contributors))
+		for i, item := range src.Contributors {
+			out.Contributors[i], err = AuthorFromAuthorGORMWithOptions(ctx, nil, &item)
+			if err != nil {
+				return nil, conversionLibraryFromLibraryGORM.ElementError(err, "contributors", i)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// LibrarySliceToLibraryGORMSlice converts a slice of api.Library with LibraryToLibraryGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceToLibraryGORMSlice(
+	src []*api.Library,
+	decorator func(*api.Library, *LibraryGORM) error,
+) ([]*LibraryGORM, error) {
+	return converters.MapSlice(src, func(item *api.Library) (*LibraryGORM, error) {
+		return LibraryToLibraryGORM(item, nil, decorator)
+	})
+}
+
+// LibrarySliceFromLibraryGORMSlice converts a slice of LibraryGORM with LibraryFromLibraryGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceFromLibraryGORMSlice(
+	src []*LibraryGORM,
+	decorator func(*api.Library, *LibraryGORM) error,
+) ([]*api.Library, error) {
+	return converters.MapSlice(src, func(item *LibraryGORM) (*api.Library, error) {
+		return LibraryFromLibraryGORM(nil, item, decorator)
+	})
+}
+
+// LibraryMapToLibraryGORMMap converts the values of a map with LibraryToLibraryGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapToLibraryGORMMap[K comparable](
+	src map[K]*api.Library,
+	decorator func(*api.Library, *LibraryGORM) error,
+) (map[K]*LibraryGORM, error) {
+	return converters.MapValues(src, func(value *api.Library) (*LibraryGORM, error) {
+		return LibraryToLibraryGORM(value, nil, decorator)
+	})
+}
+
+// LibraryMapFromLibraryGORMMap converts the values of a map with LibraryFromLibraryGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapFromLibraryGORMMap[K comparable](
+	src map[K]*LibraryGORM,
+	decorator func(*api.Library, *LibraryGORM) error,
+) (map[K]*api.Library, error) {
+	return converters.MapValues(src, func(value *LibraryGORM) (*api.Library, error) {
+		return LibraryFromLibraryGORM(nil, value, decorator)
+	})
+}
+
+// conversionLibraryToLibraryChildGORM describes LibraryToLibraryChildGORM in conversion errors.
+var conversionLibraryToLibraryChildGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "library",
+	SourceType: "api.Library",
+	TargetType: "LibraryChildGORM",
+}
+
+// conversionLibraryFromLibraryChildGORM describes LibraryFromLibraryChildGORM in conversion errors.
+var conversionLibraryFromLibraryChildGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "library",
+	SourceType: "LibraryChildGORM",
+	TargetType: "api.Library",
+}
+
+// LibraryToLibraryChildGORM converts a api.Library to LibraryChildGORM.
+// The optional decorator function allows custom field transformations.
+func LibraryToLibraryChildGORM(
+	src *api.Library,
+	dest *LibraryChildGORM,
+	decorator func(*api.Library, *LibraryChildGORM) error,
+) (out *LibraryChildGORM, err error) {
+	out, err = LibraryToLibraryChildGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// LibraryToLibraryChildGORMWithOptions is LibraryToLibraryChildGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func LibraryToLibraryChildGORMWithOptions(
+	ctx context.Context,
+	src *api.Library,
+	dest *LibraryChildGORM,
+	opts ...converters.ConvertOption,
+) (out *LibraryChildGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &LibraryChildGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = LibraryChildGORM{
+		Id:   src.Id,
+		Name: src.Name,
+	}
+	out = dest
+
+	if src.Contributors != nil {
+		out.Contributors = make([]LibraryChildGORMContributorsChild, len(src.Contributors))
+		for i, item := range src.Contributors {
+			out.Contributors[i].Ordinal = i
+			_, err = AuthorToAuthorGORMWithOptions(ctx, item, &out.Contributors[i].Value)
+			if err != nil {
+				return nil, conversionLibraryToLibraryChildGORM.ElementError(err, "contributors", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// LibraryFromLibraryChildGORM converts a LibraryChildGORM back to api.Library.
+// The optional decorator function allows custom field transformations.
+func LibraryFromLibraryChildGORM(
+	dest *api.Library,
+	src *LibraryChildGORM,
+	decorator func(dest *api.Library, src *LibraryChildGORM) error,
+) (out *api.Library, err error) {
+	out, err = LibraryFromLibraryChildGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// LibraryFromLibraryGORM converts a LibraryGORM back to api.Library.
-// The optional decorator function allows custom field transformations.
-func LibraryFromLibraryGORM(
+// LibraryFromLibraryChildGORMWithOptions is LibraryFromLibraryChildGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func LibraryFromLibraryChildGORMWithOptions(
+	ctx context.Context,
 	dest *api.Library,
-	src *LibraryGORM,
-	decorator func(dest *api.Library, src *LibraryGORM) error,
+	src *LibraryChildGORM,
+	opts ...converters.ConvertOption,
 ) (out *api.Library, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Library{}
 	}
@@ -800,16 +2272,337 @@ func LibraryFromLibraryGORM(
 	if src.Contributors != nil {
 		out.Contributors = make([]*api.Author, len(src.Contributors))
 		for i, item := range src.Contributors {
-			out.Contributors[i], err = AuthorFromAuthorGORM(nil, &item, nil)
+			out.Contributors[i], err = AuthorFromAuthorGORMWithOptions(ctx, nil, &item.Value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Contributors[%d]: %w", i, err)
+				return nil, conversionLibraryFromLibraryChildGORM.ElementError(err, "contributors", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// LibrarySliceToLibraryChildGORMSlice converts a slice of api.Library with LibraryToLibraryChildGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceToLibraryChildGORMSlice(
+	src []*api.Library,
+	decorator func(*api.Library, *LibraryChildGORM) error,
+) ([]*LibraryChildGORM, error) {
+	return converters.MapSlice(src, func(item *api.Library) (*LibraryChildGORM, error) {
+		return LibraryToLibraryChildGORM(item, nil, decorator)
+	})
+}
+
+// LibrarySliceFromLibraryChildGORMSlice converts a slice of LibraryChildGORM with LibraryFromLibraryChildGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func LibrarySliceFromLibraryChildGORMSlice(
+	src []*LibraryChildGORM,
+	decorator func(*api.Library, *LibraryChildGORM) error,
+) ([]*api.Library, error) {
+	return converters.MapSlice(src, func(item *LibraryChildGORM) (*api.Library, error) {
+		return LibraryFromLibraryChildGORM(nil, item, decorator)
+	})
+}
+
+// LibraryMapToLibraryChildGORMMap converts the values of a map with LibraryToLibraryChildGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapToLibraryChildGORMMap[K comparable](
+	src map[K]*api.Library,
+	decorator func(*api.Library, *LibraryChildGORM) error,
+) (map[K]*LibraryChildGORM, error) {
+	return converters.MapValues(src, func(value *api.Library) (*LibraryChildGORM, error) {
+		return LibraryToLibraryChildGORM(value, nil, decorator)
+	})
+}
+
+// LibraryMapFromLibraryChildGORMMap converts the values of a map with LibraryFromLibraryChildGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func LibraryMapFromLibraryChildGORMMap[K comparable](
+	src map[K]*LibraryChildGORM,
+	decorator func(*api.Library, *LibraryChildGORM) error,
+) (map[K]*api.Library, error) {
+	return converters.MapValues(src, func(value *LibraryChildGORM) (*api.Library, error) {
+		return LibraryFromLibraryChildGORM(nil, value, decorator)
+	})
+}
+
+// conversionUserToTenantUserGORM describes UserToTenantUserGORM in conversion errors.
+var conversionUserToTenantUserGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "user",
+	SourceType: "api.User",
+	TargetType: "TenantUserGORM",
+}
+
+// conversionUserFromTenantUserGORM describes UserFromTenantUserGORM in conversion errors.
+var conversionUserFromTenantUserGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "user",
+	SourceType: "TenantUserGORM",
+	TargetType: "api.User",
+}
+
+// UserToTenantUserGORM converts a api.User to TenantUserGORM.
+// The optional decorator function allows custom field transformations.
+func UserToTenantUserGORM(
+	src *api.User,
+	dest *TenantUserGORM,
+	decorator func(*api.User, *TenantUserGORM) error,
+) (out *TenantUserGORM, err error) {
+	out, err = UserToTenantUserGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserToTenantUserGORMWithOptions is UserToTenantUserGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserToTenantUserGORMWithOptions(
+	ctx context.Context,
+	src *api.User,
+	dest *TenantUserGORM,
+	opts ...converters.ConvertOption,
+) (out *TenantUserGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &TenantUserGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TenantUserGORM{
+		Id:           src.Id,
+		Name:         src.Name,
+		Email:        src.Email,
+		Age:          src.Age,
+		MemberNumber: src.MemberNumber,
+	}
+	out = dest
+
+	if src.Birthday != nil {
+		out.Birthday = converters.TimestampToTime(src.Birthday)
+	}
+
+	if src.ActivatedAt != nil {
+		out.ActivatedAt = converters.TimestampToTime(src.ActivatedAt)
+	}
+
+	if src.CreatedAt != nil {
+		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
+	}
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	return dest, nil
+}
+
+// UserFromTenantUserGORM converts a TenantUserGORM back to api.User.
+// The optional decorator function allows custom field transformations.
+func UserFromTenantUserGORM(
+	dest *api.User,
+	src *TenantUserGORM,
+	decorator func(dest *api.User, src *TenantUserGORM) error,
+) (out *api.User, err error) {
+	out, err = UserFromTenantUserGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserFromTenantUserGORMWithOptions is UserFromTenantUserGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UserFromTenantUserGORMWithOptions(
+	ctx context.Context,
+	dest *api.User,
+	src *TenantUserGORM,
+	opts ...converters.ConvertOption,
+) (out *api.User, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.User{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.User{
+		Id:           src.Id,
+		Name:         src.Name,
+		Email:        src.Email,
+		Age:          src.Age,
+		Birthday:     converters.TimeToTimestamp(src.Birthday),
+		MemberNumber: src.MemberNumber,
+		ActivatedAt:  converters.TimeToTimestamp(src.ActivatedAt),
+		CreatedAt:    converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:    converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	return out, nil
+}
+
+// UserSliceToTenantUserGORMSlice converts a slice of api.User with UserToTenantUserGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceToTenantUserGORMSlice(
+	src []*api.User,
+	decorator func(*api.User, *TenantUserGORM) error,
+) ([]*TenantUserGORM, error) {
+	return converters.MapSlice(src, func(item *api.User) (*TenantUserGORM, error) {
+		return UserToTenantUserGORM(item, nil, decorator)
+	})
+}
+
+// UserSliceFromTenantUserGORMSlice converts a slice of TenantUserGORM with UserFromTenantUserGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UserSliceFromTenantUserGORMSlice(
+	src []*TenantUserGORM,
+	decorator func(*api.User, *TenantUserGORM) error,
+) ([]*api.User, error) {
+	return converters.MapSlice(src, func(item *TenantUserGORM) (*api.User, error) {
+		return UserFromTenantUserGORM(nil, item, decorator)
+	})
+}
+
+// UserMapToTenantUserGORMMap converts the values of a map with UserToTenantUserGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapToTenantUserGORMMap[K comparable](
+	src map[K]*api.User,
+	decorator func(*api.User, *TenantUserGORM) error,
+) (map[K]*TenantUserGORM, error) {
+	return converters.MapValues(src, func(value *api.User) (*TenantUserGORM, error) {
+		return UserToTenantUserGORM(value, nil, decorator)
+	})
+}
+
+// UserMapFromTenantUserGORMMap converts the values of a map with UserFromTenantUserGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UserMapFromTenantUserGORMMap[K comparable](
+	src map[K]*TenantUserGORM,
+	decorator func(*api.User, *TenantUserGORM) error,
+) (map[K]*api.User, error) {
+	return converters.MapValues(src, func(value *TenantUserGORM) (*api.User, error) {
+		return UserFromTenantUserGORM(nil, value, decorator)
+	})
+}
+
+// conversionNoteToNoteGORM describes NoteToNoteGORM in conversion errors.
+var conversionNoteToNoteGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "note",
+	SourceType: "api.Note",
+	TargetType: "NoteGORM",
+}
+
+// conversionNoteFromNoteGORM describes NoteFromNoteGORM in conversion errors.
+var conversionNoteFromNoteGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "note",
+	SourceType: "NoteGORM",
+	TargetType: "api.Note",
+}
+
+// NoteToNoteGORM converts a api.Note to NoteGORM.
+// The optional decorator function allows custom field transformations.
+func NoteToNoteGORM(
+	src *api.Note,
+	dest *NoteGORM,
+	decorator func(*api.Note, *NoteGORM) error,
+) (out *NoteGORM, err error) {
+	out, err = NoteToNoteGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// NoteToNoteGORMWithOptions is NoteToNoteGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func NoteToNoteGORMWithOptions(
+	ctx context.Context,
+	src *api.Note,
+	dest *NoteGORM,
+	opts ...converters.ConvertOption,
+) (out *NoteGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &NoteGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = NoteGORM{
+		Id:        NoteID(src.Id),
+		Text:      src.Text,
+		CreatedBy: src.CreatedBy,
+		UpdatedBy: src.UpdatedBy,
+	}
+	out = dest
+
+	if src.CreatedAt != nil {
+		out.CreatedAt = converters.TimestampToTime(src.CreatedAt)
+	}
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	return dest, nil
+}
+
+// NoteFromNoteGORM converts a NoteGORM back to api.Note.
+// The optional decorator function allows custom field transformations.
+func NoteFromNoteGORM(
+	dest *api.Note,
+	src *NoteGORM,
+	decorator func(dest *api.Note, src *NoteGORM) error,
+) (out *api.Note, err error) {
+	out, err = NoteFromNoteGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
@@ -817,16 +2610,134 @@ func LibraryFromLibraryGORM(
 	return out, nil
 }
 
+// NoteFromNoteGORMWithOptions is NoteFromNoteGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func NoteFromNoteGORMWithOptions(
+	ctx context.Context,
+	dest *api.Note,
+	src *NoteGORM,
+	opts ...converters.ConvertOption,
+) (out *api.Note, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &api.Note{}
+	}
+
+	// Initialize struct with inline values
+	*dest = api.Note{
+		Id:        uint32(src.Id),
+		Text:      src.Text,
+		CreatedBy: src.CreatedBy,
+		UpdatedBy: src.UpdatedBy,
+		CreatedAt: converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt: converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	return out, nil
+}
+
+// NoteSliceToNoteGORMSlice converts a slice of api.Note with NoteToNoteGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func NoteSliceToNoteGORMSlice(
+	src []*api.Note,
+	decorator func(*api.Note, *NoteGORM) error,
+) ([]*NoteGORM, error) {
+	return converters.MapSlice(src, func(item *api.Note) (*NoteGORM, error) {
+		return NoteToNoteGORM(item, nil, decorator)
+	})
+}
+
+// NoteSliceFromNoteGORMSlice converts a slice of NoteGORM with NoteFromNoteGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func NoteSliceFromNoteGORMSlice(
+	src []*NoteGORM,
+	decorator func(*api.Note, *NoteGORM) error,
+) ([]*api.Note, error) {
+	return converters.MapSlice(src, func(item *NoteGORM) (*api.Note, error) {
+		return NoteFromNoteGORM(nil, item, decorator)
+	})
+}
+
+// NoteMapToNoteGORMMap converts the values of a map with NoteToNoteGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func NoteMapToNoteGORMMap[K comparable](
+	src map[K]*api.Note,
+	decorator func(*api.Note, *NoteGORM) error,
+) (map[K]*NoteGORM, error) {
+	return converters.MapValues(src, func(value *api.Note) (*NoteGORM, error) {
+		return NoteToNoteGORM(value, nil, decorator)
+	})
+}
+
+// NoteMapFromNoteGORMMap converts the values of a map with NoteFromNoteGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func NoteMapFromNoteGORMMap[K comparable](
+	src map[K]*NoteGORM,
+	decorator func(*api.Note, *NoteGORM) error,
+) (map[K]*api.Note, error) {
+	return converters.MapValues(src, func(value *NoteGORM) (*api.Note, error) {
+		return NoteFromNoteGORM(nil, value, decorator)
+	})
+}
+
+// conversionOrganizationToOrganizationGORM describes OrganizationToOrganizationGORM in conversion errors.
+var conversionOrganizationToOrganizationGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "organization",
+	SourceType: "api.Organization",
+	TargetType: "OrganizationGORM",
+}
+
+// conversionOrganizationFromOrganizationGORM describes OrganizationFromOrganizationGORM in conversion errors.
+var conversionOrganizationFromOrganizationGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "organization",
+	SourceType: "OrganizationGORM",
+	TargetType: "api.Organization",
+}
+
 // OrganizationToOrganizationGORM converts a api.Organization to OrganizationGORM.
 // The optional decorator function allows custom field transformations.
 func OrganizationToOrganizationGORM(
 	src *api.Organization,
 	dest *OrganizationGORM,
 	decorator func(*api.Organization, *OrganizationGORM) error,
+) (out *OrganizationGORM, err error) {
+	out, err = OrganizationToOrganizationGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// OrganizationToOrganizationGORMWithOptions is OrganizationToOrganizationGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func OrganizationToOrganizationGORMWithOptions(
+	ctx context.Context,
+	src *api.Organization,
+	dest *OrganizationGORM,
+	opts ...converters.ConvertOption,
 ) (out *OrganizationGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &OrganizationGORM{}
 	}
@@ -842,34 +2753,53 @@ func OrganizationToOrganizationGORM(
 		out.Departments = make(map[string]AuthorGORM, len(src.Departments))
 		for key, value := range src.Departments {
 			var converted AuthorGORM
-			_, err = AuthorToAuthorGORM(value, &converted, nil)
+			_, err = AuthorToAuthorGORMWithOptions(ctx, value, &converted)
 			if err != nil {
-				return nil, fmt.Errorf("converting Departments[%v]: %w", key, err)
+				return nil, conversionOrganizationToOrganizationGORM.ElementError(err, "departments", key)
 			}
 			out.Departments[key] = converted
 		}
 	}
 
+	return dest, nil
+}
+
+// OrganizationFromOrganizationGORM converts a OrganizationGORM back to api.Organization.
+// The optional decorator function allows custom field transformations.
+func OrganizationFromOrganizationGORM(
+	dest *api.Organization,
+	src *OrganizationGORM,
+	decorator func(dest *api.Organization, src *OrganizationGORM) error,
+) (out *api.Organization, err error) {
+	out, err = OrganizationFromOrganizationGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// OrganizationFromOrganizationGORM converts a OrganizationGORM back to api.Organization.
-// The optional decorator function allows custom field transformations.
-func OrganizationFromOrganizationGORM(
+// OrganizationFromOrganizationGORMWithOptions is OrganizationFromOrganizationGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func OrganizationFromOrganizationGORMWithOptions(
+	ctx context.Context,
 	dest *api.Organization,
 	src *OrganizationGORM,
-	decorator func(dest *api.Organization, src *OrganizationGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *api.Organization, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &api.Organization{}
 	}
@@ -884,19 +2814,56 @@ func OrganizationFromOrganizationGORM(
 	if src.Departments != nil {
 		out.Departments = make(map[string]*api.Author, len(src.Departments))
 		for key, value := range src.Departments {
-			out.Departments[key], err = AuthorFromAuthorGORM(nil, &value, nil)
+			out.Departments[key], err = AuthorFromAuthorGORMWithOptions(ctx, nil, &value)
 			if err != nil {
-				return nil, fmt.Errorf("converting Departments[%v]: %w", key, err)
+				return nil, conversionOrganizationFromOrganizationGORM.ElementError(err, "departments", key)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return out, nil
 }
+
+// OrganizationSliceToOrganizationGORMSlice converts a slice of api.Organization with OrganizationToOrganizationGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func OrganizationSliceToOrganizationGORMSlice(
+	src []*api.Organization,
+	decorator func(*api.Organization, *OrganizationGORM) error,
+) ([]*OrganizationGORM, error) {
+	return converters.MapSlice(src, func(item *api.Organization) (*OrganizationGORM, error) {
+		return OrganizationToOrganizationGORM(item, nil, decorator)
+	})
+}
+
+// OrganizationSliceFromOrganizationGORMSlice converts a slice of OrganizationGORM with OrganizationFromOrganizationGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func OrganizationSliceFromOrganizationGORMSlice(
+	src []*OrganizationGORM,
+	decorator func(*api.Organization, *OrganizationGORM) error,
+) ([]*api.Organization, error) {
+	return converters.MapSlice(src, func(item *OrganizationGORM) (*api.Organization, error) {
+		return OrganizationFromOrganizationGORM(nil, item, decorator)
+	})
+}
+
+// OrganizationMapToOrganizationGORMMap converts the values of a map with OrganizationToOrganizationGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func OrganizationMapToOrganizationGORMMap[K comparable](
+	src map[K]*api.Organization,
+	decorator func(*api.Organization, *OrganizationGORM) error,
+) (map[K]*OrganizationGORM, error) {
+	return converters.MapValues(src, func(value *api.Organization) (*OrganizationGORM, error) {
+		return OrganizationToOrganizationGORM(value, nil, decorator)
+	})
+}
+
+// OrganizationMapFromOrganizationGORMMap converts the values of a map with OrganizationFromOrganizationGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func OrganizationMapFromOrganizationGORMMap[K comparable](
+	src map[K]*OrganizationGORM,
+	decorator func(*api.Organization, *OrganizationGORM) error,
+) (map[K]*api.Organization, error) {
+	return converters.MapValues(src, func(value *OrganizationGORM) (*api.Organization, error) {
+		return OrganizationFromOrganizationGORM(nil, value, decorator)
+	})
+}
@@ -0,0 +1,935 @@
+// Code generated by protoc-gen-dal-gorm. DO NOT EDIT.
+package gorm
+
+import (
+	"math/rand"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/panyam/protoc-gen-dal/pkg/converters"
+	"github.com/panyam/protoc-gen-dal/pkg/roundtrip"
+	api "github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+)
+
+// TestUserToUserGORMRoundTrip checks that UserFromUserGORM restores what
+// UserToUserGORM stored, for random api.User messages.
+func TestUserToUserGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserGORM(%v): %v", src, err)
+		}
+		got, err := UserFromUserGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserGORM(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserGORMClone checks that Clone copies the UserGORM
+// that UserToUserGORM makes without sharing memory with it.
+func TestUserToUserGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserGORM returns the api.User that UserFromUserGORM
+// should return for the UserGORM that UserToUserGORM makes from src.
+func expectedUserFromUserGORM(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserWithPermissionsRoundTrip checks that UserFromUserWithPermissions restores what
+// UserToUserWithPermissions stored, for random api.User messages.
+func TestUserToUserWithPermissionsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithPermissions(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithPermissions(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithPermissions(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithPermissions(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithPermissions(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithPermissionsClone checks that Clone copies the UserWithPermissions
+// that UserToUserWithPermissions makes without sharing memory with it.
+func TestUserToUserWithPermissionsClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithPermissions(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithPermissions(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithPermissions returns the api.User that UserFromUserWithPermissions
+// should return for the UserWithPermissions that UserToUserWithPermissions makes from src.
+func expectedUserFromUserWithPermissions(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserWithCustomTimestampsRoundTrip checks that UserFromUserWithCustomTimestamps restores what
+// UserToUserWithCustomTimestamps stored, for random api.User messages.
+func TestUserToUserWithCustomTimestampsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithCustomTimestamps(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithCustomTimestamps(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithCustomTimestamps(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithCustomTimestamps(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithCustomTimestamps(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithCustomTimestampsClone checks that Clone copies the UserWithCustomTimestamps
+// that UserToUserWithCustomTimestamps makes without sharing memory with it.
+func TestUserToUserWithCustomTimestampsClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithCustomTimestamps(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithCustomTimestamps(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithCustomTimestamps returns the api.User that UserFromUserWithCustomTimestamps
+// should return for the UserWithCustomTimestamps that UserToUserWithCustomTimestamps makes from src.
+func expectedUserFromUserWithCustomTimestamps(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+
+	// Lossy: sub-second precision
+	want.CreatedAt = converters.TruncateTimestampToSeconds(want.CreatedAt)
+	return want
+}
+
+// TestUserToUserWithIndexesRoundTrip checks that UserFromUserWithIndexes restores what
+// UserToUserWithIndexes stored, for random api.User messages.
+func TestUserToUserWithIndexesRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithIndexes(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithIndexes(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithIndexes(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithIndexes(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithIndexes(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithIndexesClone checks that Clone copies the UserWithIndexes
+// that UserToUserWithIndexes makes without sharing memory with it.
+func TestUserToUserWithIndexesClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithIndexes(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithIndexes(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithIndexes returns the api.User that UserFromUserWithIndexes
+// should return for the UserWithIndexes that UserToUserWithIndexes makes from src.
+func expectedUserFromUserWithIndexes(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestUserToUserWithDefaultsRoundTrip checks that UserFromUserWithDefaults restores what
+// UserToUserWithDefaults stored, for random api.User messages.
+func TestUserToUserWithDefaultsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithDefaults(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithDefaults(%v): %v", src, err)
+		}
+		got, err := UserFromUserWithDefaults(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromUserWithDefaults(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromUserWithDefaults(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToUserWithDefaultsClone checks that Clone copies the UserWithDefaults
+// that UserToUserWithDefaults makes without sharing memory with it.
+func TestUserToUserWithDefaultsClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToUserWithDefaults(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToUserWithDefaults(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromUserWithDefaults returns the api.User that UserFromUserWithDefaults
+// should return for the UserWithDefaults that UserToUserWithDefaults makes from src.
+func expectedUserFromUserWithDefaults(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestAuthorToAuthorGORMRoundTrip checks that AuthorFromAuthorGORM restores what
+// AuthorToAuthorGORM stored, for random api.Author messages.
+func TestAuthorToAuthorGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Author{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AuthorToAuthorGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AuthorToAuthorGORM(%v): %v", src, err)
+		}
+		got, err := AuthorFromAuthorGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("AuthorFromAuthorGORM(%v): %v", target, err)
+		}
+
+		if want := expectedAuthorFromAuthorGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestAuthorToAuthorGORMClone checks that Clone copies the AuthorGORM
+// that AuthorToAuthorGORM makes without sharing memory with it.
+func TestAuthorToAuthorGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Author{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AuthorToAuthorGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AuthorToAuthorGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedAuthorFromAuthorGORM returns the api.Author that AuthorFromAuthorGORM
+// should return for the AuthorGORM that AuthorToAuthorGORM makes from src.
+func expectedAuthorFromAuthorGORM(src *api.Author) *api.Author {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Author)
+	return want
+}
+
+// TestBlogToBlogAsIsGORMRoundTrip checks that BlogFromBlogAsIsGORM restores what
+// BlogToBlogAsIsGORM stored, for random api.Blog messages.
+func TestBlogToBlogAsIsGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogAsIsGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogAsIsGORM(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogAsIsGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogAsIsGORM(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogAsIsGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogAsIsGORMClone checks that Clone copies the BlogAsIsGORM
+// that BlogToBlogAsIsGORM makes without sharing memory with it.
+func TestBlogToBlogAsIsGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogAsIsGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogAsIsGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogAsIsGORM returns the api.Blog that BlogFromBlogAsIsGORM
+// should return for the BlogAsIsGORM that BlogToBlogAsIsGORM makes from src.
+func expectedBlogFromBlogAsIsGORM(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+
+	want.Author = expectedAuthorFromAuthorGORM(want.Author)
+	return want
+}
+
+// TestBlogToBlogGORMRoundTrip checks that BlogFromBlogGORM restores what
+// BlogToBlogGORM stored, for random api.Blog messages.
+func TestBlogToBlogGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogGORM(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogGORM(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogGORMClone checks that Clone copies the BlogGORM
+// that BlogToBlogGORM makes without sharing memory with it.
+func TestBlogToBlogGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogGORM returns the api.Blog that BlogFromBlogGORM
+// should return for the BlogGORM that BlogToBlogGORM makes from src.
+func expectedBlogFromBlogGORM(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+
+	want.Author = expectedAuthorFromAuthorGORM(want.Author)
+	return want
+}
+
+// TestBlogToBlogFlatGORMRoundTrip checks that BlogFromBlogFlatGORM restores what
+// BlogToBlogFlatGORM stored, for random api.Blog messages.
+func TestBlogToBlogFlatGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogFlatGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogFlatGORM(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogFlatGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogFlatGORM(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogFlatGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogFlatGORMClone checks that Clone copies the BlogFlatGORM
+// that BlogToBlogFlatGORM makes without sharing memory with it.
+func TestBlogToBlogFlatGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogFlatGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogFlatGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogFlatGORM returns the api.Blog that BlogFromBlogFlatGORM
+// should return for the BlogFlatGORM that BlogToBlogFlatGORM makes from src.
+func expectedBlogFromBlogFlatGORM(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+
+	want.Author = expectedAuthorFromAuthorGORM(want.Author)
+	return want
+}
+
+// TestBlogToBlogBlobGORMRoundTrip checks that BlogFromBlogBlobGORM restores what
+// BlogToBlogBlobGORM stored, for random api.Blog messages.
+func TestBlogToBlogBlobGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogBlobGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogBlobGORM(%v): %v", src, err)
+		}
+		got, err := BlogFromBlogBlobGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogBlobGORM(%v): %v", target, err)
+		}
+
+		if want := expectedBlogFromBlogBlobGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestBlogToBlogBlobGORMClone checks that Clone copies the BlogBlobGORM
+// that BlogToBlogBlobGORM makes without sharing memory with it.
+func TestBlogToBlogBlobGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Blog{}
+		roundtrip.Fill(src, rng)
+
+		target, err := BlogToBlogBlobGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogBlobGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedBlogFromBlogBlobGORM returns the api.Blog that BlogFromBlogBlobGORM
+// should return for the BlogBlobGORM that BlogToBlogBlobGORM makes from src.
+func expectedBlogFromBlogBlobGORM(src *api.Blog) *api.Blog {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Blog)
+	return want
+}
+
+// TestProductToProductGORMRoundTrip checks that ProductFromProductGORM restores what
+// ProductToProductGORM stored, for random api.Product messages.
+func TestProductToProductGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Product{}
+		roundtrip.Fill(src, rng)
+
+		target, err := ProductToProductGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("ProductToProductGORM(%v): %v", src, err)
+		}
+		got, err := ProductFromProductGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("ProductFromProductGORM(%v): %v", target, err)
+		}
+
+		if want := expectedProductFromProductGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestProductToProductGORMClone checks that Clone copies the ProductGORM
+// that ProductToProductGORM makes without sharing memory with it.
+func TestProductToProductGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Product{}
+		roundtrip.Fill(src, rng)
+
+		target, err := ProductToProductGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("ProductToProductGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedProductFromProductGORM returns the api.Product that ProductFromProductGORM
+// should return for the ProductGORM that ProductToProductGORM makes from src.
+func expectedProductFromProductGORM(src *api.Product) *api.Product {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Product)
+	return want
+}
+
+// TestLibraryToLibraryGORMRoundTrip checks that LibraryFromLibraryGORM restores what
+// LibraryToLibraryGORM stored, for random api.Library messages.
+func TestLibraryToLibraryGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryGORM(%v): %v", src, err)
+		}
+		got, err := LibraryFromLibraryGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("LibraryFromLibraryGORM(%v): %v", target, err)
+		}
+
+		if want := expectedLibraryFromLibraryGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestLibraryToLibraryGORMClone checks that Clone copies the LibraryGORM
+// that LibraryToLibraryGORM makes without sharing memory with it.
+func TestLibraryToLibraryGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedLibraryFromLibraryGORM returns the api.Library that LibraryFromLibraryGORM
+// should return for the LibraryGORM that LibraryToLibraryGORM makes from src.
+func expectedLibraryFromLibraryGORM(src *api.Library) *api.Library {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Library)
+
+	for i, item := range want.Contributors {
+		want.Contributors[i] = expectedAuthorFromAuthorGORM(item)
+	}
+	return want
+}
+
+// TestLibraryToLibraryChildGORMRoundTrip checks that LibraryFromLibraryChildGORM restores what
+// LibraryToLibraryChildGORM stored, for random api.Library messages.
+func TestLibraryToLibraryChildGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryChildGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryChildGORM(%v): %v", src, err)
+		}
+		got, err := LibraryFromLibraryChildGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("LibraryFromLibraryChildGORM(%v): %v", target, err)
+		}
+
+		if want := expectedLibraryFromLibraryChildGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestLibraryToLibraryChildGORMClone checks that Clone copies the LibraryChildGORM
+// that LibraryToLibraryChildGORM makes without sharing memory with it.
+func TestLibraryToLibraryChildGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Library{}
+		roundtrip.Fill(src, rng)
+
+		target, err := LibraryToLibraryChildGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryChildGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedLibraryFromLibraryChildGORM returns the api.Library that LibraryFromLibraryChildGORM
+// should return for the LibraryChildGORM that LibraryToLibraryChildGORM makes from src.
+func expectedLibraryFromLibraryChildGORM(src *api.Library) *api.Library {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Library)
+
+	for i, item := range want.Contributors {
+		want.Contributors[i] = expectedAuthorFromAuthorGORM(item)
+	}
+	return want
+}
+
+// TestUserToTenantUserGORMRoundTrip checks that UserFromTenantUserGORM restores what
+// UserToTenantUserGORM stored, for random api.User messages.
+func TestUserToTenantUserGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToTenantUserGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToTenantUserGORM(%v): %v", src, err)
+		}
+		got, err := UserFromTenantUserGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UserFromTenantUserGORM(%v): %v", target, err)
+		}
+
+		if want := expectedUserFromTenantUserGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUserToTenantUserGORMClone checks that Clone copies the TenantUserGORM
+// that UserToTenantUserGORM makes without sharing memory with it.
+func TestUserToTenantUserGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.User{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UserToTenantUserGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UserToTenantUserGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUserFromTenantUserGORM returns the api.User that UserFromTenantUserGORM
+// should return for the TenantUserGORM that UserToTenantUserGORM makes from src.
+func expectedUserFromTenantUserGORM(src *api.User) *api.User {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.User)
+	return want
+}
+
+// TestNoteToNoteGORMRoundTrip checks that NoteFromNoteGORM restores what
+// NoteToNoteGORM stored, for random api.Note messages.
+func TestNoteToNoteGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Note{}
+		roundtrip.Fill(src, rng)
+
+		target, err := NoteToNoteGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("NoteToNoteGORM(%v): %v", src, err)
+		}
+		got, err := NoteFromNoteGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("NoteFromNoteGORM(%v): %v", target, err)
+		}
+
+		if want := expectedNoteFromNoteGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestNoteToNoteGORMClone checks that Clone copies the NoteGORM
+// that NoteToNoteGORM makes without sharing memory with it.
+func TestNoteToNoteGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Note{}
+		roundtrip.Fill(src, rng)
+
+		target, err := NoteToNoteGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("NoteToNoteGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedNoteFromNoteGORM returns the api.Note that NoteFromNoteGORM
+// should return for the NoteGORM that NoteToNoteGORM makes from src.
+func expectedNoteFromNoteGORM(src *api.Note) *api.Note {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Note)
+
+	// Not restored by NoteFromNoteGORM
+	roundtrip.ClearFields(want,
+		"key", // skip_field
+	)
+	return want
+}
+
+// TestOrganizationToOrganizationGORMRoundTrip checks that OrganizationFromOrganizationGORM restores what
+// OrganizationToOrganizationGORM stored, for random api.Organization messages.
+func TestOrganizationToOrganizationGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Organization{}
+		roundtrip.Fill(src, rng)
+
+		target, err := OrganizationToOrganizationGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("OrganizationToOrganizationGORM(%v): %v", src, err)
+		}
+		got, err := OrganizationFromOrganizationGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("OrganizationFromOrganizationGORM(%v): %v", target, err)
+		}
+
+		if want := expectedOrganizationFromOrganizationGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestOrganizationToOrganizationGORMClone checks that Clone copies the OrganizationGORM
+// that OrganizationToOrganizationGORM makes without sharing memory with it.
+func TestOrganizationToOrganizationGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &api.Organization{}
+		roundtrip.Fill(src, rng)
+
+		target, err := OrganizationToOrganizationGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("OrganizationToOrganizationGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedOrganizationFromOrganizationGORM returns the api.Organization that OrganizationFromOrganizationGORM
+// should return for the OrganizationGORM that OrganizationToOrganizationGORM makes from src.
+func expectedOrganizationFromOrganizationGORM(src *api.Organization) *api.Organization {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*api.Organization)
+
+	for key, value := range want.Departments {
+		want.Departments[key] = expectedAuthorFromAuthorGORM(value)
+	}
+	return want
+}
@@ -2,9 +2,16 @@
 package gorm
 
 import (
+	"bytes"
+	"maps"
+	"reflect"
+	"slices"
 	"time"
 )
 
+// NoteID is the id_type of gorm.NoteGorm.id.
+type NoteID uint32
+
 // UserGORM is the GORM model for api.User
 type UserGORM struct {
 	Id           uint32 `gorm:"primaryKey;autoIncrement"`
@@ -14,11 +21,88 @@ type UserGORM struct {
 	Birthday     time.Time
 	MemberNumber string `gorm:"type:varchar(50)"`
 	ActivatedAt  time.Time
-	CreatedAt    time.Time `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+	CreatedAt    time.Time `gorm:"autoCreateTime:false;autoUpdateTime:false"`
+	UpdatedAt    time.Time `gorm:"autoCreateTime:false;autoUpdateTime:false"`
 	DeletedAt    time.Time `gorm:"index"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UserGORM) Equal(other *UserGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.DeletedAt.Equal(other.DeletedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UserGORM) ChangedColumns(other *UserGORM) []string {
+	if m == nil {
+		m = &UserGORM{}
+	}
+	if other == nil {
+		other = &UserGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if !m.DeletedAt.Equal(other.DeletedAt) {
+		columns = append(columns, "deleted_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UserGORM) Clone() *UserGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UserGORM with copies of their own.
+func (m *UserGORM) cloneFields() {
+}
+
 // TableName returns the table name for UserGORM
 func (*UserGORM) TableName() string {
 	return "users"
@@ -37,6 +121,79 @@ type UserWithPermissions struct {
 	UpdatedAt    time.Time `gorm:"<-;autoUpdateTime"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UserWithPermissions) Equal(other *UserWithPermissions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UserWithPermissions) ChangedColumns(other *UserWithPermissions) []string {
+	if m == nil {
+		m = &UserWithPermissions{}
+	}
+	if other == nil {
+		other = &UserWithPermissions{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UserWithPermissions) Clone() *UserWithPermissions {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UserWithPermissions with copies of their own.
+func (m *UserWithPermissions) cloneFields() {
+}
+
 // TableName returns the table name for UserWithPermissions
 func (*UserWithPermissions) TableName() string {
 	return "users_with_perms"
@@ -57,6 +214,87 @@ type UserWithCustomTimestamps struct {
 	UpdatedAt    time.Time
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UserWithCustomTimestamps) Equal(other *UserWithCustomTimestamps) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.UpdatedMilli == other.UpdatedMilli &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.UpdatedNano == other.UpdatedNano &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt == other.CreatedAt &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UserWithCustomTimestamps) ChangedColumns(other *UserWithCustomTimestamps) []string {
+	if m == nil {
+		m = &UserWithCustomTimestamps{}
+	}
+	if other == nil {
+		other = &UserWithCustomTimestamps{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if m.UpdatedMilli != other.UpdatedMilli {
+		columns = append(columns, "updated_milli")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.UpdatedNano != other.UpdatedNano {
+		columns = append(columns, "updated_nano")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if m.CreatedAt != other.CreatedAt {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UserWithCustomTimestamps) Clone() *UserWithCustomTimestamps {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UserWithCustomTimestamps with copies of their own.
+func (m *UserWithCustomTimestamps) cloneFields() {
+}
+
 // TableName returns the table name for UserWithCustomTimestamps
 func (*UserWithCustomTimestamps) TableName() string {
 	return "users_custom_time"
@@ -78,6 +316,91 @@ type UserWithIndexes struct {
 	UpdatedAt    time.Time
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UserWithIndexes) Equal(other *UserWithIndexes) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.City == other.City &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.FirstName == other.FirstName &&
+		m.LastName == other.LastName &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UserWithIndexes) ChangedColumns(other *UserWithIndexes) []string {
+	if m == nil {
+		m = &UserWithIndexes{}
+	}
+	if other == nil {
+		other = &UserWithIndexes{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if m.City != other.City {
+		columns = append(columns, "city")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.FirstName != other.FirstName {
+		columns = append(columns, "first_name")
+	}
+	if m.LastName != other.LastName {
+		columns = append(columns, "last_name")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UserWithIndexes) Clone() *UserWithIndexes {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UserWithIndexes with copies of their own.
+func (m *UserWithIndexes) cloneFields() {
+}
+
 // TableName returns the table name for UserWithIndexes
 func (*UserWithIndexes) TableName() string {
 	return "users_indexed"
@@ -97,6 +420,83 @@ type UserWithDefaults struct {
 	UpdatedAt    time.Time
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UserWithDefaults) Equal(other *UserWithDefaults) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Active == other.Active &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UserWithDefaults) ChangedColumns(other *UserWithDefaults) []string {
+	if m == nil {
+		m = &UserWithDefaults{}
+	}
+	if other == nil {
+		other = &UserWithDefaults{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Active != other.Active {
+		columns = append(columns, "active")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UserWithDefaults) Clone() *UserWithDefaults {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UserWithDefaults with copies of their own.
+func (m *UserWithDefaults) cloneFields() {
+}
+
 // TableName returns the table name for UserWithDefaults
 func (*UserWithDefaults) TableName() string {
 	return "users_defaults"
@@ -108,6 +508,51 @@ type AuthorGORM struct {
 	Email string
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *AuthorGORM) Equal(other *AuthorGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Name == other.Name &&
+		m.Email == other.Email
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *AuthorGORM) ChangedColumns(other *AuthorGORM) []string {
+	if m == nil {
+		m = &AuthorGORM{}
+	}
+	if other == nil {
+		other = &AuthorGORM{}
+	}
+	var columns []string
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *AuthorGORM) Clone() *AuthorGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a AuthorGORM with copies of their own.
+func (m *AuthorGORM) cloneFields() {
+}
+
 // BlogAsIsGORM is the GORM model for api.Blog
 type BlogAsIsGORM struct {
 	Id      uint32
@@ -116,6 +561,60 @@ type BlogAsIsGORM struct {
 	Title   string
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *BlogAsIsGORM) Equal(other *BlogAsIsGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Author.Equal(&other.Author) &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *BlogAsIsGORM) ChangedColumns(other *BlogAsIsGORM) []string {
+	if m == nil {
+		m = &BlogAsIsGORM{}
+	}
+	if other == nil {
+		other = &BlogAsIsGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if !m.Author.Equal(&other.Author) {
+		columns = append(columns, "author")
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *BlogAsIsGORM) Clone() *BlogAsIsGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a BlogAsIsGORM with copies of their own.
+func (m *BlogAsIsGORM) cloneFields() {
+	m.Author.cloneFields()
+}
+
 // TableName returns the table name for BlogAsIsGORM
 func (*BlogAsIsGORM) TableName() string {
 	return "blogs"
@@ -129,11 +628,199 @@ type BlogGORM struct {
 	Title   string     `gorm:"type:varchar(255);not null"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *BlogGORM) Equal(other *BlogGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Author.Equal(&other.Author) &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *BlogGORM) ChangedColumns(other *BlogGORM) []string {
+	if m == nil {
+		m = &BlogGORM{}
+	}
+	if other == nil {
+		other = &BlogGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	for _, column := range m.Author.ChangedColumns(&other.Author) {
+		columns = append(columns, "author_"+column)
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *BlogGORM) Clone() *BlogGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a BlogGORM with copies of their own.
+func (m *BlogGORM) cloneFields() {
+	m.Author.cloneFields()
+}
+
 // TableName returns the table name for BlogGORM
 func (*BlogGORM) TableName() string {
 	return "blogs"
 }
 
+// BlogFlatGORM is the GORM model for api.Blog
+type BlogFlatGORM struct {
+	Id      uint32     `gorm:"primaryKey;autoIncrement"`
+	Author  AuthorGORM `gorm:"embedded;embeddedPrefix:by_"`
+	Upvotes int32
+	Title   string
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *BlogFlatGORM) Equal(other *BlogFlatGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Author.Equal(&other.Author) &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *BlogFlatGORM) ChangedColumns(other *BlogFlatGORM) []string {
+	if m == nil {
+		m = &BlogFlatGORM{}
+	}
+	if other == nil {
+		other = &BlogFlatGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	for _, column := range m.Author.ChangedColumns(&other.Author) {
+		columns = append(columns, "by_"+column)
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *BlogFlatGORM) Clone() *BlogFlatGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a BlogFlatGORM with copies of their own.
+func (m *BlogFlatGORM) cloneFields() {
+	m.Author.cloneFields()
+}
+
+// TableName returns the table name for BlogFlatGORM
+func (*BlogFlatGORM) TableName() string {
+	return "flat_blogs"
+}
+
+// BlogBlobGORM is the GORM model for api.Blog
+type BlogBlobGORM struct {
+	Id      uint32 `gorm:"primaryKey;autoIncrement"`
+	Author  []byte
+	Upvotes int32
+	Title   string
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *BlogBlobGORM) Equal(other *BlogBlobGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		bytes.Equal(m.Author, other.Author) &&
+		m.Upvotes == other.Upvotes &&
+		m.Title == other.Title
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *BlogBlobGORM) ChangedColumns(other *BlogBlobGORM) []string {
+	if m == nil {
+		m = &BlogBlobGORM{}
+	}
+	if other == nil {
+		other = &BlogBlobGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if !bytes.Equal(m.Author, other.Author) {
+		columns = append(columns, "author")
+	}
+	if m.Upvotes != other.Upvotes {
+		columns = append(columns, "upvotes")
+	}
+	if m.Title != other.Title {
+		columns = append(columns, "title")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *BlogBlobGORM) Clone() *BlogBlobGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a BlogBlobGORM with copies of their own.
+func (m *BlogBlobGORM) cloneFields() {
+	m.Author = bytes.Clone(m.Author)
+}
+
+// TableName returns the table name for BlogBlobGORM
+func (*BlogBlobGORM) TableName() string {
+	return "blob_blogs"
+}
+
 // ProductGORM is the GORM model for api.Product
 type ProductGORM struct {
 	Id         uint32            `gorm:"primaryKey;autoIncrement"`
@@ -144,6 +831,71 @@ type ProductGORM struct {
 	Ratings    []int32           `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *ProductGORM) Equal(other *ProductGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		reflect.DeepEqual(m.Categories, other.Categories) &&
+		reflect.DeepEqual(m.Metadata, other.Metadata) &&
+		reflect.DeepEqual(m.Ratings, other.Ratings)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *ProductGORM) ChangedColumns(other *ProductGORM) []string {
+	if m == nil {
+		m = &ProductGORM{}
+	}
+	if other == nil {
+		other = &ProductGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if !reflect.DeepEqual(m.Categories, other.Categories) {
+		columns = append(columns, "categories")
+	}
+	if !reflect.DeepEqual(m.Metadata, other.Metadata) {
+		columns = append(columns, "metadata")
+	}
+	if !reflect.DeepEqual(m.Ratings, other.Ratings) {
+		columns = append(columns, "ratings")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *ProductGORM) Clone() *ProductGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a ProductGORM with copies of their own.
+func (m *ProductGORM) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+	m.Categories = slices.Clone(m.Categories)
+	m.Metadata = maps.Clone(m.Metadata)
+	m.Ratings = slices.Clone(m.Ratings)
+}
+
 // TableName returns the table name for ProductGORM
 func (*ProductGORM) TableName() string {
 	return "products"
@@ -156,11 +908,360 @@ type LibraryGORM struct {
 	Contributors []AuthorGORM `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *LibraryGORM) Equal(other *LibraryGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Contributors, other.Contributors)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *LibraryGORM) ChangedColumns(other *LibraryGORM) []string {
+	if m == nil {
+		m = &LibraryGORM{}
+	}
+	if other == nil {
+		other = &LibraryGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Contributors, other.Contributors) {
+		columns = append(columns, "contributors")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *LibraryGORM) Clone() *LibraryGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a LibraryGORM with copies of their own.
+func (m *LibraryGORM) cloneFields() {
+	m.Contributors = slices.Clone(m.Contributors)
+	for i := range m.Contributors {
+		m.Contributors[i].cloneFields()
+	}
+}
+
 // TableName returns the table name for LibraryGORM
 func (*LibraryGORM) TableName() string {
 	return "libraries"
 }
 
+// LibraryChildGORM is the GORM model for api.Library
+type LibraryChildGORM struct {
+	Id           uint32 `gorm:"primaryKey;autoIncrement"`
+	Name         string
+	Contributors []LibraryChildGORMContributorsChild `gorm:"foreignKey:ParentID;references:Id"`
+}
+
+// Equal reports whether m and other have the same field values.
+// Child table rows are compared by value, ignoring their keys and ordinals.
+func (m *LibraryChildGORM) Equal(other *LibraryChildGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		slices.EqualFunc(m.Contributors, other.Contributors, func(a, b LibraryChildGORMContributorsChild) bool { return a.Value.Equal(&b.Value) })
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// Child tables are not columns and are left out.
+// A nil m or other is compared as the zero value.
+func (m *LibraryChildGORM) ChangedColumns(other *LibraryChildGORM) []string {
+	if m == nil {
+		m = &LibraryChildGORM{}
+	}
+	if other == nil {
+		other = &LibraryChildGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *LibraryChildGORM) Clone() *LibraryChildGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a LibraryChildGORM with copies of their own.
+func (m *LibraryChildGORM) cloneFields() {
+	m.Contributors = slices.Clone(m.Contributors)
+	for i := range m.Contributors {
+		m.Contributors[i].cloneFields()
+	}
+}
+
+// TableName returns the table name for LibraryChildGORM
+func (*LibraryChildGORM) TableName() string {
+	return "child_libraries"
+}
+
+// LibraryChildGORMContributorsChild is a row of the LibraryChildGORM.Contributors child table
+type LibraryChildGORMContributorsChild struct {
+	ParentID uint32     `gorm:"primaryKey;column:parent_id"`
+	Ordinal  int        `gorm:"primaryKey;column:ordinal"`
+	Value    AuthorGORM `gorm:"embedded"`
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *LibraryChildGORMContributorsChild) Equal(other *LibraryChildGORMContributorsChild) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.ParentID == other.ParentID &&
+		m.Ordinal == other.Ordinal &&
+		m.Value.Equal(&other.Value)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *LibraryChildGORMContributorsChild) ChangedColumns(other *LibraryChildGORMContributorsChild) []string {
+	if m == nil {
+		m = &LibraryChildGORMContributorsChild{}
+	}
+	if other == nil {
+		other = &LibraryChildGORMContributorsChild{}
+	}
+	var columns []string
+	if m.ParentID != other.ParentID {
+		columns = append(columns, "parent_id")
+	}
+	if m.Ordinal != other.Ordinal {
+		columns = append(columns, "ordinal")
+	}
+	columns = append(columns, m.Value.ChangedColumns(&other.Value)...)
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *LibraryChildGORMContributorsChild) Clone() *LibraryChildGORMContributorsChild {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a LibraryChildGORMContributorsChild with copies of their own.
+func (m *LibraryChildGORMContributorsChild) cloneFields() {
+	m.Value.cloneFields()
+}
+
+// TableName returns the table name for LibraryChildGORMContributorsChild
+func (*LibraryChildGORMContributorsChild) TableName() string {
+	return "child_libraries_contributors"
+}
+
+// TenantUserGORM is the GORM model for api.User
+type TenantUserGORM struct {
+	Id           uint32 `gorm:"primaryKey"`
+	Name         string
+	Email        string
+	Age          uint32
+	Birthday     time.Time
+	MemberNumber string
+	ActivatedAt  time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	TenantId     string
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *TenantUserGORM) Equal(other *TenantUserGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		m.Email == other.Email &&
+		m.Age == other.Age &&
+		m.Birthday.Equal(other.Birthday) &&
+		m.MemberNumber == other.MemberNumber &&
+		m.ActivatedAt.Equal(other.ActivatedAt) &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.TenantId == other.TenantId
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *TenantUserGORM) ChangedColumns(other *TenantUserGORM) []string {
+	if m == nil {
+		m = &TenantUserGORM{}
+	}
+	if other == nil {
+		other = &TenantUserGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Email != other.Email {
+		columns = append(columns, "email")
+	}
+	if m.Age != other.Age {
+		columns = append(columns, "age")
+	}
+	if !m.Birthday.Equal(other.Birthday) {
+		columns = append(columns, "birthday")
+	}
+	if m.MemberNumber != other.MemberNumber {
+		columns = append(columns, "member_number")
+	}
+	if !m.ActivatedAt.Equal(other.ActivatedAt) {
+		columns = append(columns, "activated_at")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.TenantId != other.TenantId {
+		columns = append(columns, "tenant_id")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *TenantUserGORM) Clone() *TenantUserGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a TenantUserGORM with copies of their own.
+func (m *TenantUserGORM) cloneFields() {
+}
+
+// TableName returns the table name for TenantUserGORM
+func (*TenantUserGORM) TableName() string {
+	return "tenant_users"
+}
+
+// NoteGORM is the GORM model for api.Note
+type NoteGORM struct {
+	Id        NoteID `gorm:"primaryKey;autoIncrement"`
+	Text      string
+	CreatedBy string
+	UpdatedBy string
+	CreatedAt time.Time `gorm:"autoCreateTime:false;autoUpdateTime:false"`
+	UpdatedAt time.Time `gorm:"autoCreateTime:false;autoUpdateTime:false"`
+}
+
+// Equal reports whether m and other have the same field values.
+func (m *NoteGORM) Equal(other *NoteGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Text == other.Text &&
+		m.CreatedBy == other.CreatedBy &&
+		m.UpdatedBy == other.UpdatedBy &&
+		m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *NoteGORM) ChangedColumns(other *NoteGORM) []string {
+	if m == nil {
+		m = &NoteGORM{}
+	}
+	if other == nil {
+		other = &NoteGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Text != other.Text {
+		columns = append(columns, "text")
+	}
+	if m.CreatedBy != other.CreatedBy {
+		columns = append(columns, "created_by")
+	}
+	if m.UpdatedBy != other.UpdatedBy {
+		columns = append(columns, "updated_by")
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *NoteGORM) Clone() *NoteGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a NoteGORM with copies of their own.
+func (m *NoteGORM) cloneFields() {
+}
+
+// TableName returns the table name for NoteGORM
+func (*NoteGORM) TableName() string {
+	return "notes"
+}
+
 // OrganizationGORM is the GORM model for api.Organization
 type OrganizationGORM struct {
 	Id          uint32                `gorm:"primaryKey;autoIncrement"`
@@ -168,6 +1269,60 @@ type OrganizationGORM struct {
 	Departments map[string]AuthorGORM `gorm:"serializer:json"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *OrganizationGORM) Equal(other *OrganizationGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Id == other.Id &&
+		m.Name == other.Name &&
+		reflect.DeepEqual(m.Departments, other.Departments)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *OrganizationGORM) ChangedColumns(other *OrganizationGORM) []string {
+	if m == nil {
+		m = &OrganizationGORM{}
+	}
+	if other == nil {
+		other = &OrganizationGORM{}
+	}
+	var columns []string
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if !reflect.DeepEqual(m.Departments, other.Departments) {
+		columns = append(columns, "departments")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *OrganizationGORM) Clone() *OrganizationGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a OrganizationGORM with copies of their own.
+func (m *OrganizationGORM) cloneFields() {
+	m.Departments = maps.Clone(m.Departments)
+	for key, value := range m.Departments {
+		value.cloneFields()
+		m.Departments[key] = value
+	}
+}
+
 // TableName returns the table name for OrganizationGORM
 func (*OrganizationGORM) TableName() string {
 	return "organizations"
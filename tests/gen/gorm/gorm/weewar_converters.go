@@ -2,22 +2,64 @@
 package gorm
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
 )
 
+// conversionIndexInfoToIndexInfoGORM describes IndexInfoToIndexInfoGORM in conversion errors.
+var conversionIndexInfoToIndexInfoGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "index_info",
+	SourceType: "v1.IndexInfo",
+	TargetType: "IndexInfoGORM",
+}
+
+// conversionIndexInfoFromIndexInfoGORM describes IndexInfoFromIndexInfoGORM in conversion errors.
+var conversionIndexInfoFromIndexInfoGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "index_info",
+	SourceType: "IndexInfoGORM",
+	TargetType: "v1.IndexInfo",
+}
+
 // IndexInfoToIndexInfoGORM converts a v1.IndexInfo to IndexInfoGORM.
 // The optional decorator function allows custom field transformations.
 func IndexInfoToIndexInfoGORM(
 	src *v1.IndexInfo,
 	dest *IndexInfoGORM,
 	decorator func(*v1.IndexInfo, *IndexInfoGORM) error,
+) (out *IndexInfoGORM, err error) {
+	out, err = IndexInfoToIndexInfoGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// IndexInfoToIndexInfoGORMWithOptions is IndexInfoToIndexInfoGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func IndexInfoToIndexInfoGORMWithOptions(
+	ctx context.Context,
+	src *v1.IndexInfo,
+	dest *IndexInfoGORM,
+	opts ...converters.ConvertOption,
 ) (out *IndexInfoGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &IndexInfoGORM{}
 	}
@@ -36,26 +78,45 @@ func IndexInfoToIndexInfoGORM(
 		out.LastIndexedAt = converters.TimestampToTime(src.LastIndexedAt)
 	}
 
+	return dest, nil
+}
+
+// IndexInfoFromIndexInfoGORM converts a IndexInfoGORM back to v1.IndexInfo.
+// The optional decorator function allows custom field transformations.
+func IndexInfoFromIndexInfoGORM(
+	dest *v1.IndexInfo,
+	src *IndexInfoGORM,
+	decorator func(dest *v1.IndexInfo, src *IndexInfoGORM) error,
+) (out *v1.IndexInfo, err error) {
+	out, err = IndexInfoFromIndexInfoGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// IndexInfoFromIndexInfoGORM converts a IndexInfoGORM back to v1.IndexInfo.
-// The optional decorator function allows custom field transformations.
-func IndexInfoFromIndexInfoGORM(
+// IndexInfoFromIndexInfoGORMWithOptions is IndexInfoFromIndexInfoGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func IndexInfoFromIndexInfoGORMWithOptions(
+	ctx context.Context,
 	dest *v1.IndexInfo,
 	src *IndexInfoGORM,
-	decorator func(dest *v1.IndexInfo, src *IndexInfoGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.IndexInfo, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.IndexInfo{}
 	}
@@ -68,9 +129,84 @@ func IndexInfoFromIndexInfoGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// IndexInfoSliceToIndexInfoGORMSlice converts a slice of v1.IndexInfo with IndexInfoToIndexInfoGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func IndexInfoSliceToIndexInfoGORMSlice(
+	src []*v1.IndexInfo,
+	decorator func(*v1.IndexInfo, *IndexInfoGORM) error,
+) ([]*IndexInfoGORM, error) {
+	return converters.MapSlice(src, func(item *v1.IndexInfo) (*IndexInfoGORM, error) {
+		return IndexInfoToIndexInfoGORM(item, nil, decorator)
+	})
+}
+
+// IndexInfoSliceFromIndexInfoGORMSlice converts a slice of IndexInfoGORM with IndexInfoFromIndexInfoGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func IndexInfoSliceFromIndexInfoGORMSlice(
+	src []*IndexInfoGORM,
+	decorator func(*v1.IndexInfo, *IndexInfoGORM) error,
+) ([]*v1.IndexInfo, error) {
+	return converters.MapSlice(src, func(item *IndexInfoGORM) (*v1.IndexInfo, error) {
+		return IndexInfoFromIndexInfoGORM(nil, item, decorator)
+	})
+}
+
+// IndexInfoMapToIndexInfoGORMMap converts the values of a map with IndexInfoToIndexInfoGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func IndexInfoMapToIndexInfoGORMMap[K comparable](
+	src map[K]*v1.IndexInfo,
+	decorator func(*v1.IndexInfo, *IndexInfoGORM) error,
+) (map[K]*IndexInfoGORM, error) {
+	return converters.MapValues(src, func(value *v1.IndexInfo) (*IndexInfoGORM, error) {
+		return IndexInfoToIndexInfoGORM(value, nil, decorator)
+	})
+}
+
+// IndexInfoMapFromIndexInfoGORMMap converts the values of a map with IndexInfoFromIndexInfoGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func IndexInfoMapFromIndexInfoGORMMap[K comparable](
+	src map[K]*IndexInfoGORM,
+	decorator func(*v1.IndexInfo, *IndexInfoGORM) error,
+) (map[K]*v1.IndexInfo, error) {
+	return converters.MapValues(src, func(value *IndexInfoGORM) (*v1.IndexInfo, error) {
+		return IndexInfoFromIndexInfoGORM(nil, value, decorator)
+	})
+}
+
+// conversionTileToTileGORM describes TileToTileGORM in conversion errors.
+var conversionTileToTileGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "tile",
+	SourceType: "v1.Tile",
+	TargetType: "TileGORM",
+}
+
+// conversionTileFromTileGORM describes TileFromTileGORM in conversion errors.
+var conversionTileFromTileGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "tile",
+	SourceType: "TileGORM",
+	TargetType: "v1.Tile",
+}
+
+// TileToTileGORM converts a v1.Tile to TileGORM.
+// The optional decorator function allows custom field transformations.
+func TileToTileGORM(
+	src *v1.Tile,
+	dest *TileGORM,
+	decorator func(*v1.Tile, *TileGORM) error,
+) (out *TileGORM, err error) {
+	out, err = TileToTileGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -78,16 +214,20 @@ func IndexInfoFromIndexInfoGORM(
 	return out, nil
 }
 
-// TileToTileGORM converts a v1.Tile to TileGORM.
-// The optional decorator function allows custom field transformations.
-func TileToTileGORM(
+// TileToTileGORMWithOptions is TileToTileGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TileToTileGORMWithOptions(
+	ctx context.Context,
 	src *v1.Tile,
 	dest *TileGORM,
-	decorator func(*v1.Tile, *TileGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *TileGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &TileGORM{}
 	}
@@ -104,26 +244,45 @@ func TileToTileGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// TileFromTileGORM converts a TileGORM back to v1.Tile.
+// The optional decorator function allows custom field transformations.
+func TileFromTileGORM(
+	dest *v1.Tile,
+	src *TileGORM,
+	decorator func(dest *v1.Tile, src *TileGORM) error,
+) (out *v1.Tile, err error) {
+	out, err = TileFromTileGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// TileFromTileGORM converts a TileGORM back to v1.Tile.
-// The optional decorator function allows custom field transformations.
-func TileFromTileGORM(
+// TileFromTileGORMWithOptions is TileFromTileGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func TileFromTileGORMWithOptions(
+	ctx context.Context,
 	dest *v1.Tile,
 	src *TileGORM,
-	decorator func(dest *v1.Tile, src *TileGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.Tile, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.Tile{}
 	}
@@ -140,9 +299,84 @@ func TileFromTileGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// TileSliceToTileGORMSlice converts a slice of v1.Tile with TileToTileGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TileSliceToTileGORMSlice(
+	src []*v1.Tile,
+	decorator func(*v1.Tile, *TileGORM) error,
+) ([]*TileGORM, error) {
+	return converters.MapSlice(src, func(item *v1.Tile) (*TileGORM, error) {
+		return TileToTileGORM(item, nil, decorator)
+	})
+}
+
+// TileSliceFromTileGORMSlice converts a slice of TileGORM with TileFromTileGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func TileSliceFromTileGORMSlice(
+	src []*TileGORM,
+	decorator func(*v1.Tile, *TileGORM) error,
+) ([]*v1.Tile, error) {
+	return converters.MapSlice(src, func(item *TileGORM) (*v1.Tile, error) {
+		return TileFromTileGORM(nil, item, decorator)
+	})
+}
+
+// TileMapToTileGORMMap converts the values of a map with TileToTileGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TileMapToTileGORMMap[K comparable](
+	src map[K]*v1.Tile,
+	decorator func(*v1.Tile, *TileGORM) error,
+) (map[K]*TileGORM, error) {
+	return converters.MapValues(src, func(value *v1.Tile) (*TileGORM, error) {
+		return TileToTileGORM(value, nil, decorator)
+	})
+}
+
+// TileMapFromTileGORMMap converts the values of a map with TileFromTileGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func TileMapFromTileGORMMap[K comparable](
+	src map[K]*TileGORM,
+	decorator func(*v1.Tile, *TileGORM) error,
+) (map[K]*v1.Tile, error) {
+	return converters.MapValues(src, func(value *TileGORM) (*v1.Tile, error) {
+		return TileFromTileGORM(nil, value, decorator)
+	})
+}
+
+// conversionUnitToUnitGORM describes UnitToUnitGORM in conversion errors.
+var conversionUnitToUnitGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "unit",
+	SourceType: "v1.Unit",
+	TargetType: "UnitGORM",
+}
+
+// conversionUnitFromUnitGORM describes UnitFromUnitGORM in conversion errors.
+var conversionUnitFromUnitGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "unit",
+	SourceType: "UnitGORM",
+	TargetType: "v1.Unit",
+}
+
+// UnitToUnitGORM converts a v1.Unit to UnitGORM.
+// The optional decorator function allows custom field transformations.
+func UnitToUnitGORM(
+	src *v1.Unit,
+	dest *UnitGORM,
+	decorator func(*v1.Unit, *UnitGORM) error,
+) (out *UnitGORM, err error) {
+	out, err = UnitToUnitGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -150,16 +384,20 @@ func TileFromTileGORM(
 	return out, nil
 }
 
-// UnitToUnitGORM converts a v1.Unit to UnitGORM.
-// The optional decorator function allows custom field transformations.
-func UnitToUnitGORM(
+// UnitToUnitGORMWithOptions is UnitToUnitGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UnitToUnitGORMWithOptions(
+	ctx context.Context,
 	src *v1.Unit,
 	dest *UnitGORM,
-	decorator func(*v1.Unit, *UnitGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *UnitGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &UnitGORM{}
 	}
@@ -184,33 +422,52 @@ func UnitToUnitGORM(
 	if src.AttackHistory != nil {
 		out.AttackHistory = make([]AttackRecordGORM, len(src.AttackHistory))
 		for i, item := range src.AttackHistory {
-			_, err = AttackRecordToAttackRecordGORM(item, &out.AttackHistory[i], nil)
+			_, err = AttackRecordToAttackRecordGORMWithOptions(ctx, item, &out.AttackHistory[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting AttackHistory[%d]: %w", i, err)
+				return nil, conversionUnitToUnitGORM.ElementError(err, "attack_history", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// UnitFromUnitGORM converts a UnitGORM back to v1.Unit.
+// The optional decorator function allows custom field transformations.
+func UnitFromUnitGORM(
+	dest *v1.Unit,
+	src *UnitGORM,
+	decorator func(dest *v1.Unit, src *UnitGORM) error,
+) (out *v1.Unit, err error) {
+	out, err = UnitFromUnitGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// UnitFromUnitGORM converts a UnitGORM back to v1.Unit.
-// The optional decorator function allows custom field transformations.
-func UnitFromUnitGORM(
+// UnitFromUnitGORMWithOptions is UnitFromUnitGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func UnitFromUnitGORMWithOptions(
+	ctx context.Context,
 	dest *v1.Unit,
 	src *UnitGORM,
-	decorator func(dest *v1.Unit, src *UnitGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.Unit, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.Unit{}
 	}
@@ -235,16 +492,91 @@ func UnitFromUnitGORM(
 	if src.AttackHistory != nil {
 		out.AttackHistory = make([]*v1.AttackRecord, len(src.AttackHistory))
 		for i, item := range src.AttackHistory {
-			out.AttackHistory[i], err = AttackRecordFromAttackRecordGORM(nil, &item, nil)
+			out.AttackHistory[i], err = AttackRecordFromAttackRecordGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting AttackHistory[%d]: %w", i, err)
+				return nil, conversionUnitFromUnitGORM.ElementError(err, "attack_history", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// UnitSliceToUnitGORMSlice converts a slice of v1.Unit with UnitToUnitGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UnitSliceToUnitGORMSlice(
+	src []*v1.Unit,
+	decorator func(*v1.Unit, *UnitGORM) error,
+) ([]*UnitGORM, error) {
+	return converters.MapSlice(src, func(item *v1.Unit) (*UnitGORM, error) {
+		return UnitToUnitGORM(item, nil, decorator)
+	})
+}
+
+// UnitSliceFromUnitGORMSlice converts a slice of UnitGORM with UnitFromUnitGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func UnitSliceFromUnitGORMSlice(
+	src []*UnitGORM,
+	decorator func(*v1.Unit, *UnitGORM) error,
+) ([]*v1.Unit, error) {
+	return converters.MapSlice(src, func(item *UnitGORM) (*v1.Unit, error) {
+		return UnitFromUnitGORM(nil, item, decorator)
+	})
+}
+
+// UnitMapToUnitGORMMap converts the values of a map with UnitToUnitGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UnitMapToUnitGORMMap[K comparable](
+	src map[K]*v1.Unit,
+	decorator func(*v1.Unit, *UnitGORM) error,
+) (map[K]*UnitGORM, error) {
+	return converters.MapValues(src, func(value *v1.Unit) (*UnitGORM, error) {
+		return UnitToUnitGORM(value, nil, decorator)
+	})
+}
+
+// UnitMapFromUnitGORMMap converts the values of a map with UnitFromUnitGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func UnitMapFromUnitGORMMap[K comparable](
+	src map[K]*UnitGORM,
+	decorator func(*v1.Unit, *UnitGORM) error,
+) (map[K]*v1.Unit, error) {
+	return converters.MapValues(src, func(value *UnitGORM) (*v1.Unit, error) {
+		return UnitFromUnitGORM(nil, value, decorator)
+	})
+}
+
+// conversionAttackRecordToAttackRecordGORM describes AttackRecordToAttackRecordGORM in conversion errors.
+var conversionAttackRecordToAttackRecordGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "attack_record",
+	SourceType: "v1.AttackRecord",
+	TargetType: "AttackRecordGORM",
+}
+
+// conversionAttackRecordFromAttackRecordGORM describes AttackRecordFromAttackRecordGORM in conversion errors.
+var conversionAttackRecordFromAttackRecordGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "attack_record",
+	SourceType: "AttackRecordGORM",
+	TargetType: "v1.AttackRecord",
+}
+
+// AttackRecordToAttackRecordGORM converts a v1.AttackRecord to AttackRecordGORM.
+// The optional decorator function allows custom field transformations.
+func AttackRecordToAttackRecordGORM(
+	src *v1.AttackRecord,
+	dest *AttackRecordGORM,
+	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+) (out *AttackRecordGORM, err error) {
+	out, err = AttackRecordToAttackRecordGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -252,16 +584,20 @@ func UnitFromUnitGORM(
 	return out, nil
 }
 
-// AttackRecordToAttackRecordGORM converts a v1.AttackRecord to AttackRecordGORM.
-// The optional decorator function allows custom field transformations.
-func AttackRecordToAttackRecordGORM(
+// AttackRecordToAttackRecordGORMWithOptions is AttackRecordToAttackRecordGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func AttackRecordToAttackRecordGORMWithOptions(
+	ctx context.Context,
 	src *v1.AttackRecord,
 	dest *AttackRecordGORM,
-	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *AttackRecordGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &AttackRecordGORM{}
 	}
@@ -275,26 +611,45 @@ func AttackRecordToAttackRecordGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// AttackRecordFromAttackRecordGORM converts a AttackRecordGORM back to v1.AttackRecord.
+// The optional decorator function allows custom field transformations.
+func AttackRecordFromAttackRecordGORM(
+	dest *v1.AttackRecord,
+	src *AttackRecordGORM,
+	decorator func(dest *v1.AttackRecord, src *AttackRecordGORM) error,
+) (out *v1.AttackRecord, err error) {
+	out, err = AttackRecordFromAttackRecordGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// AttackRecordFromAttackRecordGORM converts a AttackRecordGORM back to v1.AttackRecord.
-// The optional decorator function allows custom field transformations.
-func AttackRecordFromAttackRecordGORM(
+// AttackRecordFromAttackRecordGORMWithOptions is AttackRecordFromAttackRecordGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func AttackRecordFromAttackRecordGORMWithOptions(
+	ctx context.Context,
 	dest *v1.AttackRecord,
 	src *AttackRecordGORM,
-	decorator func(dest *v1.AttackRecord, src *AttackRecordGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.AttackRecord, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.AttackRecord{}
 	}
@@ -308,9 +663,84 @@ func AttackRecordFromAttackRecordGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// AttackRecordSliceToAttackRecordGORMSlice converts a slice of v1.AttackRecord with AttackRecordToAttackRecordGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func AttackRecordSliceToAttackRecordGORMSlice(
+	src []*v1.AttackRecord,
+	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+) ([]*AttackRecordGORM, error) {
+	return converters.MapSlice(src, func(item *v1.AttackRecord) (*AttackRecordGORM, error) {
+		return AttackRecordToAttackRecordGORM(item, nil, decorator)
+	})
+}
+
+// AttackRecordSliceFromAttackRecordGORMSlice converts a slice of AttackRecordGORM with AttackRecordFromAttackRecordGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func AttackRecordSliceFromAttackRecordGORMSlice(
+	src []*AttackRecordGORM,
+	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+) ([]*v1.AttackRecord, error) {
+	return converters.MapSlice(src, func(item *AttackRecordGORM) (*v1.AttackRecord, error) {
+		return AttackRecordFromAttackRecordGORM(nil, item, decorator)
+	})
+}
+
+// AttackRecordMapToAttackRecordGORMMap converts the values of a map with AttackRecordToAttackRecordGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func AttackRecordMapToAttackRecordGORMMap[K comparable](
+	src map[K]*v1.AttackRecord,
+	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+) (map[K]*AttackRecordGORM, error) {
+	return converters.MapValues(src, func(value *v1.AttackRecord) (*AttackRecordGORM, error) {
+		return AttackRecordToAttackRecordGORM(value, nil, decorator)
+	})
+}
+
+// AttackRecordMapFromAttackRecordGORMMap converts the values of a map with AttackRecordFromAttackRecordGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func AttackRecordMapFromAttackRecordGORMMap[K comparable](
+	src map[K]*AttackRecordGORM,
+	decorator func(*v1.AttackRecord, *AttackRecordGORM) error,
+) (map[K]*v1.AttackRecord, error) {
+	return converters.MapValues(src, func(value *AttackRecordGORM) (*v1.AttackRecord, error) {
+		return AttackRecordFromAttackRecordGORM(nil, value, decorator)
+	})
+}
+
+// conversionWorldToWorldGORM describes WorldToWorldGORM in conversion errors.
+var conversionWorldToWorldGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "world",
+	SourceType: "v1.World",
+	TargetType: "WorldGORM",
+}
+
+// conversionWorldFromWorldGORM describes WorldFromWorldGORM in conversion errors.
+var conversionWorldFromWorldGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "world",
+	SourceType: "WorldGORM",
+	TargetType: "v1.World",
+}
+
+// WorldToWorldGORM converts a v1.World to WorldGORM.
+// The optional decorator function allows custom field transformations.
+func WorldToWorldGORM(
+	src *v1.World,
+	dest *WorldGORM,
+	decorator func(*v1.World, *WorldGORM) error,
+) (out *WorldGORM, err error) {
+	out, err = WorldToWorldGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -318,16 +748,20 @@ func AttackRecordFromAttackRecordGORM(
 	return out, nil
 }
 
-// WorldToWorldGORM converts a v1.World to WorldGORM.
-// The optional decorator function allows custom field transformations.
-func WorldToWorldGORM(
+// WorldToWorldGORMWithOptions is WorldToWorldGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func WorldToWorldGORMWithOptions(
+	ctx context.Context,
 	src *v1.World,
 	dest *WorldGORM,
-	decorator func(*v1.World, *WorldGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *WorldGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &WorldGORM{}
 	}
@@ -354,50 +788,69 @@ func WorldToWorldGORM(
 	}
 
 	if src.WorldData != nil {
-		_, err = WorldDataToWorldDataGORM(src.WorldData, &out.WorldData, nil)
+		_, err = WorldDataToWorldDataGORMWithOptions(ctx, src.WorldData, &out.WorldData)
 		if err != nil {
-			return nil, fmt.Errorf("converting WorldData: %w", err)
+			return nil, conversionWorldToWorldGORM.FieldError(err, "world_data")
 		}
 	}
 	if src.DefaultGameConfig != nil {
-		_, err = GameConfigurationToGameConfigurationGORM(src.DefaultGameConfig, &out.DefaultGameConfig, nil)
+		_, err = GameConfigurationToGameConfigurationGORMWithOptions(ctx, src.DefaultGameConfig, &out.DefaultGameConfig)
 		if err != nil {
-			return nil, fmt.Errorf("converting DefaultGameConfig: %w", err)
+			return nil, conversionWorldToWorldGORM.FieldError(err, "default_game_config")
 		}
 	}
 	if src.ScreenshotIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoGORM(src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoGORMWithOptions(ctx, src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+			return nil, conversionWorldToWorldGORM.FieldError(err, "screenshot_index_info")
 		}
 	}
 	if src.SearchIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoGORM(src.SearchIndexInfo, &out.SearchIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoGORMWithOptions(ctx, src.SearchIndexInfo, &out.SearchIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
+			return nil, conversionWorldToWorldGORM.FieldError(err, "search_index_info")
 		}
 	}
 
+	return dest, nil
+}
+
+// WorldFromWorldGORM converts a WorldGORM back to v1.World.
+// The optional decorator function allows custom field transformations.
+func WorldFromWorldGORM(
+	dest *v1.World,
+	src *WorldGORM,
+	decorator func(dest *v1.World, src *WorldGORM) error,
+) (out *v1.World, err error) {
+	out, err = WorldFromWorldGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// WorldFromWorldGORM converts a WorldGORM back to v1.World.
-// The optional decorator function allows custom field transformations.
-func WorldFromWorldGORM(
+// WorldFromWorldGORMWithOptions is WorldFromWorldGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func WorldFromWorldGORMWithOptions(
+	ctx context.Context,
 	dest *v1.World,
 	src *WorldGORM,
-	decorator func(dest *v1.World, src *WorldGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.World, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.World{}
 	}
@@ -417,43 +870,122 @@ func WorldFromWorldGORM(
 	}
 	out = dest
 
-	out.WorldData, err = WorldDataFromWorldDataGORM(nil, &src.WorldData, nil)
+	out.WorldData, err = WorldDataFromWorldDataGORMWithOptions(ctx, nil, &src.WorldData)
 	if err != nil {
-		return nil, fmt.Errorf("converting WorldData: %w", err)
+		return nil, conversionWorldFromWorldGORM.FieldError(err, "world_data")
 	}
-	out.DefaultGameConfig, err = GameConfigurationFromGameConfigurationGORM(nil, &src.DefaultGameConfig, nil)
+	out.DefaultGameConfig, err = GameConfigurationFromGameConfigurationGORMWithOptions(ctx, nil, &src.DefaultGameConfig)
 	if err != nil {
-		return nil, fmt.Errorf("converting DefaultGameConfig: %w", err)
+		return nil, conversionWorldFromWorldGORM.FieldError(err, "default_game_config")
 	}
-	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoGORM(nil, &src.ScreenshotIndexInfo, nil)
+	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoGORMWithOptions(ctx, nil, &src.ScreenshotIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+		return nil, conversionWorldFromWorldGORM.FieldError(err, "screenshot_index_info")
 	}
-	out.SearchIndexInfo, err = IndexInfoFromIndexInfoGORM(nil, &src.SearchIndexInfo, nil)
+	out.SearchIndexInfo, err = IndexInfoFromIndexInfoGORMWithOptions(ctx, nil, &src.SearchIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
-	}
-
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
+		return nil, conversionWorldFromWorldGORM.FieldError(err, "search_index_info")
 	}
 
 	return out, nil
 }
 
-// WorldDataToWorldDataGORM converts a v1.WorldData to WorldDataGORM.
-// The optional decorator function allows custom field transformations.
-func WorldDataToWorldDataGORM(
-	src *v1.WorldData,
+// WorldSliceToWorldGORMSlice converts a slice of v1.World with WorldToWorldGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldSliceToWorldGORMSlice(
+	src []*v1.World,
+	decorator func(*v1.World, *WorldGORM) error,
+) ([]*WorldGORM, error) {
+	return converters.MapSlice(src, func(item *v1.World) (*WorldGORM, error) {
+		return WorldToWorldGORM(item, nil, decorator)
+	})
+}
+
+// WorldSliceFromWorldGORMSlice converts a slice of WorldGORM with WorldFromWorldGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldSliceFromWorldGORMSlice(
+	src []*WorldGORM,
+	decorator func(*v1.World, *WorldGORM) error,
+) ([]*v1.World, error) {
+	return converters.MapSlice(src, func(item *WorldGORM) (*v1.World, error) {
+		return WorldFromWorldGORM(nil, item, decorator)
+	})
+}
+
+// WorldMapToWorldGORMMap converts the values of a map with WorldToWorldGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldMapToWorldGORMMap[K comparable](
+	src map[K]*v1.World,
+	decorator func(*v1.World, *WorldGORM) error,
+) (map[K]*WorldGORM, error) {
+	return converters.MapValues(src, func(value *v1.World) (*WorldGORM, error) {
+		return WorldToWorldGORM(value, nil, decorator)
+	})
+}
+
+// WorldMapFromWorldGORMMap converts the values of a map with WorldFromWorldGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldMapFromWorldGORMMap[K comparable](
+	src map[K]*WorldGORM,
+	decorator func(*v1.World, *WorldGORM) error,
+) (map[K]*v1.World, error) {
+	return converters.MapValues(src, func(value *WorldGORM) (*v1.World, error) {
+		return WorldFromWorldGORM(nil, value, decorator)
+	})
+}
+
+// conversionWorldDataToWorldDataGORM describes WorldDataToWorldDataGORM in conversion errors.
+var conversionWorldDataToWorldDataGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "world_data",
+	SourceType: "v1.WorldData",
+	TargetType: "WorldDataGORM",
+}
+
+// conversionWorldDataFromWorldDataGORM describes WorldDataFromWorldDataGORM in conversion errors.
+var conversionWorldDataFromWorldDataGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "world_data",
+	SourceType: "WorldDataGORM",
+	TargetType: "v1.WorldData",
+}
+
+// WorldDataToWorldDataGORM converts a v1.WorldData to WorldDataGORM.
+// The optional decorator function allows custom field transformations.
+func WorldDataToWorldDataGORM(
+	src *v1.WorldData,
 	dest *WorldDataGORM,
 	decorator func(*v1.WorldData, *WorldDataGORM) error,
+) (out *WorldDataGORM, err error) {
+	out, err = WorldDataToWorldDataGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// WorldDataToWorldDataGORMWithOptions is WorldDataToWorldDataGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func WorldDataToWorldDataGORMWithOptions(
+	ctx context.Context,
+	src *v1.WorldData,
+	dest *WorldDataGORM,
+	opts ...converters.ConvertOption,
 ) (out *WorldDataGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &WorldDataGORM{}
 	}
@@ -465,42 +997,61 @@ func WorldDataToWorldDataGORM(
 	if src.Tiles != nil {
 		out.Tiles = make([]TileGORM, len(src.Tiles))
 		for i, item := range src.Tiles {
-			_, err = TileToTileGORM(item, &out.Tiles[i], nil)
+			_, err = TileToTileGORMWithOptions(ctx, item, &out.Tiles[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Tiles[%d]: %w", i, err)
+				return nil, conversionWorldDataToWorldDataGORM.ElementError(err, "tiles", i)
 			}
 		}
 	}
 	if src.Units != nil {
 		out.Units = make([]UnitGORM, len(src.Units))
 		for i, item := range src.Units {
-			_, err = UnitToUnitGORM(item, &out.Units[i], nil)
+			_, err = UnitToUnitGORMWithOptions(ctx, item, &out.Units[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Units[%d]: %w", i, err)
+				return nil, conversionWorldDataToWorldDataGORM.ElementError(err, "units", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// WorldDataFromWorldDataGORM converts a WorldDataGORM back to v1.WorldData.
+// The optional decorator function allows custom field transformations.
+func WorldDataFromWorldDataGORM(
+	dest *v1.WorldData,
+	src *WorldDataGORM,
+	decorator func(dest *v1.WorldData, src *WorldDataGORM) error,
+) (out *v1.WorldData, err error) {
+	out, err = WorldDataFromWorldDataGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// WorldDataFromWorldDataGORM converts a WorldDataGORM back to v1.WorldData.
-// The optional decorator function allows custom field transformations.
-func WorldDataFromWorldDataGORM(
+// WorldDataFromWorldDataGORMWithOptions is WorldDataFromWorldDataGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func WorldDataFromWorldDataGORMWithOptions(
+	ctx context.Context,
 	dest *v1.WorldData,
 	src *WorldDataGORM,
-	decorator func(dest *v1.WorldData, src *WorldDataGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.WorldData, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.WorldData{}
 	}
@@ -512,25 +1063,100 @@ func WorldDataFromWorldDataGORM(
 	if src.Tiles != nil {
 		out.Tiles = make([]*v1.Tile, len(src.Tiles))
 		for i, item := range src.Tiles {
-			out.Tiles[i], err = TileFromTileGORM(nil, &item, nil)
+			out.Tiles[i], err = TileFromTileGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Tiles[%d]: %w", i, err)
+				return nil, conversionWorldDataFromWorldDataGORM.ElementError(err, "tiles", i)
 			}
 		}
 	}
 	if src.Units != nil {
 		out.Units = make([]*v1.Unit, len(src.Units))
 		for i, item := range src.Units {
-			out.Units[i], err = UnitFromUnitGORM(nil, &item, nil)
+			out.Units[i], err = UnitFromUnitGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Units[%d]: %w", i, err)
+				return nil, conversionWorldDataFromWorldDataGORM.ElementError(err, "units", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// WorldDataSliceToWorldDataGORMSlice converts a slice of v1.WorldData with WorldDataToWorldDataGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldDataSliceToWorldDataGORMSlice(
+	src []*v1.WorldData,
+	decorator func(*v1.WorldData, *WorldDataGORM) error,
+) ([]*WorldDataGORM, error) {
+	return converters.MapSlice(src, func(item *v1.WorldData) (*WorldDataGORM, error) {
+		return WorldDataToWorldDataGORM(item, nil, decorator)
+	})
+}
+
+// WorldDataSliceFromWorldDataGORMSlice converts a slice of WorldDataGORM with WorldDataFromWorldDataGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func WorldDataSliceFromWorldDataGORMSlice(
+	src []*WorldDataGORM,
+	decorator func(*v1.WorldData, *WorldDataGORM) error,
+) ([]*v1.WorldData, error) {
+	return converters.MapSlice(src, func(item *WorldDataGORM) (*v1.WorldData, error) {
+		return WorldDataFromWorldDataGORM(nil, item, decorator)
+	})
+}
+
+// WorldDataMapToWorldDataGORMMap converts the values of a map with WorldDataToWorldDataGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldDataMapToWorldDataGORMMap[K comparable](
+	src map[K]*v1.WorldData,
+	decorator func(*v1.WorldData, *WorldDataGORM) error,
+) (map[K]*WorldDataGORM, error) {
+	return converters.MapValues(src, func(value *v1.WorldData) (*WorldDataGORM, error) {
+		return WorldDataToWorldDataGORM(value, nil, decorator)
+	})
+}
+
+// WorldDataMapFromWorldDataGORMMap converts the values of a map with WorldDataFromWorldDataGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func WorldDataMapFromWorldDataGORMMap[K comparable](
+	src map[K]*WorldDataGORM,
+	decorator func(*v1.WorldData, *WorldDataGORM) error,
+) (map[K]*v1.WorldData, error) {
+	return converters.MapValues(src, func(value *WorldDataGORM) (*v1.WorldData, error) {
+		return WorldDataFromWorldDataGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameToGameGORM describes GameToGameGORM in conversion errors.
+var conversionGameToGameGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game",
+	SourceType: "v1.Game",
+	TargetType: "GameGORM",
+}
+
+// conversionGameFromGameGORM describes GameFromGameGORM in conversion errors.
+var conversionGameFromGameGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game",
+	SourceType: "GameGORM",
+	TargetType: "v1.Game",
+}
+
+// GameToGameGORM converts a v1.Game to GameGORM.
+// The optional decorator function allows custom field transformations.
+func GameToGameGORM(
+	src *v1.Game,
+	dest *GameGORM,
+	decorator func(*v1.Game, *GameGORM) error,
+) (out *GameGORM, err error) {
+	out, err = GameToGameGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -538,23 +1164,27 @@ func WorldDataFromWorldDataGORM(
 	return out, nil
 }
 
-// GameToGameGORM converts a v1.Game to GameGORM.
-// The optional decorator function allows custom field transformations.
-func GameToGameGORM(
+// GameToGameGORMWithOptions is GameToGameGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameToGameGORMWithOptions(
+	ctx context.Context,
 	src *v1.Game,
 	dest *GameGORM,
-	decorator func(*v1.Game, *GameGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameGORM{}
 	}
 
 	// Initialize struct with inline values
 	*dest = GameGORM{
-		Id:          src.Id,
+		Id:          GameID(src.Id),
 		CreatorId:   src.CreatorId,
 		WorldId:     src.WorldId,
 		Name:        src.Name,
@@ -575,44 +1205,63 @@ func GameToGameGORM(
 	}
 
 	if src.Config != nil {
-		_, err = GameConfigurationToGameConfigurationGORM(src.Config, &out.Config, nil)
+		_, err = GameConfigurationToGameConfigurationGORMWithOptions(ctx, src.Config, &out.Config)
 		if err != nil {
-			return nil, fmt.Errorf("converting Config: %w", err)
+			return nil, conversionGameToGameGORM.FieldError(err, "config")
 		}
 	}
 	if src.ScreenshotIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoGORM(src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoGORMWithOptions(ctx, src.ScreenshotIndexInfo, &out.ScreenshotIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+			return nil, conversionGameToGameGORM.FieldError(err, "screenshot_index_info")
 		}
 	}
 	if src.SearchIndexInfo != nil {
-		_, err = IndexInfoToIndexInfoGORM(src.SearchIndexInfo, &out.SearchIndexInfo, nil)
+		_, err = IndexInfoToIndexInfoGORMWithOptions(ctx, src.SearchIndexInfo, &out.SearchIndexInfo)
 		if err != nil {
-			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
+			return nil, conversionGameToGameGORM.FieldError(err, "search_index_info")
 		}
 	}
 
+	return dest, nil
+}
+
+// GameFromGameGORM converts a GameGORM back to v1.Game.
+// The optional decorator function allows custom field transformations.
+func GameFromGameGORM(
+	dest *v1.Game,
+	src *GameGORM,
+	decorator func(dest *v1.Game, src *GameGORM) error,
+) (out *v1.Game, err error) {
+	out, err = GameFromGameGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameFromGameGORM converts a GameGORM back to v1.Game.
-// The optional decorator function allows custom field transformations.
-func GameFromGameGORM(
+// GameFromGameGORMWithOptions is GameFromGameGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameFromGameGORMWithOptions(
+	ctx context.Context,
 	dest *v1.Game,
 	src *GameGORM,
-	decorator func(dest *v1.Game, src *GameGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.Game, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.Game{}
 	}
@@ -621,7 +1270,7 @@ func GameFromGameGORM(
 	*dest = v1.Game{
 		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
 		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Id:          src.Id,
+		Id:          string(src.Id),
 		CreatorId:   src.CreatorId,
 		WorldId:     src.WorldId,
 		Name:        src.Name,
@@ -633,22 +1282,97 @@ func GameFromGameGORM(
 	}
 	out = dest
 
-	out.Config, err = GameConfigurationFromGameConfigurationGORM(nil, &src.Config, nil)
+	out.Config, err = GameConfigurationFromGameConfigurationGORMWithOptions(ctx, nil, &src.Config)
 	if err != nil {
-		return nil, fmt.Errorf("converting Config: %w", err)
+		return nil, conversionGameFromGameGORM.FieldError(err, "config")
 	}
-	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoGORM(nil, &src.ScreenshotIndexInfo, nil)
+	out.ScreenshotIndexInfo, err = IndexInfoFromIndexInfoGORMWithOptions(ctx, nil, &src.ScreenshotIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting ScreenshotIndexInfo: %w", err)
+		return nil, conversionGameFromGameGORM.FieldError(err, "screenshot_index_info")
 	}
-	out.SearchIndexInfo, err = IndexInfoFromIndexInfoGORM(nil, &src.SearchIndexInfo, nil)
+	out.SearchIndexInfo, err = IndexInfoFromIndexInfoGORMWithOptions(ctx, nil, &src.SearchIndexInfo)
 	if err != nil {
-		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
+		return nil, conversionGameFromGameGORM.FieldError(err, "search_index_info")
+	}
+
+	return out, nil
+}
+
+// GameSliceToGameGORMSlice converts a slice of v1.Game with GameToGameGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSliceToGameGORMSlice(
+	src []*v1.Game,
+	decorator func(*v1.Game, *GameGORM) error,
+) ([]*GameGORM, error) {
+	return converters.MapSlice(src, func(item *v1.Game) (*GameGORM, error) {
+		return GameToGameGORM(item, nil, decorator)
+	})
+}
+
+// GameSliceFromGameGORMSlice converts a slice of GameGORM with GameFromGameGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSliceFromGameGORMSlice(
+	src []*GameGORM,
+	decorator func(*v1.Game, *GameGORM) error,
+) ([]*v1.Game, error) {
+	return converters.MapSlice(src, func(item *GameGORM) (*v1.Game, error) {
+		return GameFromGameGORM(nil, item, decorator)
+	})
+}
+
+// GameMapToGameGORMMap converts the values of a map with GameToGameGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMapToGameGORMMap[K comparable](
+	src map[K]*v1.Game,
+	decorator func(*v1.Game, *GameGORM) error,
+) (map[K]*GameGORM, error) {
+	return converters.MapValues(src, func(value *v1.Game) (*GameGORM, error) {
+		return GameToGameGORM(value, nil, decorator)
+	})
+}
+
+// GameMapFromGameGORMMap converts the values of a map with GameFromGameGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMapFromGameGORMMap[K comparable](
+	src map[K]*GameGORM,
+	decorator func(*v1.Game, *GameGORM) error,
+) (map[K]*v1.Game, error) {
+	return converters.MapValues(src, func(value *GameGORM) (*v1.Game, error) {
+		return GameFromGameGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameConfigurationToGameConfigurationGORM describes GameConfigurationToGameConfigurationGORM in conversion errors.
+var conversionGameConfigurationToGameConfigurationGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_configuration",
+	SourceType: "v1.GameConfiguration",
+	TargetType: "GameConfigurationGORM",
+}
+
+// conversionGameConfigurationFromGameConfigurationGORM describes GameConfigurationFromGameConfigurationGORM in conversion errors.
+var conversionGameConfigurationFromGameConfigurationGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_configuration",
+	SourceType: "GameConfigurationGORM",
+	TargetType: "v1.GameConfiguration",
+}
+
+// GameConfigurationToGameConfigurationGORM converts a v1.GameConfiguration to GameConfigurationGORM.
+// The optional decorator function allows custom field transformations.
+func GameConfigurationToGameConfigurationGORM(
+	src *v1.GameConfiguration,
+	dest *GameConfigurationGORM,
+	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+) (out *GameConfigurationGORM, err error) {
+	out, err = GameConfigurationToGameConfigurationGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -656,16 +1380,20 @@ func GameFromGameGORM(
 	return out, nil
 }
 
-// GameConfigurationToGameConfigurationGORM converts a v1.GameConfiguration to GameConfigurationGORM.
-// The optional decorator function allows custom field transformations.
-func GameConfigurationToGameConfigurationGORM(
+// GameConfigurationToGameConfigurationGORMWithOptions is GameConfigurationToGameConfigurationGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameConfigurationToGameConfigurationGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameConfiguration,
 	dest *GameConfigurationGORM,
-	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameConfigurationGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameConfigurationGORM{}
 	}
@@ -675,57 +1403,76 @@ func GameConfigurationToGameConfigurationGORM(
 	out = dest
 
 	if src.IncomeConfigs != nil {
-		_, err = IncomeConfigToIncomeConfigGORM(src.IncomeConfigs, &out.IncomeConfigs, nil)
+		_, err = IncomeConfigToIncomeConfigGORMWithOptions(ctx, src.IncomeConfigs, &out.IncomeConfigs)
 		if err != nil {
-			return nil, fmt.Errorf("converting IncomeConfigs: %w", err)
+			return nil, conversionGameConfigurationToGameConfigurationGORM.FieldError(err, "income_configs")
 		}
 	}
 	if src.Settings != nil {
-		_, err = GameSettingsToGameSettingsGORM(src.Settings, &out.Settings, nil)
+		_, err = GameSettingsToGameSettingsGORMWithOptions(ctx, src.Settings, &out.Settings)
 		if err != nil {
-			return nil, fmt.Errorf("converting Settings: %w", err)
+			return nil, conversionGameConfigurationToGameConfigurationGORM.FieldError(err, "settings")
 		}
 	}
 
 	if src.Players != nil {
 		out.Players = make([]GamePlayerGORM, len(src.Players))
 		for i, item := range src.Players {
-			_, err = GamePlayerToGamePlayerGORM(item, &out.Players[i], nil)
+			_, err = GamePlayerToGamePlayerGORMWithOptions(ctx, item, &out.Players[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Players[%d]: %w", i, err)
+				return nil, conversionGameConfigurationToGameConfigurationGORM.ElementError(err, "players", i)
 			}
 		}
 	}
 	if src.Teams != nil {
 		out.Teams = make([]GameTeamGORM, len(src.Teams))
 		for i, item := range src.Teams {
-			_, err = GameTeamToGameTeamGORM(item, &out.Teams[i], nil)
+			_, err = GameTeamToGameTeamGORMWithOptions(ctx, item, &out.Teams[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Teams[%d]: %w", i, err)
+				return nil, conversionGameConfigurationToGameConfigurationGORM.ElementError(err, "teams", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// GameConfigurationFromGameConfigurationGORM converts a GameConfigurationGORM back to v1.GameConfiguration.
+// The optional decorator function allows custom field transformations.
+func GameConfigurationFromGameConfigurationGORM(
+	dest *v1.GameConfiguration,
+	src *GameConfigurationGORM,
+	decorator func(dest *v1.GameConfiguration, src *GameConfigurationGORM) error,
+) (out *v1.GameConfiguration, err error) {
+	out, err = GameConfigurationFromGameConfigurationGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameConfigurationFromGameConfigurationGORM converts a GameConfigurationGORM back to v1.GameConfiguration.
-// The optional decorator function allows custom field transformations.
-func GameConfigurationFromGameConfigurationGORM(
+// GameConfigurationFromGameConfigurationGORMWithOptions is GameConfigurationFromGameConfigurationGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameConfigurationFromGameConfigurationGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameConfiguration,
 	src *GameConfigurationGORM,
-	decorator func(dest *v1.GameConfiguration, src *GameConfigurationGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameConfiguration, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameConfiguration{}
 	}
@@ -734,37 +1481,112 @@ func GameConfigurationFromGameConfigurationGORM(
 	*dest = v1.GameConfiguration{}
 	out = dest
 
-	out.IncomeConfigs, err = IncomeConfigFromIncomeConfigGORM(nil, &src.IncomeConfigs, nil)
+	out.IncomeConfigs, err = IncomeConfigFromIncomeConfigGORMWithOptions(ctx, nil, &src.IncomeConfigs)
 	if err != nil {
-		return nil, fmt.Errorf("converting IncomeConfigs: %w", err)
+		return nil, conversionGameConfigurationFromGameConfigurationGORM.FieldError(err, "income_configs")
 	}
-	out.Settings, err = GameSettingsFromGameSettingsGORM(nil, &src.Settings, nil)
+	out.Settings, err = GameSettingsFromGameSettingsGORMWithOptions(ctx, nil, &src.Settings)
 	if err != nil {
-		return nil, fmt.Errorf("converting Settings: %w", err)
+		return nil, conversionGameConfigurationFromGameConfigurationGORM.FieldError(err, "settings")
 	}
 
 	if src.Players != nil {
 		out.Players = make([]*v1.GamePlayer, len(src.Players))
 		for i, item := range src.Players {
-			out.Players[i], err = GamePlayerFromGamePlayerGORM(nil, &item, nil)
+			out.Players[i], err = GamePlayerFromGamePlayerGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Players[%d]: %w", i, err)
+				return nil, conversionGameConfigurationFromGameConfigurationGORM.ElementError(err, "players", i)
 			}
 		}
 	}
 	if src.Teams != nil {
 		out.Teams = make([]*v1.GameTeam, len(src.Teams))
 		for i, item := range src.Teams {
-			out.Teams[i], err = GameTeamFromGameTeamGORM(nil, &item, nil)
+			out.Teams[i], err = GameTeamFromGameTeamGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Teams[%d]: %w", i, err)
+				return nil, conversionGameConfigurationFromGameConfigurationGORM.ElementError(err, "teams", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// GameConfigurationSliceToGameConfigurationGORMSlice converts a slice of v1.GameConfiguration with GameConfigurationToGameConfigurationGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameConfigurationSliceToGameConfigurationGORMSlice(
+	src []*v1.GameConfiguration,
+	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+) ([]*GameConfigurationGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameConfiguration) (*GameConfigurationGORM, error) {
+		return GameConfigurationToGameConfigurationGORM(item, nil, decorator)
+	})
+}
+
+// GameConfigurationSliceFromGameConfigurationGORMSlice converts a slice of GameConfigurationGORM with GameConfigurationFromGameConfigurationGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameConfigurationSliceFromGameConfigurationGORMSlice(
+	src []*GameConfigurationGORM,
+	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+) ([]*v1.GameConfiguration, error) {
+	return converters.MapSlice(src, func(item *GameConfigurationGORM) (*v1.GameConfiguration, error) {
+		return GameConfigurationFromGameConfigurationGORM(nil, item, decorator)
+	})
+}
+
+// GameConfigurationMapToGameConfigurationGORMMap converts the values of a map with GameConfigurationToGameConfigurationGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameConfigurationMapToGameConfigurationGORMMap[K comparable](
+	src map[K]*v1.GameConfiguration,
+	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+) (map[K]*GameConfigurationGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameConfiguration) (*GameConfigurationGORM, error) {
+		return GameConfigurationToGameConfigurationGORM(value, nil, decorator)
+	})
+}
+
+// GameConfigurationMapFromGameConfigurationGORMMap converts the values of a map with GameConfigurationFromGameConfigurationGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameConfigurationMapFromGameConfigurationGORMMap[K comparable](
+	src map[K]*GameConfigurationGORM,
+	decorator func(*v1.GameConfiguration, *GameConfigurationGORM) error,
+) (map[K]*v1.GameConfiguration, error) {
+	return converters.MapValues(src, func(value *GameConfigurationGORM) (*v1.GameConfiguration, error) {
+		return GameConfigurationFromGameConfigurationGORM(nil, value, decorator)
+	})
+}
+
+// conversionIncomeConfigToIncomeConfigGORM describes IncomeConfigToIncomeConfigGORM in conversion errors.
+var conversionIncomeConfigToIncomeConfigGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "income_config",
+	SourceType: "v1.IncomeConfig",
+	TargetType: "IncomeConfigGORM",
+}
+
+// conversionIncomeConfigFromIncomeConfigGORM describes IncomeConfigFromIncomeConfigGORM in conversion errors.
+var conversionIncomeConfigFromIncomeConfigGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "income_config",
+	SourceType: "IncomeConfigGORM",
+	TargetType: "v1.IncomeConfig",
+}
+
+// IncomeConfigToIncomeConfigGORM converts a v1.IncomeConfig to IncomeConfigGORM.
+// The optional decorator function allows custom field transformations.
+func IncomeConfigToIncomeConfigGORM(
+	src *v1.IncomeConfig,
+	dest *IncomeConfigGORM,
+	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+) (out *IncomeConfigGORM, err error) {
+	out, err = IncomeConfigToIncomeConfigGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -772,16 +1594,20 @@ func GameConfigurationFromGameConfigurationGORM(
 	return out, nil
 }
 
-// IncomeConfigToIncomeConfigGORM converts a v1.IncomeConfig to IncomeConfigGORM.
-// The optional decorator function allows custom field transformations.
-func IncomeConfigToIncomeConfigGORM(
+// IncomeConfigToIncomeConfigGORMWithOptions is IncomeConfigToIncomeConfigGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func IncomeConfigToIncomeConfigGORMWithOptions(
+	ctx context.Context,
 	src *v1.IncomeConfig,
 	dest *IncomeConfigGORM,
-	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *IncomeConfigGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &IncomeConfigGORM{}
 	}
@@ -798,26 +1624,45 @@ func IncomeConfigToIncomeConfigGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// IncomeConfigFromIncomeConfigGORM converts a IncomeConfigGORM back to v1.IncomeConfig.
+// The optional decorator function allows custom field transformations.
+func IncomeConfigFromIncomeConfigGORM(
+	dest *v1.IncomeConfig,
+	src *IncomeConfigGORM,
+	decorator func(dest *v1.IncomeConfig, src *IncomeConfigGORM) error,
+) (out *v1.IncomeConfig, err error) {
+	out, err = IncomeConfigFromIncomeConfigGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// IncomeConfigFromIncomeConfigGORM converts a IncomeConfigGORM back to v1.IncomeConfig.
-// The optional decorator function allows custom field transformations.
-func IncomeConfigFromIncomeConfigGORM(
+// IncomeConfigFromIncomeConfigGORMWithOptions is IncomeConfigFromIncomeConfigGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func IncomeConfigFromIncomeConfigGORMWithOptions(
+	ctx context.Context,
 	dest *v1.IncomeConfig,
 	src *IncomeConfigGORM,
-	decorator func(dest *v1.IncomeConfig, src *IncomeConfigGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.IncomeConfig, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.IncomeConfig{}
 	}
@@ -834,9 +1679,84 @@ func IncomeConfigFromIncomeConfigGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// IncomeConfigSliceToIncomeConfigGORMSlice converts a slice of v1.IncomeConfig with IncomeConfigToIncomeConfigGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func IncomeConfigSliceToIncomeConfigGORMSlice(
+	src []*v1.IncomeConfig,
+	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+) ([]*IncomeConfigGORM, error) {
+	return converters.MapSlice(src, func(item *v1.IncomeConfig) (*IncomeConfigGORM, error) {
+		return IncomeConfigToIncomeConfigGORM(item, nil, decorator)
+	})
+}
+
+// IncomeConfigSliceFromIncomeConfigGORMSlice converts a slice of IncomeConfigGORM with IncomeConfigFromIncomeConfigGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func IncomeConfigSliceFromIncomeConfigGORMSlice(
+	src []*IncomeConfigGORM,
+	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+) ([]*v1.IncomeConfig, error) {
+	return converters.MapSlice(src, func(item *IncomeConfigGORM) (*v1.IncomeConfig, error) {
+		return IncomeConfigFromIncomeConfigGORM(nil, item, decorator)
+	})
+}
+
+// IncomeConfigMapToIncomeConfigGORMMap converts the values of a map with IncomeConfigToIncomeConfigGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func IncomeConfigMapToIncomeConfigGORMMap[K comparable](
+	src map[K]*v1.IncomeConfig,
+	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+) (map[K]*IncomeConfigGORM, error) {
+	return converters.MapValues(src, func(value *v1.IncomeConfig) (*IncomeConfigGORM, error) {
+		return IncomeConfigToIncomeConfigGORM(value, nil, decorator)
+	})
+}
+
+// IncomeConfigMapFromIncomeConfigGORMMap converts the values of a map with IncomeConfigFromIncomeConfigGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func IncomeConfigMapFromIncomeConfigGORMMap[K comparable](
+	src map[K]*IncomeConfigGORM,
+	decorator func(*v1.IncomeConfig, *IncomeConfigGORM) error,
+) (map[K]*v1.IncomeConfig, error) {
+	return converters.MapValues(src, func(value *IncomeConfigGORM) (*v1.IncomeConfig, error) {
+		return IncomeConfigFromIncomeConfigGORM(nil, value, decorator)
+	})
+}
+
+// conversionGamePlayerToGamePlayerGORM describes GamePlayerToGamePlayerGORM in conversion errors.
+var conversionGamePlayerToGamePlayerGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_player",
+	SourceType: "v1.GamePlayer",
+	TargetType: "GamePlayerGORM",
+}
+
+// conversionGamePlayerFromGamePlayerGORM describes GamePlayerFromGamePlayerGORM in conversion errors.
+var conversionGamePlayerFromGamePlayerGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_player",
+	SourceType: "GamePlayerGORM",
+	TargetType: "v1.GamePlayer",
+}
+
+// GamePlayerToGamePlayerGORM converts a v1.GamePlayer to GamePlayerGORM.
+// The optional decorator function allows custom field transformations.
+func GamePlayerToGamePlayerGORM(
+	src *v1.GamePlayer,
+	dest *GamePlayerGORM,
+	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+) (out *GamePlayerGORM, err error) {
+	out, err = GamePlayerToGamePlayerGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -844,16 +1764,20 @@ func IncomeConfigFromIncomeConfigGORM(
 	return out, nil
 }
 
-// GamePlayerToGamePlayerGORM converts a v1.GamePlayer to GamePlayerGORM.
-// The optional decorator function allows custom field transformations.
-func GamePlayerToGamePlayerGORM(
+// GamePlayerToGamePlayerGORMWithOptions is GamePlayerToGamePlayerGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GamePlayerToGamePlayerGORMWithOptions(
+	ctx context.Context,
 	src *v1.GamePlayer,
 	dest *GamePlayerGORM,
-	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GamePlayerGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GamePlayerGORM{}
 	}
@@ -871,13 +1795,6 @@ func GamePlayerToGamePlayerGORM(
 	}
 	out = dest
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
-			return nil, err
-		}
-	}
-
 	return dest, nil
 }
 
@@ -888,11 +1805,37 @@ func GamePlayerFromGamePlayerGORM(
 	src *GamePlayerGORM,
 	decorator func(dest *v1.GamePlayer, src *GamePlayerGORM) error,
 ) (out *v1.GamePlayer, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
-		dest = &v1.GamePlayer{}
+	out, err = GamePlayerFromGamePlayerGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(out, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GamePlayerFromGamePlayerGORMWithOptions is GamePlayerFromGamePlayerGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GamePlayerFromGamePlayerGORMWithOptions(
+	ctx context.Context,
+	dest *v1.GamePlayer,
+	src *GamePlayerGORM,
+	opts ...converters.ConvertOption,
+) (out *v1.GamePlayer, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = &v1.GamePlayer{}
 	}
 
 	// Initialize struct with inline values
@@ -908,9 +1851,84 @@ func GamePlayerFromGamePlayerGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// GamePlayerSliceToGamePlayerGORMSlice converts a slice of v1.GamePlayer with GamePlayerToGamePlayerGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GamePlayerSliceToGamePlayerGORMSlice(
+	src []*v1.GamePlayer,
+	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+) ([]*GamePlayerGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GamePlayer) (*GamePlayerGORM, error) {
+		return GamePlayerToGamePlayerGORM(item, nil, decorator)
+	})
+}
+
+// GamePlayerSliceFromGamePlayerGORMSlice converts a slice of GamePlayerGORM with GamePlayerFromGamePlayerGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GamePlayerSliceFromGamePlayerGORMSlice(
+	src []*GamePlayerGORM,
+	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+) ([]*v1.GamePlayer, error) {
+	return converters.MapSlice(src, func(item *GamePlayerGORM) (*v1.GamePlayer, error) {
+		return GamePlayerFromGamePlayerGORM(nil, item, decorator)
+	})
+}
+
+// GamePlayerMapToGamePlayerGORMMap converts the values of a map with GamePlayerToGamePlayerGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GamePlayerMapToGamePlayerGORMMap[K comparable](
+	src map[K]*v1.GamePlayer,
+	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+) (map[K]*GamePlayerGORM, error) {
+	return converters.MapValues(src, func(value *v1.GamePlayer) (*GamePlayerGORM, error) {
+		return GamePlayerToGamePlayerGORM(value, nil, decorator)
+	})
+}
+
+// GamePlayerMapFromGamePlayerGORMMap converts the values of a map with GamePlayerFromGamePlayerGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GamePlayerMapFromGamePlayerGORMMap[K comparable](
+	src map[K]*GamePlayerGORM,
+	decorator func(*v1.GamePlayer, *GamePlayerGORM) error,
+) (map[K]*v1.GamePlayer, error) {
+	return converters.MapValues(src, func(value *GamePlayerGORM) (*v1.GamePlayer, error) {
+		return GamePlayerFromGamePlayerGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameTeamToGameTeamGORM describes GameTeamToGameTeamGORM in conversion errors.
+var conversionGameTeamToGameTeamGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_team",
+	SourceType: "v1.GameTeam",
+	TargetType: "GameTeamGORM",
+}
+
+// conversionGameTeamFromGameTeamGORM describes GameTeamFromGameTeamGORM in conversion errors.
+var conversionGameTeamFromGameTeamGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_team",
+	SourceType: "GameTeamGORM",
+	TargetType: "v1.GameTeam",
+}
+
+// GameTeamToGameTeamGORM converts a v1.GameTeam to GameTeamGORM.
+// The optional decorator function allows custom field transformations.
+func GameTeamToGameTeamGORM(
+	src *v1.GameTeam,
+	dest *GameTeamGORM,
+	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+) (out *GameTeamGORM, err error) {
+	out, err = GameTeamToGameTeamGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -918,16 +1936,20 @@ func GamePlayerFromGamePlayerGORM(
 	return out, nil
 }
 
-// GameTeamToGameTeamGORM converts a v1.GameTeam to GameTeamGORM.
-// The optional decorator function allows custom field transformations.
-func GameTeamToGameTeamGORM(
+// GameTeamToGameTeamGORMWithOptions is GameTeamToGameTeamGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameTeamToGameTeamGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameTeam,
 	dest *GameTeamGORM,
-	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameTeamGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameTeamGORM{}
 	}
@@ -941,26 +1963,45 @@ func GameTeamToGameTeamGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// GameTeamFromGameTeamGORM converts a GameTeamGORM back to v1.GameTeam.
+// The optional decorator function allows custom field transformations.
+func GameTeamFromGameTeamGORM(
+	dest *v1.GameTeam,
+	src *GameTeamGORM,
+	decorator func(dest *v1.GameTeam, src *GameTeamGORM) error,
+) (out *v1.GameTeam, err error) {
+	out, err = GameTeamFromGameTeamGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameTeamFromGameTeamGORM converts a GameTeamGORM back to v1.GameTeam.
-// The optional decorator function allows custom field transformations.
-func GameTeamFromGameTeamGORM(
+// GameTeamFromGameTeamGORMWithOptions is GameTeamFromGameTeamGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameTeamFromGameTeamGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameTeam,
 	src *GameTeamGORM,
-	decorator func(dest *v1.GameTeam, src *GameTeamGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameTeam, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameTeam{}
 	}
@@ -974,9 +2015,84 @@ func GameTeamFromGameTeamGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// GameTeamSliceToGameTeamGORMSlice converts a slice of v1.GameTeam with GameTeamToGameTeamGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameTeamSliceToGameTeamGORMSlice(
+	src []*v1.GameTeam,
+	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+) ([]*GameTeamGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameTeam) (*GameTeamGORM, error) {
+		return GameTeamToGameTeamGORM(item, nil, decorator)
+	})
+}
+
+// GameTeamSliceFromGameTeamGORMSlice converts a slice of GameTeamGORM with GameTeamFromGameTeamGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameTeamSliceFromGameTeamGORMSlice(
+	src []*GameTeamGORM,
+	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+) ([]*v1.GameTeam, error) {
+	return converters.MapSlice(src, func(item *GameTeamGORM) (*v1.GameTeam, error) {
+		return GameTeamFromGameTeamGORM(nil, item, decorator)
+	})
+}
+
+// GameTeamMapToGameTeamGORMMap converts the values of a map with GameTeamToGameTeamGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameTeamMapToGameTeamGORMMap[K comparable](
+	src map[K]*v1.GameTeam,
+	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+) (map[K]*GameTeamGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameTeam) (*GameTeamGORM, error) {
+		return GameTeamToGameTeamGORM(value, nil, decorator)
+	})
+}
+
+// GameTeamMapFromGameTeamGORMMap converts the values of a map with GameTeamFromGameTeamGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameTeamMapFromGameTeamGORMMap[K comparable](
+	src map[K]*GameTeamGORM,
+	decorator func(*v1.GameTeam, *GameTeamGORM) error,
+) (map[K]*v1.GameTeam, error) {
+	return converters.MapValues(src, func(value *GameTeamGORM) (*v1.GameTeam, error) {
+		return GameTeamFromGameTeamGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameSettingsToGameSettingsGORM describes GameSettingsToGameSettingsGORM in conversion errors.
+var conversionGameSettingsToGameSettingsGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_settings",
+	SourceType: "v1.GameSettings",
+	TargetType: "GameSettingsGORM",
+}
+
+// conversionGameSettingsFromGameSettingsGORM describes GameSettingsFromGameSettingsGORM in conversion errors.
+var conversionGameSettingsFromGameSettingsGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_settings",
+	SourceType: "GameSettingsGORM",
+	TargetType: "v1.GameSettings",
+}
+
+// GameSettingsToGameSettingsGORM converts a v1.GameSettings to GameSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func GameSettingsToGameSettingsGORM(
+	src *v1.GameSettings,
+	dest *GameSettingsGORM,
+	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+) (out *GameSettingsGORM, err error) {
+	out, err = GameSettingsToGameSettingsGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -984,16 +2100,20 @@ func GameTeamFromGameTeamGORM(
 	return out, nil
 }
 
-// GameSettingsToGameSettingsGORM converts a v1.GameSettings to GameSettingsGORM.
-// The optional decorator function allows custom field transformations.
-func GameSettingsToGameSettingsGORM(
+// GameSettingsToGameSettingsGORMWithOptions is GameSettingsToGameSettingsGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameSettingsToGameSettingsGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameSettings,
 	dest *GameSettingsGORM,
-	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameSettingsGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameSettingsGORM{}
 	}
@@ -1007,26 +2127,45 @@ func GameSettingsToGameSettingsGORM(
 	}
 	out = dest
 
+	return dest, nil
+}
+
+// GameSettingsFromGameSettingsGORM converts a GameSettingsGORM back to v1.GameSettings.
+// The optional decorator function allows custom field transformations.
+func GameSettingsFromGameSettingsGORM(
+	dest *v1.GameSettings,
+	src *GameSettingsGORM,
+	decorator func(dest *v1.GameSettings, src *GameSettingsGORM) error,
+) (out *v1.GameSettings, err error) {
+	out, err = GameSettingsFromGameSettingsGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameSettingsFromGameSettingsGORM converts a GameSettingsGORM back to v1.GameSettings.
-// The optional decorator function allows custom field transformations.
-func GameSettingsFromGameSettingsGORM(
+// GameSettingsFromGameSettingsGORMWithOptions is GameSettingsFromGameSettingsGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameSettingsFromGameSettingsGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameSettings,
 	src *GameSettingsGORM,
-	decorator func(dest *v1.GameSettings, src *GameSettingsGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameSettings, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameSettings{}
 	}
@@ -1040,9 +2179,84 @@ func GameSettingsFromGameSettingsGORM(
 	}
 	out = dest
 
+	return out, nil
+}
+
+// GameSettingsSliceToGameSettingsGORMSlice converts a slice of v1.GameSettings with GameSettingsToGameSettingsGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSettingsSliceToGameSettingsGORMSlice(
+	src []*v1.GameSettings,
+	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+) ([]*GameSettingsGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameSettings) (*GameSettingsGORM, error) {
+		return GameSettingsToGameSettingsGORM(item, nil, decorator)
+	})
+}
+
+// GameSettingsSliceFromGameSettingsGORMSlice converts a slice of GameSettingsGORM with GameSettingsFromGameSettingsGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameSettingsSliceFromGameSettingsGORMSlice(
+	src []*GameSettingsGORM,
+	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+) ([]*v1.GameSettings, error) {
+	return converters.MapSlice(src, func(item *GameSettingsGORM) (*v1.GameSettings, error) {
+		return GameSettingsFromGameSettingsGORM(nil, item, decorator)
+	})
+}
+
+// GameSettingsMapToGameSettingsGORMMap converts the values of a map with GameSettingsToGameSettingsGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameSettingsMapToGameSettingsGORMMap[K comparable](
+	src map[K]*v1.GameSettings,
+	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+) (map[K]*GameSettingsGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameSettings) (*GameSettingsGORM, error) {
+		return GameSettingsToGameSettingsGORM(value, nil, decorator)
+	})
+}
+
+// GameSettingsMapFromGameSettingsGORMMap converts the values of a map with GameSettingsFromGameSettingsGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameSettingsMapFromGameSettingsGORMMap[K comparable](
+	src map[K]*GameSettingsGORM,
+	decorator func(*v1.GameSettings, *GameSettingsGORM) error,
+) (map[K]*v1.GameSettings, error) {
+	return converters.MapValues(src, func(value *GameSettingsGORM) (*v1.GameSettings, error) {
+		return GameSettingsFromGameSettingsGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameStateToGameStateGORM describes GameStateToGameStateGORM in conversion errors.
+var conversionGameStateToGameStateGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_state",
+	SourceType: "v1.GameState",
+	TargetType: "GameStateGORM",
+}
+
+// conversionGameStateFromGameStateGORM describes GameStateFromGameStateGORM in conversion errors.
+var conversionGameStateFromGameStateGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_state",
+	SourceType: "GameStateGORM",
+	TargetType: "v1.GameState",
+}
+
+// GameStateToGameStateGORM converts a v1.GameState to GameStateGORM.
+// The optional decorator function allows custom field transformations.
+func GameStateToGameStateGORM(
+	src *v1.GameState,
+	dest *GameStateGORM,
+	decorator func(*v1.GameState, *GameStateGORM) error,
+) (out *GameStateGORM, err error) {
+	out, err = GameStateToGameStateGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -1050,23 +2264,27 @@ func GameSettingsFromGameSettingsGORM(
 	return out, nil
 }
 
-// GameStateToGameStateGORM converts a v1.GameState to GameStateGORM.
-// The optional decorator function allows custom field transformations.
-func GameStateToGameStateGORM(
+// GameStateToGameStateGORMWithOptions is GameStateToGameStateGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameStateToGameStateGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameState,
 	dest *GameStateGORM,
-	decorator func(*v1.GameState, *GameStateGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameStateGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameStateGORM{}
 	}
 
 	// Initialize struct with inline values
 	*dest = GameStateGORM{
-		GameId:        src.GameId,
+		GameId:        GameID(src.GameId),
 		TurnCounter:   src.TurnCounter,
 		CurrentPlayer: src.CurrentPlayer,
 		StateHash:     src.StateHash,
@@ -1083,32 +2301,51 @@ func GameStateToGameStateGORM(
 	}
 
 	if src.WorldData != nil {
-		_, err = WorldDataToWorldDataGORM(src.WorldData, &out.WorldData, nil)
+		_, err = WorldDataToWorldDataGORMWithOptions(ctx, src.WorldData, &out.WorldData)
 		if err != nil {
-			return nil, fmt.Errorf("converting WorldData: %w", err)
+			return nil, conversionGameStateToGameStateGORM.FieldError(err, "world_data")
 		}
 	}
 
+	return dest, nil
+}
+
+// GameStateFromGameStateGORM converts a GameStateGORM back to v1.GameState.
+// The optional decorator function allows custom field transformations.
+func GameStateFromGameStateGORM(
+	dest *v1.GameState,
+	src *GameStateGORM,
+	decorator func(dest *v1.GameState, src *GameStateGORM) error,
+) (out *v1.GameState, err error) {
+	out, err = GameStateFromGameStateGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameStateFromGameStateGORM converts a GameStateGORM back to v1.GameState.
-// The optional decorator function allows custom field transformations.
-func GameStateFromGameStateGORM(
+// GameStateFromGameStateGORMWithOptions is GameStateFromGameStateGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameStateFromGameStateGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameState,
 	src *GameStateGORM,
-	decorator func(dest *v1.GameState, src *GameStateGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameState, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameState{}
 	}
@@ -1116,7 +2353,7 @@ func GameStateFromGameStateGORM(
 	// Initialize struct with inline values
 	*dest = v1.GameState{
 		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
-		GameId:        src.GameId,
+		GameId:        string(src.GameId),
 		TurnCounter:   src.TurnCounter,
 		CurrentPlayer: src.CurrentPlayer,
 		StateHash:     src.StateHash,
@@ -1128,14 +2365,89 @@ func GameStateFromGameStateGORM(
 	}
 	out = dest
 
-	out.WorldData, err = WorldDataFromWorldDataGORM(nil, &src.WorldData, nil)
+	out.WorldData, err = WorldDataFromWorldDataGORMWithOptions(ctx, nil, &src.WorldData)
 	if err != nil {
-		return nil, fmt.Errorf("converting WorldData: %w", err)
+		return nil, conversionGameStateFromGameStateGORM.FieldError(err, "world_data")
+	}
+
+	return out, nil
+}
+
+// GameStateSliceToGameStateGORMSlice converts a slice of v1.GameState with GameStateToGameStateGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameStateSliceToGameStateGORMSlice(
+	src []*v1.GameState,
+	decorator func(*v1.GameState, *GameStateGORM) error,
+) ([]*GameStateGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameState) (*GameStateGORM, error) {
+		return GameStateToGameStateGORM(item, nil, decorator)
+	})
+}
+
+// GameStateSliceFromGameStateGORMSlice converts a slice of GameStateGORM with GameStateFromGameStateGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameStateSliceFromGameStateGORMSlice(
+	src []*GameStateGORM,
+	decorator func(*v1.GameState, *GameStateGORM) error,
+) ([]*v1.GameState, error) {
+	return converters.MapSlice(src, func(item *GameStateGORM) (*v1.GameState, error) {
+		return GameStateFromGameStateGORM(nil, item, decorator)
+	})
+}
+
+// GameStateMapToGameStateGORMMap converts the values of a map with GameStateToGameStateGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameStateMapToGameStateGORMMap[K comparable](
+	src map[K]*v1.GameState,
+	decorator func(*v1.GameState, *GameStateGORM) error,
+) (map[K]*GameStateGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameState) (*GameStateGORM, error) {
+		return GameStateToGameStateGORM(value, nil, decorator)
+	})
+}
+
+// GameStateMapFromGameStateGORMMap converts the values of a map with GameStateFromGameStateGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameStateMapFromGameStateGORMMap[K comparable](
+	src map[K]*GameStateGORM,
+	decorator func(*v1.GameState, *GameStateGORM) error,
+) (map[K]*v1.GameState, error) {
+	return converters.MapValues(src, func(value *GameStateGORM) (*v1.GameState, error) {
+		return GameStateFromGameStateGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveHistoryToGameMoveHistoryGORM describes GameMoveHistoryToGameMoveHistoryGORM in conversion errors.
+var conversionGameMoveHistoryToGameMoveHistoryGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move_history",
+	SourceType: "v1.GameMoveHistory",
+	TargetType: "GameMoveHistoryGORM",
+}
+
+// conversionGameMoveHistoryFromGameMoveHistoryGORM describes GameMoveHistoryFromGameMoveHistoryGORM in conversion errors.
+var conversionGameMoveHistoryFromGameMoveHistoryGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move_history",
+	SourceType: "GameMoveHistoryGORM",
+	TargetType: "v1.GameMoveHistory",
+}
+
+// GameMoveHistoryToGameMoveHistoryGORM converts a v1.GameMoveHistory to GameMoveHistoryGORM.
+// The optional decorator function allows custom field transformations.
+func GameMoveHistoryToGameMoveHistoryGORM(
+	src *v1.GameMoveHistory,
+	dest *GameMoveHistoryGORM,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+) (out *GameMoveHistoryGORM, err error) {
+	out, err = GameMoveHistoryToGameMoveHistoryGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
 	}
 
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -1143,16 +2455,20 @@ func GameStateFromGameStateGORM(
 	return out, nil
 }
 
-// GameMoveHistoryToGameMoveHistoryGORM converts a v1.GameMoveHistory to GameMoveHistoryGORM.
-// The optional decorator function allows custom field transformations.
-func GameMoveHistoryToGameMoveHistoryGORM(
+// GameMoveHistoryToGameMoveHistoryGORMWithOptions is GameMoveHistoryToGameMoveHistoryGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveHistoryToGameMoveHistoryGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameMoveHistory,
 	dest *GameMoveHistoryGORM,
-	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameMoveHistoryGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameMoveHistoryGORM{}
 	}
@@ -1166,33 +2482,52 @@ func GameMoveHistoryToGameMoveHistoryGORM(
 	if src.Groups != nil {
 		out.Groups = make([]GameMoveGroupGORM, len(src.Groups))
 		for i, item := range src.Groups {
-			_, err = GameMoveGroupToGameMoveGroupGORM(item, &out.Groups[i], nil)
+			_, err = GameMoveGroupToGameMoveGroupGORMWithOptions(ctx, item, &out.Groups[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Groups[%d]: %w", i, err)
+				return nil, conversionGameMoveHistoryToGameMoveHistoryGORM.ElementError(err, "groups", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// GameMoveHistoryFromGameMoveHistoryGORM converts a GameMoveHistoryGORM back to v1.GameMoveHistory.
+// The optional decorator function allows custom field transformations.
+func GameMoveHistoryFromGameMoveHistoryGORM(
+	dest *v1.GameMoveHistory,
+	src *GameMoveHistoryGORM,
+	decorator func(dest *v1.GameMoveHistory, src *GameMoveHistoryGORM) error,
+) (out *v1.GameMoveHistory, err error) {
+	out, err = GameMoveHistoryFromGameMoveHistoryGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveHistoryFromGameMoveHistoryGORM converts a GameMoveHistoryGORM back to v1.GameMoveHistory.
-// The optional decorator function allows custom field transformations.
-func GameMoveHistoryFromGameMoveHistoryGORM(
+// GameMoveHistoryFromGameMoveHistoryGORMWithOptions is GameMoveHistoryFromGameMoveHistoryGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveHistoryFromGameMoveHistoryGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameMoveHistory,
 	src *GameMoveHistoryGORM,
-	decorator func(dest *v1.GameMoveHistory, src *GameMoveHistoryGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameMoveHistory, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameMoveHistory{}
 	}
@@ -1206,16 +2541,91 @@ func GameMoveHistoryFromGameMoveHistoryGORM(
 	if src.Groups != nil {
 		out.Groups = make([]*v1.GameMoveGroup, len(src.Groups))
 		for i, item := range src.Groups {
-			out.Groups[i], err = GameMoveGroupFromGameMoveGroupGORM(nil, &item, nil)
+			out.Groups[i], err = GameMoveGroupFromGameMoveGroupGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Groups[%d]: %w", i, err)
+				return nil, conversionGameMoveHistoryFromGameMoveHistoryGORM.ElementError(err, "groups", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// GameMoveHistorySliceToGameMoveHistoryGORMSlice converts a slice of v1.GameMoveHistory with GameMoveHistoryToGameMoveHistoryGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveHistorySliceToGameMoveHistoryGORMSlice(
+	src []*v1.GameMoveHistory,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+) ([]*GameMoveHistoryGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameMoveHistory) (*GameMoveHistoryGORM, error) {
+		return GameMoveHistoryToGameMoveHistoryGORM(item, nil, decorator)
+	})
+}
+
+// GameMoveHistorySliceFromGameMoveHistoryGORMSlice converts a slice of GameMoveHistoryGORM with GameMoveHistoryFromGameMoveHistoryGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveHistorySliceFromGameMoveHistoryGORMSlice(
+	src []*GameMoveHistoryGORM,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+) ([]*v1.GameMoveHistory, error) {
+	return converters.MapSlice(src, func(item *GameMoveHistoryGORM) (*v1.GameMoveHistory, error) {
+		return GameMoveHistoryFromGameMoveHistoryGORM(nil, item, decorator)
+	})
+}
+
+// GameMoveHistoryMapToGameMoveHistoryGORMMap converts the values of a map with GameMoveHistoryToGameMoveHistoryGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveHistoryMapToGameMoveHistoryGORMMap[K comparable](
+	src map[K]*v1.GameMoveHistory,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+) (map[K]*GameMoveHistoryGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameMoveHistory) (*GameMoveHistoryGORM, error) {
+		return GameMoveHistoryToGameMoveHistoryGORM(value, nil, decorator)
+	})
+}
+
+// GameMoveHistoryMapFromGameMoveHistoryGORMMap converts the values of a map with GameMoveHistoryFromGameMoveHistoryGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveHistoryMapFromGameMoveHistoryGORMMap[K comparable](
+	src map[K]*GameMoveHistoryGORM,
+	decorator func(*v1.GameMoveHistory, *GameMoveHistoryGORM) error,
+) (map[K]*v1.GameMoveHistory, error) {
+	return converters.MapValues(src, func(value *GameMoveHistoryGORM) (*v1.GameMoveHistory, error) {
+		return GameMoveHistoryFromGameMoveHistoryGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveGroupToGameMoveGroupGORM describes GameMoveGroupToGameMoveGroupGORM in conversion errors.
+var conversionGameMoveGroupToGameMoveGroupGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move_group",
+	SourceType: "v1.GameMoveGroup",
+	TargetType: "GameMoveGroupGORM",
+}
+
+// conversionGameMoveGroupFromGameMoveGroupGORM describes GameMoveGroupFromGameMoveGroupGORM in conversion errors.
+var conversionGameMoveGroupFromGameMoveGroupGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move_group",
+	SourceType: "GameMoveGroupGORM",
+	TargetType: "v1.GameMoveGroup",
+}
+
+// GameMoveGroupToGameMoveGroupGORM converts a v1.GameMoveGroup to GameMoveGroupGORM.
+// The optional decorator function allows custom field transformations.
+func GameMoveGroupToGameMoveGroupGORM(
+	src *v1.GameMoveGroup,
+	dest *GameMoveGroupGORM,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+) (out *GameMoveGroupGORM, err error) {
+	out, err = GameMoveGroupToGameMoveGroupGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -1223,16 +2633,20 @@ func GameMoveHistoryFromGameMoveHistoryGORM(
 	return out, nil
 }
 
-// GameMoveGroupToGameMoveGroupGORM converts a v1.GameMoveGroup to GameMoveGroupGORM.
-// The optional decorator function allows custom field transformations.
-func GameMoveGroupToGameMoveGroupGORM(
+// GameMoveGroupToGameMoveGroupGORMWithOptions is GameMoveGroupToGameMoveGroupGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveGroupToGameMoveGroupGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameMoveGroup,
 	dest *GameMoveGroupGORM,
-	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameMoveGroupGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameMoveGroupGORM{}
 	}
@@ -1252,33 +2666,52 @@ func GameMoveGroupToGameMoveGroupGORM(
 	if src.Moves != nil {
 		out.Moves = make([]GameMoveGORM, len(src.Moves))
 		for i, item := range src.Moves {
-			_, err = GameMoveToGameMoveGORM(item, &out.Moves[i], nil)
+			_, err = GameMoveToGameMoveGORMWithOptions(ctx, item, &out.Moves[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+				return nil, conversionGameMoveGroupToGameMoveGroupGORM.ElementError(err, "moves", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// GameMoveGroupFromGameMoveGroupGORM converts a GameMoveGroupGORM back to v1.GameMoveGroup.
+// The optional decorator function allows custom field transformations.
+func GameMoveGroupFromGameMoveGroupGORM(
+	dest *v1.GameMoveGroup,
+	src *GameMoveGroupGORM,
+	decorator func(dest *v1.GameMoveGroup, src *GameMoveGroupGORM) error,
+) (out *v1.GameMoveGroup, err error) {
+	out, err = GameMoveGroupFromGameMoveGroupGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveGroupFromGameMoveGroupGORM converts a GameMoveGroupGORM back to v1.GameMoveGroup.
-// The optional decorator function allows custom field transformations.
-func GameMoveGroupFromGameMoveGroupGORM(
+// GameMoveGroupFromGameMoveGroupGORMWithOptions is GameMoveGroupFromGameMoveGroupGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveGroupFromGameMoveGroupGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameMoveGroup,
 	src *GameMoveGroupGORM,
-	decorator func(dest *v1.GameMoveGroup, src *GameMoveGroupGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameMoveGroup, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameMoveGroup{}
 	}
@@ -1293,16 +2726,91 @@ func GameMoveGroupFromGameMoveGroupGORM(
 	if src.Moves != nil {
 		out.Moves = make([]*v1.GameMove, len(src.Moves))
 		for i, item := range src.Moves {
-			out.Moves[i], err = GameMoveFromGameMoveGORM(nil, &item, nil)
+			out.Moves[i], err = GameMoveFromGameMoveGORMWithOptions(ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+				return nil, conversionGameMoveGroupFromGameMoveGroupGORM.ElementError(err, "moves", i)
 			}
 		}
 	}
 
+	return out, nil
+}
+
+// GameMoveGroupSliceToGameMoveGroupGORMSlice converts a slice of v1.GameMoveGroup with GameMoveGroupToGameMoveGroupGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveGroupSliceToGameMoveGroupGORMSlice(
+	src []*v1.GameMoveGroup,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+) ([]*GameMoveGroupGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameMoveGroup) (*GameMoveGroupGORM, error) {
+		return GameMoveGroupToGameMoveGroupGORM(item, nil, decorator)
+	})
+}
+
+// GameMoveGroupSliceFromGameMoveGroupGORMSlice converts a slice of GameMoveGroupGORM with GameMoveGroupFromGameMoveGroupGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveGroupSliceFromGameMoveGroupGORMSlice(
+	src []*GameMoveGroupGORM,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+) ([]*v1.GameMoveGroup, error) {
+	return converters.MapSlice(src, func(item *GameMoveGroupGORM) (*v1.GameMoveGroup, error) {
+		return GameMoveGroupFromGameMoveGroupGORM(nil, item, decorator)
+	})
+}
+
+// GameMoveGroupMapToGameMoveGroupGORMMap converts the values of a map with GameMoveGroupToGameMoveGroupGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveGroupMapToGameMoveGroupGORMMap[K comparable](
+	src map[K]*v1.GameMoveGroup,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+) (map[K]*GameMoveGroupGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameMoveGroup) (*GameMoveGroupGORM, error) {
+		return GameMoveGroupToGameMoveGroupGORM(value, nil, decorator)
+	})
+}
+
+// GameMoveGroupMapFromGameMoveGroupGORMMap converts the values of a map with GameMoveGroupFromGameMoveGroupGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveGroupMapFromGameMoveGroupGORMMap[K comparable](
+	src map[K]*GameMoveGroupGORM,
+	decorator func(*v1.GameMoveGroup, *GameMoveGroupGORM) error,
+) (map[K]*v1.GameMoveGroup, error) {
+	return converters.MapValues(src, func(value *GameMoveGroupGORM) (*v1.GameMoveGroup, error) {
+		return GameMoveGroupFromGameMoveGroupGORM(nil, value, decorator)
+	})
+}
+
+// conversionGameMoveToGameMoveGORM describes GameMoveToGameMoveGORM in conversion errors.
+var conversionGameMoveToGameMoveGORM = converters.Conversion{
+	Direction:  converters.ToTarget,
+	Message:    "game_move",
+	SourceType: "v1.GameMove",
+	TargetType: "GameMoveGORM",
+}
+
+// conversionGameMoveFromGameMoveGORM describes GameMoveFromGameMoveGORM in conversion errors.
+var conversionGameMoveFromGameMoveGORM = converters.Conversion{
+	Direction:  converters.FromTarget,
+	Message:    "game_move",
+	SourceType: "GameMoveGORM",
+	TargetType: "v1.GameMove",
+}
+
+// GameMoveToGameMoveGORM converts a v1.GameMove to GameMoveGORM.
+// The optional decorator function allows custom field transformations.
+func GameMoveToGameMoveGORM(
+	src *v1.GameMove,
+	dest *GameMoveGORM,
+	decorator func(*v1.GameMove, *GameMoveGORM) error,
+) (out *GameMoveGORM, err error) {
+	out, err = GameMoveToGameMoveGORMWithOptions(context.Background(), src, dest)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
+		if err := decorator(src, out); err != nil {
 			return nil, err
 		}
 	}
@@ -1310,16 +2818,20 @@ func GameMoveGroupFromGameMoveGroupGORM(
 	return out, nil
 }
 
-// GameMoveToGameMoveGORM converts a v1.GameMove to GameMoveGORM.
-// The optional decorator function allows custom field transformations.
-func GameMoveToGameMoveGORM(
+// GameMoveToGameMoveGORMWithOptions is GameMoveToGameMoveGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveToGameMoveGORMWithOptions(
+	ctx context.Context,
 	src *v1.GameMove,
 	dest *GameMoveGORM,
-	decorator func(*v1.GameMove, *GameMoveGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *GameMoveGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &GameMoveGORM{}
 	}
@@ -1339,33 +2851,52 @@ func GameMoveToGameMoveGORM(
 	if src.Changes != nil {
 		out.Changes = make([][]byte, len(src.Changes))
 		for i, item := range src.Changes {
-			_, err = converters.MessageToAnyBytesConverter(item, &out.Changes[i], nil)
+			_, err = converters.MessageToAnyBytesConverterWithOptions(ctx, item, &out.Changes[i])
 			if err != nil {
-				return nil, fmt.Errorf("converting Changes[%d]: %w", i, err)
+				return nil, conversionGameMoveToGameMoveGORM.ElementError(err, "changes", i)
 			}
 		}
 	}
 
+	return dest, nil
+}
+
+// GameMoveFromGameMoveGORM converts a GameMoveGORM back to v1.GameMove.
+// The optional decorator function allows custom field transformations.
+func GameMoveFromGameMoveGORM(
+	dest *v1.GameMove,
+	src *GameMoveGORM,
+	decorator func(dest *v1.GameMove, src *GameMoveGORM) error,
+) (out *v1.GameMove, err error) {
+	out, err = GameMoveFromGameMoveGORMWithOptions(context.Background(), dest, src)
+	if err != nil || out == nil {
+		return out, err
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
-		if err := decorator(src, dest); err != nil {
+		if err := decorator(out, src); err != nil {
 			return nil, err
 		}
 	}
 
-	return dest, nil
+	return out, nil
 }
 
-// GameMoveFromGameMoveGORM converts a GameMoveGORM back to v1.GameMove.
-// The optional decorator function allows custom field transformations.
-func GameMoveFromGameMoveGORM(
+// GameMoveFromGameMoveGORMWithOptions is GameMoveFromGameMoveGORM without a decorator, taking a context and
+// conversion options that nested message converters inherit through ctx.
+func GameMoveFromGameMoveGORMWithOptions(
+	ctx context.Context,
 	dest *v1.GameMove,
 	src *GameMoveGORM,
-	decorator func(dest *v1.GameMove, src *GameMoveGORM) error,
+	opts ...converters.ConvertOption,
 ) (out *v1.GameMove, err error) {
 	if src == nil {
 		return nil, nil
 	}
+	if ctx, err = converters.EnterConversion(ctx, opts...); err != nil {
+		return nil, err
+	}
 	if dest == nil {
 		dest = &v1.GameMove{}
 	}
@@ -1382,19 +2913,56 @@ func GameMoveFromGameMoveGORM(
 	if src.Changes != nil {
 		out.Changes = make([]*v1.WorldChange, len(src.Changes))
 		for i, item := range src.Changes {
-			out.Changes[i], err = converters.AnyBytesToMessageConverter[*v1.WorldChange](nil, &item, nil)
+			out.Changes[i], err = converters.AnyBytesToMessageConverterWithOptions[*v1.WorldChange](ctx, nil, &item)
 			if err != nil {
-				return nil, fmt.Errorf("converting Changes[%d]: %w", i, err)
+				return nil, conversionGameMoveFromGameMoveGORM.ElementError(err, "changes", i)
 			}
 		}
 	}
 
-	// Apply decorator if provided
-	if decorator != nil {
-		if err := decorator(dest, src); err != nil {
-			return nil, err
-		}
-	}
-
 	return out, nil
 }
+
+// GameMoveSliceToGameMoveGORMSlice converts a slice of v1.GameMove with GameMoveToGameMoveGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveSliceToGameMoveGORMSlice(
+	src []*v1.GameMove,
+	decorator func(*v1.GameMove, *GameMoveGORM) error,
+) ([]*GameMoveGORM, error) {
+	return converters.MapSlice(src, func(item *v1.GameMove) (*GameMoveGORM, error) {
+		return GameMoveToGameMoveGORM(item, nil, decorator)
+	})
+}
+
+// GameMoveSliceFromGameMoveGORMSlice converts a slice of GameMoveGORM with GameMoveFromGameMoveGORM,
+// applying the optional decorator to each item. Nil items stay nil.
+func GameMoveSliceFromGameMoveGORMSlice(
+	src []*GameMoveGORM,
+	decorator func(*v1.GameMove, *GameMoveGORM) error,
+) ([]*v1.GameMove, error) {
+	return converters.MapSlice(src, func(item *GameMoveGORM) (*v1.GameMove, error) {
+		return GameMoveFromGameMoveGORM(nil, item, decorator)
+	})
+}
+
+// GameMoveMapToGameMoveGORMMap converts the values of a map with GameMoveToGameMoveGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveMapToGameMoveGORMMap[K comparable](
+	src map[K]*v1.GameMove,
+	decorator func(*v1.GameMove, *GameMoveGORM) error,
+) (map[K]*GameMoveGORM, error) {
+	return converters.MapValues(src, func(value *v1.GameMove) (*GameMoveGORM, error) {
+		return GameMoveToGameMoveGORM(value, nil, decorator)
+	})
+}
+
+// GameMoveMapFromGameMoveGORMMap converts the values of a map with GameMoveFromGameMoveGORM,
+// applying the optional decorator to each value. Nil values stay nil.
+func GameMoveMapFromGameMoveGORMMap[K comparable](
+	src map[K]*GameMoveGORM,
+	decorator func(*v1.GameMove, *GameMoveGORM) error,
+) (map[K]*v1.GameMove, error) {
+	return converters.MapValues(src, func(value *GameMoveGORM) (*v1.GameMove, error) {
+		return GameMoveFromGameMoveGORM(nil, value, decorator)
+	})
+}
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-dal-gorm. DO NOT EDIT.
+package gorm
+
+import (
+	"math/rand"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/panyam/protoc-gen-dal/pkg/roundtrip"
+	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
+)
+
+// TestIndexInfoToIndexInfoGORMRoundTrip checks that IndexInfoFromIndexInfoGORM restores what
+// IndexInfoToIndexInfoGORM stored, for random v1.IndexInfo messages.
+func TestIndexInfoToIndexInfoGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IndexInfo{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IndexInfoToIndexInfoGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoToIndexInfoGORM(%v): %v", src, err)
+		}
+		got, err := IndexInfoFromIndexInfoGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoFromIndexInfoGORM(%v): %v", target, err)
+		}
+
+		if want := expectedIndexInfoFromIndexInfoGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestIndexInfoToIndexInfoGORMClone checks that Clone copies the IndexInfoGORM
+// that IndexInfoToIndexInfoGORM makes without sharing memory with it.
+func TestIndexInfoToIndexInfoGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IndexInfo{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IndexInfoToIndexInfoGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IndexInfoToIndexInfoGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedIndexInfoFromIndexInfoGORM returns the v1.IndexInfo that IndexInfoFromIndexInfoGORM
+// should return for the IndexInfoGORM that IndexInfoToIndexInfoGORM makes from src.
+func expectedIndexInfoFromIndexInfoGORM(src *v1.IndexInfo) *v1.IndexInfo {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.IndexInfo)
+	return want
+}
+
+// TestTileToTileGORMRoundTrip checks that TileFromTileGORM restores what
+// TileToTileGORM stored, for random v1.Tile messages.
+func TestTileToTileGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Tile{}
+		roundtrip.Fill(src, rng)
+
+		target, err := TileToTileGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("TileToTileGORM(%v): %v", src, err)
+		}
+		got, err := TileFromTileGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("TileFromTileGORM(%v): %v", target, err)
+		}
+
+		if want := expectedTileFromTileGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestTileToTileGORMClone checks that Clone copies the TileGORM
+// that TileToTileGORM makes without sharing memory with it.
+func TestTileToTileGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Tile{}
+		roundtrip.Fill(src, rng)
+
+		target, err := TileToTileGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("TileToTileGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedTileFromTileGORM returns the v1.Tile that TileFromTileGORM
+// should return for the TileGORM that TileToTileGORM makes from src.
+func expectedTileFromTileGORM(src *v1.Tile) *v1.Tile {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Tile)
+	return want
+}
+
+// TestUnitToUnitGORMRoundTrip checks that UnitFromUnitGORM restores what
+// UnitToUnitGORM stored, for random v1.Unit messages.
+func TestUnitToUnitGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Unit{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UnitToUnitGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UnitToUnitGORM(%v): %v", src, err)
+		}
+		got, err := UnitFromUnitGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("UnitFromUnitGORM(%v): %v", target, err)
+		}
+
+		if want := expectedUnitFromUnitGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestUnitToUnitGORMClone checks that Clone copies the UnitGORM
+// that UnitToUnitGORM makes without sharing memory with it.
+func TestUnitToUnitGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Unit{}
+		roundtrip.Fill(src, rng)
+
+		target, err := UnitToUnitGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("UnitToUnitGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedUnitFromUnitGORM returns the v1.Unit that UnitFromUnitGORM
+// should return for the UnitGORM that UnitToUnitGORM makes from src.
+func expectedUnitFromUnitGORM(src *v1.Unit) *v1.Unit {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Unit)
+
+	for i, item := range want.AttackHistory {
+		want.AttackHistory[i] = expectedAttackRecordFromAttackRecordGORM(item)
+	}
+	return want
+}
+
+// TestAttackRecordToAttackRecordGORMRoundTrip checks that AttackRecordFromAttackRecordGORM restores what
+// AttackRecordToAttackRecordGORM stored, for random v1.AttackRecord messages.
+func TestAttackRecordToAttackRecordGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.AttackRecord{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AttackRecordToAttackRecordGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordToAttackRecordGORM(%v): %v", src, err)
+		}
+		got, err := AttackRecordFromAttackRecordGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordFromAttackRecordGORM(%v): %v", target, err)
+		}
+
+		if want := expectedAttackRecordFromAttackRecordGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestAttackRecordToAttackRecordGORMClone checks that Clone copies the AttackRecordGORM
+// that AttackRecordToAttackRecordGORM makes without sharing memory with it.
+func TestAttackRecordToAttackRecordGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.AttackRecord{}
+		roundtrip.Fill(src, rng)
+
+		target, err := AttackRecordToAttackRecordGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("AttackRecordToAttackRecordGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedAttackRecordFromAttackRecordGORM returns the v1.AttackRecord that AttackRecordFromAttackRecordGORM
+// should return for the AttackRecordGORM that AttackRecordToAttackRecordGORM makes from src.
+func expectedAttackRecordFromAttackRecordGORM(src *v1.AttackRecord) *v1.AttackRecord {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.AttackRecord)
+	return want
+}
+
+// TestWorldToWorldGORMRoundTrip checks that WorldFromWorldGORM restores what
+// WorldToWorldGORM stored, for random v1.World messages.
+func TestWorldToWorldGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.World{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldToWorldGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldToWorldGORM(%v): %v", src, err)
+		}
+		got, err := WorldFromWorldGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("WorldFromWorldGORM(%v): %v", target, err)
+		}
+
+		if want := expectedWorldFromWorldGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestWorldToWorldGORMClone checks that Clone copies the WorldGORM
+// that WorldToWorldGORM makes without sharing memory with it.
+func TestWorldToWorldGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.World{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldToWorldGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldToWorldGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedWorldFromWorldGORM returns the v1.World that WorldFromWorldGORM
+// should return for the WorldGORM that WorldToWorldGORM makes from src.
+func expectedWorldFromWorldGORM(src *v1.World) *v1.World {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.World)
+
+	want.WorldData = expectedWorldDataFromWorldDataGORM(want.WorldData)
+
+	want.DefaultGameConfig = expectedGameConfigurationFromGameConfigurationGORM(want.DefaultGameConfig)
+
+	want.ScreenshotIndexInfo = expectedIndexInfoFromIndexInfoGORM(want.ScreenshotIndexInfo)
+
+	want.SearchIndexInfo = expectedIndexInfoFromIndexInfoGORM(want.SearchIndexInfo)
+	return want
+}
+
+// TestWorldDataToWorldDataGORMRoundTrip checks that WorldDataFromWorldDataGORM restores what
+// WorldDataToWorldDataGORM stored, for random v1.WorldData messages.
+func TestWorldDataToWorldDataGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.WorldData{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldDataToWorldDataGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldDataToWorldDataGORM(%v): %v", src, err)
+		}
+		got, err := WorldDataFromWorldDataGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("WorldDataFromWorldDataGORM(%v): %v", target, err)
+		}
+
+		if want := expectedWorldDataFromWorldDataGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestWorldDataToWorldDataGORMClone checks that Clone copies the WorldDataGORM
+// that WorldDataToWorldDataGORM makes without sharing memory with it.
+func TestWorldDataToWorldDataGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.WorldData{}
+		roundtrip.Fill(src, rng)
+
+		target, err := WorldDataToWorldDataGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("WorldDataToWorldDataGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedWorldDataFromWorldDataGORM returns the v1.WorldData that WorldDataFromWorldDataGORM
+// should return for the WorldDataGORM that WorldDataToWorldDataGORM makes from src.
+func expectedWorldDataFromWorldDataGORM(src *v1.WorldData) *v1.WorldData {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.WorldData)
+
+	for i, item := range want.Tiles {
+		want.Tiles[i] = expectedTileFromTileGORM(item)
+	}
+
+	for i, item := range want.Units {
+		want.Units[i] = expectedUnitFromUnitGORM(item)
+	}
+	return want
+}
+
+// TestGameToGameGORMRoundTrip checks that GameFromGameGORM restores what
+// GameToGameGORM stored, for random v1.Game messages.
+func TestGameToGameGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Game{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameToGameGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameToGameGORM(%v): %v", src, err)
+		}
+		got, err := GameFromGameGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameFromGameGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameFromGameGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameToGameGORMClone checks that Clone copies the GameGORM
+// that GameToGameGORM makes without sharing memory with it.
+func TestGameToGameGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.Game{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameToGameGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameToGameGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameFromGameGORM returns the v1.Game that GameFromGameGORM
+// should return for the GameGORM that GameToGameGORM makes from src.
+func expectedGameFromGameGORM(src *v1.Game) *v1.Game {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.Game)
+
+	want.Config = expectedGameConfigurationFromGameConfigurationGORM(want.Config)
+
+	want.ScreenshotIndexInfo = expectedIndexInfoFromIndexInfoGORM(want.ScreenshotIndexInfo)
+
+	want.SearchIndexInfo = expectedIndexInfoFromIndexInfoGORM(want.SearchIndexInfo)
+	return want
+}
+
+// TestGameConfigurationToGameConfigurationGORMRoundTrip checks that GameConfigurationFromGameConfigurationGORM restores what
+// GameConfigurationToGameConfigurationGORM stored, for random v1.GameConfiguration messages.
+func TestGameConfigurationToGameConfigurationGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameConfiguration{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameConfigurationToGameConfigurationGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationToGameConfigurationGORM(%v): %v", src, err)
+		}
+		got, err := GameConfigurationFromGameConfigurationGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationFromGameConfigurationGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameConfigurationFromGameConfigurationGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameConfigurationToGameConfigurationGORMClone checks that Clone copies the GameConfigurationGORM
+// that GameConfigurationToGameConfigurationGORM makes without sharing memory with it.
+func TestGameConfigurationToGameConfigurationGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameConfiguration{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameConfigurationToGameConfigurationGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameConfigurationToGameConfigurationGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameConfigurationFromGameConfigurationGORM returns the v1.GameConfiguration that GameConfigurationFromGameConfigurationGORM
+// should return for the GameConfigurationGORM that GameConfigurationToGameConfigurationGORM makes from src.
+func expectedGameConfigurationFromGameConfigurationGORM(src *v1.GameConfiguration) *v1.GameConfiguration {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameConfiguration)
+
+	for i, item := range want.Players {
+		want.Players[i] = expectedGamePlayerFromGamePlayerGORM(item)
+	}
+
+	for i, item := range want.Teams {
+		want.Teams[i] = expectedGameTeamFromGameTeamGORM(item)
+	}
+
+	want.IncomeConfigs = expectedIncomeConfigFromIncomeConfigGORM(want.IncomeConfigs)
+
+	want.Settings = expectedGameSettingsFromGameSettingsGORM(want.Settings)
+	return want
+}
+
+// TestIncomeConfigToIncomeConfigGORMRoundTrip checks that IncomeConfigFromIncomeConfigGORM restores what
+// IncomeConfigToIncomeConfigGORM stored, for random v1.IncomeConfig messages.
+func TestIncomeConfigToIncomeConfigGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IncomeConfig{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IncomeConfigToIncomeConfigGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigToIncomeConfigGORM(%v): %v", src, err)
+		}
+		got, err := IncomeConfigFromIncomeConfigGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigFromIncomeConfigGORM(%v): %v", target, err)
+		}
+
+		if want := expectedIncomeConfigFromIncomeConfigGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestIncomeConfigToIncomeConfigGORMClone checks that Clone copies the IncomeConfigGORM
+// that IncomeConfigToIncomeConfigGORM makes without sharing memory with it.
+func TestIncomeConfigToIncomeConfigGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.IncomeConfig{}
+		roundtrip.Fill(src, rng)
+
+		target, err := IncomeConfigToIncomeConfigGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("IncomeConfigToIncomeConfigGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedIncomeConfigFromIncomeConfigGORM returns the v1.IncomeConfig that IncomeConfigFromIncomeConfigGORM
+// should return for the IncomeConfigGORM that IncomeConfigToIncomeConfigGORM makes from src.
+func expectedIncomeConfigFromIncomeConfigGORM(src *v1.IncomeConfig) *v1.IncomeConfig {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.IncomeConfig)
+	return want
+}
+
+// TestGamePlayerToGamePlayerGORMRoundTrip checks that GamePlayerFromGamePlayerGORM restores what
+// GamePlayerToGamePlayerGORM stored, for random v1.GamePlayer messages.
+func TestGamePlayerToGamePlayerGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GamePlayer{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GamePlayerToGamePlayerGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerToGamePlayerGORM(%v): %v", src, err)
+		}
+		got, err := GamePlayerFromGamePlayerGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerFromGamePlayerGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGamePlayerFromGamePlayerGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGamePlayerToGamePlayerGORMClone checks that Clone copies the GamePlayerGORM
+// that GamePlayerToGamePlayerGORM makes without sharing memory with it.
+func TestGamePlayerToGamePlayerGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GamePlayer{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GamePlayerToGamePlayerGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GamePlayerToGamePlayerGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGamePlayerFromGamePlayerGORM returns the v1.GamePlayer that GamePlayerFromGamePlayerGORM
+// should return for the GamePlayerGORM that GamePlayerToGamePlayerGORM makes from src.
+func expectedGamePlayerFromGamePlayerGORM(src *v1.GamePlayer) *v1.GamePlayer {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GamePlayer)
+	return want
+}
+
+// TestGameTeamToGameTeamGORMRoundTrip checks that GameTeamFromGameTeamGORM restores what
+// GameTeamToGameTeamGORM stored, for random v1.GameTeam messages.
+func TestGameTeamToGameTeamGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameTeam{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameTeamToGameTeamGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameTeamToGameTeamGORM(%v): %v", src, err)
+		}
+		got, err := GameTeamFromGameTeamGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameTeamFromGameTeamGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameTeamFromGameTeamGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameTeamToGameTeamGORMClone checks that Clone copies the GameTeamGORM
+// that GameTeamToGameTeamGORM makes without sharing memory with it.
+func TestGameTeamToGameTeamGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameTeam{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameTeamToGameTeamGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameTeamToGameTeamGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameTeamFromGameTeamGORM returns the v1.GameTeam that GameTeamFromGameTeamGORM
+// should return for the GameTeamGORM that GameTeamToGameTeamGORM makes from src.
+func expectedGameTeamFromGameTeamGORM(src *v1.GameTeam) *v1.GameTeam {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameTeam)
+	return want
+}
+
+// TestGameSettingsToGameSettingsGORMRoundTrip checks that GameSettingsFromGameSettingsGORM restores what
+// GameSettingsToGameSettingsGORM stored, for random v1.GameSettings messages.
+func TestGameSettingsToGameSettingsGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameSettings{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameSettingsToGameSettingsGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsToGameSettingsGORM(%v): %v", src, err)
+		}
+		got, err := GameSettingsFromGameSettingsGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsFromGameSettingsGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameSettingsFromGameSettingsGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameSettingsToGameSettingsGORMClone checks that Clone copies the GameSettingsGORM
+// that GameSettingsToGameSettingsGORM makes without sharing memory with it.
+func TestGameSettingsToGameSettingsGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameSettings{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameSettingsToGameSettingsGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameSettingsToGameSettingsGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameSettingsFromGameSettingsGORM returns the v1.GameSettings that GameSettingsFromGameSettingsGORM
+// should return for the GameSettingsGORM that GameSettingsToGameSettingsGORM makes from src.
+func expectedGameSettingsFromGameSettingsGORM(src *v1.GameSettings) *v1.GameSettings {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameSettings)
+	return want
+}
+
+// TestGameStateToGameStateGORMRoundTrip checks that GameStateFromGameStateGORM restores what
+// GameStateToGameStateGORM stored, for random v1.GameState messages.
+func TestGameStateToGameStateGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameState{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameStateToGameStateGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameStateToGameStateGORM(%v): %v", src, err)
+		}
+		got, err := GameStateFromGameStateGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameStateFromGameStateGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameStateFromGameStateGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameStateToGameStateGORMClone checks that Clone copies the GameStateGORM
+// that GameStateToGameStateGORM makes without sharing memory with it.
+func TestGameStateToGameStateGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameState{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameStateToGameStateGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameStateToGameStateGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameStateFromGameStateGORM returns the v1.GameState that GameStateFromGameStateGORM
+// should return for the GameStateGORM that GameStateToGameStateGORM makes from src.
+func expectedGameStateFromGameStateGORM(src *v1.GameState) *v1.GameState {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameState)
+
+	want.WorldData = expectedWorldDataFromWorldDataGORM(want.WorldData)
+	return want
+}
+
+// TestGameMoveHistoryToGameMoveHistoryGORMRoundTrip checks that GameMoveHistoryFromGameMoveHistoryGORM restores what
+// GameMoveHistoryToGameMoveHistoryGORM stored, for random v1.GameMoveHistory messages.
+func TestGameMoveHistoryToGameMoveHistoryGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveHistory{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveHistoryToGameMoveHistoryGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryToGameMoveHistoryGORM(%v): %v", src, err)
+		}
+		got, err := GameMoveHistoryFromGameMoveHistoryGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryFromGameMoveHistoryGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveHistoryFromGameMoveHistoryGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveHistoryToGameMoveHistoryGORMClone checks that Clone copies the GameMoveHistoryGORM
+// that GameMoveHistoryToGameMoveHistoryGORM makes without sharing memory with it.
+func TestGameMoveHistoryToGameMoveHistoryGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveHistory{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveHistoryToGameMoveHistoryGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveHistoryToGameMoveHistoryGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveHistoryFromGameMoveHistoryGORM returns the v1.GameMoveHistory that GameMoveHistoryFromGameMoveHistoryGORM
+// should return for the GameMoveHistoryGORM that GameMoveHistoryToGameMoveHistoryGORM makes from src.
+func expectedGameMoveHistoryFromGameMoveHistoryGORM(src *v1.GameMoveHistory) *v1.GameMoveHistory {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMoveHistory)
+
+	for i, item := range want.Groups {
+		want.Groups[i] = expectedGameMoveGroupFromGameMoveGroupGORM(item)
+	}
+	return want
+}
+
+// TestGameMoveGroupToGameMoveGroupGORMRoundTrip checks that GameMoveGroupFromGameMoveGroupGORM restores what
+// GameMoveGroupToGameMoveGroupGORM stored, for random v1.GameMoveGroup messages.
+func TestGameMoveGroupToGameMoveGroupGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveGroup{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveGroupToGameMoveGroupGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupToGameMoveGroupGORM(%v): %v", src, err)
+		}
+		got, err := GameMoveGroupFromGameMoveGroupGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupFromGameMoveGroupGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveGroupFromGameMoveGroupGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveGroupToGameMoveGroupGORMClone checks that Clone copies the GameMoveGroupGORM
+// that GameMoveGroupToGameMoveGroupGORM makes without sharing memory with it.
+func TestGameMoveGroupToGameMoveGroupGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMoveGroup{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveGroupToGameMoveGroupGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveGroupToGameMoveGroupGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveGroupFromGameMoveGroupGORM returns the v1.GameMoveGroup that GameMoveGroupFromGameMoveGroupGORM
+// should return for the GameMoveGroupGORM that GameMoveGroupToGameMoveGroupGORM makes from src.
+func expectedGameMoveGroupFromGameMoveGroupGORM(src *v1.GameMoveGroup) *v1.GameMoveGroup {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMoveGroup)
+
+	for i, item := range want.Moves {
+		want.Moves[i] = expectedGameMoveFromGameMoveGORM(item)
+	}
+	return want
+}
+
+// TestGameMoveToGameMoveGORMRoundTrip checks that GameMoveFromGameMoveGORM restores what
+// GameMoveToGameMoveGORM stored, for random v1.GameMove messages.
+func TestGameMoveToGameMoveGORMRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMove{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveToGameMoveGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveToGameMoveGORM(%v): %v", src, err)
+		}
+		got, err := GameMoveFromGameMoveGORM(nil, target, nil)
+		if err != nil {
+			t.Fatalf("GameMoveFromGameMoveGORM(%v): %v", target, err)
+		}
+
+		if want := expectedGameMoveFromGameMoveGORM(src); !proto.Equal(want, got) {
+			t.Fatalf("round trip mismatch\nsrc:  %v\nwant: %v\ngot:  %v", src, want, got)
+		}
+	}
+}
+
+// TestGameMoveToGameMoveGORMClone checks that Clone copies the GameMoveGORM
+// that GameMoveToGameMoveGORM makes without sharing memory with it.
+func TestGameMoveToGameMoveGORMClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < roundtrip.Iterations; i++ {
+		src := &v1.GameMove{}
+		roundtrip.Fill(src, rng)
+
+		target, err := GameMoveToGameMoveGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("GameMoveToGameMoveGORM(%v): %v", src, err)
+		}
+
+		clone := target.Clone()
+		if !clone.Equal(target) {
+			t.Fatalf("clone differs from the original\noriginal: %+v\nclone:    %+v", target, clone)
+		}
+		if shared := roundtrip.SharedMemory(target, clone); len(shared) > 0 {
+			t.Fatalf("clone shares memory with the original at %v", shared)
+		}
+	}
+}
+
+// expectedGameMoveFromGameMoveGORM returns the v1.GameMove that GameMoveFromGameMoveGORM
+// should return for the GameMoveGORM that GameMoveToGameMoveGORM makes from src.
+func expectedGameMoveFromGameMoveGORM(src *v1.GameMove) *v1.GameMove {
+	if src == nil {
+		return nil
+	}
+	want := proto.Clone(src).(*v1.GameMove)
+
+	// Not restored by GameMoveFromGameMoveGORM
+	roundtrip.ClearFields(want,
+		"move_unit",   // oneof_replaced
+		"attack_unit", // oneof_replaced
+		"end_turn",    // oneof_replaced
+		"build_unit",  // oneof_replaced
+	)
+	return want
+}
@@ -2,14 +2,20 @@
 package gorm
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"slices"
 	"time"
 
 	v1 "github.com/panyam/protoc-gen-dal/tests/gen/go/weewar/v1"
 )
 
+// GameID is the id_type of gorm.GameGORM.id.
+type GameID string
+
 // IndexInfoGORM is the GORM model for weewar.v1.IndexInfo
 type IndexInfoGORM struct {
 	LastUpdatedAt time.Time
@@ -17,6 +23,55 @@ type IndexInfoGORM struct {
 	NeedsIndexing bool
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *IndexInfoGORM) Equal(other *IndexInfoGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.LastUpdatedAt.Equal(other.LastUpdatedAt) &&
+		m.LastIndexedAt.Equal(other.LastIndexedAt) &&
+		m.NeedsIndexing == other.NeedsIndexing
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *IndexInfoGORM) ChangedColumns(other *IndexInfoGORM) []string {
+	if m == nil {
+		m = &IndexInfoGORM{}
+	}
+	if other == nil {
+		other = &IndexInfoGORM{}
+	}
+	var columns []string
+	if !m.LastUpdatedAt.Equal(other.LastUpdatedAt) {
+		columns = append(columns, "last_updated_at")
+	}
+	if !m.LastIndexedAt.Equal(other.LastIndexedAt) {
+		columns = append(columns, "last_indexed_at")
+	}
+	if m.NeedsIndexing != other.NeedsIndexing {
+		columns = append(columns, "needs_indexing")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *IndexInfoGORM) Clone() *IndexInfoGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a IndexInfoGORM with copies of their own.
+func (m *IndexInfoGORM) cloneFields() {
+}
+
 // TileGORM is the GORM model for weewar.v1.Tile
 type TileGORM struct {
 	Q                int32
@@ -28,6 +83,71 @@ type TileGORM struct {
 	LastToppedupTurn int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *TileGORM) Equal(other *TileGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.TileType == other.TileType &&
+		m.Player == other.Player &&
+		m.Shortcut == other.Shortcut &&
+		m.LastActedTurn == other.LastActedTurn &&
+		m.LastToppedupTurn == other.LastToppedupTurn
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *TileGORM) ChangedColumns(other *TileGORM) []string {
+	if m == nil {
+		m = &TileGORM{}
+	}
+	if other == nil {
+		other = &TileGORM{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.TileType != other.TileType {
+		columns = append(columns, "tile_type")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if m.Shortcut != other.Shortcut {
+		columns = append(columns, "shortcut")
+	}
+	if m.LastActedTurn != other.LastActedTurn {
+		columns = append(columns, "last_acted_turn")
+	}
+	if m.LastToppedupTurn != other.LastToppedupTurn {
+		columns = append(columns, "last_toppedup_turn")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *TileGORM) Clone() *TileGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a TileGORM with copies of their own.
+func (m *TileGORM) cloneFields() {
+}
+
 // Value implements driver.Valuer for TileGORM
 func (m TileGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -69,6 +189,99 @@ type UnitGORM struct {
 	ChosenAlternative       string
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *UnitGORM) Equal(other *UnitGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.Player == other.Player &&
+		m.UnitType == other.UnitType &&
+		m.Shortcut == other.Shortcut &&
+		m.AvailableHealth == other.AvailableHealth &&
+		m.DistanceLeft == other.DistanceLeft &&
+		m.LastActedTurn == other.LastActedTurn &&
+		m.LastToppedupTurn == other.LastToppedupTurn &&
+		m.AttacksReceivedThisTurn == other.AttacksReceivedThisTurn &&
+		reflect.DeepEqual(m.AttackHistory, other.AttackHistory) &&
+		m.ProgressionStep == other.ProgressionStep &&
+		m.ChosenAlternative == other.ChosenAlternative
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *UnitGORM) ChangedColumns(other *UnitGORM) []string {
+	if m == nil {
+		m = &UnitGORM{}
+	}
+	if other == nil {
+		other = &UnitGORM{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if m.UnitType != other.UnitType {
+		columns = append(columns, "unit_type")
+	}
+	if m.Shortcut != other.Shortcut {
+		columns = append(columns, "shortcut")
+	}
+	if m.AvailableHealth != other.AvailableHealth {
+		columns = append(columns, "available_health")
+	}
+	if m.DistanceLeft != other.DistanceLeft {
+		columns = append(columns, "distance_left")
+	}
+	if m.LastActedTurn != other.LastActedTurn {
+		columns = append(columns, "last_acted_turn")
+	}
+	if m.LastToppedupTurn != other.LastToppedupTurn {
+		columns = append(columns, "last_toppedup_turn")
+	}
+	if m.AttacksReceivedThisTurn != other.AttacksReceivedThisTurn {
+		columns = append(columns, "attacks_received_this_turn")
+	}
+	if !reflect.DeepEqual(m.AttackHistory, other.AttackHistory) {
+		columns = append(columns, "attack_history")
+	}
+	if m.ProgressionStep != other.ProgressionStep {
+		columns = append(columns, "progression_step")
+	}
+	if m.ChosenAlternative != other.ChosenAlternative {
+		columns = append(columns, "chosen_alternative")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *UnitGORM) Clone() *UnitGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a UnitGORM with copies of their own.
+func (m *UnitGORM) cloneFields() {
+	m.AttackHistory = slices.Clone(m.AttackHistory)
+	for i := range m.AttackHistory {
+		m.AttackHistory[i].cloneFields()
+	}
+}
+
 // Value implements driver.Valuer for UnitGORM
 func (m UnitGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -101,6 +314,59 @@ type AttackRecordGORM struct {
 	TurnNumber int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *AttackRecordGORM) Equal(other *AttackRecordGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Q == other.Q &&
+		m.R == other.R &&
+		m.IsRanged == other.IsRanged &&
+		m.TurnNumber == other.TurnNumber
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *AttackRecordGORM) ChangedColumns(other *AttackRecordGORM) []string {
+	if m == nil {
+		m = &AttackRecordGORM{}
+	}
+	if other == nil {
+		other = &AttackRecordGORM{}
+	}
+	var columns []string
+	if m.Q != other.Q {
+		columns = append(columns, "q")
+	}
+	if m.R != other.R {
+		columns = append(columns, "r")
+	}
+	if m.IsRanged != other.IsRanged {
+		columns = append(columns, "is_ranged")
+	}
+	if m.TurnNumber != other.TurnNumber {
+		columns = append(columns, "turn_number")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *AttackRecordGORM) Clone() *AttackRecordGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a AttackRecordGORM with copies of their own.
+func (m *AttackRecordGORM) cloneFields() {
+}
+
 // Value implements driver.Valuer for AttackRecordGORM
 func (m AttackRecordGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -143,6 +409,105 @@ type WorldGORM struct {
 	SearchIndexInfo     IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *WorldGORM) Equal(other *WorldGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Id == other.Id &&
+		m.CreatorId == other.CreatorId &&
+		m.Name == other.Name &&
+		m.Description == other.Description &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		m.ImageUrl == other.ImageUrl &&
+		m.Difficulty == other.Difficulty &&
+		m.WorldData.Equal(&other.WorldData) &&
+		reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) &&
+		m.DefaultGameConfig.Equal(&other.DefaultGameConfig) &&
+		m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) &&
+		m.SearchIndexInfo.Equal(&other.SearchIndexInfo)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *WorldGORM) ChangedColumns(other *WorldGORM) []string {
+	if m == nil {
+		m = &WorldGORM{}
+	}
+	if other == nil {
+		other = &WorldGORM{}
+	}
+	var columns []string
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.CreatorId != other.CreatorId {
+		columns = append(columns, "creator_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Description != other.Description {
+		columns = append(columns, "description")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if m.ImageUrl != other.ImageUrl {
+		columns = append(columns, "image_url")
+	}
+	if m.Difficulty != other.Difficulty {
+		columns = append(columns, "difficulty")
+	}
+	if !m.WorldData.Equal(&other.WorldData) {
+		columns = append(columns, "world_data")
+	}
+	if !reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) {
+		columns = append(columns, "preview_urls")
+	}
+	if !m.DefaultGameConfig.Equal(&other.DefaultGameConfig) {
+		columns = append(columns, "default_game_config")
+	}
+	for _, column := range m.ScreenshotIndexInfo.ChangedColumns(&other.ScreenshotIndexInfo) {
+		columns = append(columns, "screenshot_index_"+column)
+	}
+	for _, column := range m.SearchIndexInfo.ChangedColumns(&other.SearchIndexInfo) {
+		columns = append(columns, "search_index_"+column)
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *WorldGORM) Clone() *WorldGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a WorldGORM with copies of their own.
+func (m *WorldGORM) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+	m.WorldData.cloneFields()
+	m.PreviewUrls = slices.Clone(m.PreviewUrls)
+	m.DefaultGameConfig.cloneFields()
+	m.ScreenshotIndexInfo.cloneFields()
+	m.SearchIndexInfo.cloneFields()
+}
+
 // TableName returns the table name for WorldGORM
 func (*WorldGORM) TableName() string {
 	return "worlds"
@@ -155,6 +520,63 @@ type WorldDataGORM struct {
 	WorldId string     `gorm:"primaryKey"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *WorldDataGORM) Equal(other *WorldDataGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.Tiles, other.Tiles) &&
+		reflect.DeepEqual(m.Units, other.Units) &&
+		m.WorldId == other.WorldId
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *WorldDataGORM) ChangedColumns(other *WorldDataGORM) []string {
+	if m == nil {
+		m = &WorldDataGORM{}
+	}
+	if other == nil {
+		other = &WorldDataGORM{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.Tiles, other.Tiles) {
+		columns = append(columns, "tiles")
+	}
+	if !reflect.DeepEqual(m.Units, other.Units) {
+		columns = append(columns, "units")
+	}
+	if m.WorldId != other.WorldId {
+		columns = append(columns, "world_id")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *WorldDataGORM) Clone() *WorldDataGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a WorldDataGORM with copies of their own.
+func (m *WorldDataGORM) cloneFields() {
+	m.Tiles = slices.Clone(m.Tiles)
+	for i := range m.Tiles {
+		m.Tiles[i].cloneFields()
+	}
+	m.Units = slices.Clone(m.Units)
+	for i := range m.Units {
+		m.Units[i].cloneFields()
+	}
+}
+
 // TableName returns the table name for WorldDataGORM
 func (*WorldDataGORM) TableName() string {
 	return "world_data"
@@ -188,7 +610,7 @@ func (m *WorldDataGORM) Scan(value interface{}) error {
 type GameGORM struct {
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
-	Id                  string `gorm:"primaryKey"`
+	Id                  GameID `gorm:"primaryKey"`
 	CreatorId           string
 	WorldId             string
 	Name                string
@@ -202,6 +624,104 @@ type GameGORM struct {
 	SearchIndexInfo     IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameGORM) Equal(other *GameGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.CreatedAt.Equal(other.CreatedAt) &&
+		m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.Id == other.Id &&
+		m.CreatorId == other.CreatorId &&
+		m.WorldId == other.WorldId &&
+		m.Name == other.Name &&
+		m.Description == other.Description &&
+		reflect.DeepEqual(m.Tags, other.Tags) &&
+		m.ImageUrl == other.ImageUrl &&
+		m.Difficulty == other.Difficulty &&
+		m.Config.Equal(&other.Config) &&
+		reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) &&
+		m.ScreenshotIndexInfo.Equal(&other.ScreenshotIndexInfo) &&
+		m.SearchIndexInfo.Equal(&other.SearchIndexInfo)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameGORM) ChangedColumns(other *GameGORM) []string {
+	if m == nil {
+		m = &GameGORM{}
+	}
+	if other == nil {
+		other = &GameGORM{}
+	}
+	var columns []string
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		columns = append(columns, "created_at")
+	}
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.Id != other.Id {
+		columns = append(columns, "id")
+	}
+	if m.CreatorId != other.CreatorId {
+		columns = append(columns, "creator_id")
+	}
+	if m.WorldId != other.WorldId {
+		columns = append(columns, "world_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Description != other.Description {
+		columns = append(columns, "description")
+	}
+	if !reflect.DeepEqual(m.Tags, other.Tags) {
+		columns = append(columns, "tags")
+	}
+	if m.ImageUrl != other.ImageUrl {
+		columns = append(columns, "image_url")
+	}
+	if m.Difficulty != other.Difficulty {
+		columns = append(columns, "difficulty")
+	}
+	if !m.Config.Equal(&other.Config) {
+		columns = append(columns, "config")
+	}
+	if !reflect.DeepEqual(m.PreviewUrls, other.PreviewUrls) {
+		columns = append(columns, "preview_urls")
+	}
+	for _, column := range m.ScreenshotIndexInfo.ChangedColumns(&other.ScreenshotIndexInfo) {
+		columns = append(columns, "screenshot_index_"+column)
+	}
+	for _, column := range m.SearchIndexInfo.ChangedColumns(&other.SearchIndexInfo) {
+		columns = append(columns, "search_index_"+column)
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameGORM) Clone() *GameGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameGORM with copies of their own.
+func (m *GameGORM) cloneFields() {
+	m.Tags = slices.Clone(m.Tags)
+	m.Config.cloneFields()
+	m.PreviewUrls = slices.Clone(m.PreviewUrls)
+	m.ScreenshotIndexInfo.cloneFields()
+	m.SearchIndexInfo.cloneFields()
+}
+
 // TableName returns the table name for GameGORM
 func (*GameGORM) TableName() string {
 	return "games"
@@ -215,6 +735,67 @@ type GameConfigurationGORM struct {
 	Settings      GameSettingsGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameConfigurationGORM) Equal(other *GameConfigurationGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.Players, other.Players) &&
+		reflect.DeepEqual(m.Teams, other.Teams) &&
+		m.IncomeConfigs.Equal(&other.IncomeConfigs) &&
+		m.Settings.Equal(&other.Settings)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameConfigurationGORM) ChangedColumns(other *GameConfigurationGORM) []string {
+	if m == nil {
+		m = &GameConfigurationGORM{}
+	}
+	if other == nil {
+		other = &GameConfigurationGORM{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.Players, other.Players) {
+		columns = append(columns, "players")
+	}
+	if !reflect.DeepEqual(m.Teams, other.Teams) {
+		columns = append(columns, "teams")
+	}
+	columns = append(columns, m.IncomeConfigs.ChangedColumns(&other.IncomeConfigs)...)
+	if !m.Settings.Equal(&other.Settings) {
+		columns = append(columns, "settings")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameConfigurationGORM) Clone() *GameConfigurationGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameConfigurationGORM with copies of their own.
+func (m *GameConfigurationGORM) cloneFields() {
+	m.Players = slices.Clone(m.Players)
+	for i := range m.Players {
+		m.Players[i].cloneFields()
+	}
+	m.Teams = slices.Clone(m.Teams)
+	for i := range m.Teams {
+		m.Teams[i].cloneFields()
+	}
+	m.IncomeConfigs.cloneFields()
+	m.Settings.cloneFields()
+}
+
 // Value implements driver.Valuer for GameConfigurationGORM
 func (m GameConfigurationGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -250,6 +831,71 @@ type IncomeConfigGORM struct {
 	MinesIncome       int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *IncomeConfigGORM) Equal(other *IncomeConfigGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.StartingCoins == other.StartingCoins &&
+		m.GameIncome == other.GameIncome &&
+		m.LandbaseIncome == other.LandbaseIncome &&
+		m.NavalbaseIncome == other.NavalbaseIncome &&
+		m.AirportbaseIncome == other.AirportbaseIncome &&
+		m.MissilesiloIncome == other.MissilesiloIncome &&
+		m.MinesIncome == other.MinesIncome
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *IncomeConfigGORM) ChangedColumns(other *IncomeConfigGORM) []string {
+	if m == nil {
+		m = &IncomeConfigGORM{}
+	}
+	if other == nil {
+		other = &IncomeConfigGORM{}
+	}
+	var columns []string
+	if m.StartingCoins != other.StartingCoins {
+		columns = append(columns, "starting_coins")
+	}
+	if m.GameIncome != other.GameIncome {
+		columns = append(columns, "game_income")
+	}
+	if m.LandbaseIncome != other.LandbaseIncome {
+		columns = append(columns, "landbase_income")
+	}
+	if m.NavalbaseIncome != other.NavalbaseIncome {
+		columns = append(columns, "navalbase_income")
+	}
+	if m.AirportbaseIncome != other.AirportbaseIncome {
+		columns = append(columns, "airportbase_income")
+	}
+	if m.MissilesiloIncome != other.MissilesiloIncome {
+		columns = append(columns, "missilesilo_income")
+	}
+	if m.MinesIncome != other.MinesIncome {
+		columns = append(columns, "mines_income")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *IncomeConfigGORM) Clone() *IncomeConfigGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a IncomeConfigGORM with copies of their own.
+func (m *IncomeConfigGORM) cloneFields() {
+}
+
 // GamePlayerGORM is the GORM model for weewar.v1.GamePlayer
 type GamePlayerGORM struct {
 	PlayerId      int32
@@ -262,6 +908,75 @@ type GamePlayerGORM struct {
 	Coins         int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GamePlayerGORM) Equal(other *GamePlayerGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.PlayerId == other.PlayerId &&
+		m.PlayerType == other.PlayerType &&
+		m.Color == other.Color &&
+		m.TeamId == other.TeamId &&
+		m.Name == other.Name &&
+		m.IsActive == other.IsActive &&
+		m.StartingCoins == other.StartingCoins &&
+		m.Coins == other.Coins
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GamePlayerGORM) ChangedColumns(other *GamePlayerGORM) []string {
+	if m == nil {
+		m = &GamePlayerGORM{}
+	}
+	if other == nil {
+		other = &GamePlayerGORM{}
+	}
+	var columns []string
+	if m.PlayerId != other.PlayerId {
+		columns = append(columns, "player_id")
+	}
+	if m.PlayerType != other.PlayerType {
+		columns = append(columns, "player_type")
+	}
+	if m.Color != other.Color {
+		columns = append(columns, "color")
+	}
+	if m.TeamId != other.TeamId {
+		columns = append(columns, "team_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.IsActive != other.IsActive {
+		columns = append(columns, "is_active")
+	}
+	if m.StartingCoins != other.StartingCoins {
+		columns = append(columns, "starting_coins")
+	}
+	if m.Coins != other.Coins {
+		columns = append(columns, "coins")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GamePlayerGORM) Clone() *GamePlayerGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GamePlayerGORM with copies of their own.
+func (m *GamePlayerGORM) cloneFields() {
+}
+
 // Value implements driver.Valuer for GamePlayerGORM
 func (m GamePlayerGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -294,6 +1009,59 @@ type GameTeamGORM struct {
 	IsActive bool
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameTeamGORM) Equal(other *GameTeamGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.TeamId == other.TeamId &&
+		m.Name == other.Name &&
+		m.Color == other.Color &&
+		m.IsActive == other.IsActive
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameTeamGORM) ChangedColumns(other *GameTeamGORM) []string {
+	if m == nil {
+		m = &GameTeamGORM{}
+	}
+	if other == nil {
+		other = &GameTeamGORM{}
+	}
+	var columns []string
+	if m.TeamId != other.TeamId {
+		columns = append(columns, "team_id")
+	}
+	if m.Name != other.Name {
+		columns = append(columns, "name")
+	}
+	if m.Color != other.Color {
+		columns = append(columns, "color")
+	}
+	if m.IsActive != other.IsActive {
+		columns = append(columns, "is_active")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameTeamGORM) Clone() *GameTeamGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameTeamGORM with copies of their own.
+func (m *GameTeamGORM) cloneFields() {
+}
+
 // Value implements driver.Valuer for GameTeamGORM
 func (m GameTeamGORM) Value() (driver.Value, error) {
 	return json.Marshal(m)
@@ -326,10 +1094,64 @@ type GameSettingsGORM struct {
 	MaxTurns      int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameSettingsGORM) Equal(other *GameSettingsGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(m.AllowedUnits, other.AllowedUnits) &&
+		m.TurnTimeLimit == other.TurnTimeLimit &&
+		m.TeamMode == other.TeamMode &&
+		m.MaxTurns == other.MaxTurns
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameSettingsGORM) ChangedColumns(other *GameSettingsGORM) []string {
+	if m == nil {
+		m = &GameSettingsGORM{}
+	}
+	if other == nil {
+		other = &GameSettingsGORM{}
+	}
+	var columns []string
+	if !reflect.DeepEqual(m.AllowedUnits, other.AllowedUnits) {
+		columns = append(columns, "allowed_units")
+	}
+	if m.TurnTimeLimit != other.TurnTimeLimit {
+		columns = append(columns, "turn_time_limit")
+	}
+	if m.TeamMode != other.TeamMode {
+		columns = append(columns, "team_mode")
+	}
+	if m.MaxTurns != other.MaxTurns {
+		columns = append(columns, "max_turns")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameSettingsGORM) Clone() *GameSettingsGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameSettingsGORM with copies of their own.
+func (m *GameSettingsGORM) cloneFields() {
+	m.AllowedUnits = slices.Clone(m.AllowedUnits)
+}
+
 // GameStateGORM is the GORM model for weewar.v1.GameState
 type GameStateGORM struct {
 	UpdatedAt     time.Time
-	GameId        string `gorm:"primaryKey"`
+	GameId        GameID `gorm:"primaryKey"`
 	TurnCounter   int32
 	CurrentPlayer int32
 	WorldData     WorldDataGORM
@@ -341,12 +1163,143 @@ type GameStateGORM struct {
 	WinningTeam   int32
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameStateGORM) Equal(other *GameStateGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.UpdatedAt.Equal(other.UpdatedAt) &&
+		m.GameId == other.GameId &&
+		m.TurnCounter == other.TurnCounter &&
+		m.CurrentPlayer == other.CurrentPlayer &&
+		m.WorldData.Equal(&other.WorldData) &&
+		m.StateHash == other.StateHash &&
+		m.Version == other.Version &&
+		m.Status == other.Status &&
+		m.Finished == other.Finished &&
+		m.WinningPlayer == other.WinningPlayer &&
+		m.WinningTeam == other.WinningTeam
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameStateGORM) ChangedColumns(other *GameStateGORM) []string {
+	if m == nil {
+		m = &GameStateGORM{}
+	}
+	if other == nil {
+		other = &GameStateGORM{}
+	}
+	var columns []string
+	if !m.UpdatedAt.Equal(other.UpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
+	if m.GameId != other.GameId {
+		columns = append(columns, "game_id")
+	}
+	if m.TurnCounter != other.TurnCounter {
+		columns = append(columns, "turn_counter")
+	}
+	if m.CurrentPlayer != other.CurrentPlayer {
+		columns = append(columns, "current_player")
+	}
+	if !m.WorldData.Equal(&other.WorldData) {
+		columns = append(columns, "world_data")
+	}
+	if m.StateHash != other.StateHash {
+		columns = append(columns, "state_hash")
+	}
+	if m.Version != other.Version {
+		columns = append(columns, "version")
+	}
+	if m.Status != other.Status {
+		columns = append(columns, "status")
+	}
+	if m.Finished != other.Finished {
+		columns = append(columns, "finished")
+	}
+	if m.WinningPlayer != other.WinningPlayer {
+		columns = append(columns, "winning_player")
+	}
+	if m.WinningTeam != other.WinningTeam {
+		columns = append(columns, "winning_team")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameStateGORM) Clone() *GameStateGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameStateGORM with copies of their own.
+func (m *GameStateGORM) cloneFields() {
+	m.WorldData.cloneFields()
+}
+
 // GameMoveHistoryGORM is the GORM model for weewar.v1.GameMoveHistory
 type GameMoveHistoryGORM struct {
 	GameId string
 	Groups []GameMoveGroupGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameMoveHistoryGORM) Equal(other *GameMoveHistoryGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.GameId == other.GameId &&
+		reflect.DeepEqual(m.Groups, other.Groups)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameMoveHistoryGORM) ChangedColumns(other *GameMoveHistoryGORM) []string {
+	if m == nil {
+		m = &GameMoveHistoryGORM{}
+	}
+	if other == nil {
+		other = &GameMoveHistoryGORM{}
+	}
+	var columns []string
+	if m.GameId != other.GameId {
+		columns = append(columns, "game_id")
+	}
+	if !reflect.DeepEqual(m.Groups, other.Groups) {
+		columns = append(columns, "groups")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameMoveHistoryGORM) Clone() *GameMoveHistoryGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameMoveHistoryGORM with copies of their own.
+func (m *GameMoveHistoryGORM) cloneFields() {
+	m.Groups = slices.Clone(m.Groups)
+	for i := range m.Groups {
+		m.Groups[i].cloneFields()
+	}
+}
+
 // GameMoveGroupGORM is the GORM model for weewar.v1.GameMoveGroup
 type GameMoveGroupGORM struct {
 	StartedAt time.Time
@@ -354,9 +1307,62 @@ type GameMoveGroupGORM struct {
 	Moves     []GameMoveGORM
 }
 
+// Equal reports whether m and other have the same field values.
+func (m *GameMoveGroupGORM) Equal(other *GameMoveGroupGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.StartedAt.Equal(other.StartedAt) &&
+		m.EndedAt.Equal(other.EndedAt) &&
+		reflect.DeepEqual(m.Moves, other.Moves)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameMoveGroupGORM) ChangedColumns(other *GameMoveGroupGORM) []string {
+	if m == nil {
+		m = &GameMoveGroupGORM{}
+	}
+	if other == nil {
+		other = &GameMoveGroupGORM{}
+	}
+	var columns []string
+	if !m.StartedAt.Equal(other.StartedAt) {
+		columns = append(columns, "started_at")
+	}
+	if !m.EndedAt.Equal(other.EndedAt) {
+		columns = append(columns, "ended_at")
+	}
+	if !reflect.DeepEqual(m.Moves, other.Moves) {
+		columns = append(columns, "moves")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameMoveGroupGORM) Clone() *GameMoveGroupGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameMoveGroupGORM with copies of their own.
+func (m *GameMoveGroupGORM) cloneFields() {
+	m.Moves = slices.Clone(m.Moves)
+	for i := range m.Moves {
+		m.Moves[i].cloneFields()
+	}
+}
+
 // GameMoveGORM is the GORM model for weewar.v1.GameMove
 type GameMoveGORM struct {
-	GameId      string `gorm:"primaryKey"`
+	GameId      GameID `gorm:"primaryKey"`
 	Player      int32
 	GroupNumber string `gorm:"primaryKey"`
 	Timestamp   time.Time
@@ -366,3 +1372,86 @@ type GameMoveGORM struct {
 	IsPermanent bool
 	Changes     [][]byte `gorm:"serializer:json"`
 }
+
+// Equal reports whether m and other have the same field values.
+func (m *GameMoveGORM) Equal(other *GameMoveGORM) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.GameId == other.GameId &&
+		m.Player == other.Player &&
+		m.GroupNumber == other.GroupNumber &&
+		m.Timestamp.Equal(other.Timestamp) &&
+		m.MoveNumber == other.MoveNumber &&
+		bytes.Equal(m.MoveType, other.MoveType) &&
+		m.SequenceNum == other.SequenceNum &&
+		m.IsPermanent == other.IsPermanent &&
+		reflect.DeepEqual(m.Changes, other.Changes)
+}
+
+// ChangedColumns returns the columns of m whose values differ from other, in
+// field order. Columns of embedded structs are included with their prefix.
+// A nil m or other is compared as the zero value.
+func (m *GameMoveGORM) ChangedColumns(other *GameMoveGORM) []string {
+	if m == nil {
+		m = &GameMoveGORM{}
+	}
+	if other == nil {
+		other = &GameMoveGORM{}
+	}
+	var columns []string
+	if m.GameId != other.GameId {
+		columns = append(columns, "game_id")
+	}
+	if m.Player != other.Player {
+		columns = append(columns, "player")
+	}
+	if m.GroupNumber != other.GroupNumber {
+		columns = append(columns, "group_number")
+	}
+	if !m.Timestamp.Equal(other.Timestamp) {
+		columns = append(columns, "timestamp")
+	}
+	if m.MoveNumber != other.MoveNumber {
+		columns = append(columns, "move_number")
+	}
+	if !bytes.Equal(m.MoveType, other.MoveType) {
+		columns = append(columns, "move_type")
+	}
+	if m.SequenceNum != other.SequenceNum {
+		columns = append(columns, "sequence_num")
+	}
+	if m.IsPermanent != other.IsPermanent {
+		columns = append(columns, "is_permanent")
+	}
+	if !reflect.DeepEqual(m.Changes, other.Changes) {
+		columns = append(columns, "changes")
+	}
+	return columns
+}
+
+// Clone returns a deep copy of m that shares no slices, maps or nested
+// structs with it, or nil if m is nil.
+func (m *GameMoveGORM) Clone() *GameMoveGORM {
+	if m == nil {
+		return nil
+	}
+	out := *m
+	out.cloneFields()
+	return &out
+}
+
+// cloneFields replaces the slices, maps and nested structs of a shallow copy
+// of a GameMoveGORM with copies of their own.
+func (m *GameMoveGORM) cloneFields() {
+	m.MoveType = bytes.Clone(m.MoveType)
+	m.Changes = slices.Clone(m.Changes)
+	for i := range m.Changes {
+		m.Changes[i] = bytes.Clone(m.Changes[i])
+	}
+}
+
+// TableName returns the table name for GameMoveGORM
+func (*GameMoveGORM) TableName() string {
+	return "game_moves"
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Sri Panyam
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	dsgen "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
+	"github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+)
+
+// TestNoteLoadKey tests that the generated KeyLoader fills the numeric id from
+// the key, which is not stored as a property, in the order the Datastore
+// client loads entities (LoadKey, then Load).
+func TestNoteLoadKey(t *testing.T) {
+	note := &dsgen.NoteDatastore{Id: 42, Text: "hello"}
+	props, err := note.Save()
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	for _, prop := range props {
+		if prop.Name == "id" {
+			t.Errorf("Expected no id property, got %v", prop)
+		}
+	}
+
+	var loaded dsgen.NoteDatastore
+	key := datastore.IDKey("Note", 42, nil)
+	if err := loaded.LoadKey(key); err != nil {
+		t.Fatalf("LoadKey failed: %v", err)
+	}
+	if err := loaded.Load(props); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Key != key || loaded.Id != 42 || loaded.Text != "hello" {
+		t.Errorf("Loaded %+v, want Key %v, Id 42 and Text hello", loaded, key)
+	}
+}
+
+// TestTestRecord4LoadKey tests that the generated PropertyLoadSaver keeps the
+// key fields set by LoadKey.
+func TestTestRecord4LoadKey(t *testing.T) {
+	deadline := time.Date(2024, 6, 15, 10, 30, 0, 0, time.UTC)
+	record := &dsgen.TestRecord4Datastore{Id: "r1", Deadlines: map[string]time.Time{"draft": deadline}}
+	props, err := record.Save()
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	var loaded dsgen.TestRecord4Datastore
+	key := datastore.NameKey("test_records4", "r2", nil)
+	if err := loaded.LoadKey(key); err != nil {
+		t.Fatalf("LoadKey failed: %v", err)
+	}
+	if err := loaded.Load(props); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Key != key || loaded.Id != "r2" {
+		t.Errorf("Loaded Key %v and Id %q, want %v and r2", loaded.Key, loaded.Id, key)
+	}
+	if got := loaded.Deadlines["draft"]; !got.Equal(deadline) {
+		t.Errorf("Deadlines[draft] = %v, want %v", got, deadline)
+	}
+}
+
+// TestNoteConversion_EncodedKey tests that the converters expose the entity's
+// key as the web-safe encoded api.Note key.
+func TestNoteConversion_EncodedKey(t *testing.T) {
+	key := datastore.IDKey("Note", 42, nil)
+	key.Namespace = "notes"
+
+	note, err := dsgen.NoteToNoteDatastore(&api.Note{Text: "hello", Key: key.Encode()}, nil, nil)
+	if err != nil {
+		t.Fatalf("NoteToNoteDatastore failed: %v", err)
+	}
+	if !note.Key.Equal(key) {
+		t.Errorf("Key = %v, want %v", note.Key, key)
+	}
+
+	got, err := dsgen.NoteFromNoteDatastore(nil, note, nil)
+	if err != nil {
+		t.Fatalf("NoteFromNoteDatastore failed: %v", err)
+	}
+	if got.Key != key.Encode() {
+		t.Errorf("Key = %q, want %q", got.Key, key.Encode())
+	}
+
+	// Notes without a key have no encoded key
+	note, err = dsgen.NoteToNoteDatastore(&api.Note{Text: "new"}, nil, nil)
+	if err != nil {
+		t.Fatalf("NoteToNoteDatastore failed: %v", err)
+	}
+	if note.Key != nil {
+		t.Errorf("Key = %v, want nil", note.Key)
+	}
+	if got, _ := dsgen.NoteFromNoteDatastore(nil, note, nil); got.Key != "" {
+		t.Errorf("Key = %q, want empty", got.Key)
+	}
+
+	if _, err := dsgen.NoteToNoteDatastore(&api.Note{Key: "not a key"}, nil, nil); err == nil {
+		t.Error("Expected an error for an invalid encoded key")
+	}
+}
@@ -17,6 +17,7 @@ package datastore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/datastore"
 	dsgen "github.com/panyam/protoc-gen-dal/tests/gen/datastore/datastore"
@@ -181,3 +182,35 @@ func TestMapStringInt64_NilMap(t *testing.T) {
 		t.Errorf("Expected nil/empty CountsByType, got %v", retrieved.CountsByType)
 	}
 }
+
+// TestMapStringTimestamp_SaveLoad tests that a map of well-known type values
+// (map[string]time.Time from map<string, Timestamp>) survives the generated
+// PropertyLoadSaver, without a Datastore connection.
+func TestMapStringTimestamp_SaveLoad(t *testing.T) {
+	deadline := time.Date(2024, 6, 15, 10, 30, 0, 0, time.UTC)
+	record := &dsgen.TestRecord4Datastore{
+		Id:        "r1",
+		SeenAt:    []time.Time{deadline},
+		MemberIds: []string{"7", "42"},
+		Deadlines: map[string]time.Time{"draft": deadline},
+	}
+
+	props, err := record.Save()
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	var loaded dsgen.TestRecord4Datastore
+	if err := loaded.Load(props); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := loaded.Deadlines["draft"]; !got.Equal(deadline) {
+		t.Errorf("Deadlines[draft] = %v, want %v", got, deadline)
+	}
+	if len(loaded.SeenAt) != 1 || !loaded.SeenAt[0].Equal(deadline) {
+		t.Errorf("SeenAt = %v, want [%v]", loaded.SeenAt, deadline)
+	}
+	if len(loaded.MemberIds) != 2 || loaded.MemberIds[1] != "42" {
+		t.Errorf("MemberIds = %v, want [7 42]", loaded.MemberIds)
+	}
+}
@@ -0,0 +1,147 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/panyam/protoc-gen-dal/tests/gen/go/api"
+	"github.com/panyam/protoc-gen-dal/tests/gen/go/service"
+	gormgen "github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gorm.io/gorm"
+)
+
+// The generated server implements the gRPC interface
+var _ service.NoteServiceServer = (*service.NoteServiceDALServer)(nil)
+
+func TestServiceCRUD(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.NoteGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+	ctx := context.Background()
+	srv := &service.NoteServiceDALServer{DB: db}
+
+	created, err := srv.CreateNote(ctx, &service.CreateNoteRequest{Note: &api.Note{Text: "first"}})
+	if err != nil {
+		t.Fatalf("CreateNote failed: %v", err)
+	}
+	if created.Id == 0 || created.Text != "first" || created.CreatedAt == nil {
+		t.Errorf("Expected the stored note with its id and audit fields, got %v", created)
+	}
+
+	got, err := srv.GetNote(ctx, &service.GetNoteRequest{Id: created.Id})
+	if err != nil || got.Text != "first" {
+		t.Fatalf("GetNote = %v, %v", got, err)
+	}
+
+	// Masked updates only change the named fields
+	updated, err := srv.UpdateNote(ctx, &service.UpdateNoteRequest{
+		Note:       &api.Note{Id: created.Id, Text: "second", CreatedBy: "ignored"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"text"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateNote failed: %v", err)
+	}
+	if updated.Text != "second" || updated.CreatedBy != "" {
+		t.Errorf("Expected only text to change, got %v", updated)
+	}
+	_, err = srv.UpdateNote(ctx, &service.UpdateNoteRequest{
+		Note:       &api.Note{Id: created.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"nope"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an unknown mask path, got %v", err)
+	}
+
+	if _, err := srv.DeleteNote(ctx, &service.DeleteNoteRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeleteNote failed: %v", err)
+	}
+
+	// Missing records are NotFound
+	for name, err := range map[string]error{
+		"GetNote":    second(srv.GetNote(ctx, &service.GetNoteRequest{Id: created.Id})),
+		"UpdateNote": second(srv.UpdateNote(ctx, &service.UpdateNoteRequest{Note: &api.Note{Id: created.Id}})),
+		"DeleteNote": second(srv.DeleteNote(ctx, &service.DeleteNoteRequest{Id: created.Id})),
+	} {
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected %s to return NotFound, got %v", name, err)
+		}
+	}
+
+	if _, err := srv.CreateNote(ctx, &service.CreateNoteRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without a note, got %v", err)
+	}
+	if _, err := srv.ArchiveNote(ctx, &service.GetNoteRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected non-standard methods to be Unimplemented, got %v", err)
+	}
+}
+
+func TestServiceList(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.NoteGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+	ctx := context.Background()
+	srv := &service.NoteServiceDALServer{
+		DB: db,
+		ListNotesQuery: func(ctx context.Context, req *service.ListNotesRequest, db *gorm.DB) *gorm.DB {
+			return db.Where("text <> ?", "skip")
+		},
+	}
+	for _, text := range []string{"a", "b", "skip", "c", "d", "e"} {
+		if _, err := srv.CreateNote(ctx, &service.CreateNoteRequest{Note: &api.Note{Text: text}}); err != nil {
+			t.Fatalf("CreateNote failed: %v", err)
+		}
+	}
+
+	// Pages follow next_page_token until it is empty
+	var pages [][]string
+	req := &service.ListNotesRequest{PageSize: 2}
+	for {
+		resp, err := srv.ListNotes(ctx, req)
+		if err != nil {
+			t.Fatalf("ListNotes failed: %v", err)
+		}
+		var texts []string
+		for _, note := range resp.Notes {
+			texts = append(texts, note.Text)
+		}
+		pages = append(pages, texts)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(pages) != 3 || len(pages[2]) != 1 || pages[0][0] != "a" || pages[2][0] != "e" {
+		t.Errorf("Expected pages [a b] [c d] [e], got %v", pages)
+	}
+
+	if _, err := srv.ListNotes(ctx, &service.ListNotesRequest{PageToken: "bogus!"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a bad page token, got %v", err)
+	}
+
+	// Filters apply after the query hook and invalid ones are InvalidArgument
+	resp, err := srv.ListNotes(ctx, &service.ListNotesRequest{Filter: `text = "a" OR text = "e" OR text = "skip"`})
+	if err != nil || len(resp.Notes) != 2 || resp.Notes[1].Text != "e" {
+		t.Errorf("Expected notes a and e, got %v, %v", resp, err)
+	}
+	if _, err := srv.ListNotes(ctx, &service.ListNotesRequest{Filter: "title = x"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a bad filter, got %v", err)
+	}
+
+	// Hooks can reject requests with a status
+	srv.WillListNotes = func(ctx context.Context, req *service.ListNotesRequest) error {
+		return status.Error(codes.PermissionDenied, "no")
+	}
+	if _, err := srv.ListNotes(ctx, &service.ListNotesRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected the hook's status, got %v", err)
+	}
+}
+
+// second returns the error of a (result, error) pair
+func second[T any](_ T, err error) error {
+	return err
+}
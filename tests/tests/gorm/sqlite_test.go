@@ -9,9 +9,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/panyam/protoc-gen-dal/pkg/audit"
+	"github.com/panyam/protoc-gen-dal/pkg/cache"
+	"github.com/panyam/protoc-gen-dal/pkg/filtering"
+	"github.com/panyam/protoc-gen-dal/pkg/idgen"
+	"github.com/panyam/protoc-gen-dal/pkg/tenant"
 	"github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	gormgen "github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	"github.com/panyam/protoc-gen-dal/tests/gen/gorm/dal/gorm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -149,8 +156,14 @@ func TestAutoMigrate(t *testing.T) {
 		&gormgen.UserWithIndexes{},
 		&gormgen.UserWithDefaults{},
 		&gormgen.BlogGORM{},
+		&gormgen.BlogFlatGORM{},
+		&gormgen.BlogBlobGORM{},
 		&gormgen.ProductGORM{},
 		&gormgen.LibraryGORM{},
+		&gormgen.LibraryChildGORM{},
+		&gormgen.LibraryChildGORMContributorsChild{},
+		&gormgen.TenantUserGORM{},
+		&gormgen.NoteGORM{},
 		&gormgen.OrganizationGORM{},
 		&gormgen.WorldGORM{},
 		&gormgen.WorldDataGORM{},
@@ -164,6 +177,77 @@ func TestAutoMigrate(t *testing.T) {
 	}
 }
 
+// TestFlattenColumns tests that a flattened message is stored as prefixed
+// columns that can be queried directly
+func TestFlattenColumns(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.BlogFlatGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	for _, column := range []string{"by_name", "by_email"} {
+		if !db.Migrator().HasColumn(&gormgen.BlogFlatGORM{}, column) {
+			t.Errorf("Expected column %s on flat_blogs", column)
+		}
+	}
+
+	src := &api.Blog{Id: 1, Title: "Hello", Author: &api.Author{Name: "Alice", Email: "alice@example.com"}}
+	blog, err := gormgen.BlogToBlogFlatGORM(src, nil, nil)
+	if err != nil {
+		t.Fatalf("BlogToBlogFlatGORM failed: %v", err)
+	}
+	if err := db.Create(blog).Error; err != nil {
+		t.Fatalf("Failed to create blog: %v", err)
+	}
+
+	var found gormgen.BlogFlatGORM
+	if err := db.Where("by_name = ?", "Alice").First(&found).Error; err != nil {
+		t.Fatalf("Failed to query by flattened column: %v", err)
+	}
+	got, err := gormgen.BlogFromBlogFlatGORM(nil, &found, nil)
+	if err != nil {
+		t.Fatalf("BlogFromBlogFlatGORM failed: %v", err)
+	}
+	if got.Author.GetEmail() != "alice@example.com" {
+		t.Errorf("Author.Email mismatch: got %s, want alice@example.com", got.Author.GetEmail())
+	}
+}
+
+// TestMessageStorageBinary tests that a PROTO_BINARY message column survives a
+// database round trip, including the distinction between nil and empty
+func TestMessageStorageBinary(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.BlogBlobGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	sources := []*api.Blog{
+		{Id: 1, Title: "With author", Author: &api.Author{Name: "Alice", Email: "alice@example.com"}},
+		{Id: 2, Title: "Without author"},
+	}
+	for _, src := range sources {
+		blog, err := gormgen.BlogToBlogBlobGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("BlogToBlogBlobGORM failed: %v", err)
+		}
+		if err := db.Create(blog).Error; err != nil {
+			t.Fatalf("Failed to create blog: %v", err)
+		}
+
+		var found gormgen.BlogBlobGORM
+		if err := db.First(&found, src.Id).Error; err != nil {
+			t.Fatalf("Failed to load blog %d: %v", src.Id, err)
+		}
+		got, err := gormgen.BlogFromBlogBlobGORM(nil, &found, nil)
+		if err != nil {
+			t.Fatalf("BlogFromBlogBlobGORM failed: %v", err)
+		}
+		if !proto.Equal(src, got) {
+			t.Errorf("Round trip mismatch: got %v, want %v", got, src)
+		}
+	}
+}
+
 // TestDALCreate tests the Create method
 func TestDALCreate(t *testing.T) {
 	db := setupTestDB(t)
@@ -402,6 +486,602 @@ func TestDALListAndBatchGet(t *testing.T) {
 	}
 }
 
+// TestDALChildTable tests that a child_table field is synced on Save and
+// preloaded, in order, on Get
+func TestDALChildTable(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.LibraryChildGORM{}, &gormgen.LibraryChildGORMContributorsChild{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	libraryDAL := &dal.LibraryChildGORMDAL{}
+
+	names := func(lib *api.Library) []string {
+		var out []string
+		for _, author := range lib.Contributors {
+			out = append(out, author.Name)
+		}
+		return out
+	}
+	save := func(src *api.Library) {
+		t.Helper()
+		lib, err := gormgen.LibraryToLibraryChildGORM(src, nil, nil)
+		if err != nil {
+			t.Fatalf("LibraryToLibraryChildGORM failed: %v", err)
+		}
+		if err := libraryDAL.Save(ctx, db, lib); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+	load := func() *api.Library {
+		t.Helper()
+		lib, err := libraryDAL.Get(ctx, db, 1)
+		if err != nil || lib == nil {
+			t.Fatalf("Get failed: %v, %v", lib, err)
+		}
+		got, err := gormgen.LibraryFromLibraryChildGORM(nil, lib, nil)
+		if err != nil {
+			t.Fatalf("LibraryFromLibraryChildGORM failed: %v", err)
+		}
+		return got
+	}
+	countRows := func() int64 {
+		var count int64
+		db.Model(&gormgen.LibraryChildGORMContributorsChild{}).Where("parent_id = ?", 1).Count(&count)
+		return count
+	}
+
+	// Insert
+	save(&api.Library{Id: 1, Name: "Central", Contributors: []*api.Author{
+		{Name: "Alice", Email: "alice@example.com"},
+		{Name: "Bob", Email: "bob@example.com"},
+		{Name: "Carol", Email: "carol@example.com"},
+	}})
+	if got := names(load()); fmt.Sprint(got) != "[Alice Bob Carol]" {
+		t.Errorf("After insert: got %v", got)
+	}
+
+	// Update in place and delete the tail
+	save(&api.Library{Id: 1, Name: "Central", Contributors: []*api.Author{
+		{Name: "Carol", Email: "carol@example.com"},
+		{Name: "Alice", Email: "alice@example.com"},
+	}})
+	got := load()
+	if fmt.Sprint(names(got)) != "[Carol Alice]" {
+		t.Errorf("After update: got %v", names(got))
+	}
+	if got.Contributors[1].Email != "alice@example.com" {
+		t.Errorf("Contributors[1].Email: got %s, want alice@example.com", got.Contributors[1].Email)
+	}
+	if n := countRows(); n != 2 {
+		t.Errorf("Expected 2 child rows after update, got %d", n)
+	}
+
+	// Clear
+	save(&api.Library{Id: 1, Name: "Central"})
+	if got := load(); len(got.Contributors) != 0 {
+		t.Errorf("After clear: got %v", names(got))
+	}
+	if n := countRows(); n != 0 {
+		t.Errorf("Expected 0 child rows after clear, got %d", n)
+	}
+
+	// Delete removes the children with the parent
+	save(&api.Library{Id: 1, Name: "Central", Contributors: []*api.Author{{Name: "Dave"}}})
+	if err := libraryDAL.Delete(ctx, db, 1); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if n := countRows(); n != 0 {
+		t.Errorf("Expected 0 child rows after delete, got %d", n)
+	}
+}
+
+// TestDALTenantScoping tests that a tenant_column DAL only sees and writes the
+// rows of the context's tenant
+func TestDALTenantScoping(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.TenantUserGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	acme := tenant.WithTenant(context.Background(), "acme")
+	globex := tenant.WithTenant(context.Background(), "globex")
+	userDAL := &dal.TenantUserGORMDAL{}
+
+	// Create stamps the tenant, whatever the caller set
+	alice := &gormgen.TenantUserGORM{Id: 1, Name: "Alice", TenantId: "globex"}
+	if err := userDAL.Create(acme, db, alice); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if alice.TenantId != "acme" {
+		t.Errorf("Expected Create to stamp tenant acme, got %q", alice.TenantId)
+	}
+	if err := userDAL.Create(globex, db, &gormgen.TenantUserGORM{Id: 2, Name: "Bob"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// Reads are scoped to the tenant
+	if got, err := userDAL.Get(acme, db, 1); err != nil || got == nil || got.Name != "Alice" {
+		t.Errorf("Get in own tenant: got %v, %v", got, err)
+	}
+	if got, err := userDAL.Get(globex, db, 1); err != nil || got != nil {
+		t.Errorf("Get across tenants should find nothing, got %v, %v", got, err)
+	}
+	if got, err := userDAL.List(acme, db); err != nil || len(got) != 1 || got[0].Id != 1 {
+		t.Errorf("List: got %v, %v", got, err)
+	}
+	if got, err := userDAL.BatchGet(globex, db, []uint32{1, 2}); err != nil || len(got) != 1 || got[0].Id != 2 {
+		t.Errorf("BatchGet: got %v, %v", got, err)
+	}
+
+	// Writes cannot reach another tenant's rows
+	err := userDAL.Update(globex, db, &gormgen.TenantUserGORM{Id: 1, Name: "Mallory"})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Update across tenants: expected ErrRecordNotFound, got %v", err)
+	}
+	err = userDAL.Save(globex, db, &gormgen.TenantUserGORM{Id: 1, Name: "Mallory"})
+	if !errors.Is(err, tenant.ErrCrossTenant) {
+		t.Errorf("Save across tenants: expected ErrCrossTenant, got %v", err)
+	}
+	if err := userDAL.Delete(globex, db, 1); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 1); got == nil || got.Name != "Alice" {
+		t.Errorf("Alice should be untouched by globex, got %v", got)
+	}
+
+	// Writes within the tenant still work
+	if err := userDAL.Save(acme, db, &gormgen.TenantUserGORM{Id: 1, Name: "Alicia"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 1); got == nil || got.Name != "Alicia" {
+		t.Errorf("After Save: got %v", got)
+	}
+	if err := userDAL.Delete(acme, db, 1); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 1); got != nil {
+		t.Errorf("Expected Alice deleted, got %v", got)
+	}
+
+	// Operations refuse to run without a tenant
+	if _, err := userDAL.Get(context.Background(), db, 2); !errors.Is(err, tenant.ErrMissingTenant) {
+		t.Errorf("Get without tenant: expected ErrMissingTenant, got %v", err)
+	}
+	if err := userDAL.Create(context.Background(), db, &gormgen.TenantUserGORM{Id: 3}); !errors.Is(err, tenant.ErrMissingTenant) {
+		t.Errorf("Create without tenant: expected ErrMissingTenant, got %v", err)
+	}
+}
+
+// TestDALAudit tests that audit columns are filled from the context's actor
+// and the DAL's clock, and reach the API message through the converters
+func TestDALAudit(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.NoteGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	t1 := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	t3 := time.Date(2025, 1, 3, 9, 0, 0, 0, time.UTC)
+	now := t1
+	noteDAL := &dal.NoteGORMDAL{Clock: func() time.Time { return now }}
+	alice := audit.WithActor(context.Background(), "alice")
+	bob := audit.WithActor(context.Background(), "bob")
+
+	load := func() *api.Note {
+		t.Helper()
+		note, err := noteDAL.Get(context.Background(), db, 1)
+		if err != nil || note == nil {
+			t.Fatalf("Get failed: %v, %v", note, err)
+		}
+		got, err := gormgen.NoteFromNoteGORM(nil, note, nil)
+		if err != nil {
+			t.Fatalf("NoteFromNoteGORM failed: %v", err)
+		}
+		return got
+	}
+	check := func(step string, got *api.Note, createdBy string, createdAt time.Time, updatedBy string, updatedAt time.Time) {
+		t.Helper()
+		if got.CreatedBy != createdBy || !got.CreatedAt.AsTime().Equal(createdAt) ||
+			got.UpdatedBy != updatedBy || !got.UpdatedAt.AsTime().Equal(updatedAt) {
+			t.Errorf("%s: got created %s@%v, updated %s@%v; want created %s@%v, updated %s@%v", step,
+				got.CreatedBy, got.CreatedAt.AsTime(), got.UpdatedBy, got.UpdatedAt.AsTime(),
+				createdBy, createdAt, updatedBy, updatedAt)
+		}
+	}
+
+	// Create fills every column
+	if err := noteDAL.Create(alice, db, &gormgen.NoteGORM{Id: 1, Text: "draft"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	check("After Create", load(), "alice", t1, "alice", t1)
+
+	// Save of an existing record keeps the creation audit, whatever the caller sent
+	now = t2
+	if err := noteDAL.Save(bob, db, &gormgen.NoteGORM{Id: 1, Text: "edited", CreatedBy: "mallory"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	check("After Save", load(), "alice", t1, "bob", t2)
+
+	// Update only touches the updated_* columns
+	now = t3
+	if err := noteDAL.Update(alice, db, &gormgen.NoteGORM{Id: 1, Text: "final"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	check("After Update", load(), "alice", t1, "alice", t3)
+
+	// Writes without an actor leave the *_by columns empty
+	if err := noteDAL.Save(context.Background(), db, &gormgen.NoteGORM{Id: 2, Text: "system"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if note, _ := noteDAL.Get(context.Background(), db, 2); note == nil || note.CreatedBy != "" || !note.CreatedAt.Equal(t3) {
+		t.Errorf("Save without actor: got %+v", note)
+	}
+}
+
+// TestDALUpdateChanged tests that UpdateChanged writes only the changed
+// columns (plus the updated_* audit columns) and skips no-op writes
+func TestDALUpdateChanged(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.NoteGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	t1 := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	now := t1
+	noteDAL := &dal.NoteGORMDAL{Clock: func() time.Time { return now }}
+	alice := audit.WithActor(context.Background(), "alice")
+	bob := audit.WithActor(context.Background(), "bob")
+
+	if err := noteDAL.Create(alice, db, &gormgen.NoteGORM{Id: 1, Text: "draft"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	old, err := noteDAL.Get(context.Background(), db, 1)
+	if err != nil || old == nil {
+		t.Fatalf("Get failed: %v, %v", old, err)
+	}
+
+	// Nothing changed: no write, so the audit columns keep their values
+	now = t2
+	same := *old
+	if cols := same.ChangedColumns(old); len(cols) != 0 || !same.Equal(old) {
+		t.Fatalf("copy: ChangedColumns = %v, Equal = %v; want none, true", cols, same.Equal(old))
+	}
+	if err := noteDAL.UpdateChanged(bob, db, old, &same); err != nil {
+		t.Fatalf("UpdateChanged without changes failed: %v", err)
+	}
+	if got, _ := noteDAL.Get(context.Background(), db, 1); got.UpdatedBy != "alice" || !got.UpdatedAt.Equal(t1) {
+		t.Errorf("no-op UpdateChanged wrote the record: updated %s@%v", got.UpdatedBy, got.UpdatedAt)
+	}
+
+	// Another writer changes created_by; a text change from the stale copy
+	// must not overwrite it
+	if err := db.Model(&gormgen.NoteGORM{}).Where("id = ?", 1).Update("created_by", "carol").Error; err != nil {
+		t.Fatalf("direct update failed: %v", err)
+	}
+	edited := *old
+	edited.Text = "final"
+	if cols := edited.ChangedColumns(old); len(cols) != 1 || cols[0] != "text" {
+		t.Fatalf("ChangedColumns = %v, want [text]", cols)
+	}
+	if err := noteDAL.UpdateChanged(bob, db, old, &edited); err != nil {
+		t.Fatalf("UpdateChanged failed: %v", err)
+	}
+	got, _ := noteDAL.Get(context.Background(), db, 1)
+	if got.Text != "final" || got.CreatedBy != "carol" || got.UpdatedBy != "bob" || !got.UpdatedAt.Equal(t2) {
+		t.Errorf("After UpdateChanged: got %+v; want text final, created_by carol, updated bob@%v", got, t2)
+	}
+
+	// Missing records are reported like Update
+	missing := gormgen.NoteGORM{Id: 2, Text: "x"}
+	if err := noteDAL.UpdateChanged(bob, db, &gormgen.NoteGORM{Id: 2}, &missing); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateChanged of a missing record: err = %v, want ErrRecordNotFound", err)
+	}
+
+	// Embedded structs report their columns with their prefix
+	a := &gormgen.BlogFlatGORM{Id: 1, Title: "t", Author: gormgen.AuthorGORM{Name: "Alice"}}
+	b := &gormgen.BlogFlatGORM{Id: 1, Title: "t", Author: gormgen.AuthorGORM{Name: "Bob"}}
+	if cols := b.ChangedColumns(a); len(cols) != 1 || cols[0] != "by_name" {
+		t.Errorf("BlogFlatGORM.ChangedColumns = %v, want [by_name]", cols)
+	}
+}
+
+// TestDALIterate tests that Iterate visits every matching record in batches
+// and that IterateAPI yields converted API messages
+func TestDALIterate(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.NoteGORM{}, &gormgen.TenantUserGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	noteDAL := &dal.NoteGORMDAL{}
+	for i := 1; i <= 25; i++ {
+		if err := noteDAL.Create(ctx, db, &gormgen.NoteGORM{Id: gormgen.NoteID(i), Text: fmt.Sprintf("note %d", i)}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	// Every record, in primary key order
+	var ids []gormgen.NoteID
+	err := noteDAL.Iterate(ctx, db, 10, func(note *gormgen.NoteGORM) error {
+		ids = append(ids, note.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Iterate failed: %v", err)
+	}
+	if len(ids) != 25 || ids[0] != 1 || ids[24] != 25 {
+		t.Errorf("Expected ids 1..25, got %v", ids)
+	}
+
+	// Filters apply and fn's error stops the iteration
+	stop := errors.New("stop")
+	var texts []string
+	err = noteDAL.IterateAPI(ctx, db.Where("id > ?", 20), 2, func(note *api.Note) error {
+		texts = append(texts, note.Text)
+		if len(texts) == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("Expected IterateAPI to return fn's error, got %v", err)
+	}
+	if fmt.Sprint(texts) != "[note 21 note 22 note 23]" {
+		t.Errorf("IterateAPI: got %v", texts)
+	}
+
+	// Tenant-scoped DALs only iterate the tenant's records
+	userDAL := &dal.TenantUserGORMDAL{}
+	acme := tenant.WithTenant(ctx, "acme")
+	userDAL.Create(acme, db, &gormgen.TenantUserGORM{Id: 1, Name: "Alice"})
+	userDAL.Create(tenant.WithTenant(ctx, "globex"), db, &gormgen.TenantUserGORM{Id: 2, Name: "Bob"})
+	var names []string
+	err = userDAL.Iterate(acme, db, 10, func(user *gormgen.TenantUserGORM) error {
+		names = append(names, user.Name)
+		return nil
+	})
+	if err != nil || fmt.Sprint(names) != "[Alice]" {
+		t.Errorf("Tenant Iterate: got %v, %v", names, err)
+	}
+}
+
+// TestDALGeneratedIDs tests that DALs fill empty id_strategy keys from their
+// IDGenerator, that the ID makes it back to the API message, and that
+// AUTO_INCREMENT keys are left to the database
+func TestDALGeneratedIDs(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.GameGORM{}, &gormgen.NoteGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	gameDAL := &dal.GameGORMDAL{IDGenerator: &idgen.Sequence{Prefix: "game-"}}
+	game := &gormgen.GameGORM{Name: "first"}
+	if err := gameDAL.Create(ctx, db, game); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if game.Id != "game-1" {
+		t.Errorf("Create: Id = %q, want game-1", game.Id)
+	}
+	apiGame, err := gormgen.GameFromGameGORM(nil, game, nil)
+	if err != nil || apiGame.Id != "game-1" {
+		t.Errorf("GameFromGameGORM: Id = %q, err = %v", apiGame.GetId(), err)
+	}
+
+	// Save generates too, and keeps IDs that are already set
+	second := &gormgen.GameGORM{Name: "second"}
+	if err := gameDAL.Save(ctx, db, second); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if second.Id != "game-2" {
+		t.Errorf("Save: Id = %q, want game-2", second.Id)
+	}
+	if err := gameDAL.Save(ctx, db, &gormgen.GameGORM{Id: "mine", Name: "third"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if got, err := gameDAL.Get(ctx, db, "mine"); err != nil || got == nil {
+		t.Errorf("Get(mine) = %v, %v", got, err)
+	}
+
+	// Without an IDGenerator the default produces ULIDs
+	defaulted := &gormgen.GameGORM{Name: "fourth"}
+	if err := (&dal.GameGORMDAL{}).Create(ctx, db, defaulted); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if len(defaulted.Id) != 26 {
+		t.Errorf("Default generator: Id = %q, want a ULID", defaulted.Id)
+	}
+
+	// AUTO_INCREMENT keys come back from the database
+	noteDAL := &dal.NoteGORMDAL{}
+	first, next := &gormgen.NoteGORM{Text: "a"}, &gormgen.NoteGORM{Text: "b"}
+	if err := noteDAL.Create(ctx, db, first); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := noteDAL.Save(ctx, db, next); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if first.Id == 0 || next.Id <= first.Id {
+		t.Errorf("Expected increasing auto-increment ids, got %d and %d", first.Id, next.Id)
+	}
+}
+
+// TestDALFilter tests that AIP-160 filters select the matching records
+func TestDALFilter(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.UserGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	for i, user := range []struct {
+		name string
+		age  uint32
+		year int
+	}{{"Alice", 30, 1990}, {"Bob", 25, 1995}, {"Carol", 40, 1980}, {"Dave", 35, 1985}} {
+		record := &gormgen.UserGORM{
+			Id:       uint32(i + 1),
+			Name:     user.name,
+			Email:    user.name + "@example.com",
+			Age:      user.age,
+			Birthday: time.Date(user.year, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	userDAL := &dal.UserGORMDAL{}
+	for filter, want := range map[string]string{
+		``:                                       "[Alice Bob Carol Dave]",
+		`age > 28 AND name != "Carol"`:           "[Alice Dave]",
+		`name = "*o*"`:                           "[Bob Carol]",
+		`birthday < "1986-01-01T00:00:00Z"`:      "[Carol Dave]",
+		`-(age < 30 OR age > 36)`:                "[Alice Dave]",
+		`name:Bob OR email = "Dave@example.com"`: "[Bob Dave]",
+	} {
+		scope, err := userDAL.FilterScope(filter)
+		if err != nil {
+			t.Errorf("%s: FilterScope failed: %v", filter, err)
+			continue
+		}
+		users, err := userDAL.List(context.Background(), db.Scopes(scope).Order("id"))
+		if err != nil {
+			t.Errorf("%s: List failed: %v", filter, err)
+			continue
+		}
+		var names []string
+		for _, user := range users {
+			names = append(names, user.Name)
+		}
+		if got := fmt.Sprint(names); got != want {
+			t.Errorf("%s: got %s, want %s", filter, got, want)
+		}
+	}
+
+	// Fields outside the schema and mistyped values are rejected
+	for _, filter := range []string{`deleted_at > "2020-01-01T00:00:00Z"`, `age = old`} {
+		if _, err := userDAL.FilterScope(filter); !errors.Is(err, filtering.ErrInvalidFilter) {
+			t.Errorf("%s: expected an invalid filter error, got %v", filter, err)
+		}
+	}
+}
+
+// TestDALCache tests that the cached DAL serves reads from the cache, keeps
+// tenants apart, caches misses, and is invalidated by its own writes
+func TestDALCache(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.TenantUserGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	acme := tenant.WithTenant(context.Background(), "acme")
+	globex := tenant.WithTenant(context.Background(), "globex")
+	userDAL := dal.NewTenantUserGORMCachedDAL("", cache.NewLRU(100))
+	userDAL.NegativeTTL = time.Minute
+
+	if err := userDAL.Create(acme, db, &gormgen.TenantUserGORM{Id: 1, Name: "Alice"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if got, err := userDAL.Get(acme, db, 1); err != nil || got == nil || got.Name != "Alice" || got.TenantId != "acme" {
+		t.Fatalf("Get: got %v, %v", got, err)
+	}
+
+	// Changes made behind the DAL's back are not seen while cached
+	db.Model(&gormgen.TenantUserGORM{}).Where("id = ?", 1).Update("name", "Changed")
+	if got, _ := userDAL.Get(acme, db, 1); got == nil || got.Name != "Alice" || got.TenantId != "acme" {
+		t.Errorf("Expected the cached record, got %v", got)
+	}
+
+	// Other tenants have their own entries
+	if got, err := userDAL.Get(globex, db, 1); err != nil || got != nil {
+		t.Errorf("Get across tenants should find nothing, got %v, %v", got, err)
+	}
+
+	// Misses are cached until the DAL writes the record
+	if got, _ := userDAL.Get(acme, db, 2); got != nil {
+		t.Fatalf("Expected no record 2, got %v", got)
+	}
+	db.Create(&gormgen.TenantUserGORM{Id: 2, Name: "Bob", TenantId: "acme"})
+	if got, _ := userDAL.Get(acme, db, 2); got != nil {
+		t.Errorf("Expected the cached miss, got %v", got)
+	}
+	if got, err := userDAL.BatchGet(acme, db, []uint32{1, 2}); err != nil || len(got) != 1 || got[0].Name != "Alice" {
+		t.Errorf("BatchGet: expected only the cached Alice, got %v, %v", got, err)
+	}
+	if err := userDAL.Save(acme, db, &gormgen.TenantUserGORM{Id: 2, Name: "Bobby"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 2); got == nil || got.Name != "Bobby" {
+		t.Errorf("After Save: got %v", got)
+	}
+
+	// Update and Delete invalidate
+	if err := userDAL.Update(acme, db, &gormgen.TenantUserGORM{Id: 1, Name: "Alicia"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 1); got == nil || got.Name != "Alicia" {
+		t.Errorf("After Update: got %v", got)
+	}
+	if err := userDAL.Delete(acme, db, 1); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if got, _ := userDAL.Get(acme, db, 1); got != nil {
+		t.Errorf("Expected Alicia deleted, got %v", got)
+	}
+
+	if _, err := userDAL.Get(context.Background(), db, 2); !errors.Is(err, tenant.ErrMissingTenant) {
+		t.Errorf("Get without tenant: expected ErrMissingTenant, got %v", err)
+	}
+}
+
+// TestDALCacheCompositeKey tests the cached DAL of a composite key record
+func TestDALCacheCompositeKey(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.GameMoveGORM{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	lru := cache.NewLRU(100)
+	moveDAL := dal.NewGameMoveGORMCachedDAL("", lru)
+	for i := int32(1); i <= 2; i++ {
+		move := &gormgen.GameMoveGORM{GameId: "g1", GroupNumber: "1", MoveNumber: i, Player: i}
+		if err := moveDAL.Create(ctx, db, move); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	got, err := moveDAL.Get(ctx, db, "g1", "1", 2)
+	if err != nil || got == nil || got.Player != 2 {
+		t.Fatalf("Get: got %v, %v", got, err)
+	}
+	keys := []dal.GameMoveKey{{GameId: "g1", GroupNumber: "1", MoveNumber: 1}, {GameId: "g1", GroupNumber: "1", MoveNumber: 2}}
+	moves, err := moveDAL.BatchGet(ctx, db, keys)
+	if err != nil || len(moves) != 2 {
+		t.Fatalf("BatchGet: got %v, %v", moves, err)
+	}
+	if lru.Len() != 2 {
+		t.Errorf("Expected both moves cached, got %d entries", lru.Len())
+	}
+
+	// Served from the cache once loaded
+	db.Where("game_id = ?", "g1").Delete(&gormgen.GameMoveGORM{})
+	if moves, _ := moveDAL.BatchGet(ctx, db, keys); len(moves) != 2 {
+		t.Errorf("Expected the cached moves, got %v", moves)
+	}
+	if err := moveDAL.Delete(ctx, db, "g1", "1", 1); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if got, _ := moveDAL.Get(ctx, db, "g1", "1", 1); got != nil {
+		t.Errorf("Expected move 1 deleted, got %v", got)
+	}
+}
+
 // TestOptimisticLocking tests conditional updates with timestamp checking
 func TestOptimisticLocking(t *testing.T) {
 	db := setupTestDB(t)
@@ -839,3 +1519,53 @@ func TestTestRecord1EnumRoundTrip(t *testing.T) {
 		t.Errorf("MapStringToEnum['c']: got %v, want %v", retrieved.MapStringToEnum["c"], api.SampleEnum_SAMPLE_ENUM_C)
 	}
 }
+
+// TestTestRecord4ElementConversions tests that repeated and map fields converted
+// element by element (Timestamps, uint32 IDs stored as strings, Timestamp map
+// values stored as Unix seconds) survive a store and load.
+func TestTestRecord4ElementConversions(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&gormgen.TestRecord4GORM{}); err != nil {
+		t.Fatalf("Failed to auto-migrate TestRecord4GORM: %v", err)
+	}
+
+	seen := time.Date(2024, 6, 15, 10, 30, 0, 0, time.UTC)
+	src := &api.TestRecord4{
+		Id:        "r1",
+		SeenAt:    []*timestamppb.Timestamp{timestamppb.New(seen), timestamppb.New(seen.Add(time.Hour))},
+		MemberIds: []uint32{7, 42, 4294967295},
+		Deadlines: map[string]*timestamppb.Timestamp{
+			"draft": timestamppb.New(seen.Add(24 * time.Hour)),
+			"final": timestamppb.New(seen.Add(48 * time.Hour)),
+		},
+	}
+
+	record, err := gormgen.TestRecord4ToTestRecord4GORM(src, nil, nil)
+	if err != nil {
+		t.Fatalf("TestRecord4ToTestRecord4GORM failed: %v", err)
+	}
+	if want := []string{"7", "42", "4294967295"}; fmt.Sprint(record.MemberIds) != fmt.Sprint(want) {
+		t.Errorf("MemberIds = %v, want %v", record.MemberIds, want)
+	}
+	if got, want := record.Deadlines["draft"], seen.Add(24*time.Hour).Unix(); got != want {
+		t.Errorf("Deadlines[draft] = %d, want %d", got, want)
+	}
+
+	recordDAL := dal.NewTestRecord4GORMDAL("")
+	ctx := context.Background()
+	if err := recordDAL.Create(ctx, db, record); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	loaded, err := recordDAL.Get(ctx, db, "r1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	got, err := gormgen.TestRecord4FromTestRecord4GORM(nil, loaded, nil)
+	if err != nil {
+		t.Fatalf("TestRecord4FromTestRecord4GORM failed: %v", err)
+	}
+	if !proto.Equal(got, src) {
+		t.Errorf("Loaded record mismatch:\ngot:  %v\nwant: %v", got, src)
+	}
+}
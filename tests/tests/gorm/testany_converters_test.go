@@ -1,9 +1,11 @@
 package gorm
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	"github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	"github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -636,3 +638,32 @@ func TestRecord2Conversion_NilMaps(t *testing.T) {
 		t.Errorf("Round-trip BoolToMessage should be nil")
 	}
 }
+
+// TestRecord4Conversion_BadMemberID verifies that a stored ID that does not
+// parse fails the conversion with the element's path instead of panicking.
+func TestRecord4Conversion_BadMemberID(t *testing.T) {
+	record := &gorm.TestRecord4GORM{Id: "r1", MemberIds: []string{"7", "seven"}}
+
+	_, err := gorm.TestRecord4FromTestRecord4GORM(nil, record, nil)
+
+	var convErr *converters.ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("expected a ConversionError, got %v", err)
+	}
+	if convErr.Path != "test_record4.member_ids[1]" {
+		t.Errorf("Path = %q, want %q", convErr.Path, "test_record4.member_ids[1]")
+	}
+	if convErr.Direction != converters.FromTarget {
+		t.Errorf("Direction = %s, want from_target", convErr.Direction)
+	}
+
+	// Batch converters add the index of the failed record
+	records := []*gorm.TestRecord4GORM{{Id: "r0"}, record}
+	_, err = gorm.TestRecord4SliceFromTestRecord4GORMSlice(records, nil)
+	if !errors.As(err, &convErr) {
+		t.Fatalf("expected a ConversionError from the batch converter, got %v", err)
+	}
+	if convErr.Path != "test_record4[1].member_ids[1]" {
+		t.Errorf("Path = %q, want %q", convErr.Path, "test_record4[1].member_ids[1]")
+	}
+}
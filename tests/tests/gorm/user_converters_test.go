@@ -1,10 +1,13 @@
 package gorm
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/panyam/protoc-gen-dal/pkg/converters"
 	"github.com/panyam/protoc-gen-dal/tests/gen/go/api"
 	"github.com/panyam/protoc-gen-dal/tests/gen/gorm/gorm"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -846,3 +849,110 @@ func TestOrganizationConversion_NilDepartments(t *testing.T) {
 		t.Errorf("Round-trip: Expected nil Departments, got %v", apiOrg.Departments)
 	}
 }
+
+// TestBlogConversion_WithOptions verifies that the WithOptions converters carry
+// their options into nested message converters: the nested Author conversion
+// counts towards the maximum depth in both directions.
+func TestBlogConversion_WithOptions(t *testing.T) {
+	ctx := context.Background()
+	src := &api.Blog{
+		Id:     1,
+		Title:  "Nested",
+		Author: &api.Author{Name: "Alice", Email: "alice@example.com"},
+	}
+
+	if _, err := gorm.BlogToBlogAsIsGORMWithOptions(ctx, src, nil, converters.WithMaxDepth(1)); !errors.Is(err, converters.ErrMaxDepthExceeded) {
+		t.Fatalf("ToTarget with max depth 1: err = %v, want ErrMaxDepthExceeded", err)
+	}
+
+	gormBlog, err := gorm.BlogToBlogAsIsGORMWithOptions(ctx, src, nil, converters.WithMaxDepth(2))
+	if err != nil {
+		t.Fatalf("ToTarget with max depth 2 failed: %v", err)
+	}
+	if gormBlog.Author.Name != "Alice" {
+		t.Errorf("Author.Name = %q, want %q", gormBlog.Author.Name, "Alice")
+	}
+
+	if _, err := gorm.BlogFromBlogAsIsGORMWithOptions(ctx, nil, gormBlog, converters.WithMaxDepth(1)); !errors.Is(err, converters.ErrMaxDepthExceeded) {
+		t.Fatalf("FromTarget with max depth 1: err = %v, want ErrMaxDepthExceeded", err)
+	}
+
+	// The plain converters have no limit
+	apiBlog, err := gorm.BlogFromBlogAsIsGORM(nil, gormBlog, nil)
+	if err != nil {
+		t.Fatalf("BlogFromBlogAsIsGORM failed: %v", err)
+	}
+	if apiBlog.Author.GetEmail() != "alice@example.com" {
+		t.Errorf("Round-trip: Author.Email = %q, want %q", apiBlog.Author.GetEmail(), "alice@example.com")
+	}
+}
+
+// TestLibraryConversion_ErrorPath verifies that a failure in a repeated message
+// element surfaces as a ConversionError with the element's field path.
+func TestLibraryConversion_ErrorPath(t *testing.T) {
+	src := &api.Library{
+		Id:           1,
+		Name:         "Central",
+		Contributors: []*api.Author{{Name: "Alice"}},
+	}
+
+	// The contributors' Author converters are the second level
+	_, err := gorm.LibraryToLibraryGORMWithOptions(context.Background(), src, nil, converters.WithMaxDepth(1))
+
+	var convErr *converters.ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("expected a ConversionError, got %v", err)
+	}
+	if convErr.Path != "library.contributors[0]" {
+		t.Errorf("Path = %q, want %q", convErr.Path, "library.contributors[0]")
+	}
+	if convErr.Direction != converters.ToTarget || convErr.SourceType != "api.Library" || convErr.TargetType != "LibraryGORM" {
+		t.Errorf("got %s %s -> %s, want to_target api.Library -> LibraryGORM", convErr.Direction, convErr.SourceType, convErr.TargetType)
+	}
+	if !errors.Is(err, converters.ErrMaxDepthExceeded) {
+		t.Errorf("errors.Is(err, ErrMaxDepthExceeded) = false for %v", err)
+	}
+}
+
+// TestAuthorConversion_Batch verifies the slice and map batch converters keep
+// positions, nil items and keys, and apply the decorator to every item.
+func TestAuthorConversion_Batch(t *testing.T) {
+	authors := []*api.Author{{Name: "Alice"}, nil, {Name: "Bob"}}
+	decorate := func(src *api.Author, dest *gorm.AuthorGORM) error {
+		dest.Email = src.Name + "@example.com"
+		return nil
+	}
+
+	gormAuthors, err := gorm.AuthorSliceToAuthorGORMSlice(authors, decorate)
+	if err != nil {
+		t.Fatalf("AuthorSliceToAuthorGORMSlice failed: %v", err)
+	}
+	if len(gormAuthors) != 3 || gormAuthors[1] != nil || gormAuthors[2].Email != "Bob@example.com" {
+		t.Fatalf("AuthorSliceToAuthorGORMSlice = %+v, want Alice, nil, Bob with decorated emails", gormAuthors)
+	}
+
+	apiAuthors, err := gorm.AuthorSliceFromAuthorGORMSlice(gormAuthors, nil)
+	if err != nil {
+		t.Fatalf("AuthorSliceFromAuthorGORMSlice failed: %v", err)
+	}
+	if len(apiAuthors) != 3 || apiAuthors[0].GetEmail() != "Alice@example.com" || apiAuthors[1] != nil {
+		t.Errorf("AuthorSliceFromAuthorGORMSlice = %v, want Alice, nil, Bob", apiAuthors)
+	}
+
+	if out, err := gorm.AuthorSliceToAuthorGORMSlice(nil, nil); out != nil || err != nil {
+		t.Errorf("AuthorSliceToAuthorGORMSlice(nil) = %v, %v; want nil, nil", out, err)
+	}
+
+	byID := map[int64]*api.Author{1: {Name: "Alice"}, 2: nil}
+	gormByID, err := gorm.AuthorMapToAuthorGORMMap(byID, nil)
+	if err != nil {
+		t.Fatalf("AuthorMapToAuthorGORMMap failed: %v", err)
+	}
+	if len(gormByID) != 2 || gormByID[1].Name != "Alice" || gormByID[2] != nil {
+		t.Errorf("AuthorMapToAuthorGORMMap = %v, want 1: Alice, 2: nil", gormByID)
+	}
+	back, err := gorm.AuthorMapFromAuthorGORMMap(gormByID, nil)
+	if err != nil || back[1].GetName() != "Alice" {
+		t.Errorf("AuthorMapFromAuthorGORMMap = %v, %v", back, err)
+	}
+}